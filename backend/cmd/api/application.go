@@ -2,24 +2,36 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
+	bulkoperationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/command"
+	permissionquery "github.com/tranvuongduy2003/go-copilot/internal/application/permission/query"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/cache/redis"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/jobs"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/messaging/memory"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/routes"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/server"
 	"github.com/tranvuongduy2003/go-copilot/pkg/config"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type Application struct {
-	Config      *config.Config
-	Database    *postgres.DB
-	RedisClient *redis.Client
-	EventBus    *memory.InMemoryEventBus
-	Router      http.Handler
-	Server      *server.Server
+	Config          *config.Config
+	Database        *postgres.DB
+	RedisClient     *redis.Client
+	EventBus        *memory.InMemoryEventBus
+	Router          http.Handler
+	Server          *server.Server
+	ExpiryJob       *jobs.AccessRequestExpiryJob
+	EscalationJob   *jobs.AccessReviewEscalationJob
+	PurgeJob        *jobs.UserPurgeJob
+	ReinstateJob    *jobs.UserReinstatementJob
+	Routes          *routes.Registry
+	PermissionDrift *permissionquery.DetectPermissionDriftHandler
+	BulkOperations  *bulkoperationcommand.StartBulkUserOperationHandler
 }
 
 func NewApplication(
@@ -28,25 +40,100 @@ func NewApplication(
 	redisClient *redis.Client,
 	eventBus *memory.InMemoryEventBus,
 	routerHandler http.Handler,
+	expiryJob *jobs.AccessRequestExpiryJob,
+	escalationJob *jobs.AccessReviewEscalationJob,
+	purgeJob *jobs.UserPurgeJob,
+	reinstateJob *jobs.UserReinstatementJob,
+	routeRegistry *routes.Registry,
+	detectPermissionDrift *permissionquery.DetectPermissionDriftHandler,
+	bulkOperations *bulkoperationcommand.StartBulkUserOperationHandler,
 ) *Application {
 	httpServer := server.New(routerHandler, cfg.Server, logger.L())
 
 	return &Application{
-		Config:      cfg,
-		Database:    database,
-		RedisClient: redisClient,
-		EventBus:    eventBus,
-		Router:      routerHandler,
-		Server:      httpServer,
+		Config:          cfg,
+		Database:        database,
+		RedisClient:     redisClient,
+		EventBus:        eventBus,
+		Router:          routerHandler,
+		Server:          httpServer,
+		ExpiryJob:       expiryJob,
+		EscalationJob:   escalationJob,
+		PurgeJob:        purgeJob,
+		ReinstateJob:    reinstateJob,
+		Routes:          routeRegistry,
+		PermissionDrift: detectPermissionDrift,
+		BulkOperations:  bulkOperations,
 	}
 }
 
+var errPermissionDrift = errors.New("routes reference permissions that do not exist")
+
+func (app *Application) CheckPermissionDrift(ctx context.Context) error {
+	drift, err := app.PermissionDrift.Handle(ctx, permissionquery.DetectPermissionDriftQuery{
+		References: app.Routes.References(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, missing := range drift.Missing {
+		logger.Warn("route references a permission that does not exist",
+			logger.String("permission", missing.Code),
+			logger.Strings("referenced_by", missing.ReferencedBy),
+		)
+	}
+
+	if len(drift.Unused) > 0 {
+		unused := make([]string, len(drift.Unused))
+		for i, permission := range drift.Unused {
+			unused[i] = permission.Code
+		}
+		logger.Info("permissions not required by any route",
+			logger.Strings("permissions", unused),
+		)
+	}
+
+	if drift.HasMissing() && app.Config.RBAC.PermissionDriftMode == config.PermissionDriftFail {
+		return errPermissionDrift
+	}
+	return nil
+}
+
 func (app *Application) Start() error {
+	if app.ExpiryJob != nil {
+		app.ExpiryJob.Start()
+	}
+	if app.EscalationJob != nil {
+		app.EscalationJob.Start()
+	}
+	if app.PurgeJob != nil {
+		app.PurgeJob.Start()
+	}
+	if app.ReinstateJob != nil {
+		app.ReinstateJob.Start()
+	}
 	return app.Server.Start()
 }
 
 func (app *Application) Shutdown(ctx context.Context) error {
-	return app.Server.Shutdown(ctx)
+	if app.ExpiryJob != nil {
+		app.ExpiryJob.Stop(ctx)
+	}
+	if app.EscalationJob != nil {
+		app.EscalationJob.Stop(ctx)
+	}
+	if app.PurgeJob != nil {
+		app.PurgeJob.Stop(ctx)
+	}
+	if app.ReinstateJob != nil {
+		app.ReinstateJob.Stop(ctx)
+	}
+	err := app.Server.Shutdown(ctx)
+	if app.BulkOperations != nil {
+		app.BulkOperations.Shutdown(ctx)
+	}
+	return err
 }
 
 func (app *Application) Close() {
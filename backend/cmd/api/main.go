@@ -101,6 +101,11 @@ func run() int {
 	}
 	defer application.Close()
 
+	if err := application.CheckPermissionDrift(applicationContext); err != nil {
+		logger.Error("permission drift check failed", logger.Err(err))
+		return 1
+	}
+
 	serverErrorChannel := make(chan error, 1)
 	go func() {
 		if err := application.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -8,27 +8,59 @@ import (
 
 	"github.com/google/wire"
 
+	accessrequestcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/command"
+	accessrequestquery "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/query"
+	accessreviewcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/command"
+	accessreviewquery "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/query"
+	auditlogquery "github.com/tranvuongduy2003/go-copilot/internal/application/auditlog/query"
 	authcommand "github.com/tranvuongduy2003/go-copilot/internal/application/auth/command"
 	authquery "github.com/tranvuongduy2003/go-copilot/internal/application/auth/query"
+	authzquery "github.com/tranvuongduy2003/go-copilot/internal/application/authz/query"
+	bulkoperationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/command"
+	bulkoperationquery "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/query"
+	groupcommand "github.com/tranvuongduy2003/go-copilot/internal/application/group/command"
+	groupquery "github.com/tranvuongduy2003/go-copilot/internal/application/group/query"
+	invitationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/command"
+	invitationquery "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/query"
+	organizationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/organization/command"
+	organizationquery "github.com/tranvuongduy2003/go-copilot/internal/application/organization/query"
 	permissioncommand "github.com/tranvuongduy2003/go-copilot/internal/application/permission/command"
 	permissionquery "github.com/tranvuongduy2003/go-copilot/internal/application/permission/query"
 	rolecommand "github.com/tranvuongduy2003/go-copilot/internal/application/role/command"
 	rolequery "github.com/tranvuongduy2003/go-copilot/internal/application/role/query"
+	sodcommand "github.com/tranvuongduy2003/go-copilot/internal/application/sod/command"
+	sodquery "github.com/tranvuongduy2003/go-copilot/internal/application/sod/query"
 	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
 	userquery "github.com/tranvuongduy2003/go-copilot/internal/application/user/query"
+	userattributecommand "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/command"
+	userattributequery "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/audit"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/cache/redis"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/jobs"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/mail"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/messaging/memory"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/repository"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/storage"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/handler"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/router"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/routes"
 	"github.com/tranvuongduy2003/go-copilot/pkg/config"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 	"github.com/tranvuongduy2003/go-copilot/pkg/security"
@@ -55,6 +87,50 @@ func provideRefreshTokenRepository(database *postgres.DB) *repository.RefreshTok
 	return repository.NewRefreshTokenRepository(database.Pool())
 }
 
+func provideOrganizationRepository(database *postgres.DB) *repository.OrganizationRepository {
+	return repository.NewOrganizationRepository(database.Pool())
+}
+
+func provideGroupRepository(database *postgres.DB) *repository.GroupRepository {
+	return repository.NewGroupRepository(database.Pool())
+}
+
+func provideAccessRequestRepository(database *postgres.DB) *repository.AccessRequestRepository {
+	return repository.NewAccessRequestRepository(database.Pool())
+}
+
+func provideAccessReviewRepository(database *postgres.DB) *repository.AccessReviewRepository {
+	return repository.NewAccessReviewRepository(database.Pool())
+}
+
+func provideBulkOperationRepository(database *postgres.DB) *repository.BulkOperationRepository {
+	return repository.NewBulkOperationRepository(database.Pool())
+}
+
+func provideUserAttributeDefinitionRepository(database *postgres.DB) *repository.UserAttributeDefinitionRepository {
+	return repository.NewUserAttributeDefinitionRepository(database.Pool())
+}
+
+func provideSoDRuleRepository(database *postgres.DB) *repository.SoDRuleRepository {
+	return repository.NewSoDRuleRepository(database.Pool())
+}
+
+func provideEmailChangeRepository(database *postgres.DB) *repository.EmailChangeRepository {
+	return repository.NewEmailChangeRepository(database.Pool())
+}
+
+func providePreferencesRepository(database *postgres.DB) *repository.PreferencesRepository {
+	return repository.NewPreferencesRepository(database.Pool())
+}
+
+func provideInvitationRepository(database *postgres.DB) *repository.InvitationRepository {
+	return repository.NewInvitationRepository(database.Pool())
+}
+
+func provideTransactionManager(database *postgres.DB) *postgres.TransactionManager {
+	return postgres.NewTransactionManager(database.Pool())
+}
+
 func providePasswordHasher() security.PasswordHasher {
 	return security.NewDefaultPasswordHasher()
 }
@@ -77,7 +153,7 @@ func provideTokenBlacklist(redisClient *redis.Client) auth.TokenBlacklist {
 	return security.NewRedisTokenBlacklist(redisClient.Client())
 }
 
-func providePasswordResetTokenStore(redisClient *redis.Client) authcommand.PasswordResetTokenStore {
+func providePasswordResetTokenStore(redisClient *redis.Client) *security.RedisPasswordResetTokenStore {
 	return security.NewRedisPasswordResetTokenStore(redisClient.Client())
 }
 
@@ -99,6 +175,14 @@ func provideAuditLogger(database *postgres.DB, log logger.Logger) audit.AuditLog
 	return audit.NewCompositeAuditLogger(loggerAudit, postgresAudit)
 }
 
+func provideAuditTrail(database *postgres.DB) *audit.PostgresAuditTrail {
+	return audit.NewPostgresAuditTrail(database.Pool())
+}
+
+func provideAuditLogRepository(database *postgres.DB) *audit.PostgresAuditLogRepository {
+	return audit.NewPostgresAuditLogRepository(database.Pool())
+}
+
 func provideAuthAuditHandler(auditLogger audit.AuditLogger, log logger.Logger) *audit.AuthAuditHandler {
 	return audit.NewAuthAuditHandler(auditLogger, log)
 }
@@ -131,14 +215,18 @@ func provideRegisterHandler(
 		PasswordHasher:         passwordHasher,
 		EventBus:               eventBus,
 		RefreshTokenTTL:        cfg.JWT.RefreshTokenTTL,
+		InviteOnly:             cfg.Registration.IsInviteOnly(),
 		Logger:                 log,
 	})
 }
 
 func provideLoginHandler(
 	userRepo user.Repository,
+	preferencesRepo user.PreferencesRepository,
 	roleRepo role.Repository,
+	groupRepo group.Repository,
 	permissionRepo permission.Repository,
+	organizationRepo organization.Repository,
 	refreshTokenRepo auth.RefreshTokenRepository,
 	tokenGen auth.TokenGenerator,
 	passwordHasher security.PasswordHasher,
@@ -149,8 +237,11 @@ func provideLoginHandler(
 ) *authcommand.LoginHandler {
 	return authcommand.NewLoginHandler(authcommand.LoginHandlerParams{
 		UserRepository:         userRepo,
+		PreferencesRepository:  preferencesRepo,
 		RoleRepository:         roleRepo,
+		GroupRepository:        groupRepo,
 		PermissionRepository:   permissionRepo,
+		OrganizationRepository: organizationRepo,
 		RefreshTokenRepository: refreshTokenRepo,
 		TokenGenerator:         tokenGen,
 		PasswordHasher:         passwordHasher,
@@ -163,8 +254,11 @@ func provideLoginHandler(
 
 func provideRefreshTokenHandler(
 	userRepo user.Repository,
+	preferencesRepo user.PreferencesRepository,
 	roleRepo role.Repository,
+	groupRepo group.Repository,
 	permissionRepo permission.Repository,
+	organizationRepo organization.Repository,
 	refreshTokenRepo auth.RefreshTokenRepository,
 	tokenGen auth.TokenGenerator,
 	tokenBlacklist auth.TokenBlacklist,
@@ -174,8 +268,11 @@ func provideRefreshTokenHandler(
 ) *authcommand.RefreshTokenHandler {
 	return authcommand.NewRefreshTokenHandler(authcommand.RefreshTokenHandlerParams{
 		UserRepository:         userRepo,
+		PreferencesRepository:  preferencesRepo,
 		RoleRepository:         roleRepo,
+		GroupRepository:        groupRepo,
 		PermissionRepository:   permissionRepo,
+		OrganizationRepository: organizationRepo,
 		RefreshTokenRepository: refreshTokenRepo,
 		TokenGenerator:         tokenGen,
 		TokenBlacklist:         tokenBlacklist,
@@ -185,6 +282,34 @@ func provideRefreshTokenHandler(
 	})
 }
 
+func provideSwitchOrganizationHandler(
+	userRepo user.Repository,
+	preferencesRepo user.PreferencesRepository,
+	roleRepo role.Repository,
+	groupRepo group.Repository,
+	permissionRepo permission.Repository,
+	organizationRepo organization.Repository,
+	refreshTokenRepo auth.RefreshTokenRepository,
+	tokenGen auth.TokenGenerator,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *authcommand.SwitchOrganizationHandler {
+	return authcommand.NewSwitchOrganizationHandler(authcommand.SwitchOrganizationHandlerParams{
+		UserRepository:         userRepo,
+		PreferencesRepository:  preferencesRepo,
+		RoleRepository:         roleRepo,
+		GroupRepository:        groupRepo,
+		PermissionRepository:   permissionRepo,
+		OrganizationRepository: organizationRepo,
+		RefreshTokenRepository: refreshTokenRepo,
+		TokenGenerator:         tokenGen,
+		EventBus:               eventBus,
+		RefreshTokenTTL:        cfg.JWT.RefreshTokenTTL,
+		Logger:                 log,
+	})
+}
+
 func provideLogoutHandler(
 	refreshTokenRepo auth.RefreshTokenRepository,
 	tokenBlacklist auth.TokenBlacklist,
@@ -237,12 +362,14 @@ func provideResetPasswordHandler(
 func provideGetCurrentUserHandler(
 	userRepo user.Repository,
 	roleRepo role.Repository,
+	groupRepo group.Repository,
 	permissionRepo permission.Repository,
 	log logger.Logger,
 ) *authquery.GetCurrentUserHandler {
 	return authquery.NewGetCurrentUserHandler(authquery.GetCurrentUserHandlerParams{
 		UserRepository:       userRepo,
 		RoleRepository:       roleRepo,
+		GroupRepository:      groupRepo,
 		PermissionRepository: permissionRepo,
 		Logger:               log,
 	})
@@ -258,6 +385,199 @@ func provideGetUserSessionsHandler(
 	})
 }
 
+func provideGetUserSessionsByCursorHandler(
+	refreshTokenRepo auth.RefreshTokenRepository,
+	log logger.Logger,
+) *authquery.GetUserSessionsByCursorHandler {
+	return authquery.NewGetUserSessionsByCursorHandler(authquery.GetUserSessionsByCursorHandlerParams{
+		RefreshTokenRepository: refreshTokenRepo,
+		Logger:                 log,
+	})
+}
+
+func provideCreateAccessRequestHandler(
+	accessRequestRepo accessrequest.Repository,
+	userRepo user.Repository,
+	roleRepo role.Repository,
+	organizationRepo organization.Repository,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *accessrequestcommand.CreateAccessRequestHandler {
+	return accessrequestcommand.NewCreateAccessRequestHandler(accessRequestRepo, userRepo, roleRepo, organizationRepo, eventBus, log, cfg.AccessRequest.MaxDuration)
+}
+
+func provideApproveAccessRequestHandler(
+	accessRequestRepo accessrequest.Repository,
+	userRepo user.Repository,
+	roleRepo role.Repository,
+	groupRepo group.Repository,
+	permissionRepo permission.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *accessrequestcommand.ApproveAccessRequestHandler {
+	return accessrequestcommand.NewApproveAccessRequestHandler(accessRequestRepo, userRepo, roleRepo, groupRepo, permissionRepo, delegationPolicy, sodChecker, transactionManager, eventBus, log, cfg.AccessRequest.ApproverPermission)
+}
+
+func provideDenyAccessRequestHandler(
+	accessRequestRepo accessrequest.Repository,
+	userRepo user.Repository,
+	roleRepo role.Repository,
+	groupRepo group.Repository,
+	permissionRepo permission.Repository,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *accessrequestcommand.DenyAccessRequestHandler {
+	return accessrequestcommand.NewDenyAccessRequestHandler(accessRequestRepo, userRepo, roleRepo, groupRepo, permissionRepo, eventBus, log, cfg.AccessRequest.ApproverPermission)
+}
+
+func provideGetAccessRequestHandler(
+	accessRequestRepo accessrequest.Repository,
+	userRepo user.Repository,
+	roleRepo role.Repository,
+	groupRepo group.Repository,
+	permissionRepo permission.Repository,
+	cfg *config.Config,
+	log logger.Logger,
+) *accessrequestquery.GetAccessRequestHandler {
+	return accessrequestquery.NewGetAccessRequestHandler(accessRequestRepo, userRepo, roleRepo, groupRepo, permissionRepo, log, cfg.AccessRequest.ApproverPermission)
+}
+
+func provideAccessRequestExpiryJob(
+	expireHandler *accessrequestcommand.ExpireAccessRequestsHandler,
+	cfg *config.Config,
+	log logger.Logger,
+) *jobs.AccessRequestExpiryJob {
+	return jobs.NewAccessRequestExpiryJob(expireHandler, cfg.AccessRequest.ExpiryCheckInterval, log)
+}
+
+func provideExportAccessReviewEvidenceHandler(
+	accessReviewRepo accessreview.Repository,
+	userRepo user.Repository,
+	roleRepo role.Repository,
+	cfg *config.Config,
+	log logger.Logger,
+) *accessreviewquery.ExportAccessReviewEvidenceHandler {
+	return accessreviewquery.NewExportAccessReviewEvidenceHandler(accessReviewRepo, userRepo, roleRepo, log, cfg.AccessReview.EvidenceSigningKey)
+}
+
+func provideAccessReviewEscalationJob(
+	escalateHandler *accessreviewcommand.EscalateOverdueAccessReviewsHandler,
+	cfg *config.Config,
+	log logger.Logger,
+) *jobs.AccessReviewEscalationJob {
+	return jobs.NewAccessReviewEscalationJob(escalateHandler, cfg.AccessReview.EscalationCheckInterval, log)
+}
+
+func providePurgeDeletedUsersHandler(
+	userRepo user.Repository,
+	auditTrail user.AuditTrail,
+	blobStore shared.BlobStore,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *usercommand.PurgeDeletedUsersHandler {
+	return usercommand.NewPurgeDeletedUsersHandler(userRepo, auditTrail, blobStore, transactionManager, eventBus, log, cfg.UserRetention.Period)
+}
+
+func provideUserPurgeJob(
+	purgeHandler *usercommand.PurgeDeletedUsersHandler,
+	cfg *config.Config,
+	log logger.Logger,
+) *jobs.UserPurgeJob {
+	if cfg.UserRetention.Period <= 0 {
+		return nil
+	}
+	return jobs.NewUserPurgeJob(purgeHandler, cfg.UserRetention.PurgeCheckInterval, log)
+}
+
+func provideSuspendUserHandler(
+	userRepo user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *usercommand.SuspendUserHandler {
+	return usercommand.NewSuspendUserHandler(userRepo, delegationPolicy, eventBus, log, cfg.UserSuspension.MaxDuration)
+}
+
+func provideUserReinstatementJob(
+	reinstateHandler *usercommand.ReinstateSuspendedUsersHandler,
+	cfg *config.Config,
+	log logger.Logger,
+) *jobs.UserReinstatementJob {
+	return jobs.NewUserReinstatementJob(reinstateHandler, cfg.UserSuspension.ReinstateCheckInterval, log)
+}
+
+func provideMailer(cfg *config.Config, log logger.Logger) shared.Mailer {
+	if cfg.Mail.Driver == config.MailDriverSMTP {
+		return mail.NewSMTPMailer(mail.SMTPMailerConfig{
+			Host:     cfg.Mail.SMTP.Host,
+			Port:     cfg.Mail.SMTP.Port,
+			Username: cfg.Mail.SMTP.Username,
+			Password: cfg.Mail.SMTP.Password,
+			From:     cfg.Mail.From,
+		})
+	}
+	return mail.NewLogMailer(log)
+}
+
+func provideRequestEmailChangeHandler(
+	userRepo user.Repository,
+	emailChangeRepo user.EmailChangeRepository,
+	preferencesRepo user.PreferencesRepository,
+	mailer shared.Mailer,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *usercommand.RequestEmailChangeHandler {
+	return usercommand.NewRequestEmailChangeHandler(userRepo, emailChangeRepo, preferencesRepo, mailer, eventBus, log, usercommand.EmailChangeSettings{
+		TokenTTL:   cfg.EmailChange.TokenTTL,
+		ConfirmURL: cfg.EmailChange.ConfirmURL,
+		CancelURL:  cfg.EmailChange.CancelURL,
+	})
+}
+
+func provideBlobStore(cfg *config.Config) (shared.BlobStore, error) {
+	if cfg.Storage.Driver == config.StorageDriverS3 {
+		return storage.NewS3BlobStore(storage.S3BlobStoreConfig{
+			Endpoint:        cfg.Storage.S3.Endpoint,
+			Region:          cfg.Storage.S3.Region,
+			Bucket:          cfg.Storage.S3.Bucket,
+			AccessKeyID:     cfg.Storage.S3.AccessKeyID,
+			SecretAccessKey: cfg.Storage.S3.SecretAccessKey,
+			PublicBaseURL:   cfg.Storage.PublicBaseURL,
+		})
+	}
+	return storage.NewLocalBlobStore(cfg.Storage.LocalPath, cfg.Storage.MediaPath()), nil
+}
+
+func provideUploadAvatarHandler(
+	userRepo user.Repository,
+	blobStore shared.BlobStore,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	cfg *config.Config,
+	log logger.Logger,
+) *usercommand.UploadAvatarHandler {
+	return usercommand.NewUploadAvatarHandler(userRepo, blobStore, delegationPolicy, eventBus, log, cfg.Avatar.MaxUploadSize)
+}
+
+// provideMediaHandler serves locally stored blobs; with S3 the bucket or a CDN
+// in front of it serves them instead.
+func provideMediaHandler(cfg *config.Config) *handler.MediaHandler {
+	if cfg.Storage.Driver != config.StorageDriverLocal {
+		return nil
+	}
+	return handler.NewMediaHandler(cfg.Storage.MediaPath(), cfg.Storage.LocalPath)
+}
+
 func provideHealthHandler(database *postgres.DB, redisClient *redis.Client) *handler.HealthHandler {
 	return handler.NewHealthHandler(database, redisClient)
 }
@@ -286,53 +606,86 @@ func provideAuthHandler(
 	registerHandler *authcommand.RegisterHandler,
 	loginHandler *authcommand.LoginHandler,
 	refreshTokenHandler *authcommand.RefreshTokenHandler,
+	switchOrganizationHandler *authcommand.SwitchOrganizationHandler,
 	logoutHandler *authcommand.LogoutHandler,
 	forgotPasswordHandler *authcommand.ForgotPasswordHandler,
 	resetPasswordHandler *authcommand.ResetPasswordHandler,
 	revokeSessionHandler *authcommand.RevokeSessionHandler,
 	getCurrentUserHandler *authquery.GetCurrentUserHandler,
 	getUserSessionsHandler *authquery.GetUserSessionsHandler,
+	getUserSessionsByCursorHandler *authquery.GetUserSessionsByCursorHandler,
 	val *validator.Validator,
 	log logger.Logger,
 ) *handler.AuthHandler {
 	return handler.NewAuthHandler(handler.AuthHandlerParams{
-		RegisterHandler:        registerHandler,
-		LoginHandler:           loginHandler,
-		RefreshTokenHandler:    refreshTokenHandler,
-		LogoutHandler:          logoutHandler,
-		ForgotPasswordHandler:  forgotPasswordHandler,
-		ResetPasswordHandler:   resetPasswordHandler,
-		RevokeSessionHandler:   revokeSessionHandler,
-		GetCurrentUserHandler:  getCurrentUserHandler,
-		GetUserSessionsHandler: getUserSessionsHandler,
-		Validator:              val,
-		Logger:                 log,
+		RegisterHandler:                registerHandler,
+		LoginHandler:                   loginHandler,
+		RefreshTokenHandler:            refreshTokenHandler,
+		SwitchOrganizationHandler:      switchOrganizationHandler,
+		LogoutHandler:                  logoutHandler,
+		ForgotPasswordHandler:          forgotPasswordHandler,
+		ResetPasswordHandler:           resetPasswordHandler,
+		RevokeSessionHandler:           revokeSessionHandler,
+		GetCurrentUserHandler:          getCurrentUserHandler,
+		GetUserSessionsHandler:         getUserSessionsHandler,
+		GetUserSessionsByCursorHandler: getUserSessionsByCursorHandler,
+		Validator:                      val,
+		Logger:                         log,
 	})
 }
 
+func provideInvitationSettings(cfg *config.Config) invitationcommand.InvitationSettings {
+	return invitationcommand.InvitationSettings{
+		TokenTTL:  cfg.Invitation.TokenTTL,
+		AcceptURL: cfg.Invitation.AcceptURL,
+	}
+}
+
 func provideRouter(
 	userHandler *handler.UserHandler,
 	authHandler *handler.AuthHandler,
 	permissionHandler *handler.PermissionHandler,
 	roleHandler *handler.RoleHandler,
+	organizationHandler *handler.OrganizationHandler,
+	groupHandler *handler.GroupHandler,
+	authzHandler *handler.AuthzHandler,
+	accessRequestHandler *handler.AccessRequestHandler,
+	accessReviewHandler *handler.AccessReviewHandler,
+	invitationHandler *handler.InvitationHandler,
+	sodHandler *handler.SoDHandler,
+	userAttributeHandler *handler.UserAttributeHandler,
+	auditLogHandler *handler.AuditLogHandler,
 	healthHandler *handler.HealthHandler,
 	metricsHandler *handler.MetricsHandler,
 	docsHandler *handler.DocsHandler,
+	mediaHandler *handler.MediaHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	routeRegistry *routes.Registry,
 	log logger.Logger,
 	cfg *config.Config,
 ) http.Handler {
 	return router.NewRouter(router.RouterDependencies{
-		UserHandler:       userHandler,
-		AuthHandler:       authHandler,
-		PermissionHandler: permissionHandler,
-		RoleHandler:       roleHandler,
-		HealthHandler:     healthHandler,
-		MetricsHandler:    metricsHandler,
-		DocsHandler:       docsHandler,
-		AuthMiddleware:    authMiddleware,
-		Logger:            log,
-		Config:            cfg,
+		UserHandler:          userHandler,
+		AuthHandler:          authHandler,
+		PermissionHandler:    permissionHandler,
+		RoleHandler:          roleHandler,
+		OrganizationHandler:  organizationHandler,
+		GroupHandler:         groupHandler,
+		AuthzHandler:         authzHandler,
+		AccessRequestHandler: accessRequestHandler,
+		AccessReviewHandler:  accessReviewHandler,
+		InvitationHandler:    invitationHandler,
+		SoDHandler:           sodHandler,
+		UserAttributeHandler: userAttributeHandler,
+		AuditLogHandler:      auditLogHandler,
+		HealthHandler:        healthHandler,
+		MetricsHandler:       metricsHandler,
+		DocsHandler:          docsHandler,
+		MediaHandler:         mediaHandler,
+		AuthMiddleware:       authMiddleware,
+		RouteRegistry:        routeRegistry,
+		Logger:               log,
+		Config:               cfg,
 	})
 }
 
@@ -348,7 +701,15 @@ var InfrastructureSet = wire.NewSet(
 	providePasswordResetTokenStore,
 	provideAccountLockout,
 	provideAuthMiddleware,
+	provideBlobStore,
+	provideMailer,
+	provideAuditTrail,
+	provideAuditLogRepository,
 	wire.Bind(new(shared.EventBus), new(*memory.InMemoryEventBus)),
+	wire.Bind(new(authcommand.PasswordResetTokenStore), new(*security.RedisPasswordResetTokenStore)),
+	wire.Bind(new(usercommand.PasswordResetTokenEraser), new(*security.RedisPasswordResetTokenStore)),
+	wire.Bind(new(user.AuditTrail), new(*audit.PostgresAuditTrail)),
+	wire.Bind(new(auditlog.Repository), new(*audit.PostgresAuditLogRepository)),
 )
 
 var RepositorySet = wire.NewSet(
@@ -356,10 +717,37 @@ var RepositorySet = wire.NewSet(
 	providePermissionRepository,
 	provideRoleRepository,
 	provideRefreshTokenRepository,
+	provideOrganizationRepository,
+	provideGroupRepository,
+	provideAccessRequestRepository,
+	provideAccessReviewRepository,
+	provideBulkOperationRepository,
+	provideSoDRuleRepository,
+	provideUserAttributeDefinitionRepository,
+	provideEmailChangeRepository,
+	providePreferencesRepository,
+	provideInvitationRepository,
+	provideTransactionManager,
 	wire.Bind(new(user.Repository), new(*repository.UserRepository)),
 	wire.Bind(new(permission.Repository), new(*repository.PermissionRepository)),
 	wire.Bind(new(role.Repository), new(*repository.RoleRepository)),
 	wire.Bind(new(auth.RefreshTokenRepository), new(*repository.RefreshTokenRepository)),
+	wire.Bind(new(organization.Repository), new(*repository.OrganizationRepository)),
+	wire.Bind(new(group.Repository), new(*repository.GroupRepository)),
+	wire.Bind(new(accessrequest.Repository), new(*repository.AccessRequestRepository)),
+	wire.Bind(new(accessreview.Repository), new(*repository.AccessReviewRepository)),
+	wire.Bind(new(bulkoperation.Repository), new(*repository.BulkOperationRepository)),
+	wire.Bind(new(sod.Repository), new(*repository.SoDRuleRepository)),
+	wire.Bind(new(userattribute.Repository), new(*repository.UserAttributeDefinitionRepository)),
+	wire.Bind(new(user.EmailChangeRepository), new(*repository.EmailChangeRepository)),
+	wire.Bind(new(user.PreferencesRepository), new(*repository.PreferencesRepository)),
+	wire.Bind(new(invitation.Repository), new(*repository.InvitationRepository)),
+	wire.Bind(new(shared.TransactionManager), new(*postgres.TransactionManager)),
+)
+
+var DomainServiceSet = wire.NewSet(
+	sod.NewChecker,
+	authz.NewDelegationPolicy,
 )
 
 var UserCommandHandlerSet = wire.NewSet(
@@ -370,15 +758,32 @@ var UserCommandHandlerSet = wire.NewSet(
 	usercommand.NewActivateUserHandler,
 	usercommand.NewDeactivateUserHandler,
 	usercommand.NewBanUserHandler,
+	provideSuspendUserHandler,
+	usercommand.NewUnbanUserHandler,
+	usercommand.NewReinstateSuspendedUsersHandler,
 	usercommand.NewAssignRoleToUserHandler,
 	usercommand.NewRevokeRoleFromUserHandler,
 	usercommand.NewSetUserRolesHandler,
+	usercommand.NewDenyUserPermissionHandler,
+	usercommand.NewRemoveUserDenyHandler,
+	usercommand.NewImportUsersHandler,
+	provideUploadAvatarHandler,
+	usercommand.NewDeleteAvatarHandler,
+	usercommand.NewEraseUserHandler,
+	usercommand.NewRestoreUserHandler,
+	providePurgeDeletedUsersHandler,
+	provideRequestEmailChangeHandler,
+	usercommand.NewConfirmEmailChangeHandler,
+	usercommand.NewCancelEmailChangeHandler,
+	usercommand.NewChangeUserEmailHandler,
+	usercommand.NewUpdatePreferencesHandler,
 )
 
 var AuthCommandHandlerSet = wire.NewSet(
 	provideRegisterHandler,
 	provideLoginHandler,
 	provideRefreshTokenHandler,
+	provideSwitchOrganizationHandler,
 	provideLogoutHandler,
 	provideForgotPasswordHandler,
 	provideResetPasswordHandler,
@@ -388,13 +793,18 @@ var AuthCommandHandlerSet = wire.NewSet(
 var UserQueryHandlerSet = wire.NewSet(
 	userquery.NewGetUserHandler,
 	userquery.NewListUsersHandler,
+	userquery.NewListUsersByCursorHandler,
 	userquery.NewGetUserRolesHandler,
 	userquery.NewGetUserPermissionsHandler,
+	userquery.NewExportUsersHandler,
+	userquery.NewExportPersonalDataHandler,
+	userquery.NewGetPreferencesHandler,
 )
 
 var AuthQueryHandlerSet = wire.NewSet(
 	provideGetCurrentUserHandler,
 	provideGetUserSessionsHandler,
+	provideGetUserSessionsByCursorHandler,
 )
 
 var PermissionCommandHandlerSet = wire.NewSet(
@@ -405,8 +815,10 @@ var PermissionCommandHandlerSet = wire.NewSet(
 
 var PermissionQueryHandlerSet = wire.NewSet(
 	permissionquery.NewListPermissionsHandler,
+	permissionquery.NewListPermissionsByCursorHandler,
 	permissionquery.NewGetPermissionHandler,
 	permissionquery.NewGetPermissionsForRoleHandler,
+	permissionquery.NewDetectPermissionDriftHandler,
 )
 
 var RoleCommandHandlerSet = wire.NewSet(
@@ -416,14 +828,135 @@ var RoleCommandHandlerSet = wire.NewSet(
 	rolecommand.NewAssignPermissionToRoleHandler,
 	rolecommand.NewRemovePermissionFromRoleHandler,
 	rolecommand.NewSetRolePermissionsHandler,
+	rolecommand.NewDenyRolePermissionHandler,
+	rolecommand.NewRemoveRoleDenyHandler,
 )
 
 var RoleQueryHandlerSet = wire.NewSet(
 	rolequery.NewListRolesHandler,
+	rolequery.NewListRolesByCursorHandler,
+	rolequery.NewListAssignableRolesHandler,
 	rolequery.NewGetRoleHandler,
 	rolequery.NewGetUsersWithRoleHandler,
 )
 
+var OrganizationCommandHandlerSet = wire.NewSet(
+	organizationcommand.NewCreateOrganizationHandler,
+	organizationcommand.NewUpdateOrganizationHandler,
+	organizationcommand.NewDeleteOrganizationHandler,
+	organizationcommand.NewAddOrganizationMemberHandler,
+	organizationcommand.NewRemoveOrganizationMemberHandler,
+)
+
+var OrganizationQueryHandlerSet = wire.NewSet(
+	organizationquery.NewGetOrganizationHandler,
+	organizationquery.NewListOrganizationsHandler,
+	organizationquery.NewListOrganizationMembersHandler,
+	organizationquery.NewListUserOrganizationsHandler,
+)
+
+var GroupCommandHandlerSet = wire.NewSet(
+	groupcommand.NewCreateGroupHandler,
+	groupcommand.NewUpdateGroupHandler,
+	groupcommand.NewDeleteGroupHandler,
+	groupcommand.NewAddGroupMemberHandler,
+	groupcommand.NewRemoveGroupMemberHandler,
+	groupcommand.NewAssignRoleToGroupHandler,
+	groupcommand.NewRevokeRoleFromGroupHandler,
+)
+
+var GroupQueryHandlerSet = wire.NewSet(
+	groupquery.NewGetGroupHandler,
+	groupquery.NewListGroupsHandler,
+	groupquery.NewListGroupMembersHandler,
+	groupquery.NewListUserGroupsHandler,
+)
+
+var AuthzQueryHandlerSet = wire.NewSet(
+	authzquery.NewCheckPermissionsHandler,
+	authzquery.NewExplainPermissionsHandler,
+	authzquery.NewSimulateAccessChangeHandler,
+)
+
+var AccessRequestCommandHandlerSet = wire.NewSet(
+	provideCreateAccessRequestHandler,
+	provideApproveAccessRequestHandler,
+	provideDenyAccessRequestHandler,
+	accessrequestcommand.NewCancelAccessRequestHandler,
+	accessrequestcommand.NewExpireAccessRequestsHandler,
+)
+
+var AccessRequestQueryHandlerSet = wire.NewSet(
+	provideGetAccessRequestHandler,
+	accessrequestquery.NewListPendingAccessRequestsHandler,
+	accessrequestquery.NewListMyAccessRequestsHandler,
+)
+
+var InvitationCommandHandlerSet = wire.NewSet(
+	provideInvitationSettings,
+	invitationcommand.NewCreateInvitationHandler,
+	invitationcommand.NewResendInvitationHandler,
+	invitationcommand.NewRevokeInvitationHandler,
+	invitationcommand.NewAcceptInvitationHandler,
+)
+
+var InvitationQueryHandlerSet = wire.NewSet(
+	invitationquery.NewListInvitationsHandler,
+)
+
+var BulkOperationHandlerSet = wire.NewSet(
+	bulkoperationcommand.NewStartBulkUserOperationHandler,
+	bulkoperationquery.NewGetBulkOperationHandler,
+)
+
+var AccessReviewCommandHandlerSet = wire.NewSet(
+	accessreviewcommand.NewLaunchAccessReviewHandler,
+	accessreviewcommand.NewAssignAccessReviewerHandler,
+	accessreviewcommand.NewDecideAccessReviewItemHandler,
+	accessreviewcommand.NewCloseAccessReviewHandler,
+	accessreviewcommand.NewEscalateOverdueAccessReviewsHandler,
+)
+
+var AccessReviewQueryHandlerSet = wire.NewSet(
+	accessreviewquery.NewGetAccessReviewHandler,
+	accessreviewquery.NewListAccessReviewsHandler,
+	accessreviewquery.NewListMyReviewAssignmentsHandler,
+	provideExportAccessReviewEvidenceHandler,
+)
+
+var SoDCommandHandlerSet = wire.NewSet(
+	sodcommand.NewCreateSoDRuleHandler,
+	sodcommand.NewUpdateSoDRuleHandler,
+	sodcommand.NewDeleteSoDRuleHandler,
+)
+
+var SoDQueryHandlerSet = wire.NewSet(
+	sodquery.NewGetSoDRuleHandler,
+	sodquery.NewListSoDRulesHandler,
+	sodquery.NewListSoDViolationsHandler,
+)
+
+var UserAttributeCommandHandlerSet = wire.NewSet(
+	userattributecommand.NewCreateAttributeDefinitionHandler,
+	userattributecommand.NewUpdateAttributeDefinitionHandler,
+	userattributecommand.NewDeleteAttributeDefinitionHandler,
+)
+
+var UserAttributeQueryHandlerSet = wire.NewSet(
+	userattributequery.NewListAttributeDefinitionsHandler,
+)
+
+var AuditLogQueryHandlerSet = wire.NewSet(
+	auditlogquery.NewListAuditLogsHandler,
+)
+
+var JobSet = wire.NewSet(
+	provideAccessRequestExpiryJob,
+	provideAccessReviewEscalationJob,
+	provideUserPurgeJob,
+	provideUserReinstatementJob,
+)
+
 var HandlerSet = wire.NewSet(
 	wire.Struct(new(handler.UserHandlerParams), "*"),
 	handler.NewUserHandler,
@@ -431,13 +964,33 @@ var HandlerSet = wire.NewSet(
 	handler.NewPermissionHandler,
 	wire.Struct(new(handler.RoleHandlerParams), "*"),
 	handler.NewRoleHandler,
+	wire.Struct(new(handler.OrganizationHandlerParams), "*"),
+	handler.NewOrganizationHandler,
+	wire.Struct(new(handler.GroupHandlerParams), "*"),
+	handler.NewGroupHandler,
+	wire.Struct(new(handler.AuthzHandlerParams), "*"),
+	handler.NewAuthzHandler,
+	wire.Struct(new(handler.AccessRequestHandlerParams), "*"),
+	handler.NewAccessRequestHandler,
+	wire.Struct(new(handler.AccessReviewHandlerParams), "*"),
+	handler.NewAccessReviewHandler,
+	wire.Struct(new(handler.InvitationHandlerParams), "*"),
+	handler.NewInvitationHandler,
+	wire.Struct(new(handler.SoDHandlerParams), "*"),
+	handler.NewSoDHandler,
+	wire.Struct(new(handler.UserAttributeHandlerParams), "*"),
+	handler.NewUserAttributeHandler,
+	wire.Struct(new(handler.AuditLogHandlerParams), "*"),
+	handler.NewAuditLogHandler,
 	provideAuthHandler,
 	provideHealthHandler,
 	provideMetricsHandler,
 	provideDocsHandler,
+	provideMediaHandler,
 )
 
 var RouterSet = wire.NewSet(
+	routes.NewRegistry,
 	provideRouter,
 )
 
@@ -449,14 +1002,33 @@ func InitializeApplication(
 	wire.Build(
 		InfrastructureSet,
 		RepositorySet,
+		DomainServiceSet,
 		UserCommandHandlerSet,
 		AuthCommandHandlerSet,
 		PermissionCommandHandlerSet,
 		RoleCommandHandlerSet,
+		OrganizationCommandHandlerSet,
+		GroupCommandHandlerSet,
+		AccessRequestCommandHandlerSet,
+		AccessReviewCommandHandlerSet,
+		InvitationCommandHandlerSet,
+		SoDCommandHandlerSet,
+		UserAttributeCommandHandlerSet,
+		BulkOperationHandlerSet,
 		UserQueryHandlerSet,
 		AuthQueryHandlerSet,
 		PermissionQueryHandlerSet,
 		RoleQueryHandlerSet,
+		OrganizationQueryHandlerSet,
+		GroupQueryHandlerSet,
+		AuthzQueryHandlerSet,
+		AccessRequestQueryHandlerSet,
+		AccessReviewQueryHandlerSet,
+		InvitationQueryHandlerSet,
+		SoDQueryHandlerSet,
+		UserAttributeQueryHandlerSet,
+		AuditLogQueryHandlerSet,
+		JobSet,
 		HandlerSet,
 		RouterSet,
 		NewApplication,
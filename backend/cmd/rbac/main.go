@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+	"github.com/tranvuongduy2003/go-copilot/pkg/config"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const usage = `usage: rbac <command> [flags]
+
+Commands:
+  export   write the current roles and permissions as a manifest
+  plan     show the changes needed to make the database match a manifest
+  apply    execute the plan in a single transaction
+
+Flags:
+  -file string     manifest path (export writes to stdout when empty)
+  -format string   manifest format: yaml or json (defaults to the file extension)
+  -force           allow changes to system roles and permissions
+`
+
+type options struct {
+	file   string
+	format rbacmanifest.Format
+	force  bool
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	command := args[0]
+	flags := flag.NewFlagSet("rbac "+command, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	file := flags.String("file", "", "")
+	format := flags.String("format", "", "")
+	force := flags.Bool("force", false, "")
+	if err := flags.Parse(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n%s", err, usage)
+		return 2
+	}
+
+	commandOptions := options{file: *file, force: *force}
+	if *format != "" {
+		parsedFormat, err := rbacmanifest.ParseFormat(*format)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+		commandOptions.format = parsedFormat
+	} else {
+		commandOptions.format = rbacmanifest.FormatFromPath(*file)
+	}
+
+	configuration, err := config.LoadFromEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	if err := logger.Init(configuration.Log, configuration.IsProduction()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize logger: %v\n", err)
+		return 1
+	}
+	defer logger.Sync()
+
+	ctx := context.Background()
+	database := postgres.NewDB(&configuration.Database, logger.L())
+	if err := database.Connect(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to database: %v\n", err)
+		return 1
+	}
+	defer database.Close()
+
+	tool := newTool(database, logger.L())
+
+	switch command {
+	case "export":
+		err = tool.export(ctx, commandOptions)
+	case "plan":
+		err = tool.plan(ctx, commandOptions)
+	case "apply":
+		err = tool.apply(ctx, commandOptions)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", command, usage)
+		return 2
+	}
+
+	if err != nil {
+		if errors.Is(err, rbacmanifest.ErrSystemChangesRequireForce) {
+			fmt.Fprintln(os.Stderr, "refusing to modify system roles or permissions without -force")
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "rbac %s failed: %v\n", command, err)
+		return 1
+	}
+
+	return 0
+}
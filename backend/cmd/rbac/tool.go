@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	permissioncommand "github.com/tranvuongduy2003/go-copilot/internal/application/permission/command"
+	rbaccommand "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/command"
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	rbacquery "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/query"
+	rolecommand "github.com/tranvuongduy2003/go-copilot/internal/application/role/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/repository"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type tool struct {
+	exportManifestHandler *rbacquery.ExportManifestHandler
+	planManifestHandler   *rbacquery.PlanManifestHandler
+	applyManifestHandler  *rbaccommand.ApplyManifestHandler
+}
+
+func newTool(database *postgres.DB, log logger.Logger) *tool {
+	permissionRepository := repository.NewPermissionRepository(database.Pool())
+	roleRepository := repository.NewRoleRepository(database.Pool())
+	userRepository := repository.NewUserRepository(database.Pool())
+	organizationRepository := repository.NewOrganizationRepository(database.Pool())
+	delegationPolicy := authz.NewDelegationPolicy(userRepository, roleRepository, nil)
+
+	runInTransaction := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return postgres.WithTransaction(ctx, database.Pool(), fn)
+	}
+
+	return &tool{
+		exportManifestHandler: rbacquery.NewExportManifestHandler(permissionRepository, roleRepository, log),
+		planManifestHandler:   rbacquery.NewPlanManifestHandler(permissionRepository, roleRepository, log),
+		applyManifestHandler: rbaccommand.NewApplyManifestHandler(
+			permissionRepository,
+			roleRepository,
+			runInTransaction,
+			permissioncommand.NewCreatePermissionHandler(permissionRepository, log),
+			permissioncommand.NewUpdatePermissionHandler(permissionRepository, log),
+			permissioncommand.NewDeletePermissionHandler(permissionRepository, roleRepository, log),
+			rolecommand.NewCreateRoleHandler(roleRepository, permissionRepository, organizationRepository, nil, log),
+			rolecommand.NewUpdateRoleHandler(roleRepository, delegationPolicy, nil, log),
+			rolecommand.NewSetRolePermissionsHandler(roleRepository, permissionRepository, delegationPolicy, nil, log),
+			rolecommand.NewDenyRolePermissionHandler(roleRepository, permissionRepository, delegationPolicy, nil, log),
+			rolecommand.NewRemoveRoleDenyHandler(roleRepository, delegationPolicy, nil, log),
+			rolecommand.NewDeleteRoleHandler(roleRepository, userRepository, delegationPolicy, nil, log),
+			log,
+		),
+	}
+}
+
+func (tool *tool) export(ctx context.Context, commandOptions options) error {
+	manifest, err := tool.exportManifestHandler.Handle(ctx, rbacquery.ExportManifestQuery{})
+	if err != nil {
+		return err
+	}
+
+	data, err := rbacmanifest.Marshal(manifest, commandOptions.format)
+	if err != nil {
+		return err
+	}
+
+	if commandOptions.file == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(commandOptions.file, data, 0o644)
+}
+
+func (tool *tool) plan(ctx context.Context, commandOptions options) error {
+	manifest, err := readManifest(commandOptions)
+	if err != nil {
+		return err
+	}
+
+	plan, err := tool.planManifestHandler.Handle(ctx, rbacquery.PlanManifestQuery{Manifest: manifest})
+	if err != nil {
+		return err
+	}
+
+	printPlan(plan)
+	if systemChanges := plan.SystemChanges(); len(systemChanges) > 0 && !commandOptions.force {
+		fmt.Printf("\n%d change(s) touch system entities; apply requires -force\n", len(systemChanges))
+	}
+	return nil
+}
+
+func (tool *tool) apply(ctx context.Context, commandOptions options) error {
+	manifest, err := readManifest(commandOptions)
+	if err != nil {
+		return err
+	}
+
+	plan, err := tool.applyManifestHandler.Handle(ctx, rbaccommand.ApplyManifestCommand{
+		Manifest: manifest,
+		Force:    commandOptions.force,
+	})
+	if err != nil {
+		return err
+	}
+
+	printPlan(plan)
+	if plan.HasChanges() {
+		fmt.Printf("\napplied %d change(s)\n", len(plan.Changes))
+	}
+	return nil
+}
+
+func readManifest(commandOptions options) (*rbacmanifest.Manifest, error) {
+	if commandOptions.file == "" {
+		return nil, fmt.Errorf("-file is required")
+	}
+
+	data, err := os.ReadFile(commandOptions.file)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	return rbacmanifest.Parse(data, commandOptions.format)
+}
+
+func printPlan(plan *rbacmanifest.Plan) {
+	if !plan.HasChanges() {
+		fmt.Println("no changes; roles and permissions match the manifest")
+		return
+	}
+
+	for _, change := range plan.Changes {
+		fmt.Println(change.String())
+	}
+}
@@ -0,0 +1,150 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ApproveAccessRequestCommand struct {
+	RequestID  uuid.UUID
+	ReviewerID uuid.UUID
+	Comment    string
+}
+
+type ApproveAccessRequestHandler struct {
+	accessRequestRepository accessrequest.Repository
+	userRepository          user.Repository
+	roleRepository          role.Repository
+	resolver                *authz.Resolver
+	delegationPolicy        *authz.DelegationPolicy
+	sodChecker              *sod.Checker
+	transactionManager      shared.TransactionManager
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+	approverPermission      string
+}
+
+func NewApproveAccessRequestHandler(
+	accessRequestRepository accessrequest.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	approverPermission string,
+) *ApproveAccessRequestHandler {
+	return &ApproveAccessRequestHandler{
+		accessRequestRepository: accessRequestRepository,
+		userRepository:          userRepository,
+		roleRepository:          roleRepository,
+		resolver:                authz.NewResolver(roleRepository, groupRepository, permissionRepository),
+		delegationPolicy:        delegationPolicy,
+		sodChecker:              sodChecker,
+		transactionManager:      transactionManager,
+		eventBus:                eventBus,
+		logger:                  logger,
+		approverPermission:      approverPermission,
+	}
+}
+
+// Handle grants the requested role and marks the request approved in the
+// same transaction, so a failed write never leaves a granted role behind a
+// request that is still pending. As for a direct assignment, the reviewer
+// cannot grant a role that ranks at or above their own.
+func (handler *ApproveAccessRequestHandler) Handle(ctx context.Context, command ApproveAccessRequestCommand) (*accessrequestdto.AccessRequestDTO, error) {
+	request, err := handler.accessRequestRepository.FindByID(ctx, command.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureApprover(ctx, handler.userRepository, handler.resolver, handler.approverPermission, command.ReviewerID, request); err != nil {
+		return nil, err
+	}
+
+	requester, err := handler.userRepository.FindByID(ctx, request.RequesterID())
+	if err != nil {
+		return nil, fmt.Errorf("find requester: %w", err)
+	}
+
+	requestedRole, err := handler.roleRepository.FindByID(ctx, request.RoleID())
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(ctx, &command.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanGrantTo(requester); err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(requestedRole); err != nil {
+		return nil, err
+	}
+
+	if err := request.Approve(command.ReviewerID, command.Comment); err != nil {
+		return nil, err
+	}
+
+	if err := requester.AssignTemporaryRole(request.RoleID(), request.ID()); err != nil {
+		if errors.Is(err, user.ErrRoleAlreadyAssigned) {
+			return nil, accessrequest.ErrRoleAlreadyHeld
+		}
+		return nil, err
+	}
+
+	if err := handler.sodChecker.CheckUser(ctx, requester, request.RoleID()); err != nil {
+		return nil, err
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if err := handler.userRepository.Update(txContext, requester); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+		if err := handler.accessRequestRepository.Update(txContext, request); err != nil {
+			return fmt.Errorf("update access request: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if handler.eventBus != nil {
+		events := append(request.DomainEvents(), requester.DomainEvents()...)
+		if err := handler.eventBus.Publish(ctx, events...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+		}
+		request.ClearDomainEvents()
+		requester.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access request approved",
+		logger.String("access_request_id", request.ID().String()),
+		logger.String("reviewer_id", command.ReviewerID.String()),
+		logger.String("expires_at", request.ExpiresAt().String()),
+	)
+
+	return accessrequestdto.AccessRequestFromDomain(request), nil
+}
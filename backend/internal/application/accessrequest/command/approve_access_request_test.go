@@ -0,0 +1,126 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestApproveAccessRequestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("grants time-limited role", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		requestID := fixture.createRequest(t)
+
+		result, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: fixture.approver.ID(),
+			Comment:    "approved for incident",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "approved", result.Status)
+		require.NotNil(t, result.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *result.ExpiresAt, time.Minute)
+		assert.True(t, fixture.requester.HasRole(fixture.adminRole.ID()))
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, accessrequest.EventTypeAccessRequestApproved)
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, user.EventTypeUserRoleAssigned)
+		assert.Equal(t, 1, fixture.txManager.Transactions)
+	})
+
+	t.Run("grants nothing when the request cannot be saved", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		requestID := fixture.createRequest(t)
+		fixture.accessRequestRepo.UpdateError = errors.New("version conflict")
+
+		_, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: fixture.approver.ID(),
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, 1, fixture.txManager.Transactions, "the role grant and the request update share one transaction")
+		testutil.AssertDomainEventCount(t, fixture.eventBus, 1)
+	})
+
+	t.Run("rejects self approval", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		requestID := fixture.createRequest(t)
+
+		_, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: fixture.requester.ID(),
+		})
+
+		assert.ErrorIs(t, err, accessrequest.ErrSelfApproval)
+		assert.False(t, fixture.requester.HasRole(fixture.adminRole.ID()))
+	})
+
+	t.Run("rejects role that outranks the reviewer", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		ownerRole, err := role.NewRole(role.NewRoleParams{Name: "owner", DisplayName: "Owner", Priority: 100})
+		require.NoError(t, err)
+		fixture.roleRepo.AddRole(ownerRole)
+
+		created, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        ownerRole.ID(),
+			Justification: "needs to rotate the signing keys",
+			Duration:      time.Hour,
+		})
+		require.NoError(t, err)
+
+		_, err = fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+			RequestID:  created.ID,
+			ReviewerID: fixture.approver.ID(),
+		})
+
+		assert.EqualError(t, err, authz.ErrRoleOutranksActor.Error())
+		assert.False(t, fixture.requester.HasRole(ownerRole.ID()))
+		assert.Equal(t, accessrequest.StatusPending, fixture.accessRequestRepo.Requests[created.ID].Status())
+	})
+
+	t.Run("rejects reviewer without approver permission", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		requestID := fixture.createRequest(t)
+		bystander := testutil.CreateActiveUser()
+		fixture.userRepo.AddUser(bystander)
+
+		_, err := fixture.denyHandler().Handle(ctx, DenyAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: bystander.ID(),
+		})
+
+		assert.ErrorIs(t, err, accessrequest.ErrNotApprover)
+	})
+
+	t.Run("cannot approve denied request", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		requestID := fixture.createRequest(t)
+
+		_, err := fixture.denyHandler().Handle(ctx, DenyAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: fixture.approver.ID(),
+			Comment:    "not justified",
+		})
+		require.NoError(t, err)
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, accessrequest.EventTypeAccessRequestDenied)
+
+		_, err = fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+			RequestID:  requestID,
+			ReviewerID: fixture.approver.ID(),
+		})
+		testutil.AssertInvalidStatusTransitionError(t, err)
+	})
+}
@@ -0,0 +1,43 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+func ensureApprover(
+	context context.Context,
+	userRepository user.Repository,
+	resolver *authz.Resolver,
+	approverPermission string,
+	reviewerID uuid.UUID,
+	request *accessrequest.AccessRequest,
+) error {
+	if reviewerID == request.RequesterID() {
+		return accessrequest.ErrSelfApproval
+	}
+
+	reviewer, err := userRepository.FindByID(context, reviewerID)
+	if err != nil {
+		return fmt.Errorf("find reviewer: %w", err)
+	}
+	if !reviewer.Status().IsActive() {
+		return accessrequest.ErrNotApprover
+	}
+
+	access, err := resolver.Resolve(context, reviewer, request.OrganizationID())
+	if err != nil {
+		return fmt.Errorf("resolve reviewer access: %w", err)
+	}
+	if !access.Evaluate(approverPermission).Allowed {
+		return accessrequest.ErrNotApprover
+	}
+
+	return nil
+}
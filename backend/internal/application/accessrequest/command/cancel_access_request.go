@@ -0,0 +1,67 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CancelAccessRequestCommand struct {
+	RequestID   uuid.UUID
+	RequesterID uuid.UUID
+}
+
+type CancelAccessRequestHandler struct {
+	accessRequestRepository accessrequest.Repository
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+}
+
+func NewCancelAccessRequestHandler(
+	accessRequestRepository accessrequest.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CancelAccessRequestHandler {
+	return &CancelAccessRequestHandler{
+		accessRequestRepository: accessRequestRepository,
+		eventBus:                eventBus,
+		logger:                  logger,
+	}
+}
+
+func (handler *CancelAccessRequestHandler) Handle(context context.Context, command CancelAccessRequestCommand) (*accessrequestdto.AccessRequestDTO, error) {
+	request, err := handler.accessRequestRepository.FindByID(context, command.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Cancel(command.RequesterID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessRequestRepository.Update(context, request); err != nil {
+		return nil, fmt.Errorf("update access request: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, request.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+		}
+		request.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access request cancelled",
+		logger.String("access_request_id", request.ID().String()),
+	)
+
+	return accessrequestdto.AccessRequestFromDomain(request), nil
+}
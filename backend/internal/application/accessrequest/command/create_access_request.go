@@ -0,0 +1,125 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CreateAccessRequestCommand struct {
+	RequesterID   uuid.UUID
+	RoleID        uuid.UUID
+	Justification string
+	Duration      time.Duration
+}
+
+type CreateAccessRequestHandler struct {
+	accessRequestRepository accessrequest.Repository
+	userRepository          user.Repository
+	roleRepository          role.Repository
+	organizationRepository  organization.Repository
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+	maxDuration             time.Duration
+}
+
+func NewCreateAccessRequestHandler(
+	accessRequestRepository accessrequest.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	organizationRepository organization.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	maxDuration time.Duration,
+) *CreateAccessRequestHandler {
+	return &CreateAccessRequestHandler{
+		accessRequestRepository: accessRequestRepository,
+		userRepository:          userRepository,
+		roleRepository:          roleRepository,
+		organizationRepository:  organizationRepository,
+		eventBus:                eventBus,
+		logger:                  logger,
+		maxDuration:             maxDuration,
+	}
+}
+
+func (handler *CreateAccessRequestHandler) Handle(context context.Context, command CreateAccessRequestCommand) (*accessrequestdto.AccessRequestDTO, error) {
+	if handler.maxDuration > 0 && command.Duration > handler.maxDuration {
+		return nil, accessrequest.ErrDurationTooLong
+	}
+
+	requester, err := handler.userRepository.FindByID(context, command.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if organizationID := requestedRole.OrganizationID(); organizationID != nil {
+		isMember, err := handler.organizationRepository.IsMember(context, *organizationID, requester.ID())
+		if err != nil {
+			return nil, fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, organization.ErrRoleOutsideOrganization
+		}
+	}
+
+	if requester.HasRole(command.RoleID) {
+		return nil, accessrequest.ErrRoleAlreadyHeld
+	}
+
+	hasPending, err := handler.accessRequestRepository.ExistsPending(context, requester.ID(), command.RoleID)
+	if err != nil {
+		return nil, fmt.Errorf("check pending access requests: %w", err)
+	}
+	if hasPending {
+		return nil, accessrequest.ErrPendingRequestExists
+	}
+
+	request, err := accessrequest.NewAccessRequest(accessrequest.NewAccessRequestParams{
+		RequesterID:    requester.ID(),
+		RoleID:         command.RoleID,
+		OrganizationID: requestedRole.OrganizationID(),
+		Justification:  command.Justification,
+		Duration:       command.Duration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessRequestRepository.Create(context, request); err != nil {
+		return nil, fmt.Errorf("create access request: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, request.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+		}
+		request.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access request created",
+		logger.String("access_request_id", request.ID().String()),
+		logger.String("requester_id", requester.ID().String()),
+		logger.String("role_id", command.RoleID.String()),
+	)
+
+	return accessrequestdto.AccessRequestFromDomain(request), nil
+}
@@ -0,0 +1,168 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+const testApproverPermission = "access_requests:approve"
+
+type accessRequestFixture struct {
+	accessRequestRepo *testutil.MockAccessRequestRepository
+	userRepo          *testutil.MockUserRepository
+	roleRepo          *testutil.MockRoleRepository
+	groupRepo         *testutil.MockGroupRepository
+	permRepo          *testutil.MockPermissionRepository
+	organizationRepo  *testutil.MockOrganizationRepository
+	sodRuleRepo       *testutil.MockSoDRuleRepository
+	txManager         *testutil.MockTransactionManager
+	eventBus          *testutil.MockEventBus
+	requester         *user.User
+	approver          *user.User
+	adminRole         *role.Role
+}
+
+func newAccessRequestFixture() *accessRequestFixture {
+	fixture := &accessRequestFixture{
+		accessRequestRepo: testutil.NewMockAccessRequestRepository(),
+		userRepo:          testutil.NewMockUserRepository(),
+		roleRepo:          testutil.NewMockRoleRepository(),
+		groupRepo:         testutil.NewMockGroupRepository(),
+		permRepo:          testutil.NewMockPermissionRepository(),
+		organizationRepo:  testutil.NewMockOrganizationRepository(),
+		sodRuleRepo:       testutil.NewMockSoDRuleRepository(),
+		txManager:         testutil.NewMockTransactionManager(),
+		eventBus:          testutil.NewMockEventBus(),
+		requester:         testutil.CreateActiveUser(),
+		approver:          testutil.CreateActiveUser(),
+	}
+
+	approvePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "access_requests", Action: "approve"})
+	fixture.permRepo.AddPermission(approvePerm)
+
+	approverRole, _ := role.NewRole(role.NewRoleParams{Name: "approver", DisplayName: "Approver", PermissionIDs: []uuid.UUID{approvePerm.ID()}, Priority: 90})
+	fixture.adminRole, _ = role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	fixture.roleRepo.AddRole(approverRole)
+	fixture.roleRepo.AddRole(fixture.adminRole)
+
+	_ = fixture.approver.AssignRole(approverRole.ID())
+	fixture.approver.ClearDomainEvents()
+	fixture.userRepo.AddUser(fixture.requester)
+	fixture.userRepo.AddUser(fixture.approver)
+
+	return fixture
+}
+
+func (fixture *accessRequestFixture) createHandler() *CreateAccessRequestHandler {
+	return NewCreateAccessRequestHandler(fixture.accessRequestRepo, fixture.userRepo, fixture.roleRepo, fixture.organizationRepo, fixture.eventBus, testutil.NewNoopLogger(), 8*time.Hour)
+}
+
+func (fixture *accessRequestFixture) approveHandler() *ApproveAccessRequestHandler {
+	return NewApproveAccessRequestHandler(fixture.accessRequestRepo, fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, authz.NewDelegationPolicy(fixture.userRepo, fixture.roleRepo, fixture.groupRepo), sod.NewChecker(fixture.sodRuleRepo, fixture.userRepo, fixture.groupRepo), fixture.txManager, fixture.eventBus, testutil.NewNoopLogger(), testApproverPermission)
+}
+
+func (fixture *accessRequestFixture) denyHandler() *DenyAccessRequestHandler {
+	return NewDenyAccessRequestHandler(fixture.accessRequestRepo, fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, fixture.eventBus, testutil.NewNoopLogger(), testApproverPermission)
+}
+
+func (fixture *accessRequestFixture) createRequest(t *testing.T) uuid.UUID {
+	result, err := fixture.createHandler().Handle(context.Background(), CreateAccessRequestCommand{
+		RequesterID:   fixture.requester.ID(),
+		RoleID:        fixture.adminRole.ID(),
+		Justification: "incident INC-42 needs production access",
+		Duration:      time.Hour,
+	})
+	require.NoError(t, err)
+	return result.ID
+}
+
+func TestCreateAccessRequestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates pending request", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+
+		result, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        fixture.adminRole.ID(),
+			Justification: "on-call rotation",
+			Duration:      2 * time.Hour,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "pending", result.Status)
+		assert.Equal(t, int64(7200), result.DurationSeconds)
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, accessrequest.EventTypeAccessRequestCreated)
+	})
+
+	t.Run("rejects duplicate pending request", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		fixture.createRequest(t)
+
+		_, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        fixture.adminRole.ID(),
+			Justification: "again",
+			Duration:      time.Hour,
+		})
+
+		assert.ErrorIs(t, err, accessrequest.ErrPendingRequestExists)
+	})
+
+	t.Run("rejects duration above maximum", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+
+		_, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        fixture.adminRole.ID(),
+			Justification: "long task",
+			Duration:      24 * time.Hour,
+		})
+
+		assert.ErrorIs(t, err, accessrequest.ErrDurationTooLong)
+	})
+
+	t.Run("rejects role already held", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		_ = fixture.requester.AssignRole(fixture.adminRole.ID())
+
+		_, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        fixture.adminRole.ID(),
+			Justification: "already admin",
+			Duration:      time.Hour,
+		})
+
+		assert.ErrorIs(t, err, accessrequest.ErrRoleAlreadyHeld)
+	})
+
+	t.Run("rejects organization role for non-member", func(t *testing.T) {
+		fixture := newAccessRequestFixture()
+		organizationID := uuid.New()
+		scopedRole, _ := role.NewRole(role.NewRoleParams{Name: "acme_admin", DisplayName: "Acme Admin", OrganizationID: &organizationID})
+		fixture.roleRepo.AddRole(scopedRole)
+
+		_, err := fixture.createHandler().Handle(ctx, CreateAccessRequestCommand{
+			RequesterID:   fixture.requester.ID(),
+			RoleID:        scopedRole.ID(),
+			Justification: "acme support",
+			Duration:      time.Hour,
+		})
+
+		assert.ErrorIs(t, err, organization.ErrRoleOutsideOrganization)
+	})
+}
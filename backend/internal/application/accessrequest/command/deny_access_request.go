@@ -0,0 +1,89 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DenyAccessRequestCommand struct {
+	RequestID  uuid.UUID
+	ReviewerID uuid.UUID
+	Comment    string
+}
+
+type DenyAccessRequestHandler struct {
+	accessRequestRepository accessrequest.Repository
+	userRepository          user.Repository
+	resolver                *authz.Resolver
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+	approverPermission      string
+}
+
+func NewDenyAccessRequestHandler(
+	accessRequestRepository accessrequest.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	approverPermission string,
+) *DenyAccessRequestHandler {
+	return &DenyAccessRequestHandler{
+		accessRequestRepository: accessRequestRepository,
+		userRepository:          userRepository,
+		resolver:                authz.NewResolver(roleRepository, groupRepository, permissionRepository),
+		eventBus:                eventBus,
+		logger:                  logger,
+		approverPermission:      approverPermission,
+	}
+}
+
+func (handler *DenyAccessRequestHandler) Handle(context context.Context, command DenyAccessRequestCommand) (*accessrequestdto.AccessRequestDTO, error) {
+	request, err := handler.accessRequestRepository.FindByID(context, command.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureApprover(context, handler.userRepository, handler.resolver, handler.approverPermission, command.ReviewerID, request); err != nil {
+		return nil, err
+	}
+
+	if err := request.Deny(command.ReviewerID, command.Comment); err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessRequestRepository.Update(context, request); err != nil {
+		return nil, fmt.Errorf("update access request: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, request.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+		}
+		request.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access request denied",
+		logger.String("access_request_id", request.ID().String()),
+		logger.String("reviewer_id", command.ReviewerID.String()),
+	)
+
+	return accessrequestdto.AccessRequestFromDomain(request), nil
+}
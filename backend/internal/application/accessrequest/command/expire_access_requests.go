@@ -0,0 +1,124 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const expireBatchSize = 100
+
+type ExpireAccessRequestsCommand struct {
+	Now time.Time
+}
+
+type ExpireAccessRequestsHandler struct {
+	accessRequestRepository accessrequest.Repository
+	userRepository          user.Repository
+	transactionManager      shared.TransactionManager
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+}
+
+func NewExpireAccessRequestsHandler(
+	accessRequestRepository accessrequest.Repository,
+	userRepository user.Repository,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *ExpireAccessRequestsHandler {
+	return &ExpireAccessRequestsHandler{
+		accessRequestRepository: accessRequestRepository,
+		userRepository:          userRepository,
+		transactionManager:      transactionManager,
+		eventBus:                eventBus,
+		logger:                  logger,
+	}
+}
+
+func (handler *ExpireAccessRequestsHandler) Handle(context context.Context, command ExpireAccessRequestsCommand) (int, error) {
+	requests, err := handler.accessRequestRepository.FindExpired(context, command.Now, expireBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("find expired access requests: %w", err)
+	}
+
+	expired := 0
+	for _, request := range requests {
+		if err := handler.expire(context, request); err != nil {
+			handler.logger.Error("failed to expire access request",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+			continue
+		}
+		expired++
+	}
+
+	if expired > 0 {
+		handler.logger.Info("access requests expired",
+			logger.Int("count", expired),
+		)
+	}
+
+	return expired, nil
+}
+
+// expire revokes the role the request granted and marks the request expired
+// in the same transaction. Soft-deleted requesters lose the role too, so that
+// restoring them does not bring back access that has run out.
+func (handler *ExpireAccessRequestsHandler) expire(ctx context.Context, request *accessrequest.AccessRequest) error {
+	requester, err := handler.userRepository.FindByIDIncludingDeleted(ctx, request.RequesterID())
+	if err != nil && !errors.Is(err, user.ErrUserNotFound) {
+		return fmt.Errorf("find requester: %w", err)
+	}
+
+	// The role stays when it was revoked already or granted again by other
+	// means, such as an administrator assigning it permanently.
+	if requester != nil {
+		if err := requester.RevokeTemporaryRole(request.RoleID(), request.ID()); err != nil && !errors.Is(err, user.ErrRoleNotAssigned) {
+			return err
+		}
+	}
+
+	if err := request.Expire(); err != nil {
+		return err
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if requester != nil {
+			if err := handler.userRepository.Update(txContext, requester); err != nil {
+				return fmt.Errorf("update user: %w", err)
+			}
+		}
+		if err := handler.accessRequestRepository.Update(txContext, request); err != nil {
+			return fmt.Errorf("update access request: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if handler.eventBus != nil {
+		events := request.DomainEvents()
+		if requester != nil {
+			events = append(events, requester.DomainEvents()...)
+			requester.ClearDomainEvents()
+		}
+		if err := handler.eventBus.Publish(ctx, events...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("access_request_id", request.ID().String()),
+				logger.Err(err),
+			)
+		}
+		request.ClearDomainEvents()
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package accessrequestcommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestExpireAccessRequestsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAccessRequestFixture()
+	requestID := fixture.createRequest(t)
+
+	_, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+		RequestID:  requestID,
+		ReviewerID: fixture.approver.ID(),
+	})
+	require.NoError(t, err)
+
+	handler := NewExpireAccessRequestsHandler(fixture.accessRequestRepo, fixture.userRepo, fixture.txManager, fixture.eventBus, testutil.NewNoopLogger())
+
+	expired, err := handler.Handle(ctx, ExpireAccessRequestsCommand{Now: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, expired)
+	assert.True(t, fixture.requester.HasRole(fixture.adminRole.ID()))
+
+	expired, err = handler.Handle(ctx, ExpireAccessRequestsCommand{Now: time.Now().UTC().Add(2 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.False(t, fixture.requester.HasRole(fixture.adminRole.ID()))
+	assert.Equal(t, accessrequest.StatusExpired, fixture.accessRequestRepo.Requests[requestID].Status())
+	testutil.AssertDomainEventPublished(t, fixture.eventBus, accessrequest.EventTypeAccessRequestExpired)
+	testutil.AssertDomainEventPublished(t, fixture.eventBus, user.EventTypeUserRoleRevoked)
+}
+
+func TestExpireAccessRequestsHandler_Handle_RoleRegrantedPermanently(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAccessRequestFixture()
+	requestID := fixture.createRequest(t)
+
+	_, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+		RequestID:  requestID,
+		ReviewerID: fixture.approver.ID(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, fixture.requester.AssignRole(fixture.adminRole.ID()))
+	require.NoError(t, fixture.userRepo.Update(ctx, fixture.requester))
+
+	handler := NewExpireAccessRequestsHandler(fixture.accessRequestRepo, fixture.userRepo, fixture.txManager, fixture.eventBus, testutil.NewNoopLogger())
+
+	expired, err := handler.Handle(ctx, ExpireAccessRequestsCommand{Now: time.Now().UTC().Add(2 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, accessrequest.StatusExpired, fixture.accessRequestRepo.Requests[requestID].Status())
+	assert.True(t, fixture.requester.HasRole(fixture.adminRole.ID()), "the permanent assignment survives the expiry")
+}
+
+// activeUserRepository hides soft-deleted users from FindByID, as the
+// database repository does.
+type activeUserRepository struct {
+	*testutil.MockUserRepository
+}
+
+func (repository activeUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	found, err := repository.MockUserRepository.FindByID(ctx, id)
+	if err == nil && found.IsDeleted() {
+		return nil, user.NewUserNotFoundError(id.String())
+	}
+	return found, err
+}
+
+func TestExpireAccessRequestsHandler_Handle_SoftDeletedRequester(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAccessRequestFixture()
+	requestID := fixture.createRequest(t)
+
+	_, err := fixture.approveHandler().Handle(ctx, ApproveAccessRequestCommand{
+		RequestID:  requestID,
+		ReviewerID: fixture.approver.ID(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, fixture.requester.Delete())
+
+	handler := NewExpireAccessRequestsHandler(fixture.accessRequestRepo, activeUserRepository{fixture.userRepo}, fixture.txManager, fixture.eventBus, testutil.NewNoopLogger())
+
+	expired, err := handler.Handle(ctx, ExpireAccessRequestsCommand{Now: time.Now().UTC().Add(2 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.False(t, fixture.requester.HasRole(fixture.adminRole.ID()), "restoring the user must not bring the role back")
+}
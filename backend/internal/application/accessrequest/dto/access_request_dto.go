@@ -0,0 +1,77 @@
+package accessrequestdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type AccessRequestDTO struct {
+	ID              uuid.UUID  `json:"id"`
+	RequesterID     uuid.UUID  `json:"requester_id"`
+	RoleID          uuid.UUID  `json:"role_id"`
+	OrganizationID  *uuid.UUID `json:"organization_id,omitempty"`
+	Justification   string     `json:"justification"`
+	DurationSeconds int64      `json:"duration_seconds"`
+	Status          string     `json:"status"`
+	ReviewerID      *uuid.UUID `json:"reviewer_id,omitempty"`
+	ReviewComment   string     `json:"review_comment,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func AccessRequestFromDomain(request *accessrequest.AccessRequest) *AccessRequestDTO {
+	if request == nil {
+		return nil
+	}
+	return &AccessRequestDTO{
+		ID:              request.ID(),
+		RequesterID:     request.RequesterID(),
+		RoleID:          request.RoleID(),
+		OrganizationID:  request.OrganizationID(),
+		Justification:   request.Justification(),
+		DurationSeconds: int64(request.Duration().Seconds()),
+		Status:          request.Status().String(),
+		ReviewerID:      request.ReviewerID(),
+		ReviewComment:   request.ReviewComment(),
+		ReviewedAt:      request.ReviewedAt(),
+		ExpiresAt:       request.ExpiresAt(),
+		CreatedAt:       request.CreatedAt(),
+		UpdatedAt:       request.UpdatedAt(),
+	}
+}
+
+func AccessRequestsFromDomain(requests []*accessrequest.AccessRequest) []*AccessRequestDTO {
+	dtos := make([]*AccessRequestDTO, len(requests))
+	for i, request := range requests {
+		dtos[i] = AccessRequestFromDomain(request)
+	}
+	return dtos
+}
+
+type PaginatedAccessRequestsDTO struct {
+	Items      []*AccessRequestDTO `json:"items"`
+	Total      int64               `json:"total"`
+	Page       int                 `json:"page"`
+	Limit      int                 `json:"limit"`
+	TotalPages int                 `json:"total_pages"`
+	HasNext    bool                `json:"has_next"`
+	HasPrev    bool                `json:"has_prev"`
+}
+
+func NewPaginatedAccessRequestsDTO(requests []*accessrequest.AccessRequest, total int64, pagination shared.Pagination) *PaginatedAccessRequestsDTO {
+	return &PaginatedAccessRequestsDTO{
+		Items:      AccessRequestsFromDomain(requests),
+		Total:      total,
+		Page:       pagination.Page(),
+		Limit:      pagination.Limit(),
+		TotalPages: pagination.TotalPages(total),
+		HasNext:    pagination.HasNext(total),
+		HasPrev:    pagination.HasPrev(),
+	}
+}
@@ -0,0 +1,73 @@
+package accessrequestquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetAccessRequestQuery struct {
+	RequestID uuid.UUID
+	ViewerID  uuid.UUID
+}
+
+type GetAccessRequestHandler struct {
+	accessRequestRepository accessrequest.Repository
+	userRepository          user.Repository
+	resolver                *authz.Resolver
+	logger                  logger.Logger
+	approverPermission      string
+}
+
+func NewGetAccessRequestHandler(
+	accessRequestRepository accessrequest.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+	logger logger.Logger,
+	approverPermission string,
+) *GetAccessRequestHandler {
+	return &GetAccessRequestHandler{
+		accessRequestRepository: accessRequestRepository,
+		userRepository:          userRepository,
+		resolver:                authz.NewResolver(roleRepository, groupRepository, permissionRepository),
+		logger:                  logger,
+		approverPermission:      approverPermission,
+	}
+}
+
+func (handler *GetAccessRequestHandler) Handle(context context.Context, query GetAccessRequestQuery) (*accessrequestdto.AccessRequestDTO, error) {
+	request, err := handler.accessRequestRepository.FindByID(context, query.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RequesterID() != query.ViewerID {
+		viewer, err := handler.userRepository.FindByID(context, query.ViewerID)
+		if err != nil {
+			return nil, fmt.Errorf("find viewer: %w", err)
+		}
+
+		access, err := handler.resolver.Resolve(context, viewer, request.OrganizationID())
+		if err != nil {
+			return nil, fmt.Errorf("resolve viewer access: %w", err)
+		}
+		if !access.Evaluate(handler.approverPermission).Allowed {
+			return nil, shared.NewAuthorizationError("view", "access request")
+		}
+	}
+
+	return accessrequestdto.AccessRequestFromDomain(request), nil
+}
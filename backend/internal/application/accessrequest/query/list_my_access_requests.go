@@ -0,0 +1,50 @@
+package accessrequestquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListMyAccessRequestsQuery struct {
+	RequesterID uuid.UUID
+	Page        int
+	Limit       int
+	Status      *accessrequest.Status
+}
+
+type ListMyAccessRequestsHandler struct {
+	accessRequestRepository accessrequest.Repository
+	logger                  logger.Logger
+}
+
+func NewListMyAccessRequestsHandler(
+	accessRequestRepository accessrequest.Repository,
+	logger logger.Logger,
+) *ListMyAccessRequestsHandler {
+	return &ListMyAccessRequestsHandler{
+		accessRequestRepository: accessRequestRepository,
+		logger:                  logger,
+	}
+}
+
+func (handler *ListMyAccessRequestsHandler) Handle(context context.Context, query ListMyAccessRequestsQuery) (*accessrequestdto.PaginatedAccessRequestsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+	filter := accessrequest.Filter{
+		RequesterID: &query.RequesterID,
+		Status:      query.Status,
+	}
+
+	requests, total, err := handler.accessRequestRepository.List(context, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list access requests: %w", err)
+	}
+
+	return accessrequestdto.NewPaginatedAccessRequestsDTO(requests, total, pagination), nil
+}
@@ -0,0 +1,50 @@
+package accessrequestquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListPendingAccessRequestsQuery struct {
+	Page           int
+	Limit          int
+	OrganizationID *uuid.UUID
+}
+
+type ListPendingAccessRequestsHandler struct {
+	accessRequestRepository accessrequest.Repository
+	logger                  logger.Logger
+}
+
+func NewListPendingAccessRequestsHandler(
+	accessRequestRepository accessrequest.Repository,
+	logger logger.Logger,
+) *ListPendingAccessRequestsHandler {
+	return &ListPendingAccessRequestsHandler{
+		accessRequestRepository: accessRequestRepository,
+		logger:                  logger,
+	}
+}
+
+func (handler *ListPendingAccessRequestsHandler) Handle(context context.Context, query ListPendingAccessRequestsQuery) (*accessrequestdto.PaginatedAccessRequestsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+	status := accessrequest.StatusPending
+	filter := accessrequest.Filter{
+		Status:         &status,
+		OrganizationID: query.OrganizationID,
+	}
+
+	requests, total, err := handler.accessRequestRepository.List(context, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list pending access requests: %w", err)
+	}
+
+	return accessrequestdto.NewPaginatedAccessRequestsDTO(requests, total, pagination), nil
+}
@@ -0,0 +1,242 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+type accessReviewFixture struct {
+	accessReviewRepo *testutil.MockAccessReviewRepository
+	userRepo         *testutil.MockUserRepository
+	roleRepo         *testutil.MockRoleRepository
+	eventBus         *testutil.MockEventBus
+	owner            *user.User
+	reviewer         *user.User
+	holders          []*user.User
+	reviewedRole     *role.Role
+}
+
+func newAccessReviewFixture() *accessReviewFixture {
+	fixture := &accessReviewFixture{
+		accessReviewRepo: testutil.NewMockAccessReviewRepository(),
+		userRepo:         testutil.NewMockUserRepository(),
+		roleRepo:         testutil.NewMockRoleRepository(),
+		eventBus:         testutil.NewMockEventBus(),
+		owner:            testutil.CreateActiveUser(),
+		reviewer:         testutil.CreateActiveUser(),
+		holders:          []*user.User{testutil.CreateActiveUser(), testutil.CreateActiveUser()},
+	}
+
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	fixture.reviewedRole, _ = role.NewRole(role.NewRoleParams{Name: "billing", DisplayName: "Billing", Priority: 20})
+	fixture.roleRepo.AddRole(adminRole)
+	fixture.roleRepo.AddRole(fixture.reviewedRole)
+
+	_ = fixture.owner.AssignRole(adminRole.ID())
+	fixture.userRepo.AddUser(fixture.owner)
+	fixture.userRepo.AddUser(fixture.reviewer)
+	for _, holder := range fixture.holders {
+		_ = holder.AssignRole(fixture.reviewedRole.ID())
+		holder.ClearDomainEvents()
+		fixture.userRepo.AddUser(holder)
+	}
+
+	return fixture
+}
+
+func (fixture *accessReviewFixture) launch(t *testing.T) uuid.UUID {
+	t.Helper()
+	handler := NewLaunchAccessReviewHandler(fixture.accessReviewRepo, fixture.userRepo, fixture.roleRepo, fixture.eventBus, testutil.NewNoopLogger())
+	result, err := handler.Handle(context.Background(), LaunchAccessReviewCommand{
+		Name:    "Billing recertification",
+		RoleIDs: []uuid.UUID{fixture.reviewedRole.ID()},
+		DueAt:   time.Now().UTC().Add(7 * 24 * time.Hour),
+		ActorID: fixture.owner.ID(),
+	})
+	require.NoError(t, err)
+	return result.ID
+}
+
+func (fixture *accessReviewFixture) assignReviewer(t *testing.T, campaignID uuid.UUID) {
+	t.Helper()
+	handler := NewAssignAccessReviewerHandler(fixture.accessReviewRepo, fixture.userRepo, fixture.eventBus, testutil.NewNoopLogger())
+	_, err := handler.Handle(context.Background(), AssignAccessReviewerCommand{
+		CampaignID: campaignID,
+		RoleID:     fixture.reviewedRole.ID(),
+		ReviewerID: fixture.reviewer.ID(),
+		ActorID:    fixture.owner.ID(),
+	})
+	require.NoError(t, err)
+}
+
+func (fixture *accessReviewFixture) decideHandler() *DecideAccessReviewItemHandler {
+	return NewDecideAccessReviewItemHandler(fixture.accessReviewRepo, fixture.eventBus, testutil.NewNoopLogger())
+}
+
+func (fixture *accessReviewFixture) closeHandler() *CloseAccessReviewHandler {
+	delegationPolicy := authz.NewDelegationPolicy(fixture.userRepo, fixture.roleRepo, nil)
+	revokeHandler := usercommand.NewRevokeRoleFromUserHandler(fixture.userRepo, fixture.roleRepo, delegationPolicy, fixture.eventBus, testutil.NewNoopLogger())
+	return NewCloseAccessReviewHandler(fixture.accessReviewRepo, revokeHandler, fixture.eventBus, testutil.NewNoopLogger())
+}
+
+func (fixture *accessReviewFixture) itemFor(campaignID uuid.UUID, holder *user.User) *accessreview.Item {
+	for _, item := range fixture.accessReviewRepo.Campaigns[campaignID].Items() {
+		if item.UserID() == holder.ID() {
+			return item
+		}
+	}
+	return nil
+}
+
+func TestLaunchAccessReviewHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("snapshots role holders", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		campaignID := fixture.launch(t)
+
+		campaign := fixture.accessReviewRepo.Campaigns[campaignID]
+		assert.Len(t, campaign.Items(), 2)
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, accessreview.EventTypeCampaignLaunched)
+	})
+
+	t.Run("scopes to selected users", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		handler := NewLaunchAccessReviewHandler(fixture.accessReviewRepo, fixture.userRepo, fixture.roleRepo, fixture.eventBus, testutil.NewNoopLogger())
+
+		result, err := handler.Handle(ctx, LaunchAccessReviewCommand{
+			Name:    "Single user",
+			UserIDs: []uuid.UUID{fixture.holders[0].ID()},
+			DueAt:   time.Now().Add(time.Hour),
+			ActorID: fixture.owner.ID(),
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, fixture.reviewedRole.ID(), result.Items[0].RoleID)
+	})
+
+	t.Run("fails for unknown role", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		handler := NewLaunchAccessReviewHandler(fixture.accessReviewRepo, fixture.userRepo, fixture.roleRepo, fixture.eventBus, testutil.NewNoopLogger())
+
+		_, err := handler.Handle(ctx, LaunchAccessReviewCommand{
+			Name:    "Unknown",
+			RoleIDs: []uuid.UUID{uuid.New()},
+			DueAt:   time.Now().Add(time.Hour),
+			ActorID: fixture.owner.ID(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
+
+func TestCloseAccessReviewHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies revocations", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		campaignID := fixture.launch(t)
+		fixture.assignReviewer(t, campaignID)
+
+		revoked, kept := fixture.holders[0], fixture.holders[1]
+		_, err := fixture.decideHandler().Handle(ctx, DecideAccessReviewItemCommand{
+			CampaignID: campaignID,
+			ItemID:     fixture.itemFor(campaignID, revoked).ID(),
+			ReviewerID: fixture.reviewer.ID(),
+			Decision:   accessreview.DecisionRevoke,
+			Comment:    "moved to support",
+		})
+		require.NoError(t, err)
+		_, err = fixture.decideHandler().Handle(ctx, DecideAccessReviewItemCommand{
+			CampaignID: campaignID,
+			ItemID:     fixture.itemFor(campaignID, kept).ID(),
+			ReviewerID: fixture.reviewer.ID(),
+			Decision:   accessreview.DecisionKeep,
+			Comment:    "still owns invoicing",
+		})
+		require.NoError(t, err)
+
+		result, err := fixture.closeHandler().Handle(ctx, CloseAccessReviewCommand{CampaignID: campaignID, ActorID: fixture.owner.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.RevocationsApplied)
+		assert.Empty(t, result.RevocationFailures)
+		assert.Equal(t, "closed", result.Campaign.Status)
+		assert.False(t, revoked.HasRole(fixture.reviewedRole.ID()))
+		assert.True(t, kept.HasRole(fixture.reviewedRole.ID()))
+		assert.NotNil(t, fixture.itemFor(campaignID, revoked).RevokedAt())
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, accessreview.EventTypeCampaignClosed)
+		testutil.AssertDomainEventPublished(t, fixture.eventBus, user.EventTypeUserRoleRevoked)
+	})
+
+	t.Run("requires force while items are pending", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		campaignID := fixture.launch(t)
+
+		_, err := fixture.closeHandler().Handle(ctx, CloseAccessReviewCommand{CampaignID: campaignID, ActorID: fixture.owner.ID()})
+		assert.ErrorIs(t, err, accessreview.ErrPendingItems)
+
+		result, err := fixture.closeHandler().Handle(ctx, CloseAccessReviewCommand{CampaignID: campaignID, ActorID: fixture.owner.ID(), Force: true})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.RevocationsApplied)
+		assert.Equal(t, 2, result.Campaign.Pending)
+	})
+
+	t.Run("reports revocations blocked by delegation", func(t *testing.T) {
+		fixture := newAccessReviewFixture()
+		campaignID := fixture.launch(t)
+		fixture.assignReviewer(t, campaignID)
+
+		_, err := fixture.decideHandler().Handle(ctx, DecideAccessReviewItemCommand{
+			CampaignID: campaignID,
+			ItemID:     fixture.itemFor(campaignID, fixture.holders[0]).ID(),
+			ReviewerID: fixture.reviewer.ID(),
+			Decision:   accessreview.DecisionRevoke,
+			Comment:    "unused",
+		})
+		require.NoError(t, err)
+
+		result, err := fixture.closeHandler().Handle(ctx, CloseAccessReviewCommand{CampaignID: campaignID, ActorID: fixture.reviewer.ID(), Force: true})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.RevocationsApplied)
+		require.Len(t, result.RevocationFailures, 1)
+		assert.True(t, fixture.holders[0].HasRole(fixture.reviewedRole.ID()))
+	})
+}
+
+func TestEscalateOverdueAccessReviewsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAccessReviewFixture()
+	campaignID := fixture.launch(t)
+
+	handler := NewEscalateOverdueAccessReviewsHandler(fixture.accessReviewRepo, fixture.eventBus, testutil.NewNoopLogger())
+
+	escalated, err := handler.Handle(ctx, EscalateOverdueAccessReviewsCommand{Now: time.Now().UTC()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, escalated)
+
+	escalated, err = handler.Handle(ctx, EscalateOverdueAccessReviewsCommand{Now: time.Now().UTC().Add(8 * 24 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 2, escalated)
+	testutil.AssertDomainEventPublished(t, fixture.eventBus, accessreview.EventTypeReviewItemEscalated)
+
+	_, err = fixture.decideHandler().Handle(ctx, DecideAccessReviewItemCommand{
+		CampaignID: campaignID,
+		ItemID:     fixture.itemFor(campaignID, fixture.holders[0]).ID(),
+		ReviewerID: fixture.owner.ID(),
+		Decision:   accessreview.DecisionKeep,
+		Comment:    "decided by owner after escalation",
+	})
+	require.NoError(t, err)
+}
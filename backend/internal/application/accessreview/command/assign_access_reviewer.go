@@ -0,0 +1,83 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AssignAccessReviewerCommand struct {
+	CampaignID uuid.UUID
+	RoleID     uuid.UUID
+	ReviewerID uuid.UUID
+	ActorID    uuid.UUID
+}
+
+type AssignAccessReviewerHandler struct {
+	accessReviewRepository accessreview.Repository
+	userRepository         user.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewAssignAccessReviewerHandler(
+	accessReviewRepository accessreview.Repository,
+	userRepository user.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *AssignAccessReviewerHandler {
+	return &AssignAccessReviewerHandler{
+		accessReviewRepository: accessReviewRepository,
+		userRepository:         userRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *AssignAccessReviewerHandler) Handle(context context.Context, command AssignAccessReviewerCommand) (*accessreviewdto.CampaignDTO, error) {
+	campaign, err := handler.accessReviewRepository.FindByID(context, command.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer, err := handler.userRepository.FindByID(context, command.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !reviewer.Status().IsActive() {
+		return nil, shared.NewValidationError("reviewer_id", "reviewer must be an active user")
+	}
+
+	if err := campaign.AssignReviewer(command.RoleID, command.ReviewerID, command.ActorID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessReviewRepository.Update(context, campaign); err != nil {
+		return nil, fmt.Errorf("update access review: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, campaign.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.Err(err),
+			)
+		}
+		campaign.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access reviewer assigned",
+		logger.String("campaign_id", campaign.ID().String()),
+		logger.String("role_id", command.RoleID.String()),
+		logger.String("reviewer_id", command.ReviewerID.String()),
+	)
+
+	return accessreviewdto.CampaignFromDomain(campaign), nil
+}
@@ -0,0 +1,130 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CloseAccessReviewCommand struct {
+	CampaignID uuid.UUID
+	ActorID    uuid.UUID
+	Force      bool
+}
+
+type CloseAccessReviewHandler struct {
+	accessReviewRepository    accessreview.Repository
+	revokeRoleFromUserHandler *usercommand.RevokeRoleFromUserHandler
+	eventBus                  shared.EventBus
+	logger                    logger.Logger
+}
+
+func NewCloseAccessReviewHandler(
+	accessReviewRepository accessreview.Repository,
+	revokeRoleFromUserHandler *usercommand.RevokeRoleFromUserHandler,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CloseAccessReviewHandler {
+	return &CloseAccessReviewHandler{
+		accessReviewRepository:    accessReviewRepository,
+		revokeRoleFromUserHandler: revokeRoleFromUserHandler,
+		eventBus:                  eventBus,
+		logger:                    logger,
+	}
+}
+
+func (handler *CloseAccessReviewHandler) Handle(context context.Context, command CloseAccessReviewCommand) (*accessreviewdto.CloseResultDTO, error) {
+	campaign, err := handler.accessReviewRepository.FindByID(context, command.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	retrying := !campaign.Status().IsActive() && hasPendingRevocations(campaign)
+	if !retrying {
+		if err := campaign.Close(command.ActorID, command.Force); err != nil {
+			return nil, err
+		}
+		if err := handler.accessReviewRepository.Update(context, campaign); err != nil {
+			return nil, fmt.Errorf("update access review: %w", err)
+		}
+	}
+
+	result := &accessreviewdto.CloseResultDTO{
+		RevocationFailures: make([]*accessreviewdto.RevocationFailureDTO, 0),
+	}
+
+	actorID := command.ActorID
+	for _, item := range campaign.Items() {
+		if !item.AwaitsRevocation() {
+			continue
+		}
+
+		_, err := handler.revokeRoleFromUserHandler.Handle(context, usercommand.RevokeRoleFromUserCommand{
+			UserID:  item.UserID(),
+			RoleID:  item.RoleID(),
+			ActorID: &actorID,
+		})
+		if err != nil && !errors.Is(err, user.ErrRoleNotAssigned) && !errors.Is(err, user.ErrUserNotFound) {
+			handler.logger.Error("failed to apply access review revocation",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.String("item_id", item.ID().String()),
+				logger.Err(err),
+			)
+			result.RevocationFailures = append(result.RevocationFailures, &accessreviewdto.RevocationFailureDTO{
+				ItemID: item.ID(),
+				UserID: item.UserID(),
+				RoleID: item.RoleID(),
+				Reason: err.Error(),
+			})
+			continue
+		}
+
+		if err := campaign.MarkRevoked(item.ID()); err != nil {
+			return nil, err
+		}
+		result.RevocationsApplied++
+	}
+
+	if result.RevocationsApplied > 0 {
+		if err := handler.accessReviewRepository.Update(context, campaign); err != nil {
+			return nil, fmt.Errorf("update access review: %w", err)
+		}
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, campaign.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.Err(err),
+			)
+		}
+		campaign.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access review closed",
+		logger.String("campaign_id", campaign.ID().String()),
+		logger.Int("revocations_applied", result.RevocationsApplied),
+		logger.Int("revocation_failures", len(result.RevocationFailures)),
+	)
+
+	result.Campaign = accessreviewdto.CampaignFromDomain(campaign)
+	return result, nil
+}
+
+func hasPendingRevocations(campaign *accessreview.Campaign) bool {
+	for _, item := range campaign.Items() {
+		if item.AwaitsRevocation() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DecideAccessReviewItemCommand struct {
+	CampaignID uuid.UUID
+	ItemID     uuid.UUID
+	ReviewerID uuid.UUID
+	Decision   accessreview.Decision
+	Comment    string
+}
+
+type DecideAccessReviewItemHandler struct {
+	accessReviewRepository accessreview.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewDecideAccessReviewItemHandler(
+	accessReviewRepository accessreview.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DecideAccessReviewItemHandler {
+	return &DecideAccessReviewItemHandler{
+		accessReviewRepository: accessReviewRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *DecideAccessReviewItemHandler) Handle(context context.Context, command DecideAccessReviewItemCommand) (*accessreviewdto.ReviewItemDTO, error) {
+	campaign, err := handler.accessReviewRepository.FindByID(context, command.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := campaign.Decide(command.ItemID, command.ReviewerID, command.Decision, command.Comment); err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessReviewRepository.Update(context, campaign); err != nil {
+		return nil, fmt.Errorf("update access review: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, campaign.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.Err(err),
+			)
+		}
+		campaign.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access review item decided",
+		logger.String("campaign_id", campaign.ID().String()),
+		logger.String("item_id", command.ItemID.String()),
+		logger.String("decision", command.Decision.String()),
+	)
+
+	item, err := campaign.Item(command.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	return accessreviewdto.ReviewItemFromDomain(item), nil
+}
@@ -0,0 +1,77 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const escalateBatchSize = 50
+
+type EscalateOverdueAccessReviewsCommand struct {
+	Now time.Time
+}
+
+type EscalateOverdueAccessReviewsHandler struct {
+	accessReviewRepository accessreview.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewEscalateOverdueAccessReviewsHandler(
+	accessReviewRepository accessreview.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *EscalateOverdueAccessReviewsHandler {
+	return &EscalateOverdueAccessReviewsHandler{
+		accessReviewRepository: accessReviewRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *EscalateOverdueAccessReviewsHandler) Handle(context context.Context, command EscalateOverdueAccessReviewsCommand) (int, error) {
+	campaigns, err := handler.accessReviewRepository.FindOverdue(context, command.Now, escalateBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("find overdue access reviews: %w", err)
+	}
+
+	escalated := 0
+	for _, campaign := range campaigns {
+		count := campaign.Escalate(command.Now)
+		if count == 0 {
+			continue
+		}
+
+		if err := handler.accessReviewRepository.Update(context, campaign); err != nil {
+			handler.logger.Error("failed to escalate access review",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.Err(err),
+			)
+			continue
+		}
+
+		if handler.eventBus != nil {
+			if err := handler.eventBus.Publish(context, campaign.DomainEvents()...); err != nil {
+				handler.logger.Error("failed to publish domain events",
+					logger.String("campaign_id", campaign.ID().String()),
+					logger.Err(err),
+				)
+			}
+			campaign.ClearDomainEvents()
+		}
+		escalated += count
+	}
+
+	if escalated > 0 {
+		handler.logger.Info("access review items escalated",
+			logger.Int("count", escalated),
+		)
+	}
+
+	return escalated, nil
+}
@@ -0,0 +1,133 @@
+package accessreviewcommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type LaunchAccessReviewCommand struct {
+	Name        string
+	Description string
+	RoleIDs     []uuid.UUID
+	UserIDs     []uuid.UUID
+	DueAt       time.Time
+	ActorID     uuid.UUID
+}
+
+type LaunchAccessReviewHandler struct {
+	accessReviewRepository accessreview.Repository
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewLaunchAccessReviewHandler(
+	accessReviewRepository accessreview.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *LaunchAccessReviewHandler {
+	return &LaunchAccessReviewHandler{
+		accessReviewRepository: accessReviewRepository,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *LaunchAccessReviewHandler) Handle(context context.Context, command LaunchAccessReviewCommand) (*accessreviewdto.CampaignDTO, error) {
+	for _, roleID := range command.RoleIDs {
+		if _, err := handler.roleRepository.FindByID(context, roleID); err != nil {
+			return nil, err
+		}
+	}
+
+	assignments, err := handler.collectAssignments(context, command.RoleIDs, command.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := accessreview.NewCampaign(accessreview.NewCampaignParams{
+		Name:         command.Name,
+		Description:  command.Description,
+		ScopeRoleIDs: command.RoleIDs,
+		ScopeUserIDs: command.UserIDs,
+		DueAt:        command.DueAt,
+		CreatedBy:    command.ActorID,
+		Assignments:  assignments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.accessReviewRepository.Create(context, campaign); err != nil {
+		return nil, fmt.Errorf("create access review: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, campaign.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("campaign_id", campaign.ID().String()),
+				logger.Err(err),
+			)
+		}
+		campaign.ClearDomainEvents()
+	}
+
+	handler.logger.Info("access review launched",
+		logger.String("campaign_id", campaign.ID().String()),
+		logger.Int("items", len(campaign.Items())),
+	)
+
+	return accessreviewdto.CampaignFromDomain(campaign), nil
+}
+
+func (handler *LaunchAccessReviewHandler) collectAssignments(context context.Context, roleIDs, userIDs []uuid.UUID) ([]accessreview.Assignment, error) {
+	assignments := make([]accessreview.Assignment, 0)
+
+	if len(userIDs) == 0 {
+		for _, roleID := range roleIDs {
+			holders, err := handler.userRepository.FindByRole(context, roleID)
+			if err != nil {
+				return nil, fmt.Errorf("find role holders: %w", err)
+			}
+			for _, holder := range holders {
+				assignments = append(assignments, accessreview.Assignment{UserID: holder.ID(), RoleID: roleID})
+			}
+		}
+		return assignments, nil
+	}
+
+	inScope := make(map[uuid.UUID]bool, len(roleIDs))
+	for _, roleID := range roleIDs {
+		inScope[roleID] = true
+	}
+
+	for _, userID := range userIDs {
+		holder, err := handler.userRepository.FindByID(context, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, roleID := range holder.RoleIDs() {
+			if len(inScope) > 0 && !inScope[roleID] {
+				continue
+			}
+			assignments = append(assignments, accessreview.Assignment{UserID: userID, RoleID: roleID})
+		}
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,145 @@
+package accessreviewdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type ReviewItemDTO struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	RoleID      uuid.UUID  `json:"role_id"`
+	ReviewerID  *uuid.UUID `json:"reviewer_id,omitempty"`
+	Decision    string     `json:"decision"`
+	Comment     string     `json:"comment,omitempty"`
+	DecidedBy   *uuid.UUID `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+func ReviewItemFromDomain(item *accessreview.Item) *ReviewItemDTO {
+	return &ReviewItemDTO{
+		ID:          item.ID(),
+		UserID:      item.UserID(),
+		RoleID:      item.RoleID(),
+		ReviewerID:  item.ReviewerID(),
+		Decision:    item.Decision().String(),
+		Comment:     item.Comment(),
+		DecidedBy:   item.DecidedBy(),
+		DecidedAt:   item.DecidedAt(),
+		EscalatedAt: item.EscalatedAt(),
+		RevokedAt:   item.RevokedAt(),
+	}
+}
+
+type CampaignDTO struct {
+	ID           uuid.UUID        `json:"id"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description,omitempty"`
+	ScopeRoleIDs []uuid.UUID      `json:"scope_role_ids"`
+	ScopeUserIDs []uuid.UUID      `json:"scope_user_ids"`
+	Status       string           `json:"status"`
+	DueAt        time.Time        `json:"due_at"`
+	CreatedBy    uuid.UUID        `json:"created_by"`
+	ClosedBy     *uuid.UUID       `json:"closed_by,omitempty"`
+	ClosedAt     *time.Time       `json:"closed_at,omitempty"`
+	Kept         int              `json:"kept"`
+	Revoked      int              `json:"revoked"`
+	Pending      int              `json:"pending"`
+	Items        []*ReviewItemDTO `json:"items"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+func CampaignFromDomain(campaign *accessreview.Campaign) *CampaignDTO {
+	if campaign == nil {
+		return nil
+	}
+
+	items := make([]*ReviewItemDTO, len(campaign.Items()))
+	for i, item := range campaign.Items() {
+		items[i] = ReviewItemFromDomain(item)
+	}
+
+	kept, revoked, pending := campaign.Counts()
+	return &CampaignDTO{
+		ID:           campaign.ID(),
+		Name:         campaign.Name(),
+		Description:  campaign.Description(),
+		ScopeRoleIDs: campaign.ScopeRoleIDs(),
+		ScopeUserIDs: campaign.ScopeUserIDs(),
+		Status:       campaign.Status().String(),
+		DueAt:        campaign.DueAt(),
+		CreatedBy:    campaign.CreatedBy(),
+		ClosedBy:     campaign.ClosedBy(),
+		ClosedAt:     campaign.ClosedAt(),
+		Kept:         kept,
+		Revoked:      revoked,
+		Pending:      pending,
+		Items:        items,
+		CreatedAt:    campaign.CreatedAt(),
+		UpdatedAt:    campaign.UpdatedAt(),
+	}
+}
+
+func CampaignsFromDomain(campaigns []*accessreview.Campaign) []*CampaignDTO {
+	dtos := make([]*CampaignDTO, len(campaigns))
+	for i, campaign := range campaigns {
+		dtos[i] = CampaignFromDomain(campaign)
+	}
+	return dtos
+}
+
+type PaginatedCampaignsDTO struct {
+	Items      []*CampaignDTO `json:"items"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	Limit      int            `json:"limit"`
+	TotalPages int            `json:"total_pages"`
+	HasNext    bool           `json:"has_next"`
+	HasPrev    bool           `json:"has_prev"`
+}
+
+func NewPaginatedCampaignsDTO(campaigns []*accessreview.Campaign, total int64, pagination shared.Pagination) *PaginatedCampaignsDTO {
+	return &PaginatedCampaignsDTO{
+		Items:      CampaignsFromDomain(campaigns),
+		Total:      total,
+		Page:       pagination.Page(),
+		Limit:      pagination.Limit(),
+		TotalPages: pagination.TotalPages(total),
+		HasNext:    pagination.HasNext(total),
+		HasPrev:    pagination.HasPrev(),
+	}
+}
+
+type ReviewAssignmentDTO struct {
+	CampaignID   uuid.UUID      `json:"campaign_id"`
+	CampaignName string         `json:"campaign_name"`
+	DueAt        time.Time      `json:"due_at"`
+	Item         *ReviewItemDTO `json:"item"`
+}
+
+type RevocationFailureDTO struct {
+	ItemID uuid.UUID `json:"item_id"`
+	UserID uuid.UUID `json:"user_id"`
+	RoleID uuid.UUID `json:"role_id"`
+	Reason string    `json:"reason"`
+}
+
+type CloseResultDTO struct {
+	Campaign           *CampaignDTO            `json:"campaign"`
+	RevocationsApplied int                     `json:"revocations_applied"`
+	RevocationFailures []*RevocationFailureDTO `json:"revocation_failures"`
+}
+
+type EvidenceDTO struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	Signature   string
+}
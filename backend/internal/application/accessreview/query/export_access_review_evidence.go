@@ -0,0 +1,252 @@
+package accessreviewquery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	EvidenceFormatCSV  = "csv"
+	EvidenceFormatJSON = "json"
+)
+
+var ErrEvidenceSigningKeyMissing = shared.NewBusinessRuleViolationError(
+	"access_review_evidence_unsigned",
+	"evidence signing key is not configured",
+)
+
+type ExportAccessReviewEvidenceQuery struct {
+	CampaignID uuid.UUID
+	Format     string
+}
+
+type ExportAccessReviewEvidenceHandler struct {
+	accessReviewRepository accessreview.Repository
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	logger                 logger.Logger
+	signingKey             []byte
+}
+
+func NewExportAccessReviewEvidenceHandler(
+	accessReviewRepository accessreview.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	logger logger.Logger,
+	signingKey string,
+) *ExportAccessReviewEvidenceHandler {
+	return &ExportAccessReviewEvidenceHandler{
+		accessReviewRepository: accessReviewRepository,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		logger:                 logger,
+		signingKey:             []byte(signingKey),
+	}
+}
+
+type evidenceReport struct {
+	CampaignID   uuid.UUID           `json:"campaign_id"`
+	CampaignName string              `json:"campaign_name"`
+	Status       string              `json:"status"`
+	DueAt        time.Time           `json:"due_at"`
+	CreatedBy    uuid.UUID           `json:"created_by"`
+	ClosedBy     *uuid.UUID          `json:"closed_by,omitempty"`
+	ClosedAt     *time.Time          `json:"closed_at,omitempty"`
+	GeneratedAt  time.Time           `json:"generated_at"`
+	Kept         int                 `json:"kept"`
+	Revoked      int                 `json:"revoked"`
+	Pending      int                 `json:"pending"`
+	Items        []evidenceReportRow `json:"items"`
+}
+
+type evidenceReportRow struct {
+	ItemID      uuid.UUID  `json:"item_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	UserEmail   string     `json:"user_email"`
+	RoleID      uuid.UUID  `json:"role_id"`
+	RoleName    string     `json:"role_name"`
+	ReviewerID  *uuid.UUID `json:"reviewer_id,omitempty"`
+	Decision    string     `json:"decision"`
+	Comment     string     `json:"comment"`
+	DecidedBy   *uuid.UUID `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+func (handler *ExportAccessReviewEvidenceHandler) Handle(context context.Context, query ExportAccessReviewEvidenceQuery) (*accessreviewdto.EvidenceDTO, error) {
+	if len(handler.signingKey) == 0 {
+		return nil, ErrEvidenceSigningKeyMissing
+	}
+	if query.Format != EvidenceFormatCSV && query.Format != EvidenceFormatJSON {
+		return nil, shared.NewValidationError("format", "format must be csv or json")
+	}
+
+	campaign, err := handler.accessReviewRepository.FindByID(context, query.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := handler.buildReport(context, campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	evidence := &accessreviewdto.EvidenceDTO{
+		Filename: fmt.Sprintf("access-review-%s.%s", campaign.ID(), query.Format),
+	}
+	switch query.Format {
+	case EvidenceFormatCSV:
+		evidence.ContentType = "text/csv"
+		evidence.Content, err = encodeEvidenceCSV(report)
+	default:
+		evidence.ContentType = "application/json"
+		evidence.Content, err = json.MarshalIndent(report, "", "  ")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode evidence: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, handler.signingKey)
+	mac.Write(evidence.Content)
+	evidence.Signature = hex.EncodeToString(mac.Sum(nil))
+
+	handler.logger.Info("access review evidence exported",
+		logger.String("campaign_id", campaign.ID().String()),
+		logger.String("format", query.Format),
+	)
+
+	return evidence, nil
+}
+
+func (handler *ExportAccessReviewEvidenceHandler) buildReport(context context.Context, campaign *accessreview.Campaign) (*evidenceReport, error) {
+	kept, revoked, pending := campaign.Counts()
+	report := &evidenceReport{
+		CampaignID:   campaign.ID(),
+		CampaignName: campaign.Name(),
+		Status:       campaign.Status().String(),
+		DueAt:        campaign.DueAt(),
+		CreatedBy:    campaign.CreatedBy(),
+		ClosedBy:     campaign.ClosedBy(),
+		ClosedAt:     campaign.ClosedAt(),
+		GeneratedAt:  time.Now().UTC(),
+		Kept:         kept,
+		Revoked:      revoked,
+		Pending:      pending,
+		Items:        make([]evidenceReportRow, len(campaign.Items())),
+	}
+
+	emails := make(map[uuid.UUID]string)
+	roleNames := make(map[uuid.UUID]string)
+	for i, item := range campaign.Items() {
+		email, found := emails[item.UserID()]
+		if !found {
+			holder, err := handler.userRepository.FindByID(context, item.UserID())
+			if err != nil && !errors.Is(err, user.ErrUserNotFound) {
+				return nil, fmt.Errorf("find user: %w", err)
+			}
+			if holder != nil {
+				email = holder.Email().String()
+			}
+			emails[item.UserID()] = email
+		}
+
+		roleName, found := roleNames[item.RoleID()]
+		if !found {
+			reviewedRole, err := handler.roleRepository.FindByID(context, item.RoleID())
+			if err != nil && !errors.Is(err, role.ErrRoleNotFound) {
+				return nil, fmt.Errorf("find role: %w", err)
+			}
+			if reviewedRole != nil {
+				roleName = reviewedRole.Name()
+			}
+			roleNames[item.RoleID()] = roleName
+		}
+
+		report.Items[i] = evidenceReportRow{
+			ItemID:      item.ID(),
+			UserID:      item.UserID(),
+			UserEmail:   email,
+			RoleID:      item.RoleID(),
+			RoleName:    roleName,
+			ReviewerID:  item.ReviewerID(),
+			Decision:    item.Decision().String(),
+			Comment:     item.Comment(),
+			DecidedBy:   item.DecidedBy(),
+			DecidedAt:   item.DecidedAt(),
+			EscalatedAt: item.EscalatedAt(),
+			RevokedAt:   item.RevokedAt(),
+		}
+	}
+
+	return report, nil
+}
+
+func encodeEvidenceCSV(report *evidenceReport) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	header := []string{
+		"campaign_id", "campaign_name", "item_id", "user_id", "user_email", "role_id", "role_name",
+		"reviewer_id", "decision", "comment", "decided_by", "decided_at", "escalated_at", "revoked_at",
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, row := range report.Items {
+		record := []string{
+			report.CampaignID.String(),
+			report.CampaignName,
+			row.ItemID.String(),
+			row.UserID.String(),
+			row.UserEmail,
+			row.RoleID.String(),
+			row.RoleName,
+			optionalID(row.ReviewerID),
+			row.Decision,
+			row.Comment,
+			optionalID(row.DecidedBy),
+			optionalTime(row.DecidedAt),
+			optionalTime(row.EscalatedAt),
+			optionalTime(row.RevokedAt),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,95 @@
+package accessreviewquery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+const testSigningKey = "evidence-signing-key-for-tests-0123456789"
+
+func TestExportAccessReviewEvidenceHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	accessReviewRepo := testutil.NewMockAccessReviewRepository()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+
+	reviewedRole, _ := role.NewRole(role.NewRoleParams{Name: "billing", DisplayName: "Billing"})
+	roleRepo.AddRole(reviewedRole)
+	holder := testutil.CreateActiveUser()
+	userRepo.AddUser(holder)
+	owner := uuid.New()
+	reviewer := uuid.New()
+
+	campaign, err := accessreview.NewCampaign(accessreview.NewCampaignParams{
+		Name:         "Billing recertification",
+		ScopeRoleIDs: []uuid.UUID{reviewedRole.ID()},
+		DueAt:        time.Now().Add(time.Hour),
+		CreatedBy:    owner,
+		Assignments:  []accessreview.Assignment{{UserID: holder.ID(), RoleID: reviewedRole.ID()}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, campaign.AssignReviewer(reviewedRole.ID(), reviewer, owner))
+	require.NoError(t, campaign.Decide(campaign.Items()[0].ID(), reviewer, accessreview.DecisionKeep, "needs invoicing, approved"))
+	accessReviewRepo.AddCampaign(campaign)
+
+	handler := NewExportAccessReviewEvidenceHandler(accessReviewRepo, userRepo, roleRepo, testutil.NewNoopLogger(), testSigningKey)
+
+	verify := func(t *testing.T, content []byte, signature string) {
+		mac := hmac.New(sha256.New, []byte(testSigningKey))
+		mac.Write(content)
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), signature)
+	}
+
+	t.Run("exports signed csv", func(t *testing.T) {
+		evidence, err := handler.Handle(ctx, ExportAccessReviewEvidenceQuery{CampaignID: campaign.ID(), Format: EvidenceFormatCSV})
+		require.NoError(t, err)
+		assert.Equal(t, "text/csv", evidence.ContentType)
+		verify(t, evidence.Content, evidence.Signature)
+
+		records, err := csv.NewReader(strings.NewReader(string(evidence.Content))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, holder.Email().String(), records[1][4])
+		assert.Equal(t, "billing", records[1][6])
+		assert.Equal(t, "keep", records[1][8])
+		assert.Equal(t, "needs invoicing, approved", records[1][9])
+	})
+
+	t.Run("exports signed json", func(t *testing.T) {
+		evidence, err := handler.Handle(ctx, ExportAccessReviewEvidenceQuery{CampaignID: campaign.ID(), Format: EvidenceFormatJSON})
+		require.NoError(t, err)
+		verify(t, evidence.Content, evidence.Signature)
+
+		var report map[string]interface{}
+		require.NoError(t, json.Unmarshal(evidence.Content, &report))
+		assert.Equal(t, float64(1), report["kept"])
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		_, err := handler.Handle(ctx, ExportAccessReviewEvidenceQuery{CampaignID: campaign.ID(), Format: "xml"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "format")
+	})
+
+	t.Run("refuses to export without signing key", func(t *testing.T) {
+		unsigned := NewExportAccessReviewEvidenceHandler(accessReviewRepo, userRepo, roleRepo, testutil.NewNoopLogger(), "")
+		_, err := unsigned.Handle(ctx, ExportAccessReviewEvidenceQuery{CampaignID: campaign.ID(), Format: EvidenceFormatJSON})
+		assert.ErrorIs(t, err, ErrEvidenceSigningKeyMissing)
+	})
+}
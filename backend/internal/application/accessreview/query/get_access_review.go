@@ -0,0 +1,39 @@
+package accessreviewquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetAccessReviewQuery struct {
+	CampaignID uuid.UUID
+}
+
+type GetAccessReviewHandler struct {
+	accessReviewRepository accessreview.Repository
+	logger                 logger.Logger
+}
+
+func NewGetAccessReviewHandler(
+	accessReviewRepository accessreview.Repository,
+	logger logger.Logger,
+) *GetAccessReviewHandler {
+	return &GetAccessReviewHandler{
+		accessReviewRepository: accessReviewRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *GetAccessReviewHandler) Handle(context context.Context, query GetAccessReviewQuery) (*accessreviewdto.CampaignDTO, error) {
+	campaign, err := handler.accessReviewRepository.FindByID(context, query.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessreviewdto.CampaignFromDomain(campaign), nil
+}
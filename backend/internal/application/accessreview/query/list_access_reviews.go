@@ -0,0 +1,43 @@
+package accessreviewquery
+
+import (
+	"context"
+	"fmt"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListAccessReviewsQuery struct {
+	Page   int
+	Limit  int
+	Status *accessreview.Status
+}
+
+type ListAccessReviewsHandler struct {
+	accessReviewRepository accessreview.Repository
+	logger                 logger.Logger
+}
+
+func NewListAccessReviewsHandler(
+	accessReviewRepository accessreview.Repository,
+	logger logger.Logger,
+) *ListAccessReviewsHandler {
+	return &ListAccessReviewsHandler{
+		accessReviewRepository: accessReviewRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *ListAccessReviewsHandler) Handle(context context.Context, query ListAccessReviewsQuery) (*accessreviewdto.PaginatedCampaignsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+
+	campaigns, total, err := handler.accessReviewRepository.List(context, accessreview.Filter{Status: query.Status}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list access reviews: %w", err)
+	}
+
+	return accessreviewdto.NewPaginatedCampaignsDTO(campaigns, total, pagination), nil
+}
@@ -0,0 +1,55 @@
+package accessreviewquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListMyReviewAssignmentsQuery struct {
+	ReviewerID uuid.UUID
+}
+
+type ListMyReviewAssignmentsHandler struct {
+	accessReviewRepository accessreview.Repository
+	logger                 logger.Logger
+}
+
+func NewListMyReviewAssignmentsHandler(
+	accessReviewRepository accessreview.Repository,
+	logger logger.Logger,
+) *ListMyReviewAssignmentsHandler {
+	return &ListMyReviewAssignmentsHandler{
+		accessReviewRepository: accessReviewRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *ListMyReviewAssignmentsHandler) Handle(context context.Context, query ListMyReviewAssignmentsQuery) ([]*accessreviewdto.ReviewAssignmentDTO, error) {
+	campaigns, err := handler.accessReviewRepository.FindActiveByReviewer(context, query.ReviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("find review assignments: %w", err)
+	}
+
+	assignments := make([]*accessreviewdto.ReviewAssignmentDTO, 0)
+	for _, campaign := range campaigns {
+		for _, item := range campaign.Items() {
+			if !item.Decision().IsPending() || !campaign.CanReview(item, query.ReviewerID) {
+				continue
+			}
+			assignments = append(assignments, &accessreviewdto.ReviewAssignmentDTO{
+				CampaignID:   campaign.ID(),
+				CampaignName: campaign.Name(),
+				DueAt:        campaign.DueAt(),
+				Item:         accessreviewdto.ReviewItemFromDomain(item),
+			})
+		}
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,44 @@
+package auditlogdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
+)
+
+type AuditLogDTO struct {
+	ID            uuid.UUID      `json:"id"`
+	Timestamp     time.Time      `json:"timestamp"`
+	EventType     string         `json:"event_type"`
+	ActorID       *uuid.UUID     `json:"actor_id,omitempty"`
+	Action        string         `json:"action"`
+	ResourceType  string         `json:"resource_type,omitempty"`
+	ResourceID    string         `json:"resource_id,omitempty"`
+	IPAddress     string         `json:"ip_address,omitempty"`
+	UserAgent     string         `json:"user_agent,omitempty"`
+	Success       bool           `json:"success"`
+	FailureReason string         `json:"failure_reason,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+}
+
+func AuditLogFromDomain(entry *auditlog.Entry) *AuditLogDTO {
+	if entry == nil {
+		return nil
+	}
+	return &AuditLogDTO{
+		ID:            entry.ID,
+		Timestamp:     entry.Timestamp,
+		EventType:     entry.EventType,
+		ActorID:       entry.ActorID,
+		Action:        entry.Action,
+		ResourceType:  entry.ResourceType,
+		ResourceID:    entry.ResourceID,
+		IPAddress:     entry.IPAddress,
+		UserAgent:     entry.UserAgent,
+		Success:       entry.Success,
+		FailureReason: entry.FailureReason,
+		Metadata:      entry.Metadata,
+	}
+}
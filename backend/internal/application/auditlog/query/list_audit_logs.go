@@ -0,0 +1,93 @@
+package auditlogquery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditlogdto "github.com/tranvuongduy2003/go-copilot/internal/application/auditlog/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// ListAuditLogsQuery pages through the audit log newest first. The audit
+// log only supports cursor pagination; an empty Cursor requests the first
+// page.
+type ListAuditLogsQuery struct {
+	ActorID      *uuid.UUID
+	EventType    string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Success      *bool
+	// From and To bound the entry timestamp, as RFC 3339 times or dates.
+	From         string
+	To           string
+	Cursor       string
+	Limit        int
+	IncludeTotal bool
+}
+
+type ListAuditLogsHandler struct {
+	auditLogRepository auditlog.Repository
+	logger             logger.Logger
+}
+
+func NewListAuditLogsHandler(
+	auditLogRepository auditlog.Repository,
+	logger logger.Logger,
+) *ListAuditLogsHandler {
+	return &ListAuditLogsHandler{
+		auditLogRepository: auditLogRepository,
+		logger:             logger,
+	}
+}
+
+func (handler *ListAuditLogsHandler) Handle(context context.Context, query ListAuditLogsQuery) (shared.CursorResult[*auditlogdto.AuditLogDTO], error) {
+	page, err := shared.NewCursorPage(query.Cursor, query.Limit, query.IncludeTotal)
+	if err != nil {
+		return shared.CursorResult[*auditlogdto.AuditLogDTO]{}, err
+	}
+
+	filter := auditlog.Filter{
+		ActorID:      query.ActorID,
+		EventType:    query.EventType,
+		Action:       query.Action,
+		ResourceType: query.ResourceType,
+		ResourceID:   query.ResourceID,
+		Success:      query.Success,
+	}
+	if filter.From, err = parseTimeBound("from", query.From); err != nil {
+		return shared.CursorResult[*auditlogdto.AuditLogDTO]{}, err
+	}
+	if filter.To, err = parseTimeBound("to", query.To); err != nil {
+		return shared.CursorResult[*auditlogdto.AuditLogDTO]{}, err
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return shared.CursorResult[*auditlogdto.AuditLogDTO]{}, shared.NewValidationError("to", "must not be before from")
+	}
+
+	result, err := handler.auditLogRepository.ListByCursor(context, filter, page)
+	if err != nil {
+		return shared.CursorResult[*auditlogdto.AuditLogDTO]{}, fmt.Errorf("list audit logs: %w", err)
+	}
+
+	return shared.MapCursorResult(result, auditlogdto.AuditLogFromDomain), nil
+}
+
+// parseTimeBound accepts a full timestamp or a bare date, which means
+// midnight UTC.
+func parseTimeBound(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return &parsed, nil
+		}
+	}
+	return nil, shared.NewValidationError(field, "must be an RFC 3339 time or a date")
+}
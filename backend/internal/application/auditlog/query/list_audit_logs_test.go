@@ -0,0 +1,76 @@
+package auditlogquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListAuditLogsHandler_Handle(t *testing.T) {
+	auditLogRepo := testutil.NewMockAuditLogRepository()
+	actorID := uuid.New()
+	now := time.Now().UTC()
+	for i := 0; i < 3; i++ {
+		auditLogRepo.Entries = append(auditLogRepo.Entries, &auditlog.Entry{
+			ID:        uuid.New(),
+			Timestamp: now.Add(time.Duration(i) * time.Minute),
+			EventType: "user.logged_in",
+			ActorID:   &actorID,
+			Action:    "login",
+			Success:   true,
+		})
+	}
+	auditLogRepo.Entries = append(auditLogRepo.Entries, &auditlog.Entry{
+		ID:        uuid.New(),
+		Timestamp: now,
+		EventType: "user.login_failed",
+		Action:    "login",
+	})
+
+	handler := NewListAuditLogsHandler(auditLogRepo, testutil.NewNoopLogger())
+
+	first, err := handler.Handle(context.Background(), ListAuditLogsQuery{ActorID: &actorID, Limit: 2, IncludeTotal: true})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, auditLogRepo.Entries[2].ID, first.Items[0].ID)
+	assert.Equal(t, int64(3), *first.Total)
+
+	second, err := handler.Handle(context.Background(), ListAuditLogsQuery{ActorID: &actorID, Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, auditLogRepo.Entries[0].ID, second.Items[0].ID)
+	assert.Empty(t, second.NextCursor)
+
+	_, err = handler.Handle(context.Background(), ListAuditLogsQuery{From: "2026-03-01", To: "2026-03-02T12:00:00Z"})
+	require.NoError(t, err)
+	require.NotNil(t, auditLogRepo.LastFilter.From)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), *auditLogRepo.LastFilter.From)
+}
+
+func TestListAuditLogsHandler_RejectsInvalidOptions(t *testing.T) {
+	handler := NewListAuditLogsHandler(testutil.NewMockAuditLogRepository(), testutil.NewNoopLogger())
+
+	tests := []struct {
+		name  string
+		query ListAuditLogsQuery
+	}{
+		{name: "malformed from", query: ListAuditLogsQuery{From: "yesterday"}},
+		{name: "to before from", query: ListAuditLogsQuery{From: "2026-03-02", To: "2026-03-01"}},
+		{name: "malformed cursor", query: ListAuditLogsQuery{Cursor: "!"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.Handle(context.Background(), tt.query)
+			assert.True(t, shared.IsValidationError(err))
+		})
+	}
+}
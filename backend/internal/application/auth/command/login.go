@@ -2,6 +2,7 @@ package authcommand
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -11,6 +12,9 @@ import (
 	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -20,16 +24,21 @@ import (
 )
 
 type LoginCommand struct {
-	Email     string
-	Password  string
-	IPAddress net.IP
-	UserAgent string
+	Email          string
+	Password       string
+	OrganizationID *uuid.UUID
+	IPAddress      net.IP
+	UserAgent      string
 }
 
 type LoginHandler struct {
 	userRepository         user.Repository
+	preferencesRepository  user.PreferencesRepository
 	roleRepository         role.Repository
+	groupRepository        group.Repository
 	permissionRepository   permission.Repository
+	accessResolver         *authz.Resolver
+	organizationRepository organization.Repository
 	refreshTokenRepository auth.RefreshTokenRepository
 	tokenGenerator         auth.TokenGenerator
 	passwordHasher         security.PasswordHasher
@@ -41,8 +50,11 @@ type LoginHandler struct {
 
 type LoginHandlerParams struct {
 	UserRepository         user.Repository
+	PreferencesRepository  user.PreferencesRepository
 	RoleRepository         role.Repository
+	GroupRepository        group.Repository
 	PermissionRepository   permission.Repository
+	OrganizationRepository organization.Repository
 	RefreshTokenRepository auth.RefreshTokenRepository
 	TokenGenerator         auth.TokenGenerator
 	PasswordHasher         security.PasswordHasher
@@ -55,8 +67,12 @@ type LoginHandlerParams struct {
 func NewLoginHandler(params LoginHandlerParams) *LoginHandler {
 	return &LoginHandler{
 		userRepository:         params.UserRepository,
+		preferencesRepository:  params.PreferencesRepository,
 		roleRepository:         params.RoleRepository,
+		groupRepository:        params.GroupRepository,
 		permissionRepository:   params.PermissionRepository,
+		accessResolver:         authz.NewResolver(params.RoleRepository, params.GroupRepository, params.PermissionRepository),
+		organizationRepository: params.OrganizationRepository,
 		refreshTokenRepository: params.RefreshTokenRepository,
 		tokenGenerator:         params.TokenGenerator,
 		passwordHasher:         params.PasswordHasher,
@@ -95,10 +111,6 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 		return nil, auth.ErrInvalidCredentials
 	}
 
-	if !existingUser.Status().IsActive() {
-		return nil, auth.ErrAccountInactive
-	}
-
 	valid, err := handler.passwordHasher.Verify(existingUser.PasswordHash().String(), command.Password)
 	if err != nil || !valid {
 		if handler.accountLockout != nil {
@@ -110,6 +122,13 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 		return nil, auth.ErrInvalidCredentials
 	}
 
+	// The status is only revealed to someone who knows the password, so the
+	// endpoint cannot be used to learn whether and until when an account is
+	// suspended.
+	if err := accountStatusError(existingUser); err != nil {
+		return nil, err
+	}
+
 	if handler.accountLockout != nil {
 		if err := handler.accountLockout.ResetAttempts(ctx, lockoutIdentifier); err != nil {
 			handler.logger.Error("failed to reset login attempts",
@@ -119,13 +138,26 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 		}
 	}
 
-	roles, permissions := handler.loadUserRolesAndPermissions(ctx, existingUser)
+	if command.OrganizationID != nil {
+		isMember, err := handler.organizationRepository.IsMember(ctx, *command.OrganizationID, existingUser.ID())
+		if err != nil {
+			return nil, fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, organization.ErrNotMember
+		}
+	}
+
+	roles, permissions, deniedPermissions := handler.loadUserRolesAndPermissions(ctx, existingUser, command.OrganizationID)
 
-	accessToken, err := handler.tokenGenerator.GenerateAccessToken(
+	accessToken, err := handler.tokenGenerator.GenerateOrganizationAccessToken(
 		existingUser.ID(),
 		existingUser.Email().String(),
+		command.OrganizationID,
 		roles,
 		permissions,
+		deniedPermissions,
+		preferredLocale(ctx, handler.preferencesRepository, handler.logger, existingUser.ID()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
@@ -142,11 +174,12 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 	}
 
 	refreshToken, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
-		UserID:     existingUser.ID(),
-		TokenHash:  refreshTokenHash,
-		ExpiresAt:  time.Now().UTC().Add(handler.refreshTokenTTL),
-		DeviceInfo: deviceInfo,
-		IPAddress:  command.IPAddress,
+		UserID:         existingUser.ID(),
+		TokenHash:      refreshTokenHash,
+		ExpiresAt:      time.Now().UTC().Add(handler.refreshTokenTTL),
+		DeviceInfo:     deviceInfo,
+		IPAddress:      command.IPAddress,
+		OrganizationID: command.OrganizationID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create refresh token: %w", err)
@@ -156,6 +189,15 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 		return nil, fmt.Errorf("save refresh token: %w", err)
 	}
 
+	loginAt := time.Now().UTC()
+	existingUser.RecordLogin(loginAt)
+	if err := handler.userRepository.RecordLogin(ctx, existingUser.ID(), loginAt); err != nil {
+		handler.logger.Error("failed to record last login",
+			logger.String("user_id", existingUser.ID().String()),
+			logger.Err(err),
+		)
+	}
+
 	if handler.eventBus != nil {
 		event := auth.NewUserLoggedInEvent(
 			existingUser.ID(),
@@ -177,62 +219,25 @@ func (handler *LoginHandler) Handle(ctx context.Context, command LoginCommand) (
 	)
 
 	return &authdto.AuthResponseDTO{
-		User:         userdto.UserFromDomain(existingUser),
-		AccessToken:  accessToken.Token(),
-		RefreshToken: refreshTokenString,
-		ExpiresAt:    accessToken.ExpiresAt(),
+		User:           userdto.UserFromDomain(existingUser),
+		AccessToken:    accessToken.Token(),
+		RefreshToken:   refreshTokenString,
+		ExpiresAt:      accessToken.ExpiresAt(),
+		OrganizationID: command.OrganizationID,
 	}, nil
 }
 
-func (handler *LoginHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User) ([]string, []string) {
-	roleIDs := domainUser.RoleIDs()
-	if len(roleIDs) == 0 {
-		return []string{}, []string{}
-	}
-
-	roles, err := handler.roleRepository.FindByIDs(ctx, roleIDs)
+func (handler *LoginHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User, organizationID *uuid.UUID) ([]string, []string, []string) {
+	access, err := handler.accessResolver.Resolve(ctx, domainUser, organizationID)
 	if err != nil {
-		handler.logger.Error("failed to load user roles",
+		handler.logger.Error("failed to resolve user access",
 			logger.String("user_id", domainUser.ID().String()),
 			logger.Err(err),
 		)
-		return []string{}, []string{}
+		return []string{}, []string{}, []string{}
 	}
 
-	roleNames := make([]string, 0, len(roles))
-	permissionIDSet := make(map[uuid.UUID]bool)
-
-	for _, roleEntity := range roles {
-		roleNames = append(roleNames, roleEntity.Name())
-		for _, permID := range roleEntity.PermissionIDs() {
-			permissionIDSet[permID] = true
-		}
-	}
-
-	permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
-	for permID := range permissionIDSet {
-		permissionIDs = append(permissionIDs, permID)
-	}
-
-	if len(permissionIDs) == 0 {
-		return roleNames, []string{}
-	}
-
-	permissions, err := handler.permissionRepository.FindByIDs(ctx, permissionIDs)
-	if err != nil {
-		handler.logger.Error("failed to load permissions",
-			logger.String("user_id", domainUser.ID().String()),
-			logger.Err(err),
-		)
-		return roleNames, []string{}
-	}
-
-	permissionCodes := make([]string, 0, len(permissions))
-	for _, perm := range permissions {
-		permissionCodes = append(permissionCodes, perm.CodeString())
-	}
-
-	return roleNames, permissionCodes
+	return access.RoleNames(), access.PermissionCodes(), access.DeniedPermissionCodes()
 }
 
 func (handler *LoginHandler) publishLoginFailedEvent(ctx context.Context, domainUser *user.User, ipAddress, reason string, attemptCount int) {
@@ -255,3 +260,43 @@ func (handler *LoginHandler) publishLoginFailedEvent(ctx context.Context, domain
 		)
 	}
 }
+
+// accountStatusError explains why a user who is not active cannot get a
+// session, or returns nil when they can.
+func accountStatusError(domainUser *user.User) error {
+	switch {
+	case domainUser.Status().IsActive():
+		return nil
+	case domainUser.Status().IsBanned():
+		return auth.ErrAccountBanned
+	case domainUser.Status().IsSuspended():
+		if until := domainUser.SuspendedUntil(); until != nil {
+			return auth.NewAccountSuspendedError(*until)
+		}
+		return auth.ErrAccountSuspended
+	default:
+		return auth.ErrAccountInactive
+	}
+}
+
+// preferredLocale returns the locale to carry in the user's access token, or
+// "" when they follow Accept-Language. The token carries it so that requests
+// need no preferences lookup; a failed lookup falls back to Accept-Language
+// rather than failing the sign-in.
+func preferredLocale(ctx context.Context, preferencesRepository user.PreferencesRepository, log logger.Logger, userID uuid.UUID) string {
+	if preferencesRepository == nil {
+		return ""
+	}
+	preferences, err := preferencesRepository.FindByUserID(ctx, userID)
+	if errors.Is(err, user.ErrPreferencesNotFound) {
+		return ""
+	}
+	if err != nil {
+		log.Error("failed to load preferred locale",
+			logger.String("user_id", userID.String()),
+			logger.Err(err),
+		)
+		return ""
+	}
+	return preferences.Locale()
+}
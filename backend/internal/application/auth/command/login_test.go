@@ -2,6 +2,7 @@ package authcommand
 
 import (
 	"context"
+	"errors"
 	"net"
 	"testing"
 	"time"
@@ -10,6 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -232,6 +238,79 @@ func TestLoginHandler_Handle_ResetsLockoutOnSuccess(t *testing.T) {
 	assert.Equal(t, 0, lockout.AttemptCount)
 }
 
+func TestLoginHandler_Handle_ExplainsBannedAndSuspendedAccounts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	until := now.Add(48 * time.Hour)
+
+	tests := []struct {
+		name        string
+		status      user.Status
+		until       *time.Time
+		wantErr     error
+		errContains string
+	}{
+		{name: "banned", status: user.StatusBanned, wantErr: auth.ErrAccountBanned, errContains: "banned"},
+		{name: "suspended", status: user.StatusSuspended, until: &until, wantErr: auth.ErrAccountSuspended, errContains: until.Format(time.RFC3339)},
+		{name: "inactive", status: user.StatusInactive, wantErr: auth.ErrAccountInactive, errContains: "not active"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := testutil.NewMockUserRepository()
+			passwordHasher := testutil.NewMockPasswordHasher()
+
+			testUser, err := user.ReconstructUser(user.ReconstructUserParams{
+				ID:               uuid.New(),
+				Email:            "test@example.com",
+				PasswordHash:     "$2a$10$hashedpassword",
+				FullName:         "Test User",
+				Status:           tt.status,
+				CreatedAt:        now,
+				UpdatedAt:        now,
+				SuspendedUntil:   tt.until,
+				SuspensionReason: "spam",
+			})
+			require.NoError(t, err)
+			userRepo.AddUser(testUser)
+
+			handler := NewLoginHandler(LoginHandlerParams{
+				UserRepository:         userRepo,
+				RoleRepository:         testutil.NewMockRoleRepository(),
+				PermissionRepository:   testutil.NewMockPermissionRepository(),
+				RefreshTokenRepository: testutil.NewMockRefreshTokenRepository(),
+				TokenGenerator:         testutil.NewMockTokenGenerator(),
+				PasswordHasher:         passwordHasher,
+				AccountLockout:         testutil.NewMockAccountLockout(),
+				EventBus:               testutil.NewMockEventBus(),
+				RefreshTokenTTL:        24 * time.Hour,
+				Logger:                 testutil.NewNoopLogger(),
+			})
+
+			passwordHasher.VerifyResult = false
+			_, err = handler.Handle(ctx, LoginCommand{
+				Email:     "test@example.com",
+				Password:  "wrongpassword",
+				IPAddress: net.ParseIP("192.168.1.1"),
+				UserAgent: "Mozilla/5.0",
+			})
+			require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+			assert.NotContains(t, err.Error(), tt.errContains)
+
+			passwordHasher.VerifyResult = true
+			_, err = handler.Handle(ctx, LoginCommand{
+				Email:     "test@example.com",
+				Password:  "correctpassword",
+				IPAddress: net.ParseIP("192.168.1.1"),
+				UserAgent: "Mozilla/5.0",
+			})
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
 func TestLoginHandler_Handle_RecordsFailedAttempts(t *testing.T) {
 	ctx := context.Background()
 	userRepo := testutil.NewMockUserRepository()
@@ -328,3 +407,257 @@ func TestLoginHandler_Handle_PublishesLoginEvent(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, eventBus.PublishedEvents)
 }
+
+func TestLoginHandler_Handle_RecordsLastLogin(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	passwordHasher := testutil.NewMockPasswordHasher()
+
+	now := time.Now().UTC()
+	testUser, _ := user.ReconstructUser(user.ReconstructUserParams{
+		ID:           uuid.New(),
+		Email:        "test@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Test User",
+		Status:       user.StatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	userRepo.AddUser(testUser)
+	passwordHasher.VerifyResult = true
+
+	handler := NewLoginHandler(LoginHandlerParams{
+		UserRepository:         userRepo,
+		RoleRepository:         testutil.NewMockRoleRepository(),
+		PermissionRepository:   testutil.NewMockPermissionRepository(),
+		RefreshTokenRepository: testutil.NewMockRefreshTokenRepository(),
+		TokenGenerator:         testutil.NewMockTokenGenerator(),
+		PasswordHasher:         passwordHasher,
+		RefreshTokenTTL:        24 * time.Hour,
+		Logger:                 testutil.NewNoopLogger(),
+	})
+
+	result, err := handler.Handle(ctx, LoginCommand{
+		Email:     "test@example.com",
+		Password:  "correctpassword",
+		IPAddress: net.ParseIP("192.168.1.1"),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, testUser.LastLoginAt())
+	assert.WithinDuration(t, time.Now(), *testUser.LastLoginAt(), time.Minute)
+	assert.Equal(t, testUser.LastLoginAt(), result.User.LastLoginAt)
+	assert.Equal(t, now, testUser.UpdatedAt())
+}
+
+func TestLoginHandler_Handle_SignsPreferredLocale(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	preferencesRepo := testutil.NewMockPreferencesRepository()
+	passwordHasher := testutil.NewMockPasswordHasher()
+	tokenGenerator := testutil.NewMockTokenGenerator()
+
+	now := time.Now().UTC()
+	testUser, _ := user.ReconstructUser(user.ReconstructUserParams{
+		ID:           uuid.New(),
+		Email:        "test@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Test User",
+		Status:       user.StatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	userRepo.AddUser(testUser)
+	passwordHasher.VerifyResult = true
+
+	handler := NewLoginHandler(LoginHandlerParams{
+		UserRepository:         userRepo,
+		PreferencesRepository:  preferencesRepo,
+		RoleRepository:         testutil.NewMockRoleRepository(),
+		PermissionRepository:   testutil.NewMockPermissionRepository(),
+		RefreshTokenRepository: testutil.NewMockRefreshTokenRepository(),
+		TokenGenerator:         tokenGenerator,
+		PasswordHasher:         passwordHasher,
+		RefreshTokenTTL:        24 * time.Hour,
+		Logger:                 testutil.NewNoopLogger(),
+	})
+	command := LoginCommand{
+		Email:     "test@example.com",
+		Password:  "correctpassword",
+		IPAddress: net.ParseIP("192.168.1.1"),
+	}
+
+	t.Run("leaves the locale to Accept-Language without preferences", func(t *testing.T) {
+		_, err := handler.Handle(ctx, command)
+
+		require.NoError(t, err)
+		assert.Empty(t, tokenGenerator.LastLocale)
+	})
+
+	t.Run("signs the saved locale into the access token", func(t *testing.T) {
+		preferences := user.DefaultPreferences(testUser.ID())
+		vietnamese := "vi"
+		require.NoError(t, preferences.Update(user.UpdatePreferencesParams{Locale: &vietnamese}))
+		require.NoError(t, preferencesRepo.Save(ctx, preferences))
+
+		_, err := handler.Handle(ctx, command)
+
+		require.NoError(t, err)
+		assert.Equal(t, "vi", tokenGenerator.LastLocale)
+	})
+
+	t.Run("still signs in when preferences cannot be read", func(t *testing.T) {
+		preferencesRepo.FindError = errors.New("database error")
+
+		_, err := handler.Handle(ctx, command)
+
+		require.NoError(t, err)
+		assert.Empty(t, tokenGenerator.LastLocale)
+	})
+}
+
+func TestLoginHandler_Handle_OrganizationScope(t *testing.T) {
+	ctx := context.Background()
+	organizationID := uuid.New()
+
+	setup := func(member bool) (*LoginHandler, *testutil.MockTokenGenerator, *testutil.MockRefreshTokenRepository) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		organizationRepo := testutil.NewMockOrganizationRepository()
+		tokenRepo := testutil.NewMockRefreshTokenRepository()
+		tokenGen := testutil.NewMockTokenGenerator()
+		passwordHasher := testutil.NewMockPasswordHasher()
+		passwordHasher.VerifyResult = true
+
+		globalRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+		scopedRole, _ := role.NewRole(role.NewRoleParams{Name: "tenant_admin", DisplayName: "Tenant Admin", OrganizationID: &organizationID})
+		otherOrganizationID := uuid.New()
+		otherRole, _ := role.NewRole(role.NewRoleParams{Name: "other_admin", DisplayName: "Other Admin", OrganizationID: &otherOrganizationID})
+		roleRepo.AddRole(globalRole)
+		roleRepo.AddRole(scopedRole)
+		roleRepo.AddRole(otherRole)
+
+		testUser := testutil.NewUserBuilder().WithEmail("tenant@example.com").Active().MustBuild()
+		_ = testUser.AssignRole(globalRole.ID())
+		_ = testUser.AssignRole(scopedRole.ID())
+		_ = testUser.AssignRole(otherRole.ID())
+		userRepo.AddUser(testUser)
+		if member {
+			organizationRepo.AddMembership(organizationID, testUser.ID())
+		}
+
+		handler := NewLoginHandler(LoginHandlerParams{
+			UserRepository:         userRepo,
+			RoleRepository:         roleRepo,
+			PermissionRepository:   testutil.NewMockPermissionRepository(),
+			OrganizationRepository: organizationRepo,
+			RefreshTokenRepository: tokenRepo,
+			TokenGenerator:         tokenGen,
+			PasswordHasher:         passwordHasher,
+			EventBus:               testutil.NewMockEventBus(),
+			RefreshTokenTTL:        24 * time.Hour,
+			Logger:                 testutil.NewNoopLogger(),
+		})
+		return handler, tokenGen, tokenRepo
+	}
+
+	t.Run("issues token scoped to organization", func(t *testing.T) {
+		handler, tokenGen, tokenRepo := setup(true)
+
+		result, err := handler.Handle(ctx, LoginCommand{
+			Email:          "tenant@example.com",
+			Password:       "correctpassword",
+			OrganizationID: &organizationID,
+			IPAddress:      net.ParseIP("192.168.1.1"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, &organizationID, result.OrganizationID)
+		assert.Equal(t, &organizationID, tokenGen.LastOrganizationID)
+		assert.ElementsMatch(t, []string{"viewer", "tenant_admin"}, tokenGen.LastRoles)
+		for _, token := range tokenRepo.Tokens {
+			assert.Equal(t, &organizationID, token.OrganizationID())
+		}
+	})
+
+	t.Run("excludes organization roles without tenant", func(t *testing.T) {
+		handler, tokenGen, _ := setup(true)
+
+		_, err := handler.Handle(ctx, LoginCommand{
+			Email:    "tenant@example.com",
+			Password: "correctpassword",
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, tokenGen.LastOrganizationID)
+		assert.Equal(t, []string{"viewer"}, tokenGen.LastRoles)
+	})
+
+	t.Run("rejects non member", func(t *testing.T) {
+		handler, _, _ := setup(false)
+
+		result, err := handler.Handle(ctx, LoginCommand{
+			Email:          "tenant@example.com",
+			Password:       "correctpassword",
+			OrganizationID: &organizationID,
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, organization.ErrNotMember)
+	})
+}
+
+func TestLoginHandler_Handle_IncludesGroupRoles(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	groupRepo := testutil.NewMockGroupRepository()
+	permRepo := testutil.NewMockPermissionRepository()
+	tokenGen := testutil.NewMockTokenGenerator()
+	passwordHasher := testutil.NewMockPasswordHasher()
+	passwordHasher.VerifyResult = true
+
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	permRepo.AddPermission(readPerm)
+	permRepo.AddPermission(deletePerm)
+
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+	moderatorRole, _ := role.NewRole(role.NewRoleParams{Name: "moderator", DisplayName: "Moderator", PermissionIDs: []uuid.UUID{deletePerm.ID()}})
+	roleRepo.AddRole(viewerRole)
+	roleRepo.AddRole(moderatorRole)
+
+	testUser := testutil.NewUserBuilder().WithEmail("grouped@example.com").Active().MustBuild()
+	_ = testUser.AssignRole(viewerRole.ID())
+	userRepo.AddUser(testUser)
+
+	moderators, _ := group.NewGroup(group.NewGroupParams{Name: "Moderators", RoleIDs: []uuid.UUID{moderatorRole.ID()}})
+	groupRepo.AddGroup(moderators)
+	groupRepo.AddMembership(moderators.ID(), testUser.ID())
+
+	handler := NewLoginHandler(LoginHandlerParams{
+		UserRepository:         userRepo,
+		RoleRepository:         roleRepo,
+		GroupRepository:        groupRepo,
+		PermissionRepository:   permRepo,
+		OrganizationRepository: testutil.NewMockOrganizationRepository(),
+		RefreshTokenRepository: testutil.NewMockRefreshTokenRepository(),
+		TokenGenerator:         tokenGen,
+		PasswordHasher:         passwordHasher,
+		EventBus:               testutil.NewMockEventBus(),
+		RefreshTokenTTL:        24 * time.Hour,
+		Logger:                 testutil.NewNoopLogger(),
+	})
+
+	_, err := handler.Handle(ctx, LoginCommand{
+		Email:     "grouped@example.com",
+		Password:  "correctpassword",
+		IPAddress: net.ParseIP("192.168.1.1"),
+	})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"viewer", "moderator"}, tokenGen.LastRoles)
+	assert.ElementsMatch(t, []string{"users:read", "users:delete"}, tokenGen.LastPermissions)
+}
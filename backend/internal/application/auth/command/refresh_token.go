@@ -11,6 +11,9 @@ import (
 	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -26,8 +29,12 @@ type RefreshTokenCommand struct {
 
 type RefreshTokenHandler struct {
 	userRepository         user.Repository
+	preferencesRepository  user.PreferencesRepository
 	roleRepository         role.Repository
+	groupRepository        group.Repository
 	permissionRepository   permission.Repository
+	accessResolver         *authz.Resolver
+	organizationRepository organization.Repository
 	refreshTokenRepository auth.RefreshTokenRepository
 	tokenGenerator         auth.TokenGenerator
 	tokenBlacklist         auth.TokenBlacklist
@@ -38,8 +45,11 @@ type RefreshTokenHandler struct {
 
 type RefreshTokenHandlerParams struct {
 	UserRepository         user.Repository
+	PreferencesRepository  user.PreferencesRepository
 	RoleRepository         role.Repository
+	GroupRepository        group.Repository
 	PermissionRepository   permission.Repository
+	OrganizationRepository organization.Repository
 	RefreshTokenRepository auth.RefreshTokenRepository
 	TokenGenerator         auth.TokenGenerator
 	TokenBlacklist         auth.TokenBlacklist
@@ -51,8 +61,12 @@ type RefreshTokenHandlerParams struct {
 func NewRefreshTokenHandler(params RefreshTokenHandlerParams) *RefreshTokenHandler {
 	return &RefreshTokenHandler{
 		userRepository:         params.UserRepository,
+		preferencesRepository:  params.PreferencesRepository,
 		roleRepository:         params.RoleRepository,
+		groupRepository:        params.GroupRepository,
 		permissionRepository:   params.PermissionRepository,
+		accessResolver:         authz.NewResolver(params.RoleRepository, params.GroupRepository, params.PermissionRepository),
+		organizationRepository: params.OrganizationRepository,
 		refreshTokenRepository: params.RefreshTokenRepository,
 		tokenGenerator:         params.TokenGenerator,
 		tokenBlacklist:         params.TokenBlacklist,
@@ -79,21 +93,35 @@ func (handler *RefreshTokenHandler) Handle(ctx context.Context, command RefreshT
 		return nil, auth.ErrRefreshTokenInvalid
 	}
 
-	if !existingUser.Status().IsActive() {
-		return nil, auth.ErrAccountInactive
+	if err := accountStatusError(existingUser); err != nil {
+		return nil, err
+	}
+
+	organizationID := existingToken.OrganizationID()
+	if organizationID != nil {
+		isMember, err := handler.organizationRepository.IsMember(ctx, *organizationID, existingUser.ID())
+		if err != nil {
+			return nil, fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, organization.ErrNotMember
+		}
 	}
 
 	if err := handler.refreshTokenRepository.Revoke(ctx, existingToken.ID()); err != nil {
 		return nil, fmt.Errorf("revoke old refresh token: %w", err)
 	}
 
-	roles, permissions := handler.loadUserRolesAndPermissions(ctx, existingUser)
+	roles, permissions, deniedPermissions := handler.loadUserRolesAndPermissions(ctx, existingUser, organizationID)
 
-	accessToken, err := handler.tokenGenerator.GenerateAccessToken(
+	accessToken, err := handler.tokenGenerator.GenerateOrganizationAccessToken(
 		existingUser.ID(),
 		existingUser.Email().String(),
+		organizationID,
 		roles,
 		permissions,
+		deniedPermissions,
+		preferredLocale(ctx, handler.preferencesRepository, handler.logger, existingUser.ID()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
@@ -110,11 +138,12 @@ func (handler *RefreshTokenHandler) Handle(ctx context.Context, command RefreshT
 	}
 
 	newRefreshToken, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
-		UserID:     existingUser.ID(),
-		TokenHash:  newRefreshTokenHash,
-		ExpiresAt:  time.Now().UTC().Add(handler.refreshTokenTTL),
-		DeviceInfo: deviceInfo,
-		IPAddress:  command.IPAddress,
+		UserID:         existingUser.ID(),
+		TokenHash:      newRefreshTokenHash,
+		ExpiresAt:      time.Now().UTC().Add(handler.refreshTokenTTL),
+		DeviceInfo:     deviceInfo,
+		IPAddress:      command.IPAddress,
+		OrganizationID: organizationID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create refresh token: %w", err)
@@ -143,60 +172,23 @@ func (handler *RefreshTokenHandler) Handle(ctx context.Context, command RefreshT
 	)
 
 	return &authdto.AuthResponseDTO{
-		User:         userdto.UserFromDomain(existingUser),
-		AccessToken:  accessToken.Token(),
-		RefreshToken: newRefreshTokenString,
-		ExpiresAt:    accessToken.ExpiresAt(),
+		User:           userdto.UserFromDomain(existingUser),
+		AccessToken:    accessToken.Token(),
+		RefreshToken:   newRefreshTokenString,
+		ExpiresAt:      accessToken.ExpiresAt(),
+		OrganizationID: organizationID,
 	}, nil
 }
 
-func (handler *RefreshTokenHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User) ([]string, []string) {
-	roleIDs := domainUser.RoleIDs()
-	if len(roleIDs) == 0 {
-		return []string{}, []string{}
-	}
-
-	roles, err := handler.roleRepository.FindByIDs(ctx, roleIDs)
-	if err != nil {
-		handler.logger.Error("failed to load user roles",
-			logger.String("user_id", domainUser.ID().String()),
-			logger.Err(err),
-		)
-		return []string{}, []string{}
-	}
-
-	roleNames := make([]string, 0, len(roles))
-	permissionIDSet := make(map[uuid.UUID]bool)
-
-	for _, roleEntity := range roles {
-		roleNames = append(roleNames, roleEntity.Name())
-		for _, permissionID := range roleEntity.PermissionIDs() {
-			permissionIDSet[permissionID] = true
-		}
-	}
-
-	if len(permissionIDSet) == 0 {
-		return roleNames, []string{}
-	}
-
-	permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
-	for permissionID := range permissionIDSet {
-		permissionIDs = append(permissionIDs, permissionID)
-	}
-
-	permissions, err := handler.permissionRepository.FindByIDs(ctx, permissionIDs)
+func (handler *RefreshTokenHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User, organizationID *uuid.UUID) ([]string, []string, []string) {
+	access, err := handler.accessResolver.Resolve(ctx, domainUser, organizationID)
 	if err != nil {
-		handler.logger.Error("failed to load permissions",
+		handler.logger.Error("failed to resolve user access",
 			logger.String("user_id", domainUser.ID().String()),
 			logger.Err(err),
 		)
-		return roleNames, []string{}
-	}
-
-	permissionCodes := make([]string, 0, len(permissions))
-	for _, permissionEntity := range permissions {
-		permissionCodes = append(permissionCodes, permissionEntity.Code().String())
+		return []string{}, []string{}, []string{}
 	}
 
-	return roleNames, permissionCodes
+	return access.RoleNames(), access.PermissionCodes(), access.DeniedPermissionCodes()
 }
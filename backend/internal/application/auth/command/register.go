@@ -6,11 +6,10 @@ import (
 	"net"
 	"time"
 
-	"github.com/google/uuid"
-
 	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -30,12 +29,13 @@ type RegisterCommand struct {
 type RegisterHandler struct {
 	userRepository         user.Repository
 	roleRepository         role.Repository
-	permissionRepository   permission.Repository
 	refreshTokenRepository auth.RefreshTokenRepository
+	accessResolver         *authz.Resolver
 	tokenGenerator         auth.TokenGenerator
 	passwordHasher         security.PasswordHasher
 	eventBus               shared.EventBus
 	refreshTokenTTL        time.Duration
+	inviteOnly             bool
 	logger                 logger.Logger
 }
 
@@ -48,24 +48,32 @@ type RegisterHandlerParams struct {
 	PasswordHasher         security.PasswordHasher
 	EventBus               shared.EventBus
 	RefreshTokenTTL        time.Duration
-	Logger                 logger.Logger
+	// InviteOnly rejects every registration; accounts are then created by
+	// accepting an invitation.
+	InviteOnly bool
+	Logger     logger.Logger
 }
 
 func NewRegisterHandler(params RegisterHandlerParams) *RegisterHandler {
 	return &RegisterHandler{
 		userRepository:         params.UserRepository,
 		roleRepository:         params.RoleRepository,
-		permissionRepository:   params.PermissionRepository,
 		refreshTokenRepository: params.RefreshTokenRepository,
+		accessResolver:         authz.NewResolver(params.RoleRepository, nil, params.PermissionRepository),
 		tokenGenerator:         params.TokenGenerator,
 		passwordHasher:         params.PasswordHasher,
 		eventBus:               params.EventBus,
 		refreshTokenTTL:        params.RefreshTokenTTL,
+		inviteOnly:             params.InviteOnly,
 		logger:                 params.Logger,
 	}
 }
 
 func (handler *RegisterHandler) Handle(ctx context.Context, command RegisterCommand) (*authdto.AuthResponseDTO, error) {
+	if handler.inviteOnly {
+		return nil, auth.ErrRegistrationDisabled
+	}
+
 	if err := shared.ValidatePassword(command.Password); err != nil {
 		return nil, err
 	}
@@ -116,13 +124,14 @@ func (handler *RegisterHandler) Handle(ctx context.Context, command RegisterComm
 		return nil, fmt.Errorf("save user: %w", err)
 	}
 
-	roles, permissions := handler.loadUserRolesAndPermissions(ctx, newUser)
+	roles, permissions, deniedPermissions := handler.loadUserRolesAndPermissions(ctx, newUser)
 
 	accessToken, err := handler.tokenGenerator.GenerateAccessToken(
 		newUser.ID(),
 		newUser.Email().String(),
 		roles,
 		permissions,
+		deniedPermissions,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
@@ -183,53 +192,15 @@ func (handler *RegisterHandler) Handle(ctx context.Context, command RegisterComm
 	}, nil
 }
 
-func (handler *RegisterHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User) ([]string, []string) {
-	roleIDs := domainUser.RoleIDs()
-	if len(roleIDs) == 0 {
-		return []string{}, []string{}
-	}
-
-	roles, err := handler.roleRepository.FindByIDs(ctx, roleIDs)
+func (handler *RegisterHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User) ([]string, []string, []string) {
+	access, err := handler.accessResolver.Resolve(ctx, domainUser, nil)
 	if err != nil {
-		handler.logger.Error("failed to load user roles",
+		handler.logger.Error("failed to resolve user access",
 			logger.String("user_id", domainUser.ID().String()),
 			logger.Err(err),
 		)
-		return []string{}, []string{}
-	}
-
-	roleNames := make([]string, 0, len(roles))
-	permissionIDSet := make(map[uuid.UUID]bool)
-
-	for _, roleEntity := range roles {
-		roleNames = append(roleNames, roleEntity.Name())
-		for _, permissionID := range roleEntity.PermissionIDs() {
-			permissionIDSet[permissionID] = true
-		}
-	}
-
-	if len(permissionIDSet) == 0 {
-		return roleNames, []string{}
-	}
-
-	permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
-	for permissionID := range permissionIDSet {
-		permissionIDs = append(permissionIDs, permissionID)
-	}
-
-	permissions, err := handler.permissionRepository.FindByIDs(ctx, permissionIDs)
-	if err != nil {
-		handler.logger.Error("failed to load permissions",
-			logger.String("user_id", domainUser.ID().String()),
-			logger.Err(err),
-		)
-		return roleNames, []string{}
-	}
-
-	permissionCodes := make([]string, 0, len(permissions))
-	for _, permissionEntity := range permissions {
-		permissionCodes = append(permissionCodes, permissionEntity.Code().String())
+		return []string{}, []string{}, []string{}
 	}
 
-	return roleNames, permissionCodes
+	return access.RoleNames(), access.PermissionCodes(), access.DeniedPermissionCodes()
 }
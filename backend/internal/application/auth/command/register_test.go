@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -253,3 +254,31 @@ func TestRegisterHandler_Handle_PublishesEvents(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, eventBus.PublishedEvents)
 }
+
+func TestRegisterHandler_Handle_InviteOnly(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+
+	handler := NewRegisterHandler(RegisterHandlerParams{
+		UserRepository:         userRepo,
+		RoleRepository:         testutil.NewMockRoleRepository(),
+		PermissionRepository:   testutil.NewMockPermissionRepository(),
+		RefreshTokenRepository: testutil.NewMockRefreshTokenRepository(),
+		TokenGenerator:         testutil.NewMockTokenGenerator(),
+		PasswordHasher:         testutil.NewMockPasswordHasher(),
+		EventBus:               testutil.NewMockEventBus(),
+		RefreshTokenTTL:        24 * time.Hour,
+		InviteOnly:             true,
+		Logger:                 testutil.NewNoopLogger(),
+	})
+
+	result, err := handler.Handle(ctx, RegisterCommand{
+		Email:    "newuser@example.com",
+		Password: "SecurePass123!",
+		FullName: "New User",
+	})
+
+	assert.ErrorIs(t, err, auth.ErrRegistrationDisabled)
+	assert.Nil(t, result)
+	assert.Empty(t, userRepo.Users)
+}
@@ -0,0 +1,195 @@
+package authcommand
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+
+	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type SwitchOrganizationCommand struct {
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
+	RefreshToken   string
+	IPAddress      net.IP
+	UserAgent      string
+}
+
+type SwitchOrganizationHandler struct {
+	userRepository         user.Repository
+	preferencesRepository  user.PreferencesRepository
+	roleRepository         role.Repository
+	groupRepository        group.Repository
+	permissionRepository   permission.Repository
+	accessResolver         *authz.Resolver
+	organizationRepository organization.Repository
+	refreshTokenRepository auth.RefreshTokenRepository
+	tokenGenerator         auth.TokenGenerator
+	eventBus               shared.EventBus
+	refreshTokenTTL        time.Duration
+	logger                 logger.Logger
+}
+
+type SwitchOrganizationHandlerParams struct {
+	UserRepository         user.Repository
+	PreferencesRepository  user.PreferencesRepository
+	RoleRepository         role.Repository
+	GroupRepository        group.Repository
+	PermissionRepository   permission.Repository
+	OrganizationRepository organization.Repository
+	RefreshTokenRepository auth.RefreshTokenRepository
+	TokenGenerator         auth.TokenGenerator
+	EventBus               shared.EventBus
+	RefreshTokenTTL        time.Duration
+	Logger                 logger.Logger
+}
+
+func NewSwitchOrganizationHandler(params SwitchOrganizationHandlerParams) *SwitchOrganizationHandler {
+	return &SwitchOrganizationHandler{
+		userRepository:         params.UserRepository,
+		preferencesRepository:  params.PreferencesRepository,
+		roleRepository:         params.RoleRepository,
+		groupRepository:        params.GroupRepository,
+		permissionRepository:   params.PermissionRepository,
+		accessResolver:         authz.NewResolver(params.RoleRepository, params.GroupRepository, params.PermissionRepository),
+		organizationRepository: params.OrganizationRepository,
+		refreshTokenRepository: params.RefreshTokenRepository,
+		tokenGenerator:         params.TokenGenerator,
+		eventBus:               params.EventBus,
+		refreshTokenTTL:        params.RefreshTokenTTL,
+		logger:                 params.Logger,
+	}
+}
+
+func (handler *SwitchOrganizationHandler) Handle(ctx context.Context, command SwitchOrganizationCommand) (*authdto.AuthResponseDTO, error) {
+	tokenHash := handler.tokenGenerator.HashRefreshToken(command.RefreshToken)
+
+	existingToken, err := handler.refreshTokenRepository.FindByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, auth.ErrRefreshTokenInvalid
+	}
+
+	if !existingToken.IsValid() || existingToken.UserID() != command.UserID {
+		return nil, auth.ErrRefreshTokenInvalid
+	}
+
+	existingUser, err := handler.userRepository.FindByID(ctx, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := accountStatusError(existingUser); err != nil {
+		return nil, err
+	}
+
+	if command.OrganizationID != nil {
+		if _, err := handler.organizationRepository.FindByID(ctx, *command.OrganizationID); err != nil {
+			return nil, err
+		}
+		isMember, err := handler.organizationRepository.IsMember(ctx, *command.OrganizationID, existingUser.ID())
+		if err != nil {
+			return nil, fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isMember {
+			return nil, organization.ErrNotMember
+		}
+	}
+
+	if err := handler.refreshTokenRepository.Revoke(ctx, existingToken.ID()); err != nil {
+		return nil, fmt.Errorf("revoke old refresh token: %w", err)
+	}
+
+	roles, permissions, deniedPermissions := handler.loadUserRolesAndPermissions(ctx, existingUser, command.OrganizationID)
+
+	accessToken, err := handler.tokenGenerator.GenerateOrganizationAccessToken(
+		existingUser.ID(),
+		existingUser.Email().String(),
+		command.OrganizationID,
+		roles,
+		permissions,
+		deniedPermissions,
+		preferredLocale(ctx, handler.preferencesRepository, handler.logger, existingUser.ID()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate access token: %w", err)
+	}
+
+	newRefreshTokenString, err := handler.tokenGenerator.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	newRefreshToken, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+		UserID:         existingUser.ID(),
+		TokenHash:      handler.tokenGenerator.HashRefreshToken(newRefreshTokenString),
+		ExpiresAt:      time.Now().UTC().Add(handler.refreshTokenTTL),
+		DeviceInfo:     &auth.DeviceInfo{UserAgent: command.UserAgent},
+		IPAddress:      command.IPAddress,
+		OrganizationID: command.OrganizationID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create refresh token: %w", err)
+	}
+
+	if err := handler.refreshTokenRepository.Create(ctx, newRefreshToken); err != nil {
+		return nil, fmt.Errorf("save refresh token: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := auth.NewRefreshTokenRotatedEvent(
+			existingUser.ID(),
+			existingToken.ID(),
+			newRefreshToken.ID(),
+		)
+		if err := handler.eventBus.Publish(ctx, event); err != nil {
+			handler.logger.Error("failed to publish refresh token rotated event",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	organizationIDValue := ""
+	if command.OrganizationID != nil {
+		organizationIDValue = command.OrganizationID.String()
+	}
+	handler.logger.Info("user switched organization",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.String("organization_id", organizationIDValue),
+	)
+
+	return &authdto.AuthResponseDTO{
+		User:           userdto.UserFromDomain(existingUser),
+		AccessToken:    accessToken.Token(),
+		RefreshToken:   newRefreshTokenString,
+		ExpiresAt:      accessToken.ExpiresAt(),
+		OrganizationID: command.OrganizationID,
+	}, nil
+}
+
+func (handler *SwitchOrganizationHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User, organizationID *uuid.UUID) ([]string, []string, []string) {
+	access, err := handler.accessResolver.Resolve(ctx, domainUser, organizationID)
+	if err != nil {
+		handler.logger.Error("failed to resolve user access",
+			logger.String("user_id", domainUser.ID().String()),
+			logger.Err(err),
+		)
+		return []string{}, []string{}, []string{}
+	}
+
+	return access.RoleNames(), access.PermissionCodes(), access.DeniedPermissionCodes()
+}
@@ -0,0 +1,165 @@
+package authcommand
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestSwitchOrganizationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	createOrganization := func() *organization.Organization {
+		o, _ := organization.NewOrganization(organization.NewOrganizationParams{
+			Name: "Acme Corp",
+			Slug: "acme",
+		})
+		return o
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockUserRepository, *testutil.MockOrganizationRepository, *testutil.MockRefreshTokenRepository, *testutil.MockTokenGenerator) SwitchOrganizationCommand
+		wantErr     error
+		checkResult func(*testing.T, *testutil.MockRefreshTokenRepository, *testutil.MockTokenGenerator, SwitchOrganizationCommand)
+	}{
+		{
+			name: "successfully switch to member organization",
+			setupMocks: func(userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository, tokenRepo *testutil.MockRefreshTokenRepository, tokenGen *testutil.MockTokenGenerator) SwitchOrganizationCommand {
+				testUser := testutil.CreateActiveUser()
+				userRepo.AddUser(testUser)
+				o := createOrganization()
+				organizationRepo.AddOrganization(o)
+				organizationRepo.AddMembership(o.ID(), testUser.ID())
+
+				token, _ := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+					UserID:    testUser.ID(),
+					TokenHash: "old_hash",
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				})
+				tokenRepo.Tokens[token.ID()] = token
+				tokenRepo.HashIndex["old_hash"] = token
+				tokenGen.RefreshTokenHash = "old_hash"
+
+				organizationID := o.ID()
+				return SwitchOrganizationCommand{
+					UserID:         testUser.ID(),
+					OrganizationID: &organizationID,
+					RefreshToken:   "old_refresh_token",
+					IPAddress:      net.ParseIP("192.168.1.1"),
+				}
+			},
+			checkResult: func(t *testing.T, tokenRepo *testutil.MockRefreshTokenRepository, tokenGen *testutil.MockTokenGenerator, command SwitchOrganizationCommand) {
+				assert.Equal(t, command.OrganizationID, tokenGen.LastOrganizationID)
+				revokedCount := 0
+				scopedCount := 0
+				for _, token := range tokenRepo.Tokens {
+					if token.IsRevoked() {
+						revokedCount++
+					}
+					if token.OrganizationID() != nil && *token.OrganizationID() == *command.OrganizationID {
+						scopedCount++
+					}
+				}
+				assert.Equal(t, 1, revokedCount)
+				assert.Equal(t, 1, scopedCount)
+			},
+		},
+		{
+			name: "fail when user is not a member",
+			setupMocks: func(userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository, tokenRepo *testutil.MockRefreshTokenRepository, tokenGen *testutil.MockTokenGenerator) SwitchOrganizationCommand {
+				testUser := testutil.CreateActiveUser()
+				userRepo.AddUser(testUser)
+				o := createOrganization()
+				organizationRepo.AddOrganization(o)
+
+				token, _ := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+					UserID:    testUser.ID(),
+					TokenHash: "old_hash",
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				})
+				tokenRepo.Tokens[token.ID()] = token
+				tokenRepo.HashIndex["old_hash"] = token
+				tokenGen.RefreshTokenHash = "old_hash"
+
+				organizationID := o.ID()
+				return SwitchOrganizationCommand{
+					UserID:         testUser.ID(),
+					OrganizationID: &organizationID,
+					RefreshToken:   "old_refresh_token",
+				}
+			},
+			wantErr: organization.ErrNotMember,
+		},
+		{
+			name: "fail when refresh token belongs to another user",
+			setupMocks: func(userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository, tokenRepo *testutil.MockRefreshTokenRepository, tokenGen *testutil.MockTokenGenerator) SwitchOrganizationCommand {
+				testUser := testutil.CreateActiveUser()
+				userRepo.AddUser(testUser)
+
+				token, _ := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+					UserID:    uuid.New(),
+					TokenHash: "old_hash",
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				})
+				tokenRepo.Tokens[token.ID()] = token
+				tokenRepo.HashIndex["old_hash"] = token
+				tokenGen.RefreshTokenHash = "old_hash"
+
+				return SwitchOrganizationCommand{
+					UserID:       testUser.ID(),
+					RefreshToken: "old_refresh_token",
+				}
+			},
+			wantErr: auth.ErrRefreshTokenInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := testutil.NewMockUserRepository()
+			organizationRepo := testutil.NewMockOrganizationRepository()
+			tokenRepo := testutil.NewMockRefreshTokenRepository()
+			tokenGen := testutil.NewMockTokenGenerator()
+
+			command := tt.setupMocks(userRepo, organizationRepo, tokenRepo, tokenGen)
+
+			handler := NewSwitchOrganizationHandler(SwitchOrganizationHandlerParams{
+				UserRepository:         userRepo,
+				RoleRepository:         testutil.NewMockRoleRepository(),
+				PermissionRepository:   testutil.NewMockPermissionRepository(),
+				OrganizationRepository: organizationRepo,
+				RefreshTokenRepository: tokenRepo,
+				TokenGenerator:         tokenGen,
+				EventBus:               testutil.NewMockEventBus(),
+				RefreshTokenTTL:        24 * time.Hour,
+				Logger:                 testutil.NewNoopLogger(),
+			})
+
+			result, err := handler.Handle(ctx, command)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, command.OrganizationID, result.OrganizationID)
+			if tt.checkResult != nil {
+				tt.checkResult(t, tokenRepo, tokenGen, command)
+			}
+		})
+	}
+}
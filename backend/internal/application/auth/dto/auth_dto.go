@@ -10,10 +10,11 @@ import (
 )
 
 type AuthResponseDTO struct {
-	User         *userdto.UserDTO `json:"user"`
-	AccessToken  string           `json:"access_token"`
-	RefreshToken string           `json:"refresh_token"`
-	ExpiresAt    time.Time        `json:"expires_at"`
+	User           *userdto.UserDTO `json:"user"`
+	AccessToken    string           `json:"access_token"`
+	RefreshToken   string           `json:"refresh_token"`
+	ExpiresAt      time.Time        `json:"expires_at"`
+	OrganizationID *uuid.UUID       `json:"organization_id,omitempty"`
 }
 
 type TokenPairDTO struct {
@@ -77,12 +78,14 @@ func SessionsFromRefreshTokens(tokens []*auth.RefreshToken, currentTokenID uuid.
 }
 
 type AuthUserDTO struct {
-	ID          uuid.UUID `json:"id"`
-	Email       string    `json:"email"`
-	FullName    string    `json:"full_name"`
-	Status      string    `json:"status"`
-	Roles       []string  `json:"roles"`
-	Permissions []string  `json:"permissions"`
+	ID                uuid.UUID  `json:"id"`
+	Email             string     `json:"email"`
+	FullName          string     `json:"full_name"`
+	Status            string     `json:"status"`
+	OrganizationID    *uuid.UUID `json:"organization_id,omitempty"`
+	Roles             []string   `json:"roles"`
+	Permissions       []string   `json:"permissions"`
+	DeniedPermissions []string   `json:"denied_permissions"`
 }
 
 type ClaimsDTO struct {
@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 
 	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
@@ -14,19 +16,23 @@ import (
 )
 
 type GetCurrentUserQuery struct {
-	UserID uuid.UUID
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
 }
 
 type GetCurrentUserHandler struct {
 	userRepository       user.Repository
 	roleRepository       role.Repository
+	groupRepository      group.Repository
 	permissionRepository permission.Repository
+	accessResolver       *authz.Resolver
 	logger               logger.Logger
 }
 
 type GetCurrentUserHandlerParams struct {
 	UserRepository       user.Repository
 	RoleRepository       role.Repository
+	GroupRepository      group.Repository
 	PermissionRepository permission.Repository
 	Logger               logger.Logger
 }
@@ -35,7 +41,9 @@ func NewGetCurrentUserHandler(params GetCurrentUserHandlerParams) *GetCurrentUse
 	return &GetCurrentUserHandler{
 		userRepository:       params.UserRepository,
 		roleRepository:       params.RoleRepository,
+		groupRepository:      params.GroupRepository,
 		permissionRepository: params.PermissionRepository,
+		accessResolver:       authz.NewResolver(params.RoleRepository, params.GroupRepository, params.PermissionRepository),
 		logger:               params.Logger,
 	}
 }
@@ -46,65 +54,29 @@ func (handler *GetCurrentUserHandler) Handle(ctx context.Context, query GetCurre
 		return nil, fmt.Errorf("find user: %w", err)
 	}
 
-	roleNames, permissions := handler.loadUserRolesAndPermissions(ctx, existingUser)
+	roleNames, permissions, deniedPermissions := handler.loadUserRolesAndPermissions(ctx, existingUser, query.OrganizationID)
 
 	return &authdto.AuthUserDTO{
-		ID:          existingUser.ID(),
-		Email:       existingUser.Email().String(),
-		FullName:    existingUser.FullName().String(),
-		Status:      existingUser.Status().String(),
-		Roles:       roleNames,
-		Permissions: permissions,
+		ID:                existingUser.ID(),
+		Email:             existingUser.Email().String(),
+		FullName:          existingUser.FullName().String(),
+		Status:            existingUser.Status().String(),
+		OrganizationID:    query.OrganizationID,
+		Roles:             roleNames,
+		Permissions:       permissions,
+		DeniedPermissions: deniedPermissions,
 	}, nil
 }
 
-func (handler *GetCurrentUserHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User) ([]string, []string) {
-	roleIDs := domainUser.RoleIDs()
-	if len(roleIDs) == 0 {
-		return []string{}, []string{}
-	}
-
-	roles, err := handler.roleRepository.FindByIDs(ctx, roleIDs)
+func (handler *GetCurrentUserHandler) loadUserRolesAndPermissions(ctx context.Context, domainUser *user.User, organizationID *uuid.UUID) ([]string, []string, []string) {
+	access, err := handler.accessResolver.Resolve(ctx, domainUser, organizationID)
 	if err != nil {
-		handler.logger.Error("failed to load user roles",
+		handler.logger.Error("failed to resolve user access",
 			logger.String("user_id", domainUser.ID().String()),
 			logger.Err(err),
 		)
-		return []string{}, []string{}
-	}
-
-	roleNames := make([]string, 0, len(roles))
-	permissionIDSet := make(map[uuid.UUID]bool)
-
-	for _, roleEntity := range roles {
-		roleNames = append(roleNames, roleEntity.Name())
-		for _, permissionID := range roleEntity.PermissionIDs() {
-			permissionIDSet[permissionID] = true
-		}
-	}
-
-	if len(permissionIDSet) == 0 {
-		return roleNames, []string{}
-	}
-
-	permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
-	for permissionID := range permissionIDSet {
-		permissionIDs = append(permissionIDs, permissionID)
-	}
-
-	permissions, err := handler.permissionRepository.FindByIDs(ctx, permissionIDs)
-	if err != nil {
-		handler.logger.Error("failed to load permissions",
-			logger.String("user_id", domainUser.ID().String()),
-			logger.Err(err),
-		)
-		return roleNames, []string{}
-	}
-
-	permissionCodes := make([]string, 0, len(permissions))
-	for _, permissionEntity := range permissions {
-		permissionCodes = append(permissionCodes, permissionEntity.Code().String())
+		return []string{}, []string{}, []string{}
 	}
 
-	return roleNames, permissionCodes
+	return access.RoleNames(), access.PermissionCodes(), access.DeniedPermissionCodes()
 }
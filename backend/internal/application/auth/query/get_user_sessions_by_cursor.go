@@ -0,0 +1,57 @@
+package authquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// GetUserSessionsByCursorQuery pages through the sessions
+// GetUserSessionsQuery returns in one go. An empty Cursor requests the
+// first page.
+type GetUserSessionsByCursorQuery struct {
+	UserID         uuid.UUID
+	CurrentTokenID uuid.UUID
+	Cursor         string
+	Limit          int
+	IncludeTotal   bool
+}
+
+type GetUserSessionsByCursorHandler struct {
+	refreshTokenRepository auth.RefreshTokenRepository
+	logger                 logger.Logger
+}
+
+type GetUserSessionsByCursorHandlerParams struct {
+	RefreshTokenRepository auth.RefreshTokenRepository
+	Logger                 logger.Logger
+}
+
+func NewGetUserSessionsByCursorHandler(params GetUserSessionsByCursorHandlerParams) *GetUserSessionsByCursorHandler {
+	return &GetUserSessionsByCursorHandler{
+		refreshTokenRepository: params.RefreshTokenRepository,
+		logger:                 params.Logger,
+	}
+}
+
+func (handler *GetUserSessionsByCursorHandler) Handle(ctx context.Context, query GetUserSessionsByCursorQuery) (shared.CursorResult[*authdto.SessionDTO], error) {
+	page, err := shared.NewCursorPage(query.Cursor, query.Limit, query.IncludeTotal)
+	if err != nil {
+		return shared.CursorResult[*authdto.SessionDTO]{}, err
+	}
+
+	result, err := handler.refreshTokenRepository.ListActiveByCursor(ctx, query.UserID, page)
+	if err != nil {
+		return shared.CursorResult[*authdto.SessionDTO]{}, fmt.Errorf("list active refresh tokens: %w", err)
+	}
+
+	return shared.MapCursorResult(result, func(token *auth.RefreshToken) *authdto.SessionDTO {
+		return authdto.SessionFromRefreshToken(token, query.CurrentTokenID)
+	}), nil
+}
@@ -0,0 +1,59 @@
+package authquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestGetUserSessionsByCursorHandler_Handle(t *testing.T) {
+	tokenRepo := testutil.NewMockRefreshTokenRepository()
+	userID := uuid.New()
+	now := time.Now().UTC()
+	tokens := make([]*auth.RefreshToken, 3)
+	for i := range tokens {
+		tokens[i] = auth.ReconstructRefreshToken(auth.ReconstructRefreshTokenParams{
+			ID:        uuid.New(),
+			UserID:    userID,
+			TokenHash: "hash_" + uuid.New().String(),
+			ExpiresAt: now.Add(24 * time.Hour),
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		})
+		tokenRepo.Tokens[tokens[i].ID()] = tokens[i]
+	}
+	tokenRepo.UserTokens[userID] = tokens
+
+	handler := NewGetUserSessionsByCursorHandler(GetUserSessionsByCursorHandlerParams{
+		RefreshTokenRepository: tokenRepo,
+		Logger:                 testutil.NewNoopLogger(),
+	})
+
+	first, err := handler.Handle(context.Background(), GetUserSessionsByCursorQuery{
+		UserID:         userID,
+		CurrentTokenID: tokens[2].ID(),
+		Limit:          2,
+		IncludeTotal:   true,
+	})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, tokens[2].ID(), first.Items[0].ID)
+	assert.True(t, first.Items[0].IsCurrent)
+	assert.Equal(t, int64(3), *first.Total)
+
+	second, err := handler.Handle(context.Background(), GetUserSessionsByCursorQuery{
+		UserID: userID,
+		Limit:  2,
+		Cursor: first.NextCursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, tokens[0].ID(), second.Items[0].ID)
+	assert.Empty(t, second.NextCursor)
+}
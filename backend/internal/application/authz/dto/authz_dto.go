@@ -0,0 +1,119 @@
+package authzdto
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+)
+
+type GrantDTO struct {
+	RoleID            uuid.UUID  `json:"role_id"`
+	RoleName          string     `json:"role_name"`
+	OrganizationID    *uuid.UUID `json:"organization_id,omitempty"`
+	Source            string     `json:"source"`
+	GroupID           *uuid.UUID `json:"group_id,omitempty"`
+	GroupName         string     `json:"group_name,omitempty"`
+	Permissions       []string   `json:"permissions,omitempty"`
+	DeniedPermissions []string   `json:"denied_permissions,omitempty"`
+	MatchedPermission string     `json:"matched_permission,omitempty"`
+}
+
+type DecisionDTO struct {
+	Permission     string      `json:"permission"`
+	Allowed        bool        `json:"allowed"`
+	Reason         string      `json:"reason"`
+	MatchedGrants  []*GrantDTO `json:"matched_grants"`
+	DenyingGrants  []*GrantDTO `json:"denying_grants,omitempty"`
+	DeniedDirectly bool        `json:"denied_directly,omitempty"`
+}
+
+type ExplanationDTO struct {
+	UserID            uuid.UUID      `json:"user_id"`
+	OrganizationID    *uuid.UUID     `json:"organization_id,omitempty"`
+	DenialReason      string         `json:"denial_reason,omitempty"`
+	Roles             []string       `json:"roles"`
+	Permissions       []string       `json:"permissions"`
+	DeniedPermissions []string       `json:"denied_permissions"`
+	Grants            []*GrantDTO    `json:"grants"`
+	Decisions         []*DecisionDTO `json:"decisions"`
+}
+
+func GrantFromDomain(grant authz.RoleGrant) *GrantDTO {
+	return &GrantDTO{
+		RoleID:            grant.RoleID,
+		RoleName:          grant.RoleName,
+		OrganizationID:    grant.OrganizationID,
+		Source:            string(grant.Source),
+		GroupID:           grant.GroupID,
+		GroupName:         grant.GroupName,
+		Permissions:       grant.PermissionCodes,
+		DeniedPermissions: grant.DeniedCodes,
+	}
+}
+
+func DecisionFromDomain(decision authz.Decision) *DecisionDTO {
+	matchedGrants := make([]*GrantDTO, len(decision.MatchedGrants))
+	for i, matchedGrant := range decision.MatchedGrants {
+		grantDTO := GrantFromDomain(matchedGrant.RoleGrant)
+		grantDTO.Permissions = nil
+		grantDTO.MatchedPermission = matchedGrant.MatchedPermission
+		matchedGrants[i] = grantDTO
+	}
+
+	denyingGrants := make([]*GrantDTO, len(decision.DenyingGrants))
+	for i, denyingGrant := range decision.DenyingGrants {
+		grantDTO := GrantFromDomain(denyingGrant)
+		grantDTO.Permissions = nil
+		grantDTO.DeniedPermissions = nil
+		grantDTO.MatchedPermission = decision.Permission
+		denyingGrants[i] = grantDTO
+	}
+
+	return &DecisionDTO{
+		Permission:     decision.Permission,
+		Allowed:        decision.Allowed,
+		Reason:         decision.Reason,
+		MatchedGrants:  matchedGrants,
+		DenyingGrants:  denyingGrants,
+		DeniedDirectly: decision.DeniedDirectly,
+	}
+}
+
+func ExplanationFromDomain(access *authz.EffectiveAccess, decisions []authz.Decision) *ExplanationDTO {
+	grants := make([]*GrantDTO, len(access.Grants))
+	for i, grant := range access.Grants {
+		grants[i] = GrantFromDomain(grant)
+	}
+
+	decisionDTOs := make([]*DecisionDTO, len(decisions))
+	for i, decision := range decisions {
+		decisionDTOs[i] = DecisionFromDomain(decision)
+	}
+
+	return &ExplanationDTO{
+		UserID:            access.UserID,
+		OrganizationID:    access.OrganizationID,
+		DenialReason:      access.DenialReason(),
+		Roles:             access.RoleNames(),
+		Permissions:       access.PermissionCodes(),
+		DeniedPermissions: access.DeniedPermissionCodes(),
+		Grants:            grants,
+		Decisions:         decisionDTOs,
+	}
+}
+
+type PermissionImpactDTO struct {
+	UserID            uuid.UUID `json:"user_id"`
+	Email             string    `json:"email"`
+	FullName          string    `json:"full_name"`
+	GainedPermissions []string  `json:"gained_permissions"`
+	LostPermissions   []string  `json:"lost_permissions"`
+}
+
+type SimulationDTO struct {
+	ChangeType     string                 `json:"change_type"`
+	OrganizationID *uuid.UUID             `json:"organization_id,omitempty"`
+	UsersEvaluated int                    `json:"users_evaluated"`
+	UsersAffected  int                    `json:"users_affected"`
+	Impacts        []*PermissionImpactDTO `json:"impacts"`
+}
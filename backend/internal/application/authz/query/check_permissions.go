@@ -0,0 +1,59 @@
+package authzquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	authzdto "github.com/tranvuongduy2003/go-copilot/internal/application/authz/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CheckPermissionsQuery struct {
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Permissions    []string
+}
+
+type CheckPermissionsHandler struct {
+	userRepository         user.Repository
+	organizationRepository organization.Repository
+	resolver               *authz.Resolver
+	logger                 logger.Logger
+}
+
+func NewCheckPermissionsHandler(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *CheckPermissionsHandler {
+	return &CheckPermissionsHandler{
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		resolver:               authz.NewResolver(roleRepository, groupRepository, permissionRepository),
+		logger:                 logger,
+	}
+}
+
+func (handler *CheckPermissionsHandler) Handle(context context.Context, query CheckPermissionsQuery) ([]*authzdto.DecisionDTO, error) {
+	access, err := resolveSubjectAccess(context, handler.userRepository, handler.organizationRepository, handler.resolver, query.UserID, query.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*authzdto.DecisionDTO, len(query.Permissions))
+	for i, permissionCode := range query.Permissions {
+		decisions[i] = authzdto.DecisionFromDomain(access.Evaluate(permissionCode))
+	}
+
+	return decisions, nil
+}
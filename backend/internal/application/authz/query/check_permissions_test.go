@@ -0,0 +1,142 @@
+package authzquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+type authzFixture struct {
+	userRepo         *testutil.MockUserRepository
+	roleRepo         *testutil.MockRoleRepository
+	groupRepo        *testutil.MockGroupRepository
+	permRepo         *testutil.MockPermissionRepository
+	organizationRepo *testutil.MockOrganizationRepository
+	subject          *user.User
+}
+
+func newAuthzFixture(subject *user.User) *authzFixture {
+	fixture := &authzFixture{
+		userRepo:         testutil.NewMockUserRepository(),
+		roleRepo:         testutil.NewMockRoleRepository(),
+		groupRepo:        testutil.NewMockGroupRepository(),
+		permRepo:         testutil.NewMockPermissionRepository(),
+		organizationRepo: testutil.NewMockOrganizationRepository(),
+		subject:          subject,
+	}
+
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	fixture.permRepo.AddPermission(readPerm)
+	fixture.permRepo.AddPermission(deletePerm)
+
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+	moderatorRole, _ := role.NewRole(role.NewRoleParams{Name: "moderator", DisplayName: "Moderator", PermissionIDs: []uuid.UUID{deletePerm.ID()}})
+	fixture.roleRepo.AddRole(viewerRole)
+	fixture.roleRepo.AddRole(moderatorRole)
+
+	_ = subject.AssignRole(viewerRole.ID())
+	fixture.userRepo.AddUser(subject)
+
+	moderators, _ := group.NewGroup(group.NewGroupParams{Name: "Moderators", RoleIDs: []uuid.UUID{moderatorRole.ID()}})
+	fixture.groupRepo.AddGroup(moderators)
+	fixture.groupRepo.AddMembership(moderators.ID(), subject.ID())
+
+	return fixture
+}
+
+func (fixture *authzFixture) checkHandler() *CheckPermissionsHandler {
+	return NewCheckPermissionsHandler(fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, fixture.organizationRepo, testutil.NewNoopLogger())
+}
+
+func TestCheckPermissionsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("evaluates direct and group grants", func(t *testing.T) {
+		fixture := newAuthzFixture(testutil.CreateActiveUser())
+
+		decisions, err := fixture.checkHandler().Handle(ctx, CheckPermissionsQuery{
+			UserID:      fixture.subject.ID(),
+			Permissions: []string{"users:read", "users:delete", "roles:delete"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, decisions, 3)
+		assert.True(t, decisions[0].Allowed)
+		assert.Equal(t, "direct", decisions[0].MatchedGrants[0].Source)
+		assert.True(t, decisions[1].Allowed)
+		assert.Equal(t, "group", decisions[1].MatchedGrants[0].Source)
+		assert.Equal(t, "Moderators", decisions[1].MatchedGrants[0].GroupName)
+		assert.False(t, decisions[2].Allowed)
+		assert.Empty(t, decisions[2].MatchedGrants)
+	})
+
+	t.Run("denies inactive user", func(t *testing.T) {
+		fixture := newAuthzFixture(testutil.CreateInactiveUser())
+
+		decisions, err := fixture.checkHandler().Handle(ctx, CheckPermissionsQuery{
+			UserID:      fixture.subject.ID(),
+			Permissions: []string{"users:read"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, decisions, 1)
+		assert.False(t, decisions[0].Allowed)
+		assert.Equal(t, "user is not active", decisions[0].Reason)
+	})
+
+	t.Run("denies outside organization membership", func(t *testing.T) {
+		fixture := newAuthzFixture(testutil.CreateActiveUser())
+		organizationID := uuid.New()
+
+		decisions, err := fixture.checkHandler().Handle(ctx, CheckPermissionsQuery{
+			UserID:         fixture.subject.ID(),
+			OrganizationID: &organizationID,
+			Permissions:    []string{"users:read"},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, decisions[0].Allowed)
+		assert.Equal(t, "user is not a member of the organization", decisions[0].Reason)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		fixture := newAuthzFixture(testutil.CreateActiveUser())
+
+		_, err := fixture.checkHandler().Handle(ctx, CheckPermissionsQuery{
+			UserID:      uuid.New(),
+			Permissions: []string{"users:read"},
+		})
+
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestExplainPermissionsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAuthzFixture(testutil.CreateActiveUser())
+	handler := NewExplainPermissionsHandler(fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, fixture.organizationRepo, testutil.NewNoopLogger())
+
+	explanation, err := handler.Handle(ctx, ExplainPermissionsQuery{
+		UserID:      fixture.subject.ID(),
+		Permissions: []string{"users:delete"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, fixture.subject.ID(), explanation.UserID)
+	assert.ElementsMatch(t, []string{"viewer", "moderator"}, explanation.Roles)
+	assert.ElementsMatch(t, []string{"users:read", "users:delete"}, explanation.Permissions)
+	assert.Len(t, explanation.Grants, 2)
+	require.Len(t, explanation.Decisions, 1)
+	assert.True(t, explanation.Decisions[0].Allowed)
+	assert.Equal(t, "users:delete", explanation.Decisions[0].MatchedGrants[0].MatchedPermission)
+}
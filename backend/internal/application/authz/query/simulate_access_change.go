@@ -0,0 +1,218 @@
+package authzquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	authzdto "github.com/tranvuongduy2003/go-copilot/internal/application/authz/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type SimulateAccessChangeQuery struct {
+	Type                string
+	RoleID              uuid.UUID
+	AddPermissionIDs    []uuid.UUID
+	RemovePermissionIDs []uuid.UUID
+	UserID              uuid.UUID
+	RoleIDs             []uuid.UUID
+	OrganizationID      *uuid.UUID
+}
+
+type SimulateAccessChangeHandler struct {
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	groupRepository        group.Repository
+	permissionRepository   permission.Repository
+	organizationRepository organization.Repository
+	resolver               *authz.Resolver
+	logger                 logger.Logger
+}
+
+func NewSimulateAccessChangeHandler(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *SimulateAccessChangeHandler {
+	return &SimulateAccessChangeHandler{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		groupRepository:        groupRepository,
+		permissionRepository:   permissionRepository,
+		organizationRepository: organizationRepository,
+		resolver:               authz.NewResolver(roleRepository, groupRepository, permissionRepository),
+		logger:                 logger,
+	}
+}
+
+func (handler *SimulateAccessChangeHandler) Handle(context context.Context, query SimulateAccessChangeQuery) (*authzdto.SimulationDTO, error) {
+	changeType, err := authz.ParseChangeType(query.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	change := authz.Change{
+		Type:                changeType,
+		RoleID:              query.RoleID,
+		AddPermissionIDs:    query.AddPermissionIDs,
+		RemovePermissionIDs: query.RemovePermissionIDs,
+		UserID:              query.UserID,
+		RoleIDs:             query.RoleIDs,
+	}
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+
+	organizationID, subjects, err := handler.affectedSubjects(context, change, query.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &authzdto.SimulationDTO{
+		ChangeType:     changeType.String(),
+		OrganizationID: organizationID,
+		UsersEvaluated: len(subjects),
+		Impacts:        make([]*authzdto.PermissionImpactDTO, 0),
+	}
+
+	for _, subject := range subjects {
+		before, err := handler.resolver.Resolve(context, subject, organizationID)
+		if err != nil {
+			return nil, fmt.Errorf("resolve current access: %w", err)
+		}
+		after, err := handler.resolver.Simulate(context, subject, organizationID, change)
+		if err != nil {
+			return nil, fmt.Errorf("resolve simulated access: %w", err)
+		}
+		if err := restrictSubjectAccess(context, handler.organizationRepository, subject, organizationID, before, after); err != nil {
+			return nil, err
+		}
+
+		gained, lost := authz.CompareAccess(before, after)
+		if len(gained) == 0 && len(lost) == 0 {
+			continue
+		}
+
+		result.Impacts = append(result.Impacts, &authzdto.PermissionImpactDTO{
+			UserID:            subject.ID(),
+			Email:             subject.Email().String(),
+			FullName:          subject.FullName().String(),
+			GainedPermissions: gained,
+			LostPermissions:   lost,
+		})
+	}
+	result.UsersAffected = len(result.Impacts)
+
+	return result, nil
+}
+
+func (handler *SimulateAccessChangeHandler) affectedSubjects(context context.Context, change authz.Change, organizationID *uuid.UUID) (*uuid.UUID, []*user.User, error) {
+	if change.Type == authz.ChangeTypeUserRoles {
+		subject, err := handler.userRepository.FindByID(context, change.UserID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("find user: %w", err)
+		}
+		if err := handler.ensureRolesExist(context, change.RoleIDs); err != nil {
+			return nil, nil, err
+		}
+		return organizationID, []*user.User{subject}, nil
+	}
+
+	targetRole, err := handler.roleRepository.FindByID(context, change.RoleID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find role: %w", err)
+	}
+	if err := handler.ensurePermissionsExist(context, change.AddPermissionIDs); err != nil {
+		return nil, nil, err
+	}
+
+	holders, err := handler.userRepository.FindByRole(context, targetRole.ID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("find role holders: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(holders))
+	for _, holder := range holders {
+		seen[holder.ID()] = true
+	}
+
+	if handler.groupRepository != nil {
+		memberIDs, err := handler.groupRepository.FindMemberIDsByRole(context, targetRole.ID())
+		if err != nil {
+			return nil, nil, fmt.Errorf("find group members with role: %w", err)
+		}
+		for _, memberID := range memberIDs {
+			if seen[memberID] {
+				continue
+			}
+			member, err := handler.userRepository.FindByID(context, memberID)
+			if err != nil {
+				if shared.IsNotFoundError(err) {
+					continue
+				}
+				return nil, nil, fmt.Errorf("find group member: %w", err)
+			}
+			holders = append(holders, member)
+			seen[memberID] = true
+		}
+	}
+
+	return targetRole.OrganizationID(), holders, nil
+}
+
+func (handler *SimulateAccessChangeHandler) ensureRolesExist(context context.Context, roleIDs []uuid.UUID) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	roles, err := handler.roleRepository.FindByIDs(context, roleIDs)
+	if err != nil {
+		return fmt.Errorf("find roles: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(roles))
+	for _, roleEntity := range roles {
+		found[roleEntity.ID()] = true
+	}
+	for _, roleID := range roleIDs {
+		if !found[roleID] {
+			return role.NewRoleNotFoundError(roleID.String())
+		}
+	}
+
+	return nil
+}
+
+func (handler *SimulateAccessChangeHandler) ensurePermissionsExist(context context.Context, permissionIDs []uuid.UUID) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+
+	permissions, err := handler.permissionRepository.FindByIDs(context, permissionIDs)
+	if err != nil {
+		return fmt.Errorf("find permissions: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(permissions))
+	for _, permissionEntity := range permissions {
+		found[permissionEntity.ID()] = true
+	}
+	for _, permissionID := range permissionIDs {
+		if !found[permissionID] {
+			return permission.NewPermissionNotFoundError(permissionID.String())
+		}
+	}
+
+	return nil
+}
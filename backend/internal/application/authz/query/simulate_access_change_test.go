@@ -0,0 +1,140 @@
+package authzquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestSimulateAccessChangeHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*authzFixture, *SimulateAccessChangeHandler) {
+		fixture := newAuthzFixture(testutil.CreateActiveUser())
+		handler := NewSimulateAccessChangeHandler(fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, fixture.organizationRepo, testutil.NewNoopLogger())
+		return fixture, handler
+	}
+
+	lookupRoleID := func(t *testing.T, fixture *authzFixture, name string) uuid.UUID {
+		roleEntity, err := fixture.roleRepo.FindByName(ctx, name)
+		require.NoError(t, err)
+		return roleEntity.ID()
+	}
+
+	lookupPermissionID := func(t *testing.T, fixture *authzFixture, code string) uuid.UUID {
+		permissionEntity, err := fixture.permRepo.FindByCodeString(ctx, code)
+		require.NoError(t, err)
+		return permissionEntity.ID()
+	}
+
+	t.Run("adding a permission only affects holders without it", func(t *testing.T) {
+		fixture, handler := setup()
+		viewerRoleID := lookupRoleID(t, fixture, "viewer")
+		otherViewer := testutil.CreateActiveUser()
+		_ = otherViewer.AssignRole(viewerRoleID)
+		fixture.userRepo.AddUser(otherViewer)
+
+		result, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:             string(authz.ChangeTypeRolePermissions),
+			RoleID:           viewerRoleID,
+			AddPermissionIDs: []uuid.UUID{lookupPermissionID(t, fixture, "users:delete")},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.UsersEvaluated)
+		require.Equal(t, 1, result.UsersAffected)
+		assert.Equal(t, otherViewer.ID(), result.Impacts[0].UserID)
+		assert.Equal(t, []string{"users:delete"}, result.Impacts[0].GainedPermissions)
+		assert.Empty(t, result.Impacts[0].LostPermissions)
+	})
+
+	t.Run("removing a permission reports losses", func(t *testing.T) {
+		fixture, handler := setup()
+
+		result, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:                string(authz.ChangeTypeRolePermissions),
+			RoleID:              lookupRoleID(t, fixture, "viewer"),
+			RemovePermissionIDs: []uuid.UUID{lookupPermissionID(t, fixture, "users:read")},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Impacts, 1)
+		assert.Equal(t, []string{"users:read"}, result.Impacts[0].LostPermissions)
+
+		viewerRole, _ := fixture.roleRepo.FindByName(ctx, "viewer")
+		assert.Len(t, viewerRole.PermissionIDs(), 1)
+	})
+
+	t.Run("deleting a role affects group members", func(t *testing.T) {
+		fixture, handler := setup()
+
+		result, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:   string(authz.ChangeTypeRoleDeletion),
+			RoleID: lookupRoleID(t, fixture, "moderator"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.UsersEvaluated)
+		require.Len(t, result.Impacts, 1)
+		assert.Equal(t, fixture.subject.ID(), result.Impacts[0].UserID)
+		assert.Equal(t, []string{"users:delete"}, result.Impacts[0].LostPermissions)
+	})
+
+	t.Run("changing user roles keeps group grants", func(t *testing.T) {
+		fixture, handler := setup()
+
+		result, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:    string(authz.ChangeTypeUserRoles),
+			UserID:  fixture.subject.ID(),
+			RoleIDs: []uuid.UUID{lookupRoleID(t, fixture, "moderator")},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Impacts, 1)
+		assert.Equal(t, []string{"users:read"}, result.Impacts[0].LostPermissions)
+		assert.Empty(t, result.Impacts[0].GainedPermissions)
+		assert.Len(t, fixture.subject.RoleIDs(), 1)
+	})
+
+	t.Run("inactive users are not affected", func(t *testing.T) {
+		fixture := newAuthzFixture(testutil.CreateInactiveUser())
+		handler := NewSimulateAccessChangeHandler(fixture.userRepo, fixture.roleRepo, fixture.groupRepo, fixture.permRepo, fixture.organizationRepo, testutil.NewNoopLogger())
+
+		result, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:   string(authz.ChangeTypeRoleDeletion),
+			RoleID: lookupRoleID(t, fixture, "viewer"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.UsersEvaluated)
+		assert.Empty(t, result.Impacts)
+	})
+
+	t.Run("unknown permission", func(t *testing.T) {
+		fixture, handler := setup()
+
+		_, err := handler.Handle(ctx, SimulateAccessChangeQuery{
+			Type:             string(authz.ChangeTypeRolePermissions),
+			RoleID:           lookupRoleID(t, fixture, "viewer"),
+			AddPermissionIDs: []uuid.UUID{uuid.New()},
+		})
+
+		testutil.AssertNotFoundError(t, err)
+	})
+
+	t.Run("invalid change", func(t *testing.T) {
+		_, handler := setup()
+
+		_, err := handler.Handle(ctx, SimulateAccessChangeQuery{Type: "rename_role"})
+		testutil.AssertValidationError(t, err)
+
+		_, err = handler.Handle(ctx, SimulateAccessChangeQuery{Type: string(authz.ChangeTypeRolePermissions), RoleID: uuid.New()})
+		testutil.AssertValidationError(t, err)
+	})
+}
@@ -0,0 +1,66 @@
+package authzquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+func resolveSubjectAccess(
+	context context.Context,
+	userRepository user.Repository,
+	organizationRepository organization.Repository,
+	resolver *authz.Resolver,
+	userID uuid.UUID,
+	organizationID *uuid.UUID,
+) (*authz.EffectiveAccess, error) {
+	subject, err := userRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	access, err := resolver.Resolve(context, subject, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve access: %w", err)
+	}
+
+	if err := restrictSubjectAccess(context, organizationRepository, subject, organizationID, access); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+func restrictSubjectAccess(
+	context context.Context,
+	organizationRepository organization.Repository,
+	subject *user.User,
+	organizationID *uuid.UUID,
+	accesses ...*authz.EffectiveAccess,
+) error {
+	denialReason := ""
+	if !subject.Status().IsActive() {
+		denialReason = "user is not active"
+	} else if organizationID != nil {
+		isMember, err := organizationRepository.IsMember(context, *organizationID, subject.ID())
+		if err != nil {
+			return fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isMember {
+			denialReason = "user is not a member of the organization"
+		}
+	}
+
+	if denialReason != "" {
+		for _, access := range accesses {
+			access.Deny(denialReason)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package bulkoperationcommand
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	bulkoperationdto "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/dto"
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	SynchronousLimit = 50
+
+	progressInterval  = 50
+	interruptedReason = "interrupted by shutdown"
+)
+
+type StartBulkUserOperationCommand struct {
+	Action  string
+	UserIDs []uuid.UUID
+	Filter  *UserFilter
+	Reason  string
+	RoleIDs []uuid.UUID
+	ActorID uuid.UUID
+}
+
+// UserFilter selects targets the same way the user listing does. Unlike the
+// listing, an unknown status is rejected rather than ignored so that a typo
+// never widens the target set to every user.
+type UserFilter struct {
+	Status         *string
+	Search         *string
+	OrganizationID *uuid.UUID
+}
+
+type StartBulkUserOperationHandler struct {
+	bulkOperationRepository bulkoperation.Repository
+	userRepository          user.Repository
+	activateUserHandler     *usercommand.ActivateUserHandler
+	deactivateUserHandler   *usercommand.DeactivateUserHandler
+	banUserHandler          *usercommand.BanUserHandler
+	deleteUserHandler       *usercommand.DeleteUserHandler
+	setUserRolesHandler     *usercommand.SetUserRolesHandler
+	eventBus                shared.EventBus
+	logger                  logger.Logger
+	background              context.Context
+	stopBackground          context.CancelFunc
+	running                 sync.WaitGroup
+}
+
+func NewStartBulkUserOperationHandler(
+	bulkOperationRepository bulkoperation.Repository,
+	userRepository user.Repository,
+	activateUserHandler *usercommand.ActivateUserHandler,
+	deactivateUserHandler *usercommand.DeactivateUserHandler,
+	banUserHandler *usercommand.BanUserHandler,
+	deleteUserHandler *usercommand.DeleteUserHandler,
+	setUserRolesHandler *usercommand.SetUserRolesHandler,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *StartBulkUserOperationHandler {
+	background, stopBackground := context.WithCancel(context.Background())
+	return &StartBulkUserOperationHandler{
+		bulkOperationRepository: bulkOperationRepository,
+		userRepository:          userRepository,
+		activateUserHandler:     activateUserHandler,
+		deactivateUserHandler:   deactivateUserHandler,
+		banUserHandler:          banUserHandler,
+		deleteUserHandler:       deleteUserHandler,
+		setUserRolesHandler:     setUserRolesHandler,
+		eventBus:                eventBus,
+		logger:                  logger,
+		background:              background,
+		stopBackground:          stopBackground,
+	}
+}
+
+func (handler *StartBulkUserOperationHandler) Handle(ctx context.Context, command StartBulkUserOperationCommand) (*bulkoperationdto.OperationDTO, error) {
+	action, valid := bulkoperation.ParseAction(command.Action)
+	if !valid {
+		return nil, bulkoperation.ErrInvalidAction
+	}
+
+	userIDs, err := handler.resolveTargets(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := bulkoperation.NewOperation(bulkoperation.NewOperationParams{
+		Action:    action,
+		Reason:    command.Reason,
+		RoleIDs:   command.RoleIDs,
+		UserIDs:   userIDs,
+		CreatedBy: command.ActorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.bulkOperationRepository.Create(ctx, operation); err != nil {
+		return nil, fmt.Errorf("save bulk operation: %w", err)
+	}
+
+	handler.logger.Info("bulk user operation created",
+		logger.String("operation_id", operation.ID().String()),
+		logger.String("action", action.String()),
+		logger.Int("targets", len(operation.Items())),
+	)
+
+	if len(operation.Items()) <= SynchronousLimit {
+		handler.run(context.WithoutCancel(ctx), operation)
+		return bulkoperationdto.OperationFromDomain(operation), nil
+	}
+
+	result := bulkoperationdto.OperationFromDomain(operation)
+	handler.running.Add(1)
+	go func() {
+		defer handler.running.Done()
+		handler.run(handler.background, operation)
+	}()
+
+	return result, nil
+}
+
+// Shutdown stops background operations between users and waits for them to
+// record their final state.
+func (handler *StartBulkUserOperationHandler) Shutdown(ctx context.Context) {
+	handler.stopBackground()
+
+	done := make(chan struct{})
+	go func() {
+		handler.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (handler *StartBulkUserOperationHandler) resolveTargets(context context.Context, command StartBulkUserOperationCommand) ([]uuid.UUID, error) {
+	if len(command.UserIDs) > 0 && command.Filter != nil {
+		return nil, shared.NewValidationError("user_ids", "provide either user_ids or filter, not both")
+	}
+	if command.Filter == nil {
+		return command.UserIDs, nil
+	}
+
+	filter := user.Filter{
+		Search:         command.Filter.Search,
+		OrganizationID: command.Filter.OrganizationID,
+	}
+	if command.Filter.Status != nil {
+		status, valid := user.ParseStatus(*command.Filter.Status)
+		if !valid {
+			return nil, shared.NewValidationError("filter.status", "invalid user status")
+		}
+		filter.Statuses = []user.Status{status}
+	}
+
+	userIDs := make([]uuid.UUID, 0)
+	for page := 1; ; page++ {
+		pagination := shared.NewPagination(page, shared.MaxLimit)
+		users, total, err := handler.userRepository.List(context, filter, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+		if total > bulkoperation.MaxTargets {
+			return nil, bulkoperation.ErrTooManyTargets
+		}
+
+		for _, matchedUser := range users {
+			userIDs = append(userIDs, matchedUser.ID())
+		}
+
+		if len(users) == 0 || !pagination.HasNext(total) {
+			return userIDs, nil
+		}
+	}
+}
+
+func (handler *StartBulkUserOperationHandler) run(ctx context.Context, operation *bulkoperation.Operation) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			handler.finish(context.WithoutCancel(ctx), operation, fmt.Sprintf("panic: %v", recovered))
+		}
+	}()
+
+	if err := operation.Start(); err != nil {
+		handler.logger.Error("failed to start bulk operation",
+			logger.String("operation_id", operation.ID().String()),
+			logger.Err(err),
+		)
+		return
+	}
+	handler.save(ctx, operation)
+
+	for i, item := range operation.Items() {
+		if ctx.Err() != nil {
+			handler.finish(context.WithoutCancel(ctx), operation, interruptedReason)
+			return
+		}
+
+		userID := item.UserID()
+		if err := handler.apply(ctx, operation, userID); err != nil {
+			operation.RecordFailure(userID, err.Error())
+		} else {
+			operation.RecordSuccess(userID)
+		}
+
+		if (i+1)%progressInterval == 0 {
+			handler.save(ctx, operation)
+		}
+	}
+
+	handler.finish(ctx, operation, "")
+}
+
+func (handler *StartBulkUserOperationHandler) apply(context context.Context, operation *bulkoperation.Operation, userID uuid.UUID) error {
+	actorID := operation.CreatedBy()
+	if userID == actorID {
+		return bulkoperation.ErrSelfTarget
+	}
+
+	var err error
+	switch operation.Action() {
+	case bulkoperation.ActionActivate:
+		_, err = handler.activateUserHandler.Handle(context, usercommand.ActivateUserCommand{UserID: userID, ActorID: &actorID})
+	case bulkoperation.ActionDeactivate:
+		_, err = handler.deactivateUserHandler.Handle(context, usercommand.DeactivateUserCommand{UserID: userID, ActorID: &actorID})
+	case bulkoperation.ActionBan:
+		_, err = handler.banUserHandler.Handle(context, usercommand.BanUserCommand{UserID: userID, Reason: operation.Reason(), ActorID: &actorID})
+	case bulkoperation.ActionDelete:
+		err = handler.deleteUserHandler.Handle(context, usercommand.DeleteUserCommand{UserID: userID, ActorID: &actorID})
+	case bulkoperation.ActionSetRoles:
+		_, err = handler.setUserRolesHandler.Handle(context, usercommand.SetUserRolesCommand{UserID: userID, RoleIDs: operation.RoleIDs(), ActorID: &actorID})
+	default:
+		err = bulkoperation.ErrInvalidAction
+	}
+	return err
+}
+
+func (handler *StartBulkUserOperationHandler) finish(context context.Context, operation *bulkoperation.Operation, failureReason string) {
+	var err error
+	if failureReason == "" {
+		err = operation.Complete()
+	} else {
+		err = operation.Fail(failureReason)
+	}
+	if err != nil {
+		handler.logger.Error("failed to finish bulk operation",
+			logger.String("operation_id", operation.ID().String()),
+			logger.Err(err),
+		)
+		return
+	}
+	handler.save(context, operation)
+
+	succeeded, failed, _ := operation.Counts()
+	handler.logger.Info("bulk user operation finished",
+		logger.String("operation_id", operation.ID().String()),
+		logger.String("status", operation.Status().String()),
+		logger.Int("succeeded", succeeded),
+		logger.Int("failed", failed),
+	)
+}
+
+func (handler *StartBulkUserOperationHandler) save(context context.Context, operation *bulkoperation.Operation) {
+	if err := handler.bulkOperationRepository.Update(context, operation); err != nil {
+		handler.logger.Error("failed to save bulk operation progress",
+			logger.String("operation_id", operation.ID().String()),
+			logger.Err(err),
+		)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, operation.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("operation_id", operation.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+	operation.ClearDomainEvents()
+}
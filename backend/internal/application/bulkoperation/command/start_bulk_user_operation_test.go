@@ -0,0 +1,240 @@
+package bulkoperationcommand
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+type bulkOperationFixture struct {
+	userRepo *testutil.MockUserRepository
+	bulkRepo *testutil.MockBulkOperationRepository
+	eventBus *testutil.MockEventBus
+	handler  *StartBulkUserOperationHandler
+}
+
+func newBulkOperationFixture() *bulkOperationFixture {
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	organizationRepo := testutil.NewMockOrganizationRepository()
+	bulkRepo := testutil.NewMockBulkOperationRepository()
+	eventBus := testutil.NewMockEventBus()
+	log := testutil.NewNoopLogger()
+
+	delegationPolicy := authz.NewDelegationPolicy(userRepo, roleRepo, nil)
+	sodChecker := sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil)
+
+	handler := NewStartBulkUserOperationHandler(
+		bulkRepo,
+		userRepo,
+		usercommand.NewActivateUserHandler(userRepo, delegationPolicy, eventBus, log),
+		usercommand.NewDeactivateUserHandler(userRepo, delegationPolicy, eventBus, log),
+		usercommand.NewBanUserHandler(userRepo, delegationPolicy, eventBus, log),
+		usercommand.NewDeleteUserHandler(userRepo, delegationPolicy, eventBus, log),
+		usercommand.NewSetUserRolesHandler(userRepo, roleRepo, organizationRepo, delegationPolicy, sodChecker, eventBus, log),
+		eventBus,
+		log,
+	)
+
+	return &bulkOperationFixture{
+		userRepo: userRepo,
+		bulkRepo: bulkRepo,
+		eventBus: eventBus,
+		handler:  handler,
+	}
+}
+
+func (fixture *bulkOperationFixture) addActiveUser(t *testing.T, email string) *user.User {
+	t.Helper()
+	activeUser, err := user.NewUser(user.NewUserParams{
+		Email:        email,
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Bulk Target",
+	})
+	require.NoError(t, err)
+	require.NoError(t, activeUser.Activate())
+	activeUser.ClearDomainEvents()
+	fixture.userRepo.AddUser(activeUser)
+	return activeUser
+}
+
+func (fixture *bulkOperationFixture) eventsOfType(eventType string) []shared.DomainEvent {
+	events := make([]shared.DomainEvent, 0)
+	for _, event := range fixture.eventBus.PublishedEvents {
+		if event.EventType() == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestStartBulkUserOperationHandler_RunsSmallSetsSynchronously(t *testing.T) {
+	fixture := newBulkOperationFixture()
+	actorID := fixture.addActiveUser(t, "admin@example.com").ID()
+
+	first := fixture.addActiveUser(t, "first@example.com")
+	second := fixture.addActiveUser(t, "second@example.com")
+	missing := uuid.New()
+
+	result, err := fixture.handler.Handle(context.Background(), StartBulkUserOperationCommand{
+		Action:  bulkoperation.ActionDeactivate.String(),
+		UserIDs: []uuid.UUID{first.ID(), second.ID(), missing, actorID},
+		ActorID: actorID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, bulkoperation.StatusCompleted.String(), result.Status)
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, bulkoperation.ItemStatusFailed.String(), result.Items[3].Status)
+	assert.Equal(t, bulkoperation.ErrSelfTarget.Error(), result.Items[3].Error)
+
+	assert.Equal(t, user.StatusInactive, first.Status())
+	assert.Equal(t, user.StatusInactive, second.Status())
+
+	require.Len(t, fixture.eventsOfType(bulkoperation.EventTypeOperationCompleted), 1)
+	processed := fixture.eventsOfType(bulkoperation.EventTypeItemProcessed)
+	require.Len(t, processed, 4)
+	for _, event := range processed {
+		assert.Equal(t, result.ID, event.AggregateID())
+	}
+	assert.Len(t, fixture.eventsOfType(user.EventTypeUserDeactivated), 2)
+}
+
+func TestStartBulkUserOperationHandler_ResolvesFilter(t *testing.T) {
+	fixture := newBulkOperationFixture()
+
+	active := fixture.addActiveUser(t, "active@example.com")
+	pending, err := user.NewUser(user.NewUserParams{
+		Email:        "pending@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Pending User",
+	})
+	require.NoError(t, err)
+	fixture.userRepo.AddUser(pending)
+	actor := fixture.addActiveUser(t, "admin@example.com")
+
+	status := user.StatusActive.String()
+	result, err := fixture.handler.Handle(context.Background(), StartBulkUserOperationCommand{
+		Action:  bulkoperation.ActionBan.String(),
+		Filter:  &UserFilter{Status: &status},
+		Reason:  "credential stuffing incident",
+		ActorID: actor.ID(),
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Items, 2)
+	assert.Equal(t, 1, result.Succeeded)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, user.StatusBanned, active.Status())
+	assert.Equal(t, user.StatusActive, actor.Status())
+	assert.Equal(t, user.StatusPending, pending.Status())
+}
+
+func TestStartBulkUserOperationHandler_RejectsInvalidRequests(t *testing.T) {
+	status := user.StatusActive.String()
+	unknownStatus := "archived"
+
+	tests := []struct {
+		name    string
+		command StartBulkUserOperationCommand
+		wantErr error
+	}{
+		{
+			name:    "unknown action",
+			command: StartBulkUserOperationCommand{Action: "promote", UserIDs: []uuid.UUID{uuid.New()}},
+			wantErr: bulkoperation.ErrInvalidAction,
+		},
+		{
+			name:    "no targets",
+			command: StartBulkUserOperationCommand{Action: bulkoperation.ActionActivate.String()},
+			wantErr: bulkoperation.ErrNoTargets,
+		},
+		{
+			name:    "filter matching nobody",
+			command: StartBulkUserOperationCommand{Action: bulkoperation.ActionActivate.String(), Filter: &UserFilter{Status: &status}},
+			wantErr: bulkoperation.ErrNoTargets,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newBulkOperationFixture()
+			tt.command.ActorID = uuid.New()
+
+			_, err := fixture.handler.Handle(context.Background(), tt.command)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, fixture.bulkRepo.Operations)
+		})
+	}
+
+	t.Run("both ids and filter", func(t *testing.T) {
+		fixture := newBulkOperationFixture()
+
+		_, err := fixture.handler.Handle(context.Background(), StartBulkUserOperationCommand{
+			Action:  bulkoperation.ActionActivate.String(),
+			UserIDs: []uuid.UUID{uuid.New()},
+			Filter:  &UserFilter{Status: &status},
+			ActorID: uuid.New(),
+		})
+
+		require.Error(t, err)
+		assert.True(t, shared.IsValidationError(err))
+	})
+
+	t.Run("unknown filter status", func(t *testing.T) {
+		fixture := newBulkOperationFixture()
+
+		_, err := fixture.handler.Handle(context.Background(), StartBulkUserOperationCommand{
+			Action:  bulkoperation.ActionActivate.String(),
+			Filter:  &UserFilter{Status: &unknownStatus},
+			ActorID: uuid.New(),
+		})
+
+		require.Error(t, err)
+		assert.True(t, shared.IsValidationError(err))
+	})
+}
+
+func TestStartBulkUserOperationHandler_RunsLargeSetsInBackground(t *testing.T) {
+	fixture := newBulkOperationFixture()
+
+	userIDs := make([]uuid.UUID, SynchronousLimit+1)
+	for i := range userIDs {
+		userIDs[i] = fixture.addActiveUser(t, fmt.Sprintf("user%d@example.com", i)).ID()
+	}
+
+	result, err := fixture.handler.Handle(context.Background(), StartBulkUserOperationCommand{
+		Action:  bulkoperation.ActionDeactivate.String(),
+		UserIDs: userIDs,
+		ActorID: fixture.addActiveUser(t, "admin@example.com").ID(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, bulkoperation.StatusPending.String(), result.Status)
+	assert.Equal(t, SynchronousLimit+1, result.Pending)
+
+	shutdownContext, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	fixture.handler.Shutdown(shutdownContext)
+
+	operation, err := fixture.bulkRepo.FindByID(context.Background(), result.ID)
+	require.NoError(t, err)
+	assert.True(t, operation.Status().IsFinished())
+	assert.NotNil(t, operation.CompletedAt())
+}
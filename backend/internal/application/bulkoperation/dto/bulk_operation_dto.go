@@ -0,0 +1,71 @@
+package bulkoperationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+)
+
+type ItemDTO struct {
+	UserID      uuid.UUID  `json:"user_id"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+}
+
+type OperationDTO struct {
+	ID            uuid.UUID   `json:"id"`
+	Action        string      `json:"action"`
+	Reason        string      `json:"reason,omitempty"`
+	RoleIDs       []uuid.UUID `json:"role_ids,omitempty"`
+	Status        string      `json:"status"`
+	CreatedBy     uuid.UUID   `json:"created_by"`
+	Total         int         `json:"total"`
+	Succeeded     int         `json:"succeeded"`
+	Failed        int         `json:"failed"`
+	Pending       int         `json:"pending"`
+	FailureReason string      `json:"failure_reason,omitempty"`
+	Items         []*ItemDTO  `json:"items"`
+	StartedAt     *time.Time  `json:"started_at,omitempty"`
+	CompletedAt   *time.Time  `json:"completed_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+func OperationFromDomain(operation *bulkoperation.Operation) *OperationDTO {
+	if operation == nil {
+		return nil
+	}
+
+	items := make([]*ItemDTO, len(operation.Items()))
+	for i, item := range operation.Items() {
+		items[i] = &ItemDTO{
+			UserID:      item.UserID(),
+			Status:      item.Status().String(),
+			Error:       item.Failure(),
+			ProcessedAt: item.ProcessedAt(),
+		}
+	}
+
+	succeeded, failed, pending := operation.Counts()
+	return &OperationDTO{
+		ID:            operation.ID(),
+		Action:        operation.Action().String(),
+		Reason:        operation.Reason(),
+		RoleIDs:       operation.RoleIDs(),
+		Status:        operation.Status().String(),
+		CreatedBy:     operation.CreatedBy(),
+		Total:         len(operation.Items()),
+		Succeeded:     succeeded,
+		Failed:        failed,
+		Pending:       pending,
+		FailureReason: operation.FailureReason(),
+		Items:         items,
+		StartedAt:     operation.StartedAt(),
+		CompletedAt:   operation.CompletedAt(),
+		CreatedAt:     operation.CreatedAt(),
+		UpdatedAt:     operation.UpdatedAt(),
+	}
+}
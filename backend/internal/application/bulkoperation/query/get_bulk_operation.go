@@ -0,0 +1,39 @@
+package bulkoperationquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	bulkoperationdto "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetBulkOperationQuery struct {
+	OperationID uuid.UUID
+}
+
+type GetBulkOperationHandler struct {
+	bulkOperationRepository bulkoperation.Repository
+	logger                  logger.Logger
+}
+
+func NewGetBulkOperationHandler(
+	bulkOperationRepository bulkoperation.Repository,
+	logger logger.Logger,
+) *GetBulkOperationHandler {
+	return &GetBulkOperationHandler{
+		bulkOperationRepository: bulkOperationRepository,
+		logger:                  logger,
+	}
+}
+
+func (handler *GetBulkOperationHandler) Handle(context context.Context, query GetBulkOperationQuery) (*bulkoperationdto.OperationDTO, error) {
+	operation, err := handler.bulkOperationRepository.FindByID(context, query.OperationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkoperationdto.OperationFromDomain(operation), nil
+}
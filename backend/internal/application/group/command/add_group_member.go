@@ -0,0 +1,145 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AddGroupMemberCommand struct {
+	GroupID uuid.UUID
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type AddGroupMemberHandler struct {
+	groupRepository        group.Repository
+	userRepository         user.Repository
+	organizationRepository organization.Repository
+	roleRepository         role.Repository
+	delegationPolicy       *authz.DelegationPolicy
+	sodChecker             *sod.Checker
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewAddGroupMemberHandler(
+	groupRepository group.Repository,
+	userRepository user.Repository,
+	organizationRepository organization.Repository,
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *AddGroupMemberHandler {
+	return &AddGroupMemberHandler{
+		groupRepository:        groupRepository,
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		roleRepository:         roleRepository,
+		delegationPolicy:       delegationPolicy,
+		sodChecker:             sodChecker,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *AddGroupMemberHandler) Handle(context context.Context, command AddGroupMemberCommand) error {
+	existingGroup, err := handler.groupRepository.FindByID(context, command.GroupID)
+	if err != nil {
+		return err
+	}
+
+	member, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return err
+	}
+
+	if organizationID := existingGroup.OrganizationID(); organizationID != nil {
+		isOrganizationMember, err := handler.organizationRepository.IsMember(context, *organizationID, command.UserID)
+		if err != nil {
+			return fmt.Errorf("check organization membership: %w", err)
+		}
+		if !isOrganizationMember {
+			return group.ErrMemberOutsideGroupOrganization
+		}
+	}
+
+	isMember, err := handler.groupRepository.IsMember(context, command.GroupID, command.UserID)
+	if err != nil {
+		return fmt.Errorf("check group membership: %w", err)
+	}
+	if isMember {
+		return group.ErrAlreadyMember
+	}
+
+	if err := handler.ensureCanGrantGroupRoles(context, command.ActorID, existingGroup, member); err != nil {
+		return err
+	}
+
+	if err := handler.sodChecker.CheckUser(context, member, existingGroup.RoleIDs()...); err != nil {
+		return err
+	}
+
+	if err := handler.groupRepository.AddMember(context, command.GroupID, command.UserID); err != nil {
+		return fmt.Errorf("add group member: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := group.NewGroupMemberAddedEvent(command.GroupID, command.UserID)
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish group member added event",
+				logger.String("group_id", command.GroupID.String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("user added to group",
+		logger.String("group_id", command.GroupID.String()),
+		logger.String("user_id", command.UserID.String()),
+	)
+
+	return nil
+}
+
+func (handler *AddGroupMemberHandler) ensureCanGrantGroupRoles(context context.Context, actorID *uuid.UUID, targetGroup *group.Group, member *user.User) error {
+	delegator, err := handler.delegationPolicy.ForActor(context, actorID)
+	if err != nil {
+		return err
+	}
+	if err := delegator.EnsureCanManageUser(context, member); err != nil {
+		return err
+	}
+	// Checked even for a group without roles, since whoever manages its roles
+	// later would otherwise grant them to an actor who joined it themselves.
+	if err := delegator.EnsureCanGrantTo(member); err != nil {
+		return err
+	}
+	if len(targetGroup.RoleIDs()) == 0 {
+		return nil
+	}
+
+	groupRoles, err := handler.roleRepository.FindByIDs(context, targetGroup.RoleIDs())
+	if err != nil {
+		return fmt.Errorf("load group roles: %w", err)
+	}
+	for _, groupRole := range groupRoles {
+		if err := delegator.EnsureCanManageRole(groupRole); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package groupcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestAddGroupMemberHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockGroupRepository, *testutil.MockUserRepository, *testutil.MockOrganizationRepository) (uuid.UUID, uuid.UUID)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "successfully add member",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository) (uuid.UUID, uuid.UUID) {
+				g, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+				groupRepo.AddGroup(g)
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				return g.ID(), u.ID()
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail when group not found",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository) (uuid.UUID, uuid.UUID) {
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				return uuid.New(), u.ID()
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name: "fail when user is outside the group organization",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository) (uuid.UUID, uuid.UUID) {
+				organizationID := uuid.New()
+				g, _ := group.NewGroup(group.NewGroupParams{Name: "Acme Support", OrganizationID: &organizationID})
+				groupRepo.AddGroup(g)
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				return g.ID(), u.ID()
+			},
+			wantErr:     true,
+			errContains: "not a member of the group's organization",
+		},
+		{
+			name: "fail when user is already a member",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, userRepo *testutil.MockUserRepository, organizationRepo *testutil.MockOrganizationRepository) (uuid.UUID, uuid.UUID) {
+				g, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+				groupRepo.AddGroup(g)
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				groupRepo.AddMembership(g.ID(), u.ID())
+				return g.ID(), u.ID()
+			},
+			wantErr:     true,
+			errContains: "already a member",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupRepo := testutil.NewMockGroupRepository()
+			userRepo := testutil.NewMockUserRepository()
+			organizationRepo := testutil.NewMockOrganizationRepository()
+			eventBus := testutil.NewMockEventBus()
+			logger := testutil.NewNoopLogger()
+
+			groupID, userID := tt.setupMocks(groupRepo, userRepo, organizationRepo)
+
+			handler := NewAddGroupMemberHandler(groupRepo, userRepo, organizationRepo, testutil.NewMockRoleRepository(), authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), groupRepo), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, groupRepo), eventBus, logger)
+			err := handler.Handle(ctx, AddGroupMemberCommand{GroupID: groupID, UserID: userID})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			isMember, _ := groupRepo.IsMember(ctx, groupID, userID)
+			assert.True(t, isMember)
+			testutil.AssertDomainEventPublished(t, eventBus, group.EventTypeGroupMemberAdded)
+		})
+	}
+}
+
+func TestAddGroupMemberHandler_SeparationOfDuties(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	userRepo := testutil.NewMockUserRepository()
+	sodRuleRepo := testutil.NewMockSoDRuleRepository()
+
+	requesterRoleID := uuid.New()
+	approverRoleID := uuid.New()
+	rule, _ := sod.NewRule(sod.NewRuleParams{
+		Name:    "payments",
+		Type:    sod.RuleTypeMutualExclusion,
+		RoleIDs: []uuid.UUID{requesterRoleID, approverRoleID},
+	})
+	sodRuleRepo.AddRule(rule)
+
+	g, _ := group.NewGroup(group.NewGroupParams{Name: "Approvers", RoleIDs: []uuid.UUID{approverRoleID}})
+	groupRepo.AddGroup(g)
+	u := testutil.CreateActiveUser()
+	_ = u.AssignRole(requesterRoleID)
+	userRepo.AddUser(u)
+
+	handler := NewAddGroupMemberHandler(groupRepo, userRepo, testutil.NewMockOrganizationRepository(), testutil.NewMockRoleRepository(), authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), groupRepo), sod.NewChecker(sodRuleRepo, userRepo, groupRepo), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+	err := handler.Handle(ctx, AddGroupMemberCommand{GroupID: g.ID(), UserID: u.ID()})
+
+	assert.ErrorIs(t, err, sod.ErrViolation)
+	isMember, _ := groupRepo.IsMember(ctx, g.ID(), u.ID())
+	assert.False(t, isMember)
+}
+
+func TestRemoveGroupMemberHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	eventBus := testutil.NewMockEventBus()
+
+	g, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+	groupRepo.AddGroup(g)
+	userID := uuid.New()
+	groupRepo.AddMembership(g.ID(), userID)
+
+	handler := NewRemoveGroupMemberHandler(groupRepo, eventBus, testutil.NewNoopLogger())
+
+	require.NoError(t, handler.Handle(ctx, RemoveGroupMemberCommand{GroupID: g.ID(), UserID: userID}))
+	isMember, _ := groupRepo.IsMember(ctx, g.ID(), userID)
+	assert.False(t, isMember)
+	testutil.AssertDomainEventPublished(t, eventBus, group.EventTypeGroupMemberRemoved)
+
+	err := handler.Handle(ctx, RemoveGroupMemberCommand{GroupID: g.ID(), UserID: userID})
+	assert.ErrorIs(t, err, group.ErrNotMember)
+}
+
+func TestAddGroupMemberHandler_RejectsSelfJoin(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	g, _ := group.NewGroup(group.NewGroupParams{Name: "Empty Team"})
+	groupRepo.AddGroup(g)
+	actor := testutil.CreateActiveUser()
+	userRepo.AddUser(actor)
+	actorID := actor.ID()
+
+	handler := NewAddGroupMemberHandler(groupRepo, userRepo, testutil.NewMockOrganizationRepository(), testutil.NewMockRoleRepository(), authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), groupRepo), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, groupRepo), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+	err := handler.Handle(ctx, AddGroupMemberCommand{GroupID: g.ID(), UserID: actor.ID(), ActorID: &actorID})
+
+	assert.EqualError(t, err, authz.ErrSelfEscalation.Error())
+	isMember, _ := groupRepo.IsMember(ctx, g.ID(), actor.ID())
+	assert.False(t, isMember)
+}
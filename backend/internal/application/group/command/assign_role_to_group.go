@@ -0,0 +1,122 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AssignRoleToGroupCommand struct {
+	GroupID uuid.UUID
+	RoleID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type AssignRoleToGroupHandler struct {
+	groupRepository  group.Repository
+	roleRepository   role.Repository
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	sodChecker       *sod.Checker
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewAssignRoleToGroupHandler(
+	groupRepository group.Repository,
+	roleRepository role.Repository,
+	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *AssignRoleToGroupHandler {
+	return &AssignRoleToGroupHandler{
+		groupRepository:  groupRepository,
+		roleRepository:   roleRepository,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		sodChecker:       sodChecker,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+// Handle grants the role to every member of the group, so the actor must be
+// allowed to grant it to each of them as if assigning it directly.
+func (handler *AssignRoleToGroupHandler) Handle(context context.Context, command AssignRoleToGroupCommand) (*groupdto.GroupDTO, error) {
+	existingGroup, err := handler.groupRepository.FindByID(context, command.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignedRole, err := ensureRoleMatchesGroup(context, handler.roleRepository, existingGroup, command.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(assignedRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingGroup.AssignRole(command.RoleID); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := handler.groupRepository.ListMemberIDs(context, command.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("list group members: %w", err)
+	}
+	members := make([]*user.User, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		member, err := handler.userRepository.FindByID(context, memberID)
+		if err != nil {
+			return nil, fmt.Errorf("find group member: %w", err)
+		}
+		if err := delegator.EnsureCanGrantTo(member); err != nil {
+			return nil, err
+		}
+		if err := delegator.EnsureCanManageUser(context, member); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	if err := handler.sodChecker.CheckUsers(context, members, command.RoleID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.groupRepository.Update(context, existingGroup); err != nil {
+		return nil, fmt.Errorf("update group: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingGroup.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("group_id", existingGroup.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingGroup.ClearDomainEvents()
+	}
+
+	handler.logger.Info("role assigned to group",
+		logger.String("group_id", existingGroup.ID().String()),
+		logger.String("role_id", command.RoleID.String()),
+	)
+
+	return groupdto.GroupFromDomain(existingGroup), nil
+}
@@ -0,0 +1,113 @@
+package groupcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestAssignRoleToGroupHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	userRepo := testutil.NewMockUserRepository()
+	eventBus := testutil.NewMockEventBus()
+	logger := testutil.NewNoopLogger()
+
+	g, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+	g.ClearDomainEvents()
+	groupRepo.AddGroup(g)
+
+	globalRole, _ := role.NewRole(role.NewRoleParams{Name: "support", DisplayName: "Support"})
+	roleRepo.AddRole(globalRole)
+
+	organizationID := uuid.New()
+	scopedRole, _ := role.NewRole(role.NewRoleParams{Name: "acme_support", DisplayName: "Acme Support", OrganizationID: &organizationID})
+	roleRepo.AddRole(scopedRole)
+
+	assignHandler := NewAssignRoleToGroupHandler(groupRepo, roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, groupRepo), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, groupRepo), eventBus, logger)
+
+	result, err := assignHandler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: globalRole.ID()})
+	require.NoError(t, err)
+	assert.Contains(t, result.RoleIDs, globalRole.ID())
+	testutil.AssertDomainEventPublished(t, eventBus, group.EventTypeGroupRoleAssigned)
+
+	_, err = assignHandler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: globalRole.ID()})
+	assert.ErrorIs(t, err, group.ErrRoleAlreadyAssigned)
+
+	_, err = assignHandler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: scopedRole.ID()})
+	assert.ErrorIs(t, err, group.ErrRoleOutsideGroupOrganization)
+
+	revokeHandler := NewRevokeRoleFromGroupHandler(groupRepo, roleRepo, authz.NewDelegationPolicy(userRepo, roleRepo, groupRepo), eventBus, logger)
+
+	result, err = revokeHandler.Handle(ctx, RevokeRoleFromGroupCommand{GroupID: g.ID(), RoleID: globalRole.ID()})
+	require.NoError(t, err)
+	assert.NotContains(t, result.RoleIDs, globalRole.ID())
+	testutil.AssertDomainEventPublished(t, eventBus, group.EventTypeGroupRoleRevoked)
+}
+
+func TestAssignRoleToGroupHandler_Handle_Delegation(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 50})
+	superAdminRole, _ := role.NewRole(role.NewRoleParams{Name: "super_admin", DisplayName: "Super Admin", Priority: 90})
+	supportRole, _ := role.NewRole(role.NewRoleParams{Name: "support", DisplayName: "Support", Priority: 10})
+	roleRepo.AddRole(adminRole)
+	roleRepo.AddRole(superAdminRole)
+	roleRepo.AddRole(supportRole)
+
+	actor := testutil.CreateActiveUser()
+	_ = actor.AssignRole(adminRole.ID())
+	userRepo.AddUser(actor)
+	actorID := actor.ID()
+
+	handler := NewAssignRoleToGroupHandler(groupRepo, roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, groupRepo), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, groupRepo), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+	t.Run("rejects a group the actor belongs to", func(t *testing.T) {
+		g, _ := group.NewGroup(group.NewGroupParams{Name: "Own Team"})
+		groupRepo.AddGroup(g)
+		groupRepo.AddMembership(g.ID(), actor.ID())
+
+		_, err := handler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: supportRole.ID(), ActorID: &actorID})
+
+		assert.EqualError(t, err, authz.ErrSelfEscalation.Error())
+	})
+
+	t.Run("rejects a group with a member who outranks the actor", func(t *testing.T) {
+		superior := testutil.CreateActiveUser()
+		_ = superior.AssignRole(superAdminRole.ID())
+		userRepo.AddUser(superior)
+		g, _ := group.NewGroup(group.NewGroupParams{Name: "Leads"})
+		groupRepo.AddGroup(g)
+		groupRepo.AddMembership(g.ID(), superior.ID())
+
+		_, err := handler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: supportRole.ID(), ActorID: &actorID})
+
+		assert.EqualError(t, err, authz.ErrUserOutranksActor.Error())
+	})
+
+	t.Run("grants to members the actor may manage", func(t *testing.T) {
+		member := testutil.CreateActiveUser()
+		userRepo.AddUser(member)
+		g, _ := group.NewGroup(group.NewGroupParams{Name: "Agents"})
+		groupRepo.AddGroup(g)
+		groupRepo.AddMembership(g.ID(), member.ID())
+
+		result, err := handler.Handle(ctx, AssignRoleToGroupCommand{GroupID: g.ID(), RoleID: supportRole.ID(), ActorID: &actorID})
+
+		require.NoError(t, err)
+		assert.Contains(t, result.RoleIDs, supportRole.ID())
+	})
+}
@@ -0,0 +1,126 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CreateGroupCommand struct {
+	Name           string
+	Description    string
+	OrganizationID *uuid.UUID
+	RoleIDs        []uuid.UUID
+}
+
+type CreateGroupHandler struct {
+	groupRepository        group.Repository
+	roleRepository         role.Repository
+	organizationRepository organization.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewCreateGroupHandler(
+	groupRepository group.Repository,
+	roleRepository role.Repository,
+	organizationRepository organization.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CreateGroupHandler {
+	return &CreateGroupHandler{
+		groupRepository:        groupRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *CreateGroupHandler) Handle(context context.Context, command CreateGroupCommand) (*groupdto.GroupDTO, error) {
+	if command.OrganizationID != nil {
+		if _, err := handler.organizationRepository.FindByID(context, *command.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	newGroup, err := group.NewGroup(group.NewGroupParams{
+		Name:           command.Name,
+		Description:    command.Description,
+		OrganizationID: command.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := handler.groupRepository.ExistsByName(context, newGroup.Name(), command.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("check group name exists: %w", err)
+	}
+	if exists {
+		return nil, group.NewGroupNameExistsError(newGroup.Name())
+	}
+
+	for _, roleID := range command.RoleIDs {
+		if newGroup.HasRole(roleID) {
+			continue
+		}
+		if _, err := ensureRoleMatchesGroup(context, handler.roleRepository, newGroup, roleID); err != nil {
+			return nil, err
+		}
+		if err := newGroup.AssignRole(roleID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := handler.groupRepository.Create(context, newGroup); err != nil {
+		return nil, fmt.Errorf("save group: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, newGroup.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("group_id", newGroup.ID().String()),
+				logger.Err(err),
+			)
+		}
+		newGroup.ClearDomainEvents()
+	}
+
+	handler.logger.Info("group created successfully",
+		logger.String("group_id", newGroup.ID().String()),
+		logger.String("name", newGroup.Name()),
+	)
+
+	return groupdto.GroupFromDomain(newGroup), nil
+}
+
+func ensureRoleMatchesGroup(context context.Context, roleRepository role.Repository, targetGroup *group.Group, roleID uuid.UUID) (*role.Role, error) {
+	existingRole, err := roleRepository.FindByID(context, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupOrganizationID := targetGroup.OrganizationID()
+	roleOrganizationID := existingRole.OrganizationID()
+	if groupOrganizationID == nil || roleOrganizationID == nil {
+		if groupOrganizationID != roleOrganizationID {
+			return nil, group.ErrRoleOutsideGroupOrganization
+		}
+		return existingRole, nil
+	}
+
+	if *groupOrganizationID != *roleOrganizationID {
+		return nil, group.ErrRoleOutsideGroupOrganization
+	}
+
+	return existingRole, nil
+}
@@ -0,0 +1,109 @@
+package groupcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestCreateGroupHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockGroupRepository, *testutil.MockRoleRepository, *testutil.MockOrganizationRepository) CreateGroupCommand
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "successfully create global group with roles",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, roleRepo *testutil.MockRoleRepository, organizationRepo *testutil.MockOrganizationRepository) CreateGroupCommand {
+				r, _ := role.NewRole(role.NewRoleParams{Name: "support", DisplayName: "Support"})
+				roleRepo.AddRole(r)
+				return CreateGroupCommand{Name: "Support Team", RoleIDs: []uuid.UUID{r.ID()}}
+			},
+			wantErr: false,
+		},
+		{
+			name: "successfully create organization group with scoped role",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, roleRepo *testutil.MockRoleRepository, organizationRepo *testutil.MockOrganizationRepository) CreateGroupCommand {
+				o, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme", Slug: "acme"})
+				organizationRepo.AddOrganization(o)
+				organizationID := o.ID()
+				r, _ := role.NewRole(role.NewRoleParams{Name: "acme_support", DisplayName: "Acme Support", OrganizationID: &organizationID})
+				roleRepo.AddRole(r)
+				return CreateGroupCommand{Name: "Acme Support", OrganizationID: &organizationID, RoleIDs: []uuid.UUID{r.ID()}}
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail when role scope differs from group scope",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, roleRepo *testutil.MockRoleRepository, organizationRepo *testutil.MockOrganizationRepository) CreateGroupCommand {
+				o, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme", Slug: "acme"})
+				organizationRepo.AddOrganization(o)
+				organizationID := o.ID()
+				r, _ := role.NewRole(role.NewRoleParams{Name: "acme_support", DisplayName: "Acme Support", OrganizationID: &organizationID})
+				roleRepo.AddRole(r)
+				return CreateGroupCommand{Name: "Global Support", RoleIDs: []uuid.UUID{r.ID()}}
+			},
+			wantErr:     true,
+			errContains: "role scope does not match",
+		},
+		{
+			name: "fail when role not found",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, roleRepo *testutil.MockRoleRepository, organizationRepo *testutil.MockOrganizationRepository) CreateGroupCommand {
+				return CreateGroupCommand{Name: "Support Team", RoleIDs: []uuid.UUID{uuid.New()}}
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name: "fail when name already exists",
+			setupMocks: func(groupRepo *testutil.MockGroupRepository, roleRepo *testutil.MockRoleRepository, organizationRepo *testutil.MockOrganizationRepository) CreateGroupCommand {
+				existing, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+				groupRepo.AddGroup(existing)
+				return CreateGroupCommand{Name: "support team"}
+			},
+			wantErr:     true,
+			errContains: "already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupRepo := testutil.NewMockGroupRepository()
+			roleRepo := testutil.NewMockRoleRepository()
+			organizationRepo := testutil.NewMockOrganizationRepository()
+			eventBus := testutil.NewMockEventBus()
+			logger := testutil.NewNoopLogger()
+
+			command := tt.setupMocks(groupRepo, roleRepo, organizationRepo)
+
+			handler := NewCreateGroupHandler(groupRepo, roleRepo, organizationRepo, eventBus, logger)
+			result, err := handler.Handle(ctx, command)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, command.Name, result.Name)
+			assert.Equal(t, command.OrganizationID, result.OrganizationID)
+			assert.ElementsMatch(t, command.RoleIDs, result.RoleIDs)
+			assert.Len(t, groupRepo.Groups, 1)
+			testutil.AssertDomainEventPublished(t, eventBus, group.EventTypeGroupCreated)
+		})
+	}
+}
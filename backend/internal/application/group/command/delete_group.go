@@ -0,0 +1,62 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DeleteGroupCommand struct {
+	GroupID uuid.UUID
+}
+
+type DeleteGroupHandler struct {
+	groupRepository group.Repository
+	eventBus        shared.EventBus
+	logger          logger.Logger
+}
+
+func NewDeleteGroupHandler(
+	groupRepository group.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DeleteGroupHandler {
+	return &DeleteGroupHandler{
+		groupRepository: groupRepository,
+		eventBus:        eventBus,
+		logger:          logger,
+	}
+}
+
+func (handler *DeleteGroupHandler) Handle(context context.Context, command DeleteGroupCommand) error {
+	existingGroup, err := handler.groupRepository.FindByID(context, command.GroupID)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.groupRepository.Delete(context, command.GroupID); err != nil {
+		return fmt.Errorf("delete group: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := group.NewGroupDeletedEvent(existingGroup.ID(), existingGroup.Name())
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish group deleted event",
+				logger.String("group_id", existingGroup.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("group deleted successfully",
+		logger.String("group_id", existingGroup.ID().String()),
+		logger.String("name", existingGroup.Name()),
+	)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type RemoveGroupMemberCommand struct {
+	GroupID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type RemoveGroupMemberHandler struct {
+	groupRepository group.Repository
+	eventBus        shared.EventBus
+	logger          logger.Logger
+}
+
+func NewRemoveGroupMemberHandler(
+	groupRepository group.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *RemoveGroupMemberHandler {
+	return &RemoveGroupMemberHandler{
+		groupRepository: groupRepository,
+		eventBus:        eventBus,
+		logger:          logger,
+	}
+}
+
+func (handler *RemoveGroupMemberHandler) Handle(context context.Context, command RemoveGroupMemberCommand) error {
+	if _, err := handler.groupRepository.FindByID(context, command.GroupID); err != nil {
+		return err
+	}
+
+	isMember, err := handler.groupRepository.IsMember(context, command.GroupID, command.UserID)
+	if err != nil {
+		return fmt.Errorf("check group membership: %w", err)
+	}
+	if !isMember {
+		return group.ErrNotMember
+	}
+
+	if err := handler.groupRepository.RemoveMember(context, command.GroupID, command.UserID); err != nil {
+		return fmt.Errorf("remove group member: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := group.NewGroupMemberRemovedEvent(command.GroupID, command.UserID)
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish group member removed event",
+				logger.String("group_id", command.GroupID.String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("user removed from group",
+		logger.String("group_id", command.GroupID.String()),
+		logger.String("user_id", command.UserID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type RevokeRoleFromGroupCommand struct {
+	GroupID uuid.UUID
+	RoleID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type RevokeRoleFromGroupHandler struct {
+	groupRepository  group.Repository
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewRevokeRoleFromGroupHandler(
+	groupRepository group.Repository,
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *RevokeRoleFromGroupHandler {
+	return &RevokeRoleFromGroupHandler{
+		groupRepository:  groupRepository,
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+func (handler *RevokeRoleFromGroupHandler) Handle(context context.Context, command RevokeRoleFromGroupCommand) (*groupdto.GroupDTO, error) {
+	existingGroup, err := handler.groupRepository.FindByID(context, command.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	revokedRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(revokedRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingGroup.RevokeRole(command.RoleID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.groupRepository.Update(context, existingGroup); err != nil {
+		return nil, fmt.Errorf("update group: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingGroup.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("group_id", existingGroup.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingGroup.ClearDomainEvents()
+	}
+
+	handler.logger.Info("role revoked from group",
+		logger.String("group_id", existingGroup.ID().String()),
+		logger.String("role_id", command.RoleID.String()),
+	)
+
+	return groupdto.GroupFromDomain(existingGroup), nil
+}
@@ -0,0 +1,80 @@
+package groupcommand
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UpdateGroupCommand struct {
+	GroupID     uuid.UUID
+	Name        string
+	Description string
+}
+
+type UpdateGroupHandler struct {
+	groupRepository group.Repository
+	eventBus        shared.EventBus
+	logger          logger.Logger
+}
+
+func NewUpdateGroupHandler(
+	groupRepository group.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *UpdateGroupHandler {
+	return &UpdateGroupHandler{
+		groupRepository: groupRepository,
+		eventBus:        eventBus,
+		logger:          logger,
+	}
+}
+
+func (handler *UpdateGroupHandler) Handle(context context.Context, command UpdateGroupCommand) (*groupdto.GroupDTO, error) {
+	existingGroup, err := handler.groupRepository.FindByID(context, command.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(command.Name)
+	if name != "" && !strings.EqualFold(name, existingGroup.Name()) {
+		exists, err := handler.groupRepository.ExistsByName(context, name, existingGroup.OrganizationID())
+		if err != nil {
+			return nil, fmt.Errorf("check group name exists: %w", err)
+		}
+		if exists {
+			return nil, group.NewGroupNameExistsError(name)
+		}
+	}
+
+	if err := existingGroup.UpdateDetails(name, command.Description); err != nil {
+		return nil, err
+	}
+
+	if err := handler.groupRepository.Update(context, existingGroup); err != nil {
+		return nil, fmt.Errorf("update group: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingGroup.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("group_id", existingGroup.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingGroup.ClearDomainEvents()
+	}
+
+	handler.logger.Info("group updated successfully",
+		logger.String("group_id", existingGroup.ID().String()),
+	)
+
+	return groupdto.GroupFromDomain(existingGroup), nil
+}
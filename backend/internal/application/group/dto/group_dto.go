@@ -0,0 +1,65 @@
+package groupdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type GroupDTO struct {
+	ID             uuid.UUID   `json:"id"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	OrganizationID *uuid.UUID  `json:"organization_id,omitempty"`
+	RoleIDs        []uuid.UUID `json:"role_ids"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+func GroupFromDomain(domainGroup *group.Group) *GroupDTO {
+	if domainGroup == nil {
+		return nil
+	}
+	return &GroupDTO{
+		ID:             domainGroup.ID(),
+		Name:           domainGroup.Name(),
+		Description:    domainGroup.Description(),
+		OrganizationID: domainGroup.OrganizationID(),
+		RoleIDs:        domainGroup.RoleIDs(),
+		CreatedAt:      domainGroup.CreatedAt(),
+		UpdatedAt:      domainGroup.UpdatedAt(),
+	}
+}
+
+func GroupsFromDomain(domainGroups []*group.Group) []*GroupDTO {
+	dtos := make([]*GroupDTO, len(domainGroups))
+	for i, domainGroup := range domainGroups {
+		dtos[i] = GroupFromDomain(domainGroup)
+	}
+	return dtos
+}
+
+type PaginatedGroupsDTO struct {
+	Items      []*GroupDTO `json:"items"`
+	Total      int64       `json:"total"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	TotalPages int         `json:"total_pages"`
+	HasNext    bool        `json:"has_next"`
+	HasPrev    bool        `json:"has_prev"`
+}
+
+func NewPaginatedGroupsDTO(groups []*group.Group, total int64, pagination shared.Pagination) *PaginatedGroupsDTO {
+	return &PaginatedGroupsDTO{
+		Items:      GroupsFromDomain(groups),
+		Total:      total,
+		Page:       pagination.Page(),
+		Limit:      pagination.Limit(),
+		TotalPages: pagination.TotalPages(total),
+		HasNext:    pagination.HasNext(total),
+		HasPrev:    pagination.HasPrev(),
+	}
+}
@@ -0,0 +1,39 @@
+package groupquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetGroupQuery struct {
+	GroupID uuid.UUID
+}
+
+type GetGroupHandler struct {
+	groupRepository group.Repository
+	logger          logger.Logger
+}
+
+func NewGetGroupHandler(
+	groupRepository group.Repository,
+	logger logger.Logger,
+) *GetGroupHandler {
+	return &GetGroupHandler{
+		groupRepository: groupRepository,
+		logger:          logger,
+	}
+}
+
+func (handler *GetGroupHandler) Handle(context context.Context, query GetGroupQuery) (*groupdto.GroupDTO, error) {
+	foundGroup, err := handler.groupRepository.FindByID(context, query.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupdto.GroupFromDomain(foundGroup), nil
+}
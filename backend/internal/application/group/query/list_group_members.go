@@ -0,0 +1,61 @@
+package groupquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListGroupMembersQuery struct {
+	GroupID uuid.UUID
+}
+
+type ListGroupMembersHandler struct {
+	groupRepository group.Repository
+	userRepository  user.Repository
+	logger          logger.Logger
+}
+
+func NewListGroupMembersHandler(
+	groupRepository group.Repository,
+	userRepository user.Repository,
+	logger logger.Logger,
+) *ListGroupMembersHandler {
+	return &ListGroupMembersHandler{
+		groupRepository: groupRepository,
+		userRepository:  userRepository,
+		logger:          logger,
+	}
+}
+
+func (handler *ListGroupMembersHandler) Handle(context context.Context, query ListGroupMembersQuery) ([]*userdto.UserDTO, error) {
+	if _, err := handler.groupRepository.FindByID(context, query.GroupID); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := handler.groupRepository.ListMemberIDs(context, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("list group members: %w", err)
+	}
+
+	members := make([]*user.User, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		member, err := handler.userRepository.FindByID(context, memberID)
+		if err != nil {
+			if shared.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get group member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	return userdto.UsersFromDomain(members), nil
+}
@@ -0,0 +1,57 @@
+package groupquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListGroupMembersHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	g, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+	groupRepo.AddGroup(g)
+
+	member := testutil.CreateActiveUser()
+	userRepo.AddUser(member)
+	groupRepo.AddMembership(g.ID(), member.ID())
+	groupRepo.AddMembership(g.ID(), uuid.New())
+
+	handler := NewListGroupMembersHandler(groupRepo, userRepo, testutil.NewNoopLogger())
+
+	members, err := handler.Handle(ctx, ListGroupMembersQuery{GroupID: g.ID()})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, member.ID(), members[0].ID)
+
+	_, err = handler.Handle(ctx, ListGroupMembersQuery{GroupID: uuid.New()})
+	testutil.AssertNotFoundError(t, err)
+}
+
+func TestListUserGroupsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	groupRepo := testutil.NewMockGroupRepository()
+
+	support, _ := group.NewGroup(group.NewGroupParams{Name: "Support Team"})
+	engineering, _ := group.NewGroup(group.NewGroupParams{Name: "Engineering"})
+	groupRepo.AddGroup(support)
+	groupRepo.AddGroup(engineering)
+
+	userID := uuid.New()
+	groupRepo.AddMembership(support.ID(), userID)
+
+	handler := NewListUserGroupsHandler(groupRepo, testutil.NewNoopLogger())
+
+	groups, err := handler.Handle(ctx, ListUserGroupsQuery{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, support.ID(), groups[0].ID)
+}
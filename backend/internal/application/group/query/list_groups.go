@@ -0,0 +1,50 @@
+package groupquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListGroupsQuery struct {
+	Page           int
+	Limit          int
+	Search         *string
+	OrganizationID *uuid.UUID
+}
+
+type ListGroupsHandler struct {
+	groupRepository group.Repository
+	logger          logger.Logger
+}
+
+func NewListGroupsHandler(
+	groupRepository group.Repository,
+	logger logger.Logger,
+) *ListGroupsHandler {
+	return &ListGroupsHandler{
+		groupRepository: groupRepository,
+		logger:          logger,
+	}
+}
+
+func (handler *ListGroupsHandler) Handle(context context.Context, query ListGroupsQuery) (*groupdto.PaginatedGroupsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+	filter := group.Filter{
+		OrganizationID: query.OrganizationID,
+		Search:         query.Search,
+	}
+
+	groups, total, err := handler.groupRepository.List(context, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list groups: %w", err)
+	}
+
+	return groupdto.NewPaginatedGroupsDTO(groups, total, pagination), nil
+}
@@ -0,0 +1,40 @@
+package groupquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListUserGroupsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListUserGroupsHandler struct {
+	groupRepository group.Repository
+	logger          logger.Logger
+}
+
+func NewListUserGroupsHandler(
+	groupRepository group.Repository,
+	logger logger.Logger,
+) *ListUserGroupsHandler {
+	return &ListUserGroupsHandler{
+		groupRepository: groupRepository,
+		logger:          logger,
+	}
+}
+
+func (handler *ListUserGroupsHandler) Handle(context context.Context, query ListUserGroupsQuery) ([]*groupdto.GroupDTO, error) {
+	groups, err := handler.groupRepository.FindByMember(context, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("list user groups: %w", err)
+	}
+
+	return groupdto.GroupsFromDomain(groups), nil
+}
@@ -0,0 +1,171 @@
+package invitationcommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/security"
+)
+
+type AcceptInvitationCommand struct {
+	Token    string
+	Password string
+	FullName string
+}
+
+type AcceptInvitationHandler struct {
+	invitationRepository invitation.Repository
+	userRepository       user.Repository
+	roleRepository       role.Repository
+	passwordHasher       security.PasswordHasher
+	sodChecker           *sod.Checker
+	transactionManager   shared.TransactionManager
+	eventBus             shared.EventBus
+	logger               logger.Logger
+}
+
+func NewAcceptInvitationHandler(
+	invitationRepository invitation.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	passwordHasher security.PasswordHasher,
+	sodChecker *sod.Checker,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *AcceptInvitationHandler {
+	return &AcceptInvitationHandler{
+		invitationRepository: invitationRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		passwordHasher:       passwordHasher,
+		sodChecker:           sodChecker,
+		transactionManager:   transactionManager,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+// Handle creates the invitee's account, active and holding the invited
+// roles, and marks the invitation accepted in the same transaction. Links
+// that were already used, revoked or replaced by a resend are rejected as
+// invalid; a lapsed link is marked expired.
+func (handler *AcceptInvitationHandler) Handle(ctx context.Context, command AcceptInvitationCommand) (*userdto.UserDTO, error) {
+	if command.Token == "" {
+		return nil, invitation.ErrInvalidInvitationToken
+	}
+
+	pendingInvitation, err := handler.invitationRepository.FindByTokenHash(ctx, hashInvitationToken(command.Token))
+	if err != nil {
+		return nil, err
+	}
+	if !pendingInvitation.Status().IsPending() {
+		return nil, invitation.ErrInvalidInvitationToken
+	}
+
+	now := time.Now().UTC()
+	if pendingInvitation.IsExpiredAt(now) {
+		if err := pendingInvitation.Expire(); err != nil {
+			return nil, err
+		}
+		if err := handler.invitationRepository.Update(ctx, pendingInvitation); err != nil {
+			return nil, fmt.Errorf("update invitation: %w", err)
+		}
+		publishInvitationEvents(ctx, handler.eventBus, handler.logger, pendingInvitation)
+		return nil, invitation.ErrInvitationExpired
+	}
+
+	if err := shared.ValidatePassword(command.Password); err != nil {
+		return nil, err
+	}
+
+	email := pendingInvitation.Email().String()
+	exists, err := handler.userRepository.ExistsByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(email)
+	}
+
+	roleIDs := pendingInvitation.RoleIDs()
+	if len(roleIDs) > 0 {
+		roles, err := handler.roleRepository.FindByIDs(ctx, roleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("validate roles: %w", err)
+		}
+		if len(roles) != len(roleIDs) {
+			return nil, role.ErrRoleNotFound
+		}
+	}
+
+	hashedPassword, err := handler.passwordHasher.Hash(command.Password)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	newUser, err := user.NewUser(user.NewUserParams{
+		Email:        email,
+		PasswordHash: hashedPassword,
+		FullName:     command.FullName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, roleID := range roleIDs {
+		if err := newUser.AssignRole(roleID); err != nil {
+			return nil, err
+		}
+	}
+	if err := newUser.Activate(); err != nil {
+		return nil, err
+	}
+
+	if err := handler.sodChecker.CheckUser(ctx, newUser, roleIDs...); err != nil {
+		return nil, err
+	}
+
+	if err := pendingInvitation.Accept(newUser.ID(), now); err != nil {
+		return nil, err
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if err := handler.userRepository.Create(txContext, newUser); err != nil {
+			return fmt.Errorf("save user: %w", err)
+		}
+		if err := handler.invitationRepository.Update(txContext, pendingInvitation); err != nil {
+			return fmt.Errorf("update invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if handler.eventBus != nil {
+		events := append(newUser.DomainEvents(), pendingInvitation.DomainEvents()...)
+		if err := handler.eventBus.Publish(ctx, events...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", newUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		newUser.ClearDomainEvents()
+		pendingInvitation.ClearDomainEvents()
+	}
+
+	handler.logger.Info("invitation accepted",
+		logger.String("invitation_id", pendingInvitation.ID().String()),
+		logger.String("user_id", newUser.ID().String()),
+	)
+
+	return userdto.UserFromDomain(newUser), nil
+}
@@ -0,0 +1,153 @@
+package invitationcommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestAcceptInvitationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	type acceptSetup struct {
+		*invitationFixture
+		sodRepo   *testutil.MockSoDRuleRepository
+		txManager *testutil.MockTransactionManager
+		handler   *AcceptInvitationHandler
+	}
+
+	setup := func(t *testing.T) *acceptSetup {
+		f := newInvitationFixture(t)
+		sodRepo := testutil.NewMockSoDRuleRepository()
+		txManager := testutil.NewMockTransactionManager()
+		handler := NewAcceptInvitationHandler(
+			f.invitationRepo,
+			f.userRepo,
+			f.roleRepo,
+			testutil.NewMockPasswordHasher(),
+			sod.NewChecker(sodRepo, f.userRepo, nil),
+			txManager,
+			f.eventBus,
+			testutil.NewNoopLogger(),
+		)
+		return &acceptSetup{invitationFixture: f, sodRepo: sodRepo, txManager: txManager, handler: handler}
+	}
+
+	validCommand := AcceptInvitationCommand{Token: "token", Password: "SecurePass123!", FullName: "Invited User"}
+
+	t.Run("creates an active user with the invited roles", func(t *testing.T) {
+		s := setup(t)
+		inv := s.addInvitation(t, "invitee@example.com", "token", s.memberRole.ID())
+
+		result, err := s.handler.Handle(ctx, validCommand)
+
+		require.NoError(t, err)
+		assert.Equal(t, "invitee@example.com", result.Email)
+		assert.Equal(t, "Invited User", result.FullName)
+		assert.Equal(t, user.StatusActive.String(), result.Status)
+
+		created, err := s.userRepo.FindByEmail(ctx, "invitee@example.com")
+		require.NoError(t, err)
+		assert.True(t, created.HasRole(s.memberRole.ID()))
+
+		assert.Equal(t, invitation.StatusAccepted, inv.Status())
+		assert.Equal(t, created.ID(), *inv.UserID())
+		assert.Equal(t, 1, s.txManager.Transactions)
+
+		eventTypes := make([]string, 0)
+		for _, event := range s.eventBus.PublishedEvents {
+			eventTypes = append(eventTypes, event.EventType())
+		}
+		assert.Contains(t, eventTypes, user.EventTypeUserCreated)
+		assert.Contains(t, eventTypes, user.EventTypeUserActivated)
+		assert.Contains(t, eventTypes, invitation.EventTypeInvitationAccepted)
+	})
+
+	t.Run("link is single-use", func(t *testing.T) {
+		s := setup(t)
+		s.addInvitation(t, "invitee@example.com", "token")
+
+		_, err := s.handler.Handle(ctx, validCommand)
+		require.NoError(t, err)
+		_, err = s.handler.Handle(ctx, validCommand)
+
+		assert.ErrorIs(t, err, invitation.ErrInvalidInvitationToken)
+	})
+
+	t.Run("rejects unknown and revoked tokens", func(t *testing.T) {
+		s := setup(t)
+		inv := s.addInvitation(t, "invitee@example.com", "token")
+		require.NoError(t, inv.Revoke(uuid.New()))
+
+		_, err := s.handler.Handle(ctx, AcceptInvitationCommand{Token: "other", Password: "SecurePass123!", FullName: "Invited User"})
+		assert.ErrorIs(t, err, invitation.ErrInvalidInvitationToken)
+
+		_, err = s.handler.Handle(ctx, validCommand)
+		assert.ErrorIs(t, err, invitation.ErrInvalidInvitationToken)
+		assert.Nil(t, s.userRepo.EmailIndex["invitee@example.com"])
+	})
+
+	t.Run("marks lapsed invitation expired", func(t *testing.T) {
+		s := setup(t)
+		inv, err := invitation.ReconstructInvitation(invitation.ReconstructInvitationParams{
+			ID:        uuid.New(),
+			Email:     "invitee@example.com",
+			InvitedBy: s.actor.ID(),
+			TokenHash: hashInvitationToken("token"),
+			Status:    invitation.StatusPending,
+			ExpiresAt: time.Now().Add(-time.Minute),
+			CreatedAt: time.Now().Add(-time.Hour),
+			UpdatedAt: time.Now().Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		s.invitationRepo.AddInvitation(inv)
+
+		_, err = s.handler.Handle(ctx, validCommand)
+
+		assert.ErrorIs(t, err, invitation.ErrInvitationExpired)
+		assert.Equal(t, invitation.StatusExpired, inv.Status())
+		assert.Nil(t, s.userRepo.EmailIndex["invitee@example.com"])
+	})
+
+	t.Run("validates password before creating the account", func(t *testing.T) {
+		s := setup(t)
+		inv := s.addInvitation(t, "invitee@example.com", "token")
+
+		_, err := s.handler.Handle(ctx, AcceptInvitationCommand{Token: "token", Password: "weak", FullName: "Invited User"})
+
+		assert.True(t, shared.IsValidationError(err))
+		assert.Equal(t, invitation.StatusPending, inv.Status())
+	})
+
+	t.Run("enforces separation of duties on invited roles", func(t *testing.T) {
+		s := setup(t)
+		auditorRole, err := role.NewRole(role.NewRoleParams{Name: "auditor", DisplayName: "Auditor", Priority: 10})
+		require.NoError(t, err)
+		s.roleRepo.AddRole(auditorRole)
+		rule, err := sod.NewRule(sod.NewRuleParams{
+			Name:    "member vs auditor",
+			Type:    sod.RuleTypeMutualExclusion,
+			RoleIDs: []uuid.UUID{s.memberRole.ID(), auditorRole.ID()},
+		})
+		require.NoError(t, err)
+		s.sodRepo.AddRule(rule)
+		inv := s.addInvitation(t, "invitee@example.com", "token", s.memberRole.ID(), auditorRole.ID())
+
+		_, err = s.handler.Handle(ctx, validCommand)
+
+		assert.True(t, shared.IsBusinessRuleViolationError(err))
+		assert.Equal(t, invitation.StatusPending, inv.Status())
+		assert.Nil(t, s.userRepo.EmailIndex["invitee@example.com"])
+	})
+}
@@ -0,0 +1,234 @@
+package invitationcommand
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	invitationdto "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	invitationSubject = "You have been invited to create an account"
+	invitationBody    = "Hello,\n\nYou have been invited to create an account. Open the link below before {expires} to choose your name and password:\n\n{link}\n\nThe link can only be used once. If you were not expecting this invitation, ignore this message.\n"
+)
+
+// InvitationSettings configures invitation links. The token is appended to
+// AcceptURL as a "token" query parameter.
+type InvitationSettings struct {
+	TokenTTL  time.Duration
+	AcceptURL string
+}
+
+type CreateInvitationCommand struct {
+	Email     string
+	RoleIDs   []uuid.UUID
+	InvitedBy uuid.UUID
+}
+
+type CreateInvitationHandler struct {
+	invitationRepository invitation.Repository
+	userRepository       user.Repository
+	roleRepository       role.Repository
+	delegationPolicy     *authz.DelegationPolicy
+	mailer               shared.Mailer
+	eventBus             shared.EventBus
+	logger               logger.Logger
+	settings             InvitationSettings
+}
+
+func NewCreateInvitationHandler(
+	invitationRepository invitation.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	mailer shared.Mailer,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	settings InvitationSettings,
+) *CreateInvitationHandler {
+	return &CreateInvitationHandler{
+		invitationRepository: invitationRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		delegationPolicy:     delegationPolicy,
+		mailer:               mailer,
+		eventBus:             eventBus,
+		logger:               logger,
+		settings:             settings,
+	}
+}
+
+// Handle records a pending invitation and mails the single-use link to the
+// invitee. Only global roles can be pre-assigned, since the invitee does not
+// belong to any organization yet.
+func (handler *CreateInvitationHandler) Handle(ctx context.Context, command CreateInvitationCommand) (*invitationdto.InvitationDTO, error) {
+	email, err := shared.NewEmail(command.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := handler.userRepository.ExistsByEmail(ctx, email.String())
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(email.String())
+	}
+
+	pending, err := handler.invitationRepository.ExistsPendingForEmail(ctx, email.String())
+	if err != nil {
+		return nil, fmt.Errorf("check pending invitation: %w", err)
+	}
+	if pending {
+		return nil, invitation.ErrPendingInvitationExists
+	}
+
+	if err := ensureCanInviteWithRoles(ctx, handler.delegationPolicy, handler.roleRepository, command.InvitedBy, command.RoleIDs); err != nil {
+		return nil, err
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	newInvitation, err := invitation.NewInvitation(invitation.NewInvitationParams{
+		Email:     email.String(),
+		RoleIDs:   command.RoleIDs,
+		InvitedBy: command.InvitedBy,
+		TokenHash: hashInvitationToken(token),
+		ExpiresAt: time.Now().UTC().Add(handler.settings.TokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.invitationRepository.Create(ctx, newInvitation); err != nil {
+		return nil, fmt.Errorf("save invitation: %w", err)
+	}
+
+	if err := sendInvitationEmail(ctx, handler.mailer, newInvitation, handler.settings, token); err != nil {
+		return nil, err
+	}
+
+	publishInvitationEvents(ctx, handler.eventBus, handler.logger, newInvitation)
+
+	handler.logger.Info("invitation created",
+		logger.String("invitation_id", newInvitation.ID().String()),
+		logger.String("invited_by", command.InvitedBy.String()),
+	)
+
+	return invitationdto.InvitationFromDomain(newInvitation), nil
+}
+
+// ensureCanInviteWithRoles checks that every role exists, is global and
+// ranks below the actor, the same rules that apply when granting the roles
+// to an existing user.
+func ensureCanInviteWithRoles(ctx context.Context, delegationPolicy *authz.DelegationPolicy, roleRepository role.Repository, actorID uuid.UUID, roleIDs []uuid.UUID) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	uniqueIDs := make([]uuid.UUID, 0, len(roleIDs))
+	seen := make(map[uuid.UUID]bool, len(roleIDs))
+	for _, roleID := range roleIDs {
+		if !seen[roleID] {
+			seen[roleID] = true
+			uniqueIDs = append(uniqueIDs, roleID)
+		}
+	}
+
+	roles, err := roleRepository.FindByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return fmt.Errorf("validate roles: %w", err)
+	}
+	if len(roles) != len(uniqueIDs) {
+		return role.ErrRoleNotFound
+	}
+
+	delegator, err := delegationPolicy.ForActor(ctx, &actorID)
+	if err != nil {
+		return err
+	}
+	for _, invitedRole := range roles {
+		if !invitedRole.IsGlobal() {
+			return organization.ErrRoleOutsideOrganization
+		}
+		if err := delegator.EnsureCanManageRole(invitedRole); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendInvitationEmail writes the invitation in the inviter's locale, as the
+// invitee has no preferences yet, and shows the expiry in the default format.
+func sendInvitationEmail(ctx context.Context, mailer shared.Mailer, inv *invitation.Invitation, settings InvitationSettings, token string) error {
+	locale := i18n.FromContext(ctx)
+	err := mailer.Send(ctx, shared.MailMessage{
+		To:      inv.Email().String(),
+		Subject: i18n.Translate(locale, invitationSubject, nil),
+		Body: i18n.Translate(locale, invitationBody, i18n.Params{
+			"expires": user.DefaultPreferences(uuid.Nil).FormatDateTime(inv.ExpiresAt()),
+			"link":    invitationLink(settings.AcceptURL, token),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("send invitation email: %w", err)
+	}
+	return nil
+}
+
+func publishInvitationEvents(ctx context.Context, eventBus shared.EventBus, log logger.Logger, inv *invitation.Invitation) {
+	if eventBus == nil {
+		return
+	}
+	if err := eventBus.Publish(ctx, inv.DomainEvents()...); err != nil {
+		log.Error("failed to publish domain events",
+			logger.String("invitation_id", inv.ID().String()),
+			logger.Err(err),
+		)
+	}
+	inv.ClearDomainEvents()
+}
+
+func generateInvitationToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func hashInvitationToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func invitationLink(baseURL, token string) string {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(token)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
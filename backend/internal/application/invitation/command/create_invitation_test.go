@@ -0,0 +1,193 @@
+package invitationcommand
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+var testInvitationSettings = InvitationSettings{
+	TokenTTL:  72 * time.Hour,
+	AcceptURL: "https://app.example.com/invitations/accept",
+}
+
+type invitationFixture struct {
+	invitationRepo *testutil.MockInvitationRepository
+	userRepo       *testutil.MockUserRepository
+	roleRepo       *testutil.MockRoleRepository
+	mailer         *testutil.MockMailer
+	eventBus       *testutil.MockEventBus
+	actor          *user.User
+	adminRole      *role.Role
+	memberRole     *role.Role
+}
+
+func newInvitationFixture(t *testing.T) *invitationFixture {
+	t.Helper()
+
+	adminRole, err := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	require.NoError(t, err)
+	memberRole, err := role.NewRole(role.NewRoleParams{Name: "member", DisplayName: "Member", Priority: 10})
+	require.NoError(t, err)
+
+	roleRepo := testutil.NewMockRoleRepository()
+	roleRepo.AddRole(adminRole)
+	roleRepo.AddRole(memberRole)
+
+	userRepo := testutil.NewMockUserRepository()
+	actor := testutil.CreateActiveUser()
+	require.NoError(t, actor.AssignRole(adminRole.ID()))
+	userRepo.AddUser(actor)
+
+	return &invitationFixture{
+		invitationRepo: testutil.NewMockInvitationRepository(),
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		mailer:         testutil.NewMockMailer(),
+		eventBus:       testutil.NewMockEventBus(),
+		actor:          actor,
+		adminRole:      adminRole,
+		memberRole:     memberRole,
+	}
+}
+
+func (f *invitationFixture) delegationPolicy() *authz.DelegationPolicy {
+	return authz.NewDelegationPolicy(f.userRepo, f.roleRepo, nil)
+}
+
+func (f *invitationFixture) createHandler() *CreateInvitationHandler {
+	return NewCreateInvitationHandler(f.invitationRepo, f.userRepo, f.roleRepo, f.delegationPolicy(), f.mailer, f.eventBus, testutil.NewNoopLogger(), testInvitationSettings)
+}
+
+func tokenFromMessage(t *testing.T, message shared.MailMessage) string {
+	t.Helper()
+	for _, line := range strings.Split(message.Body, "\n") {
+		if strings.HasPrefix(line, testInvitationSettings.AcceptURL) {
+			link, err := url.Parse(line)
+			require.NoError(t, err)
+			return link.Query().Get("token")
+		}
+	}
+	t.Fatalf("no invitation link in message body: %q", message.Body)
+	return ""
+}
+
+func TestCreateInvitationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records invitation and mails a single-use link", func(t *testing.T) {
+		f := newInvitationFixture(t)
+
+		result, err := f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     "Invitee@Example.com",
+			RoleIDs:   []uuid.UUID{f.memberRole.ID()},
+			InvitedBy: f.actor.ID(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "invitee@example.com", result.Email)
+		assert.Equal(t, string(invitation.StatusPending), result.Status)
+		assert.Equal(t, []uuid.UUID{f.memberRole.ID()}, result.RoleIDs)
+		assert.WithinDuration(t, time.Now().Add(testInvitationSettings.TokenTTL), result.ExpiresAt, time.Minute)
+
+		require.Len(t, f.mailer.Messages, 1)
+		assert.Equal(t, "invitee@example.com", f.mailer.Messages[0].To)
+		token := tokenFromMessage(t, f.mailer.Messages[0])
+		stored := f.invitationRepo.Invitations[result.ID]
+		require.NotNil(t, stored)
+		assert.Equal(t, hashInvitationToken(token), stored.TokenHash())
+		assert.NotContains(t, stored.TokenHash(), token)
+
+		require.Len(t, f.eventBus.PublishedEvents, 1)
+		assert.Equal(t, invitation.EventTypeInvitationCreated, f.eventBus.PublishedEvents[0].EventType())
+	})
+
+	t.Run("rejects email of an existing user", func(t *testing.T) {
+		f := newInvitationFixture(t)
+
+		_, err := f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     f.actor.Email().String(),
+			InvitedBy: f.actor.ID(),
+		})
+
+		assert.True(t, shared.IsConflictError(err))
+		assert.Empty(t, f.invitationRepo.Invitations)
+		assert.Empty(t, f.mailer.Messages)
+	})
+
+	t.Run("rejects second pending invitation for the same email", func(t *testing.T) {
+		f := newInvitationFixture(t)
+		handler := f.createHandler()
+		command := CreateInvitationCommand{Email: "invitee@example.com", InvitedBy: f.actor.ID()}
+
+		_, err := handler.Handle(ctx, command)
+		require.NoError(t, err)
+		_, err = handler.Handle(ctx, command)
+
+		assert.ErrorIs(t, err, invitation.ErrPendingInvitationExists)
+		assert.Len(t, f.invitationRepo.Invitations, 1)
+	})
+
+	t.Run("rejects role at or above the actor's priority", func(t *testing.T) {
+		f := newInvitationFixture(t)
+
+		_, err := f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     "invitee@example.com",
+			RoleIDs:   []uuid.UUID{f.adminRole.ID()},
+			InvitedBy: f.actor.ID(),
+		})
+
+		assert.ErrorIs(t, err, authz.ErrRoleOutranksActor)
+		assert.Empty(t, f.invitationRepo.Invitations)
+	})
+
+	t.Run("rejects unknown and organization roles", func(t *testing.T) {
+		f := newInvitationFixture(t)
+		organizationID := uuid.New()
+		scopedRole, err := role.NewRole(role.NewRoleParams{OrganizationID: &organizationID, Name: "org_member", DisplayName: "Org Member"})
+		require.NoError(t, err)
+		f.roleRepo.AddRole(scopedRole)
+
+		_, err = f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     "invitee@example.com",
+			RoleIDs:   []uuid.UUID{uuid.New()},
+			InvitedBy: f.actor.ID(),
+		})
+		assert.ErrorIs(t, err, role.ErrRoleNotFound)
+
+		_, err = f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     "invitee@example.com",
+			RoleIDs:   []uuid.UUID{scopedRole.ID()},
+			InvitedBy: f.actor.ID(),
+		})
+		assert.ErrorIs(t, err, organization.ErrRoleOutsideOrganization)
+	})
+
+	t.Run("returns mail failures", func(t *testing.T) {
+		f := newInvitationFixture(t)
+		f.mailer.SendError = errors.New("smtp unavailable")
+
+		_, err := f.createHandler().Handle(ctx, CreateInvitationCommand{
+			Email:     "invitee@example.com",
+			InvitedBy: f.actor.ID(),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "send invitation email")
+	})
+}
@@ -0,0 +1,102 @@
+package invitationcommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	invitationdto "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ResendInvitationCommand struct {
+	InvitationID uuid.UUID
+	ActorID      uuid.UUID
+}
+
+type ResendInvitationHandler struct {
+	invitationRepository invitation.Repository
+	userRepository       user.Repository
+	roleRepository       role.Repository
+	delegationPolicy     *authz.DelegationPolicy
+	mailer               shared.Mailer
+	eventBus             shared.EventBus
+	logger               logger.Logger
+	settings             InvitationSettings
+}
+
+func NewResendInvitationHandler(
+	invitationRepository invitation.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	mailer shared.Mailer,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	settings InvitationSettings,
+) *ResendInvitationHandler {
+	return &ResendInvitationHandler{
+		invitationRepository: invitationRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		delegationPolicy:     delegationPolicy,
+		mailer:               mailer,
+		eventBus:             eventBus,
+		logger:               logger,
+		settings:             settings,
+	}
+}
+
+// Handle mails a fresh link with a new expiry. The previous link stops
+// working, and an expired invitation becomes pending again.
+func (handler *ResendInvitationHandler) Handle(ctx context.Context, command ResendInvitationCommand) (*invitationdto.InvitationDTO, error) {
+	existingInvitation, err := handler.invitationRepository.FindByID(ctx, command.InvitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureCanInviteWithRoles(ctx, handler.delegationPolicy, handler.roleRepository, command.ActorID, existingInvitation.RoleIDs()); err != nil {
+		return nil, err
+	}
+
+	exists, err := handler.userRepository.ExistsByEmail(ctx, existingInvitation.Email().String())
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(existingInvitation.Email().String())
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := existingInvitation.Resend(command.ActorID, hashInvitationToken(token), time.Now().UTC().Add(handler.settings.TokenTTL)); err != nil {
+		return nil, err
+	}
+
+	if err := handler.invitationRepository.Update(ctx, existingInvitation); err != nil {
+		return nil, fmt.Errorf("update invitation: %w", err)
+	}
+
+	if err := sendInvitationEmail(ctx, handler.mailer, existingInvitation, handler.settings, token); err != nil {
+		return nil, err
+	}
+
+	publishInvitationEvents(ctx, handler.eventBus, handler.logger, existingInvitation)
+
+	handler.logger.Info("invitation resent",
+		logger.String("invitation_id", existingInvitation.ID().String()),
+		logger.String("actor_id", command.ActorID.String()),
+	)
+
+	return invitationdto.InvitationFromDomain(existingInvitation), nil
+}
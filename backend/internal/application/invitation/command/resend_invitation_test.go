@@ -0,0 +1,92 @@
+package invitationcommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func (f *invitationFixture) addInvitation(t *testing.T, email, token string, roleIDs ...uuid.UUID) *invitation.Invitation {
+	t.Helper()
+	inv, err := invitation.NewInvitation(invitation.NewInvitationParams{
+		Email:     email,
+		RoleIDs:   roleIDs,
+		InvitedBy: f.actor.ID(),
+		TokenHash: hashInvitationToken(token),
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	inv.ClearDomainEvents()
+	f.invitationRepo.AddInvitation(inv)
+	return inv
+}
+
+func TestResendInvitationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	newHandler := func(f *invitationFixture) *ResendInvitationHandler {
+		return NewResendInvitationHandler(f.invitationRepo, f.userRepo, f.roleRepo, f.delegationPolicy(), f.mailer, f.eventBus, testutil.NewNoopLogger(), testInvitationSettings)
+	}
+
+	t.Run("replaces the link and revives an expired invitation", func(t *testing.T) {
+		f := newInvitationFixture(t)
+		inv := f.addInvitation(t, "invitee@example.com", "old-token", f.memberRole.ID())
+		require.NoError(t, inv.Expire())
+		inv.ClearDomainEvents()
+
+		result, err := newHandler(f).Handle(ctx, ResendInvitationCommand{InvitationID: inv.ID(), ActorID: f.actor.ID()})
+
+		require.NoError(t, err)
+		assert.Equal(t, string(invitation.StatusPending), result.Status)
+		require.Len(t, f.mailer.Messages, 1)
+		token := tokenFromMessage(t, f.mailer.Messages[0])
+		assert.NotEqual(t, "old-token", token)
+		assert.Equal(t, hashInvitationToken(token), inv.TokenHash())
+		require.Len(t, f.eventBus.PublishedEvents, 1)
+		assert.Equal(t, invitation.EventTypeInvitationResent, f.eventBus.PublishedEvents[0].EventType())
+	})
+
+	t.Run("rejects accepted invitation", func(t *testing.T) {
+		f := newInvitationFixture(t)
+		inv := f.addInvitation(t, "invitee@example.com", "token")
+		require.NoError(t, inv.Accept(uuid.New(), time.Now()))
+
+		_, err := newHandler(f).Handle(ctx, ResendInvitationCommand{InvitationID: inv.ID(), ActorID: f.actor.ID()})
+
+		assert.True(t, shared.IsInvalidStatusTransitionError(err))
+		assert.Empty(t, f.mailer.Messages)
+	})
+
+	t.Run("returns not found for unknown invitation", func(t *testing.T) {
+		f := newInvitationFixture(t)
+
+		_, err := newHandler(f).Handle(ctx, ResendInvitationCommand{InvitationID: uuid.New(), ActorID: f.actor.ID()})
+
+		assert.True(t, shared.IsNotFoundError(err))
+	})
+}
+
+func TestRevokeInvitationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	f := newInvitationFixture(t)
+	inv := f.addInvitation(t, "invitee@example.com", "token", f.memberRole.ID())
+	handler := NewRevokeInvitationHandler(f.invitationRepo, f.eventBus, testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, RevokeInvitationCommand{InvitationID: inv.ID(), ActorID: f.actor.ID()})
+
+	require.NoError(t, err)
+	assert.Equal(t, string(invitation.StatusRevoked), result.Status)
+	require.Len(t, f.eventBus.PublishedEvents, 1)
+	assert.Equal(t, invitation.EventTypeInvitationRevoked, f.eventBus.PublishedEvents[0].EventType())
+
+	_, err = handler.Handle(ctx, RevokeInvitationCommand{InvitationID: inv.ID(), ActorID: f.actor.ID()})
+	assert.True(t, shared.IsInvalidStatusTransitionError(err))
+}
@@ -0,0 +1,73 @@
+package invitationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type InvitationDTO struct {
+	ID         uuid.UUID   `json:"id"`
+	Email      string      `json:"email"`
+	RoleIDs    []uuid.UUID `json:"role_ids"`
+	InvitedBy  uuid.UUID   `json:"invited_by"`
+	Status     string      `json:"status"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+	UserID     *uuid.UUID  `json:"user_id,omitempty"`
+	AcceptedAt *time.Time  `json:"accepted_at,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// InvitationFromDomain reports the effective status, so a pending
+// invitation past its expiry is shown as expired.
+func InvitationFromDomain(inv *invitation.Invitation) *InvitationDTO {
+	if inv == nil {
+		return nil
+	}
+	return &InvitationDTO{
+		ID:         inv.ID(),
+		Email:      inv.Email().String(),
+		RoleIDs:    inv.RoleIDs(),
+		InvitedBy:  inv.InvitedBy(),
+		Status:     inv.EffectiveStatus(time.Now()).String(),
+		ExpiresAt:  inv.ExpiresAt(),
+		UserID:     inv.UserID(),
+		AcceptedAt: inv.AcceptedAt(),
+		CreatedAt:  inv.CreatedAt(),
+		UpdatedAt:  inv.UpdatedAt(),
+	}
+}
+
+func InvitationsFromDomain(invitations []*invitation.Invitation) []*InvitationDTO {
+	dtos := make([]*InvitationDTO, len(invitations))
+	for i, inv := range invitations {
+		dtos[i] = InvitationFromDomain(inv)
+	}
+	return dtos
+}
+
+type PaginatedInvitationsDTO struct {
+	Items      []*InvitationDTO `json:"items"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+	TotalPages int              `json:"total_pages"`
+	HasNext    bool             `json:"has_next"`
+	HasPrev    bool             `json:"has_prev"`
+}
+
+func NewPaginatedInvitationsDTO(invitations []*invitation.Invitation, total int64, pagination shared.Pagination) *PaginatedInvitationsDTO {
+	return &PaginatedInvitationsDTO{
+		Items:      InvitationsFromDomain(invitations),
+		Total:      total,
+		Page:       pagination.Page(),
+		Limit:      pagination.Limit(),
+		TotalPages: pagination.TotalPages(total),
+		HasNext:    pagination.HasNext(total),
+		HasPrev:    pagination.HasPrev(),
+	}
+}
@@ -0,0 +1,48 @@
+package invitationquery
+
+import (
+	"context"
+	"fmt"
+
+	invitationdto "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListInvitationsQuery struct {
+	Page   int
+	Limit  int
+	Status *invitation.Status
+	Email  string
+}
+
+type ListInvitationsHandler struct {
+	invitationRepository invitation.Repository
+	logger               logger.Logger
+}
+
+func NewListInvitationsHandler(
+	invitationRepository invitation.Repository,
+	logger logger.Logger,
+) *ListInvitationsHandler {
+	return &ListInvitationsHandler{
+		invitationRepository: invitationRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *ListInvitationsHandler) Handle(context context.Context, query ListInvitationsQuery) (*invitationdto.PaginatedInvitationsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+	filter := invitation.Filter{
+		Status: query.Status,
+		Email:  query.Email,
+	}
+
+	invitations, total, err := handler.invitationRepository.List(context, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list invitations: %w", err)
+	}
+
+	return invitationdto.NewPaginatedInvitationsDTO(invitations, total, pagination), nil
+}
@@ -0,0 +1,84 @@
+package organizationcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AddOrganizationMemberCommand struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type AddOrganizationMemberHandler struct {
+	organizationRepository organization.Repository
+	userRepository         user.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewAddOrganizationMemberHandler(
+	organizationRepository organization.Repository,
+	userRepository user.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *AddOrganizationMemberHandler {
+	return &AddOrganizationMemberHandler{
+		organizationRepository: organizationRepository,
+		userRepository:         userRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *AddOrganizationMemberHandler) Handle(context context.Context, command AddOrganizationMemberCommand) (*organizationdto.MembershipDTO, error) {
+	if _, err := handler.organizationRepository.FindByID(context, command.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if _, err := handler.userRepository.FindByID(context, command.UserID); err != nil {
+		return nil, err
+	}
+
+	isMember, err := handler.organizationRepository.IsMember(context, command.OrganizationID, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("check organization membership: %w", err)
+	}
+	if isMember {
+		return nil, organization.ErrAlreadyMember
+	}
+
+	membership, err := organization.NewMembership(command.OrganizationID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.organizationRepository.AddMember(context, membership); err != nil {
+		return nil, fmt.Errorf("add organization member: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := organization.NewOrganizationMemberAddedEvent(command.OrganizationID, command.UserID)
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish organization member added event",
+				logger.String("organization_id", command.OrganizationID.String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("user added to organization",
+		logger.String("organization_id", command.OrganizationID.String()),
+		logger.String("user_id", command.UserID.String()),
+	)
+
+	return organizationdto.MembershipFromDomain(membership), nil
+}
@@ -0,0 +1,109 @@
+package organizationcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestAddOrganizationMemberHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	createTestOrganization := func() *organization.Organization {
+		o, _ := organization.NewOrganization(organization.NewOrganizationParams{
+			Name: "Acme Corp",
+			Slug: "acme",
+		})
+		return o
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockOrganizationRepository, *testutil.MockUserRepository) (uuid.UUID, uuid.UUID)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "successfully add member",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository, userRepo *testutil.MockUserRepository) (uuid.UUID, uuid.UUID) {
+				o := createTestOrganization()
+				organizationRepo.AddOrganization(o)
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				return o.ID(), u.ID()
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail when organization not found",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository, userRepo *testutil.MockUserRepository) (uuid.UUID, uuid.UUID) {
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				return uuid.New(), u.ID()
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name: "fail when user not found",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository, userRepo *testutil.MockUserRepository) (uuid.UUID, uuid.UUID) {
+				o := createTestOrganization()
+				organizationRepo.AddOrganization(o)
+				return o.ID(), uuid.New()
+			},
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name: "fail when user is already a member",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository, userRepo *testutil.MockUserRepository) (uuid.UUID, uuid.UUID) {
+				o := createTestOrganization()
+				organizationRepo.AddOrganization(o)
+				u := testutil.CreateActiveUser()
+				userRepo.AddUser(u)
+				organizationRepo.AddMembership(o.ID(), u.ID())
+				return o.ID(), u.ID()
+			},
+			wantErr:     true,
+			errContains: "already a member",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationRepo := testutil.NewMockOrganizationRepository()
+			userRepo := testutil.NewMockUserRepository()
+			eventBus := testutil.NewMockEventBus()
+			logger := testutil.NewNoopLogger()
+
+			organizationID, userID := tt.setupMocks(organizationRepo, userRepo)
+
+			handler := NewAddOrganizationMemberHandler(organizationRepo, userRepo, eventBus, logger)
+			result, err := handler.Handle(ctx, AddOrganizationMemberCommand{
+				OrganizationID: organizationID,
+				UserID:         userID,
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, organizationID, result.OrganizationID)
+			assert.Equal(t, userID, result.UserID)
+			isMember, _ := organizationRepo.IsMember(ctx, organizationID, userID)
+			assert.True(t, isMember)
+			testutil.AssertDomainEventPublished(t, eventBus, organization.EventTypeOrganizationMemberAdded)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package organizationcommand
+
+import (
+	"context"
+	"fmt"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CreateOrganizationCommand struct {
+	Name        string
+	Slug        string
+	Description string
+}
+
+type CreateOrganizationHandler struct {
+	organizationRepository organization.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewCreateOrganizationHandler(
+	organizationRepository organization.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CreateOrganizationHandler {
+	return &CreateOrganizationHandler{
+		organizationRepository: organizationRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *CreateOrganizationHandler) Handle(context context.Context, command CreateOrganizationCommand) (*organizationdto.OrganizationDTO, error) {
+	newOrganization, err := organization.NewOrganization(organization.NewOrganizationParams{
+		Name:        command.Name,
+		Slug:        command.Slug,
+		Description: command.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := handler.organizationRepository.ExistsBySlug(context, newOrganization.Slug())
+	if err != nil {
+		return nil, fmt.Errorf("check organization slug exists: %w", err)
+	}
+	if exists {
+		return nil, organization.NewOrganizationSlugExistsError(newOrganization.Slug())
+	}
+
+	if err := handler.organizationRepository.Create(context, newOrganization); err != nil {
+		return nil, fmt.Errorf("save organization: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, newOrganization.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("organization_id", newOrganization.ID().String()),
+				logger.Err(err),
+			)
+		}
+		newOrganization.ClearDomainEvents()
+	}
+
+	handler.logger.Info("organization created successfully",
+		logger.String("organization_id", newOrganization.ID().String()),
+		logger.String("slug", newOrganization.Slug()),
+	)
+
+	return organizationdto.OrganizationFromDomain(newOrganization), nil
+}
@@ -0,0 +1,88 @@
+package organizationcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestCreateOrganizationHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockOrganizationRepository)
+		command     CreateOrganizationCommand
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "successfully create organization",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository) {},
+			command: CreateOrganizationCommand{
+				Name:        "Acme Corp",
+				Slug:        "acme",
+				Description: "Acme tenant",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail when slug already exists",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository) {
+				existing, _ := organization.NewOrganization(organization.NewOrganizationParams{
+					Name: "Acme Existing",
+					Slug: "acme",
+				})
+				organizationRepo.AddOrganization(existing)
+			},
+			command: CreateOrganizationCommand{
+				Name: "Acme Corp",
+				Slug: "acme",
+			},
+			wantErr:     true,
+			errContains: "already exists",
+		},
+		{
+			name:       "fail when slug is invalid",
+			setupMocks: func(organizationRepo *testutil.MockOrganizationRepository) {},
+			command: CreateOrganizationCommand{
+				Name: "Acme Corp",
+				Slug: "Acme Corp",
+			},
+			wantErr:     true,
+			errContains: "slug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationRepo := testutil.NewMockOrganizationRepository()
+			eventBus := testutil.NewMockEventBus()
+			logger := testutil.NewNoopLogger()
+
+			tt.setupMocks(organizationRepo)
+
+			handler := NewCreateOrganizationHandler(organizationRepo, eventBus, logger)
+			result, err := handler.Handle(ctx, tt.command)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.command.Name, result.Name)
+			assert.Equal(t, tt.command.Slug, result.Slug)
+			assert.Len(t, organizationRepo.Organizations, 1)
+			testutil.AssertDomainEventPublished(t, eventBus, organization.EventTypeOrganizationCreated)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package organizationcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DeleteOrganizationCommand struct {
+	OrganizationID uuid.UUID
+}
+
+type DeleteOrganizationHandler struct {
+	organizationRepository organization.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewDeleteOrganizationHandler(
+	organizationRepository organization.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DeleteOrganizationHandler {
+	return &DeleteOrganizationHandler{
+		organizationRepository: organizationRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *DeleteOrganizationHandler) Handle(context context.Context, command DeleteOrganizationCommand) error {
+	existingOrganization, err := handler.organizationRepository.FindByID(context, command.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.organizationRepository.Delete(context, command.OrganizationID); err != nil {
+		return fmt.Errorf("delete organization: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := organization.NewOrganizationDeletedEvent(existingOrganization.ID(), existingOrganization.Slug())
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish organization deleted event",
+				logger.String("organization_id", existingOrganization.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("organization deleted successfully",
+		logger.String("organization_id", existingOrganization.ID().String()),
+		logger.String("slug", existingOrganization.Slug()),
+	)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package organizationcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type RemoveOrganizationMemberCommand struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type RemoveOrganizationMemberHandler struct {
+	organizationRepository organization.Repository
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewRemoveOrganizationMemberHandler(
+	organizationRepository organization.Repository,
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *RemoveOrganizationMemberHandler {
+	return &RemoveOrganizationMemberHandler{
+		organizationRepository: organizationRepository,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *RemoveOrganizationMemberHandler) Handle(context context.Context, command RemoveOrganizationMemberCommand) error {
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.organizationRepository.RemoveMember(context, command.OrganizationID, command.UserID); err != nil {
+		return err
+	}
+
+	assignedRoles, err := handler.roleRepository.FindByIDs(context, existingUser.RoleIDs())
+	if err != nil {
+		return fmt.Errorf("load user roles: %w", err)
+	}
+
+	revokedRoleCount := 0
+	for _, assignedRole := range assignedRoles {
+		organizationID := assignedRole.OrganizationID()
+		if organizationID == nil || *organizationID != command.OrganizationID {
+			continue
+		}
+		if err := existingUser.RevokeRole(assignedRole.ID()); err != nil {
+			return err
+		}
+		revokedRoleCount++
+	}
+
+	if revokedRoleCount > 0 {
+		if err := handler.userRepository.Update(context, existingUser); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+	}
+
+	if handler.eventBus != nil {
+		events := append(existingUser.DomainEvents(), organization.NewOrganizationMemberRemovedEvent(command.OrganizationID, command.UserID))
+		if err := handler.eventBus.Publish(context, events...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("organization_id", command.OrganizationID.String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user removed from organization",
+		logger.String("organization_id", command.OrganizationID.String()),
+		logger.String("user_id", command.UserID.String()),
+		logger.Int("revoked_role_count", revokedRoleCount),
+	)
+
+	return nil
+}
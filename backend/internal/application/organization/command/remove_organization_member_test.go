@@ -0,0 +1,67 @@
+package organizationcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestRemoveOrganizationMemberHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes member and revokes organization scoped roles", func(t *testing.T) {
+		organizationRepo := testutil.NewMockOrganizationRepository()
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		eventBus := testutil.NewMockEventBus()
+
+		o, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme Corp", Slug: "acme"})
+		organizationRepo.AddOrganization(o)
+
+		organizationID := o.ID()
+		scopedRole, _ := role.NewRole(role.NewRoleParams{Name: "acme_editor", DisplayName: "Acme Editor", OrganizationID: &organizationID})
+		globalRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+		roleRepo.AddRole(scopedRole)
+		roleRepo.AddRole(globalRole)
+
+		u := testutil.CreateActiveUser()
+		require.NoError(t, u.AssignRole(scopedRole.ID()))
+		require.NoError(t, u.AssignRole(globalRole.ID()))
+		u.ClearDomainEvents()
+		userRepo.AddUser(u)
+		organizationRepo.AddMembership(o.ID(), u.ID())
+
+		handler := NewRemoveOrganizationMemberHandler(organizationRepo, userRepo, roleRepo, eventBus, testutil.NewNoopLogger())
+		err := handler.Handle(ctx, RemoveOrganizationMemberCommand{OrganizationID: o.ID(), UserID: u.ID()})
+
+		require.NoError(t, err)
+		isMember, _ := organizationRepo.IsMember(ctx, o.ID(), u.ID())
+		assert.False(t, isMember)
+		assert.False(t, u.HasRole(scopedRole.ID()))
+		assert.True(t, u.HasRole(globalRole.ID()))
+		testutil.AssertDomainEventPublished(t, eventBus, organization.EventTypeOrganizationMemberRemoved)
+	})
+
+	t.Run("fails when user is not a member", func(t *testing.T) {
+		organizationRepo := testutil.NewMockOrganizationRepository()
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+
+		o, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme Corp", Slug: "acme"})
+		organizationRepo.AddOrganization(o)
+		u := testutil.CreateActiveUser()
+		userRepo.AddUser(u)
+
+		handler := NewRemoveOrganizationMemberHandler(organizationRepo, userRepo, roleRepo, testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		err := handler.Handle(ctx, RemoveOrganizationMemberCommand{OrganizationID: o.ID(), UserID: u.ID()})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, organization.ErrNotMember)
+	})
+}
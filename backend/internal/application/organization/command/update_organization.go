@@ -0,0 +1,68 @@
+package organizationcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UpdateOrganizationCommand struct {
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+}
+
+type UpdateOrganizationHandler struct {
+	organizationRepository organization.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewUpdateOrganizationHandler(
+	organizationRepository organization.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *UpdateOrganizationHandler {
+	return &UpdateOrganizationHandler{
+		organizationRepository: organizationRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+func (handler *UpdateOrganizationHandler) Handle(context context.Context, command UpdateOrganizationCommand) (*organizationdto.OrganizationDTO, error) {
+	existingOrganization, err := handler.organizationRepository.FindByID(context, command.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := existingOrganization.UpdateDetails(command.Name, command.Description); err != nil {
+		return nil, err
+	}
+
+	if err := handler.organizationRepository.Update(context, existingOrganization); err != nil {
+		return nil, fmt.Errorf("update organization: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingOrganization.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("organization_id", existingOrganization.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingOrganization.ClearDomainEvents()
+	}
+
+	handler.logger.Info("organization updated successfully",
+		logger.String("organization_id", existingOrganization.ID().String()),
+	)
+
+	return organizationdto.OrganizationFromDomain(existingOrganization), nil
+}
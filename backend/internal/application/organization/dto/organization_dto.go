@@ -0,0 +1,88 @@
+package organizationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type OrganizationDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func OrganizationFromDomain(domainOrganization *organization.Organization) *OrganizationDTO {
+	if domainOrganization == nil {
+		return nil
+	}
+	return &OrganizationDTO{
+		ID:          domainOrganization.ID(),
+		Name:        domainOrganization.Name(),
+		Slug:        domainOrganization.Slug(),
+		Description: domainOrganization.Description(),
+		CreatedAt:   domainOrganization.CreatedAt(),
+		UpdatedAt:   domainOrganization.UpdatedAt(),
+	}
+}
+
+func OrganizationsFromDomain(domainOrganizations []*organization.Organization) []*OrganizationDTO {
+	dtos := make([]*OrganizationDTO, len(domainOrganizations))
+	for i, domainOrganization := range domainOrganizations {
+		dtos[i] = OrganizationFromDomain(domainOrganization)
+	}
+	return dtos
+}
+
+type PaginatedOrganizationsDTO struct {
+	Items      []*OrganizationDTO `json:"items"`
+	Total      int64              `json:"total"`
+	Page       int                `json:"page"`
+	Limit      int                `json:"limit"`
+	TotalPages int                `json:"total_pages"`
+	HasNext    bool               `json:"has_next"`
+	HasPrev    bool               `json:"has_prev"`
+}
+
+func NewPaginatedOrganizationsDTO(organizations []*organization.Organization, total int64, pagination shared.Pagination) *PaginatedOrganizationsDTO {
+	return &PaginatedOrganizationsDTO{
+		Items:      OrganizationsFromDomain(organizations),
+		Total:      total,
+		Page:       pagination.Page(),
+		Limit:      pagination.Limit(),
+		TotalPages: pagination.TotalPages(total),
+		HasNext:    pagination.HasNext(total),
+		HasPrev:    pagination.HasPrev(),
+	}
+}
+
+type MembershipDTO struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
+
+func MembershipFromDomain(membership *organization.Membership) *MembershipDTO {
+	if membership == nil {
+		return nil
+	}
+	return &MembershipDTO{
+		OrganizationID: membership.OrganizationID(),
+		UserID:         membership.UserID(),
+		JoinedAt:       membership.JoinedAt(),
+	}
+}
+
+func MembershipsFromDomain(memberships []*organization.Membership) []*MembershipDTO {
+	dtos := make([]*MembershipDTO, len(memberships))
+	for i, membership := range memberships {
+		dtos[i] = MembershipFromDomain(membership)
+	}
+	return dtos
+}
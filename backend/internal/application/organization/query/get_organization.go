@@ -0,0 +1,39 @@
+package organizationquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetOrganizationQuery struct {
+	OrganizationID uuid.UUID
+}
+
+type GetOrganizationHandler struct {
+	organizationRepository organization.Repository
+	logger                 logger.Logger
+}
+
+func NewGetOrganizationHandler(
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *GetOrganizationHandler {
+	return &GetOrganizationHandler{
+		organizationRepository: organizationRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *GetOrganizationHandler) Handle(context context.Context, query GetOrganizationQuery) (*organizationdto.OrganizationDTO, error) {
+	foundOrganization, err := handler.organizationRepository.FindByID(context, query.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return organizationdto.OrganizationFromDomain(foundOrganization), nil
+}
@@ -0,0 +1,44 @@
+package organizationquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListOrganizationMembersQuery struct {
+	OrganizationID uuid.UUID
+}
+
+type ListOrganizationMembersHandler struct {
+	organizationRepository organization.Repository
+	logger                 logger.Logger
+}
+
+func NewListOrganizationMembersHandler(
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *ListOrganizationMembersHandler {
+	return &ListOrganizationMembersHandler{
+		organizationRepository: organizationRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *ListOrganizationMembersHandler) Handle(context context.Context, query ListOrganizationMembersQuery) ([]*organizationdto.MembershipDTO, error) {
+	if _, err := handler.organizationRepository.FindByID(context, query.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	memberships, err := handler.organizationRepository.ListMembers(context, query.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list organization members: %w", err)
+	}
+
+	return organizationdto.MembershipsFromDomain(memberships), nil
+}
@@ -0,0 +1,61 @@
+package organizationquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListOrganizationMembersHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists members of organization", func(t *testing.T) {
+		organizationRepo := testutil.NewMockOrganizationRepository()
+		o, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme Corp", Slug: "acme"})
+		organizationRepo.AddOrganization(o)
+		organizationRepo.AddMembership(o.ID(), uuid.New())
+		organizationRepo.AddMembership(o.ID(), uuid.New())
+
+		handler := NewListOrganizationMembersHandler(organizationRepo, testutil.NewNoopLogger())
+		result, err := handler.Handle(ctx, ListOrganizationMembersQuery{OrganizationID: o.ID()})
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("fails when organization not found", func(t *testing.T) {
+		organizationRepo := testutil.NewMockOrganizationRepository()
+
+		handler := NewListOrganizationMembersHandler(organizationRepo, testutil.NewNoopLogger())
+		result, err := handler.Handle(ctx, ListOrganizationMembersQuery{OrganizationID: uuid.New()})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		testutil.AssertNotFoundError(t, err)
+	})
+}
+
+func TestListUserOrganizationsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	organizationRepo := testutil.NewMockOrganizationRepository()
+	userID := uuid.New()
+
+	member, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Acme Corp", Slug: "acme"})
+	other, _ := organization.NewOrganization(organization.NewOrganizationParams{Name: "Globex", Slug: "globex"})
+	organizationRepo.AddOrganization(member)
+	organizationRepo.AddOrganization(other)
+	organizationRepo.AddMembership(member.ID(), userID)
+
+	handler := NewListUserOrganizationsHandler(organizationRepo, testutil.NewNoopLogger())
+	result, err := handler.Handle(ctx, ListUserOrganizationsQuery{UserID: userID})
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, member.ID(), result[0].ID)
+}
@@ -0,0 +1,42 @@
+package organizationquery
+
+import (
+	"context"
+	"fmt"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListOrganizationsQuery struct {
+	Page  int
+	Limit int
+}
+
+type ListOrganizationsHandler struct {
+	organizationRepository organization.Repository
+	logger                 logger.Logger
+}
+
+func NewListOrganizationsHandler(
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *ListOrganizationsHandler {
+	return &ListOrganizationsHandler{
+		organizationRepository: organizationRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *ListOrganizationsHandler) Handle(context context.Context, query ListOrganizationsQuery) (*organizationdto.PaginatedOrganizationsDTO, error) {
+	pagination := shared.NewPagination(query.Page, query.Limit)
+
+	organizations, total, err := handler.organizationRepository.List(context, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list organizations: %w", err)
+	}
+
+	return organizationdto.NewPaginatedOrganizationsDTO(organizations, total, pagination), nil
+}
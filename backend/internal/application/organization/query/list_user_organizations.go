@@ -0,0 +1,40 @@
+package organizationquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListUserOrganizationsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListUserOrganizationsHandler struct {
+	organizationRepository organization.Repository
+	logger                 logger.Logger
+}
+
+func NewListUserOrganizationsHandler(
+	organizationRepository organization.Repository,
+	logger logger.Logger,
+) *ListUserOrganizationsHandler {
+	return &ListUserOrganizationsHandler{
+		organizationRepository: organizationRepository,
+		logger:                 logger,
+	}
+}
+
+func (handler *ListUserOrganizationsHandler) Handle(context context.Context, query ListUserOrganizationsQuery) ([]*organizationdto.OrganizationDTO, error) {
+	organizations, err := handler.organizationRepository.FindByUser(context, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("list user organizations: %w", err)
+	}
+
+	return organizationdto.OrganizationsFromDomain(organizations), nil
+}
@@ -12,7 +12,9 @@ import (
 )
 
 type DeletePermissionCommand struct {
-	PermissionID uuid.UUID
+	PermissionID    uuid.UUID
+	Force           bool
+	ExpectedVersion *int64
 }
 
 type DeletePermissionHandler struct {
@@ -39,7 +41,11 @@ func (handler *DeletePermissionHandler) Handle(context context.Context, command
 		return err
 	}
 
-	if !existingPermission.CanBeDeleted() {
+	if err := existingPermission.CheckVersion(command.ExpectedVersion); err != nil {
+		return err
+	}
+
+	if !existingPermission.CanBeDeleted() && !command.Force {
 		return permission.ErrSystemPermissionCannotBeDeleted
 	}
 
@@ -51,7 +57,7 @@ func (handler *DeletePermissionHandler) Handle(context context.Context, command
 		return permission.ErrPermissionInUse
 	}
 
-	if err := handler.permissionRepository.Delete(context, command.PermissionID); err != nil {
+	if err := handler.permissionRepository.Delete(context, command.PermissionID, existingPermission.Version()); err != nil {
 		return fmt.Errorf("delete permission: %w", err)
 	}
 
@@ -12,8 +12,10 @@ import (
 )
 
 type UpdatePermissionCommand struct {
-	PermissionID uuid.UUID
-	Description  string
+	PermissionID    uuid.UUID
+	Description     string
+	Force           bool
+	ExpectedVersion *int64
 }
 
 type UpdatePermissionHandler struct {
@@ -37,7 +39,11 @@ func (handler *UpdatePermissionHandler) Handle(context context.Context, command
 		return nil, err
 	}
 
-	if existingPermission.IsSystem() {
+	if err := existingPermission.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	if existingPermission.IsSystem() && !command.Force {
 		return nil, permission.ErrSystemPermissionCannotBeModified
 	}
 
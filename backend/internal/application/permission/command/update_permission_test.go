@@ -190,3 +190,32 @@ func TestUpdatePermissionHandler_Handle_PreservesOriginalFields(t *testing.T) {
 	assert.Equal(t, "New description", result.Description)
 	assert.False(t, result.IsSystem)
 }
+
+func TestUpdatePermissionHandler_Handle_RejectsStaleVersion(t *testing.T) {
+	permissionRepo := testutil.NewMockPermissionRepository()
+	now := time.Now().UTC()
+	existing, err := permission.ReconstructPermission(permission.ReconstructPermissionParams{
+		ID:          uuid.New(),
+		Resource:    "reports",
+		Action:      "export",
+		Description: "Original description",
+		Version:     3,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	require.NoError(t, err)
+	permissionRepo.AddPermission(existing)
+
+	handler := NewUpdatePermissionHandler(permissionRepo, testutil.NewNoopLogger())
+
+	stale := int64(2)
+	_, err = handler.Handle(context.Background(), UpdatePermissionCommand{
+		PermissionID:    existing.ID(),
+		Description:     "Overwritten",
+		ExpectedVersion: &stale,
+	})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, permission.ErrConcurrentModification)
+	assert.Equal(t, "Original description", existing.Description())
+	assert.Equal(t, int64(3), existing.Version())
+}
@@ -15,6 +15,7 @@ type PermissionDTO struct {
 	Code        string    `json:"code"`
 	Description string    `json:"description"`
 	IsSystem    bool      `json:"is_system"`
+	Version     int64     `json:"version"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -30,6 +31,7 @@ func PermissionFromDomain(domainPermission *permission.Permission) *PermissionDT
 		Code:        domainPermission.CodeString(),
 		Description: domainPermission.Description(),
 		IsSystem:    domainPermission.IsSystem(),
+		Version:     domainPermission.Version(),
 		CreatedAt:   domainPermission.CreatedAt(),
 		UpdatedAt:   domainPermission.UpdatedAt(),
 	}
@@ -42,3 +44,35 @@ func PermissionsFromDomain(domainPermissions []*permission.Permission) []*Permis
 	}
 	return dtos
 }
+
+type PermissionGrantDTO struct {
+	PermissionDTO
+	Effect   string   `json:"effect"`
+	DeniedBy []string `json:"denied_by,omitempty"`
+}
+
+func PermissionGrantFromDomain(domainPermission *permission.Permission, deniedBy []string) *PermissionGrantDTO {
+	effect := permission.EffectAllow
+	if len(deniedBy) > 0 {
+		effect = permission.EffectDeny
+	}
+	return &PermissionGrantDTO{
+		PermissionDTO: *PermissionFromDomain(domainPermission),
+		Effect:        effect.String(),
+		DeniedBy:      deniedBy,
+	}
+}
+
+type MissingPermissionDTO struct {
+	Code         string   `json:"code"`
+	ReferencedBy []string `json:"referenced_by"`
+}
+
+type PermissionDriftDTO struct {
+	Missing []MissingPermissionDTO `json:"missing"`
+	Unused  []*PermissionDTO       `json:"unused"`
+}
+
+func (drift *PermissionDriftDTO) HasMissing() bool {
+	return len(drift.Missing) > 0
+}
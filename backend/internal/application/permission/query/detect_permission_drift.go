@@ -0,0 +1,81 @@
+package permissionquery
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	permissiondto "github.com/tranvuongduy2003/go-copilot/internal/application/permission/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type PermissionReference struct {
+	Code    string
+	Sources []string
+}
+
+type DetectPermissionDriftQuery struct {
+	References []PermissionReference
+}
+
+type DetectPermissionDriftHandler struct {
+	permissionRepository permission.Repository
+	logger               logger.Logger
+}
+
+func NewDetectPermissionDriftHandler(
+	permissionRepository permission.Repository,
+	logger logger.Logger,
+) *DetectPermissionDriftHandler {
+	return &DetectPermissionDriftHandler{
+		permissionRepository: permissionRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *DetectPermissionDriftHandler) Handle(context context.Context, query DetectPermissionDriftQuery) (*permissiondto.PermissionDriftDTO, error) {
+	permissions, err := handler.permissionRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("list permissions: %w", err)
+	}
+
+	existing := make(map[string]bool, len(permissions))
+	for _, existingPermission := range permissions {
+		existing[existingPermission.CodeString()] = true
+	}
+
+	drift := &permissiondto.PermissionDriftDTO{
+		Missing: []permissiondto.MissingPermissionDTO{},
+		Unused:  []*permissiondto.PermissionDTO{},
+	}
+
+	referenced := make(map[string]bool, len(query.References))
+	for _, reference := range query.References {
+		referenced[reference.Code] = true
+		if existing[reference.Code] {
+			continue
+		}
+		sources := append([]string{}, reference.Sources...)
+		sort.Strings(sources)
+		drift.Missing = append(drift.Missing, permissiondto.MissingPermissionDTO{
+			Code:         reference.Code,
+			ReferencedBy: sources,
+		})
+	}
+
+	for _, existingPermission := range permissions {
+		if !referenced[existingPermission.CodeString()] {
+			drift.Unused = append(drift.Unused, permissiondto.PermissionFromDomain(existingPermission))
+		}
+	}
+
+	sort.Slice(drift.Missing, func(i, j int) bool {
+		return drift.Missing[i].Code < drift.Missing[j].Code
+	})
+	sort.Slice(drift.Unused, func(i, j int) bool {
+		return drift.Unused[i].Code < drift.Unused[j].Code
+	})
+
+	return drift, nil
+}
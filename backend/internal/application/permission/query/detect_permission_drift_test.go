@@ -0,0 +1,84 @@
+package permissionquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestDetectPermissionDriftHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	createTestPermission := func(resource, action string) *permission.Permission {
+		now := time.Now().UTC()
+		perm, _ := permission.ReconstructPermission(permission.ReconstructPermissionParams{
+			ID:          uuid.New(),
+			Resource:    resource,
+			Action:      action,
+			Description: resource + ":" + action,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		return perm
+	}
+
+	t.Run("reports missing and unused permissions", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		permRepo.AddPermission(createTestPermission("users", "read"))
+		permRepo.AddPermission(createTestPermission("users", "create"))
+		permRepo.AddPermission(createTestPermission("reports", "export"))
+
+		handler := NewDetectPermissionDriftHandler(permRepo, testutil.NewNoopLogger())
+
+		drift, err := handler.Handle(ctx, DetectPermissionDriftQuery{
+			References: []PermissionReference{
+				{Code: "users:read", Sources: []string{"GET /api/v1/users/{id}"}},
+				{Code: "users:create", Sources: []string{"POST /api/v1/users"}},
+				{Code: "invoices:read", Sources: []string{"GET /api/v1/invoices/{id}", "GET /api/v1/invoices"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, drift.HasMissing())
+		require.Len(t, drift.Missing, 1)
+		assert.Equal(t, "invoices:read", drift.Missing[0].Code)
+		assert.Equal(t, []string{"GET /api/v1/invoices", "GET /api/v1/invoices/{id}"}, drift.Missing[0].ReferencedBy)
+		require.Len(t, drift.Unused, 1)
+		assert.Equal(t, "reports:export", drift.Unused[0].Code)
+	})
+
+	t.Run("reports no drift when registry and database agree", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		permRepo.AddPermission(createTestPermission("users", "read"))
+
+		handler := NewDetectPermissionDriftHandler(permRepo, testutil.NewNoopLogger())
+
+		drift, err := handler.Handle(ctx, DetectPermissionDriftQuery{
+			References: []PermissionReference{{Code: "users:read"}},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, drift.HasMissing())
+		assert.Empty(t, drift.Unused)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		permRepo.FindError = errors.New("connection refused")
+
+		handler := NewDetectPermissionDriftHandler(permRepo, testutil.NewNoopLogger())
+
+		_, err := handler.Handle(ctx, DetectPermissionDriftQuery{})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "list permissions")
+	})
+}
@@ -0,0 +1,59 @@
+package permissionquery
+
+import (
+	"context"
+	"fmt"
+
+	permissiondto "github.com/tranvuongduy2003/go-copilot/internal/application/permission/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// ListPermissionsByCursorQuery pages through the permissions
+// ListPermissionsQuery returns in one go. An empty Cursor requests the
+// first page.
+type ListPermissionsByCursorQuery struct {
+	Resource     *string
+	Cursor       string
+	Limit        int
+	IncludeTotal bool
+}
+
+type ListPermissionsByCursorHandler struct {
+	permissionRepository permission.Repository
+	logger               logger.Logger
+}
+
+func NewListPermissionsByCursorHandler(
+	permissionRepository permission.Repository,
+	logger logger.Logger,
+) *ListPermissionsByCursorHandler {
+	return &ListPermissionsByCursorHandler{
+		permissionRepository: permissionRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *ListPermissionsByCursorHandler) Handle(context context.Context, query ListPermissionsByCursorQuery) (shared.CursorResult[*permissiondto.PermissionDTO], error) {
+	page, err := shared.NewCursorPage(query.Cursor, query.Limit, query.IncludeTotal)
+	if err != nil {
+		return shared.CursorResult[*permissiondto.PermissionDTO]{}, err
+	}
+
+	var resource *permission.Resource
+	if query.Resource != nil && *query.Resource != "" {
+		parsed, err := permission.NewResource(*query.Resource)
+		if err != nil {
+			return shared.CursorResult[*permissiondto.PermissionDTO]{}, err
+		}
+		resource = &parsed
+	}
+
+	result, err := handler.permissionRepository.ListByCursor(context, resource, page)
+	if err != nil {
+		return shared.CursorResult[*permissiondto.PermissionDTO]{}, fmt.Errorf("list permissions: %w", err)
+	}
+
+	return shared.MapCursorResult(result, permissiondto.PermissionFromDomain), nil
+}
@@ -0,0 +1,45 @@
+package permissionquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListPermissionsByCursorHandler_Handle(t *testing.T) {
+	permRepo := testutil.NewMockPermissionRepository()
+	now := time.Now().UTC()
+	for _, code := range [][2]string{{"users", "create"}, {"users", "read"}, {"users", "update"}, {"roles", "read"}} {
+		perm, err := permission.ReconstructPermission(permission.ReconstructPermissionParams{
+			ID:        uuid.New(),
+			Resource:  code[0],
+			Action:    code[1],
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		require.NoError(t, err)
+		permRepo.AddPermission(perm)
+	}
+
+	handler := NewListPermissionsByCursorHandler(permRepo, testutil.NewNoopLogger())
+
+	first, err := handler.Handle(context.Background(), ListPermissionsByCursorQuery{Resource: stringPtr("users"), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "users:create", first.Items[0].Code)
+	assert.Nil(t, first.Total)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := handler.Handle(context.Background(), ListPermissionsByCursorQuery{Resource: stringPtr("users"), Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "users:update", second.Items[0].Code)
+	assert.Empty(t, second.NextCursor)
+}
@@ -0,0 +1,250 @@
+package rbaccommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	permissioncommand "github.com/tranvuongduy2003/go-copilot/internal/application/permission/command"
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	rolecommand "github.com/tranvuongduy2003/go-copilot/internal/application/role/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type TransactionRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+type ApplyManifestCommand struct {
+	Manifest *rbacmanifest.Manifest
+	Force    bool
+}
+
+type ApplyManifestHandler struct {
+	permissionRepository      permission.Repository
+	roleRepository            role.Repository
+	runInTransaction          TransactionRunner
+	createPermissionHandler   *permissioncommand.CreatePermissionHandler
+	updatePermissionHandler   *permissioncommand.UpdatePermissionHandler
+	deletePermissionHandler   *permissioncommand.DeletePermissionHandler
+	createRoleHandler         *rolecommand.CreateRoleHandler
+	updateRoleHandler         *rolecommand.UpdateRoleHandler
+	setRolePermissionsHandler *rolecommand.SetRolePermissionsHandler
+	denyRolePermissionHandler *rolecommand.DenyRolePermissionHandler
+	removeRoleDenyHandler     *rolecommand.RemoveRoleDenyHandler
+	deleteRoleHandler         *rolecommand.DeleteRoleHandler
+	logger                    logger.Logger
+}
+
+func NewApplyManifestHandler(
+	permissionRepository permission.Repository,
+	roleRepository role.Repository,
+	runInTransaction TransactionRunner,
+	createPermissionHandler *permissioncommand.CreatePermissionHandler,
+	updatePermissionHandler *permissioncommand.UpdatePermissionHandler,
+	deletePermissionHandler *permissioncommand.DeletePermissionHandler,
+	createRoleHandler *rolecommand.CreateRoleHandler,
+	updateRoleHandler *rolecommand.UpdateRoleHandler,
+	setRolePermissionsHandler *rolecommand.SetRolePermissionsHandler,
+	denyRolePermissionHandler *rolecommand.DenyRolePermissionHandler,
+	removeRoleDenyHandler *rolecommand.RemoveRoleDenyHandler,
+	deleteRoleHandler *rolecommand.DeleteRoleHandler,
+	logger logger.Logger,
+) *ApplyManifestHandler {
+	return &ApplyManifestHandler{
+		permissionRepository:      permissionRepository,
+		roleRepository:            roleRepository,
+		runInTransaction:          runInTransaction,
+		createPermissionHandler:   createPermissionHandler,
+		updatePermissionHandler:   updatePermissionHandler,
+		deletePermissionHandler:   deletePermissionHandler,
+		createRoleHandler:         createRoleHandler,
+		updateRoleHandler:         updateRoleHandler,
+		setRolePermissionsHandler: setRolePermissionsHandler,
+		denyRolePermissionHandler: denyRolePermissionHandler,
+		removeRoleDenyHandler:     removeRoleDenyHandler,
+		deleteRoleHandler:         deleteRoleHandler,
+		logger:                    logger,
+	}
+}
+
+func (handler *ApplyManifestHandler) Handle(ctx context.Context, command ApplyManifestCommand) (*rbacmanifest.Plan, error) {
+	if err := command.Manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	var plan *rbacmanifest.Plan
+	err := handler.runInTransaction(ctx, func(transactionContext context.Context) error {
+		permissions, err := handler.permissionRepository.FindAll(transactionContext)
+		if err != nil {
+			return fmt.Errorf("find permissions: %w", err)
+		}
+
+		roles, err := handler.roleRepository.FindAll(transactionContext)
+		if err != nil {
+			return fmt.Errorf("find roles: %w", err)
+		}
+
+		plan = rbacmanifest.Diff(command.Manifest, permissions, roles)
+		if len(plan.SystemChanges()) > 0 && !command.Force {
+			return rbacmanifest.ErrSystemChangesRequireForce
+		}
+
+		return handler.execute(transactionContext, plan, command.Force)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	handler.logger.Info("rbac manifest applied successfully",
+		logger.Int("changes", len(plan.Changes)),
+		logger.Bool("force", command.Force),
+	)
+
+	return plan, nil
+}
+
+func (handler *ApplyManifestHandler) execute(context context.Context, plan *rbacmanifest.Plan, force bool) error {
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindPermission, rbacmanifest.ChangeActionCreate) {
+		_, err := handler.createPermissionHandler.Handle(context, permissioncommand.CreatePermissionCommand{
+			Resource:    change.Permission.Resource,
+			Action:      change.Permission.Action,
+			Description: change.Permission.Description,
+		})
+		if err != nil {
+			return fmt.Errorf("create permission %s: %w", change.Name, err)
+		}
+	}
+
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindPermission, rbacmanifest.ChangeActionUpdate) {
+		_, err := handler.updatePermissionHandler.Handle(context, permissioncommand.UpdatePermissionCommand{
+			PermissionID: *change.ID,
+			Description:  change.Permission.Description,
+			Force:        force,
+		})
+		if err != nil {
+			return fmt.Errorf("update permission %s: %w", change.Name, err)
+		}
+	}
+
+	permissionIDs, err := handler.permissionIDsByCode(context)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindRole, rbacmanifest.ChangeActionCreate) {
+		createdRole, err := handler.createRoleHandler.Handle(context, rolecommand.CreateRoleCommand{
+			Name:          change.Role.Name,
+			DisplayName:   change.Role.DisplayName,
+			Description:   change.Role.Description,
+			PermissionIDs: resolvePermissionIDs(permissionIDs, change.Role.Permissions),
+		})
+		if err != nil {
+			return fmt.Errorf("create role %s: %w", change.Name, err)
+		}
+
+		if err := handler.addDenies(context, createdRole.ID, change, permissionIDs, force); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindRole, rbacmanifest.ChangeActionUpdate) {
+		if change.HasField("display_name") || change.HasField("description") {
+			_, err := handler.updateRoleHandler.Handle(context, rolecommand.UpdateRoleCommand{
+				RoleID:      *change.ID,
+				DisplayName: change.Role.DisplayName,
+				Description: change.Role.Description,
+				Force:       force,
+			})
+			if err != nil {
+				return fmt.Errorf("update role %s: %w", change.Name, err)
+			}
+		}
+
+		for _, permissionID := range resolvePermissionIDs(permissionIDs, change.RemovedDenies) {
+			_, err := handler.removeRoleDenyHandler.Handle(context, rolecommand.RemoveRoleDenyCommand{
+				RoleID:       *change.ID,
+				PermissionID: permissionID,
+				Force:        force,
+			})
+			if err != nil {
+				return fmt.Errorf("remove role deny %s: %w", change.Name, err)
+			}
+		}
+
+		if change.HasField("permissions") {
+			_, err := handler.setRolePermissionsHandler.Handle(context, rolecommand.SetRolePermissionsCommand{
+				RoleID:        *change.ID,
+				PermissionIDs: resolvePermissionIDs(permissionIDs, change.Role.Permissions),
+				Force:         force,
+			})
+			if err != nil {
+				return fmt.Errorf("set role permissions %s: %w", change.Name, err)
+			}
+		}
+
+		if err := handler.addDenies(context, *change.ID, change, permissionIDs, force); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindRole, rbacmanifest.ChangeActionDelete) {
+		err := handler.deleteRoleHandler.Handle(context, rolecommand.DeleteRoleCommand{
+			RoleID: *change.ID,
+			Force:  force,
+		})
+		if err != nil {
+			return fmt.Errorf("delete role %s: %w", change.Name, err)
+		}
+	}
+
+	for _, change := range plan.Filter(rbacmanifest.ChangeKindPermission, rbacmanifest.ChangeActionDelete) {
+		err := handler.deletePermissionHandler.Handle(context, permissioncommand.DeletePermissionCommand{
+			PermissionID: *change.ID,
+			Force:        force,
+		})
+		if err != nil {
+			return fmt.Errorf("delete permission %s: %w", change.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (handler *ApplyManifestHandler) addDenies(context context.Context, roleID uuid.UUID, change rbacmanifest.Change, permissionIDs map[string]uuid.UUID, force bool) error {
+	for _, permissionID := range resolvePermissionIDs(permissionIDs, change.AddedDenies) {
+		_, err := handler.denyRolePermissionHandler.Handle(context, rolecommand.DenyRolePermissionCommand{
+			RoleID:       roleID,
+			PermissionID: permissionID,
+			Force:        force,
+		})
+		if err != nil {
+			return fmt.Errorf("deny role permission %s: %w", change.Name, err)
+		}
+	}
+	return nil
+}
+
+func (handler *ApplyManifestHandler) permissionIDsByCode(context context.Context) (map[string]uuid.UUID, error) {
+	permissions, err := handler.permissionRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("find permissions: %w", err)
+	}
+
+	permissionIDs := make(map[string]uuid.UUID, len(permissions))
+	for _, permissionEntity := range permissions {
+		permissionIDs[permissionEntity.CodeString()] = permissionEntity.ID()
+	}
+	return permissionIDs, nil
+}
+
+func resolvePermissionIDs(permissionIDs map[string]uuid.UUID, codes []string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(codes))
+	for _, code := range codes {
+		if id, exists := permissionIDs[code]; exists {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,177 @@
+package rbaccommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	permissioncommand "github.com/tranvuongduy2003/go-copilot/internal/application/permission/command"
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	rolecommand "github.com/tranvuongduy2003/go-copilot/internal/application/role/command"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func newApplyManifestHandler(permRepo *testutil.MockPermissionRepository, roleRepo *testutil.MockRoleRepository) *ApplyManifestHandler {
+	userRepo := testutil.NewMockUserRepository()
+	organizationRepo := testutil.NewMockOrganizationRepository()
+	log := testutil.NewNoopLogger()
+	runInTransaction := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	return NewApplyManifestHandler(
+		permRepo,
+		roleRepo,
+		runInTransaction,
+		permissioncommand.NewCreatePermissionHandler(permRepo, log),
+		permissioncommand.NewUpdatePermissionHandler(permRepo, log),
+		permissioncommand.NewDeletePermissionHandler(permRepo, roleRepo, log),
+		rolecommand.NewCreateRoleHandler(roleRepo, permRepo, organizationRepo, nil, log),
+		rolecommand.NewUpdateRoleHandler(roleRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), nil, log),
+		rolecommand.NewSetRolePermissionsHandler(roleRepo, permRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), nil, log),
+		rolecommand.NewDenyRolePermissionHandler(roleRepo, permRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), nil, log),
+		rolecommand.NewRemoveRoleDenyHandler(roleRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), nil, log),
+		rolecommand.NewDeleteRoleHandler(roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), nil, log),
+		log,
+	)
+}
+
+func TestApplyManifestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates updates and deletes entities", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+
+		readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+		obsoletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "legacy", Action: "read"})
+		permRepo.AddPermission(readPerm)
+		permRepo.AddPermission(obsoletePerm)
+
+		viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+		legacyRole, _ := role.NewRole(role.NewRoleParams{Name: "legacy", DisplayName: "Legacy", PermissionIDs: []uuid.UUID{obsoletePerm.ID()}})
+		roleRepo.AddRole(viewerRole)
+		roleRepo.AddRole(legacyRole)
+
+		manifest := &rbacmanifest.Manifest{
+			Version: rbacmanifest.CurrentVersion,
+			Permissions: []rbacmanifest.PermissionSpec{
+				{Resource: "users", Action: "read"},
+				{Resource: "reports", Action: "read"},
+			},
+			Roles: []rbacmanifest.RoleSpec{
+				{Name: "viewer", DisplayName: "Read Only", Permissions: []string{"users:read", "reports:read"}},
+				{Name: "analyst", DisplayName: "Analyst", Permissions: []string{"reports:read"}},
+			},
+		}
+
+		plan, err := newApplyManifestHandler(permRepo, roleRepo).Handle(ctx, ApplyManifestCommand{Manifest: manifest})
+
+		require.NoError(t, err)
+		assert.Len(t, plan.Changes, 5)
+
+		reportsPerm, err := permRepo.FindByCodeString(ctx, "reports:read")
+		require.NoError(t, err)
+		_, err = permRepo.FindByCodeString(ctx, "legacy:read")
+		assert.Error(t, err)
+
+		updatedViewer, err := roleRepo.FindByID(ctx, viewerRole.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Read Only", updatedViewer.DisplayName())
+		assert.ElementsMatch(t, []uuid.UUID{readPerm.ID(), reportsPerm.ID()}, updatedViewer.PermissionIDs())
+
+		analyst, err := roleRepo.FindByName(ctx, "analyst")
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{reportsPerm.ID()}, analyst.PermissionIDs())
+
+		_, err = roleRepo.FindByID(ctx, legacyRole.ID())
+		assert.Error(t, err)
+	})
+
+	t.Run("moves permissions between allow and deny", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+
+		readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+		deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+		permRepo.AddPermission(readPerm)
+		permRepo.AddPermission(deletePerm)
+
+		editorRole, _ := role.NewRole(role.NewRoleParams{Name: "editor", DisplayName: "Editor", PermissionIDs: []uuid.UUID{readPerm.ID(), deletePerm.ID()}})
+		roleRepo.AddRole(editorRole)
+
+		permissionSpecs := []rbacmanifest.PermissionSpec{
+			{Resource: "users", Action: "read"},
+			{Resource: "users", Action: "delete"},
+		}
+		handler := newApplyManifestHandler(permRepo, roleRepo)
+
+		_, err := handler.Handle(ctx, ApplyManifestCommand{Manifest: &rbacmanifest.Manifest{
+			Version:     rbacmanifest.CurrentVersion,
+			Permissions: permissionSpecs,
+			Roles: []rbacmanifest.RoleSpec{
+				{Name: "editor", DisplayName: "Editor", Permissions: []string{"users:read"}, Deny: []string{"users:delete"}},
+				{Name: "auditor", DisplayName: "Auditor", Deny: []string{"users:delete"}},
+			},
+		}})
+		require.NoError(t, err)
+
+		updatedEditor, _ := roleRepo.FindByID(ctx, editorRole.ID())
+		assert.Equal(t, []uuid.UUID{readPerm.ID()}, updatedEditor.PermissionIDs())
+		assert.Equal(t, []uuid.UUID{deletePerm.ID()}, updatedEditor.DeniedPermissionIDs())
+
+		auditor, err := roleRepo.FindByName(ctx, "auditor")
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{deletePerm.ID()}, auditor.DeniedPermissionIDs())
+
+		_, err = handler.Handle(ctx, ApplyManifestCommand{Manifest: &rbacmanifest.Manifest{
+			Version:     rbacmanifest.CurrentVersion,
+			Permissions: permissionSpecs,
+			Roles: []rbacmanifest.RoleSpec{
+				{Name: "editor", DisplayName: "Editor", Permissions: []string{"users:read", "users:delete"}},
+			},
+		}})
+		require.NoError(t, err)
+
+		updatedEditor, _ = roleRepo.FindByID(ctx, editorRole.ID())
+		assert.ElementsMatch(t, []uuid.UUID{readPerm.ID(), deletePerm.ID()}, updatedEditor.PermissionIDs())
+		assert.Empty(t, updatedEditor.DeniedPermissionIDs())
+	})
+
+	t.Run("system changes require force", func(t *testing.T) {
+		permRepo := testutil.NewMockPermissionRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+
+		adminPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "system", Action: "admin", IsSystem: true})
+		permRepo.AddPermission(adminPerm)
+		adminRole, _ := role.NewRole(role.NewRoleParams{Name: "super_admin", DisplayName: "Super Admin", IsSystem: true, PermissionIDs: []uuid.UUID{adminPerm.ID()}})
+		roleRepo.AddRole(adminRole)
+
+		manifest := &rbacmanifest.Manifest{
+			Version:     rbacmanifest.CurrentVersion,
+			Permissions: []rbacmanifest.PermissionSpec{{Resource: "system", Action: "admin"}},
+			Roles: []rbacmanifest.RoleSpec{
+				{Name: "super_admin", DisplayName: "Root", Permissions: []string{"system:admin"}},
+			},
+		}
+		handler := newApplyManifestHandler(permRepo, roleRepo)
+
+		_, err := handler.Handle(ctx, ApplyManifestCommand{Manifest: manifest})
+		assert.ErrorIs(t, err, rbacmanifest.ErrSystemChangesRequireForce)
+
+		unchanged, _ := roleRepo.FindByID(ctx, adminRole.ID())
+		assert.Equal(t, "Super Admin", unchanged.DisplayName())
+
+		_, err = handler.Handle(ctx, ApplyManifestCommand{Manifest: manifest, Force: true})
+		require.NoError(t, err)
+
+		updated, _ := roleRepo.FindByID(ctx, adminRole.ID())
+		assert.Equal(t, "Root", updated.DisplayName())
+	})
+}
@@ -0,0 +1,10 @@
+package rbacmanifest
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var ErrSystemChangesRequireForce = shared.NewBusinessRuleViolationError(
+	"rbac_manifest_system_changes",
+	"manifest changes system roles or permissions; apply with force to proceed",
+)
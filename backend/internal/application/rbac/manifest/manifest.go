@@ -0,0 +1,212 @@
+package rbacmanifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+)
+
+const CurrentVersion = 1
+
+type Format string
+
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+type Manifest struct {
+	Version     int              `json:"version" yaml:"version"`
+	Permissions []PermissionSpec `json:"permissions" yaml:"permissions"`
+	Roles       []RoleSpec       `json:"roles" yaml:"roles"`
+}
+
+type PermissionSpec struct {
+	Resource    string `json:"resource" yaml:"resource"`
+	Action      string `json:"action" yaml:"action"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	System      bool   `json:"system,omitempty" yaml:"system,omitempty"`
+}
+
+func (spec PermissionSpec) Code() string {
+	return spec.Resource + ":" + spec.Action
+}
+
+type RoleSpec struct {
+	Name        string   `json:"name" yaml:"name"`
+	DisplayName string   `json:"display_name" yaml:"display_name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	System      bool     `json:"system,omitempty" yaml:"system,omitempty"`
+	Permissions []string `json:"permissions" yaml:"permissions"`
+	Deny        []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+}
+
+func ParseFormat(value string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yaml", "yml":
+		return FormatYAML, nil
+	case "json":
+		return FormatJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported manifest format %q", value)
+	}
+}
+
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+func Parse(data []byte, format Format) (*Manifest, error) {
+	manifest := &Manifest{}
+
+	var err error
+	switch format {
+	case FormatJSON:
+		err = json.Unmarshal(data, manifest)
+	default:
+		err = yaml.Unmarshal(data, manifest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func Marshal(manifest *Manifest, format Format) ([]byte, error) {
+	if format == FormatJSON {
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encode manifest: %w", err)
+		}
+		return append(data, '\n'), nil
+	}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("encode manifest: %w", err)
+	}
+	return data, nil
+}
+
+func (manifest *Manifest) Validate() error {
+	if manifest.Version != CurrentVersion {
+		return fmt.Errorf("unsupported manifest version %d", manifest.Version)
+	}
+
+	permissionCodes := make(map[string]bool, len(manifest.Permissions))
+	for _, spec := range manifest.Permissions {
+		code, err := permission.ParsePermissionCode(spec.Code())
+		if err != nil {
+			return fmt.Errorf("permission %q: %w", spec.Code(), err)
+		}
+		if code.String() != spec.Code() {
+			return fmt.Errorf("permission %q must be written in lowercase", spec.Code())
+		}
+		if permissionCodes[spec.Code()] {
+			return fmt.Errorf("permission %q is declared more than once", spec.Code())
+		}
+		permissionCodes[spec.Code()] = true
+	}
+
+	roleNames := make(map[string]bool, len(manifest.Roles))
+	for _, spec := range manifest.Roles {
+		if strings.TrimSpace(spec.Name) == "" {
+			return fmt.Errorf("role name cannot be empty")
+		}
+		if roleNames[spec.Name] {
+			return fmt.Errorf("role %q is declared more than once", spec.Name)
+		}
+		roleNames[spec.Name] = true
+
+		allowed := make(map[string]bool, len(spec.Permissions))
+		for _, code := range spec.Permissions {
+			if !permissionCodes[code] {
+				return fmt.Errorf("role %q references undeclared permission %q", spec.Name, code)
+			}
+			allowed[code] = true
+		}
+
+		for _, code := range spec.Deny {
+			if !permissionCodes[code] {
+				return fmt.Errorf("role %q denies undeclared permission %q", spec.Name, code)
+			}
+			if allowed[code] {
+				return fmt.Errorf("role %q both grants and denies permission %q", spec.Name, code)
+			}
+		}
+	}
+
+	return nil
+}
+
+func FromDomain(permissions []*permission.Permission, roles []*role.Role) *Manifest {
+	permissionCodes := make(map[string]string, len(permissions))
+	manifest := &Manifest{
+		Version:     CurrentVersion,
+		Permissions: make([]PermissionSpec, 0, len(permissions)),
+		Roles:       make([]RoleSpec, 0, len(roles)),
+	}
+
+	for _, permissionEntity := range permissions {
+		permissionCodes[permissionEntity.ID().String()] = permissionEntity.CodeString()
+		manifest.Permissions = append(manifest.Permissions, PermissionSpec{
+			Resource:    permissionEntity.Resource().String(),
+			Action:      permissionEntity.Action().String(),
+			Description: permissionEntity.Description(),
+			System:      permissionEntity.IsSystem(),
+		})
+	}
+	sort.Slice(manifest.Permissions, func(i, j int) bool {
+		return manifest.Permissions[i].Code() < manifest.Permissions[j].Code()
+	})
+
+	for _, roleEntity := range roles {
+		if !roleEntity.IsGlobal() {
+			continue
+		}
+
+		spec := RoleSpec{
+			Name:        roleEntity.Name(),
+			DisplayName: roleEntity.DisplayName(),
+			Description: roleEntity.Description(),
+			System:      roleEntity.IsSystem(),
+			Permissions: codesFor(permissionCodes, roleEntity.PermissionIDs()),
+		}
+		if denied := codesFor(permissionCodes, roleEntity.DeniedPermissionIDs()); len(denied) > 0 {
+			spec.Deny = denied
+		}
+		manifest.Roles = append(manifest.Roles, spec)
+	}
+	sort.Slice(manifest.Roles, func(i, j int) bool {
+		return manifest.Roles[i].Name < manifest.Roles[j].Name
+	})
+
+	return manifest
+}
+
+func codesFor(permissionCodes map[string]string, permissionIDs []uuid.UUID) []string {
+	codes := make([]string, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		if code, exists := permissionCodes[permissionID.String()]; exists {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
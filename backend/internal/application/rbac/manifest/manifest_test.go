@@ -0,0 +1,169 @@
+package rbacmanifest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		format      Format
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "valid yaml manifest",
+			data: `
+version: 1
+permissions:
+  - resource: reports
+    action: read
+roles:
+  - name: analyst
+    display_name: Analyst
+    permissions: [reports:read]
+`,
+			format: FormatYAML,
+		},
+		{
+			name:   "valid json manifest",
+			data:   `{"version":1,"permissions":[{"resource":"reports","action":"read"}],"roles":[]}`,
+			format: FormatJSON,
+		},
+		{
+			name:        "unsupported version",
+			data:        `version: 2`,
+			format:      FormatYAML,
+			wantErr:     true,
+			errContains: "unsupported manifest version",
+		},
+		{
+			name: "undeclared permission",
+			data: `
+version: 1
+roles:
+  - name: analyst
+    display_name: Analyst
+    permissions: [reports:read]
+`,
+			format:      FormatYAML,
+			wantErr:     true,
+			errContains: "undeclared permission",
+		},
+		{
+			name: "permission both granted and denied",
+			data: `
+version: 1
+permissions:
+  - {resource: reports, action: read}
+roles:
+  - name: analyst
+    display_name: Analyst
+    permissions: [reports:read]
+    deny: [reports:read]
+`,
+			format:      FormatYAML,
+			wantErr:     true,
+			errContains: "both grants and denies",
+		},
+		{
+			name: "duplicate permission",
+			data: `
+version: 1
+permissions:
+  - {resource: reports, action: read}
+  - {resource: reports, action: read}
+`,
+			format:      FormatYAML,
+			wantErr:     true,
+			errContains: "declared more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := Parse([]byte(tt.data), tt.format)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, CurrentVersion, manifest.Version)
+		})
+	}
+}
+
+func TestFromDomain_RoundTrip(t *testing.T) {
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read", Description: "Read users"})
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+	organizationID := uuid.New()
+	tenantRole, _ := role.NewRole(role.NewRoleParams{Name: "tenant", DisplayName: "Tenant", OrganizationID: &organizationID})
+
+	manifest := FromDomain([]*permission.Permission{readPerm}, []*role.Role{viewerRole, tenantRole})
+
+	require.Len(t, manifest.Roles, 1)
+	assert.Equal(t, []string{"users:read"}, manifest.Roles[0].Permissions)
+
+	for _, format := range []Format{FormatYAML, FormatJSON} {
+		data, err := Marshal(manifest, format)
+		require.NoError(t, err)
+
+		parsed, err := Parse(data, format)
+		require.NoError(t, err)
+		assert.Equal(t, manifest, parsed)
+
+		plan := Diff(parsed, []*permission.Permission{readPerm}, []*role.Role{viewerRole, tenantRole})
+		assert.False(t, plan.HasChanges())
+	}
+}
+
+func TestDiff(t *testing.T) {
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read", Description: "Read users"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	systemPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "system", Action: "admin", IsSystem: true})
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID(), deletePerm.ID()}})
+	legacyRole, _ := role.NewRole(role.NewRoleParams{Name: "legacy", DisplayName: "Legacy"})
+
+	manifest := &Manifest{
+		Version: CurrentVersion,
+		Permissions: []PermissionSpec{
+			{Resource: "users", Action: "read", Description: "View users"},
+			{Resource: "reports", Action: "read"},
+		},
+		Roles: []RoleSpec{
+			{Name: "viewer", DisplayName: "Viewer", Permissions: []string{"users:read", "reports:read"}},
+			{Name: "analyst", DisplayName: "Analyst", Permissions: []string{"reports:read"}},
+		},
+	}
+
+	plan := Diff(manifest, []*permission.Permission{readPerm, deletePerm, systemPerm}, []*role.Role{viewerRole, legacyRole})
+
+	summary := make([]string, 0, len(plan.Changes))
+	for _, change := range plan.Changes {
+		summary = append(summary, change.String())
+	}
+	assert.ElementsMatch(t, []string{
+		"~ permission users:read (description)",
+		"+ permission reports:read",
+		"~ role viewer (+reports:read, -users:delete)",
+		"+ role analyst (+reports:read)",
+		"- role legacy",
+		"- permission users:delete",
+		"- permission system:admin [system]",
+	}, summary)
+
+	systemChanges := plan.SystemChanges()
+	require.Len(t, systemChanges, 1)
+	assert.Equal(t, "system:admin", systemChanges[0].Name)
+}
@@ -0,0 +1,278 @@
+package rbacmanifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+)
+
+type ChangeKind string
+
+const (
+	ChangeKindPermission ChangeKind = "permission"
+	ChangeKindRole       ChangeKind = "role"
+)
+
+type ChangeAction string
+
+const (
+	ChangeActionCreate ChangeAction = "create"
+	ChangeActionUpdate ChangeAction = "update"
+	ChangeActionDelete ChangeAction = "delete"
+)
+
+type Change struct {
+	Kind               ChangeKind      `json:"kind"`
+	Action             ChangeAction    `json:"action"`
+	Name               string          `json:"name"`
+	ID                 *uuid.UUID      `json:"id,omitempty"`
+	System             bool            `json:"system"`
+	Fields             []string        `json:"fields,omitempty"`
+	AddedPermissions   []string        `json:"added_permissions,omitempty"`
+	RemovedPermissions []string        `json:"removed_permissions,omitempty"`
+	AddedDenies        []string        `json:"added_denies,omitempty"`
+	RemovedDenies      []string        `json:"removed_denies,omitempty"`
+	Permission         *PermissionSpec `json:"-"`
+	Role               *RoleSpec       `json:"-"`
+}
+
+func (change Change) HasField(field string) bool {
+	for _, changedField := range change.Fields {
+		if changedField == field {
+			return true
+		}
+	}
+	return false
+}
+
+func (change Change) String() string {
+	symbol := map[ChangeAction]string{
+		ChangeActionCreate: "+",
+		ChangeActionUpdate: "~",
+		ChangeActionDelete: "-",
+	}[change.Action]
+
+	line := fmt.Sprintf("%s %s %s", symbol, change.Kind, change.Name)
+	if change.System {
+		line += " [system]"
+	}
+
+	details := make([]string, 0, len(change.Fields)+len(change.AddedPermissions)+len(change.RemovedPermissions)+len(change.AddedDenies)+len(change.RemovedDenies))
+	for _, field := range change.Fields {
+		if field != "permissions" && field != "denies" {
+			details = append(details, field)
+		}
+	}
+	for _, code := range change.AddedPermissions {
+		details = append(details, "+"+code)
+	}
+	for _, code := range change.RemovedPermissions {
+		details = append(details, "-"+code)
+	}
+	for _, code := range change.AddedDenies {
+		details = append(details, "+!"+code)
+	}
+	for _, code := range change.RemovedDenies {
+		details = append(details, "-!"+code)
+	}
+	if len(details) > 0 {
+		line += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	return line
+}
+
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+func (plan *Plan) HasChanges() bool {
+	return len(plan.Changes) > 0
+}
+
+func (plan *Plan) SystemChanges() []Change {
+	changes := make([]Change, 0)
+	for _, change := range plan.Changes {
+		if change.System {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func (plan *Plan) Filter(kind ChangeKind, action ChangeAction) []Change {
+	changes := make([]Change, 0)
+	for _, change := range plan.Changes {
+		if change.Kind == kind && change.Action == action {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func Diff(manifest *Manifest, permissions []*permission.Permission, roles []*role.Role) *Plan {
+	plan := &Plan{Changes: make([]Change, 0)}
+
+	existingPermissions := make(map[string]*permission.Permission, len(permissions))
+	permissionCodesByID := make(map[uuid.UUID]string, len(permissions))
+	for _, permissionEntity := range permissions {
+		existingPermissions[permissionEntity.CodeString()] = permissionEntity
+		permissionCodesByID[permissionEntity.ID()] = permissionEntity.CodeString()
+	}
+
+	declaredPermissions := make(map[string]bool, len(manifest.Permissions))
+	for i := range manifest.Permissions {
+		spec := &manifest.Permissions[i]
+		declaredPermissions[spec.Code()] = true
+
+		existing, exists := existingPermissions[spec.Code()]
+		if !exists {
+			plan.Changes = append(plan.Changes, Change{
+				Kind:       ChangeKindPermission,
+				Action:     ChangeActionCreate,
+				Name:       spec.Code(),
+				Permission: spec,
+			})
+			continue
+		}
+
+		if existing.Description() != spec.Description {
+			id := existing.ID()
+			plan.Changes = append(plan.Changes, Change{
+				Kind:       ChangeKindPermission,
+				Action:     ChangeActionUpdate,
+				Name:       spec.Code(),
+				ID:         &id,
+				System:     existing.IsSystem(),
+				Fields:     []string{"description"},
+				Permission: spec,
+			})
+		}
+	}
+
+	existingRoles := make(map[string]*role.Role, len(roles))
+	for _, roleEntity := range roles {
+		if roleEntity.IsGlobal() {
+			existingRoles[roleEntity.Name()] = roleEntity
+		}
+	}
+
+	declaredRoles := make(map[string]bool, len(manifest.Roles))
+	for i := range manifest.Roles {
+		spec := &manifest.Roles[i]
+		declaredRoles[spec.Name] = true
+
+		existing, exists := existingRoles[spec.Name]
+		if !exists {
+			added := append([]string(nil), spec.Permissions...)
+			sort.Strings(added)
+			addedDenies := append([]string(nil), spec.Deny...)
+			sort.Strings(addedDenies)
+			plan.Changes = append(plan.Changes, Change{
+				Kind:             ChangeKindRole,
+				Action:           ChangeActionCreate,
+				Name:             spec.Name,
+				AddedPermissions: added,
+				AddedDenies:      addedDenies,
+				Role:             spec,
+			})
+			continue
+		}
+
+		fields := make([]string, 0)
+		if existing.DisplayName() != spec.DisplayName {
+			fields = append(fields, "display_name")
+		}
+		if existing.Description() != spec.Description {
+			fields = append(fields, "description")
+		}
+
+		added, removed := diffCodes(permissionCodesByID, existing.PermissionIDs(), spec.Permissions)
+		if len(added) > 0 || len(removed) > 0 {
+			fields = append(fields, "permissions")
+		}
+
+		addedDenies, removedDenies := diffCodes(permissionCodesByID, existing.DeniedPermissionIDs(), spec.Deny)
+		if len(addedDenies) > 0 || len(removedDenies) > 0 {
+			fields = append(fields, "denies")
+		}
+
+		if len(fields) > 0 {
+			id := existing.ID()
+			plan.Changes = append(plan.Changes, Change{
+				Kind:               ChangeKindRole,
+				Action:             ChangeActionUpdate,
+				Name:               spec.Name,
+				ID:                 &id,
+				System:             existing.IsSystem(),
+				Fields:             fields,
+				AddedPermissions:   added,
+				RemovedPermissions: removed,
+				AddedDenies:        addedDenies,
+				RemovedDenies:      removedDenies,
+				Role:               spec,
+			})
+		}
+	}
+
+	for _, roleEntity := range roles {
+		if !roleEntity.IsGlobal() || declaredRoles[roleEntity.Name()] {
+			continue
+		}
+		id := roleEntity.ID()
+		plan.Changes = append(plan.Changes, Change{
+			Kind:   ChangeKindRole,
+			Action: ChangeActionDelete,
+			Name:   roleEntity.Name(),
+			ID:     &id,
+			System: roleEntity.IsSystem(),
+		})
+	}
+
+	for _, permissionEntity := range permissions {
+		if declaredPermissions[permissionEntity.CodeString()] {
+			continue
+		}
+		id := permissionEntity.ID()
+		plan.Changes = append(plan.Changes, Change{
+			Kind:   ChangeKindPermission,
+			Action: ChangeActionDelete,
+			Name:   permissionEntity.CodeString(),
+			ID:     &id,
+			System: permissionEntity.IsSystem(),
+		})
+	}
+
+	return plan
+}
+
+func diffCodes(permissionCodesByID map[uuid.UUID]string, currentIDs []uuid.UUID, desired []string) ([]string, []string) {
+	currentCodes := make(map[string]bool, len(currentIDs))
+	for _, permissionID := range currentIDs {
+		if code, known := permissionCodesByID[permissionID]; known {
+			currentCodes[code] = true
+		}
+	}
+	desiredCodes := make(map[string]bool, len(desired))
+	added := make([]string, 0)
+	for _, code := range desired {
+		desiredCodes[code] = true
+		if !currentCodes[code] {
+			added = append(added, code)
+		}
+	}
+	removed := make([]string, 0)
+	for code := range currentCodes {
+		if !desiredCodes[code] {
+			removed = append(removed, code)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,45 @@
+package rbacquery
+
+import (
+	"context"
+	"fmt"
+
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ExportManifestQuery struct{}
+
+type ExportManifestHandler struct {
+	permissionRepository permission.Repository
+	roleRepository       role.Repository
+	logger               logger.Logger
+}
+
+func NewExportManifestHandler(
+	permissionRepository permission.Repository,
+	roleRepository role.Repository,
+	logger logger.Logger,
+) *ExportManifestHandler {
+	return &ExportManifestHandler{
+		permissionRepository: permissionRepository,
+		roleRepository:       roleRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *ExportManifestHandler) Handle(context context.Context, query ExportManifestQuery) (*rbacmanifest.Manifest, error) {
+	permissions, err := handler.permissionRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("find permissions: %w", err)
+	}
+
+	roles, err := handler.roleRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("find roles: %w", err)
+	}
+
+	return rbacmanifest.FromDomain(permissions, roles), nil
+}
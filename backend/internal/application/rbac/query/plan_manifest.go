@@ -0,0 +1,51 @@
+package rbacquery
+
+import (
+	"context"
+	"fmt"
+
+	rbacmanifest "github.com/tranvuongduy2003/go-copilot/internal/application/rbac/manifest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type PlanManifestQuery struct {
+	Manifest *rbacmanifest.Manifest
+}
+
+type PlanManifestHandler struct {
+	permissionRepository permission.Repository
+	roleRepository       role.Repository
+	logger               logger.Logger
+}
+
+func NewPlanManifestHandler(
+	permissionRepository permission.Repository,
+	roleRepository role.Repository,
+	logger logger.Logger,
+) *PlanManifestHandler {
+	return &PlanManifestHandler{
+		permissionRepository: permissionRepository,
+		roleRepository:       roleRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *PlanManifestHandler) Handle(context context.Context, query PlanManifestQuery) (*rbacmanifest.Plan, error) {
+	if err := query.Manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	permissions, err := handler.permissionRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("find permissions: %w", err)
+	}
+
+	roles, err := handler.roleRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("find roles: %w", err)
+	}
+
+	return rbacmanifest.Diff(query.Manifest, permissions, roles), nil
+}
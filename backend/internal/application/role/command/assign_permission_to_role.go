@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -14,13 +15,17 @@ import (
 )
 
 type AssignPermissionToRoleCommand struct {
-	RoleID       uuid.UUID
-	PermissionID uuid.UUID
+	RoleID          uuid.UUID
+	PermissionID    uuid.UUID
+	ActorID         *uuid.UUID
+	Scope           *role.Scope
+	ExpectedVersion *int64
 }
 
 type AssignPermissionToRoleHandler struct {
 	roleRepository       role.Repository
 	permissionRepository permission.Repository
+	delegationPolicy     *authz.DelegationPolicy
 	eventBus             shared.EventBus
 	logger               logger.Logger
 }
@@ -28,23 +33,37 @@ type AssignPermissionToRoleHandler struct {
 func NewAssignPermissionToRoleHandler(
 	roleRepository role.Repository,
 	permissionRepository permission.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *AssignPermissionToRoleHandler {
 	return &AssignPermissionToRoleHandler{
 		roleRepository:       roleRepository,
 		permissionRepository: permissionRepository,
+		delegationPolicy:     delegationPolicy,
 		eventBus:             eventBus,
 		logger:               logger,
 	}
 }
 
 func (handler *AssignPermissionToRoleHandler) Handle(context context.Context, command AssignPermissionToRoleCommand) (*roledto.RoleDTO, error) {
-	existingRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
 	if err != nil {
 		return nil, err
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingRole.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
 	if !existingRole.CanBeModified() {
 		return nil, role.ErrSystemRoleCannotBeModified
 	}
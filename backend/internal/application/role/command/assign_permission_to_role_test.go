@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -171,7 +172,7 @@ func TestAssignPermissionToRoleHandler_Handle(t *testing.T) {
 
 			testRole, testPermission := tt.setupMocks(roleRepo, permissionRepo)
 
-			handler := NewAssignPermissionToRoleHandler(roleRepo, permissionRepo, eventBus, logger)
+			handler := NewAssignPermissionToRoleHandler(roleRepo, permissionRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testRole, testPermission)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -14,35 +15,48 @@ import (
 )
 
 type CreateRoleCommand struct {
-	Name          string
-	DisplayName   string
-	Description   string
-	PermissionIDs []uuid.UUID
+	Name           string
+	DisplayName    string
+	Description    string
+	PermissionIDs  []uuid.UUID
+	OrganizationID *uuid.UUID
 }
 
 type CreateRoleHandler struct {
-	roleRepository       role.Repository
-	permissionRepository permission.Repository
-	eventBus             shared.EventBus
-	logger               logger.Logger
+	roleRepository         role.Repository
+	permissionRepository   permission.Repository
+	organizationRepository organization.Repository
+	eventBus               shared.EventBus
+	logger                 logger.Logger
 }
 
 func NewCreateRoleHandler(
 	roleRepository role.Repository,
 	permissionRepository permission.Repository,
+	organizationRepository organization.Repository,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *CreateRoleHandler {
 	return &CreateRoleHandler{
-		roleRepository:       roleRepository,
-		permissionRepository: permissionRepository,
-		eventBus:             eventBus,
-		logger:               logger,
+		roleRepository:         roleRepository,
+		permissionRepository:   permissionRepository,
+		organizationRepository: organizationRepository,
+		eventBus:               eventBus,
+		logger:                 logger,
 	}
 }
 
 func (handler *CreateRoleHandler) Handle(context context.Context, command CreateRoleCommand) (*roledto.RoleDTO, error) {
-	exists, err := handler.roleRepository.ExistsByName(context, command.Name)
+	var exists bool
+	var err error
+	if command.OrganizationID != nil {
+		if _, err := handler.organizationRepository.FindByID(context, *command.OrganizationID); err != nil {
+			return nil, err
+		}
+		exists, err = handler.roleRepository.ExistsByNameInOrganization(context, command.Name, *command.OrganizationID)
+	} else {
+		exists, err = handler.roleRepository.ExistsByName(context, command.Name)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("check role name exists: %w", err)
 	}
@@ -61,13 +75,14 @@ func (handler *CreateRoleHandler) Handle(context context.Context, command Create
 	}
 
 	newRole, err := role.NewRole(role.NewRoleParams{
-		Name:          command.Name,
-		DisplayName:   command.DisplayName,
-		Description:   command.Description,
-		PermissionIDs: command.PermissionIDs,
-		IsSystem:      false,
-		IsDefault:     false,
-		Priority:      0,
+		Name:           command.Name,
+		DisplayName:    command.DisplayName,
+		Description:    command.Description,
+		PermissionIDs:  command.PermissionIDs,
+		IsSystem:       false,
+		IsDefault:      false,
+		Priority:       0,
+		OrganizationID: command.OrganizationID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create role: %w", err)
@@ -169,7 +169,7 @@ func TestCreateRoleHandler_Handle(t *testing.T) {
 
 			permIDs := tt.setupMocks(roleRepo, permissionRepo)
 
-			handler := NewCreateRoleHandler(roleRepo, permissionRepo, eventBus, logger)
+			handler := NewCreateRoleHandler(roleRepo, permissionRepo, testutil.NewMockOrganizationRepository(), eventBus, logger)
 			cmd := tt.command(permIDs)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
@@ -13,38 +14,57 @@ import (
 )
 
 type DeleteRoleCommand struct {
-	RoleID uuid.UUID
+	RoleID          uuid.UUID
+	Force           bool
+	ActorID         *uuid.UUID
+	Scope           *role.Scope
+	ExpectedVersion *int64
 }
 
 type DeleteRoleHandler struct {
-	roleRepository role.Repository
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	roleRepository   role.Repository
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewDeleteRoleHandler(
 	roleRepository role.Repository,
 	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *DeleteRoleHandler {
 	return &DeleteRoleHandler{
-		roleRepository: roleRepository,
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		roleRepository:   roleRepository,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
 func (handler *DeleteRoleHandler) Handle(context context.Context, command DeleteRoleCommand) error {
-	existingRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
 	if err != nil {
 		return err
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return err
+	}
+
+	if err := existingRole.CheckVersion(command.ExpectedVersion); err != nil {
+		return err
+	}
+
 	if !existingRole.CanBeDeleted() {
-		if existingRole.IsSystem() {
+		if existingRole.IsSystem() && !command.Force {
 			return role.ErrSystemRoleCannotBeDeleted
 		}
 		if existingRole.IsDefault() {
@@ -60,7 +80,7 @@ func (handler *DeleteRoleHandler) Handle(context context.Context, command Delete
 		return role.ErrRoleInUse
 	}
 
-	if err := handler.roleRepository.Delete(context, command.RoleID); err != nil {
+	if err := handler.roleRepository.Delete(context, command.RoleID, existingRole.Version()); err != nil {
 		return fmt.Errorf("delete role: %w", err)
 	}
 
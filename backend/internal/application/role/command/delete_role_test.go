@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -150,7 +151,7 @@ func TestDeleteRoleHandler_Handle(t *testing.T) {
 
 			testRole := tt.setupMocks(roleRepo, userRepo)
 
-			handler := NewDeleteRoleHandler(roleRepo, userRepo, eventBus, logger)
+			handler := NewDeleteRoleHandler(roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testRole)
 
 			err := handler.Handle(ctx, cmd)
@@ -213,7 +214,7 @@ func TestDeleteRoleHandler_Handle_MultipleUsersWithRole(t *testing.T) {
 	})
 	userRepo.AddUser(user2)
 
-	handler := NewDeleteRoleHandler(roleRepo, userRepo, eventBus, logger)
+	handler := NewDeleteRoleHandler(roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), eventBus, logger)
 
 	err := handler.Handle(ctx, DeleteRoleCommand{
 		RoleID: testRole.ID(),
@@ -247,7 +248,7 @@ func TestDeleteRoleHandler_Handle_PublishesRoleDeletedEvent(t *testing.T) {
 	})
 	roleRepo.AddRole(testRole)
 
-	handler := NewDeleteRoleHandler(roleRepo, userRepo, eventBus, logger)
+	handler := NewDeleteRoleHandler(roleRepo, userRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), eventBus, logger)
 
 	err := handler.Handle(ctx, DeleteRoleCommand{
 		RoleID: testRole.ID(),
@@ -0,0 +1,145 @@
+package rolecommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestDenyRolePermissionHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	createTestPermission := func() *permission.Permission {
+		now := time.Now().UTC()
+		perm, _ := permission.ReconstructPermission(permission.ReconstructPermissionParams{
+			ID:          uuid.New(),
+			Resource:    "articles",
+			Action:      "delete",
+			Description: "Delete articles",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		return perm
+	}
+
+	createTestRole := func(isSystem bool, allowed, denied []uuid.UUID) *role.Role {
+		now := time.Now().UTC()
+		testRole, _ := role.ReconstructRole(role.ReconstructRoleParams{
+			ID:                  uuid.New(),
+			Name:                "editor",
+			DisplayName:         "Editor",
+			PermissionIDs:       allowed,
+			DeniedPermissionIDs: denied,
+			IsSystem:            isSystem,
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		})
+		return testRole
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(*permission.Permission) *role.Role
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "successfully deny permission on role",
+			setup: func(p *permission.Permission) *role.Role {
+				return createTestRole(false, nil, nil)
+			},
+		},
+		{
+			name: "fail when role is system role",
+			setup: func(p *permission.Permission) *role.Role {
+				return createTestRole(true, nil, nil)
+			},
+			wantErr:     true,
+			errContains: "system role",
+		},
+		{
+			name: "fail when permission already denied",
+			setup: func(p *permission.Permission) *role.Role {
+				return createTestRole(false, nil, []uuid.UUID{p.ID()})
+			},
+			wantErr:     true,
+			errContains: "already denied",
+		},
+		{
+			name: "fail when role also grants the permission",
+			setup: func(p *permission.Permission) *role.Role {
+				return createTestRole(false, []uuid.UUID{p.ID()}, nil)
+			},
+			wantErr:     true,
+			errContains: "both grant and deny",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := testutil.NewMockRoleRepository()
+			permissionRepo := testutil.NewMockPermissionRepository()
+
+			testPermission := createTestPermission()
+			permissionRepo.AddPermission(testPermission)
+			testRole := tt.setup(testPermission)
+			roleRepo.AddRole(testRole)
+
+			handler := NewDenyRolePermissionHandler(roleRepo, permissionRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+			result, err := handler.Handle(ctx, DenyRolePermissionCommand{
+				RoleID:       testRole.ID(),
+				PermissionID: testPermission.ID(),
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, result.DeniedPermissionIDs, testPermission.ID())
+			updatedRole, _ := roleRepo.FindByID(ctx, testRole.ID())
+			assert.True(t, updatedRole.DeniesPermission(testPermission.ID()))
+		})
+	}
+}
+
+func TestRemoveRoleDenyHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	permissionID := uuid.New()
+	now := time.Now().UTC()
+
+	testRole, _ := role.ReconstructRole(role.ReconstructRoleParams{
+		ID:                  uuid.New(),
+		Name:                "editor",
+		DisplayName:         "Editor",
+		DeniedPermissionIDs: []uuid.UUID{permissionID},
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	})
+
+	roleRepo := testutil.NewMockRoleRepository()
+	roleRepo.AddRole(testRole)
+
+	handler := NewRemoveRoleDenyHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, RemoveRoleDenyCommand{RoleID: testRole.ID(), PermissionID: permissionID})
+	require.NoError(t, err)
+	assert.Empty(t, result.DeniedPermissionIDs)
+
+	_, err = handler.Handle(ctx, RemoveRoleDenyCommand{RoleID: testRole.ID(), PermissionID: permissionID})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not denied")
+}
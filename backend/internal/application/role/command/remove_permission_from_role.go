@@ -7,40 +7,59 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type RemovePermissionFromRoleCommand struct {
-	RoleID       uuid.UUID
-	PermissionID uuid.UUID
+	RoleID          uuid.UUID
+	PermissionID    uuid.UUID
+	ActorID         *uuid.UUID
+	Scope           *role.Scope
+	ExpectedVersion *int64
 }
 
 type RemovePermissionFromRoleHandler struct {
-	roleRepository role.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewRemovePermissionFromRoleHandler(
 	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *RemovePermissionFromRoleHandler {
 	return &RemovePermissionFromRoleHandler{
-		roleRepository: roleRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
 func (handler *RemovePermissionFromRoleHandler) Handle(context context.Context, command RemovePermissionFromRoleCommand) (*roledto.RoleDTO, error) {
-	existingRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
 	if err != nil {
 		return nil, err
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingRole.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
 	if !existingRole.CanBeModified() {
 		return nil, role.ErrSystemRoleCannotBeModified
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -137,7 +138,7 @@ func TestRemovePermissionFromRoleHandler_Handle(t *testing.T) {
 
 			testRole, testPermission := tt.setupMocks(roleRepo)
 
-			handler := NewRemovePermissionFromRoleHandler(roleRepo, eventBus, logger)
+			handler := NewRemovePermissionFromRoleHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testRole, testPermission)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -182,7 +183,7 @@ func TestRemovePermissionFromRoleHandler_Handle_RemoveMultiplePermissions(t *tes
 	})
 	roleRepo.AddRole(testRole)
 
-	handler := NewRemovePermissionFromRoleHandler(roleRepo, eventBus, logger)
+	handler := NewRemovePermissionFromRoleHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), eventBus, logger)
 
 	_, err := handler.Handle(ctx, RemovePermissionFromRoleCommand{
 		RoleID:       testRole.ID(),
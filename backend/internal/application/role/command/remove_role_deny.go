@@ -0,0 +1,87 @@
+package rolecommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type RemoveRoleDenyCommand struct {
+	RoleID       uuid.UUID
+	PermissionID uuid.UUID
+	ActorID      *uuid.UUID
+	Scope        *role.Scope
+	Force        bool
+}
+
+type RemoveRoleDenyHandler struct {
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewRemoveRoleDenyHandler(
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *RemoveRoleDenyHandler {
+	return &RemoveRoleDenyHandler{
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+func (handler *RemoveRoleDenyHandler) Handle(context context.Context, command RemoveRoleDenyCommand) (*roledto.RoleDTO, error) {
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return nil, err
+	}
+
+	if !existingRole.CanBeModified() && !command.Force {
+		return nil, role.ErrSystemRoleCannotBeModified
+	}
+
+	if err := existingRole.RemoveDeny(command.PermissionID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.roleRepository.Update(context, existingRole); err != nil {
+		return nil, fmt.Errorf("update role: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingRole.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("role_id", existingRole.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingRole.ClearDomainEvents()
+	}
+
+	handler.logger.Info("permission deny removed from role",
+		logger.String("role_id", existingRole.ID().String()),
+		logger.String("permission_id", command.PermissionID.String()),
+	)
+
+	return roledto.RoleFromDomain(existingRole), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
@@ -14,13 +15,18 @@ import (
 )
 
 type SetRolePermissionsCommand struct {
-	RoleID        uuid.UUID
-	PermissionIDs []uuid.UUID
+	RoleID          uuid.UUID
+	PermissionIDs   []uuid.UUID
+	Force           bool
+	ActorID         *uuid.UUID
+	Scope           *role.Scope
+	ExpectedVersion *int64
 }
 
 type SetRolePermissionsHandler struct {
 	roleRepository       role.Repository
 	permissionRepository permission.Repository
+	delegationPolicy     *authz.DelegationPolicy
 	eventBus             shared.EventBus
 	logger               logger.Logger
 }
@@ -28,27 +34,47 @@ type SetRolePermissionsHandler struct {
 func NewSetRolePermissionsHandler(
 	roleRepository role.Repository,
 	permissionRepository permission.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *SetRolePermissionsHandler {
 	return &SetRolePermissionsHandler{
 		roleRepository:       roleRepository,
 		permissionRepository: permissionRepository,
+		delegationPolicy:     delegationPolicy,
 		eventBus:             eventBus,
 		logger:               logger,
 	}
 }
 
 func (handler *SetRolePermissionsHandler) Handle(context context.Context, command SetRolePermissionsCommand) (*roledto.RoleDTO, error) {
-	existingRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
 	if err != nil {
 		return nil, err
 	}
 
-	if !existingRole.CanBeModified() {
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingRole.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	if !existingRole.CanBeModified() && !command.Force {
 		return nil, role.ErrSystemRoleCannotBeModified
 	}
 
+	for _, permissionID := range command.PermissionIDs {
+		if existingRole.DeniesPermission(permissionID) {
+			return nil, role.ErrPermissionAllowedAndDenied
+		}
+	}
+
 	if len(command.PermissionIDs) > 0 {
 		permissions, err := handler.permissionRepository.FindByIDs(context, command.PermissionIDs)
 		if err != nil {
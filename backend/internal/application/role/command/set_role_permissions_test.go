@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -196,7 +197,7 @@ func TestSetRolePermissionsHandler_Handle(t *testing.T) {
 
 			testRole, permIDs := tt.setupMocks(roleRepo, permissionRepo)
 
-			handler := NewSetRolePermissionsHandler(roleRepo, permissionRepo, eventBus, logger)
+			handler := NewSetRolePermissionsHandler(roleRepo, permissionRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testRole, permIDs)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -215,3 +216,21 @@ func TestSetRolePermissionsHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRolePermissionsHandler_Handle_ChecksExpectedVersion(t *testing.T) {
+	roleRepo := testutil.NewMockRoleRepository()
+	permissionRepo := testutil.NewMockPermissionRepository()
+	testRole, err := role.NewRole(role.NewRoleParams{Name: "auditor", DisplayName: "Auditor"})
+	require.NoError(t, err)
+	roleRepo.AddRole(testRole)
+
+	handler := NewSetRolePermissionsHandler(roleRepo, permissionRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+	current := testRole.Version()
+	result, err := handler.Handle(context.Background(), SetRolePermissionsCommand{RoleID: testRole.ID(), PermissionIDs: []uuid.UUID{}, ExpectedVersion: &current})
+	require.NoError(t, err)
+	assert.Equal(t, current+1, result.Version)
+
+	_, err = handler.Handle(context.Background(), SetRolePermissionsCommand{RoleID: testRole.ID(), PermissionIDs: []uuid.UUID{}, ExpectedVersion: &current})
+	assert.ErrorIs(t, err, role.ErrConcurrentModification)
+}
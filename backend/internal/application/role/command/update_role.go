@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
@@ -16,33 +17,54 @@ type UpdateRoleCommand struct {
 	RoleID      uuid.UUID
 	DisplayName string
 	Description string
+	Force       bool
+	ActorID     *uuid.UUID
+	Scope       *role.Scope
+	// ExpectedVersion, when set, makes the update conditional on the role
+	// not having changed since the caller read it.
+	ExpectedVersion *int64
 }
 
 type UpdateRoleHandler struct {
-	roleRepository role.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewUpdateRoleHandler(
 	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *UpdateRoleHandler {
 	return &UpdateRoleHandler{
-		roleRepository: roleRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
 func (handler *UpdateRoleHandler) Handle(context context.Context, command UpdateRoleCommand) (*roledto.RoleDTO, error) {
-	existingRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	existingRole, err := findRoleInScope(context, handler.roleRepository, command.RoleID, command.Scope)
 	if err != nil {
 		return nil, err
 	}
 
-	if !existingRole.CanBeModified() {
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(existingRole); err != nil {
+		return nil, err
+	}
+
+	if err := existingRole.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	if !existingRole.CanBeModified() && !command.Force {
 		return nil, role.ErrSystemRoleCannotBeModified
 	}
 
@@ -71,3 +93,18 @@ func (handler *UpdateRoleHandler) Handle(context context.Context, command Update
 
 	return roledto.RoleFromDomain(existingRole), nil
 }
+
+// findRoleInScope loads the role a command changes. A nil scope, as used by
+// the rbac CLI, reaches every role.
+func findRoleInScope(context context.Context, roleRepository role.Repository, roleID uuid.UUID, scope *role.Scope) (*role.Role, error) {
+	existingRole, err := roleRepository.FindByID(context, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		if err := scope.EnsureChangeable(existingRole); err != nil {
+			return nil, err
+		}
+	}
+	return existingRole, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -126,7 +127,7 @@ func TestUpdateRoleHandler_Handle(t *testing.T) {
 
 			testRole := tt.setupMocks(roleRepo)
 
-			handler := NewUpdateRoleHandler(roleRepo, eventBus, logger)
+			handler := NewUpdateRoleHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testRole)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -145,3 +146,53 @@ func TestUpdateRoleHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRoleHandler_Handle_ChecksExpectedVersion(t *testing.T) {
+	roleRepo := testutil.NewMockRoleRepository()
+	testRole, err := role.NewRole(role.NewRoleParams{Name: "auditor", DisplayName: "Auditor"})
+	require.NoError(t, err)
+	roleRepo.AddRole(testRole)
+
+	handler := NewUpdateRoleHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+	current := testRole.Version()
+	result, err := handler.Handle(context.Background(), UpdateRoleCommand{RoleID: testRole.ID(), DisplayName: "Auditors", ExpectedVersion: &current})
+	require.NoError(t, err)
+	assert.Equal(t, current+1, result.Version)
+
+	_, err = handler.Handle(context.Background(), UpdateRoleCommand{RoleID: testRole.ID(), DisplayName: "Lost Update", ExpectedVersion: &current})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, role.ErrConcurrentModification)
+	assert.Equal(t, "Auditors", testRole.DisplayName())
+}
+
+func TestUpdateRoleHandler_Handle_TenantScope(t *testing.T) {
+	roleRepo := testutil.NewMockRoleRepository()
+	organizationID := uuid.New()
+	otherOrganizationID := uuid.New()
+
+	globalRole, err := role.NewRole(role.NewRoleParams{Name: "auditor", DisplayName: "Auditor"})
+	require.NoError(t, err)
+	otherTenantRole, err := role.NewRole(role.NewRoleParams{Name: "billing", DisplayName: "Billing", OrganizationID: &otherOrganizationID})
+	require.NoError(t, err)
+	ownRole, err := role.NewRole(role.NewRoleParams{Name: "support", DisplayName: "Support", OrganizationID: &organizationID})
+	require.NoError(t, err)
+	roleRepo.AddRole(globalRole)
+	roleRepo.AddRole(otherTenantRole)
+	roleRepo.AddRole(ownRole)
+
+	handler := NewUpdateRoleHandler(roleRepo, authz.NewDelegationPolicy(testutil.NewMockUserRepository(), roleRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+	scope := &role.Scope{OrganizationID: &organizationID}
+
+	_, err = handler.Handle(context.Background(), UpdateRoleCommand{RoleID: otherTenantRole.ID(), DisplayName: "Hijacked", Scope: scope})
+	assert.ErrorIs(t, err, role.ErrRoleNotFound)
+
+	_, err = handler.Handle(context.Background(), UpdateRoleCommand{RoleID: globalRole.ID(), DisplayName: "Hijacked", Scope: scope})
+	assert.ErrorIs(t, err, role.ErrGlobalRoleReadOnly)
+
+	result, err := handler.Handle(context.Background(), UpdateRoleCommand{RoleID: ownRole.ID(), DisplayName: "Customer Support", Scope: scope})
+	require.NoError(t, err)
+	assert.Equal(t, "Customer Support", result.DisplayName)
+	assert.Equal(t, "Billing", otherTenantRole.DisplayName())
+	assert.Equal(t, "Auditor", globalRole.DisplayName())
+}
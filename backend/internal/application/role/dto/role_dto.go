@@ -9,16 +9,19 @@ import (
 )
 
 type RoleDTO struct {
-	ID            uuid.UUID   `json:"id"`
-	Name          string      `json:"name"`
-	DisplayName   string      `json:"display_name"`
-	Description   string      `json:"description"`
-	PermissionIDs []uuid.UUID `json:"permission_ids"`
-	IsSystem      bool        `json:"is_system"`
-	IsDefault     bool        `json:"is_default"`
-	Priority      int         `json:"priority"`
-	CreatedAt     time.Time   `json:"created_at"`
-	UpdatedAt     time.Time   `json:"updated_at"`
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	DisplayName         string      `json:"display_name"`
+	Description         string      `json:"description"`
+	PermissionIDs       []uuid.UUID `json:"permission_ids"`
+	DeniedPermissionIDs []uuid.UUID `json:"denied_permission_ids"`
+	IsSystem            bool        `json:"is_system"`
+	IsDefault           bool        `json:"is_default"`
+	Priority            int         `json:"priority"`
+	OrganizationID      *uuid.UUID  `json:"organization_id,omitempty"`
+	Version             int64       `json:"version"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
 }
 
 func RoleFromDomain(domainRole *role.Role) *RoleDTO {
@@ -26,16 +29,19 @@ func RoleFromDomain(domainRole *role.Role) *RoleDTO {
 		return nil
 	}
 	return &RoleDTO{
-		ID:            domainRole.ID(),
-		Name:          domainRole.Name(),
-		DisplayName:   domainRole.DisplayName(),
-		Description:   domainRole.Description(),
-		PermissionIDs: domainRole.PermissionIDs(),
-		IsSystem:      domainRole.IsSystem(),
-		IsDefault:     domainRole.IsDefault(),
-		Priority:      domainRole.Priority(),
-		CreatedAt:     domainRole.CreatedAt(),
-		UpdatedAt:     domainRole.UpdatedAt(),
+		ID:                  domainRole.ID(),
+		Name:                domainRole.Name(),
+		DisplayName:         domainRole.DisplayName(),
+		Description:         domainRole.Description(),
+		PermissionIDs:       domainRole.PermissionIDs(),
+		DeniedPermissionIDs: domainRole.DeniedPermissionIDs(),
+		IsSystem:            domainRole.IsSystem(),
+		IsDefault:           domainRole.IsDefault(),
+		Priority:            domainRole.Priority(),
+		OrganizationID:      domainRole.OrganizationID(),
+		Version:             domainRole.Version(),
+		CreatedAt:           domainRole.CreatedAt(),
+		UpdatedAt:           domainRole.UpdatedAt(),
 	}
 }
 
@@ -48,17 +54,19 @@ func RolesFromDomain(domainRoles []*role.Role) []*RoleDTO {
 }
 
 type RoleWithPermissionsDTO struct {
-	ID            uuid.UUID     `json:"id"`
-	Name          string        `json:"name"`
-	DisplayName   string        `json:"display_name"`
-	Description   string        `json:"description"`
-	Permissions   []string      `json:"permissions"`
-	PermissionIDs []uuid.UUID   `json:"permission_ids"`
-	IsSystem      bool          `json:"is_system"`
-	IsDefault     bool          `json:"is_default"`
-	Priority      int           `json:"priority"`
-	CreatedAt     time.Time     `json:"created_at"`
-	UpdatedAt     time.Time     `json:"updated_at"`
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	DisplayName         string      `json:"display_name"`
+	Description         string      `json:"description"`
+	Permissions         []string    `json:"permissions"`
+	PermissionIDs       []uuid.UUID `json:"permission_ids"`
+	DeniedPermissionIDs []uuid.UUID `json:"denied_permission_ids"`
+	IsSystem            bool        `json:"is_system"`
+	IsDefault           bool        `json:"is_default"`
+	Priority            int         `json:"priority"`
+	Version             int64       `json:"version"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
 }
 
 func RoleWithPermissionsFromDomain(domainRole *role.Role, permissionCodes []string) *RoleWithPermissionsDTO {
@@ -66,16 +74,18 @@ func RoleWithPermissionsFromDomain(domainRole *role.Role, permissionCodes []stri
 		return nil
 	}
 	return &RoleWithPermissionsDTO{
-		ID:            domainRole.ID(),
-		Name:          domainRole.Name(),
-		DisplayName:   domainRole.DisplayName(),
-		Description:   domainRole.Description(),
-		Permissions:   permissionCodes,
-		PermissionIDs: domainRole.PermissionIDs(),
-		IsSystem:      domainRole.IsSystem(),
-		IsDefault:     domainRole.IsDefault(),
-		Priority:      domainRole.Priority(),
-		CreatedAt:     domainRole.CreatedAt(),
-		UpdatedAt:     domainRole.UpdatedAt(),
+		ID:                  domainRole.ID(),
+		Name:                domainRole.Name(),
+		DisplayName:         domainRole.DisplayName(),
+		Description:         domainRole.Description(),
+		Permissions:         permissionCodes,
+		PermissionIDs:       domainRole.PermissionIDs(),
+		DeniedPermissionIDs: domainRole.DeniedPermissionIDs(),
+		IsSystem:            domainRole.IsSystem(),
+		IsDefault:           domainRole.IsDefault(),
+		Priority:            domainRole.Priority(),
+		Version:             domainRole.Version(),
+		CreatedAt:           domainRole.CreatedAt(),
+		UpdatedAt:           domainRole.UpdatedAt(),
 	}
 }
@@ -14,6 +14,7 @@ import (
 
 type GetRoleQuery struct {
 	RoleID uuid.UUID
+	Scope  *role.Scope
 }
 
 type GetRoleHandler struct {
@@ -35,7 +36,7 @@ func NewGetRoleHandler(
 }
 
 func (handler *GetRoleHandler) Handle(context context.Context, query GetRoleQuery) (*roledto.RoleWithPermissionsDTO, error) {
-	foundRole, err := handler.roleRepository.FindByID(context, query.RoleID)
+	foundRole, err := findVisibleRole(context, handler.roleRepository, query.RoleID, query.Scope)
 	if err != nil {
 		return nil, err
 	}
@@ -58,3 +59,18 @@ func (handler *GetRoleHandler) Handle(context context.Context, query GetRoleQuer
 
 	return roledto.RoleWithPermissionsFromDomain(foundRole, permissionCodes), nil
 }
+
+// findVisibleRole loads a role the caller reads by ID. A nil scope reaches
+// every role.
+func findVisibleRole(context context.Context, roleRepository role.Repository, roleID uuid.UUID, scope *role.Scope) (*role.Role, error) {
+	foundRole, err := roleRepository.FindByID(context, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if scope != nil {
+		if err := scope.EnsureVisible(foundRole); err != nil {
+			return nil, err
+		}
+	}
+	return foundRole, nil
+}
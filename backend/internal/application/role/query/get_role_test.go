@@ -164,3 +164,24 @@ func TestGetRoleHandler_Handle_LoadsPermissionCodes(t *testing.T) {
 	assert.Contains(t, result.Permissions, "users:read")
 	assert.Contains(t, result.Permissions, "users:create")
 }
+
+func TestGetRoleHandler_Handle_TenantScope(t *testing.T) {
+	roleRepo := testutil.NewMockRoleRepository()
+	organizationID := uuid.New()
+	otherOrganizationID := uuid.New()
+
+	globalRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+	otherTenantRole, _ := role.NewRole(role.NewRoleParams{Name: "billing", DisplayName: "Billing", OrganizationID: &otherOrganizationID})
+	roleRepo.AddRole(globalRole)
+	roleRepo.AddRole(otherTenantRole)
+
+	handler := NewGetRoleHandler(roleRepo, testutil.NewMockPermissionRepository(), testutil.NewNoopLogger())
+	scope := &role.Scope{OrganizationID: &organizationID}
+
+	result, err := handler.Handle(context.Background(), GetRoleQuery{RoleID: globalRole.ID(), Scope: scope})
+	require.NoError(t, err)
+	assert.Equal(t, globalRole.ID(), result.ID)
+
+	_, err = handler.Handle(context.Background(), GetRoleQuery{RoleID: otherTenantRole.ID(), Scope: scope})
+	assert.ErrorIs(t, err, role.ErrRoleNotFound)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
@@ -14,29 +15,34 @@ import (
 
 type GetUsersWithRoleQuery struct {
 	RoleID uuid.UUID
+	Scope  *role.Scope
 }
 
 type GetUsersWithRoleHandler struct {
-	roleRepository role.Repository
-	userRepository user.Repository
-	logger         logger.Logger
+	roleRepository         role.Repository
+	userRepository         user.Repository
+	organizationRepository organization.Repository
+	logger                 logger.Logger
 }
 
 func NewGetUsersWithRoleHandler(
 	roleRepository role.Repository,
 	userRepository user.Repository,
+	organizationRepository organization.Repository,
 	logger logger.Logger,
 ) *GetUsersWithRoleHandler {
 	return &GetUsersWithRoleHandler{
-		roleRepository: roleRepository,
-		userRepository: userRepository,
-		logger:         logger,
+		roleRepository:         roleRepository,
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		logger:                 logger,
 	}
 }
 
+// Handle lists the holders of a role. A caller inside an organization only
+// sees the members of that organization, also for global roles.
 func (handler *GetUsersWithRoleHandler) Handle(context context.Context, query GetUsersWithRoleQuery) ([]*userdto.UserDTO, error) {
-	_, err := handler.roleRepository.FindByID(context, query.RoleID)
-	if err != nil {
+	if _, err := findVisibleRole(context, handler.roleRepository, query.RoleID, query.Scope); err != nil {
 		return nil, err
 	}
 
@@ -45,5 +51,23 @@ func (handler *GetUsersWithRoleHandler) Handle(context context.Context, query Ge
 		return nil, fmt.Errorf("get users with role: %w", err)
 	}
 
+	if query.Scope != nil && query.Scope.OrganizationID != nil {
+		memberships, err := handler.organizationRepository.ListMembers(context, *query.Scope.OrganizationID)
+		if err != nil {
+			return nil, fmt.Errorf("list organization members: %w", err)
+		}
+		memberIDs := make(map[uuid.UUID]bool, len(memberships))
+		for _, membership := range memberships {
+			memberIDs[membership.UserID()] = true
+		}
+		members := make([]*user.User, 0, len(users))
+		for _, holder := range users {
+			if memberIDs[holder.ID()] {
+				members = append(members, holder)
+			}
+		}
+		users = members
+	}
+
 	return userdto.UsersFromDomain(users), nil
 }
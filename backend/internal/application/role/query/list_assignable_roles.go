@@ -0,0 +1,56 @@
+package rolequery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListAssignableRolesQuery struct {
+	ActorID        uuid.UUID
+	OrganizationID *uuid.UUID
+}
+
+type ListAssignableRolesHandler struct {
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	logger           logger.Logger
+}
+
+func NewListAssignableRolesHandler(
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	logger logger.Logger,
+) *ListAssignableRolesHandler {
+	return &ListAssignableRolesHandler{
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		logger:           logger,
+	}
+}
+
+func (handler *ListAssignableRolesHandler) Handle(context context.Context, query ListAssignableRolesQuery) ([]*roledto.RoleDTO, error) {
+	delegator, err := handler.delegationPolicy.ForActor(context, &query.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := findRolesInScope(context, handler.roleRepository, role.Scope{OrganizationID: query.OrganizationID})
+	if err != nil {
+		return nil, err
+	}
+
+	assignableRoles := make([]*role.Role, 0, len(roles))
+	for _, candidateRole := range roles {
+		if delegator.CanManageRole(candidateRole) {
+			assignableRoles = append(assignableRoles, candidateRole)
+		}
+	}
+
+	return roledto.RolesFromDomain(assignableRoles), nil
+}
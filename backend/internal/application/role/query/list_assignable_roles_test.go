@@ -0,0 +1,46 @@
+package rolequery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListAssignableRolesHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+
+	superAdminRole, _ := role.NewRole(role.NewRoleParams{Name: "super_admin", DisplayName: "Super Admin", Priority: 100})
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	managerRole, _ := role.NewRole(role.NewRoleParams{Name: "manager", DisplayName: "Manager", Priority: 50})
+	userRole, _ := role.NewRole(role.NewRoleParams{Name: "user", DisplayName: "User", Priority: 10})
+	for _, r := range []*role.Role{superAdminRole, adminRole, managerRole, userRole} {
+		roleRepo.AddRole(r)
+	}
+
+	actor := testutil.CreateActiveUser()
+	_ = actor.AssignRole(adminRole.ID())
+	userRepo.AddUser(actor)
+
+	handler := NewListAssignableRolesHandler(roleRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), testutil.NewNoopLogger())
+
+	roles, err := handler.Handle(ctx, ListAssignableRolesQuery{ActorID: actor.ID()})
+	require.NoError(t, err)
+
+	roleIDs := make([]uuid.UUID, len(roles))
+	for i, roleDTO := range roles {
+		roleIDs[i] = roleDTO.ID
+	}
+	assert.ElementsMatch(t, []uuid.UUID{managerRole.ID(), userRole.ID()}, roleIDs)
+
+	_, err = handler.Handle(ctx, ListAssignableRolesQuery{ActorID: uuid.New()})
+	assert.Error(t, err)
+}
@@ -4,12 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
-type ListRolesQuery struct{}
+// ListRolesQuery lists the global roles plus those of OrganizationID. A
+// caller outside any organization sees only the global roles unless
+// AllOrganizations is set, which only platform administrators may do.
+type ListRolesQuery struct {
+	OrganizationID   *uuid.UUID
+	AllOrganizations bool
+}
 
 type ListRolesHandler struct {
 	roleRepository role.Repository
@@ -27,10 +35,30 @@ func NewListRolesHandler(
 }
 
 func (handler *ListRolesHandler) Handle(context context.Context, query ListRolesQuery) ([]*roledto.RoleDTO, error) {
-	roles, err := handler.roleRepository.FindAll(context)
+	roles, err := findRolesInScope(context, handler.roleRepository, role.Scope{
+		OrganizationID:   query.OrganizationID,
+		AllOrganizations: query.AllOrganizations,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("list roles: %w", err)
+		return nil, err
 	}
 
 	return roledto.RolesFromDomain(roles), nil
 }
+
+func findRolesInScope(context context.Context, roleRepository role.Repository, scope role.Scope) ([]*role.Role, error) {
+	var roles []*role.Role
+	var err error
+	switch {
+	case scope.AllOrganizations:
+		roles, err = roleRepository.FindAll(context)
+	case scope.OrganizationID != nil:
+		roles, err = roleRepository.FindAllForOrganization(context, *scope.OrganizationID)
+	default:
+		roles, err = roleRepository.FindGlobal(context)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+	return roles, nil
+}
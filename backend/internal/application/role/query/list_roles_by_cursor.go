@@ -0,0 +1,55 @@
+package rolequery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// ListRolesByCursorQuery pages through the roles ListRolesQuery returns in
+// one go. An empty Cursor requests the first page.
+type ListRolesByCursorQuery struct {
+	OrganizationID   *uuid.UUID
+	AllOrganizations bool
+	Cursor           string
+	Limit            int
+	IncludeTotal     bool
+}
+
+type ListRolesByCursorHandler struct {
+	roleRepository role.Repository
+	logger         logger.Logger
+}
+
+func NewListRolesByCursorHandler(
+	roleRepository role.Repository,
+	logger logger.Logger,
+) *ListRolesByCursorHandler {
+	return &ListRolesByCursorHandler{
+		roleRepository: roleRepository,
+		logger:         logger,
+	}
+}
+
+func (handler *ListRolesByCursorHandler) Handle(context context.Context, query ListRolesByCursorQuery) (shared.CursorResult[*roledto.RoleDTO], error) {
+	page, err := shared.NewCursorPage(query.Cursor, query.Limit, query.IncludeTotal)
+	if err != nil {
+		return shared.CursorResult[*roledto.RoleDTO]{}, err
+	}
+
+	result, err := handler.roleRepository.ListByCursor(context, role.Scope{
+		OrganizationID:   query.OrganizationID,
+		AllOrganizations: query.AllOrganizations,
+	}, page)
+	if err != nil {
+		return shared.CursorResult[*roledto.RoleDTO]{}, fmt.Errorf("list roles: %w", err)
+	}
+
+	return shared.MapCursorResult(result, roledto.RoleFromDomain), nil
+}
@@ -0,0 +1,50 @@
+package rolequery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListRolesByCursorHandler_Handle(t *testing.T) {
+	roleRepo := testutil.NewMockRoleRepository()
+	now := time.Now().UTC()
+	for i, name := range []string{"admin", "editor", "viewer"} {
+		testRole, err := role.ReconstructRole(role.ReconstructRoleParams{
+			ID:          uuid.New(),
+			Name:        name,
+			DisplayName: name,
+			Priority:    100 - i,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		require.NoError(t, err)
+		roleRepo.AddRole(testRole)
+	}
+
+	handler := NewListRolesByCursorHandler(roleRepo, testutil.NewNoopLogger())
+
+	first, err := handler.Handle(context.Background(), ListRolesByCursorQuery{Limit: 2, IncludeTotal: true})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "admin", first.Items[0].Name)
+	assert.Equal(t, int64(3), *first.Total)
+
+	second, err := handler.Handle(context.Background(), ListRolesByCursorQuery{Limit: 2, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "viewer", second.Items[0].Name)
+	assert.Empty(t, second.NextCursor)
+	assert.NotEmpty(t, second.PrevCursor)
+
+	_, err = handler.Handle(context.Background(), ListRolesByCursorQuery{Cursor: "%%%"})
+	assert.True(t, shared.IsValidationError(err))
+}
@@ -79,3 +79,43 @@ func TestListRolesHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestListRolesHandler_Handle_TenantScope(t *testing.T) {
+	organizationID := uuid.New()
+	otherOrganizationID := uuid.New()
+
+	roleRepo := testutil.NewMockRoleRepository()
+	now := time.Now().UTC()
+	for name, ownerID := range map[string]*uuid.UUID{
+		"global": nil,
+		"tenant": &organizationID,
+		"other":  &otherOrganizationID,
+	} {
+		testRole, err := role.ReconstructRole(role.ReconstructRoleParams{
+			ID:             uuid.New(),
+			OrganizationID: ownerID,
+			Name:           name,
+			DisplayName:    name,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+		require.NoError(t, err)
+		roleRepo.AddRole(testRole)
+	}
+
+	handler := NewListRolesHandler(roleRepo, testutil.NewNoopLogger())
+
+	names := func(query ListRolesQuery) []string {
+		result, err := handler.Handle(context.Background(), query)
+		require.NoError(t, err)
+		names := make([]string, 0, len(result))
+		for _, listed := range result {
+			names = append(names, listed.Name)
+		}
+		return names
+	}
+
+	assert.ElementsMatch(t, []string{"global"}, names(ListRolesQuery{}), "a caller outside any organization sees only global roles")
+	assert.ElementsMatch(t, []string{"global", "tenant"}, names(ListRolesQuery{OrganizationID: &organizationID}))
+	assert.ElementsMatch(t, []string{"global", "tenant", "other"}, names(ListRolesQuery{AllOrganizations: true}))
+}
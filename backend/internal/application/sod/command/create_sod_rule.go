@@ -0,0 +1,81 @@
+package sodcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CreateSoDRuleCommand struct {
+	Name        string
+	Description string
+	Type        sod.RuleType
+	RoleIDs     []uuid.UUID
+	MaxHolders  int
+}
+
+type CreateSoDRuleHandler struct {
+	ruleRepository sod.Repository
+	roleRepository role.Repository
+	eventBus       shared.EventBus
+	logger         logger.Logger
+}
+
+func NewCreateSoDRuleHandler(
+	ruleRepository sod.Repository,
+	roleRepository role.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CreateSoDRuleHandler {
+	return &CreateSoDRuleHandler{
+		ruleRepository: ruleRepository,
+		roleRepository: roleRepository,
+		eventBus:       eventBus,
+		logger:         logger,
+	}
+}
+
+func (handler *CreateSoDRuleHandler) Handle(context context.Context, command CreateSoDRuleCommand) (*soddto.RuleDTO, error) {
+	rule, err := sod.NewRule(sod.NewRuleParams{
+		Name:        command.Name,
+		Description: command.Description,
+		Type:        command.Type,
+		RoleIDs:     command.RoleIDs,
+		MaxHolders:  command.MaxHolders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureRolesExist(context, handler.roleRepository, rule.RoleIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := handler.ruleRepository.Create(context, rule); err != nil {
+		return nil, fmt.Errorf("save sod rule: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, rule.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("sod_rule_id", rule.ID().String()),
+				logger.Err(err),
+			)
+		}
+		rule.ClearDomainEvents()
+	}
+
+	handler.logger.Info("sod rule created",
+		logger.String("sod_rule_id", rule.ID().String()),
+		logger.String("name", rule.Name()),
+	)
+
+	return soddto.RuleFromDomain(rule), nil
+}
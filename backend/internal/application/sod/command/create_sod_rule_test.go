@@ -0,0 +1,90 @@
+package sodcommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestCreateSoDRuleHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	requesterRole, _ := role.NewRole(role.NewRoleParams{Name: "payment_requester", DisplayName: "Payment Requester"})
+	approverRole, _ := role.NewRole(role.NewRoleParams{Name: "payment_approver", DisplayName: "Payment Approver"})
+
+	tests := []struct {
+		name    string
+		command CreateSoDRuleCommand
+		wantErr func(error) bool
+	}{
+		{
+			name: "successfully create mutual exclusion rule",
+			command: CreateSoDRuleCommand{
+				Name:    "payments",
+				Type:    sod.RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{requesterRole.ID(), approverRole.ID()},
+			},
+		},
+		{
+			name: "successfully create cardinality rule",
+			command: CreateSoDRuleCommand{
+				Name:       "single approver",
+				Type:       sod.RuleTypeMaxCardinality,
+				RoleIDs:    []uuid.UUID{approverRole.ID()},
+				MaxHolders: 1,
+			},
+		},
+		{
+			name: "fail when role does not exist",
+			command: CreateSoDRuleCommand{
+				Name:    "payments",
+				Type:    sod.RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{requesterRole.ID(), uuid.New()},
+			},
+			wantErr: shared.IsNotFoundError,
+		},
+		{
+			name: "fail when mutual exclusion has a single role",
+			command: CreateSoDRuleCommand{
+				Name:    "payments",
+				Type:    sod.RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{requesterRole.ID()},
+			},
+			wantErr: shared.IsValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleRepo := testutil.NewMockSoDRuleRepository()
+			roleRepo := testutil.NewMockRoleRepository()
+			roleRepo.AddRole(requesterRole)
+			roleRepo.AddRole(approverRole)
+			eventBus := testutil.NewMockEventBus()
+
+			handler := NewCreateSoDRuleHandler(ruleRepo, roleRepo, eventBus, testutil.NewNoopLogger())
+			result, err := handler.Handle(ctx, tt.command)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantErr(err))
+				assert.Empty(t, ruleRepo.Rules)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.command.Name, result.Name)
+			assert.Equal(t, tt.command.Type.String(), result.Type)
+			assert.Len(t, ruleRepo.Rules, 1)
+			testutil.AssertDomainEventPublished(t, eventBus, sod.EventTypeRuleCreated)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package sodcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DeleteSoDRuleCommand struct {
+	RuleID uuid.UUID
+}
+
+type DeleteSoDRuleHandler struct {
+	ruleRepository sod.Repository
+	eventBus       shared.EventBus
+	logger         logger.Logger
+}
+
+func NewDeleteSoDRuleHandler(
+	ruleRepository sod.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DeleteSoDRuleHandler {
+	return &DeleteSoDRuleHandler{
+		ruleRepository: ruleRepository,
+		eventBus:       eventBus,
+		logger:         logger,
+	}
+}
+
+func (handler *DeleteSoDRuleHandler) Handle(context context.Context, command DeleteSoDRuleCommand) error {
+	rule, err := handler.ruleRepository.FindByID(context, command.RuleID)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.ruleRepository.Delete(context, command.RuleID); err != nil {
+		return fmt.Errorf("delete sod rule: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := sod.NewRuleDeletedEvent(rule.ID(), rule.Name())
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish sod rule deleted event",
+				logger.String("sod_rule_id", rule.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("sod rule deleted",
+		logger.String("sod_rule_id", rule.ID().String()),
+		logger.String("name", rule.Name()),
+	)
+
+	return nil
+}
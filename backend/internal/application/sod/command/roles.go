@@ -0,0 +1,33 @@
+package sodcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+)
+
+func ensureRolesExist(context context.Context, roleRepository role.Repository, roleIDs []uuid.UUID) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	roles, err := roleRepository.FindByIDs(context, roleIDs)
+	if err != nil {
+		return fmt.Errorf("validate roles: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(roles))
+	for _, existingRole := range roles {
+		found[existingRole.ID()] = true
+	}
+	for _, roleID := range roleIDs {
+		if !found[roleID] {
+			return role.NewRoleNotFoundError(roleID.String())
+		}
+	}
+
+	return nil
+}
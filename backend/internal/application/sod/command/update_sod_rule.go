@@ -0,0 +1,83 @@
+package sodcommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UpdateSoDRuleCommand struct {
+	RuleID      uuid.UUID
+	Name        string
+	Description string
+	RoleIDs     []uuid.UUID
+	MaxHolders  int
+}
+
+type UpdateSoDRuleHandler struct {
+	ruleRepository sod.Repository
+	roleRepository role.Repository
+	eventBus       shared.EventBus
+	logger         logger.Logger
+}
+
+func NewUpdateSoDRuleHandler(
+	ruleRepository sod.Repository,
+	roleRepository role.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *UpdateSoDRuleHandler {
+	return &UpdateSoDRuleHandler{
+		ruleRepository: ruleRepository,
+		roleRepository: roleRepository,
+		eventBus:       eventBus,
+		logger:         logger,
+	}
+}
+
+func (handler *UpdateSoDRuleHandler) Handle(context context.Context, command UpdateSoDRuleCommand) (*soddto.RuleDTO, error) {
+	rule, err := handler.ruleRepository.FindByID(context, command.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rule.Update(sod.UpdateRuleParams{
+		Name:        command.Name,
+		Description: command.Description,
+		RoleIDs:     command.RoleIDs,
+		MaxHolders:  command.MaxHolders,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ensureRolesExist(context, handler.roleRepository, rule.RoleIDs()); err != nil {
+		return nil, err
+	}
+
+	if err := handler.ruleRepository.Update(context, rule); err != nil {
+		return nil, fmt.Errorf("update sod rule: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, rule.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("sod_rule_id", rule.ID().String()),
+				logger.Err(err),
+			)
+		}
+		rule.ClearDomainEvents()
+	}
+
+	handler.logger.Info("sod rule updated",
+		logger.String("sod_rule_id", rule.ID().String()),
+	)
+
+	return soddto.RuleFromDomain(rule), nil
+}
@@ -0,0 +1,66 @@
+package soddto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+)
+
+type RuleDTO struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	RoleIDs     []uuid.UUID `json:"role_ids"`
+	MaxHolders  int         `json:"max_holders,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+func RuleFromDomain(rule *sod.Rule) *RuleDTO {
+	if rule == nil {
+		return nil
+	}
+	return &RuleDTO{
+		ID:          rule.ID(),
+		Name:        rule.Name(),
+		Description: rule.Description(),
+		Type:        rule.Type().String(),
+		RoleIDs:     rule.RoleIDs(),
+		MaxHolders:  rule.MaxHolders(),
+		CreatedAt:   rule.CreatedAt(),
+		UpdatedAt:   rule.UpdatedAt(),
+	}
+}
+
+func RulesFromDomain(rules []*sod.Rule) []*RuleDTO {
+	dtos := make([]*RuleDTO, len(rules))
+	for i, rule := range rules {
+		dtos[i] = RuleFromDomain(rule)
+	}
+	return dtos
+}
+
+type ViolationDTO struct {
+	RuleID   uuid.UUID   `json:"rule_id"`
+	RuleName string      `json:"rule_name"`
+	RuleType string      `json:"rule_type"`
+	UserID   uuid.UUID   `json:"user_id"`
+	RoleIDs  []uuid.UUID `json:"role_ids"`
+}
+
+func ViolationsFromDomain(violations []sod.Violation) []*ViolationDTO {
+	dtos := make([]*ViolationDTO, len(violations))
+	for i, violation := range violations {
+		dtos[i] = &ViolationDTO{
+			RuleID:   violation.RuleID,
+			RuleName: violation.RuleName,
+			RuleType: violation.RuleType.String(),
+			UserID:   violation.UserID,
+			RoleIDs:  violation.RoleIDs,
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,39 @@
+package sodquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetSoDRuleQuery struct {
+	RuleID uuid.UUID
+}
+
+type GetSoDRuleHandler struct {
+	ruleRepository sod.Repository
+	logger         logger.Logger
+}
+
+func NewGetSoDRuleHandler(
+	ruleRepository sod.Repository,
+	logger logger.Logger,
+) *GetSoDRuleHandler {
+	return &GetSoDRuleHandler{
+		ruleRepository: ruleRepository,
+		logger:         logger,
+	}
+}
+
+func (handler *GetSoDRuleHandler) Handle(context context.Context, query GetSoDRuleQuery) (*soddto.RuleDTO, error) {
+	rule, err := handler.ruleRepository.FindByID(context, query.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return soddto.RuleFromDomain(rule), nil
+}
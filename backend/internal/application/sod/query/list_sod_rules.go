@@ -0,0 +1,36 @@
+package sodquery
+
+import (
+	"context"
+	"fmt"
+
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListSoDRulesQuery struct{}
+
+type ListSoDRulesHandler struct {
+	ruleRepository sod.Repository
+	logger         logger.Logger
+}
+
+func NewListSoDRulesHandler(
+	ruleRepository sod.Repository,
+	logger logger.Logger,
+) *ListSoDRulesHandler {
+	return &ListSoDRulesHandler{
+		ruleRepository: ruleRepository,
+		logger:         logger,
+	}
+}
+
+func (handler *ListSoDRulesHandler) Handle(context context.Context, query ListSoDRulesQuery) ([]*soddto.RuleDTO, error) {
+	rules, err := handler.ruleRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("list sod rules: %w", err)
+	}
+
+	return soddto.RulesFromDomain(rules), nil
+}
@@ -0,0 +1,58 @@
+package sodquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListSoDViolationsQuery struct {
+	RuleID *uuid.UUID
+}
+
+type ListSoDViolationsHandler struct {
+	ruleRepository sod.Repository
+	checker        *sod.Checker
+	logger         logger.Logger
+}
+
+func NewListSoDViolationsHandler(
+	ruleRepository sod.Repository,
+	checker *sod.Checker,
+	logger logger.Logger,
+) *ListSoDViolationsHandler {
+	return &ListSoDViolationsHandler{
+		ruleRepository: ruleRepository,
+		checker:        checker,
+		logger:         logger,
+	}
+}
+
+func (handler *ListSoDViolationsHandler) Handle(context context.Context, query ListSoDViolationsQuery) ([]*soddto.ViolationDTO, error) {
+	var rules []*sod.Rule
+	if query.RuleID != nil {
+		rule, err := handler.ruleRepository.FindByID(context, *query.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		rules = []*sod.Rule{rule}
+	} else {
+		allRules, err := handler.ruleRepository.FindAll(context)
+		if err != nil {
+			return nil, fmt.Errorf("list sod rules: %w", err)
+		}
+		rules = allRules
+	}
+
+	violations, err := handler.checker.FindViolations(context, rules)
+	if err != nil {
+		return nil, fmt.Errorf("find sod violations: %w", err)
+	}
+
+	return soddto.ViolationsFromDomain(violations), nil
+}
@@ -0,0 +1,88 @@
+package sodquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListSoDViolationsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	ruleRepo := testutil.NewMockSoDRuleRepository()
+	userRepo := testutil.NewMockUserRepository()
+	groupRepo := testutil.NewMockGroupRepository()
+
+	requesterRoleID := uuid.New()
+	approverRoleID := uuid.New()
+
+	exclusionRule, _ := sod.NewRule(sod.NewRuleParams{
+		Name:    "payments",
+		Type:    sod.RuleTypeMutualExclusion,
+		RoleIDs: []uuid.UUID{requesterRoleID, approverRoleID},
+	})
+	ruleRepo.AddRule(exclusionRule)
+	cardinalityRule, _ := sod.NewRule(sod.NewRuleParams{
+		Name:       "single approver",
+		Type:       sod.RuleTypeMaxCardinality,
+		RoleIDs:    []uuid.UUID{approverRoleID},
+		MaxHolders: 1,
+	})
+	ruleRepo.AddRule(cardinalityRule)
+
+	directViolator := testutil.CreateActiveUser()
+	_ = directViolator.AssignRole(requesterRoleID)
+	_ = directViolator.AssignRole(approverRoleID)
+	userRepo.AddUser(directViolator)
+
+	approvers, _ := group.NewGroup(group.NewGroupParams{Name: "Approvers", RoleIDs: []uuid.UUID{approverRoleID}})
+	groupRepo.AddGroup(approvers)
+	groupViolator := testutil.CreateActiveUser()
+	_ = groupViolator.AssignRole(requesterRoleID)
+	userRepo.AddUser(groupViolator)
+	groupRepo.AddMembership(approvers.ID(), groupViolator.ID())
+
+	compliantUser := testutil.CreateActiveUser()
+	_ = compliantUser.AssignRole(requesterRoleID)
+	userRepo.AddUser(compliantUser)
+
+	handler := NewListSoDViolationsHandler(ruleRepo, sod.NewChecker(ruleRepo, userRepo, groupRepo), testutil.NewNoopLogger())
+
+	t.Run("reports violations for a single rule", func(t *testing.T) {
+		ruleID := exclusionRule.ID()
+		violations, err := handler.Handle(ctx, ListSoDViolationsQuery{RuleID: &ruleID})
+		require.NoError(t, err)
+
+		violatorIDs := make([]uuid.UUID, len(violations))
+		for i, violation := range violations {
+			assert.Equal(t, exclusionRule.ID(), violation.RuleID)
+			violatorIDs[i] = violation.UserID
+		}
+		assert.ElementsMatch(t, []uuid.UUID{directViolator.ID(), groupViolator.ID()}, violatorIDs)
+	})
+
+	t.Run("reports violations across all rules", func(t *testing.T) {
+		violations, err := handler.Handle(ctx, ListSoDViolationsQuery{})
+		require.NoError(t, err)
+
+		cardinalityViolations := 0
+		for _, violation := range violations {
+			if violation.RuleID == cardinalityRule.ID() {
+				cardinalityViolations++
+			}
+		}
+		assert.Equal(t, 2, cardinalityViolations)
+	})
+
+	t.Run("fail when rule not found", func(t *testing.T) {
+		ruleID := uuid.New()
+		_, err := handler.Handle(ctx, ListSoDViolationsQuery{RuleID: &ruleID})
+		assert.ErrorIs(t, err, sod.ErrRuleNotFound)
+	})
+}
@@ -7,30 +7,35 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type ActivateUserCommand struct {
-	UserID uuid.UUID
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
 }
 
 type ActivateUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewActivateUserHandler(
 	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *ActivateUserHandler {
 	return &ActivateUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
@@ -40,6 +45,14 @@ func (handler *ActivateUserHandler) Handle(context context.Context, command Acti
 		return nil, fmt.Errorf("find user: %w", err)
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
 	if err := existingUser.Activate(); err != nil {
 		return nil, fmt.Errorf("activate user: %w", err)
 	}
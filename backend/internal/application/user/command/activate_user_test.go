@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -103,7 +104,7 @@ func TestActivateUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo, eventBus, testUser)
 
-			handler := NewActivateUserHandler(userRepo, eventBus, logger)
+			handler := NewActivateUserHandler(userRepo, authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -7,35 +7,48 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type AssignRoleToUserCommand struct {
-	UserID uuid.UUID
-	RoleID uuid.UUID
+	UserID  uuid.UUID
+	RoleID  uuid.UUID
+	ActorID *uuid.UUID
 }
 
 type AssignRoleToUserHandler struct {
-	userRepository user.Repository
-	roleRepository role.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	organizationRepository organization.Repository
+	delegationPolicy       *authz.DelegationPolicy
+	sodChecker             *sod.Checker
+	eventBus               shared.EventBus
+	logger                 logger.Logger
 }
 
 func NewAssignRoleToUserHandler(
 	userRepository user.Repository,
 	roleRepository role.Repository,
+	organizationRepository organization.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *AssignRoleToUserHandler {
 	return &AssignRoleToUserHandler{
-		userRepository: userRepository,
-		roleRepository: roleRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		delegationPolicy:       delegationPolicy,
+		sodChecker:             sodChecker,
+		eventBus:               eventBus,
+		logger:                 logger,
 	}
 }
 
@@ -45,15 +58,37 @@ func (handler *AssignRoleToUserHandler) Handle(context context.Context, command
 		return nil, err
 	}
 
-	_, err = handler.roleRepository.FindByID(context, command.RoleID)
+	assignedRole, err := handler.roleRepository.FindByID(context, command.RoleID)
 	if err != nil {
 		return nil, err
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanGrantTo(existingUser); err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(assignedRole); err != nil {
+		return nil, err
+	}
+
+	if err := ensureRoleWithinMembership(context, handler.organizationRepository, existingUser.ID(), assignedRole); err != nil {
+		return nil, err
+	}
+
 	if err := existingUser.AssignRole(command.RoleID); err != nil {
 		return nil, err
 	}
 
+	if err := handler.sodChecker.CheckUser(context, existingUser, command.RoleID); err != nil {
+		return nil, err
+	}
+
 	if err := handler.userRepository.Update(context, existingUser); err != nil {
 		return nil, fmt.Errorf("update user: %w", err)
 	}
@@ -75,3 +110,19 @@ func (handler *AssignRoleToUserHandler) Handle(context context.Context, command
 
 	return userdto.UserFromDomain(existingUser), nil
 }
+
+func ensureRoleWithinMembership(context context.Context, organizationRepository organization.Repository, userID uuid.UUID, scopedRole *role.Role) error {
+	if scopedRole.IsGlobal() {
+		return nil
+	}
+
+	isMember, err := organizationRepository.IsMember(context, *scopedRole.OrganizationID(), userID)
+	if err != nil {
+		return fmt.Errorf("check organization membership: %w", err)
+	}
+	if !isMember {
+		return organization.ErrRoleOutsideOrganization
+	}
+
+	return nil
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -110,7 +113,7 @@ func TestAssignRoleToUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo, roleRepo, eventBus)
 
-			handler := NewAssignRoleToUserHandler(userRepo, roleRepo, eventBus, logger)
+			handler := NewAssignRoleToUserHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -130,3 +133,205 @@ func TestAssignRoleToUserHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignRoleToUserHandler_OrganizationScopedRole(t *testing.T) {
+	ctx := context.Background()
+	organizationID := uuid.New()
+
+	scopedRole, _ := role.NewRole(role.NewRoleParams{
+		Name:           "tenant_editor",
+		DisplayName:    "Tenant Editor",
+		OrganizationID: &organizationID,
+	})
+
+	t.Run("assigns scoped role to organization member", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		organizationRepo := testutil.NewMockOrganizationRepository()
+
+		testUser := testutil.CreateActiveUser()
+		userRepo.AddUser(testUser)
+		roleRepo.AddRole(scopedRole)
+		organizationRepo.AddMembership(organizationID, testUser.ID())
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, organizationRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		result, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: testUser.ID(), RoleID: scopedRole.ID()})
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, testUser.HasRole(scopedRole.ID()))
+	})
+
+	t.Run("rejects scoped role for non member", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		organizationRepo := testutil.NewMockOrganizationRepository()
+
+		testUser := testutil.CreateActiveUser()
+		userRepo.AddUser(testUser)
+		roleRepo.AddRole(scopedRole)
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, organizationRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		result, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: testUser.ID(), RoleID: scopedRole.ID()})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, organization.ErrRoleOutsideOrganization)
+		assert.False(t, testUser.HasRole(scopedRole.ID()))
+	})
+}
+
+func TestAssignRoleToUserHandler_SeparationOfDuties(t *testing.T) {
+	ctx := context.Background()
+
+	requesterRole, _ := role.NewRole(role.NewRoleParams{Name: "payment_requester", DisplayName: "Payment Requester"})
+	approverRole, _ := role.NewRole(role.NewRoleParams{Name: "payment_approver", DisplayName: "Payment Approver"})
+
+	t.Run("rejects mutually exclusive roles", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		sodRuleRepo := testutil.NewMockSoDRuleRepository()
+
+		roleRepo.AddRole(requesterRole)
+		roleRepo.AddRole(approverRole)
+		rule, _ := sod.NewRule(sod.NewRuleParams{
+			Name:    "payments",
+			Type:    sod.RuleTypeMutualExclusion,
+			RoleIDs: []uuid.UUID{requesterRole.ID(), approverRole.ID()},
+		})
+		sodRuleRepo.AddRule(rule)
+
+		testUser := testutil.CreateActiveUser()
+		_ = testUser.AssignRole(requesterRole.ID())
+		userRepo.AddUser(testUser)
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(sodRuleRepo, userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		result, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: testUser.ID(), RoleID: approverRole.ID()})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, sod.ErrViolation)
+	})
+
+	t.Run("rejects assignment beyond role cardinality", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		sodRuleRepo := testutil.NewMockSoDRuleRepository()
+
+		roleRepo.AddRole(approverRole)
+		rule, _ := sod.NewRule(sod.NewRuleParams{
+			Name:       "single approver",
+			Type:       sod.RuleTypeMaxCardinality,
+			RoleIDs:    []uuid.UUID{approverRole.ID()},
+			MaxHolders: 1,
+		})
+		sodRuleRepo.AddRule(rule)
+
+		existingHolder := testutil.CreateActiveUser()
+		_ = existingHolder.AssignRole(approverRole.ID())
+		userRepo.AddUser(existingHolder)
+		testUser := testutil.CreateActiveUser()
+		userRepo.AddUser(testUser)
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(sodRuleRepo, userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: testUser.ID(), RoleID: approverRole.ID()})
+
+		assert.ErrorIs(t, err, sod.ErrViolation)
+	})
+
+	t.Run("ignores a violation that predates the change", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		sodRuleRepo := testutil.NewMockSoDRuleRepository()
+
+		viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+		roleRepo.AddRole(requesterRole)
+		roleRepo.AddRole(approverRole)
+		roleRepo.AddRole(viewerRole)
+
+		testUser := testutil.CreateActiveUser()
+		_ = testUser.AssignRole(requesterRole.ID())
+		_ = testUser.AssignRole(approverRole.ID())
+		userRepo.AddUser(testUser)
+
+		rule, _ := sod.NewRule(sod.NewRuleParams{
+			Name:    "payments",
+			Type:    sod.RuleTypeMutualExclusion,
+			RoleIDs: []uuid.UUID{requesterRole.ID(), approverRole.ID()},
+		})
+		sodRuleRepo.AddRule(rule)
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(sodRuleRepo, userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: testUser.ID(), RoleID: viewerRole.ID()})
+
+		require.NoError(t, err)
+		assert.True(t, testUser.HasRole(viewerRole.ID()))
+	})
+}
+
+func TestAssignRoleToUserHandler_DelegatedAdministration(t *testing.T) {
+	ctx := context.Background()
+
+	superAdminRole, _ := role.NewRole(role.NewRoleParams{Name: "super_admin", DisplayName: "Super Admin", Priority: 100})
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	managerRole, _ := role.NewRole(role.NewRoleParams{Name: "manager", DisplayName: "Manager", Priority: 50})
+
+	setup := func() (*testutil.MockUserRepository, *AssignRoleToUserHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		roleRepo.AddRole(superAdminRole)
+		roleRepo.AddRole(adminRole)
+		roleRepo.AddRole(managerRole)
+
+		actor := testutil.CreateActiveUser()
+		_ = actor.AssignRole(adminRole.ID())
+		userRepo.AddUser(actor)
+
+		handler := NewAssignRoleToUserHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		return userRepo, handler, actor
+	}
+
+	t.Run("assigns role below the actor's priority", func(t *testing.T) {
+		userRepo, handler, actor := setup()
+		target := testutil.CreateActiveUser()
+		userRepo.AddUser(target)
+		actorID := actor.ID()
+
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: target.ID(), RoleID: managerRole.ID(), ActorID: &actorID})
+		require.NoError(t, err)
+		assert.True(t, target.HasRole(managerRole.ID()))
+	})
+
+	t.Run("rejects role at or above the actor's priority", func(t *testing.T) {
+		userRepo, handler, actor := setup()
+		target := testutil.CreateActiveUser()
+		userRepo.AddUser(target)
+		actorID := actor.ID()
+
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: target.ID(), RoleID: adminRole.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, authz.ErrRoleOutranksActor)
+
+		_, err = handler.Handle(ctx, AssignRoleToUserCommand{UserID: target.ID(), RoleID: superAdminRole.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, authz.ErrRoleOutranksActor)
+		assert.False(t, target.HasRole(superAdminRole.ID()))
+	})
+
+	t.Run("rejects changes to users who outrank the actor", func(t *testing.T) {
+		userRepo, handler, actor := setup()
+		target := testutil.CreateActiveUser()
+		_ = target.AssignRole(superAdminRole.ID())
+		userRepo.AddUser(target)
+		actorID := actor.ID()
+
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: target.ID(), RoleID: managerRole.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, authz.ErrUserOutranksActor)
+	})
+
+	t.Run("rejects self assignment", func(t *testing.T) {
+		_, handler, actor := setup()
+		actorID := actor.ID()
+
+		_, err := handler.Handle(ctx, AssignRoleToUserCommand{UserID: actor.ID(), RoleID: managerRole.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, authz.ErrSelfEscalation)
+	})
+}
@@ -7,31 +7,36 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type BanUserCommand struct {
-	UserID uuid.UUID
-	Reason string
+	UserID  uuid.UUID
+	Reason  string
+	ActorID *uuid.UUID
 }
 
 type BanUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewBanUserHandler(
 	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *BanUserHandler {
 	return &BanUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
@@ -41,6 +46,14 @@ func (handler *BanUserHandler) Handle(context context.Context, command BanUserCo
 		return nil, fmt.Errorf("find user: %w", err)
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
 	if err := existingUser.Ban(command.Reason); err != nil {
 		return nil, fmt.Errorf("ban user: %w", err)
 	}
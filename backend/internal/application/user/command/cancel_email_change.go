@@ -0,0 +1,65 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CancelEmailChangeCommand struct {
+	Token string
+}
+
+type CancelEmailChangeHandler struct {
+	emailChangeRepository user.EmailChangeRepository
+	eventBus              shared.EventBus
+	logger                logger.Logger
+}
+
+func NewCancelEmailChangeHandler(
+	emailChangeRepository user.EmailChangeRepository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CancelEmailChangeHandler {
+	return &CancelEmailChangeHandler{
+		emailChangeRepository: emailChangeRepository,
+		eventBus:              eventBus,
+		logger:                logger,
+	}
+}
+
+// Handle discards the pending change named by the cancel token mailed to the
+// current address. Expired changes can still be cancelled.
+func (handler *CancelEmailChangeHandler) Handle(ctx context.Context, command CancelEmailChangeCommand) error {
+	if command.Token == "" {
+		return user.ErrInvalidEmailChangeToken
+	}
+
+	change, err := handler.emailChangeRepository.FindByCancelTokenHash(ctx, hashEmailChangeToken(command.Token))
+	if err != nil {
+		return err
+	}
+
+	if err := handler.emailChangeRepository.DeleteByUserID(ctx, change.UserID()); err != nil {
+		return fmt.Errorf("delete email change: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := user.NewEmailChangeCancelledEvent(change.UserID(), change.NewEmail().String())
+		if err := handler.eventBus.Publish(ctx, event); err != nil {
+			handler.logger.Error("failed to publish email change cancelled event",
+				logger.String("user_id", change.UserID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("email change cancelled",
+		logger.String("user_id", change.UserID().String()),
+	)
+
+	return nil
+}
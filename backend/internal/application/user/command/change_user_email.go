@@ -0,0 +1,153 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	emailChangedSubject = "Your email address was changed"
+	emailChangedBody    = "Hello {name},\n\nAn administrator changed the email address of your account to {new_email}. Sign in with the new address from now on.\n\nIf you did not expect this, contact your administrator.\n"
+)
+
+type ChangeUserEmailCommand struct {
+	UserID   uuid.UUID
+	NewEmail string
+	ActorID  *uuid.UUID
+}
+
+type ChangeUserEmailHandler struct {
+	userRepository         user.Repository
+	emailChangeRepository  user.EmailChangeRepository
+	preferencesRepository  user.PreferencesRepository
+	refreshTokenRepository auth.RefreshTokenRepository
+	mailer                 shared.Mailer
+	delegationPolicy       *authz.DelegationPolicy
+	transactionManager     shared.TransactionManager
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewChangeUserEmailHandler(
+	userRepository user.Repository,
+	emailChangeRepository user.EmailChangeRepository,
+	preferencesRepository user.PreferencesRepository,
+	refreshTokenRepository auth.RefreshTokenRepository,
+	mailer shared.Mailer,
+	delegationPolicy *authz.DelegationPolicy,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *ChangeUserEmailHandler {
+	return &ChangeUserEmailHandler{
+		userRepository:         userRepository,
+		emailChangeRepository:  emailChangeRepository,
+		preferencesRepository:  preferencesRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		mailer:                 mailer,
+		delegationPolicy:       delegationPolicy,
+		transactionManager:     transactionManager,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+// Handle lets an administrator change a user's email without confirmation
+// from the new address. The change is recorded as an override, any pending
+// self-service change is discarded, the user's sessions are revoked and the
+// old address is told about the change, in the user's saved locale, unless
+// they turned off account update notices.
+func (handler *ChangeUserEmailHandler) Handle(ctx context.Context, command ChangeUserEmailCommand) (*userdto.UserDTO, error) {
+	existingUser, err := handler.userRepository.FindByID(ctx, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(ctx, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(ctx, existingUser); err != nil {
+		return nil, err
+	}
+
+	oldEmail := existingUser.Email().String()
+	if err := existingUser.ChangeEmail(command.NewEmail, command.ActorID, true); err != nil {
+		return nil, err
+	}
+
+	newEmail := existingUser.Email().String()
+	exists, err := handler.userRepository.ExistsByEmail(ctx, newEmail)
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(newEmail)
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if err := handler.userRepository.Update(txContext, existingUser); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+		if err := handler.emailChangeRepository.DeleteByUserID(txContext, existingUser.ID()); err != nil {
+			return fmt.Errorf("delete email change: %w", err)
+		}
+		if err := handler.refreshTokenRepository.RevokeAllByUserID(txContext, existingUser.ID()); err != nil {
+			return fmt.Errorf("revoke sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	handler.notifyPreviousEmail(ctx, existingUser, oldEmail)
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(ctx, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user email changed by administrator",
+		logger.String("user_id", existingUser.ID().String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
+
+func (handler *ChangeUserEmailHandler) notifyPreviousEmail(ctx context.Context, changedUser *user.User, oldEmail string) {
+	preferences := recipientPreferences(ctx, handler.preferencesRepository, handler.logger, changedUser.ID())
+	if !preferences.Notifications().AccountUpdates {
+		return
+	}
+
+	locale := recipientLocale(preferences, i18n.Default)
+	if err := handler.mailer.Send(ctx, shared.MailMessage{
+		To:      oldEmail,
+		Subject: i18n.Translate(locale, emailChangedSubject, nil),
+		Body: i18n.Translate(locale, emailChangedBody, i18n.Params{
+			"name":      changedUser.FullName().String(),
+			"new_email": changedUser.Email().String(),
+		}),
+	}); err != nil {
+		handler.logger.Error("failed to notify previous email address",
+			logger.String("user_id", changedUser.ID().String()),
+			logger.Err(err),
+		)
+	}
+}
@@ -0,0 +1,194 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestChangeUserEmailHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	type mocks struct {
+		userRepo      *testutil.MockUserRepository
+		emailChanges  *testutil.MockEmailChangeRepository
+		preferences   *testutil.MockPreferencesRepository
+		refreshTokens *testutil.MockRefreshTokenRepository
+		mailer        *testutil.MockMailer
+		eventBus      *testutil.MockEventBus
+	}
+
+	tests := []struct {
+		name        string
+		newEmail    string
+		setup       func(*testing.T, mocks, *user.User)
+		wantErr     error
+		errContains string
+		checkResult func(*testing.T, mocks, *user.User)
+	}{
+		{
+			name:     "changes the email as an override",
+			newEmail: "admin-set@example.com",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				change, err := user.NewEmailChange(user.NewEmailChangeParams{
+					UserID:           u.ID(),
+					NewEmail:         "pending@example.com",
+					ConfirmTokenHash: "confirm-hash",
+					CancelTokenHash:  "cancel-hash",
+					ExpiresAt:        time.Now().Add(time.Hour),
+				})
+				require.NoError(t, err)
+				require.NoError(t, m.emailChanges.Save(ctx, change))
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Equal(t, "admin-set@example.com", u.Email().String())
+				assert.Empty(t, m.emailChanges.Changes, "pending self-service change is discarded")
+
+				sessions, err := m.refreshTokens.FindActiveByUserID(ctx, u.ID())
+				require.NoError(t, err)
+				assert.Empty(t, sessions)
+
+				require.Len(t, m.mailer.Messages, 1)
+				assert.Equal(t, "test@example.com", m.mailer.Messages[0].To)
+
+				require.Len(t, m.eventBus.PublishedEvents, 1)
+				event, ok := m.eventBus.PublishedEvents[0].(user.EmailChangedEvent)
+				require.True(t, ok)
+				assert.True(t, event.Override)
+				assert.Equal(t, "test@example.com", event.OldEmail)
+			},
+		},
+		{
+			name:     "writes the notice in the user's saved locale",
+			newEmail: "admin-set@example.com",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				locale := i18n.Vietnamese
+				preferences := user.DefaultPreferences(u.ID())
+				require.NoError(t, preferences.Update(user.UpdatePreferencesParams{Locale: &locale}))
+				require.NoError(t, m.preferences.Save(ctx, preferences))
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				require.Len(t, m.mailer.Messages, 1)
+				assert.Equal(t, "Địa chỉ email của bạn đã được thay đổi", m.mailer.Messages[0].Subject)
+				assert.Contains(t, m.mailer.Messages[0].Body, "admin-set@example.com")
+			},
+		},
+		{
+			name:     "skips the notice when the user turned off account updates",
+			newEmail: "admin-set@example.com",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				accountUpdates := false
+				preferences := user.DefaultPreferences(u.ID())
+				require.NoError(t, preferences.Update(user.UpdatePreferencesParams{AccountUpdates: &accountUpdates}))
+				require.NoError(t, m.preferences.Save(ctx, preferences))
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Equal(t, "admin-set@example.com", u.Email().String())
+				assert.Empty(t, m.mailer.Messages)
+			},
+		},
+		{
+			name:     "still succeeds when the notice cannot be mailed",
+			newEmail: "admin-set@example.com",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				m.mailer.SendError = errors.New("smtp unavailable")
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Equal(t, "admin-set@example.com", u.Email().String())
+			},
+		},
+		{
+			name:     "rejects the current address",
+			newEmail: "test@example.com",
+			wantErr:  user.ErrEmailUnchanged,
+		},
+		{
+			name:     "rejects an address used by another user",
+			newEmail: "taken@example.com",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				other, err := user.NewUser(user.NewUserParams{
+					Email:        "taken@example.com",
+					PasswordHash: "$2a$10$hashedpassword",
+					FullName:     "Other User",
+				})
+				require.NoError(t, err)
+				m.userRepo.AddUser(other)
+			},
+			errContains: "already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				userRepo:      testutil.NewMockUserRepository(),
+				emailChanges:  testutil.NewMockEmailChangeRepository(),
+				preferences:   testutil.NewMockPreferencesRepository(),
+				refreshTokens: testutil.NewMockRefreshTokenRepository(),
+				mailer:        testutil.NewMockMailer(),
+				eventBus:      testutil.NewMockEventBus(),
+			}
+			testUser, err := user.NewUser(user.NewUserParams{
+				Email:        "test@example.com",
+				PasswordHash: "$2a$10$hashedpassword",
+				FullName:     "Test User",
+			})
+			require.NoError(t, err)
+			testUser.ClearDomainEvents()
+			m.userRepo.AddUser(testUser)
+
+			token, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+				UserID:    testUser.ID(),
+				TokenHash: "session-hash",
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			require.NoError(t, m.refreshTokens.Create(ctx, token))
+
+			if tt.setup != nil {
+				tt.setup(t, m, testUser)
+			}
+
+			handler := NewChangeUserEmailHandler(
+				m.userRepo,
+				m.emailChanges,
+				m.preferences,
+				m.refreshTokens,
+				m.mailer,
+				authz.NewDelegationPolicy(m.userRepo, testutil.NewMockRoleRepository(), nil),
+				testutil.NewMockTransactionManager(),
+				m.eventBus,
+				testutil.NewNoopLogger(),
+			)
+
+			result, err := handler.Handle(ctx, ChangeUserEmailCommand{UserID: testUser.ID(), NewEmail: tt.newEmail})
+
+			if tt.wantErr != nil || tt.errContains != "" {
+				require.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				assert.Empty(t, m.eventBus.PublishedEvents)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			if tt.checkResult != nil {
+				tt.checkResult(t, m, testUser)
+			}
+		})
+	}
+}
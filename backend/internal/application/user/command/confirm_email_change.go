@@ -0,0 +1,119 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ConfirmEmailChangeCommand struct {
+	Token string
+}
+
+type ConfirmEmailChangeHandler struct {
+	userRepository         user.Repository
+	emailChangeRepository  user.EmailChangeRepository
+	refreshTokenRepository auth.RefreshTokenRepository
+	transactionManager     shared.TransactionManager
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewConfirmEmailChangeHandler(
+	userRepository user.Repository,
+	emailChangeRepository user.EmailChangeRepository,
+	refreshTokenRepository auth.RefreshTokenRepository,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *ConfirmEmailChangeHandler {
+	return &ConfirmEmailChangeHandler{
+		userRepository:         userRepository,
+		emailChangeRepository:  emailChangeRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		transactionManager:     transactionManager,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+// Handle swaps in the pending address and revokes every refresh session of
+// the user, so that sessions opened under the old address must sign in
+// again. The confirmation link is opened without a session, so none is
+// spared.
+func (handler *ConfirmEmailChangeHandler) Handle(ctx context.Context, command ConfirmEmailChangeCommand) (*userdto.UserDTO, error) {
+	if command.Token == "" {
+		return nil, user.ErrInvalidEmailChangeToken
+	}
+
+	change, err := handler.emailChangeRepository.FindByConfirmTokenHash(ctx, hashEmailChangeToken(command.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	if change.IsExpired(time.Now().UTC()) {
+		if err := handler.emailChangeRepository.DeleteByUserID(ctx, change.UserID()); err != nil {
+			handler.logger.Error("failed to delete expired email change",
+				logger.String("user_id", change.UserID().String()),
+				logger.Err(err),
+			)
+		}
+		return nil, user.ErrInvalidEmailChangeToken
+	}
+
+	existingUser, err := handler.userRepository.FindByID(ctx, change.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	exists, err := handler.userRepository.ExistsByEmail(ctx, change.NewEmail().String())
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(change.NewEmail().String())
+	}
+
+	userID := existingUser.ID()
+	if err := existingUser.ChangeEmail(change.NewEmail().String(), &userID, false); err != nil {
+		return nil, err
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if err := handler.userRepository.Update(txContext, existingUser); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+		if err := handler.emailChangeRepository.DeleteByUserID(txContext, userID); err != nil {
+			return fmt.Errorf("delete email change: %w", err)
+		}
+		if err := handler.refreshTokenRepository.RevokeAllByUserID(txContext, userID); err != nil {
+			return fmt.Errorf("revoke sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(ctx, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", userID.String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("email change confirmed",
+		logger.String("user_id", userID.String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
@@ -0,0 +1,196 @@
+package usercommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestConfirmEmailChangeHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	type mocks struct {
+		userRepo      *testutil.MockUserRepository
+		emailChanges  *testutil.MockEmailChangeRepository
+		refreshTokens *testutil.MockRefreshTokenRepository
+		eventBus      *testutil.MockEventBus
+	}
+
+	createPendingChange := func(t *testing.T, m mocks, userID uuid.UUID, expiresAt time.Time) {
+		change, err := user.ReconstructEmailChange(user.ReconstructEmailChangeParams{
+			UserID:           userID,
+			NewEmail:         "new@example.com",
+			ConfirmTokenHash: hashEmailChangeToken("confirm-token"),
+			CancelTokenHash:  hashEmailChangeToken("cancel-token"),
+			RequestedAt:      time.Now().Add(-time.Hour),
+			ExpiresAt:        expiresAt,
+		})
+		require.NoError(t, err)
+		require.NoError(t, m.emailChanges.Save(ctx, change))
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		setup       func(*testing.T, mocks, *user.User)
+		wantErr     error
+		errContains string
+		checkResult func(*testing.T, mocks, *user.User)
+	}{
+		{
+			name:  "swaps the email and revokes every session",
+			token: "confirm-token",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				createPendingChange(t, m, u.ID(), time.Now().Add(time.Hour))
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Equal(t, "new@example.com", u.Email().String())
+				assert.Empty(t, m.emailChanges.Changes)
+
+				sessions, err := m.refreshTokens.FindActiveByUserID(ctx, u.ID())
+				require.NoError(t, err)
+				assert.Empty(t, sessions)
+
+				require.Len(t, m.eventBus.PublishedEvents, 1)
+				event, ok := m.eventBus.PublishedEvents[0].(user.EmailChangedEvent)
+				require.True(t, ok)
+				assert.Equal(t, "test@example.com", event.OldEmail)
+				assert.Equal(t, "new@example.com", event.NewEmail)
+				assert.False(t, event.Override)
+			},
+		},
+		{
+			name:  "rejects an unknown token",
+			token: "cancel-token",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				createPendingChange(t, m, u.ID(), time.Now().Add(time.Hour))
+			},
+			wantErr: user.ErrInvalidEmailChangeToken,
+		},
+		{
+			name:  "rejects and discards an expired change",
+			token: "confirm-token",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				createPendingChange(t, m, u.ID(), time.Now().Add(-time.Minute))
+			},
+			wantErr: user.ErrInvalidEmailChangeToken,
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Empty(t, m.emailChanges.Changes)
+			},
+		},
+		{
+			name:  "rejects an address taken since the request",
+			token: "confirm-token",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				createPendingChange(t, m, u.ID(), time.Now().Add(time.Hour))
+				other, err := user.NewUser(user.NewUserParams{
+					Email:        "new@example.com",
+					PasswordHash: "$2a$10$hashedpassword",
+					FullName:     "Other User",
+				})
+				require.NoError(t, err)
+				m.userRepo.AddUser(other)
+			},
+			errContains: "already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				userRepo:      testutil.NewMockUserRepository(),
+				emailChanges:  testutil.NewMockEmailChangeRepository(),
+				refreshTokens: testutil.NewMockRefreshTokenRepository(),
+				eventBus:      testutil.NewMockEventBus(),
+			}
+			testUser, err := user.NewUser(user.NewUserParams{
+				Email:        "test@example.com",
+				PasswordHash: "$2a$10$hashedpassword",
+				FullName:     "Test User",
+			})
+			require.NoError(t, err)
+			testUser.ClearDomainEvents()
+			m.userRepo.AddUser(testUser)
+
+			token, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+				UserID:    testUser.ID(),
+				TokenHash: "session-hash",
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			require.NoError(t, m.refreshTokens.Create(ctx, token))
+
+			if tt.setup != nil {
+				tt.setup(t, m, testUser)
+			}
+
+			handler := NewConfirmEmailChangeHandler(
+				m.userRepo,
+				m.emailChanges,
+				m.refreshTokens,
+				testutil.NewMockTransactionManager(),
+				m.eventBus,
+				testutil.NewNoopLogger(),
+			)
+
+			result, err := handler.Handle(ctx, ConfirmEmailChangeCommand{Token: tt.token})
+
+			if tt.wantErr != nil || tt.errContains != "" {
+				require.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				assert.Equal(t, "test@example.com", testUser.Email().String())
+				assert.Empty(t, m.eventBus.PublishedEvents)
+				if tt.checkResult != nil {
+					tt.checkResult(t, m, testUser)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, "new@example.com", result.Email)
+			if tt.checkResult != nil {
+				tt.checkResult(t, m, testUser)
+			}
+		})
+	}
+}
+
+func TestCancelEmailChangeHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	emailChanges := testutil.NewMockEmailChangeRepository()
+	eventBus := testutil.NewMockEventBus()
+	handler := NewCancelEmailChangeHandler(emailChanges, eventBus, testutil.NewNoopLogger())
+
+	change, err := user.NewEmailChange(user.NewEmailChangeParams{
+		UserID:           uuid.New(),
+		NewEmail:         "new@example.com",
+		ConfirmTokenHash: hashEmailChangeToken("confirm-token"),
+		CancelTokenHash:  hashEmailChangeToken("cancel-token"),
+		ExpiresAt:        time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NoError(t, emailChanges.Save(ctx, change))
+
+	assert.ErrorIs(t, handler.Handle(ctx, CancelEmailChangeCommand{Token: "confirm-token"}), user.ErrInvalidEmailChangeToken)
+	assert.NotEmpty(t, emailChanges.Changes, "the confirm token cannot cancel")
+
+	require.NoError(t, handler.Handle(ctx, CancelEmailChangeCommand{Token: "cancel-token"}))
+	assert.Empty(t, emailChanges.Changes)
+	require.Len(t, eventBus.PublishedEvents, 1)
+	assert.Equal(t, user.EventTypeEmailChangeCancelled, eventBus.PublishedEvents[0].EventType())
+
+	assert.ErrorIs(t, handler.Handle(ctx, CancelEmailChangeCommand{Token: "cancel-token"}), user.ErrInvalidEmailChangeToken)
+}
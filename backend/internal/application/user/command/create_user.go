@@ -9,34 +9,39 @@ import (
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 	"github.com/tranvuongduy2003/go-copilot/pkg/security"
 )
 
 type CreateUserCommand struct {
-	Email    string
-	Password string
-	FullName string
+	Email      string
+	Password   string
+	FullName   string
+	Attributes map[string]any
 }
 
 type CreateUserHandler struct {
-	userRepository user.Repository
-	passwordHasher security.PasswordHasher
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository      user.Repository
+	attributeRepository userattribute.Repository
+	passwordHasher      security.PasswordHasher
+	eventBus            shared.EventBus
+	logger              logger.Logger
 }
 
 func NewCreateUserHandler(
 	userRepository user.Repository,
+	attributeRepository userattribute.Repository,
 	passwordHasher security.PasswordHasher,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *CreateUserHandler {
 	return &CreateUserHandler{
-		userRepository: userRepository,
-		passwordHasher: passwordHasher,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:      userRepository,
+		attributeRepository: attributeRepository,
+		passwordHasher:      passwordHasher,
+		eventBus:            eventBus,
+		logger:              logger,
 	}
 }
 
@@ -45,6 +50,15 @@ func (handler *CreateUserHandler) Handle(context context.Context, command Create
 		return nil, err
 	}
 
+	schema, err := userattribute.LoadSchema(context, handler.attributeRepository)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := schema.Apply(nil, command.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	exists, err := handler.userRepository.ExistsByEmail(context, command.Email)
 	if err != nil {
 		return nil, fmt.Errorf("check email exists: %w", err)
@@ -62,6 +76,7 @@ func (handler *CreateUserHandler) Handle(context context.Context, command Create
 		Email:        command.Email,
 		PasswordHash: hashedPassword,
 		FullName:     command.FullName,
+		Attributes:   attributes,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create user: %w", err)
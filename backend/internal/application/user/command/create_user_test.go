@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
 
@@ -106,7 +107,7 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo, hasher, eventBus)
 
-			handler := NewCreateUserHandler(userRepo, hasher, eventBus, logger)
+			handler := NewCreateUserHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), hasher, eventBus, logger)
 			result, err := handler.Handle(ctx, tt.command)
 
 			if tt.wantErr {
@@ -125,3 +126,55 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func newDepartmentAttributeRepository(t *testing.T, required bool) *testutil.MockAttributeDefinitionRepository {
+	t.Helper()
+	definition, err := userattribute.NewDefinition(userattribute.NewDefinitionParams{
+		Key:        "department",
+		Type:       userattribute.TypeEnum,
+		Required:   required,
+		Visibility: userattribute.VisibilityPublic,
+		Rules:      userattribute.Rules{Options: []string{"engineering", "sales"}},
+	})
+	require.NoError(t, err)
+
+	attributeRepo := testutil.NewMockAttributeDefinitionRepository()
+	attributeRepo.AddDefinition(definition)
+	return attributeRepo
+}
+
+func TestCreateUserHandler_ValidatesAttributes(t *testing.T) {
+	tests := []struct {
+		name        string
+		attributes  map[string]any
+		errContains string
+	}{
+		{name: "valid attributes", attributes: map[string]any{"department": "sales"}},
+		{name: "missing required attribute", attributes: nil, errContains: "attributes.department"},
+		{name: "value outside the options", attributes: map[string]any{"department": "legal"}, errContains: "attributes.department"},
+		{name: "undefined attribute", attributes: map[string]any{"department": "sales", "shoe_size": 42.0}, errContains: "attributes.shoe_size"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := testutil.NewMockUserRepository()
+			handler := NewCreateUserHandler(userRepo, newDepartmentAttributeRepository(t, true), testutil.NewMockPasswordHasher(), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+			result, err := handler.Handle(context.Background(), CreateUserCommand{
+				Email:      "newuser@example.com",
+				Password:   "SecurePass123!",
+				FullName:   "New User",
+				Attributes: tt.attributes,
+			})
+
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Empty(t, userRepo.Users)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.attributes, result.Attributes)
+		})
+	}
+}
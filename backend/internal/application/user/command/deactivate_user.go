@@ -7,30 +7,35 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type DeactivateUserCommand struct {
-	UserID uuid.UUID
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
 }
 
 type DeactivateUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewDeactivateUserHandler(
 	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *DeactivateUserHandler {
 	return &DeactivateUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
@@ -40,6 +45,14 @@ func (handler *DeactivateUserHandler) Handle(context context.Context, command De
 		return nil, fmt.Errorf("find user: %w", err)
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
 	if err := existingUser.Deactivate(); err != nil {
 		return nil, fmt.Errorf("deactivate user: %w", err)
 	}
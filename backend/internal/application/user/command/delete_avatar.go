@@ -0,0 +1,85 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DeleteAvatarCommand struct {
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type DeleteAvatarHandler struct {
+	userRepository   user.Repository
+	blobStore        shared.BlobStore
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewDeleteAvatarHandler(
+	userRepository user.Repository,
+	blobStore shared.BlobStore,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DeleteAvatarHandler {
+	return &DeleteAvatarHandler{
+		userRepository:   userRepository,
+		blobStore:        blobStore,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+func (handler *DeleteAvatarHandler) Handle(context context.Context, command DeleteAvatarCommand) (*userdto.UserDTO, error) {
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	previous := existingUser.RemoveAvatar()
+	if previous == nil {
+		return userdto.UserFromDomain(existingUser), nil
+	}
+
+	if err := handler.userRepository.Update(context, existingUser); err != nil {
+		return nil, fmt.Errorf("save user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	removeAvatarBlobs(context, handler.blobStore, handler.logger, *previous)
+
+	handler.logger.Info("user avatar removed",
+		logger.String("user_id", existingUser.ID().String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
@@ -6,30 +6,36 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type DeleteUserCommand struct {
-	UserID uuid.UUID
+	UserID          uuid.UUID
+	ActorID         *uuid.UUID
+	ExpectedVersion *int64
 }
 
 type DeleteUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewDeleteUserHandler(
 	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *DeleteUserHandler {
 	return &DeleteUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
@@ -39,6 +45,18 @@ func (handler *DeleteUserHandler) Handle(context context.Context, command Delete
 		return fmt.Errorf("find user: %w", err)
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return err
+	}
+
+	if err := existingUser.CheckVersion(command.ExpectedVersion); err != nil {
+		return err
+	}
+
 	if err := existingUser.Delete(); err != nil {
 		return fmt.Errorf("delete user: %w", err)
 	}
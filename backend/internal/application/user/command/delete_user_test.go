@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -89,7 +90,7 @@ func TestDeleteUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo, eventBus)
 
-			handler := NewDeleteUserHandler(userRepo, eventBus, logger)
+			handler := NewDeleteUserHandler(userRepo, authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			err := handler.Handle(ctx, cmd)
@@ -0,0 +1,90 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DenyUserPermissionCommand struct {
+	UserID       uuid.UUID
+	PermissionID uuid.UUID
+	ActorID      *uuid.UUID
+}
+
+type DenyUserPermissionHandler struct {
+	userRepository       user.Repository
+	permissionRepository permission.Repository
+	delegationPolicy     *authz.DelegationPolicy
+	eventBus             shared.EventBus
+	logger               logger.Logger
+}
+
+func NewDenyUserPermissionHandler(
+	userRepository user.Repository,
+	permissionRepository permission.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DenyUserPermissionHandler {
+	return &DenyUserPermissionHandler{
+		userRepository:       userRepository,
+		permissionRepository: permissionRepository,
+		delegationPolicy:     delegationPolicy,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+func (handler *DenyUserPermissionHandler) Handle(context context.Context, command DenyUserPermissionCommand) (*userdto.UserDTO, error) {
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = handler.permissionRepository.FindByID(context, command.PermissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	if err := existingUser.DenyPermission(command.PermissionID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.userRepository.Update(context, existingUser); err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("permission denied for user",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.String("permission_id", command.PermissionID.String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
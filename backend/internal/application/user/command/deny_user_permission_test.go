@@ -0,0 +1,86 @@
+package usercommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestDenyUserPermissionHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+
+	setup := func() (*testutil.MockUserRepository, *DenyUserPermissionHandler, *RemoveUserDenyHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		roleRepo.AddRole(adminRole)
+		permRepo := testutil.NewMockPermissionRepository()
+		permRepo.AddPermission(deletePerm)
+
+		actor := testutil.CreateActiveUser()
+		_ = actor.AssignRole(adminRole.ID())
+		userRepo.AddUser(actor)
+
+		delegationPolicy := authz.NewDelegationPolicy(userRepo, roleRepo, nil)
+		denyHandler := NewDenyUserPermissionHandler(userRepo, permRepo, delegationPolicy, testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		removeHandler := NewRemoveUserDenyHandler(userRepo, permRepo, delegationPolicy, testutil.NewMockEventBus(), testutil.NewNoopLogger())
+		return userRepo, denyHandler, removeHandler, actor
+	}
+
+	t.Run("denies and restores a permission", func(t *testing.T) {
+		userRepo, denyHandler, removeHandler, actor := setup()
+		target := testutil.CreateActiveUser()
+		userRepo.AddUser(target)
+		actorID := actor.ID()
+
+		_, err := denyHandler.Handle(ctx, DenyUserPermissionCommand{UserID: target.ID(), PermissionID: deletePerm.ID(), ActorID: &actorID})
+		require.NoError(t, err)
+		assert.True(t, target.DeniesPermission(deletePerm.ID()))
+
+		_, err = denyHandler.Handle(ctx, DenyUserPermissionCommand{UserID: target.ID(), PermissionID: deletePerm.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, user.ErrPermissionAlreadyDenied)
+
+		_, err = removeHandler.Handle(ctx, RemoveUserDenyCommand{UserID: target.ID(), PermissionID: deletePerm.ID(), ActorID: &actorID})
+		require.NoError(t, err)
+		assert.False(t, target.DeniesPermission(deletePerm.ID()))
+	})
+
+	t.Run("fails when permission not found", func(t *testing.T) {
+		userRepo, denyHandler, _, _ := setup()
+		target := testutil.CreateActiveUser()
+		userRepo.AddUser(target)
+
+		_, err := denyHandler.Handle(ctx, DenyUserPermissionCommand{UserID: target.ID(), PermissionID: uuid.New()})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("allows denying the actor's own permission", func(t *testing.T) {
+		_, denyHandler, _, actor := setup()
+		actorID := actor.ID()
+
+		_, err := denyHandler.Handle(ctx, DenyUserPermissionCommand{UserID: actor.ID(), PermissionID: deletePerm.ID(), ActorID: &actorID})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects lifting the actor's own deny", func(t *testing.T) {
+		_, _, removeHandler, actor := setup()
+		require.NoError(t, actor.DenyPermission(deletePerm.ID()))
+		actorID := actor.ID()
+
+		_, err := removeHandler.Handle(ctx, RemoveUserDenyCommand{UserID: actor.ID(), PermissionID: deletePerm.ID(), ActorID: &actorID})
+		assert.ErrorIs(t, err, authz.ErrSelfEscalation)
+		assert.True(t, actor.DeniesPermission(deletePerm.ID()))
+	})
+}
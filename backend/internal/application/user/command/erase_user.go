@@ -0,0 +1,167 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// PasswordResetTokenEraser removes the outstanding reset tokens issued to an
+// address, which are keyed by email rather than user id.
+type PasswordResetTokenEraser interface {
+	DeleteAllForEmail(ctx context.Context, email string) (int64, error)
+}
+
+type EraseUserCommand struct {
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type EraseUserHandler struct {
+	userRepository         user.Repository
+	refreshTokenRepository auth.RefreshTokenRepository
+	emailChangeRepository  user.EmailChangeRepository
+	resetTokenEraser       PasswordResetTokenEraser
+	invitationRepository   invitation.Repository
+	auditTrail             user.AuditTrail
+	blobStore              shared.BlobStore
+	delegationPolicy       *authz.DelegationPolicy
+	transactionManager     shared.TransactionManager
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewEraseUserHandler(
+	userRepository user.Repository,
+	refreshTokenRepository auth.RefreshTokenRepository,
+	emailChangeRepository user.EmailChangeRepository,
+	resetTokenEraser PasswordResetTokenEraser,
+	invitationRepository invitation.Repository,
+	auditTrail user.AuditTrail,
+	blobStore shared.BlobStore,
+	delegationPolicy *authz.DelegationPolicy,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *EraseUserHandler {
+	return &EraseUserHandler{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		emailChangeRepository:  emailChangeRepository,
+		resetTokenEraser:       resetTokenEraser,
+		invitationRepository:   invitationRepository,
+		auditTrail:             auditTrail,
+		blobStore:              blobStore,
+		delegationPolicy:       delegationPolicy,
+		transactionManager:     transactionManager,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+// Handle pseudonymizes the user row, deletes their sessions, reset tokens,
+// invitations and pending email change and scrubs their audit entries.
+// Soft-deleted users can be erased too.
+func (handler *EraseUserHandler) Handle(ctx context.Context, command EraseUserCommand) (*userdto.ErasureReceiptDTO, error) {
+	existingUser, err := handler.userRepository.FindByIDIncludingDeleted(ctx, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(ctx, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(ctx, existingUser); err != nil {
+		return nil, err
+	}
+
+	email := existingUser.Email().String()
+	identifiers := []string{email, existingUser.FullName().String()}
+	avatar := existingUser.Avatar()
+
+	if err := existingUser.Erase(); err != nil {
+		return nil, err
+	}
+
+	receipt := user.ErasureReceipt{
+		UserID:        existingUser.ID(),
+		ErasedAt:      *existingUser.ErasedAt(),
+		ErasedBy:      command.ActorID,
+		AvatarRemoved: avatar != nil,
+	}
+
+	err = handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if err := handler.userRepository.Update(txContext, existingUser); err != nil {
+			return fmt.Errorf("save user: %w", err)
+		}
+
+		receipt.RefreshTokensDeleted, err = handler.refreshTokenRepository.DeleteAllByUserID(txContext, existingUser.ID())
+		if err != nil {
+			return fmt.Errorf("delete refresh tokens: %w", err)
+		}
+
+		if err := handler.emailChangeRepository.DeleteByUserID(txContext, existingUser.ID()); err != nil {
+			return fmt.Errorf("delete email change: %w", err)
+		}
+
+		// Invitations are keyed by address, so they are not reached through
+		// the user id.
+		receipt.InvitationsDeleted, err = handler.invitationRepository.DeleteAllForEmail(txContext, email)
+		if err != nil {
+			return fmt.Errorf("delete invitations: %w", err)
+		}
+
+		receipt.AuditEntriesPseudonymized, err = handler.auditTrail.Pseudonymize(txContext, existingUser.ID(), identifiers)
+		if err != nil {
+			return fmt.Errorf("pseudonymize audit trail: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reset tokens and avatar blobs live outside the database, so they are
+	// removed once the erasure has committed. A failure leaves data that
+	// expires or is unreachable, and is logged for follow-up.
+	receipt.ResetTokensDeleted, err = handler.resetTokenEraser.DeleteAllForEmail(ctx, email)
+	if err != nil {
+		handler.logger.Error("failed to delete password reset tokens of erased user",
+			logger.String("user_id", existingUser.ID().String()),
+			logger.Err(err),
+		)
+	}
+	if avatar != nil {
+		removeAvatarBlobs(ctx, handler.blobStore, handler.logger, *avatar)
+	}
+
+	existingUser.AddDomainEvent(user.NewUserErasedEvent(receipt))
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(ctx, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user personal data erased",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.Int64("refresh_tokens_deleted", receipt.RefreshTokensDeleted),
+		logger.Int64("audit_entries_pseudonymized", receipt.AuditEntriesPseudonymized),
+	)
+
+	return userdto.ErasureReceiptFromDomain(receipt), nil
+}
@@ -0,0 +1,195 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestEraseUserHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	type mocks struct {
+		userRepo      *testutil.MockUserRepository
+		refreshTokens *testutil.MockRefreshTokenRepository
+		emailChanges  *testutil.MockEmailChangeRepository
+		resetTokens   *testutil.MockPasswordResetTokenStore
+		invitations   *testutil.MockInvitationRepository
+		auditTrail    *testutil.MockAuditTrail
+		blobStore     *testutil.MockBlobStore
+		eventBus      *testutil.MockEventBus
+	}
+
+	createUser := func(t *testing.T, m mocks) *user.User {
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "test@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Test User",
+		})
+		require.NoError(t, err)
+		testUser.ClearDomainEvents()
+		m.userRepo.AddUser(testUser)
+
+		token, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+			UserID:    testUser.ID(),
+			TokenHash: "hash",
+			ExpiresAt: time.Now().Add(time.Hour),
+			IPAddress: net.ParseIP("203.0.113.7"),
+		})
+		require.NoError(t, err)
+		require.NoError(t, m.refreshTokens.Create(ctx, token))
+		require.NoError(t, m.resetTokens.Store(ctx, "Test@Example.com", "reset-hash", time.Now().Add(time.Hour)))
+		change, err := user.NewEmailChange(user.NewEmailChangeParams{
+			UserID:           testUser.ID(),
+			NewEmail:         "pending@example.com",
+			ConfirmTokenHash: "confirm-hash",
+			CancelTokenHash:  "cancel-hash",
+			ExpiresAt:        time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.NoError(t, m.emailChanges.Save(ctx, change))
+		invite, err := invitation.NewInvitation(invitation.NewInvitationParams{
+			Email:     "TEST@example.com",
+			InvitedBy: uuid.New(),
+			TokenHash: "invite-hash",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		m.invitations.AddInvitation(invite)
+		m.auditTrail.Records[testUser.ID()] = []user.AuditRecord{
+			{ID: uuid.New(), Action: "login", IPAddress: "203.0.113.7"},
+			{ID: uuid.New(), Action: "update", IPAddress: "203.0.113.7"},
+		}
+		return testUser
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(*testing.T, mocks, *user.User)
+		wantErr     bool
+		errContains string
+		checkResult func(*testing.T, mocks, *user.User)
+	}{
+		{
+			name: "pseudonymizes the user and removes everything tied to them",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				avatar := user.Avatar{Key: "avatars/current", Extension: "png", URLs: map[string]string{"small": "s"}}
+				u.SetAvatar(avatar)
+				u.ClearDomainEvents()
+				m.blobStore.Objects[avatar.BlobKey("small")] = []byte("s")
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.True(t, u.IsErased())
+				assert.True(t, u.IsDeleted())
+				assert.NotContains(t, u.Email().String(), "test@example.com")
+				assert.Equal(t, user.ErasedFullName, u.FullName().String())
+				assert.Nil(t, u.Avatar())
+				assert.Empty(t, m.blobStore.Objects)
+				assert.Equal(t, []string{"test@example.com", "Test User"}, m.auditTrail.Identifiers)
+
+				tokens, _ := m.refreshTokens.FindByUserID(ctx, u.ID())
+				assert.Empty(t, tokens)
+				assert.Empty(t, m.resetTokens.Tokens)
+				assert.Empty(t, m.emailChanges.Changes)
+				assert.Empty(t, m.invitations.Invitations)
+
+				require.Len(t, m.eventBus.PublishedEvents, 1)
+				event, ok := m.eventBus.PublishedEvents[0].(user.UserErasedEvent)
+				require.True(t, ok)
+				assert.Equal(t, u.ID(), event.Receipt.UserID)
+			},
+		},
+		{
+			name: "erases a user that was already soft-deleted",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				require.NoError(t, u.Delete())
+				u.ClearDomainEvents()
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.True(t, u.IsErased())
+			},
+		},
+		{
+			name: "rejects a user that has already been erased",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				require.NoError(t, u.Erase())
+			},
+			wantErr:     true,
+			errContains: "already been erased",
+		},
+		{
+			name: "fails when the audit trail cannot be pseudonymized",
+			setup: func(t *testing.T, m mocks, u *user.User) {
+				m.auditTrail.PseudonymizeError = errors.New("database error")
+			},
+			wantErr:     true,
+			errContains: "pseudonymize audit trail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				userRepo:      testutil.NewMockUserRepository(),
+				refreshTokens: testutil.NewMockRefreshTokenRepository(),
+				emailChanges:  testutil.NewMockEmailChangeRepository(),
+				resetTokens:   testutil.NewMockPasswordResetTokenStore(),
+				invitations:   testutil.NewMockInvitationRepository(),
+				auditTrail:    testutil.NewMockAuditTrail(),
+				blobStore:     testutil.NewMockBlobStore(),
+				eventBus:      testutil.NewMockEventBus(),
+			}
+			testUser := createUser(t, m)
+			if tt.setup != nil {
+				tt.setup(t, m, testUser)
+			}
+
+			handler := NewEraseUserHandler(
+				m.userRepo,
+				m.refreshTokens,
+				m.emailChanges,
+				m.resetTokens,
+				m.invitations,
+				m.auditTrail,
+				m.blobStore,
+				authz.NewDelegationPolicy(m.userRepo, testutil.NewMockRoleRepository(), nil),
+				testutil.NewMockTransactionManager(),
+				m.eventBus,
+				testutil.NewNoopLogger(),
+			)
+
+			receipt, err := handler.Handle(ctx, EraseUserCommand{UserID: testUser.ID()})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, receipt)
+				assert.Empty(t, m.eventBus.PublishedEvents)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, receipt)
+			assert.Equal(t, testUser.ID(), receipt.UserID)
+			assert.Equal(t, int64(1), receipt.RefreshTokensDeleted)
+			assert.Equal(t, int64(1), receipt.ResetTokensDeleted)
+			assert.Equal(t, int64(1), receipt.InvitationsDeleted)
+			assert.Equal(t, int64(2), receipt.AuditEntriesPseudonymized)
+			if tt.checkResult != nil {
+				tt.checkResult(t, m, testUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,546 @@
+package usercommand
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/security"
+)
+
+const (
+	ImportStageValidating = "validating"
+	ImportStageImporting  = "importing"
+
+	MaxImportRows = 5000
+
+	importBatchSize     = 100
+	importRoleSeparator = ";"
+	importBanReason     = "banned by user import"
+)
+
+var ErrTooManyImportRows = shared.NewBusinessRuleViolationErrorWithParams(
+	"import_too_large",
+	"an import may contain at most {max} rows",
+	map[string]string{"max": strconv.Itoa(MaxImportRows)},
+)
+
+type ImportUsersCommand struct {
+	Format          string
+	Source          io.Reader
+	DryRun          bool
+	SendInvitations bool
+	ActorID         *uuid.UUID
+	Progress        func(progress userdto.ImportProgressDTO)
+}
+
+type ImportUsersHandler struct {
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	organizationRepository organization.Repository
+	delegationPolicy       *authz.DelegationPolicy
+	sodChecker             *sod.Checker
+	passwordHasher         security.PasswordHasher
+	transactionManager     shared.TransactionManager
+	eventBus               shared.EventBus
+	logger                 logger.Logger
+}
+
+func NewImportUsersHandler(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	organizationRepository organization.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
+	passwordHasher security.PasswordHasher,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *ImportUsersHandler {
+	return &ImportUsersHandler{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		delegationPolicy:       delegationPolicy,
+		sodChecker:             sodChecker,
+		passwordHasher:         passwordHasher,
+		transactionManager:     transactionManager,
+		eventBus:               eventBus,
+		logger:                 logger,
+	}
+}
+
+type importRow struct {
+	number   int
+	email    string
+	fullName string
+	status   string
+	roles    []string
+	hasRoles bool
+}
+
+type importPlan struct {
+	row          importRow
+	subject      *user.User
+	created      bool
+	rolesChanged bool
+	addedRoleIDs []uuid.UUID
+}
+
+func (handler *ImportUsersHandler) Handle(context context.Context, command ImportUsersCommand) (*userdto.ImportResultDTO, error) {
+	rows, err := decodeImportRows(command.Format, command.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	placeholderHash, err := handler.placeholderPasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &userdto.ImportResultDTO{
+		DryRun: command.DryRun,
+		Total:  len(rows),
+		Errors: make([]*userdto.ImportRowErrorDTO, 0),
+	}
+
+	roleCache := make(map[string]*role.Role)
+	seenEmails := make(map[string]bool, len(rows))
+	plans := make([]*importPlan, 0, len(rows))
+	for i, row := range rows {
+		plan, err := handler.planRow(context, delegator, row, placeholderHash, roleCache, seenEmails)
+		switch {
+		case err != nil:
+			result.Errors = append(result.Errors, &userdto.ImportRowErrorDTO{Row: row.number, Email: row.email, Error: err.Error()})
+		case plan == nil:
+			result.Unchanged++
+		case plan.created:
+			result.Created++
+			plans = append(plans, plan)
+		default:
+			result.Updated++
+			plans = append(plans, plan)
+		}
+
+		if (i+1)%importBatchSize == 0 || i+1 == len(rows) {
+			reportImportProgress(command, ImportStageValidating, i+1, len(rows))
+		}
+	}
+
+	if err := handler.checkSeparationOfDuties(context, plans); err != nil {
+		return nil, err
+	}
+
+	if command.DryRun {
+		result.Failed = len(result.Errors)
+		return result, nil
+	}
+
+	for start := 0; start < len(plans); start += importBatchSize {
+		end := min(start+importBatchSize, len(plans))
+		batch := plans[start:end]
+
+		if err := handler.saveBatch(context, batch); err != nil {
+			handler.logger.Error("failed to import user batch",
+				logger.Int("first_row", batch[0].row.number),
+				logger.Int("rows", len(batch)),
+				logger.Err(err),
+			)
+			for _, plan := range batch {
+				if plan.created {
+					result.Created--
+				} else {
+					result.Updated--
+				}
+				result.Errors = append(result.Errors, &userdto.ImportRowErrorDTO{Row: plan.row.number, Email: plan.row.email, Error: err.Error()})
+			}
+		} else {
+			result.Invited += handler.publishBatchEvents(context, batch, command)
+		}
+
+		reportImportProgress(command, ImportStageImporting, end, len(plans))
+	}
+
+	result.Failed = len(result.Errors)
+
+	handler.logger.Info("user import completed",
+		logger.Int("total", result.Total),
+		logger.Int("created", result.Created),
+		logger.Int("updated", result.Updated),
+		logger.Int("failed", result.Failed),
+	)
+
+	return result, nil
+}
+
+func (handler *ImportUsersHandler) planRow(
+	context context.Context,
+	delegator *authz.Delegator,
+	row importRow,
+	placeholderHash string,
+	roleCache map[string]*role.Role,
+	seenEmails map[string]bool,
+) (*importPlan, error) {
+	email, err := shared.NewEmail(row.email)
+	if err != nil {
+		return nil, err
+	}
+	if seenEmails[email.String()] {
+		return nil, shared.NewValidationError("email", "appears more than once in the file")
+	}
+	seenEmails[email.String()] = true
+
+	var status *user.Status
+	if row.status != "" {
+		parsed, valid := user.ParseStatus(strings.ToLower(row.status))
+		if !valid {
+			return nil, user.ErrInvalidStatus
+		}
+		status = &parsed
+	}
+
+	roles, err := handler.resolveRoles(context, delegator, row.roles, roleCache)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &importPlan{row: row}
+	subject, err := handler.userRepository.FindByEmail(context, email.String())
+	switch {
+	case shared.IsNotFoundError(err):
+		subject, err = user.NewUser(user.NewUserParams{
+			Email:        email.String(),
+			PasswordHash: placeholderHash,
+			FullName:     row.fullName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		plan.created = true
+	case err != nil:
+		return nil, fmt.Errorf("find user: %w", err)
+	default:
+		if err := delegator.EnsureCanManageUser(context, subject); err != nil {
+			return nil, err
+		}
+		if err := subject.UpdateProfile(row.fullName); err != nil {
+			return nil, err
+		}
+	}
+	plan.subject = subject
+
+	if status != nil && subject.Status() != *status {
+		if err := applyImportedStatus(subject, *status); err != nil {
+			return nil, err
+		}
+	}
+
+	if row.hasRoles {
+		roleIDs := make([]uuid.UUID, len(roles))
+		for i, assignedRole := range roles {
+			if err := ensureRoleWithinMembership(context, handler.organizationRepository, subject.ID(), assignedRole); err != nil {
+				return nil, err
+			}
+			roleIDs[i] = assignedRole.ID()
+		}
+
+		addedRoleIDs, removedRoleIDs := roleChanges(subject.RoleIDs(), roleIDs)
+		if len(addedRoleIDs) > 0 {
+			if err := delegator.EnsureCanGrantTo(subject); err != nil {
+				return nil, err
+			}
+		}
+		if len(removedRoleIDs) > 0 {
+			removedRoles, err := handler.roleRepository.FindByIDs(context, removedRoleIDs)
+			if err != nil {
+				return nil, fmt.Errorf("load removed roles: %w", err)
+			}
+			for _, removedRole := range removedRoles {
+				if err := delegator.EnsureCanManageRole(removedRole); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if len(addedRoleIDs)+len(removedRoleIDs) > 0 {
+			subject.SetRoles(roleIDs)
+			plan.rolesChanged = true
+			plan.addedRoleIDs = addedRoleIDs
+			if err := handler.sodChecker.CheckUser(context, subject, addedRoleIDs...); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !plan.created && len(subject.DomainEvents()) == 0 {
+		return nil, nil
+	}
+
+	return plan, nil
+}
+
+func (handler *ImportUsersHandler) resolveRoles(
+	context context.Context,
+	delegator *authz.Delegator,
+	names []string,
+	roleCache map[string]*role.Role,
+) ([]*role.Role, error) {
+	roles := make([]*role.Role, 0, len(names))
+	for _, name := range names {
+		cachedRole, exists := roleCache[name]
+		if !exists {
+			foundRole, err := handler.roleRepository.FindByName(context, name)
+			if err != nil {
+				if shared.IsNotFoundError(err) {
+					return nil, role.NewRoleNotFoundError(name)
+				}
+				return nil, fmt.Errorf("find role: %w", err)
+			}
+			roleCache[name] = foundRole
+			cachedRole = foundRole
+		}
+		if err := delegator.EnsureCanManageRole(cachedRole); err != nil {
+			return nil, err
+		}
+		roles = append(roles, cachedRole)
+	}
+	return roles, nil
+}
+
+func (handler *ImportUsersHandler) checkSeparationOfDuties(context context.Context, plans []*importPlan) error {
+	subjects := make([]*user.User, 0)
+	addedRoleIDs := make([]uuid.UUID, 0)
+	for _, plan := range plans {
+		if plan.rolesChanged {
+			subjects = append(subjects, plan.subject)
+			addedRoleIDs = append(addedRoleIDs, plan.addedRoleIDs...)
+		}
+	}
+	if len(subjects) < 2 {
+		return nil
+	}
+	return handler.sodChecker.CheckUsers(context, subjects, addedRoleIDs...)
+}
+
+func (handler *ImportUsersHandler) saveBatch(ctx context.Context, batch []*importPlan) error {
+	return handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		for _, plan := range batch {
+			if plan.created {
+				if err := handler.userRepository.Create(txContext, plan.subject); err != nil {
+					return fmt.Errorf("row %d: create user: %w", plan.row.number, err)
+				}
+				continue
+			}
+			if err := handler.userRepository.Update(txContext, plan.subject); err != nil {
+				return fmt.Errorf("row %d: update user: %w", plan.row.number, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (handler *ImportUsersHandler) publishBatchEvents(context context.Context, batch []*importPlan, command ImportUsersCommand) int {
+	invited := 0
+	for _, plan := range batch {
+		events := plan.subject.DomainEvents()
+		if plan.created && command.SendInvitations {
+			events = append(events, user.NewUserInvitedEvent(plan.subject.ID(), plan.subject.Email().String(), command.ActorID))
+			invited++
+		}
+
+		if handler.eventBus != nil {
+			if err := handler.eventBus.Publish(context, events...); err != nil {
+				handler.logger.Error("failed to publish domain events",
+					logger.String("user_id", plan.subject.ID().String()),
+					logger.Err(err),
+				)
+			}
+		}
+		plan.subject.ClearDomainEvents()
+	}
+	return invited
+}
+
+func (handler *ImportUsersHandler) placeholderPasswordHash() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+
+	hashedPassword, err := handler.passwordHasher.Hash(base64.RawURLEncoding.EncodeToString(secret))
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return hashedPassword, nil
+}
+
+func applyImportedStatus(subject *user.User, status user.Status) error {
+	switch status {
+	case user.StatusActive:
+		return subject.Activate()
+	case user.StatusInactive:
+		if subject.Status().IsPending() {
+			if err := subject.Activate(); err != nil {
+				return err
+			}
+		}
+		return subject.Deactivate()
+	case user.StatusBanned:
+		return subject.Ban(importBanReason)
+	default:
+		return user.NewInvalidStatusTransitionError(subject.Status(), status)
+	}
+}
+
+func reportImportProgress(command ImportUsersCommand, stage string, processed, total int) {
+	if command.Progress == nil {
+		return
+	}
+	command.Progress(userdto.ImportProgressDTO{Stage: stage, Processed: processed, Total: total})
+}
+
+func decodeImportRows(format string, source io.Reader) ([]importRow, error) {
+	switch strings.ToLower(format) {
+	case userdto.TransferFormatCSV:
+		return decodeCSVImportRows(source)
+	case userdto.TransferFormatJSON:
+		return decodeJSONImportRows(source)
+	default:
+		return nil, shared.NewValidationError("format", "must be csv or json")
+	}
+}
+
+func decodeCSVImportRows(source io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(source)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, shared.NewValidationError("file", "file is empty")
+		}
+		return nil, shared.NewValidationError("file", err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, exists := columns["email"]; !exists {
+		return nil, shared.NewValidationError("file", "missing email column")
+	}
+
+	cell := func(record []string, name string) string {
+		index, exists := columns[name]
+		if !exists || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	rows := make([]importRow, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, shared.NewValidationError("file", err.Error())
+		}
+		if len(rows) == MaxImportRows {
+			return nil, ErrTooManyImportRows
+		}
+
+		row := importRow{
+			number:   len(rows) + 1,
+			email:    cell(record, "email"),
+			fullName: cell(record, "full_name"),
+			status:   cell(record, "status"),
+		}
+		if roles := cell(record, "roles"); roles != "" {
+			row.roles = splitRoleNames(strings.Split(roles, importRoleSeparator))
+			row.hasRoles = true
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func decodeJSONImportRows(source io.Reader) ([]importRow, error) {
+	decoder := json.NewDecoder(source)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, shared.NewValidationError("file", "expected a JSON array of users")
+	}
+	if delimiter, ok := token.(json.Delim); !ok || delimiter != '[' {
+		return nil, shared.NewValidationError("file", "expected a JSON array of users")
+	}
+
+	rows := make([]importRow, 0)
+	for decoder.More() {
+		if len(rows) == MaxImportRows {
+			return nil, ErrTooManyImportRows
+		}
+
+		var record struct {
+			Email    string    `json:"email"`
+			FullName string    `json:"full_name"`
+			Status   string    `json:"status"`
+			Roles    *[]string `json:"roles"`
+		}
+		if err := decoder.Decode(&record); err != nil {
+			return nil, shared.NewValidationError("file", fmt.Sprintf("row %d: %v", len(rows)+1, err))
+		}
+
+		row := importRow{
+			number:   len(rows) + 1,
+			email:    strings.TrimSpace(record.Email),
+			fullName: strings.TrimSpace(record.FullName),
+			status:   strings.TrimSpace(record.Status),
+		}
+		if record.Roles != nil {
+			row.roles = splitRoleNames(*record.Roles)
+			row.hasRoles = true
+		}
+		rows = append(rows, row)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, shared.NewValidationError("file", "expected a JSON array of users")
+	}
+
+	return rows, nil
+}
+
+func splitRoleNames(values []string) []string {
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		if name := strings.TrimSpace(value); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,256 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+type importUsersFixture struct {
+	userRepo           *testutil.MockUserRepository
+	roleRepo           *testutil.MockRoleRepository
+	eventBus           *testutil.MockEventBus
+	transactionManager *testutil.MockTransactionManager
+	handler            *ImportUsersHandler
+	editorRole         *role.Role
+}
+
+func newImportUsersFixture() *importUsersFixture {
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	eventBus := testutil.NewMockEventBus()
+	transactionManager := testutil.NewMockTransactionManager()
+
+	editorRole, _ := role.NewRole(role.NewRoleParams{
+		Name:        "editor",
+		DisplayName: "Editor",
+	})
+	roleRepo.AddRole(editorRole)
+
+	handler := NewImportUsersHandler(
+		userRepo,
+		roleRepo,
+		testutil.NewMockOrganizationRepository(),
+		authz.NewDelegationPolicy(userRepo, roleRepo, nil),
+		sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil),
+		testutil.NewMockPasswordHasher(),
+		transactionManager,
+		eventBus,
+		testutil.NewNoopLogger(),
+	)
+
+	return &importUsersFixture{
+		userRepo:           userRepo,
+		roleRepo:           roleRepo,
+		eventBus:           eventBus,
+		transactionManager: transactionManager,
+		handler:            handler,
+		editorRole:         editorRole,
+	}
+}
+
+func TestImportUsersHandler_CSV(t *testing.T) {
+	fixture := newImportUsersFixture()
+
+	source := strings.NewReader("email,full_name,status,roles\n" +
+		"alice@example.com,Alice Doe,active,editor\n" +
+		"bob@example.com,Bob Doe,,\n")
+
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format: userdto.TransferFormatCSV,
+		Source: source,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, 2, result.Created)
+	assert.Equal(t, 0, result.Failed)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 1, fixture.transactionManager.Transactions)
+
+	alice, err := fixture.userRepo.FindByEmail(context.Background(), "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, user.StatusActive, alice.Status())
+	assert.True(t, alice.HasRole(fixture.editorRole.ID()))
+
+	bob, err := fixture.userRepo.FindByEmail(context.Background(), "bob@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, user.StatusPending, bob.Status())
+	assert.Empty(t, bob.RoleIDs())
+}
+
+func TestImportUsersHandler_DryRunReportsRowErrors(t *testing.T) {
+	fixture := newImportUsersFixture()
+
+	source := strings.NewReader(`[
+		{"email": "valid@example.com", "full_name": "Valid User"},
+		{"email": "not-an-email", "full_name": "Broken"},
+		{"email": "unknown-role@example.com", "full_name": "Unknown Role", "roles": ["missing"]},
+		{"email": "bad-status@example.com", "full_name": "Bad Status", "status": "sleeping"},
+		{"email": "valid@example.com", "full_name": "Duplicate"}
+	]`)
+
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format: userdto.TransferFormatJSON,
+		Source: source,
+		DryRun: true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 5, result.Total)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 4, result.Failed)
+
+	failedRows := make([]int, len(result.Errors))
+	for i, rowError := range result.Errors {
+		failedRows[i] = rowError.Row
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, failedRows)
+
+	assert.Empty(t, fixture.userRepo.Users)
+	assert.Zero(t, fixture.transactionManager.Transactions)
+	assert.Empty(t, fixture.eventBus.PublishedEvents)
+}
+
+func TestImportUsersHandler_UpdatesExistingUsers(t *testing.T) {
+	fixture := newImportUsersFixture()
+
+	existing, _ := user.NewUser(user.NewUserParams{
+		Email:        "carol@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Carol Old",
+	})
+	existing.ClearDomainEvents()
+	fixture.userRepo.AddUser(existing)
+
+	unchanged, _ := user.NewUser(user.NewUserParams{
+		Email:        "dave@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Dave Doe",
+	})
+	unchanged.ClearDomainEvents()
+	fixture.userRepo.AddUser(unchanged)
+
+	source := strings.NewReader(`[
+		{"email": "carol@example.com", "full_name": "Carol New", "roles": ["editor"]},
+		{"email": "dave@example.com", "full_name": "Dave Doe"}
+	]`)
+
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format: userdto.TransferFormatJSON,
+		Source: source,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, 1, result.Unchanged)
+
+	updated, err := fixture.userRepo.FindByID(context.Background(), existing.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "Carol New", updated.FullName().String())
+	assert.True(t, updated.HasRole(fixture.editorRole.ID()))
+}
+
+func TestImportUsersHandler_SendInvitations(t *testing.T) {
+	fixture := newImportUsersFixture()
+
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format:          userdto.TransferFormatCSV,
+		Source:          strings.NewReader("email,full_name\nerin@example.com,Erin Doe\n"),
+		SendInvitations: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Invited)
+
+	invitations := 0
+	for _, event := range fixture.eventBus.PublishedEvents {
+		if event.EventType() == user.EventTypeUserInvited {
+			invitations++
+		}
+	}
+	assert.Equal(t, 1, invitations)
+}
+
+func TestImportUsersHandler_BatchFailureReportsRows(t *testing.T) {
+	fixture := newImportUsersFixture()
+	fixture.userRepo.CreateError = errors.New("database unavailable")
+
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format: userdto.TransferFormatCSV,
+		Source: strings.NewReader("email,full_name\nfrank@example.com,Frank Doe\ngrace@example.com,Grace Doe\n"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Created)
+	assert.Equal(t, 2, result.Failed)
+	assert.Empty(t, fixture.eventBus.PublishedEvents)
+}
+
+func TestImportUsersHandler_ReportsProgress(t *testing.T) {
+	fixture := newImportUsersFixture()
+
+	var builder strings.Builder
+	builder.WriteString("email,full_name\n")
+	for i := 0; i < 250; i++ {
+		fmt.Fprintf(&builder, "user%d@example.com,User %d\n", i, i)
+	}
+
+	progress := make([]userdto.ImportProgressDTO, 0)
+	result, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+		Format: userdto.TransferFormatCSV,
+		Source: strings.NewReader(builder.String()),
+		Progress: func(update userdto.ImportProgressDTO) {
+			progress = append(progress, update)
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 250, result.Created)
+	assert.Equal(t, 3, fixture.transactionManager.Transactions)
+	require.Len(t, progress, 6)
+	assert.Equal(t, userdto.ImportProgressDTO{Stage: ImportStageValidating, Processed: 250, Total: 250}, progress[2])
+	assert.Equal(t, userdto.ImportProgressDTO{Stage: ImportStageImporting, Processed: 250, Total: 250}, progress[5])
+}
+
+func TestImportUsersHandler_RejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+	}{
+		{name: "unsupported format", format: "xml", content: "<users/>"},
+		{name: "missing email column", format: userdto.TransferFormatCSV, content: "full_name\nAlice\n"},
+		{name: "empty csv", format: userdto.TransferFormatCSV, content: ""},
+		{name: "json object instead of array", format: userdto.TransferFormatJSON, content: `{"email": "a@example.com"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newImportUsersFixture()
+
+			_, err := fixture.handler.Handle(context.Background(), ImportUsersCommand{
+				Format: tt.format,
+				Source: strings.NewReader(tt.content),
+			})
+
+			require.Error(t, err)
+			assert.True(t, shared.IsValidationError(err))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const purgeBatchSize = 100
+
+type PurgeDeletedUsersCommand struct {
+	Now time.Time
+}
+
+// PurgeDeletedUsersHandler hard-deletes users whose soft deletion is older
+// than the retention period. Their audit entries are pseudonymized first,
+// since the identifiers needed to find them disappear with the row.
+type PurgeDeletedUsersHandler struct {
+	userRepository     user.Repository
+	auditTrail         user.AuditTrail
+	blobStore          shared.BlobStore
+	transactionManager shared.TransactionManager
+	eventBus           shared.EventBus
+	logger             logger.Logger
+	retention          time.Duration
+}
+
+func NewPurgeDeletedUsersHandler(
+	userRepository user.Repository,
+	auditTrail user.AuditTrail,
+	blobStore shared.BlobStore,
+	transactionManager shared.TransactionManager,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	retention time.Duration,
+) *PurgeDeletedUsersHandler {
+	return &PurgeDeletedUsersHandler{
+		userRepository:     userRepository,
+		auditTrail:         auditTrail,
+		blobStore:          blobStore,
+		transactionManager: transactionManager,
+		eventBus:           eventBus,
+		logger:             logger,
+		retention:          retention,
+	}
+}
+
+func (handler *PurgeDeletedUsersHandler) Handle(context context.Context, command PurgeDeletedUsersCommand) (int, error) {
+	if handler.retention <= 0 {
+		return 0, nil
+	}
+
+	users, err := handler.userRepository.FindDeletedBefore(context, command.Now.Add(-handler.retention), purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("find deleted users: %w", err)
+	}
+
+	purged := 0
+	for _, deletedUser := range users {
+		if err := handler.purge(context, deletedUser); err != nil {
+			handler.logger.Error("failed to purge deleted user",
+				logger.String("user_id", deletedUser.ID().String()),
+				logger.Err(err),
+			)
+			continue
+		}
+		purged++
+	}
+
+	if purged > 0 {
+		handler.logger.Info("deleted users purged",
+			logger.Int("count", purged),
+		)
+	}
+
+	return purged, nil
+}
+
+func (handler *PurgeDeletedUsersHandler) purge(ctx context.Context, deletedUser *user.User) error {
+	err := handler.transactionManager.WithinTransaction(ctx, func(txContext context.Context) error {
+		if !deletedUser.IsErased() {
+			identifiers := []string{deletedUser.Email().String(), deletedUser.FullName().String()}
+			if _, err := handler.auditTrail.Pseudonymize(txContext, deletedUser.ID(), identifiers); err != nil {
+				return fmt.Errorf("pseudonymize audit trail: %w", err)
+			}
+		}
+
+		if err := handler.userRepository.Purge(txContext, deletedUser.ID()); err != nil {
+			return fmt.Errorf("purge user: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if avatar := deletedUser.Avatar(); avatar != nil {
+		removeAvatarBlobs(ctx, handler.blobStore, handler.logger, *avatar)
+	}
+
+	if handler.eventBus != nil {
+		event := user.NewUserPurgedEvent(deletedUser.ID(), *deletedUser.DeletedAt())
+		if err := handler.eventBus.Publish(ctx, event); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", deletedUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	return nil
+}
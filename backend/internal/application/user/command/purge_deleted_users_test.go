@@ -0,0 +1,95 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestPurgeDeletedUsersHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	retention := 30 * 24 * time.Hour
+
+	deletedUser := func(t *testing.T, email string, deletedAt time.Time) *user.User {
+		u, err := user.ReconstructUser(user.ReconstructUserParams{
+			ID:           uuid.New(),
+			Email:        email,
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Deleted User",
+			Status:       user.StatusActive,
+			CreatedAt:    deletedAt.Add(-time.Hour),
+			UpdatedAt:    deletedAt,
+			DeletedAt:    &deletedAt,
+		})
+		require.NoError(t, err)
+		return u
+	}
+
+	t.Run("purges only users deleted before the retention period", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		auditTrail := testutil.NewMockAuditTrail()
+		blobStore := testutil.NewMockBlobStore()
+		eventBus := testutil.NewMockEventBus()
+
+		expired := deletedUser(t, "expired@example.com", now.Add(-retention-time.Hour))
+		avatar := user.Avatar{Key: "avatars/expired", Extension: "png", URLs: map[string]string{"small": "s"}}
+		expired.SetAvatar(avatar)
+		blobStore.Objects[avatar.BlobKey("small")] = []byte("s")
+		recent := deletedUser(t, "recent@example.com", now.Add(-time.Hour))
+		userRepo.AddUser(expired)
+		userRepo.AddUser(recent)
+
+		handler := NewPurgeDeletedUsersHandler(userRepo, auditTrail, blobStore, testutil.NewMockTransactionManager(), eventBus, testutil.NewNoopLogger(), retention)
+
+		purged, err := handler.Handle(ctx, PurgeDeletedUsersCommand{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+		assert.NotContains(t, userRepo.Users, expired.ID())
+		assert.Contains(t, userRepo.Users, recent.ID())
+		assert.Equal(t, []string{"expired@example.com", "Deleted User"}, auditTrail.Identifiers)
+		assert.Empty(t, blobStore.Objects)
+		require.Len(t, eventBus.PublishedEvents, 1)
+		assert.Equal(t, user.EventTypeUserPurged, eventBus.PublishedEvents[0].EventType())
+	})
+
+	t.Run("keeps users whose audit trail cannot be pseudonymized", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		auditTrail := testutil.NewMockAuditTrail()
+		auditTrail.PseudonymizeError = errors.New("database error")
+
+		expired := deletedUser(t, "expired@example.com", now.Add(-retention-time.Hour))
+		userRepo.AddUser(expired)
+
+		handler := NewPurgeDeletedUsersHandler(userRepo, auditTrail, testutil.NewMockBlobStore(), testutil.NewMockTransactionManager(), testutil.NewMockEventBus(), testutil.NewNoopLogger(), retention)
+
+		purged, err := handler.Handle(ctx, PurgeDeletedUsersCommand{Now: now})
+
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+		assert.Contains(t, userRepo.Users, expired.ID())
+	})
+
+	t.Run("zero retention disables purging", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		expired := deletedUser(t, "expired@example.com", now.Add(-365*24*time.Hour))
+		userRepo.AddUser(expired)
+
+		handler := NewPurgeDeletedUsersHandler(userRepo, testutil.NewMockAuditTrail(), testutil.NewMockBlobStore(), testutil.NewMockTransactionManager(), testutil.NewMockEventBus(), testutil.NewNoopLogger(), 0)
+
+		purged, err := handler.Handle(ctx, PurgeDeletedUsersCommand{Now: now})
+
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+		assert.Contains(t, userRepo.Users, expired.ID())
+	})
+}
@@ -0,0 +1,86 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const reinstateBatchSize = 100
+
+type ReinstateSuspendedUsersCommand struct {
+	Now time.Time
+}
+
+// ReinstateSuspendedUsersHandler makes users whose suspension has run out
+// active again.
+type ReinstateSuspendedUsersHandler struct {
+	userRepository user.Repository
+	eventBus       shared.EventBus
+	logger         logger.Logger
+}
+
+func NewReinstateSuspendedUsersHandler(
+	userRepository user.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *ReinstateSuspendedUsersHandler {
+	return &ReinstateSuspendedUsersHandler{
+		userRepository: userRepository,
+		eventBus:       eventBus,
+		logger:         logger,
+	}
+}
+
+func (handler *ReinstateSuspendedUsersHandler) Handle(context context.Context, command ReinstateSuspendedUsersCommand) (int, error) {
+	users, err := handler.userRepository.FindSuspensionsEndedBy(context, command.Now, reinstateBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("find ended suspensions: %w", err)
+	}
+
+	reinstated := 0
+	for _, suspendedUser := range users {
+		if err := handler.reinstate(context, suspendedUser, command.Now); err != nil {
+			handler.logger.Error("failed to reinstate suspended user",
+				logger.String("user_id", suspendedUser.ID().String()),
+				logger.Err(err),
+			)
+			continue
+		}
+		reinstated++
+	}
+
+	if reinstated > 0 {
+		handler.logger.Info("suspended users reinstated",
+			logger.Int("count", reinstated),
+		)
+	}
+
+	return reinstated, nil
+}
+
+func (handler *ReinstateSuspendedUsersHandler) reinstate(ctx context.Context, suspendedUser *user.User, now time.Time) error {
+	if err := suspendedUser.Reinstate(now); err != nil {
+		return fmt.Errorf("reinstate user: %w", err)
+	}
+
+	if err := handler.userRepository.Update(ctx, suspendedUser); err != nil {
+		return fmt.Errorf("save user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(ctx, suspendedUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", suspendedUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		suspendedUser.ClearDomainEvents()
+	}
+
+	return nil
+}
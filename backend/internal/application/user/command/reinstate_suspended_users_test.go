@@ -0,0 +1,71 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestReinstateSuspendedUsersHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	suspendedUser := func(t *testing.T, email string, until time.Time) *user.User {
+		u, err := user.ReconstructUser(user.ReconstructUserParams{
+			ID:               uuid.New(),
+			Email:            email,
+			PasswordHash:     "$2a$10$hashedpassword",
+			FullName:         "Suspended User",
+			Status:           user.StatusSuspended,
+			CreatedAt:        now.Add(-48 * time.Hour),
+			UpdatedAt:        now.Add(-24 * time.Hour),
+			SuspendedUntil:   &until,
+			SuspensionReason: "spam",
+		})
+		require.NoError(t, err)
+		return u
+	}
+
+	t.Run("reinstates only users whose suspension has ended", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		eventBus := testutil.NewMockEventBus()
+
+		ended := suspendedUser(t, "ended@example.com", now.Add(-time.Minute))
+		ongoing := suspendedUser(t, "ongoing@example.com", now.Add(time.Hour))
+		userRepo.AddUser(ended)
+		userRepo.AddUser(ongoing)
+
+		handler := NewReinstateSuspendedUsersHandler(userRepo, eventBus, testutil.NewNoopLogger())
+
+		reinstated, err := handler.Handle(ctx, ReinstateSuspendedUsersCommand{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, reinstated)
+		assert.Equal(t, user.StatusActive, userRepo.Users[ended.ID()].Status())
+		assert.Nil(t, userRepo.Users[ended.ID()].SuspendedUntil())
+		assert.Equal(t, user.StatusSuspended, userRepo.Users[ongoing.ID()].Status())
+		require.Len(t, eventBus.PublishedEvents, 1)
+		assert.Equal(t, user.EventTypeUserReinstated, eventBus.PublishedEvents[0].EventType())
+	})
+
+	t.Run("skips users that cannot be saved", func(t *testing.T) {
+		userRepo := testutil.NewMockUserRepository()
+		userRepo.UpdateError = errors.New("database error")
+		userRepo.AddUser(suspendedUser(t, "ended@example.com", now.Add(-time.Minute)))
+
+		handler := NewReinstateSuspendedUsersHandler(userRepo, testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+		reinstated, err := handler.Handle(ctx, ReinstateSuspendedUsersCommand{Now: now})
+
+		require.NoError(t, err)
+		assert.Zero(t, reinstated)
+	})
+}
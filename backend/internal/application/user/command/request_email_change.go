@@ -0,0 +1,190 @@
+package usercommand
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	emailChangeConfirmSubject = "Confirm your new email address"
+	emailChangeConfirmBody    = "Hello {name},\n\nConfirm that you want to use this address for your account by opening the link below before {expires}:\n\n{link}\n\nIf you did not ask for this, ignore this message.\n"
+	emailChangeNoticeSubject  = "Your email address is being changed"
+	emailChangeNoticeBody     = "Hello {name},\n\nA change of your account email to {new_email} was requested. It takes effect once confirmed from the new address.\n\nIf you did not ask for this, cancel the change and change your password:\n\n{link}\n"
+)
+
+// EmailChangeSettings configures self-service email changes. The token is
+// appended to ConfirmURL and CancelURL as a "token" query parameter.
+type EmailChangeSettings struct {
+	TokenTTL   time.Duration
+	ConfirmURL string
+	CancelURL  string
+}
+
+type RequestEmailChangeCommand struct {
+	UserID   uuid.UUID
+	NewEmail string
+}
+
+type RequestEmailChangeHandler struct {
+	userRepository        user.Repository
+	emailChangeRepository user.EmailChangeRepository
+	preferencesRepository user.PreferencesRepository
+	mailer                shared.Mailer
+	eventBus              shared.EventBus
+	logger                logger.Logger
+	settings              EmailChangeSettings
+}
+
+func NewRequestEmailChangeHandler(
+	userRepository user.Repository,
+	emailChangeRepository user.EmailChangeRepository,
+	preferencesRepository user.PreferencesRepository,
+	mailer shared.Mailer,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	settings EmailChangeSettings,
+) *RequestEmailChangeHandler {
+	return &RequestEmailChangeHandler{
+		userRepository:        userRepository,
+		emailChangeRepository: emailChangeRepository,
+		preferencesRepository: preferencesRepository,
+		mailer:                mailer,
+		eventBus:              eventBus,
+		logger:                logger,
+		settings:              settings,
+	}
+}
+
+// Handle records the new address as pending, replacing any earlier request,
+// and mails a confirmation link to the new address and a cancel link to the
+// current one. The user's email is left unchanged until confirmation. Both
+// mails are written in the request's locale and show the expiry in the
+// user's time zone and date format; they are sent whatever the user's
+// notification settings, since they guard the account.
+func (handler *RequestEmailChangeHandler) Handle(ctx context.Context, command RequestEmailChangeCommand) (*userdto.EmailChangeDTO, error) {
+	existingUser, err := handler.userRepository.FindByID(ctx, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	newEmail, err := shared.NewEmail(command.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if newEmail.Equals(existingUser.Email()) {
+		return nil, user.ErrEmailUnchanged
+	}
+
+	exists, err := handler.userRepository.ExistsByEmail(ctx, newEmail.String())
+	if err != nil {
+		return nil, fmt.Errorf("check email exists: %w", err)
+	}
+	if exists {
+		return nil, user.NewEmailAlreadyExistsError(newEmail.String())
+	}
+
+	confirmToken, err := generateEmailChangeToken()
+	if err != nil {
+		return nil, err
+	}
+	cancelToken, err := generateEmailChangeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := user.NewEmailChange(user.NewEmailChangeParams{
+		UserID:           existingUser.ID(),
+		NewEmail:         newEmail.String(),
+		ConfirmTokenHash: hashEmailChangeToken(confirmToken),
+		CancelTokenHash:  hashEmailChangeToken(cancelToken),
+		ExpiresAt:        time.Now().UTC().Add(handler.settings.TokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.emailChangeRepository.Save(ctx, change); err != nil {
+		return nil, fmt.Errorf("save email change: %w", err)
+	}
+
+	preferences := recipientPreferences(ctx, handler.preferencesRepository, handler.logger, existingUser.ID())
+	locale := recipientLocale(preferences, i18n.FromContext(ctx))
+
+	if err := handler.mailer.Send(ctx, shared.MailMessage{
+		To:      newEmail.String(),
+		Subject: i18n.Translate(locale, emailChangeConfirmSubject, nil),
+		Body: i18n.Translate(locale, emailChangeConfirmBody, i18n.Params{
+			"name":    existingUser.FullName().String(),
+			"expires": preferences.FormatDateTime(change.ExpiresAt()),
+			"link":    emailChangeLink(handler.settings.ConfirmURL, confirmToken),
+		}),
+	}); err != nil {
+		return nil, fmt.Errorf("send confirmation email: %w", err)
+	}
+
+	if err := handler.mailer.Send(ctx, shared.MailMessage{
+		To:      existingUser.Email().String(),
+		Subject: i18n.Translate(locale, emailChangeNoticeSubject, nil),
+		Body: i18n.Translate(locale, emailChangeNoticeBody, i18n.Params{
+			"name":      existingUser.FullName().String(),
+			"new_email": newEmail.String(),
+			"link":      emailChangeLink(handler.settings.CancelURL, cancelToken),
+		}),
+	}); err != nil {
+		return nil, fmt.Errorf("send email change notice: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := user.NewEmailChangeRequestedEvent(existingUser.ID(), newEmail.String(), change.ExpiresAt())
+		if err := handler.eventBus.Publish(ctx, event); err != nil {
+			handler.logger.Error("failed to publish email change requested event",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("email change requested",
+		logger.String("user_id", existingUser.ID().String()),
+	)
+
+	return userdto.EmailChangeFromDomain(change), nil
+}
+
+func generateEmailChangeToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func hashEmailChangeToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func emailChangeLink(baseURL, token string) string {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(token)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
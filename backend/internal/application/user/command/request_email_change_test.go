@@ -0,0 +1,179 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+var testEmailChangeSettings = EmailChangeSettings{
+	TokenTTL:   time.Hour,
+	ConfirmURL: "https://app.example.com/email-change/confirm",
+	CancelURL:  "https://app.example.com/email-change/cancel?source=mail",
+}
+
+var emailChangeTokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// mailedEmailChangeToken pulls the token out of the link in a mailed body.
+func mailedEmailChangeToken(t *testing.T, body string) string {
+	t.Helper()
+	match := emailChangeTokenPattern.FindStringSubmatch(body)
+	require.Len(t, match, 2, "mail body contains a token link")
+	return match[1]
+}
+
+func TestRequestEmailChangeHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	type mocks struct {
+		userRepo     *testutil.MockUserRepository
+		emailChanges *testutil.MockEmailChangeRepository
+		preferences  *testutil.MockPreferencesRepository
+		mailer       *testutil.MockMailer
+		eventBus     *testutil.MockEventBus
+	}
+
+	tests := []struct {
+		name        string
+		newEmail    string
+		setup       func(*testing.T, mocks)
+		wantErr     error
+		errContains string
+		checkResult func(*testing.T, mocks, *user.User)
+	}{
+		{
+			name:     "stores the pending address and mails both addresses",
+			newEmail: "New@Example.com",
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				assert.Equal(t, "test@example.com", u.Email().String(), "email is unchanged until confirmed")
+
+				change, ok := m.emailChanges.Changes[u.ID()]
+				require.True(t, ok)
+				assert.Equal(t, "new@example.com", change.NewEmail().String())
+
+				require.Len(t, m.mailer.Messages, 2)
+				confirmation, notice := m.mailer.Messages[0], m.mailer.Messages[1]
+				assert.Equal(t, "new@example.com", confirmation.To)
+				assert.Contains(t, confirmation.Body, testEmailChangeSettings.ConfirmURL+"?token=")
+				assert.Equal(t, hashEmailChangeToken(mailedEmailChangeToken(t, confirmation.Body)), change.ConfirmTokenHash())
+				assert.Equal(t, "test@example.com", notice.To)
+				assert.Contains(t, notice.Body, "source=mail")
+				assert.Equal(t, hashEmailChangeToken(mailedEmailChangeToken(t, notice.Body)), change.CancelTokenHash())
+
+				require.Len(t, m.eventBus.PublishedEvents, 1)
+				assert.Equal(t, user.EventTypeEmailChangeRequested, m.eventBus.PublishedEvents[0].EventType())
+			},
+		},
+		{
+			name:     "writes the mails in the user's locale and date format",
+			newEmail: "new@example.com",
+			setup: func(t *testing.T, m mocks) {
+				for _, u := range m.userRepo.Users {
+					locale, timezone, dateFormat := i18n.Vietnamese, "Asia/Ho_Chi_Minh", "dmy"
+					preferences := user.DefaultPreferences(u.ID())
+					require.NoError(t, preferences.Update(user.UpdatePreferencesParams{
+						Locale:     &locale,
+						Timezone:   &timezone,
+						DateFormat: &dateFormat,
+					}))
+					require.NoError(t, m.preferences.Save(ctx, preferences))
+				}
+			},
+			checkResult: func(t *testing.T, m mocks, u *user.User) {
+				require.Len(t, m.mailer.Messages, 2)
+				confirmation, notice := m.mailer.Messages[0], m.mailer.Messages[1]
+				assert.Equal(t, "Xác nhận địa chỉ email mới của bạn", confirmation.Subject)
+				assert.Contains(t, confirmation.Body, "Xin chào Test User")
+				assert.Regexp(t, `trước \d{2}/\d{2}/\d{4} \d{2}:\d{2} \+07`, confirmation.Body)
+				assert.Equal(t, "Địa chỉ email của bạn đang được thay đổi", notice.Subject)
+				assert.Contains(t, notice.Body, "new@example.com")
+			},
+		},
+		{
+			name:     "rejects the current address",
+			newEmail: "TEST@example.com",
+			wantErr:  user.ErrEmailUnchanged,
+		},
+		{
+			name:     "rejects an address used by another user",
+			newEmail: "taken@example.com",
+			setup: func(t *testing.T, m mocks) {
+				other, err := user.NewUser(user.NewUserParams{
+					Email:        "taken@example.com",
+					PasswordHash: "$2a$10$hashedpassword",
+					FullName:     "Other User",
+				})
+				require.NoError(t, err)
+				m.userRepo.AddUser(other)
+			},
+			errContains: "already exists",
+		},
+		{
+			name:        "rejects an invalid address",
+			newEmail:    "not-an-email",
+			errContains: "invalid email format",
+		},
+		{
+			name:     "fails when the confirmation cannot be mailed",
+			newEmail: "new@example.com",
+			setup: func(t *testing.T, m mocks) {
+				m.mailer.SendError = errors.New("smtp unavailable")
+			},
+			errContains: "send confirmation email",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				userRepo:     testutil.NewMockUserRepository(),
+				emailChanges: testutil.NewMockEmailChangeRepository(),
+				preferences:  testutil.NewMockPreferencesRepository(),
+				mailer:       testutil.NewMockMailer(),
+				eventBus:     testutil.NewMockEventBus(),
+			}
+			testUser, err := user.NewUser(user.NewUserParams{
+				Email:        "test@example.com",
+				PasswordHash: "$2a$10$hashedpassword",
+				FullName:     "Test User",
+			})
+			require.NoError(t, err)
+			m.userRepo.AddUser(testUser)
+			if tt.setup != nil {
+				tt.setup(t, m)
+			}
+
+			handler := NewRequestEmailChangeHandler(m.userRepo, m.emailChanges, m.preferences, m.mailer, m.eventBus, testutil.NewNoopLogger(), testEmailChangeSettings)
+
+			result, err := handler.Handle(ctx, RequestEmailChangeCommand{UserID: testUser.ID(), NewEmail: tt.newEmail})
+
+			if tt.wantErr != nil || tt.errContains != "" {
+				require.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				assert.Empty(t, m.eventBus.PublishedEvents)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, "new@example.com", result.NewEmail)
+			assert.WithinDuration(t, time.Now().Add(testEmailChangeSettings.TokenTTL), result.ExpiresAt, time.Minute)
+			if tt.checkResult != nil {
+				tt.checkResult(t, m, testUser)
+			}
+		})
+	}
+}
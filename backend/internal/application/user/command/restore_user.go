@@ -0,0 +1,79 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type RestoreUserCommand struct {
+	UserID  uuid.UUID
+	ActorID *uuid.UUID
+}
+
+type RestoreUserHandler struct {
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewRestoreUserHandler(
+	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *RestoreUserHandler {
+	return &RestoreUserHandler{
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+func (handler *RestoreUserHandler) Handle(context context.Context, command RestoreUserCommand) (*userdto.UserDTO, error) {
+	existingUser, err := handler.userRepository.FindByIDIncludingDeleted(context, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	if err := existingUser.Restore(command.ActorID); err != nil {
+		return nil, err
+	}
+
+	if err := handler.userRepository.Restore(context, existingUser); err != nil {
+		return nil, fmt.Errorf("restore user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user restored successfully",
+		logger.String("user_id", existingUser.ID().String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
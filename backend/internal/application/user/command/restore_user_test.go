@@ -0,0 +1,102 @@
+package usercommand
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestRestoreUserHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	createUser := func(t *testing.T, email string) *user.User {
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        email,
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Test User",
+		})
+		require.NoError(t, err)
+		testUser.ClearDomainEvents()
+		return testUser
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testing.T, *testutil.MockUserRepository, *user.User)
+		wantErr     bool
+		errIs       error
+		checkResult func(*testing.T, *testutil.MockEventBus, *user.User)
+	}{
+		{
+			name: "restores a soft-deleted user",
+			setupMocks: func(t *testing.T, userRepo *testutil.MockUserRepository, u *user.User) {
+				require.NoError(t, u.Delete())
+				u.ClearDomainEvents()
+			},
+			checkResult: func(t *testing.T, eventBus *testutil.MockEventBus, u *user.User) {
+				assert.False(t, u.IsDeleted())
+				require.Len(t, eventBus.PublishedEvents, 1)
+				assert.Equal(t, user.EventTypeUserRestored, eventBus.PublishedEvents[0].EventType())
+			},
+		},
+		{
+			name:    "rejects a user that is not deleted",
+			wantErr: true,
+			errIs:   user.ErrUserNotDeleted,
+		},
+		{
+			name: "rejects an erased user",
+			setupMocks: func(t *testing.T, userRepo *testutil.MockUserRepository, u *user.User) {
+				require.NoError(t, u.Erase())
+			},
+			wantErr: true,
+			errIs:   user.ErrErasedUserNotRestorable,
+		},
+		{
+			name: "rejects when the email was registered again",
+			setupMocks: func(t *testing.T, userRepo *testutil.MockUserRepository, u *user.User) {
+				require.NoError(t, u.Delete())
+				userRepo.AddUser(createUser(t, u.Email().String()))
+			},
+			wantErr: true,
+			errIs:   user.ErrEmailAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := testutil.NewMockUserRepository()
+			eventBus := testutil.NewMockEventBus()
+
+			testUser := createUser(t, "restore@example.com")
+			userRepo.AddUser(testUser)
+			if tt.setupMocks != nil {
+				tt.setupMocks(t, userRepo, testUser)
+			}
+
+			handler := NewRestoreUserHandler(userRepo, authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, testutil.NewNoopLogger())
+
+			result, err := handler.Handle(ctx, RestoreUserCommand{UserID: testUser.ID()})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.errIs)
+				assert.Nil(t, result)
+				assert.Empty(t, eventBus.PublishedEvents)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Nil(t, result.DeletedAt)
+			if tt.checkResult != nil {
+				tt.checkResult(t, eventBus, testUser)
+			}
+		})
+	}
+}
@@ -7,31 +7,40 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type RevokeRoleFromUserCommand struct {
-	UserID uuid.UUID
-	RoleID uuid.UUID
+	UserID  uuid.UUID
+	RoleID  uuid.UUID
+	ActorID *uuid.UUID
 }
 
 type RevokeRoleFromUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository   user.Repository
+	roleRepository   role.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
 }
 
 func NewRevokeRoleFromUserHandler(
 	userRepository user.Repository,
+	roleRepository role.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *RevokeRoleFromUserHandler {
 	return &RevokeRoleFromUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:   userRepository,
+		roleRepository:   roleRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
 	}
 }
 
@@ -41,6 +50,22 @@ func (handler *RevokeRoleFromUserHandler) Handle(context context.Context, comman
 		return nil, err
 	}
 
+	revokedRole, err := handler.roleRepository.FindByID(context, command.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageRole(revokedRole); err != nil {
+		return nil, err
+	}
+
 	if err := existingUser.RevokeRole(command.RoleID); err != nil {
 		return nil, err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
@@ -93,6 +94,7 @@ func TestRevokeRoleFromUserHandler_Handle(t *testing.T) {
 		{
 			name: "fail when role not assigned to user",
 			setupMocks: func(userRepo *testutil.MockUserRepository, roleRepo *testutil.MockRoleRepository, eventBus *testutil.MockEventBus) *user.User {
+				roleRepo.AddRole(testRole)
 				testUser := createTestUserWithoutRole()
 				userRepo.AddUser(testUser)
 				return testUser
@@ -117,7 +119,7 @@ func TestRevokeRoleFromUserHandler_Handle(t *testing.T) {
 
 			testUser := tt.setupMocks(userRepo, roleRepo, eventBus)
 
-			handler := NewRevokeRoleFromUserHandler(userRepo, eventBus, logger)
+			handler := NewRevokeRoleFromUserHandler(userRepo, roleRepo, authz.NewDelegationPolicy(userRepo, roleRepo, nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -7,35 +7,49 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type SetUserRolesCommand struct {
-	UserID  uuid.UUID
-	RoleIDs []uuid.UUID
+	UserID          uuid.UUID
+	RoleIDs         []uuid.UUID
+	ActorID         *uuid.UUID
+	ExpectedVersion *int64
 }
 
 type SetUserRolesHandler struct {
-	userRepository user.Repository
-	roleRepository role.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	organizationRepository organization.Repository
+	delegationPolicy       *authz.DelegationPolicy
+	sodChecker             *sod.Checker
+	eventBus               shared.EventBus
+	logger                 logger.Logger
 }
 
 func NewSetUserRolesHandler(
 	userRepository user.Repository,
 	roleRepository role.Repository,
+	organizationRepository organization.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	sodChecker *sod.Checker,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *SetUserRolesHandler {
 	return &SetUserRolesHandler{
-		userRepository: userRepository,
-		roleRepository: roleRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		delegationPolicy:       delegationPolicy,
+		sodChecker:             sodChecker,
+		eventBus:               eventBus,
+		logger:                 logger,
 	}
 }
 
@@ -45,6 +59,36 @@ func (handler *SetUserRolesHandler) Handle(context context.Context, command SetU
 		return nil, err
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	if err := existingUser.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	addedRoleIDs, removedRoleIDs := roleChanges(existingUser.RoleIDs(), command.RoleIDs)
+	if len(addedRoleIDs) > 0 {
+		if err := delegator.EnsureCanGrantTo(existingUser); err != nil {
+			return nil, err
+		}
+	}
+	if len(addedRoleIDs)+len(removedRoleIDs) > 0 {
+		changedRoles, err := handler.roleRepository.FindByIDs(context, append(addedRoleIDs, removedRoleIDs...))
+		if err != nil {
+			return nil, fmt.Errorf("load changed roles: %w", err)
+		}
+		for _, changedRole := range changedRoles {
+			if err := delegator.EnsureCanManageRole(changedRole); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if len(command.RoleIDs) > 0 {
 		roles, err := handler.roleRepository.FindByIDs(context, command.RoleIDs)
 		if err != nil {
@@ -53,10 +97,19 @@ func (handler *SetUserRolesHandler) Handle(context context.Context, command SetU
 		if len(roles) != len(command.RoleIDs) {
 			return nil, role.ErrRoleNotFound
 		}
+		for _, assignedRole := range roles {
+			if err := ensureRoleWithinMembership(context, handler.organizationRepository, existingUser.ID(), assignedRole); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	existingUser.SetRoles(command.RoleIDs)
 
+	if err := handler.sodChecker.CheckUser(context, existingUser, addedRoleIDs...); err != nil {
+		return nil, err
+	}
+
 	if err := handler.userRepository.Update(context, existingUser); err != nil {
 		return nil, fmt.Errorf("update user: %w", err)
 	}
@@ -78,3 +131,29 @@ func (handler *SetUserRolesHandler) Handle(context context.Context, command SetU
 
 	return userdto.UserFromDomain(existingUser), nil
 }
+
+func roleChanges(currentRoleIDs, desiredRoleIDs []uuid.UUID) ([]uuid.UUID, []uuid.UUID) {
+	current := make(map[uuid.UUID]bool, len(currentRoleIDs))
+	for _, roleID := range currentRoleIDs {
+		current[roleID] = true
+	}
+	desired := make(map[uuid.UUID]bool, len(desiredRoleIDs))
+	for _, roleID := range desiredRoleIDs {
+		desired[roleID] = true
+	}
+
+	added := make([]uuid.UUID, 0)
+	for roleID := range desired {
+		if !current[roleID] {
+			added = append(added, roleID)
+		}
+	}
+	removed := make([]uuid.UUID, 0)
+	for roleID := range current {
+		if !desired[roleID] {
+			removed = append(removed, roleID)
+		}
+	}
+
+	return added, removed
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -174,7 +176,7 @@ func TestSetUserRolesHandler_Handle(t *testing.T) {
 
 			testUser := tt.setupMocks(userRepo, roleRepo, eventBus)
 
-			handler := NewSetUserRolesHandler(userRepo, roleRepo, eventBus, logger)
+			handler := NewSetUserRolesHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -194,3 +196,24 @@ func TestSetUserRolesHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestSetUserRolesHandler_Handle_ChecksExpectedVersion(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	viewerRole, err := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+	require.NoError(t, err)
+	roleRepo.AddRole(viewerRole)
+	testUser := testutil.CreateActiveUser()
+	userRepo.AddUser(testUser)
+
+	handler := NewSetUserRolesHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), authz.NewDelegationPolicy(userRepo, roleRepo, nil), sod.NewChecker(testutil.NewMockSoDRuleRepository(), userRepo, nil), testutil.NewMockEventBus(), testutil.NewNoopLogger())
+
+	current := testUser.Version()
+	result, err := handler.Handle(context.Background(), SetUserRolesCommand{UserID: testUser.ID(), RoleIDs: []uuid.UUID{viewerRole.ID()}, ExpectedVersion: &current})
+	require.NoError(t, err)
+	assert.Equal(t, current+1, result.Version)
+
+	_, err = handler.Handle(context.Background(), SetUserRolesCommand{UserID: testUser.ID(), RoleIDs: []uuid.UUID{}, ExpectedVersion: &current})
+	assert.ErrorIs(t, err, user.ErrConcurrentModification)
+	assert.Equal(t, []uuid.UUID{viewerRole.ID()}, testUser.RoleIDs())
+}
@@ -0,0 +1,93 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type SuspendUserCommand struct {
+	UserID  uuid.UUID
+	Until   time.Time
+	Reason  string
+	ActorID *uuid.UUID
+}
+
+// SuspendUserHandler locks a user out until a given time. Suspensions
+// longer than maxDuration are rejected; anything meant to last longer is
+// a ban.
+type SuspendUserHandler struct {
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+	maxDuration      time.Duration
+}
+
+func NewSuspendUserHandler(
+	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	maxDuration time.Duration,
+) *SuspendUserHandler {
+	return &SuspendUserHandler{
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+		maxDuration:      maxDuration,
+	}
+}
+
+func (handler *SuspendUserHandler) Handle(context context.Context, command SuspendUserCommand) (*userdto.UserDTO, error) {
+	if handler.maxDuration > 0 && command.Until.After(time.Now().Add(handler.maxDuration)) {
+		return nil, shared.NewValidationError("until", fmt.Sprintf("must be within %s from now", handler.maxDuration))
+	}
+
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	if err := existingUser.Suspend(command.Until, command.Reason, command.ActorID); err != nil {
+		return nil, fmt.Errorf("suspend user: %w", err)
+	}
+
+	if err := handler.userRepository.Update(context, existingUser); err != nil {
+		return nil, fmt.Errorf("save user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user suspended successfully",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.Time("until", command.Until),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
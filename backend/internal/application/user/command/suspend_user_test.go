@@ -0,0 +1,76 @@
+package usercommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestSuspendUserHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	maxDuration := 30 * 24 * time.Hour
+
+	setup := func(t *testing.T) (*testutil.MockUserRepository, *testutil.MockEventBus, *SuspendUserHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		eventBus := testutil.NewMockEventBus()
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "test@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Test User",
+		})
+		require.NoError(t, err)
+		require.NoError(t, testUser.Activate())
+		testUser.ClearDomainEvents()
+		userRepo.AddUser(testUser)
+
+		policy := authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil)
+		handler := NewSuspendUserHandler(userRepo, policy, eventBus, testutil.NewNoopLogger(), maxDuration)
+		return userRepo, eventBus, handler, testUser
+	}
+
+	t.Run("suspends an active user", func(t *testing.T) {
+		userRepo, eventBus, handler, testUser := setup(t)
+		until := time.Now().Add(24 * time.Hour)
+
+		result, err := handler.Handle(ctx, SuspendUserCommand{UserID: testUser.ID(), Until: until, Reason: "spam"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "suspended", result.Status)
+		require.NotNil(t, result.SuspendedUntil)
+		assert.WithinDuration(t, until, *result.SuspendedUntil, time.Second)
+		assert.Equal(t, "spam", result.SuspensionReason)
+
+		saved, _ := userRepo.FindByID(ctx, testUser.ID())
+		assert.Equal(t, user.StatusSuspended, saved.Status())
+		require.Len(t, eventBus.PublishedEvents, 1)
+		assert.Equal(t, user.EventTypeUserSuspended, eventBus.PublishedEvents[0].EventType())
+	})
+
+	t.Run("rejects a suspension longer than the maximum", func(t *testing.T) {
+		_, eventBus, handler, testUser := setup(t)
+
+		_, err := handler.Handle(ctx, SuspendUserCommand{UserID: testUser.ID(), Until: time.Now().Add(maxDuration + time.Hour), Reason: "spam"})
+
+		var validationErr *shared.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "until", validationErr.Field)
+		assert.Empty(t, eventBus.PublishedEvents)
+	})
+
+	t.Run("rejects a banned user", func(t *testing.T) {
+		_, _, handler, testUser := setup(t)
+		require.NoError(t, testUser.Ban("abuse"))
+
+		_, err := handler.Handle(ctx, SuspendUserCommand{UserID: testUser.ID(), Until: time.Now().Add(time.Hour), Reason: "spam"})
+
+		assert.ErrorIs(t, err, user.ErrUserIsBanned)
+	})
+}
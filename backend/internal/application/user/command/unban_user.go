@@ -0,0 +1,82 @@
+package usercommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UnbanUserCommand struct {
+	UserID        uuid.UUID
+	Justification string
+	ActorID       *uuid.UUID
+}
+
+type UnbanUserHandler struct {
+	userRepository   user.Repository
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+}
+
+func NewUnbanUserHandler(
+	userRepository user.Repository,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *UnbanUserHandler {
+	return &UnbanUserHandler{
+		userRepository:   userRepository,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+	}
+}
+
+func (handler *UnbanUserHandler) Handle(context context.Context, command UnbanUserCommand) (*userdto.UserDTO, error) {
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	previousStatus := existingUser.Status()
+	if err := existingUser.Unban(command.Justification, command.ActorID); err != nil {
+		return nil, fmt.Errorf("unban user: %w", err)
+	}
+
+	if err := handler.userRepository.Update(context, existingUser); err != nil {
+		return nil, fmt.Errorf("save user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user unbanned successfully",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.String("previous_status", previousStatus.String()),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
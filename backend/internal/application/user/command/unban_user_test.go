@@ -0,0 +1,83 @@
+package usercommand
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestUnbanUserHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*testutil.MockUserRepository, *testutil.MockEventBus, *UnbanUserHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		eventBus := testutil.NewMockEventBus()
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "test@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Test User",
+		})
+		require.NoError(t, err)
+		require.NoError(t, testUser.Activate())
+		userRepo.AddUser(testUser)
+
+		policy := authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil)
+		handler := NewUnbanUserHandler(userRepo, policy, eventBus, testutil.NewNoopLogger())
+		return userRepo, eventBus, handler, testUser
+	}
+
+	t.Run("lifts a ban", func(t *testing.T) {
+		userRepo, eventBus, handler, testUser := setup(t)
+		require.NoError(t, testUser.Ban("abuse"))
+		testUser.ClearDomainEvents()
+
+		result, err := handler.Handle(ctx, UnbanUserCommand{UserID: testUser.ID(), Justification: "appeal upheld"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "active", result.Status)
+		saved, _ := userRepo.FindByID(ctx, testUser.ID())
+		assert.Equal(t, user.StatusActive, saved.Status())
+		require.Len(t, eventBus.PublishedEvents, 1)
+		event, ok := eventBus.PublishedEvents[0].(user.UserUnbannedEvent)
+		require.True(t, ok)
+		assert.Equal(t, user.StatusBanned, event.PreviousStatus)
+		assert.Equal(t, "appeal upheld", event.Justification)
+	})
+
+	t.Run("ends a suspension early", func(t *testing.T) {
+		_, _, handler, testUser := setup(t)
+		require.NoError(t, testUser.Suspend(time.Now().Add(time.Hour), "spam", nil))
+
+		result, err := handler.Handle(ctx, UnbanUserCommand{UserID: testUser.ID(), Justification: "mistake"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "active", result.Status)
+		assert.Nil(t, result.SuspendedUntil)
+	})
+
+	t.Run("requires a justification", func(t *testing.T) {
+		_, eventBus, handler, testUser := setup(t)
+		require.NoError(t, testUser.Ban("abuse"))
+		testUser.ClearDomainEvents()
+
+		_, err := handler.Handle(ctx, UnbanUserCommand{UserID: testUser.ID(), Justification: "  "})
+
+		assert.ErrorIs(t, err, user.ErrUnbanJustificationRequired)
+		assert.Empty(t, eventBus.PublishedEvents)
+	})
+
+	t.Run("rejects a user that is not banned", func(t *testing.T) {
+		_, _, handler, testUser := setup(t)
+
+		_, err := handler.Handle(ctx, UnbanUserCommand{UserID: testUser.ID(), Justification: "appeal upheld"})
+
+		assert.ErrorIs(t, err, user.ErrUserNotBanned)
+	})
+}
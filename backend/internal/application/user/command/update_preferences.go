@@ -0,0 +1,98 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// UpdatePreferencesCommand leaves a setting unchanged when its field is nil.
+// An empty Locale makes responses follow Accept-Language again.
+type UpdatePreferencesCommand struct {
+	UserID         uuid.UUID
+	Locale         *string
+	Timezone       *string
+	DateFormat     *string
+	AccountUpdates *bool
+}
+
+type UpdatePreferencesHandler struct {
+	preferencesRepository user.PreferencesRepository
+	logger                logger.Logger
+}
+
+func NewUpdatePreferencesHandler(
+	preferencesRepository user.PreferencesRepository,
+	logger logger.Logger,
+) *UpdatePreferencesHandler {
+	return &UpdatePreferencesHandler{
+		preferencesRepository: preferencesRepository,
+		logger:                logger,
+	}
+}
+
+func (handler *UpdatePreferencesHandler) Handle(ctx context.Context, command UpdatePreferencesCommand) (*userdto.PreferencesDTO, error) {
+	if command.Locale != nil && *command.Locale != "" && !i18n.IsSupported(*command.Locale) {
+		return nil, user.ErrUnsupportedLocale
+	}
+
+	preferences, err := handler.preferencesRepository.FindByUserID(ctx, command.UserID)
+	if errors.Is(err, user.ErrPreferencesNotFound) {
+		preferences = user.DefaultPreferences(command.UserID)
+	} else if err != nil {
+		return nil, fmt.Errorf("find preferences: %w", err)
+	}
+
+	if err := preferences.Update(user.UpdatePreferencesParams{
+		Locale:         command.Locale,
+		Timezone:       command.Timezone,
+		DateFormat:     command.DateFormat,
+		AccountUpdates: command.AccountUpdates,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := handler.preferencesRepository.Save(ctx, preferences); err != nil {
+		return nil, fmt.Errorf("save preferences: %w", err)
+	}
+
+	handler.logger.Info("user preferences updated",
+		logger.String("user_id", command.UserID.String()),
+	)
+
+	return userdto.PreferencesFromDomain(preferences), nil
+}
+
+// recipientPreferences returns the preferences mails to a user are rendered
+// with. A failed lookup falls back to the defaults rather than holding back
+// the mail.
+func recipientPreferences(ctx context.Context, repository user.PreferencesRepository, log logger.Logger, userID uuid.UUID) *user.Preferences {
+	preferences, err := repository.FindByUserID(ctx, userID)
+	if err == nil {
+		return preferences
+	}
+	if !errors.Is(err, user.ErrPreferencesNotFound) {
+		log.Error("failed to load preferences, mailing with defaults",
+			logger.String("user_id", userID.String()),
+			logger.Err(err),
+		)
+	}
+	return user.DefaultPreferences(userID)
+}
+
+// recipientLocale is the locale the recipient saved, or fallback when they
+// follow Accept-Language. Mails a user triggers themselves fall back to the
+// request's locale; mails an administrator triggers fall back to the default.
+func recipientLocale(preferences *user.Preferences, fallback string) string {
+	if preferences.Locale() != "" {
+		return preferences.Locale()
+	}
+	return fallback
+}
@@ -0,0 +1,135 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestUpdatePreferencesHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	stringPtr := func(value string) *string { return &value }
+	boolPtr := func(value bool) *bool { return &value }
+
+	tests := []struct {
+		name        string
+		command     func(uuid.UUID) UpdatePreferencesCommand
+		setup       func(*testing.T, *testutil.MockPreferencesRepository, uuid.UUID)
+		wantErr     error
+		errContains string
+		checkResult func(*testing.T, *testutil.MockPreferencesRepository, uuid.UUID)
+	}{
+		{
+			name: "saves preferences over the defaults",
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{
+					UserID:     userID,
+					Locale:     stringPtr("vi"),
+					Timezone:   stringPtr("Asia/Ho_Chi_Minh"),
+					DateFormat: stringPtr("dmy"),
+				}
+			},
+			checkResult: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				saved, ok := repository.Preferences[userID]
+				require.True(t, ok)
+				assert.Equal(t, "vi", saved.Locale())
+				assert.Equal(t, "Asia/Ho_Chi_Minh", saved.Timezone())
+				assert.Equal(t, user.DateFormatDMY, saved.DateFormat())
+				assert.True(t, saved.Notifications().AccountUpdates)
+			},
+		},
+		{
+			name: "keeps settings that are not given",
+			setup: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				preferences := user.DefaultPreferences(userID)
+				require.NoError(t, preferences.Update(user.UpdatePreferencesParams{Locale: stringPtr("vi")}))
+				repository.Preferences[userID] = preferences
+			},
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{UserID: userID, AccountUpdates: boolPtr(false)}
+			},
+			checkResult: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				saved := repository.Preferences[userID]
+				assert.Equal(t, "vi", saved.Locale())
+				assert.False(t, saved.Notifications().AccountUpdates)
+			},
+		},
+		{
+			name: "clears the locale to follow Accept-Language",
+			setup: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				preferences := user.DefaultPreferences(userID)
+				require.NoError(t, preferences.Update(user.UpdatePreferencesParams{Locale: stringPtr("vi")}))
+				repository.Preferences[userID] = preferences
+			},
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{UserID: userID, Locale: stringPtr("")}
+			},
+			checkResult: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				assert.Empty(t, repository.Preferences[userID].Locale())
+			},
+		},
+		{
+			name: "rejects an unsupported locale",
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{UserID: userID, Locale: stringPtr("fr")}
+			},
+			wantErr: user.ErrUnsupportedLocale,
+		},
+		{
+			name: "rejects an unknown time zone",
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{UserID: userID, Timezone: stringPtr("Nowhere/City")}
+			},
+			wantErr: shared.NewValidationError("timezone", ""),
+		},
+		{
+			name: "fails when the preferences cannot be saved",
+			setup: func(t *testing.T, repository *testutil.MockPreferencesRepository, userID uuid.UUID) {
+				repository.SaveError = errors.New("database error")
+			},
+			command: func(userID uuid.UUID) UpdatePreferencesCommand {
+				return UpdatePreferencesCommand{UserID: userID, Locale: stringPtr("en")}
+			},
+			errContains: "save preferences",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := testutil.NewMockPreferencesRepository()
+			userID := uuid.New()
+			if tt.setup != nil {
+				tt.setup(t, repository, userID)
+			}
+
+			handler := NewUpdatePreferencesHandler(repository, testutil.NewNoopLogger())
+
+			result, err := handler.Handle(ctx, tt.command(userID))
+
+			if tt.wantErr != nil || tt.errContains != "" {
+				require.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.NotNil(t, result.UpdatedAt)
+			if tt.checkResult != nil {
+				tt.checkResult(t, repository, userID)
+			}
+		})
+	}
+}
@@ -7,31 +7,46 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type UpdateUserCommand struct {
 	UserID   uuid.UUID
 	FullName *string
+	// Attributes are merged into the existing ones; a nil value removes the
+	// attribute. A nil map leaves attributes untouched.
+	Attributes map[string]any
+	ActorID    *uuid.UUID
+	// ExpectedVersion, when set, only applies the update if the user is
+	// still at that version.
+	ExpectedVersion *int64
 }
 
 type UpdateUserHandler struct {
-	userRepository user.Repository
-	eventBus       shared.EventBus
-	logger         logger.Logger
+	userRepository      user.Repository
+	attributeRepository userattribute.Repository
+	delegationPolicy    *authz.DelegationPolicy
+	eventBus            shared.EventBus
+	logger              logger.Logger
 }
 
 func NewUpdateUserHandler(
 	userRepository user.Repository,
+	attributeRepository userattribute.Repository,
+	delegationPolicy *authz.DelegationPolicy,
 	eventBus shared.EventBus,
 	logger logger.Logger,
 ) *UpdateUserHandler {
 	return &UpdateUserHandler{
-		userRepository: userRepository,
-		eventBus:       eventBus,
-		logger:         logger,
+		userRepository:      userRepository,
+		attributeRepository: attributeRepository,
+		delegationPolicy:    delegationPolicy,
+		eventBus:            eventBus,
+		logger:              logger,
 	}
 }
 
@@ -41,12 +56,36 @@ func (handler *UpdateUserHandler) Handle(context context.Context, command Update
 		return nil, fmt.Errorf("find user: %w", err)
 	}
 
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	if err := existingUser.CheckVersion(command.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
 	if command.FullName != nil {
 		if err := existingUser.UpdateProfile(*command.FullName); err != nil {
 			return nil, fmt.Errorf("update profile: %w", err)
 		}
 	}
 
+	if command.Attributes != nil {
+		schema, err := userattribute.LoadSchema(context, handler.attributeRepository)
+		if err != nil {
+			return nil, err
+		}
+		attributes, err := schema.Apply(existingUser.Attributes(), command.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		existingUser.UpdateAttributes(attributes)
+	}
+
 	if err := handler.userRepository.Update(context, existingUser); err != nil {
 		return nil, fmt.Errorf("save user: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -108,7 +109,7 @@ func TestUpdateUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo, eventBus)
 
-			handler := NewUpdateUserHandler(userRepo, eventBus, logger)
+			handler := NewUpdateUserHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, logger)
 			cmd := tt.command(testUser)
 
 			result, err := handler.Handle(ctx, cmd)
@@ -127,3 +128,35 @@ func TestUpdateUserHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserHandler_UpdatesAttributes(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	eventBus := testutil.NewMockEventBus()
+	testUser, err := user.NewUser(user.NewUserParams{
+		Email:        "test@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Test User",
+		Attributes:   map[string]any{"department": "sales"},
+	})
+	require.NoError(t, err)
+	testUser.ClearDomainEvents()
+	userRepo.AddUser(testUser)
+
+	handler := NewUpdateUserHandler(userRepo, newDepartmentAttributeRepository(t, true), authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, testutil.NewNoopLogger())
+
+	result, err := handler.Handle(context.Background(), UpdateUserCommand{
+		UserID:     testUser.ID(),
+		Attributes: map[string]any{"department": "engineering"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"department": "engineering"}, result.Attributes)
+	require.Len(t, eventBus.PublishedEvents, 1)
+	assert.Equal(t, user.EventTypeProfileUpdated, eventBus.PublishedEvents[0].EventType())
+
+	_, err = handler.Handle(context.Background(), UpdateUserCommand{
+		UserID:     testUser.ID(),
+		Attributes: map[string]any{"department": nil},
+	})
+	require.Error(t, err, "required attributes cannot be removed")
+	assert.Equal(t, "engineering", testUser.Attributes()["department"])
+}
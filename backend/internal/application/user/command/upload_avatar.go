@@ -0,0 +1,174 @@
+package usercommand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/imaging"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// AvatarSize is a square rendition generated for every uploaded avatar.
+type AvatarSize struct {
+	Name   string
+	Pixels int
+}
+
+// AvatarSizes run from largest to smallest so that each rendition is scaled
+// down from the previous one instead of from the full upload.
+var AvatarSizes = []AvatarSize{
+	{Name: "large", Pixels: 512},
+	{Name: "medium", Pixels: 256},
+	{Name: "small", Pixels: 64},
+}
+
+type UploadAvatarCommand struct {
+	UserID  uuid.UUID
+	Content []byte
+	ActorID *uuid.UUID
+}
+
+type UploadAvatarHandler struct {
+	userRepository   user.Repository
+	blobStore        shared.BlobStore
+	delegationPolicy *authz.DelegationPolicy
+	eventBus         shared.EventBus
+	logger           logger.Logger
+	maxUploadSize    int64
+}
+
+func NewUploadAvatarHandler(
+	userRepository user.Repository,
+	blobStore shared.BlobStore,
+	delegationPolicy *authz.DelegationPolicy,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+	maxUploadSize int64,
+) *UploadAvatarHandler {
+	return &UploadAvatarHandler{
+		userRepository:   userRepository,
+		blobStore:        blobStore,
+		delegationPolicy: delegationPolicy,
+		eventBus:         eventBus,
+		logger:           logger,
+		maxUploadSize:    maxUploadSize,
+	}
+}
+
+func (handler *UploadAvatarHandler) Handle(context context.Context, command UploadAvatarCommand) (*userdto.UserDTO, error) {
+	if len(command.Content) == 0 {
+		return nil, shared.NewValidationError("avatar", "file is empty")
+	}
+	if handler.maxUploadSize > 0 && int64(len(command.Content)) > handler.maxUploadSize {
+		return nil, shared.NewValidationError("avatar", fmt.Sprintf("file must not exceed %d bytes", handler.maxUploadSize))
+	}
+
+	existingUser, err := handler.userRepository.FindByID(context, command.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+
+	delegator, err := handler.delegationPolicy.ForActor(context, command.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := delegator.EnsureCanManageUser(context, existingUser); err != nil {
+		return nil, err
+	}
+
+	source, format, err := imaging.Decode(command.Content)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat):
+			return nil, shared.NewValidationError("avatar", "file must be a JPEG, PNG or GIF image")
+		case errors.Is(err, imaging.ErrTooLarge):
+			return nil, shared.NewValidationError("avatar", "image dimensions are too large")
+		default:
+			return nil, shared.NewValidationError("avatar", "image could not be decoded")
+		}
+	}
+
+	// Photos stay JPEG; everything else becomes PNG to keep transparency.
+	outputFormat := imaging.FormatPNG
+	if format == imaging.FormatJPEG {
+		outputFormat = imaging.FormatJPEG
+	}
+
+	avatar := user.Avatar{
+		Key:       fmt.Sprintf("avatars/%s/%s", existingUser.ID(), uuid.New()),
+		Extension: outputFormat.Extension(),
+		URLs:      make(map[string]string, len(AvatarSizes)),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	var rendition = source
+	for _, size := range AvatarSizes {
+		rendition = imaging.Square(rendition, size.Pixels)
+		content, err := imaging.Encode(rendition, outputFormat)
+		if err != nil {
+			handler.removeAvatarBlobs(context, avatar)
+			return nil, fmt.Errorf("encode avatar: %w", err)
+		}
+
+		blobKey := avatar.BlobKey(size.Name)
+		if err := handler.blobStore.Put(context, blobKey, content, outputFormat.ContentType()); err != nil {
+			handler.removeAvatarBlobs(context, avatar)
+			return nil, fmt.Errorf("store avatar: %w", err)
+		}
+		avatar.URLs[size.Name] = handler.blobStore.URL(blobKey)
+	}
+
+	previous := existingUser.SetAvatar(avatar)
+
+	if err := handler.userRepository.Update(context, existingUser); err != nil {
+		handler.removeAvatarBlobs(context, avatar)
+		return nil, fmt.Errorf("save user: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, existingUser.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("user_id", existingUser.ID().String()),
+				logger.Err(err),
+			)
+		}
+		existingUser.ClearDomainEvents()
+	}
+
+	if previous != nil {
+		handler.removeAvatarBlobs(context, *previous)
+	}
+
+	handler.logger.Info("user avatar updated",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.String("avatar_key", avatar.Key),
+	)
+
+	return userdto.UserFromDomain(existingUser), nil
+}
+
+func (handler *UploadAvatarHandler) removeAvatarBlobs(context context.Context, avatar user.Avatar) {
+	removeAvatarBlobs(context, handler.blobStore, handler.logger, avatar)
+}
+
+// removeAvatarBlobs deletes the renditions of an avatar that is no longer
+// referenced. Failures only leave orphaned blobs behind, so they are logged
+// rather than returned.
+func removeAvatarBlobs(context context.Context, blobStore shared.BlobStore, log logger.Logger, avatar user.Avatar) {
+	for rendition := range avatar.URLs {
+		if err := blobStore.Delete(context, avatar.BlobKey(rendition)); err != nil {
+			log.Warn("failed to delete avatar blob",
+				logger.String("blob_key", avatar.BlobKey(rendition)),
+				logger.Err(err),
+			)
+		}
+	}
+}
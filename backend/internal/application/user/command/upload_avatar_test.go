@@ -0,0 +1,190 @@
+package usercommand
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+func TestUploadAvatarHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	validImage := encodeTestPNG(t, 600, 400)
+
+	createUser := func() *user.User {
+		testUser, _ := user.NewUser(user.NewUserParams{
+			Email:        "test@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Test User",
+		})
+		testUser.ClearDomainEvents()
+		return testUser
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*testutil.MockUserRepository, *testutil.MockBlobStore, *user.User)
+		content     []byte
+		wantErr     bool
+		errContains string
+		checkResult func(*testing.T, *testutil.MockBlobStore, *testutil.MockEventBus, *user.User)
+	}{
+		{
+			name:    "stores every rendition and records their URLs",
+			content: validImage,
+			checkResult: func(t *testing.T, blobStore *testutil.MockBlobStore, eventBus *testutil.MockEventBus, u *user.User) {
+				avatar := u.Avatar()
+				require.NotNil(t, avatar)
+				assert.Equal(t, "png", avatar.Extension)
+				assert.Len(t, blobStore.Objects, len(AvatarSizes))
+				for _, size := range AvatarSizes {
+					assert.Contains(t, blobStore.Objects, avatar.BlobKey(size.Name))
+					assert.Equal(t, blobStore.URL(avatar.BlobKey(size.Name)), avatar.URLs[size.Name])
+				}
+				assert.Len(t, eventBus.PublishedEvents, 1)
+			},
+		},
+		{
+			name:    "replaces the previous avatar and removes its blobs",
+			content: validImage,
+			setupMocks: func(userRepo *testutil.MockUserRepository, blobStore *testutil.MockBlobStore, u *user.User) {
+				previous := user.Avatar{Key: "avatars/old", Extension: "png", URLs: map[string]string{"small": "old"}}
+				blobStore.Objects[previous.BlobKey("small")] = []byte("old")
+				u.SetAvatar(previous)
+				u.ClearDomainEvents()
+			},
+			checkResult: func(t *testing.T, blobStore *testutil.MockBlobStore, eventBus *testutil.MockEventBus, u *user.User) {
+				assert.NotContains(t, blobStore.Objects, "avatars/old/small.png")
+				assert.Len(t, blobStore.Objects, len(AvatarSizes))
+			},
+		},
+		{
+			name:        "rejects empty file",
+			content:     nil,
+			wantErr:     true,
+			errContains: "empty",
+		},
+		{
+			name:        "rejects file over the size limit",
+			content:     make([]byte, 2<<20),
+			wantErr:     true,
+			errContains: "must not exceed",
+		},
+		{
+			name:        "rejects content that is not an image",
+			content:     []byte("%PDF-1.4 not an image"),
+			wantErr:     true,
+			errContains: "JPEG, PNG or GIF",
+		},
+		{
+			name:    "removes stored renditions when saving the user fails",
+			content: validImage,
+			setupMocks: func(userRepo *testutil.MockUserRepository, blobStore *testutil.MockBlobStore, u *user.User) {
+				userRepo.UpdateError = errors.New("database error")
+			},
+			wantErr:     true,
+			errContains: "save user",
+		},
+		{
+			name:    "fails when the blob store is unavailable",
+			content: validImage,
+			setupMocks: func(userRepo *testutil.MockUserRepository, blobStore *testutil.MockBlobStore, u *user.User) {
+				blobStore.PutError = errors.New("connection refused")
+			},
+			wantErr:     true,
+			errContains: "store avatar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := testutil.NewMockUserRepository()
+			blobStore := testutil.NewMockBlobStore()
+			eventBus := testutil.NewMockEventBus()
+			logger := testutil.NewNoopLogger()
+
+			testUser := createUser()
+			userRepo.AddUser(testUser)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(userRepo, blobStore, testUser)
+			}
+
+			handler := NewUploadAvatarHandler(userRepo, blobStore, authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, logger, 1<<20)
+
+			result, err := handler.Handle(ctx, UploadAvatarCommand{UserID: testUser.ID(), Content: tt.content})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				assert.Empty(t, blobStore.Objects)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Len(t, result.AvatarURLs, len(AvatarSizes))
+			if tt.checkResult != nil {
+				tt.checkResult(t, blobStore, eventBus, testUser)
+			}
+		})
+	}
+}
+
+func TestDeleteAvatarHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := testutil.NewMockUserRepository()
+	blobStore := testutil.NewMockBlobStore()
+	eventBus := testutil.NewMockEventBus()
+
+	testUser, _ := user.NewUser(user.NewUserParams{
+		Email:        "test@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Test User",
+	})
+	avatar := user.Avatar{Key: "avatars/current", Extension: "jpg", URLs: map[string]string{"small": "s", "large": "l"}}
+	testUser.SetAvatar(avatar)
+	testUser.ClearDomainEvents()
+	userRepo.AddUser(testUser)
+	blobStore.Objects[avatar.BlobKey("small")] = []byte("s")
+	blobStore.Objects[avatar.BlobKey("large")] = []byte("l")
+
+	handler := NewDeleteAvatarHandler(userRepo, blobStore, authz.NewDelegationPolicy(userRepo, testutil.NewMockRoleRepository(), nil), eventBus, testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, DeleteAvatarCommand{UserID: testUser.ID()})
+	require.NoError(t, err)
+	assert.Empty(t, result.AvatarURLs)
+	assert.Nil(t, testUser.Avatar())
+	assert.Empty(t, blobStore.Objects)
+	assert.Len(t, eventBus.PublishedEvents, 1)
+
+	result, err = handler.Handle(ctx, DeleteAvatarCommand{UserID: testUser.ID()})
+	require.NoError(t, err, "removing a missing avatar is a no-op")
+	assert.Empty(t, result.AvatarURLs)
+	assert.Len(t, eventBus.PublishedEvents, 1)
+}
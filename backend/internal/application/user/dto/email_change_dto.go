@@ -0,0 +1,22 @@
+package userdto
+
+import (
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+// EmailChangeDTO describes a pending email change without its tokens.
+type EmailChangeDTO struct {
+	NewEmail    string
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+}
+
+func EmailChangeFromDomain(change *user.EmailChange) *EmailChangeDTO {
+	return &EmailChangeDTO{
+		NewEmail:    change.NewEmail().String(),
+		RequestedAt: change.RequestedAt(),
+		ExpiresAt:   change.ExpiresAt(),
+	}
+}
@@ -0,0 +1,122 @@
+package userdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+// PersonalDataDTO is everything held about a user, as returned to a data
+// subject access request.
+type PersonalDataDTO struct {
+	GeneratedAt  time.Time                `json:"generated_at"`
+	Profile      *UserDTO                 `json:"profile"`
+	Roles        []PersonalDataRoleDTO    `json:"roles"`
+	Sessions     []PersonalDataSessionDTO `json:"sessions"`
+	AuditHistory []PersonalDataAuditDTO   `json:"audit_history"`
+}
+
+type PersonalDataRoleDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+}
+
+type PersonalDataSessionDTO struct {
+	ID             uuid.UUID  `json:"id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	Revoked        bool       `json:"revoked"`
+	IPAddress      string     `json:"ip_address,omitempty"`
+	UserAgent      string     `json:"user_agent,omitempty"`
+	Platform       string     `json:"platform,omitempty"`
+	Browser        string     `json:"browser,omitempty"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+}
+
+func PersonalDataSessionFromDomain(token *auth.RefreshToken) PersonalDataSessionDTO {
+	session := PersonalDataSessionDTO{
+		ID:             token.ID(),
+		CreatedAt:      token.CreatedAt(),
+		ExpiresAt:      token.ExpiresAt(),
+		LastUsedAt:     token.LastUsedAt(),
+		Revoked:        token.IsRevoked(),
+		OrganizationID: token.OrganizationID(),
+	}
+	if token.IPAddress() != nil {
+		session.IPAddress = token.IPAddress().String()
+	}
+	if deviceInfo := token.DeviceInfo(); deviceInfo != nil {
+		session.UserAgent = deviceInfo.UserAgent
+		session.Platform = deviceInfo.Platform
+		session.Browser = deviceInfo.Browser
+	}
+	return session
+}
+
+type PersonalDataAuditDTO struct {
+	ID            uuid.UUID      `json:"id"`
+	Timestamp     time.Time      `json:"timestamp"`
+	EventType     string         `json:"event_type"`
+	ActorID       *uuid.UUID     `json:"actor_id,omitempty"`
+	Action        string         `json:"action"`
+	ResourceType  string         `json:"resource_type,omitempty"`
+	ResourceID    string         `json:"resource_id,omitempty"`
+	IPAddress     string         `json:"ip_address,omitempty"`
+	UserAgent     string         `json:"user_agent,omitempty"`
+	Success       bool           `json:"success"`
+	FailureReason string         `json:"failure_reason,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+}
+
+func PersonalDataAuditFromDomain(record user.AuditRecord) PersonalDataAuditDTO {
+	return PersonalDataAuditDTO{
+		ID:            record.ID,
+		Timestamp:     record.Timestamp,
+		EventType:     record.EventType,
+		ActorID:       record.ActorID,
+		Action:        record.Action,
+		ResourceType:  record.ResourceType,
+		ResourceID:    record.ResourceID,
+		IPAddress:     record.IPAddress,
+		UserAgent:     record.UserAgent,
+		Success:       record.Success,
+		FailureReason: record.FailureReason,
+		Metadata:      record.Metadata,
+	}
+}
+
+// PersonalDataExportDTO is an encoded personal data bundle ready to download.
+type PersonalDataExportDTO struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+type ErasureReceiptDTO struct {
+	UserID                    uuid.UUID  `json:"user_id"`
+	ErasedAt                  time.Time  `json:"erased_at"`
+	ErasedBy                  *uuid.UUID `json:"erased_by,omitempty"`
+	RefreshTokensDeleted      int64      `json:"refresh_tokens_deleted"`
+	ResetTokensDeleted        int64      `json:"reset_tokens_deleted"`
+	InvitationsDeleted        int64      `json:"invitations_deleted"`
+	AuditEntriesPseudonymized int64      `json:"audit_entries_pseudonymized"`
+	AvatarRemoved             bool       `json:"avatar_removed"`
+}
+
+func ErasureReceiptFromDomain(receipt user.ErasureReceipt) *ErasureReceiptDTO {
+	return &ErasureReceiptDTO{
+		UserID:                    receipt.UserID,
+		ErasedAt:                  receipt.ErasedAt,
+		ErasedBy:                  receipt.ErasedBy,
+		RefreshTokensDeleted:      receipt.RefreshTokensDeleted,
+		ResetTokensDeleted:        receipt.ResetTokensDeleted,
+		InvitationsDeleted:        receipt.InvitationsDeleted,
+		AuditEntriesPseudonymized: receipt.AuditEntriesPseudonymized,
+		AvatarRemoved:             receipt.AvatarRemoved,
+	}
+}
@@ -0,0 +1,30 @@
+package userdto
+
+import (
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+// PreferencesDTO describes a user's preferences. UpdatedAt is nil while the
+// user still has the defaults.
+type PreferencesDTO struct {
+	Locale         string
+	Timezone       string
+	DateFormat     string
+	AccountUpdates bool
+	UpdatedAt      *time.Time
+}
+
+func PreferencesFromDomain(preferences *user.Preferences) *PreferencesDTO {
+	dto := &PreferencesDTO{
+		Locale:         preferences.Locale(),
+		Timezone:       preferences.Timezone(),
+		DateFormat:     preferences.DateFormat().String(),
+		AccountUpdates: preferences.Notifications().AccountUpdates,
+	}
+	if updatedAt := preferences.UpdatedAt(); !updatedAt.IsZero() {
+		dto.UpdatedAt = &updatedAt
+	}
+	return dto
+}
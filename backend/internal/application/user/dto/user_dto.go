@@ -10,27 +10,43 @@ import (
 )
 
 type UserDTO struct {
-	ID        uuid.UUID  `json:"id"`
-	Email     string     `json:"email"`
-	FullName  string     `json:"full_name"`
-	Status    string     `json:"status"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	ID               uuid.UUID         `json:"id"`
+	Email            string            `json:"email"`
+	FullName         string            `json:"full_name"`
+	Status           string            `json:"status"`
+	SuspendedUntil   *time.Time        `json:"suspended_until,omitempty"`
+	SuspensionReason string            `json:"suspension_reason,omitempty"`
+	Attributes       map[string]any    `json:"attributes"`
+	AvatarURLs       map[string]string `json:"avatar_urls,omitempty"`
+	Version          int64             `json:"version"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	DeletedAt        *time.Time        `json:"deleted_at,omitempty"`
+	LastLoginAt      *time.Time        `json:"last_login_at,omitempty"`
 }
 
 func UserFromDomain(domainUser *user.User) *UserDTO {
 	if domainUser == nil {
 		return nil
 	}
+	var avatarURLs map[string]string
+	if avatar := domainUser.Avatar(); avatar != nil {
+		avatarURLs = avatar.URLs
+	}
 	return &UserDTO{
-		ID:        domainUser.ID(),
-		Email:     domainUser.Email().String(),
-		FullName:  domainUser.FullName().String(),
-		Status:    domainUser.Status().String(),
-		CreatedAt: domainUser.CreatedAt(),
-		UpdatedAt: domainUser.UpdatedAt(),
-		DeletedAt: domainUser.DeletedAt(),
+		ID:               domainUser.ID(),
+		Email:            domainUser.Email().String(),
+		FullName:         domainUser.FullName().String(),
+		Status:           domainUser.Status().String(),
+		SuspendedUntil:   domainUser.SuspendedUntil(),
+		SuspensionReason: domainUser.SuspensionReason(),
+		Attributes:       domainUser.Attributes(),
+		AvatarURLs:       avatarURLs,
+		Version:          domainUser.Version(),
+		CreatedAt:        domainUser.CreatedAt(),
+		UpdatedAt:        domainUser.UpdatedAt(),
+		DeletedAt:        domainUser.DeletedAt(),
+		LastLoginAt:      domainUser.LastLoginAt(),
 	}
 }
 
@@ -63,3 +79,40 @@ func NewPaginatedUsersDTO(users []*user.User, total int64, pagination shared.Pag
 		HasPrev:    pagination.HasPrev(),
 	}
 }
+
+const (
+	TransferFormatCSV  = "csv"
+	TransferFormatJSON = "json"
+)
+
+type ExportedUserDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"full_name"`
+	Status    string    `json:"status"`
+	Roles     []string  `json:"roles"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ImportRowErrorDTO struct {
+	Row   int    `json:"row"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
+
+type ImportProgressDTO struct {
+	Stage     string `json:"stage"`
+	Processed int    `json:"processed"`
+	Total     int    `json:"total"`
+}
+
+type ImportResultDTO struct {
+	DryRun    bool                 `json:"dry_run"`
+	Total     int                  `json:"total"`
+	Created   int                  `json:"created"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Failed    int                  `json:"failed"`
+	Invited   int                  `json:"invited"`
+	Errors    []*ImportRowErrorDTO `json:"errors"`
+}
@@ -0,0 +1,164 @@
+package userquery
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+const (
+	PersonalDataFormatJSON = "json"
+	PersonalDataFormatZIP  = "zip"
+)
+
+type ExportPersonalDataQuery struct {
+	UserID uuid.UUID
+	Format string
+}
+
+type ExportPersonalDataHandler struct {
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	refreshTokenRepository auth.RefreshTokenRepository
+	auditTrail             user.AuditTrail
+	logger                 logger.Logger
+}
+
+func NewExportPersonalDataHandler(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	refreshTokenRepository auth.RefreshTokenRepository,
+	auditTrail user.AuditTrail,
+	logger logger.Logger,
+) *ExportPersonalDataHandler {
+	return &ExportPersonalDataHandler{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		auditTrail:             auditTrail,
+		logger:                 logger,
+	}
+}
+
+// Handle gathers the profile, roles, sessions and audit history of a user,
+// including a soft-deleted one, as a single JSON document or a ZIP archive
+// with one JSON file per section.
+func (handler *ExportPersonalDataHandler) Handle(context context.Context, query ExportPersonalDataQuery) (*userdto.PersonalDataExportDTO, error) {
+	format := strings.ToLower(query.Format)
+	if format != PersonalDataFormatJSON && format != PersonalDataFormatZIP {
+		return nil, shared.NewValidationError("format", "must be json or zip")
+	}
+
+	existingUser, err := handler.userRepository.FindByIDIncludingDeleted(context, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	personalData := &userdto.PersonalDataDTO{
+		GeneratedAt:  time.Now().UTC(),
+		Profile:      userdto.UserFromDomain(existingUser),
+		Roles:        make([]userdto.PersonalDataRoleDTO, 0),
+		Sessions:     make([]userdto.PersonalDataSessionDTO, 0),
+		AuditHistory: make([]userdto.PersonalDataAuditDTO, 0),
+	}
+
+	if roleIDs := existingUser.RoleIDs(); len(roleIDs) > 0 {
+		roles, err := handler.roleRepository.FindByIDs(context, roleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("load roles: %w", err)
+		}
+		for _, assignedRole := range roles {
+			personalData.Roles = append(personalData.Roles, userdto.PersonalDataRoleDTO{
+				ID:          assignedRole.ID(),
+				Name:        assignedRole.Name(),
+				DisplayName: assignedRole.DisplayName(),
+			})
+		}
+	}
+
+	tokens, err := handler.refreshTokenRepository.FindByUserID(context, existingUser.ID())
+	if err != nil {
+		return nil, fmt.Errorf("load sessions: %w", err)
+	}
+	for _, token := range tokens {
+		personalData.Sessions = append(personalData.Sessions, userdto.PersonalDataSessionFromDomain(token))
+	}
+
+	records, err := handler.auditTrail.FindByUser(context, existingUser.ID())
+	if err != nil {
+		return nil, fmt.Errorf("load audit history: %w", err)
+	}
+	for _, record := range records {
+		personalData.AuditHistory = append(personalData.AuditHistory, userdto.PersonalDataAuditFromDomain(record))
+	}
+
+	export := &userdto.PersonalDataExportDTO{
+		Filename: fmt.Sprintf("personal-data-%s.%s", existingUser.ID(), format),
+	}
+	switch format {
+	case PersonalDataFormatZIP:
+		export.ContentType = "application/zip"
+		export.Content, err = encodePersonalDataZIP(personalData)
+	default:
+		export.ContentType = "application/json"
+		export.Content, err = json.MarshalIndent(personalData, "", "  ")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode personal data: %w", err)
+	}
+
+	handler.logger.Info("personal data exported",
+		logger.String("user_id", existingUser.ID().String()),
+		logger.String("format", format),
+	)
+
+	return export, nil
+}
+
+func encodePersonalDataZIP(personalData *userdto.PersonalDataDTO) ([]byte, error) {
+	sections := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", personalData.Profile},
+		{"roles.json", personalData.Roles},
+		{"sessions.json", personalData.Sessions},
+		{"audit_history.json", personalData.AuditHistory},
+	}
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, section := range sections {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     section.name,
+			Method:   zip.Deflate,
+			Modified: personalData.GeneratedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(section.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,118 @@
+package userquery
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestExportPersonalDataHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*ExportPersonalDataHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+		refreshTokenRepo := testutil.NewMockRefreshTokenRepository()
+		auditTrail := testutil.NewMockAuditTrail()
+
+		editorRole, _ := role.NewRole(role.NewRoleParams{
+			Name:        "editor",
+			DisplayName: "Editor",
+		})
+		roleRepo.AddRole(editorRole)
+
+		subject, _ := user.NewUser(user.NewUserParams{
+			Email:        "alice@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Alice Doe",
+		})
+		subject.AssignRole(editorRole.ID())
+		userRepo.AddUser(subject)
+
+		token, err := auth.NewRefreshToken(auth.NewRefreshTokenParams{
+			UserID:     subject.ID(),
+			TokenHash:  "hash",
+			ExpiresAt:  time.Now().Add(time.Hour),
+			IPAddress:  net.ParseIP("203.0.113.7"),
+			DeviceInfo: &auth.DeviceInfo{UserAgent: "curl/8.0"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, refreshTokenRepo.Create(ctx, token))
+
+		auditTrail.Records[subject.ID()] = []user.AuditRecord{
+			{ID: uuid.New(), EventType: "auth.login", Action: "login", IPAddress: "203.0.113.7", Success: true},
+		}
+
+		return NewExportPersonalDataHandler(userRepo, roleRepo, refreshTokenRepo, auditTrail, testutil.NewNoopLogger()), subject
+	}
+
+	t.Run("json bundle contains every section", func(t *testing.T) {
+		handler, subject := setup(t)
+
+		export, err := handler.Handle(ctx, ExportPersonalDataQuery{UserID: subject.ID(), Format: PersonalDataFormatJSON})
+
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", export.ContentType)
+		assert.Equal(t, "personal-data-"+subject.ID().String()+".json", export.Filename)
+
+		var bundle userdto.PersonalDataDTO
+		require.NoError(t, json.Unmarshal(export.Content, &bundle))
+		assert.Equal(t, "alice@example.com", bundle.Profile.Email)
+		require.Len(t, bundle.Roles, 1)
+		assert.Equal(t, "editor", bundle.Roles[0].Name)
+		require.Len(t, bundle.Sessions, 1)
+		assert.Equal(t, "203.0.113.7", bundle.Sessions[0].IPAddress)
+		assert.Equal(t, "curl/8.0", bundle.Sessions[0].UserAgent)
+		require.Len(t, bundle.AuditHistory, 1)
+		assert.Equal(t, "login", bundle.AuditHistory[0].Action)
+	})
+
+	t.Run("zip bundle has one file per section", func(t *testing.T) {
+		handler, subject := setup(t)
+
+		export, err := handler.Handle(ctx, ExportPersonalDataQuery{UserID: subject.ID(), Format: "ZIP"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "application/zip", export.ContentType)
+
+		archive, err := zip.NewReader(bytes.NewReader(export.Content), int64(len(export.Content)))
+		require.NoError(t, err)
+		var names []string
+		for _, file := range archive.File {
+			names = append(names, file.Name)
+		}
+		assert.Equal(t, []string{"profile.json", "roles.json", "sessions.json", "audit_history.json"}, names)
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		handler, subject := setup(t)
+
+		_, err := handler.Handle(ctx, ExportPersonalDataQuery{UserID: subject.ID(), Format: "xml"})
+
+		var validationErr *shared.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		handler, _ := setup(t)
+
+		_, err := handler.Handle(ctx, ExportPersonalDataQuery{UserID: uuid.New(), Format: PersonalDataFormatJSON})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,209 @@
+package userquery
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+var exportCSVHeader = []string{"id", "email", "full_name", "status", "roles", "created_at"}
+
+type ExportUsersQuery struct {
+	Format         string
+	Status         *string
+	Search         *string
+	OrganizationID *uuid.UUID
+}
+
+type ExportUsersHandler struct {
+	userRepository user.Repository
+	roleRepository role.Repository
+	logger         logger.Logger
+}
+
+func NewExportUsersHandler(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	logger logger.Logger,
+) *ExportUsersHandler {
+	return &ExportUsersHandler{
+		userRepository: userRepository,
+		roleRepository: roleRepository,
+		logger:         logger,
+	}
+}
+
+func ValidateExportFormat(format string) error {
+	switch strings.ToLower(format) {
+	case userdto.TransferFormatCSV, userdto.TransferFormatJSON:
+		return nil
+	default:
+		return shared.NewValidationError("format", "must be csv or json")
+	}
+}
+
+func (handler *ExportUsersHandler) Handle(context context.Context, query ExportUsersQuery, writer io.Writer) (int, error) {
+	if err := ValidateExportFormat(query.Format); err != nil {
+		return 0, err
+	}
+
+	filter := user.Filter{
+		Search:         query.Search,
+		OrganizationID: query.OrganizationID,
+	}
+	if query.Status != nil {
+		status, valid := user.ParseStatus(*query.Status)
+		if !valid {
+			return 0, user.ErrInvalidStatus
+		}
+		filter.Statuses = []user.Status{status}
+	}
+
+	roles, err := handler.roleRepository.FindAll(context)
+	if err != nil {
+		return 0, fmt.Errorf("load roles: %w", err)
+	}
+	roleNames := make(map[uuid.UUID]string, len(roles))
+	for _, existingRole := range roles {
+		roleNames[existingRole.ID()] = existingRole.Name()
+	}
+
+	encoder := newUserExportEncoder(strings.ToLower(query.Format), writer)
+	if err := encoder.begin(); err != nil {
+		return 0, fmt.Errorf("write export: %w", err)
+	}
+
+	exported := 0
+	for page := 1; ; page++ {
+		pagination := shared.NewPagination(page, shared.MaxLimit)
+		users, total, err := handler.userRepository.List(context, filter, pagination)
+		if err != nil {
+			return exported, fmt.Errorf("list users: %w", err)
+		}
+
+		for _, exportedUser := range users {
+			if err := encoder.encode(exportedUserFromDomain(exportedUser, roleNames)); err != nil {
+				return exported, fmt.Errorf("write export: %w", err)
+			}
+			exported++
+		}
+		if err := encoder.flush(); err != nil {
+			return exported, fmt.Errorf("write export: %w", err)
+		}
+
+		if len(users) == 0 || !pagination.HasNext(total) {
+			break
+		}
+	}
+
+	if err := encoder.end(); err != nil {
+		return exported, fmt.Errorf("write export: %w", err)
+	}
+
+	handler.logger.Info("users exported",
+		logger.String("format", query.Format),
+		logger.Int("count", exported),
+	)
+
+	return exported, nil
+}
+
+func exportedUserFromDomain(exportedUser *user.User, roleNames map[uuid.UUID]string) *userdto.ExportedUserDTO {
+	names := make([]string, 0, len(exportedUser.RoleIDs()))
+	for _, roleID := range exportedUser.RoleIDs() {
+		if name, exists := roleNames[roleID]; exists {
+			names = append(names, name)
+		}
+	}
+
+	return &userdto.ExportedUserDTO{
+		ID:        exportedUser.ID(),
+		Email:     exportedUser.Email().String(),
+		FullName:  exportedUser.FullName().String(),
+		Status:    exportedUser.Status().String(),
+		Roles:     names,
+		CreatedAt: exportedUser.CreatedAt(),
+	}
+}
+
+type userExportEncoder struct {
+	writer    io.Writer
+	csvWriter *csv.Writer
+	count     int
+}
+
+func newUserExportEncoder(format string, writer io.Writer) *userExportEncoder {
+	encoder := &userExportEncoder{writer: writer}
+	if format == userdto.TransferFormatCSV {
+		encoder.csvWriter = csv.NewWriter(writer)
+	}
+	return encoder
+}
+
+func (encoder *userExportEncoder) begin() error {
+	if encoder.csvWriter != nil {
+		return encoder.csvWriter.Write(exportCSVHeader)
+	}
+	_, err := io.WriteString(encoder.writer, "[")
+	return err
+}
+
+func (encoder *userExportEncoder) encode(exportedUser *userdto.ExportedUserDTO) error {
+	encoder.count++
+	if encoder.csvWriter != nil {
+		return encoder.csvWriter.Write([]string{
+			exportedUser.ID.String(),
+			exportedUser.Email,
+			exportedUser.FullName,
+			exportedUser.Status,
+			strings.Join(exportedUser.Roles, ";"),
+			exportedUser.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	encoded, err := json.Marshal(exportedUser)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if encoder.count == 1 {
+		separator = "\n"
+	}
+	if _, err := io.WriteString(encoder.writer, separator); err != nil {
+		return err
+	}
+	_, err = encoder.writer.Write(encoded)
+	return err
+}
+
+func (encoder *userExportEncoder) flush() error {
+	if encoder.csvWriter == nil {
+		return nil
+	}
+	encoder.csvWriter.Flush()
+	return encoder.csvWriter.Error()
+}
+
+func (encoder *userExportEncoder) end() error {
+	if encoder.csvWriter != nil {
+		return encoder.flush()
+	}
+	closing := "]\n"
+	if encoder.count > 0 {
+		closing = "\n]\n"
+	}
+	_, err := io.WriteString(encoder.writer, closing)
+	return err
+}
@@ -0,0 +1,97 @@
+package userquery
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestExportUsersHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*ExportUsersHandler, *user.User) {
+		userRepo := testutil.NewMockUserRepository()
+		roleRepo := testutil.NewMockRoleRepository()
+
+		editorRole, _ := role.NewRole(role.NewRoleParams{
+			Name:        "editor",
+			DisplayName: "Editor",
+		})
+		roleRepo.AddRole(editorRole)
+
+		exportedUser, _ := user.NewUser(user.NewUserParams{
+			Email:        "alice@example.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Alice Doe",
+		})
+		exportedUser.AssignRole(editorRole.ID())
+		userRepo.AddUser(exportedUser)
+
+		return NewExportUsersHandler(userRepo, roleRepo, testutil.NewNoopLogger()), exportedUser
+	}
+
+	t.Run("csv includes header and role names", func(t *testing.T) {
+		handler, exportedUser := setup()
+		var buffer bytes.Buffer
+
+		count, err := handler.Handle(ctx, ExportUsersQuery{Format: userdto.TransferFormatCSV}, &buffer)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		records, err := csv.NewReader(&buffer).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, exportCSVHeader, records[0])
+		assert.Equal(t, exportedUser.ID().String(), records[1][0])
+		assert.Equal(t, "alice@example.com", records[1][1])
+		assert.Equal(t, "editor", records[1][4])
+	})
+
+	t.Run("json is a valid array", func(t *testing.T) {
+		handler, _ := setup()
+		var buffer bytes.Buffer
+
+		_, err := handler.Handle(ctx, ExportUsersQuery{Format: userdto.TransferFormatJSON}, &buffer)
+
+		require.NoError(t, err)
+
+		var exported []userdto.ExportedUserDTO
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), &exported))
+		require.Len(t, exported, 1)
+		assert.Equal(t, []string{"editor"}, exported[0].Roles)
+	})
+
+	t.Run("empty json export", func(t *testing.T) {
+		handler := NewExportUsersHandler(testutil.NewMockUserRepository(), testutil.NewMockRoleRepository(), testutil.NewNoopLogger())
+		var buffer bytes.Buffer
+
+		count, err := handler.Handle(ctx, ExportUsersQuery{Format: userdto.TransferFormatJSON}, &buffer)
+
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		assert.JSONEq(t, "[]", buffer.String())
+	})
+
+	t.Run("rejects unknown format without writing", func(t *testing.T) {
+		handler, _ := setup()
+		var buffer bytes.Buffer
+
+		_, err := handler.Handle(ctx, ExportUsersQuery{Format: "xml"}, &buffer)
+
+		require.Error(t, err)
+		assert.True(t, shared.IsValidationError(err))
+		assert.Zero(t, buffer.Len())
+	})
+}
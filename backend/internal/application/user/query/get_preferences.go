@@ -0,0 +1,45 @@
+package userquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type GetPreferencesQuery struct {
+	UserID uuid.UUID
+}
+
+type GetPreferencesHandler struct {
+	preferencesRepository user.PreferencesRepository
+	logger                logger.Logger
+}
+
+func NewGetPreferencesHandler(
+	preferencesRepository user.PreferencesRepository,
+	logger logger.Logger,
+) *GetPreferencesHandler {
+	return &GetPreferencesHandler{
+		preferencesRepository: preferencesRepository,
+		logger:                logger,
+	}
+}
+
+// Handle returns the defaults for users who never saved preferences.
+func (handler *GetPreferencesHandler) Handle(ctx context.Context, query GetPreferencesQuery) (*userdto.PreferencesDTO, error) {
+	preferences, err := handler.preferencesRepository.FindByUserID(ctx, query.UserID)
+	if errors.Is(err, user.ErrPreferencesNotFound) {
+		return userdto.PreferencesFromDomain(user.DefaultPreferences(query.UserID)), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("find preferences: %w", err)
+	}
+
+	return userdto.PreferencesFromDomain(preferences), nil
+}
@@ -0,0 +1,57 @@
+package userquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestGetPreferencesHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the defaults when nothing was saved", func(t *testing.T) {
+		handler := NewGetPreferencesHandler(testutil.NewMockPreferencesRepository(), testutil.NewNoopLogger())
+
+		result, err := handler.Handle(ctx, GetPreferencesQuery{UserID: uuid.New()})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Locale)
+		assert.Equal(t, user.DefaultTimezone, result.Timezone)
+		assert.Equal(t, "iso", result.DateFormat)
+		assert.True(t, result.AccountUpdates)
+		assert.Nil(t, result.UpdatedAt)
+	})
+
+	t.Run("returns saved preferences", func(t *testing.T) {
+		repository := testutil.NewMockPreferencesRepository()
+		userID := uuid.New()
+		preferences := user.DefaultPreferences(userID)
+		locale := "vi"
+		require.NoError(t, preferences.Update(user.UpdatePreferencesParams{Locale: &locale}))
+		repository.Preferences[userID] = preferences
+		handler := NewGetPreferencesHandler(repository, testutil.NewNoopLogger())
+
+		result, err := handler.Handle(ctx, GetPreferencesQuery{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "vi", result.Locale)
+		assert.NotNil(t, result.UpdatedAt)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repository := testutil.NewMockPreferencesRepository()
+		repository.FindError = errors.New("database error")
+		handler := NewGetPreferencesHandler(repository, testutil.NewNoopLogger())
+
+		_, err := handler.Handle(ctx, GetPreferencesQuery{UserID: uuid.New()})
+
+		assert.Error(t, err)
+	})
+}
@@ -7,31 +7,36 @@ import (
 	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type GetUserQuery struct {
-	UserID uuid.UUID
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
 }
 
 type GetUserHandler struct {
-	userRepository user.Repository
-	logger         logger.Logger
+	userRepository         user.Repository
+	organizationRepository organization.Repository
+	logger                 logger.Logger
 }
 
 func NewGetUserHandler(
 	userRepository user.Repository,
+	organizationRepository organization.Repository,
 	logger logger.Logger,
 ) *GetUserHandler {
 	return &GetUserHandler{
-		userRepository: userRepository,
-		logger:         logger,
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		logger:                 logger,
 	}
 }
 
 func (handler *GetUserHandler) Handle(context context.Context, query GetUserQuery) (*userdto.UserDTO, error) {
-	foundUser, err := handler.userRepository.FindByID(context, query.UserID)
+	foundUser, err := findUserInOrganization(context, handler.userRepository, handler.organizationRepository, query.UserID, query.OrganizationID)
 	if err != nil {
 		return nil, fmt.Errorf("find user: %w", err)
 	}
@@ -39,6 +44,28 @@ func (handler *GetUserHandler) Handle(context context.Context, query GetUserQuer
 	return userdto.UserFromDomain(foundUser), nil
 }
 
+// findUserInOrganization loads a user read by ID. When organizationID is set,
+// as for a token scoped to an organization, users outside it are reported as
+// not found.
+func findUserInOrganization(context context.Context, userRepository user.Repository, organizationRepository organization.Repository, userID uuid.UUID, organizationID *uuid.UUID) (*user.User, error) {
+	foundUser, err := userRepository.FindByID(context, userID)
+	if err != nil {
+		return nil, err
+	}
+	if organizationID == nil {
+		return foundUser, nil
+	}
+
+	isMember, err := organizationRepository.IsMember(context, *organizationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check organization membership: %w", err)
+	}
+	if !isMember {
+		return nil, user.NewUserNotFoundError(userID.String())
+	}
+	return foundUser, nil
+}
+
 type GetUserByEmailQuery struct {
 	Email string
 }
@@ -7,67 +7,96 @@ import (
 	"github.com/google/uuid"
 
 	permissiondto "github.com/tranvuongduy2003/go-copilot/internal/application/permission/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
+const (
+	deniedByUser       = "user"
+	deniedByRolePrefix = "role:"
+)
+
 type GetUserPermissionsQuery struct {
-	UserID uuid.UUID
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
 }
 
 type GetUserPermissionsHandler struct {
-	userRepository       user.Repository
-	roleRepository       role.Repository
-	permissionRepository permission.Repository
-	logger               logger.Logger
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	groupRepository        group.Repository
+	permissionRepository   permission.Repository
+	organizationRepository organization.Repository
+	logger                 logger.Logger
 }
 
 func NewGetUserPermissionsHandler(
 	userRepository user.Repository,
 	roleRepository role.Repository,
+	groupRepository group.Repository,
 	permissionRepository permission.Repository,
+	organizationRepository organization.Repository,
 	logger logger.Logger,
 ) *GetUserPermissionsHandler {
 	return &GetUserPermissionsHandler{
-		userRepository:       userRepository,
-		roleRepository:       roleRepository,
-		permissionRepository: permissionRepository,
-		logger:               logger,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		groupRepository:        groupRepository,
+		permissionRepository:   permissionRepository,
+		organizationRepository: organizationRepository,
+		logger:                 logger,
 	}
 }
 
-func (handler *GetUserPermissionsHandler) Handle(context context.Context, query GetUserPermissionsQuery) ([]*permissiondto.PermissionDTO, error) {
-	existingUser, err := handler.userRepository.FindByID(context, query.UserID)
+func (handler *GetUserPermissionsHandler) Handle(context context.Context, query GetUserPermissionsQuery) ([]*permissiondto.PermissionGrantDTO, error) {
+	existingUser, err := findUserInOrganization(context, handler.userRepository, handler.organizationRepository, query.UserID, query.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	roleIDs := existingUser.RoleIDs()
-	if len(roleIDs) == 0 {
-		return []*permissiondto.PermissionDTO{}, nil
-	}
-
-	roles, err := handler.roleRepository.FindByIDs(context, roleIDs)
+	roleIDs, err := group.EffectiveRoleIDs(context, handler.groupRepository, existingUser.ID(), existingUser.RoleIDs())
 	if err != nil {
-		return nil, fmt.Errorf("get user roles: %w", err)
+		return nil, fmt.Errorf("get user group roles: %w", err)
 	}
 
+	permissionIDs := make([]uuid.UUID, 0)
 	permissionIDSet := make(map[uuid.UUID]bool)
-	for _, roleEntity := range roles {
-		for _, permissionID := range roleEntity.PermissionIDs() {
+	deniedBy := make(map[uuid.UUID][]string)
+	addPermissionID := func(permissionID uuid.UUID) {
+		if !permissionIDSet[permissionID] {
 			permissionIDSet[permissionID] = true
+			permissionIDs = append(permissionIDs, permissionID)
 		}
 	}
 
-	if len(permissionIDSet) == 0 {
-		return []*permissiondto.PermissionDTO{}, nil
+	for _, permissionID := range existingUser.DeniedPermissionIDs() {
+		addPermissionID(permissionID)
+		deniedBy[permissionID] = append(deniedBy[permissionID], deniedByUser)
 	}
 
-	permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
-	for permissionID := range permissionIDSet {
-		permissionIDs = append(permissionIDs, permissionID)
+	if len(roleIDs) > 0 {
+		roles, err := handler.roleRepository.FindByIDs(context, roleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("get user roles: %w", err)
+		}
+
+		for _, roleEntity := range roles {
+			for _, permissionID := range roleEntity.PermissionIDs() {
+				addPermissionID(permissionID)
+			}
+			for _, permissionID := range roleEntity.DeniedPermissionIDs() {
+				addPermissionID(permissionID)
+				deniedBy[permissionID] = append(deniedBy[permissionID], deniedByRolePrefix+roleEntity.Name())
+			}
+		}
+	}
+
+	if len(permissionIDs) == 0 {
+		return []*permissiondto.PermissionGrantDTO{}, nil
 	}
 
 	permissions, err := handler.permissionRepository.FindByIDs(context, permissionIDs)
@@ -75,5 +104,10 @@ func (handler *GetUserPermissionsHandler) Handle(context context.Context, query
 		return nil, fmt.Errorf("get permissions: %w", err)
 	}
 
-	return permissiondto.PermissionsFromDomain(permissions), nil
+	grants := make([]*permissiondto.PermissionGrantDTO, len(permissions))
+	for i, permissionEntity := range permissions {
+		grants[i] = permissiondto.PermissionGrantFromDomain(permissionEntity, deniedBy[permissionEntity.ID()])
+	}
+
+	return grants, nil
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	permissiondto "github.com/tranvuongduy2003/go-copilot/internal/application/permission/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
@@ -150,7 +152,7 @@ func TestGetUserPermissionsHandler_Handle(t *testing.T) {
 
 			testUser := tt.setupMocks(userRepo, roleRepo, permRepo)
 
-			handler := NewGetUserPermissionsHandler(userRepo, roleRepo, permRepo, logger)
+			handler := NewGetUserPermissionsHandler(userRepo, roleRepo, testutil.NewMockGroupRepository(), permRepo, testutil.NewMockOrganizationRepository(), logger)
 			query := tt.query(testUser)
 
 			result, err := handler.Handle(ctx, query)
@@ -169,3 +171,83 @@ func TestGetUserPermissionsHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUserPermissionsHandler_IncludesGroupRoles(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	groupRepo := testutil.NewMockGroupRepository()
+	permRepo := testutil.NewMockPermissionRepository()
+
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	permRepo.AddPermission(readPerm)
+	permRepo.AddPermission(deletePerm)
+
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+	moderatorRole, _ := role.NewRole(role.NewRoleParams{Name: "moderator", DisplayName: "Moderator", PermissionIDs: []uuid.UUID{deletePerm.ID()}})
+	roleRepo.AddRole(viewerRole)
+	roleRepo.AddRole(moderatorRole)
+
+	testUser := testutil.CreateActiveUser()
+	require.NoError(t, testUser.AssignRole(viewerRole.ID()))
+	userRepo.AddUser(testUser)
+
+	moderators, _ := group.NewGroup(group.NewGroupParams{Name: "Moderators", RoleIDs: []uuid.UUID{moderatorRole.ID()}})
+	groupRepo.AddGroup(moderators)
+	groupRepo.AddMembership(moderators.ID(), testUser.ID())
+
+	handler := NewGetUserPermissionsHandler(userRepo, roleRepo, groupRepo, permRepo, testutil.NewMockOrganizationRepository(), testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, GetUserPermissionsQuery{UserID: testUser.ID()})
+	require.NoError(t, err)
+
+	codes := make([]string, len(result))
+	for i, permissionDTO := range result {
+		codes[i] = permissionDTO.Code
+	}
+	assert.ElementsMatch(t, []string{"users:read", "users:delete"}, codes)
+}
+
+func TestGetUserPermissionsHandler_IncludesDenies(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	permRepo := testutil.NewMockPermissionRepository()
+
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	exportPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "export"})
+	permRepo.AddPermission(readPerm)
+	permRepo.AddPermission(deletePerm)
+	permRepo.AddPermission(exportPerm)
+
+	editorRole, _ := role.NewRole(role.NewRoleParams{Name: "editor", DisplayName: "Editor", PermissionIDs: []uuid.UUID{readPerm.ID(), deletePerm.ID()}})
+	restrictedRole, _ := role.NewRole(role.NewRoleParams{Name: "restricted", DisplayName: "Restricted"})
+	require.NoError(t, restrictedRole.DenyPermission(deletePerm.ID()))
+	roleRepo.AddRole(editorRole)
+	roleRepo.AddRole(restrictedRole)
+
+	testUser := testutil.CreateActiveUser()
+	require.NoError(t, testUser.AssignRole(editorRole.ID()))
+	require.NoError(t, testUser.AssignRole(restrictedRole.ID()))
+	require.NoError(t, testUser.DenyPermission(exportPerm.ID()))
+	userRepo.AddUser(testUser)
+
+	handler := NewGetUserPermissionsHandler(userRepo, roleRepo, testutil.NewMockGroupRepository(), permRepo, testutil.NewMockOrganizationRepository(), testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, GetUserPermissionsQuery{UserID: testUser.ID()})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+
+	grants := make(map[string]*permissiondto.PermissionGrantDTO, len(result))
+	for _, grant := range result {
+		grants[grant.Code] = grant
+	}
+	assert.Equal(t, "allow", grants["users:read"].Effect)
+	assert.Empty(t, grants["users:read"].DeniedBy)
+	assert.Equal(t, "deny", grants["users:delete"].Effect)
+	assert.Equal(t, []string{"role:restricted"}, grants["users:delete"].DeniedBy)
+	assert.Equal(t, "deny", grants["users:export"].Effect)
+	assert.Equal(t, []string{"user"}, grants["users:export"].DeniedBy)
+}
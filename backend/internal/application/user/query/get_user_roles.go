@@ -7,35 +7,40 @@ import (
 	"github.com/google/uuid"
 
 	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type GetUserRolesQuery struct {
-	UserID uuid.UUID
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
 }
 
 type GetUserRolesHandler struct {
-	userRepository user.Repository
-	roleRepository role.Repository
-	logger         logger.Logger
+	userRepository         user.Repository
+	roleRepository         role.Repository
+	organizationRepository organization.Repository
+	logger                 logger.Logger
 }
 
 func NewGetUserRolesHandler(
 	userRepository user.Repository,
 	roleRepository role.Repository,
+	organizationRepository organization.Repository,
 	logger logger.Logger,
 ) *GetUserRolesHandler {
 	return &GetUserRolesHandler{
-		userRepository: userRepository,
-		roleRepository: roleRepository,
-		logger:         logger,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		logger:                 logger,
 	}
 }
 
 func (handler *GetUserRolesHandler) Handle(context context.Context, query GetUserRolesQuery) ([]*roledto.RoleDTO, error) {
-	existingUser, err := handler.userRepository.FindByID(context, query.UserID)
+	existingUser, err := findUserInOrganization(context, handler.userRepository, handler.organizationRepository, query.UserID, query.OrganizationID)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func TestGetUserRolesHandler_Handle(t *testing.T) {
 
 			testUser := tt.setupMocks(userRepo, roleRepo)
 
-			handler := NewGetUserRolesHandler(userRepo, roleRepo, logger)
+			handler := NewGetUserRolesHandler(userRepo, roleRepo, testutil.NewMockOrganizationRepository(), logger)
 			query := tt.query(testUser)
 
 			result, err := handler.Handle(ctx, query)
@@ -83,7 +83,7 @@ func TestGetUserHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo)
 
-			handler := NewGetUserHandler(userRepo, logger)
+			handler := NewGetUserHandler(userRepo, testutil.NewMockOrganizationRepository(), logger)
 			q := tt.query(testUser)
 
 			result, err := handler.Handle(ctx, q)
@@ -106,6 +106,36 @@ func TestGetUserHandler_Handle(t *testing.T) {
 	}
 }
 
+func TestGetUserHandler_Handle_TenantScope(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	organizationRepo := testutil.NewMockOrganizationRepository()
+	organizationID := uuid.New()
+
+	member := testutil.CreateActiveUser()
+	outsider, _ := user.NewUser(user.NewUserParams{
+		Email:        "outsider@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Outsider",
+	})
+	userRepo.AddUser(member)
+	userRepo.AddUser(outsider)
+	organizationRepo.AddMembership(organizationID, member.ID())
+
+	handler := NewGetUserHandler(userRepo, organizationRepo, testutil.NewNoopLogger())
+
+	result, err := handler.Handle(ctx, GetUserQuery{UserID: member.ID(), OrganizationID: &organizationID})
+	require.NoError(t, err)
+	assert.Equal(t, member.ID(), result.ID)
+
+	_, err = handler.Handle(ctx, GetUserQuery{UserID: outsider.ID(), OrganizationID: &organizationID})
+	assert.ErrorIs(t, err, user.ErrUserNotFound)
+
+	result, err = handler.Handle(ctx, GetUserQuery{UserID: outsider.ID()})
+	require.NoError(t, err)
+	assert.Equal(t, outsider.ID(), result.ID)
+}
+
 func TestGetUserByEmailHandler_Handle(t *testing.T) {
 	ctx := context.Background()
 
@@ -3,58 +3,139 @@ package userquery
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
 
 	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type ListUsersQuery struct {
-	Page      int
-	Limit     int
-	Status    *string
-	Search    *string
-	SortBy    *string
-	SortOrder *string
-	DateFrom  *string
-	DateTo    *string
+	Page  int
+	Limit int
+	// Statuses matches users in any of the statuses.
+	Statuses []string
+	// RoleIDs matches users directly assigned any of the roles.
+	RoleIDs []uuid.UUID
+	Search  *string
+	// SortBy and SortOrder are comma-separated lists; see user.ParseSort.
+	SortBy         *string
+	SortOrder      *string
+	DateFrom       *string
+	DateTo         *string
+	LastLoginFrom  *string
+	LastLoginTo    *string
+	NeverLoggedIn  bool
+	OrganizationID *uuid.UUID
+	// Deleted is "include" or "only" to list soft-deleted users as well as
+	// or instead of live ones.
+	Deleted *string
+	// Attributes filters on custom attribute equality, keyed by attribute
+	// key. Only attributes the audience can read may be filtered on.
+	Attributes map[string]string
+	Audience   userattribute.Audience
 }
 
 type ListUsersHandler struct {
-	userRepository user.Repository
-	logger         logger.Logger
+	userRepository      user.Repository
+	attributeRepository userattribute.Repository
+	logger              logger.Logger
 }
 
 func NewListUsersHandler(
 	userRepository user.Repository,
+	attributeRepository userattribute.Repository,
 	logger logger.Logger,
 ) *ListUsersHandler {
 	return &ListUsersHandler{
-		userRepository: userRepository,
-		logger:         logger,
+		userRepository:      userRepository,
+		attributeRepository: attributeRepository,
+		logger:              logger,
 	}
 }
 
 func (handler *ListUsersHandler) Handle(context context.Context, query ListUsersQuery) (*userdto.PaginatedUsersDTO, error) {
 	pagination := shared.NewPagination(query.Page, query.Limit)
 
+	filter, err := buildUserFilter(context, handler.attributeRepository, query)
+	if err != nil {
+		return nil, err
+	}
+
+	users, total, err := handler.userRepository.List(context, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+
+	return userdto.NewPaginatedUsersDTO(users, total, pagination), nil
+}
+
+// buildUserFilter validates the listing options shared by offset and cursor
+// pagination.
+func buildUserFilter(context context.Context, attributeRepository userattribute.Repository, query ListUsersQuery) (user.Filter, error) {
 	filter := user.Filter{
-		Search:    query.Search,
-		DateRange: shared.NewDateRange(query.DateFrom, query.DateTo),
+		RoleIDs:        query.RoleIDs,
+		Search:         query.Search,
+		DateRange:      shared.NewDateRange(query.DateFrom, query.DateTo),
+		LastLoginRange: shared.NewDateRange(query.LastLoginFrom, query.LastLoginTo),
+		NeverLoggedIn:  query.NeverLoggedIn,
+		OrganizationID: query.OrganizationID,
 	}
 
-	if query.Status != nil {
-		status, valid := user.ParseStatus(*query.Status)
-		if valid {
-			filter.Status = &status
+	for _, statusStr := range query.Statuses {
+		status, valid := user.ParseStatus(statusStr)
+		if !valid {
+			return user.Filter{}, shared.NewValidationError("status", "unknown status "+statusStr)
 		}
+		filter.Statuses = append(filter.Statuses, status)
 	}
 
-	users, total, err := handler.userRepository.List(context, filter, pagination)
+	if filter.NeverLoggedIn && !filter.LastLoginRange.IsEmpty() {
+		return user.Filter{}, shared.NewValidationError("never_logged_in", "cannot be combined with a last login range")
+	}
+
+	sorts, err := user.ParseSort(stringValue(query.SortBy), stringValue(query.SortOrder))
 	if err != nil {
-		return nil, fmt.Errorf("list users: %w", err)
+		return user.Filter{}, err
+	}
+	searching := query.Search != nil && strings.TrimSpace(*query.Search) != ""
+	for _, sort := range sorts {
+		if sort.Field == user.SortFieldRelevance && !searching {
+			return user.Filter{}, shared.NewValidationError("sort_by", "relevance requires a search term")
+		}
 	}
+	filter.Sort = sorts
 
-	return userdto.NewPaginatedUsersDTO(users, total, pagination), nil
+	if query.Deleted != nil {
+		scope, valid := user.ParseDeletedScope(*query.Deleted)
+		if !valid {
+			return user.Filter{}, shared.NewValidationError("deleted", "must be include or only")
+		}
+		filter.Deleted = scope
+	}
+
+	if len(query.Attributes) > 0 {
+		schema, err := userattribute.LoadSchema(context, attributeRepository)
+		if err != nil {
+			return user.Filter{}, err
+		}
+		attributes, err := schema.ParseFilter(query.Attributes, query.Audience)
+		if err != nil {
+			return user.Filter{}, err
+		}
+		filter.Attributes = attributes
+	}
+
+	return filter, nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
@@ -0,0 +1,65 @@
+package userquery
+
+import (
+	"context"
+	"fmt"
+
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// ListUsersByCursorQuery takes the same filters as ListUsersQuery but pages
+// with an opaque cursor instead of a page number, so Page is ignored. An
+// empty Cursor requests the first page.
+type ListUsersByCursorQuery struct {
+	ListUsersQuery
+	Cursor       string
+	IncludeTotal bool
+}
+
+type ListUsersByCursorHandler struct {
+	userRepository      user.Repository
+	attributeRepository userattribute.Repository
+	logger              logger.Logger
+}
+
+func NewListUsersByCursorHandler(
+	userRepository user.Repository,
+	attributeRepository userattribute.Repository,
+	logger logger.Logger,
+) *ListUsersByCursorHandler {
+	return &ListUsersByCursorHandler{
+		userRepository:      userRepository,
+		attributeRepository: attributeRepository,
+		logger:              logger,
+	}
+}
+
+func (handler *ListUsersByCursorHandler) Handle(context context.Context, query ListUsersByCursorQuery) (shared.CursorResult[*userdto.UserDTO], error) {
+	page, err := shared.NewCursorPage(query.Cursor, query.Limit, query.IncludeTotal)
+	if err != nil {
+		return shared.CursorResult[*userdto.UserDTO]{}, err
+	}
+
+	filter, err := buildUserFilter(context, handler.attributeRepository, query.ListUsersQuery)
+	if err != nil {
+		return shared.CursorResult[*userdto.UserDTO]{}, err
+	}
+	// A rank is not a stable position to resume from: it depends on the
+	// search term and is not stored on the row.
+	for _, sort := range filter.Sort {
+		if sort.Field == user.SortFieldRelevance {
+			return shared.CursorResult[*userdto.UserDTO]{}, shared.NewValidationError("sort_by", "relevance cannot be used with cursor pagination")
+		}
+	}
+
+	result, err := handler.userRepository.ListByCursor(context, filter, page)
+	if err != nil {
+		return shared.CursorResult[*userdto.UserDTO]{}, fmt.Errorf("list users: %w", err)
+	}
+
+	return shared.MapCursorResult(result, userdto.UserFromDomain), nil
+}
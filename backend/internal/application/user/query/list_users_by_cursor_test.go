@@ -0,0 +1,75 @@
+package userquery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestListUsersByCursorHandler_WalksPages(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	for i := 0; i < 5; i++ {
+		u, err := user.NewUser(user.NewUserParams{
+			Email:        fmt.Sprintf("cursor%d@example.com", i),
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Cursor User",
+		})
+		require.NoError(t, err)
+		userRepo.AddUser(u)
+	}
+
+	handler := NewListUsersByCursorHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), testutil.NewNoopLogger())
+	query := ListUsersByCursorQuery{ListUsersQuery: ListUsersQuery{Limit: 2}, IncludeTotal: true}
+
+	first, err := handler.Handle(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, first.Items, 2)
+	assert.Empty(t, first.PrevCursor)
+	require.NotEmpty(t, first.NextCursor)
+	require.NotNil(t, first.Total)
+	assert.Equal(t, int64(5), *first.Total)
+
+	query.Cursor, query.IncludeTotal = first.NextCursor, false
+	second, err := handler.Handle(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, second.Items, 2)
+	assert.Nil(t, second.Total)
+	assert.NotEqual(t, first.Items[0].ID, second.Items[0].ID)
+
+	query.Cursor = second.NextCursor
+	last, err := handler.Handle(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, last.Items, 1)
+	assert.Empty(t, last.NextCursor)
+
+	query.Cursor = second.PrevCursor
+	back, err := handler.Handle(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, first.Items, back.Items)
+}
+
+func TestListUsersByCursorHandler_RejectsInvalidCursorOptions(t *testing.T) {
+	handler := NewListUsersByCursorHandler(testutil.NewMockUserRepository(), testutil.NewMockAttributeDefinitionRepository(), testutil.NewNoopLogger())
+
+	tests := []struct {
+		name  string
+		query ListUsersByCursorQuery
+	}{
+		{name: "malformed cursor", query: ListUsersByCursorQuery{Cursor: "not a cursor"}},
+		{name: "relevance sort", query: ListUsersByCursorQuery{ListUsersQuery: ListUsersQuery{Search: stringPtr("jane"), SortBy: stringPtr("relevance")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.Handle(context.Background(), tt.query)
+			assert.True(t, shared.IsValidationError(err))
+		})
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
 
@@ -76,9 +80,9 @@ func TestListUsersHandler_Handle(t *testing.T) {
 			setupMocks: func(userRepo *testutil.MockUserRepository) {
 			},
 			query: ListUsersQuery{
-				Page:   1,
-				Limit:  10,
-				Status: stringPtr("active"),
+				Page:     1,
+				Limit:    10,
+				Statuses: []string{"active"},
 			},
 			wantErr: false,
 			checkResult: func(t *testing.T, userRepo *testutil.MockUserRepository) {
@@ -89,9 +93,9 @@ func TestListUsersHandler_Handle(t *testing.T) {
 			setupMocks: func(userRepo *testutil.MockUserRepository) {
 			},
 			query: ListUsersQuery{
-				Page:   1,
-				Limit:  10,
-				Status: stringPtr("banned"),
+				Page:     1,
+				Limit:    10,
+				Statuses: []string{"banned"},
 			},
 			wantErr: false,
 			checkResult: func(t *testing.T, userRepo *testutil.MockUserRepository) {
@@ -122,7 +126,7 @@ func TestListUsersHandler_Handle(t *testing.T) {
 
 			tt.setupMocks(userRepo)
 
-			handler := NewListUsersHandler(userRepo, logger)
+			handler := NewListUsersHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), logger)
 			result, err := handler.Handle(ctx, tt.query)
 
 			if tt.wantErr {
@@ -144,6 +148,167 @@ func TestListUsersHandler_Handle(t *testing.T) {
 	}
 }
 
+func TestListUsersHandler_FiltersByAttributes(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	attributeRepo := testutil.NewMockAttributeDefinitionRepository()
+
+	for key, visibility := range map[string]userattribute.Visibility{
+		"department":  userattribute.VisibilityPublic,
+		"salary_band": userattribute.VisibilityAdmin,
+	} {
+		definition, err := userattribute.NewDefinition(userattribute.NewDefinitionParams{Key: key, Type: userattribute.TypeString, Visibility: visibility})
+		require.NoError(t, err)
+		attributeRepo.AddDefinition(definition)
+	}
+
+	for email, department := range map[string]string{"sales@example.com": "sales", "eng@example.com": "engineering"} {
+		u, err := user.NewUser(user.NewUserParams{
+			Email:        email,
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Attribute User",
+			Attributes:   map[string]any{"department": department, "salary_band": "b2"},
+		})
+		require.NoError(t, err)
+		userRepo.AddUser(u)
+	}
+
+	handler := NewListUsersHandler(userRepo, attributeRepo, testutil.NewNoopLogger())
+
+	result, err := handler.Handle(context.Background(), ListUsersQuery{
+		Page:       1,
+		Limit:      10,
+		Attributes: map[string]string{"department": "sales"},
+		Audience:   userattribute.AudiencePublic,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "sales@example.com", result.Items[0].Email)
+
+	_, err = handler.Handle(context.Background(), ListUsersQuery{
+		Page:       1,
+		Limit:      10,
+		Attributes: map[string]string{"salary_band": "b2"},
+		Audience:   userattribute.AudiencePublic,
+	})
+	assert.Error(t, err, "admin-only attributes cannot be filtered on by other callers")
+
+	result, err = handler.Handle(context.Background(), ListUsersQuery{
+		Page:       1,
+		Limit:      10,
+		Attributes: map[string]string{"salary_band": "b2"},
+		Audience:   userattribute.AudienceAdmin,
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Items, 2)
+}
+
+func TestListUsersHandler_FiltersByDeletion(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	for _, email := range []string{"live@example.com", "deleted@example.com"} {
+		u, err := user.NewUser(user.NewUserParams{
+			Email:        email,
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Deletion User",
+		})
+		require.NoError(t, err)
+		if email == "deleted@example.com" {
+			require.NoError(t, u.Delete())
+		}
+		userRepo.AddUser(u)
+	}
+
+	handler := NewListUsersHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), testutil.NewNoopLogger())
+
+	tests := []struct {
+		name       string
+		deleted    *string
+		wantEmails []string
+	}{
+		{name: "live users by default", wantEmails: []string{"live@example.com"}},
+		{name: "only deleted users", deleted: stringPtr("only"), wantEmails: []string{"deleted@example.com"}},
+		{name: "live and deleted users", deleted: stringPtr("include"), wantEmails: []string{"live@example.com", "deleted@example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.Handle(context.Background(), ListUsersQuery{Page: 1, Limit: 10, Deleted: tt.deleted})
+			require.NoError(t, err)
+
+			emails := make([]string, len(result.Items))
+			for i, item := range result.Items {
+				emails[i] = item.Email
+			}
+			assert.ElementsMatch(t, tt.wantEmails, emails)
+		})
+	}
+
+	_, err := handler.Handle(context.Background(), ListUsersQuery{Page: 1, Limit: 10, Deleted: stringPtr("all")})
+	assert.Error(t, err)
+}
+
+func TestListUsersHandler_FiltersByStatusRoleAndLogin(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	roleID := uuid.New()
+	users := make(map[string]*user.User)
+	for _, email := range []string{"admin@example.com", "member@example.com", "new@example.com"} {
+		u, err := user.NewUser(user.NewUserParams{
+			Email:        email,
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Filter User",
+		})
+		require.NoError(t, err)
+		userRepo.AddUser(u)
+		users[email] = u
+	}
+	require.NoError(t, users["admin@example.com"].Activate())
+	require.NoError(t, users["admin@example.com"].AssignRole(roleID))
+	require.NoError(t, users["member@example.com"].Ban("spam"))
+	users["admin@example.com"].RecordLogin(time.Now())
+	users["member@example.com"].RecordLogin(time.Now())
+
+	handler := NewListUsersHandler(userRepo, testutil.NewMockAttributeDefinitionRepository(), testutil.NewNoopLogger())
+	listEmails := func(query ListUsersQuery) []string {
+		t.Helper()
+		query.Page, query.Limit = 1, 10
+		result, err := handler.Handle(context.Background(), query)
+		require.NoError(t, err)
+		emails := make([]string, len(result.Items))
+		for i, item := range result.Items {
+			emails[i] = item.Email
+		}
+		return emails
+	}
+
+	assert.ElementsMatch(t, []string{"admin@example.com", "member@example.com"}, listEmails(ListUsersQuery{Statuses: []string{"active", "banned"}}))
+	assert.ElementsMatch(t, []string{"admin@example.com"}, listEmails(ListUsersQuery{RoleIDs: []uuid.UUID{roleID, uuid.New()}}))
+	assert.ElementsMatch(t, []string{"new@example.com"}, listEmails(ListUsersQuery{NeverLoggedIn: true}))
+}
+
+func TestListUsersHandler_RejectsInvalidListOptions(t *testing.T) {
+	handler := NewListUsersHandler(testutil.NewMockUserRepository(), testutil.NewMockAttributeDefinitionRepository(), testutil.NewNoopLogger())
+
+	tests := []struct {
+		name  string
+		query ListUsersQuery
+	}{
+		{name: "unknown status", query: ListUsersQuery{Statuses: []string{"active", "archived"}}},
+		{name: "unknown sort key", query: ListUsersQuery{SortBy: stringPtr("password_hash")}},
+		{name: "relevance without search", query: ListUsersQuery{SortBy: stringPtr("relevance")}},
+		{name: "never logged in with login range", query: ListUsersQuery{NeverLoggedIn: true, LastLoginFrom: stringPtr("2026-01-01")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Page, tt.query.Limit = 1, 10
+			_, err := handler.Handle(context.Background(), tt.query)
+			assert.True(t, shared.IsValidationError(err))
+		})
+	}
+
+	_, err := handler.Handle(context.Background(), ListUsersQuery{Page: 1, Limit: 10, Search: stringPtr("jane"), SortBy: stringPtr("relevance,created_at")})
+	assert.NoError(t, err)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
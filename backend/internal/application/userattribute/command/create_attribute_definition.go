@@ -0,0 +1,75 @@
+package userattributecommand
+
+import (
+	"context"
+	"fmt"
+
+	userattributedto "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type CreateAttributeDefinitionCommand struct {
+	Key         string
+	Label       string
+	Description string
+	Type        userattribute.Type
+	Required    bool
+	Visibility  userattribute.Visibility
+	Rules       userattribute.Rules
+}
+
+type CreateAttributeDefinitionHandler struct {
+	definitionRepository userattribute.Repository
+	eventBus             shared.EventBus
+	logger               logger.Logger
+}
+
+func NewCreateAttributeDefinitionHandler(
+	definitionRepository userattribute.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *CreateAttributeDefinitionHandler {
+	return &CreateAttributeDefinitionHandler{
+		definitionRepository: definitionRepository,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+func (handler *CreateAttributeDefinitionHandler) Handle(context context.Context, command CreateAttributeDefinitionCommand) (*userattributedto.DefinitionDTO, error) {
+	definition, err := userattribute.NewDefinition(userattribute.NewDefinitionParams{
+		Key:         command.Key,
+		Label:       command.Label,
+		Description: command.Description,
+		Type:        command.Type,
+		Required:    command.Required,
+		Visibility:  command.Visibility,
+		Rules:       command.Rules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.definitionRepository.Create(context, definition); err != nil {
+		return nil, fmt.Errorf("save attribute definition: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, definition.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("attribute_key", definition.Key()),
+				logger.Err(err),
+			)
+		}
+		definition.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user attribute defined",
+		logger.String("attribute_key", definition.Key()),
+		logger.String("type", definition.Type().String()),
+	)
+
+	return userattributedto.DefinitionFromDomain(definition), nil
+}
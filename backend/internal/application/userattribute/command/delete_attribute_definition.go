@@ -0,0 +1,59 @@
+package userattributecommand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type DeleteAttributeDefinitionCommand struct {
+	Key string
+}
+
+type DeleteAttributeDefinitionHandler struct {
+	definitionRepository userattribute.Repository
+	eventBus             shared.EventBus
+	logger               logger.Logger
+}
+
+func NewDeleteAttributeDefinitionHandler(
+	definitionRepository userattribute.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *DeleteAttributeDefinitionHandler {
+	return &DeleteAttributeDefinitionHandler{
+		definitionRepository: definitionRepository,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+func (handler *DeleteAttributeDefinitionHandler) Handle(context context.Context, command DeleteAttributeDefinitionCommand) error {
+	definition, err := handler.definitionRepository.FindByKey(context, command.Key)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.definitionRepository.Delete(context, definition); err != nil {
+		return fmt.Errorf("delete attribute definition: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		event := userattribute.NewDefinitionDeletedEvent(definition.ID(), definition.Key())
+		if err := handler.eventBus.Publish(context, event); err != nil {
+			handler.logger.Error("failed to publish attribute definition deleted event",
+				logger.String("attribute_key", definition.Key()),
+				logger.Err(err),
+			)
+		}
+	}
+
+	handler.logger.Info("user attribute definition deleted",
+		logger.String("attribute_key", definition.Key()),
+	)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package userattributecommand
+
+import (
+	"context"
+	"fmt"
+
+	userattributedto "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UpdateAttributeDefinitionCommand struct {
+	Key         string
+	Label       string
+	Description *string
+	Required    *bool
+	Visibility  userattribute.Visibility
+	Rules       *userattribute.Rules
+}
+
+type UpdateAttributeDefinitionHandler struct {
+	definitionRepository userattribute.Repository
+	eventBus             shared.EventBus
+	logger               logger.Logger
+}
+
+func NewUpdateAttributeDefinitionHandler(
+	definitionRepository userattribute.Repository,
+	eventBus shared.EventBus,
+	logger logger.Logger,
+) *UpdateAttributeDefinitionHandler {
+	return &UpdateAttributeDefinitionHandler{
+		definitionRepository: definitionRepository,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+func (handler *UpdateAttributeDefinitionHandler) Handle(context context.Context, command UpdateAttributeDefinitionCommand) (*userattributedto.DefinitionDTO, error) {
+	definition, err := handler.definitionRepository.FindByKey(context, command.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := definition.Update(userattribute.UpdateDefinitionParams{
+		Label:       command.Label,
+		Description: command.Description,
+		Required:    command.Required,
+		Visibility:  command.Visibility,
+		Rules:       command.Rules,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := handler.definitionRepository.Update(context, definition); err != nil {
+		return nil, fmt.Errorf("save attribute definition: %w", err)
+	}
+
+	if handler.eventBus != nil {
+		if err := handler.eventBus.Publish(context, definition.DomainEvents()...); err != nil {
+			handler.logger.Error("failed to publish domain events",
+				logger.String("attribute_key", definition.Key()),
+				logger.Err(err),
+			)
+		}
+		definition.ClearDomainEvents()
+	}
+
+	handler.logger.Info("user attribute definition updated",
+		logger.String("attribute_key", definition.Key()),
+	)
+
+	return userattributedto.DefinitionFromDomain(definition), nil
+}
@@ -0,0 +1,81 @@
+package userattributedto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+)
+
+type RulesDTO struct {
+	MinLength *int     `json:"min_length,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Options   []string `json:"options,omitempty"`
+}
+
+type DefinitionDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Key         string    `json:"key"`
+	Label       string    `json:"label"`
+	Description string    `json:"description"`
+	Type        string    `json:"type"`
+	Required    bool      `json:"required"`
+	Visibility  string    `json:"visibility"`
+	Rules       RulesDTO  `json:"rules"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func DefinitionFromDomain(definition *userattribute.Definition) *DefinitionDTO {
+	if definition == nil {
+		return nil
+	}
+	rules := definition.Rules()
+	return &DefinitionDTO{
+		ID:          definition.ID(),
+		Key:         definition.Key(),
+		Label:       definition.Label(),
+		Description: definition.Description(),
+		Type:        definition.Type().String(),
+		Required:    definition.Required(),
+		Visibility:  definition.Visibility().String(),
+		Rules: RulesDTO{
+			MinLength: rules.MinLength,
+			MaxLength: rules.MaxLength,
+			Pattern:   rules.Pattern,
+			Min:       rules.Min,
+			Max:       rules.Max,
+			Options:   rules.Options,
+		},
+		CreatedAt: definition.CreatedAt(),
+		UpdatedAt: definition.UpdatedAt(),
+	}
+}
+
+func DefinitionsFromDomain(definitions []*userattribute.Definition) []*DefinitionDTO {
+	dtos := make([]*DefinitionDTO, len(definitions))
+	for i, definition := range definitions {
+		dtos[i] = DefinitionFromDomain(definition)
+	}
+	return dtos
+}
+
+// VisibleAttributes narrows values to the attributes whose definition the
+// audience may read. Values without a definition are dropped.
+func VisibleAttributes(definitions []*DefinitionDTO, values map[string]any, audience userattribute.Audience) map[string]any {
+	visible := make(map[string]any)
+	for _, definition := range definitions {
+		value, ok := values[definition.Key]
+		if !ok {
+			continue
+		}
+		if userattribute.Visibility(definition.Visibility).VisibleTo(audience) {
+			visible[definition.Key] = value
+		}
+	}
+	return visible
+}
@@ -0,0 +1,36 @@
+package userattributequery
+
+import (
+	"context"
+	"fmt"
+
+	userattributedto "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type ListAttributeDefinitionsQuery struct{}
+
+type ListAttributeDefinitionsHandler struct {
+	definitionRepository userattribute.Repository
+	logger               logger.Logger
+}
+
+func NewListAttributeDefinitionsHandler(
+	definitionRepository userattribute.Repository,
+	logger logger.Logger,
+) *ListAttributeDefinitionsHandler {
+	return &ListAttributeDefinitionsHandler{
+		definitionRepository: definitionRepository,
+		logger:               logger,
+	}
+}
+
+func (handler *ListAttributeDefinitionsHandler) Handle(context context.Context, query ListAttributeDefinitionsQuery) ([]*userattributedto.DefinitionDTO, error) {
+	definitions, err := handler.definitionRepository.FindAll(context)
+	if err != nil {
+		return nil, fmt.Errorf("list attribute definitions: %w", err)
+	}
+
+	return userattributedto.DefinitionsFromDomain(definitions), nil
+}
@@ -0,0 +1,220 @@
+package accessrequest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type AccessRequest struct {
+	shared.AggregateRoot
+	requesterID    uuid.UUID
+	roleID         uuid.UUID
+	organizationID *uuid.UUID
+	justification  string
+	duration       time.Duration
+	status         Status
+	reviewerID     *uuid.UUID
+	reviewComment  string
+	reviewedAt     *time.Time
+	expiresAt      *time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+type NewAccessRequestParams struct {
+	RequesterID    uuid.UUID
+	RoleID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Justification  string
+	Duration       time.Duration
+}
+
+func NewAccessRequest(params NewAccessRequestParams) (*AccessRequest, error) {
+	justification := strings.TrimSpace(params.Justification)
+	if justification == "" {
+		return nil, shared.NewValidationError("justification", "justification cannot be empty")
+	}
+	if len(justification) > 1000 {
+		return nil, shared.NewValidationError("justification", "justification cannot exceed 1000 characters")
+	}
+	if params.Duration < time.Minute {
+		return nil, shared.NewValidationError("duration", "duration must be at least one minute")
+	}
+
+	now := time.Now().UTC()
+	request := &AccessRequest{
+		AggregateRoot:  shared.NewAggregateRoot(),
+		requesterID:    params.RequesterID,
+		roleID:         params.RoleID,
+		organizationID: params.OrganizationID,
+		justification:  justification,
+		duration:       params.Duration,
+		status:         StatusPending,
+		createdAt:      now,
+		updatedAt:      now,
+	}
+
+	request.AddDomainEvent(NewAccessRequestCreatedEvent(request.ID(), request.requesterID, request.roleID, request.duration))
+
+	return request, nil
+}
+
+type ReconstructAccessRequestParams struct {
+	ID             uuid.UUID
+	RequesterID    uuid.UUID
+	RoleID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Justification  string
+	Duration       time.Duration
+	Status         Status
+	ReviewerID     *uuid.UUID
+	ReviewComment  string
+	ReviewedAt     *time.Time
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func ReconstructAccessRequest(params ReconstructAccessRequestParams) *AccessRequest {
+	return &AccessRequest{
+		AggregateRoot:  shared.NewAggregateRootWithID(params.ID),
+		requesterID:    params.RequesterID,
+		roleID:         params.RoleID,
+		organizationID: params.OrganizationID,
+		justification:  params.Justification,
+		duration:       params.Duration,
+		status:         params.Status,
+		reviewerID:     params.ReviewerID,
+		reviewComment:  params.ReviewComment,
+		reviewedAt:     params.ReviewedAt,
+		expiresAt:      params.ExpiresAt,
+		createdAt:      params.CreatedAt,
+		updatedAt:      params.UpdatedAt,
+	}
+}
+
+func (r *AccessRequest) RequesterID() uuid.UUID {
+	return r.requesterID
+}
+
+func (r *AccessRequest) RoleID() uuid.UUID {
+	return r.roleID
+}
+
+func (r *AccessRequest) OrganizationID() *uuid.UUID {
+	return r.organizationID
+}
+
+func (r *AccessRequest) Justification() string {
+	return r.justification
+}
+
+func (r *AccessRequest) Duration() time.Duration {
+	return r.duration
+}
+
+func (r *AccessRequest) Status() Status {
+	return r.status
+}
+
+func (r *AccessRequest) ReviewerID() *uuid.UUID {
+	return r.reviewerID
+}
+
+func (r *AccessRequest) ReviewComment() string {
+	return r.reviewComment
+}
+
+func (r *AccessRequest) ReviewedAt() *time.Time {
+	return r.reviewedAt
+}
+
+func (r *AccessRequest) ExpiresAt() *time.Time {
+	return r.expiresAt
+}
+
+func (r *AccessRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *AccessRequest) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *AccessRequest) IsExpiredAt(now time.Time) bool {
+	return r.status.IsApproved() && r.expiresAt != nil && !now.Before(*r.expiresAt)
+}
+
+func (r *AccessRequest) Approve(reviewerID uuid.UUID, comment string) error {
+	if err := r.review(reviewerID, comment, StatusApproved); err != nil {
+		return err
+	}
+
+	expiresAt := r.reviewedAt.Add(r.duration)
+	r.expiresAt = &expiresAt
+
+	r.AddDomainEvent(NewAccessRequestApprovedEvent(r.ID(), r.requesterID, r.roleID, reviewerID, expiresAt))
+	return nil
+}
+
+func (r *AccessRequest) Deny(reviewerID uuid.UUID, comment string) error {
+	if err := r.review(reviewerID, comment, StatusDenied); err != nil {
+		return err
+	}
+
+	r.AddDomainEvent(NewAccessRequestDeniedEvent(r.ID(), r.requesterID, r.roleID, reviewerID))
+	return nil
+}
+
+func (r *AccessRequest) Cancel(requesterID uuid.UUID) error {
+	if requesterID != r.requesterID {
+		return ErrNotRequester
+	}
+	if err := r.transitionTo(StatusCancelled); err != nil {
+		return err
+	}
+
+	r.AddDomainEvent(NewAccessRequestCancelledEvent(r.ID(), r.requesterID, r.roleID))
+	return nil
+}
+
+func (r *AccessRequest) Expire() error {
+	if err := r.transitionTo(StatusExpired); err != nil {
+		return err
+	}
+
+	r.AddDomainEvent(NewAccessRequestExpiredEvent(r.ID(), r.requesterID, r.roleID))
+	return nil
+}
+
+func (r *AccessRequest) review(reviewerID uuid.UUID, comment string, target Status) error {
+	if reviewerID == r.requesterID {
+		return ErrSelfApproval
+	}
+	if len(comment) > 1000 {
+		return shared.NewValidationError("comment", "review comment cannot exceed 1000 characters")
+	}
+	if err := r.transitionTo(target); err != nil {
+		return err
+	}
+
+	reviewedAt := r.updatedAt
+	r.reviewerID = &reviewerID
+	r.reviewComment = strings.TrimSpace(comment)
+	r.reviewedAt = &reviewedAt
+	return nil
+}
+
+func (r *AccessRequest) transitionTo(target Status) error {
+	if !r.status.CanTransitionTo(target) {
+		return NewInvalidStatusTransitionError(r.status, target)
+	}
+
+	r.status = target
+	r.updatedAt = time.Now().UTC()
+	return nil
+}
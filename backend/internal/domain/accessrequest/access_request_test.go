@@ -0,0 +1,147 @@
+package accessrequest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func newPendingRequest(t *testing.T) *AccessRequest {
+	t.Helper()
+	request, err := NewAccessRequest(NewAccessRequestParams{
+		RequesterID:   uuid.New(),
+		RoleID:        uuid.New(),
+		Justification: "Investigating incident INC-42",
+		Duration:      2 * time.Hour,
+	})
+	require.NoError(t, err)
+	request.ClearDomainEvents()
+	return request
+}
+
+func TestNewAccessRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      NewAccessRequestParams
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "valid request",
+			params: NewAccessRequestParams{
+				RequesterID:   uuid.New(),
+				RoleID:        uuid.New(),
+				Justification: "On-call rotation",
+				Duration:      time.Hour,
+			},
+		},
+		{
+			name: "empty justification",
+			params: NewAccessRequestParams{
+				Justification: "  ",
+				Duration:      time.Hour,
+			},
+			wantErr:     true,
+			errContains: "justification cannot be empty",
+		},
+		{
+			name: "justification too long",
+			params: NewAccessRequestParams{
+				Justification: strings.Repeat("a", 1001),
+				Duration:      time.Hour,
+			},
+			wantErr:     true,
+			errContains: "justification cannot exceed 1000 characters",
+		},
+		{
+			name: "duration too short",
+			params: NewAccessRequestParams{
+				Justification: "On-call rotation",
+				Duration:      time.Second,
+			},
+			wantErr:     true,
+			errContains: "duration must be at least one minute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := NewAccessRequest(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, StatusPending, request.Status())
+			events := request.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, EventTypeAccessRequestCreated, events[0].EventType())
+		})
+	}
+}
+
+func TestAccessRequest_Approve(t *testing.T) {
+	t.Run("sets expiry from duration", func(t *testing.T) {
+		request := newPendingRequest(t)
+		reviewerID := uuid.New()
+
+		require.NoError(t, request.Approve(reviewerID, "approved for incident"))
+
+		assert.Equal(t, StatusApproved, request.Status())
+		assert.Equal(t, reviewerID, *request.ReviewerID())
+		require.NotNil(t, request.ExpiresAt())
+		assert.Equal(t, request.ReviewedAt().Add(2*time.Hour), *request.ExpiresAt())
+		assert.False(t, request.IsExpiredAt(time.Now()))
+		assert.True(t, request.IsExpiredAt(request.ExpiresAt().Add(time.Second)))
+
+		events := request.DomainEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTypeAccessRequestApproved, events[0].EventType())
+	})
+
+	t.Run("requester cannot approve", func(t *testing.T) {
+		request := newPendingRequest(t)
+
+		err := request.Approve(request.RequesterID(), "")
+
+		assert.ErrorIs(t, err, ErrSelfApproval)
+		assert.Equal(t, StatusPending, request.Status())
+	})
+
+	t.Run("cannot approve twice", func(t *testing.T) {
+		request := newPendingRequest(t)
+		require.NoError(t, request.Deny(uuid.New(), "not justified"))
+
+		err := request.Approve(uuid.New(), "")
+
+		assert.True(t, shared.IsInvalidStatusTransitionError(err))
+	})
+}
+
+func TestAccessRequest_CancelAndExpire(t *testing.T) {
+	request := newPendingRequest(t)
+
+	assert.ErrorIs(t, request.Cancel(uuid.New()), ErrNotRequester)
+	require.NoError(t, request.Cancel(request.RequesterID()))
+	assert.Equal(t, StatusCancelled, request.Status())
+	assert.True(t, shared.IsInvalidStatusTransitionError(request.Expire()))
+
+	approved := newPendingRequest(t)
+	require.NoError(t, approved.Approve(uuid.New(), ""))
+	approved.ClearDomainEvents()
+
+	require.NoError(t, approved.Expire())
+	assert.Equal(t, StatusExpired, approved.Status())
+	events := approved.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, EventTypeAccessRequestExpired, events[0].EventType())
+}
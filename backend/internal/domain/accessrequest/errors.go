@@ -0,0 +1,38 @@
+package accessrequest
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrAccessRequestNotFound = shared.NewNotFoundError("AccessRequest", "")
+
+	ErrPendingRequestExists = shared.NewConflictError("AccessRequest", "role_id", "")
+
+	ErrRoleAlreadyHeld = shared.NewBusinessRuleViolationError(
+		"role_already_held",
+		"user already holds the requested role",
+	)
+
+	ErrDurationTooLong = shared.NewBusinessRuleViolationError(
+		"access_duration_too_long",
+		"requested duration exceeds the maximum allowed",
+	)
+
+	ErrSelfApproval = shared.NewBusinessRuleViolationError(
+		"access_request_self_approval",
+		"requesters cannot review their own access requests",
+	)
+
+	ErrNotApprover = shared.NewAuthorizationError("review", "access request")
+
+	ErrNotRequester = shared.NewAuthorizationError("cancel", "access request")
+)
+
+func NewAccessRequestNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("AccessRequest", identifier)
+}
+
+func NewInvalidStatusTransitionError(current, target Status) *shared.InvalidStatusTransitionError {
+	return shared.NewInvalidStatusTransitionError(current.String(), target.String())
+}
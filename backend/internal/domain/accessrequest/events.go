@@ -0,0 +1,95 @@
+package accessrequest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeAccessRequestCreated   = "access_request.created"
+	EventTypeAccessRequestApproved  = "access_request.approved"
+	EventTypeAccessRequestDenied    = "access_request.denied"
+	EventTypeAccessRequestCancelled = "access_request.cancelled"
+	EventTypeAccessRequestExpired   = "access_request.expired"
+)
+
+type AccessRequestCreatedEvent struct {
+	shared.BaseDomainEvent
+	RequesterID uuid.UUID
+	RoleID      uuid.UUID
+	Duration    time.Duration
+}
+
+func NewAccessRequestCreatedEvent(requestID, requesterID, roleID uuid.UUID, duration time.Duration) AccessRequestCreatedEvent {
+	return AccessRequestCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(requestID, EventTypeAccessRequestCreated),
+		RequesterID:     requesterID,
+		RoleID:          roleID,
+		Duration:        duration,
+	}
+}
+
+type AccessRequestApprovedEvent struct {
+	shared.BaseDomainEvent
+	RequesterID uuid.UUID
+	RoleID      uuid.UUID
+	ReviewerID  uuid.UUID
+	ExpiresAt   time.Time
+}
+
+func NewAccessRequestApprovedEvent(requestID, requesterID, roleID, reviewerID uuid.UUID, expiresAt time.Time) AccessRequestApprovedEvent {
+	return AccessRequestApprovedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(requestID, EventTypeAccessRequestApproved),
+		RequesterID:     requesterID,
+		RoleID:          roleID,
+		ReviewerID:      reviewerID,
+		ExpiresAt:       expiresAt,
+	}
+}
+
+type AccessRequestDeniedEvent struct {
+	shared.BaseDomainEvent
+	RequesterID uuid.UUID
+	RoleID      uuid.UUID
+	ReviewerID  uuid.UUID
+}
+
+func NewAccessRequestDeniedEvent(requestID, requesterID, roleID, reviewerID uuid.UUID) AccessRequestDeniedEvent {
+	return AccessRequestDeniedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(requestID, EventTypeAccessRequestDenied),
+		RequesterID:     requesterID,
+		RoleID:          roleID,
+		ReviewerID:      reviewerID,
+	}
+}
+
+type AccessRequestCancelledEvent struct {
+	shared.BaseDomainEvent
+	RequesterID uuid.UUID
+	RoleID      uuid.UUID
+}
+
+func NewAccessRequestCancelledEvent(requestID, requesterID, roleID uuid.UUID) AccessRequestCancelledEvent {
+	return AccessRequestCancelledEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(requestID, EventTypeAccessRequestCancelled),
+		RequesterID:     requesterID,
+		RoleID:          roleID,
+	}
+}
+
+type AccessRequestExpiredEvent struct {
+	shared.BaseDomainEvent
+	RequesterID uuid.UUID
+	RoleID      uuid.UUID
+}
+
+func NewAccessRequestExpiredEvent(requestID, requesterID, roleID uuid.UUID) AccessRequestExpiredEvent {
+	return AccessRequestExpiredEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(requestID, EventTypeAccessRequestExpired),
+		RequesterID:     requesterID,
+		RoleID:          roleID,
+	}
+}
@@ -0,0 +1,25 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Filter struct {
+	RequesterID    *uuid.UUID
+	Status         *Status
+	OrganizationID *uuid.UUID
+}
+
+type Repository interface {
+	Create(ctx context.Context, request *AccessRequest) error
+	Update(ctx context.Context, request *AccessRequest) error
+	FindByID(ctx context.Context, id uuid.UUID) (*AccessRequest, error)
+	List(ctx context.Context, filter Filter, pagination shared.Pagination) ([]*AccessRequest, int64, error)
+	ExistsPending(ctx context.Context, requesterID, roleID uuid.UUID) (bool, error)
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*AccessRequest, error)
+}
@@ -0,0 +1,57 @@
+package accessrequest
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusApproved  Status = "approved"
+	StatusDenied    Status = "denied"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
+)
+
+var validStatuses = map[Status]bool{
+	StatusPending:   true,
+	StatusApproved:  true,
+	StatusDenied:    true,
+	StatusCancelled: true,
+	StatusExpired:   true,
+}
+
+var allowedTransitions = map[Status][]Status{
+	StatusPending:   {StatusApproved, StatusDenied, StatusCancelled},
+	StatusApproved:  {StatusExpired},
+	StatusDenied:    {},
+	StatusCancelled: {},
+	StatusExpired:   {},
+}
+
+func (s Status) IsValid() bool {
+	return validStatuses[s]
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) CanTransitionTo(target Status) bool {
+	for _, status := range allowedTransitions[s] {
+		if status == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) IsPending() bool {
+	return s == StatusPending
+}
+
+func (s Status) IsApproved() bool {
+	return s == StatusApproved
+}
+
+func ParseStatus(s string) (Status, bool) {
+	status := Status(s)
+	return status, status.IsValid()
+}
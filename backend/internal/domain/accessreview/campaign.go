@@ -0,0 +1,333 @@
+package accessreview
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Campaign struct {
+	shared.AggregateRoot
+	name         string
+	description  string
+	scopeRoleIDs []uuid.UUID
+	scopeUserIDs []uuid.UUID
+	status       Status
+	dueAt        time.Time
+	createdBy    uuid.UUID
+	closedBy     *uuid.UUID
+	closedAt     *time.Time
+	items        []*Item
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+type NewCampaignParams struct {
+	Name         string
+	Description  string
+	ScopeRoleIDs []uuid.UUID
+	ScopeUserIDs []uuid.UUID
+	DueAt        time.Time
+	CreatedBy    uuid.UUID
+	Assignments  []Assignment
+}
+
+func NewCampaign(params NewCampaignParams) (*Campaign, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return nil, shared.NewValidationError("name", "name cannot be empty")
+	}
+	if len(name) > 200 {
+		return nil, shared.NewValidationError("name", "name cannot exceed 200 characters")
+	}
+	if len(params.Description) > 1000 {
+		return nil, shared.NewValidationError("description", "description cannot exceed 1000 characters")
+	}
+	if len(params.ScopeRoleIDs) == 0 && len(params.ScopeUserIDs) == 0 {
+		return nil, shared.NewValidationError("scope", "scope must include at least one role or user")
+	}
+
+	now := time.Now().UTC()
+	if !params.DueAt.After(now) {
+		return nil, shared.NewValidationError("due_at", "due date must be in the future")
+	}
+
+	items := make([]*Item, 0, len(params.Assignments))
+	seen := make(map[Assignment]bool, len(params.Assignments))
+	for _, assignment := range params.Assignments {
+		if seen[assignment] {
+			continue
+		}
+		seen[assignment] = true
+		items = append(items, &Item{
+			id:       uuid.New(),
+			userID:   assignment.UserID,
+			roleID:   assignment.RoleID,
+			decision: DecisionPending,
+		})
+	}
+	if len(items) == 0 {
+		return nil, ErrEmptyScope
+	}
+
+	campaign := &Campaign{
+		AggregateRoot: shared.NewAggregateRoot(),
+		name:          name,
+		description:   strings.TrimSpace(params.Description),
+		scopeRoleIDs:  params.ScopeRoleIDs,
+		scopeUserIDs:  params.ScopeUserIDs,
+		status:        StatusActive,
+		dueAt:         params.DueAt.UTC(),
+		createdBy:     params.CreatedBy,
+		items:         items,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	campaign.AddDomainEvent(NewCampaignLaunchedEvent(campaign.ID(), campaign.name, campaign.createdBy, len(items), campaign.dueAt))
+
+	return campaign, nil
+}
+
+type ReconstructCampaignParams struct {
+	ID           uuid.UUID
+	Name         string
+	Description  string
+	ScopeRoleIDs []uuid.UUID
+	ScopeUserIDs []uuid.UUID
+	Status       Status
+	DueAt        time.Time
+	CreatedBy    uuid.UUID
+	ClosedBy     *uuid.UUID
+	ClosedAt     *time.Time
+	Items        []*Item
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func ReconstructCampaign(params ReconstructCampaignParams) *Campaign {
+	return &Campaign{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		name:          params.Name,
+		description:   params.Description,
+		scopeRoleIDs:  params.ScopeRoleIDs,
+		scopeUserIDs:  params.ScopeUserIDs,
+		status:        params.Status,
+		dueAt:         params.DueAt,
+		createdBy:     params.CreatedBy,
+		closedBy:      params.ClosedBy,
+		closedAt:      params.ClosedAt,
+		items:         params.Items,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}
+}
+
+func (c *Campaign) Name() string {
+	return c.name
+}
+
+func (c *Campaign) Description() string {
+	return c.description
+}
+
+func (c *Campaign) ScopeRoleIDs() []uuid.UUID {
+	return c.scopeRoleIDs
+}
+
+func (c *Campaign) ScopeUserIDs() []uuid.UUID {
+	return c.scopeUserIDs
+}
+
+func (c *Campaign) Status() Status {
+	return c.status
+}
+
+func (c *Campaign) DueAt() time.Time {
+	return c.dueAt
+}
+
+func (c *Campaign) CreatedBy() uuid.UUID {
+	return c.createdBy
+}
+
+func (c *Campaign) ClosedBy() *uuid.UUID {
+	return c.closedBy
+}
+
+func (c *Campaign) ClosedAt() *time.Time {
+	return c.closedAt
+}
+
+func (c *Campaign) Items() []*Item {
+	return c.items
+}
+
+func (c *Campaign) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Campaign) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Campaign) Item(itemID uuid.UUID) (*Item, error) {
+	for _, item := range c.items {
+		if item.id == itemID {
+			return item, nil
+		}
+	}
+	return nil, NewReviewItemNotFoundError(itemID.String())
+}
+
+func (c *Campaign) IsOverdueAt(now time.Time) bool {
+	return c.status.IsActive() && now.After(c.dueAt)
+}
+
+func (c *Campaign) CanReview(item *Item, reviewerID uuid.UUID) bool {
+	if item.reviewerID != nil && *item.reviewerID == reviewerID {
+		return true
+	}
+	return item.IsEscalated() && reviewerID == c.createdBy
+}
+
+func (c *Campaign) Counts() (kept, revoked, pending int) {
+	for _, item := range c.items {
+		switch item.decision {
+		case DecisionKeep:
+			kept++
+		case DecisionRevoke:
+			revoked++
+		default:
+			pending++
+		}
+	}
+	return kept, revoked, pending
+}
+
+func (c *Campaign) AssignReviewer(roleID, reviewerID, assignedBy uuid.UUID) error {
+	if !c.status.IsActive() {
+		return ErrCampaignNotActive
+	}
+
+	inScope := false
+	for _, item := range c.items {
+		if item.roleID != roleID {
+			continue
+		}
+		inScope = true
+		if item.decision.IsPending() {
+			reviewer := reviewerID
+			item.reviewerID = &reviewer
+		}
+	}
+	if !inScope {
+		return ErrRoleNotInScope
+	}
+
+	c.touch()
+	c.AddDomainEvent(NewReviewerAssignedEvent(c.ID(), roleID, reviewerID, assignedBy))
+	return nil
+}
+
+func (c *Campaign) Decide(itemID, reviewerID uuid.UUID, decision Decision, comment string) error {
+	if !c.status.IsActive() {
+		return ErrCampaignNotActive
+	}
+
+	item, err := c.Item(itemID)
+	if err != nil {
+		return err
+	}
+	if item.userID == reviewerID {
+		return ErrSelfReview
+	}
+	if !c.CanReview(item, reviewerID) {
+		return ErrNotReviewer
+	}
+	if !item.decision.IsPending() {
+		return ErrItemAlreadyDecided
+	}
+	if decision != DecisionKeep && decision != DecisionRevoke {
+		return shared.NewValidationError("decision", "decision must be keep or revoke")
+	}
+
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return shared.NewValidationError("comment", "comment cannot be empty")
+	}
+	if len(comment) > 1000 {
+		return shared.NewValidationError("comment", "comment cannot exceed 1000 characters")
+	}
+
+	c.touch()
+	decidedAt := c.updatedAt
+	item.decision = decision
+	item.comment = comment
+	item.decidedBy = &reviewerID
+	item.decidedAt = &decidedAt
+
+	c.AddDomainEvent(NewReviewItemDecidedEvent(c.ID(), item))
+	return nil
+}
+
+func (c *Campaign) Escalate(now time.Time) int {
+	if !c.IsOverdueAt(now) {
+		return 0
+	}
+
+	escalated := 0
+	for _, item := range c.items {
+		if !item.decision.IsPending() || item.IsEscalated() {
+			continue
+		}
+		escalatedAt := now.UTC()
+		item.escalatedAt = &escalatedAt
+		c.AddDomainEvent(NewReviewItemEscalatedEvent(c.ID(), item, c.createdBy))
+		escalated++
+	}
+
+	if escalated > 0 {
+		c.touch()
+	}
+	return escalated
+}
+
+func (c *Campaign) Close(closedBy uuid.UUID, force bool) error {
+	_, _, pending := c.Counts()
+	if pending > 0 && !force && c.status.IsActive() {
+		return ErrPendingItems
+	}
+	if !c.status.CanTransitionTo(StatusClosed) {
+		return NewInvalidStatusTransitionError(c.status, StatusClosed)
+	}
+
+	c.status = StatusClosed
+	c.touch()
+	closedAt := c.updatedAt
+	c.closedBy = &closedBy
+	c.closedAt = &closedAt
+
+	kept, revoked, pending := c.Counts()
+	c.AddDomainEvent(NewCampaignClosedEvent(c.ID(), closedBy, kept, revoked, pending))
+	return nil
+}
+
+func (c *Campaign) MarkRevoked(itemID uuid.UUID) error {
+	item, err := c.Item(itemID)
+	if err != nil {
+		return err
+	}
+
+	c.touch()
+	revokedAt := c.updatedAt
+	item.revokedAt = &revokedAt
+	return nil
+}
+
+func (c *Campaign) touch() {
+	c.updatedAt = time.Now().UTC()
+}
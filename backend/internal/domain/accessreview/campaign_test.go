@@ -0,0 +1,169 @@
+package accessreview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newActiveCampaign(t *testing.T, owner uuid.UUID, assignments ...Assignment) *Campaign {
+	t.Helper()
+	campaign, err := NewCampaign(NewCampaignParams{
+		Name:         "Q3 privileged access",
+		ScopeRoleIDs: []uuid.UUID{assignments[0].RoleID},
+		DueAt:        time.Now().UTC().Add(24 * time.Hour),
+		CreatedBy:    owner,
+		Assignments:  assignments,
+	})
+	require.NoError(t, err)
+	campaign.ClearDomainEvents()
+	return campaign
+}
+
+func TestNewCampaign(t *testing.T) {
+	roleID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("deduplicates assignments", func(t *testing.T) {
+		campaign, err := NewCampaign(NewCampaignParams{
+			Name:         "Admins",
+			ScopeRoleIDs: []uuid.UUID{roleID},
+			DueAt:        time.Now().Add(time.Hour),
+			CreatedBy:    uuid.New(),
+			Assignments:  []Assignment{{UserID: userID, RoleID: roleID}, {UserID: userID, RoleID: roleID}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, campaign.Items(), 1)
+		assert.Equal(t, StatusActive, campaign.Status())
+		assert.Equal(t, DecisionPending, campaign.Items()[0].Decision())
+		assert.Len(t, campaign.DomainEvents(), 1)
+	})
+
+	t.Run("rejects empty scope", func(t *testing.T) {
+		_, err := NewCampaign(NewCampaignParams{
+			Name:      "Admins",
+			DueAt:     time.Now().Add(time.Hour),
+			CreatedBy: uuid.New(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "scope")
+	})
+
+	t.Run("rejects scope without holders", func(t *testing.T) {
+		_, err := NewCampaign(NewCampaignParams{
+			Name:         "Admins",
+			ScopeRoleIDs: []uuid.UUID{roleID},
+			DueAt:        time.Now().Add(time.Hour),
+			CreatedBy:    uuid.New(),
+		})
+		assert.ErrorIs(t, err, ErrEmptyScope)
+	})
+
+	t.Run("rejects past due date", func(t *testing.T) {
+		_, err := NewCampaign(NewCampaignParams{
+			Name:         "Admins",
+			ScopeRoleIDs: []uuid.UUID{roleID},
+			DueAt:        time.Now().Add(-time.Hour),
+			CreatedBy:    uuid.New(),
+			Assignments:  []Assignment{{UserID: userID, RoleID: roleID}},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "due date")
+	})
+}
+
+func TestCampaign_Decide(t *testing.T) {
+	owner := uuid.New()
+	reviewer := uuid.New()
+	holder := uuid.New()
+	roleID := uuid.New()
+
+	t.Run("assigned reviewer decides", func(t *testing.T) {
+		campaign := newActiveCampaign(t, owner, Assignment{UserID: holder, RoleID: roleID})
+		item := campaign.Items()[0]
+
+		err := campaign.Decide(item.ID(), reviewer, DecisionRevoke, "left the team")
+		assert.ErrorIs(t, err, ErrNotReviewer)
+
+		require.NoError(t, campaign.AssignReviewer(roleID, reviewer, owner))
+		require.NoError(t, campaign.Decide(item.ID(), reviewer, DecisionRevoke, "left the team"))
+		assert.Equal(t, DecisionRevoke, item.Decision())
+		assert.Equal(t, "left the team", item.Comment())
+		assert.True(t, item.AwaitsRevocation())
+
+		err = campaign.Decide(item.ID(), reviewer, DecisionKeep, "changed my mind")
+		assert.ErrorIs(t, err, ErrItemAlreadyDecided)
+	})
+
+	t.Run("requires a comment", func(t *testing.T) {
+		campaign := newActiveCampaign(t, owner, Assignment{UserID: holder, RoleID: roleID})
+		require.NoError(t, campaign.AssignReviewer(roleID, reviewer, owner))
+
+		err := campaign.Decide(campaign.Items()[0].ID(), reviewer, DecisionKeep, "  ")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "comment")
+	})
+
+	t.Run("rejects self review", func(t *testing.T) {
+		campaign := newActiveCampaign(t, owner, Assignment{UserID: reviewer, RoleID: roleID})
+		require.NoError(t, campaign.AssignReviewer(roleID, reviewer, owner))
+
+		err := campaign.Decide(campaign.Items()[0].ID(), reviewer, DecisionKeep, "still needed")
+		assert.ErrorIs(t, err, ErrSelfReview)
+	})
+
+	t.Run("rejects reviewer for role outside scope", func(t *testing.T) {
+		campaign := newActiveCampaign(t, owner, Assignment{UserID: holder, RoleID: roleID})
+		assert.ErrorIs(t, campaign.AssignReviewer(uuid.New(), reviewer, owner), ErrRoleNotInScope)
+	})
+}
+
+func TestCampaign_Escalate(t *testing.T) {
+	owner := uuid.New()
+	roleID := uuid.New()
+	campaign := newActiveCampaign(t, owner, Assignment{UserID: uuid.New(), RoleID: roleID})
+	item := campaign.Items()[0]
+
+	assert.Equal(t, 0, campaign.Escalate(time.Now()))
+	assert.False(t, campaign.CanReview(item, owner))
+
+	later := campaign.DueAt().Add(time.Minute)
+	assert.Equal(t, 1, campaign.Escalate(later))
+	assert.Equal(t, 0, campaign.Escalate(later))
+	assert.True(t, item.IsEscalated())
+	assert.True(t, campaign.CanReview(item, owner))
+	assert.Len(t, campaign.DomainEvents(), 1)
+}
+
+func TestCampaign_Close(t *testing.T) {
+	owner := uuid.New()
+	reviewer := uuid.New()
+	roleID := uuid.New()
+
+	campaign := newActiveCampaign(t, owner,
+		Assignment{UserID: uuid.New(), RoleID: roleID},
+		Assignment{UserID: uuid.New(), RoleID: roleID},
+	)
+	require.NoError(t, campaign.AssignReviewer(roleID, reviewer, owner))
+	require.NoError(t, campaign.Decide(campaign.Items()[0].ID(), reviewer, DecisionRevoke, "no longer needed"))
+
+	assert.ErrorIs(t, campaign.Close(owner, false), ErrPendingItems)
+
+	require.NoError(t, campaign.Close(owner, true))
+	assert.Equal(t, StatusClosed, campaign.Status())
+	assert.NotNil(t, campaign.ClosedAt())
+
+	kept, revoked, pending := campaign.Counts()
+	assert.Equal(t, []int{0, 1, 1}, []int{kept, revoked, pending})
+
+	err := campaign.Decide(campaign.Items()[1].ID(), reviewer, DecisionKeep, "late")
+	assert.ErrorIs(t, err, ErrCampaignNotActive)
+
+	require.NoError(t, campaign.MarkRevoked(campaign.Items()[0].ID()))
+	assert.False(t, campaign.Items()[0].AwaitsRevocation())
+
+	require.Error(t, campaign.Close(owner, true))
+}
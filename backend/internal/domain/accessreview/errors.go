@@ -0,0 +1,55 @@
+package accessreview
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrCampaignNotFound = shared.NewNotFoundError("AccessReviewCampaign", "")
+
+	ErrReviewItemNotFound = shared.NewNotFoundError("AccessReviewItem", "")
+
+	ErrEmptyScope = shared.NewBusinessRuleViolationError(
+		"access_review_empty_scope",
+		"campaign scope does not cover any role assignments",
+	)
+
+	ErrCampaignNotActive = shared.NewBusinessRuleViolationError(
+		"access_review_not_active",
+		"campaign is no longer accepting decisions",
+	)
+
+	ErrPendingItems = shared.NewBusinessRuleViolationError(
+		"access_review_pending_items",
+		"campaign still has undecided items",
+	)
+
+	ErrRoleNotInScope = shared.NewBusinessRuleViolationError(
+		"access_review_role_not_in_scope",
+		"role is not part of this campaign",
+	)
+
+	ErrSelfReview = shared.NewBusinessRuleViolationError(
+		"access_review_self_review",
+		"reviewers cannot decide on their own access",
+	)
+
+	ErrItemAlreadyDecided = shared.NewBusinessRuleViolationError(
+		"access_review_item_decided",
+		"review item has already been decided",
+	)
+
+	ErrNotReviewer = shared.NewAuthorizationError("review", "access review item")
+)
+
+func NewCampaignNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("AccessReviewCampaign", identifier)
+}
+
+func NewReviewItemNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("AccessReviewItem", identifier)
+}
+
+func NewInvalidStatusTransitionError(current, target Status) *shared.InvalidStatusTransitionError {
+	return shared.NewInvalidStatusTransitionError(current.String(), target.String())
+}
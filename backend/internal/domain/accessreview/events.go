@@ -0,0 +1,111 @@
+package accessreview
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeCampaignLaunched    = "access_review.launched"
+	EventTypeReviewerAssigned    = "access_review.reviewer_assigned"
+	EventTypeReviewItemDecided   = "access_review.item_decided"
+	EventTypeReviewItemEscalated = "access_review.item_escalated"
+	EventTypeCampaignClosed      = "access_review.closed"
+)
+
+type CampaignLaunchedEvent struct {
+	shared.BaseDomainEvent
+	Name      string
+	CreatedBy uuid.UUID
+	ItemCount int
+	DueAt     time.Time
+}
+
+func NewCampaignLaunchedEvent(campaignID uuid.UUID, name string, createdBy uuid.UUID, itemCount int, dueAt time.Time) CampaignLaunchedEvent {
+	return CampaignLaunchedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(campaignID, EventTypeCampaignLaunched),
+		Name:            name,
+		CreatedBy:       createdBy,
+		ItemCount:       itemCount,
+		DueAt:           dueAt,
+	}
+}
+
+type ReviewerAssignedEvent struct {
+	shared.BaseDomainEvent
+	RoleID     uuid.UUID
+	ReviewerID uuid.UUID
+	AssignedBy uuid.UUID
+}
+
+func NewReviewerAssignedEvent(campaignID, roleID, reviewerID, assignedBy uuid.UUID) ReviewerAssignedEvent {
+	return ReviewerAssignedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(campaignID, EventTypeReviewerAssigned),
+		RoleID:          roleID,
+		ReviewerID:      reviewerID,
+		AssignedBy:      assignedBy,
+	}
+}
+
+type ReviewItemDecidedEvent struct {
+	shared.BaseDomainEvent
+	ItemID     uuid.UUID
+	UserID     uuid.UUID
+	RoleID     uuid.UUID
+	ReviewerID uuid.UUID
+	Decision   Decision
+	Comment    string
+}
+
+func NewReviewItemDecidedEvent(campaignID uuid.UUID, item *Item) ReviewItemDecidedEvent {
+	return ReviewItemDecidedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(campaignID, EventTypeReviewItemDecided),
+		ItemID:          item.id,
+		UserID:          item.userID,
+		RoleID:          item.roleID,
+		ReviewerID:      *item.decidedBy,
+		Decision:        item.decision,
+		Comment:         item.comment,
+	}
+}
+
+type ReviewItemEscalatedEvent struct {
+	shared.BaseDomainEvent
+	ItemID      uuid.UUID
+	UserID      uuid.UUID
+	RoleID      uuid.UUID
+	ReviewerID  *uuid.UUID
+	EscalatedTo uuid.UUID
+}
+
+func NewReviewItemEscalatedEvent(campaignID uuid.UUID, item *Item, escalatedTo uuid.UUID) ReviewItemEscalatedEvent {
+	return ReviewItemEscalatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(campaignID, EventTypeReviewItemEscalated),
+		ItemID:          item.id,
+		UserID:          item.userID,
+		RoleID:          item.roleID,
+		ReviewerID:      item.reviewerID,
+		EscalatedTo:     escalatedTo,
+	}
+}
+
+type CampaignClosedEvent struct {
+	shared.BaseDomainEvent
+	ClosedBy uuid.UUID
+	Kept     int
+	Revoked  int
+	Pending  int
+}
+
+func NewCampaignClosedEvent(campaignID, closedBy uuid.UUID, kept, revoked, pending int) CampaignClosedEvent {
+	return CampaignClosedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(campaignID, EventTypeCampaignClosed),
+		ClosedBy:        closedBy,
+		Kept:            kept,
+		Revoked:         revoked,
+		Pending:         pending,
+	}
+}
@@ -0,0 +1,101 @@
+package accessreview
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Item struct {
+	id          uuid.UUID
+	userID      uuid.UUID
+	roleID      uuid.UUID
+	reviewerID  *uuid.UUID
+	decision    Decision
+	comment     string
+	decidedBy   *uuid.UUID
+	decidedAt   *time.Time
+	escalatedAt *time.Time
+	revokedAt   *time.Time
+}
+
+type Assignment struct {
+	UserID uuid.UUID
+	RoleID uuid.UUID
+}
+
+type ReconstructItemParams struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	RoleID      uuid.UUID
+	ReviewerID  *uuid.UUID
+	Decision    Decision
+	Comment     string
+	DecidedBy   *uuid.UUID
+	DecidedAt   *time.Time
+	EscalatedAt *time.Time
+	RevokedAt   *time.Time
+}
+
+func ReconstructItem(params ReconstructItemParams) *Item {
+	return &Item{
+		id:          params.ID,
+		userID:      params.UserID,
+		roleID:      params.RoleID,
+		reviewerID:  params.ReviewerID,
+		decision:    params.Decision,
+		comment:     params.Comment,
+		decidedBy:   params.DecidedBy,
+		decidedAt:   params.DecidedAt,
+		escalatedAt: params.EscalatedAt,
+		revokedAt:   params.RevokedAt,
+	}
+}
+
+func (i *Item) ID() uuid.UUID {
+	return i.id
+}
+
+func (i *Item) UserID() uuid.UUID {
+	return i.userID
+}
+
+func (i *Item) RoleID() uuid.UUID {
+	return i.roleID
+}
+
+func (i *Item) ReviewerID() *uuid.UUID {
+	return i.reviewerID
+}
+
+func (i *Item) Decision() Decision {
+	return i.decision
+}
+
+func (i *Item) Comment() string {
+	return i.comment
+}
+
+func (i *Item) DecidedBy() *uuid.UUID {
+	return i.decidedBy
+}
+
+func (i *Item) DecidedAt() *time.Time {
+	return i.decidedAt
+}
+
+func (i *Item) EscalatedAt() *time.Time {
+	return i.escalatedAt
+}
+
+func (i *Item) RevokedAt() *time.Time {
+	return i.revokedAt
+}
+
+func (i *Item) IsEscalated() bool {
+	return i.escalatedAt != nil
+}
+
+func (i *Item) AwaitsRevocation() bool {
+	return i.decision == DecisionRevoke && i.revokedAt == nil
+}
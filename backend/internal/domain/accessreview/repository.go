@@ -0,0 +1,23 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Filter struct {
+	Status *Status
+}
+
+type Repository interface {
+	Create(ctx context.Context, campaign *Campaign) error
+	Update(ctx context.Context, campaign *Campaign) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Campaign, error)
+	List(ctx context.Context, filter Filter, pagination shared.Pagination) ([]*Campaign, int64, error)
+	FindActiveByReviewer(ctx context.Context, reviewerID uuid.UUID) ([]*Campaign, error)
+	FindOverdue(ctx context.Context, now time.Time, limit int) ([]*Campaign, error)
+}
@@ -0,0 +1,65 @@
+package accessreview
+
+type Status string
+
+const (
+	StatusActive Status = "active"
+	StatusClosed Status = "closed"
+)
+
+var validStatuses = map[Status]bool{
+	StatusActive: true,
+	StatusClosed: true,
+}
+
+var allowedTransitions = map[Status][]Status{
+	StatusActive: {StatusClosed},
+	StatusClosed: {},
+}
+
+func (s Status) IsValid() bool {
+	return validStatuses[s]
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) CanTransitionTo(target Status) bool {
+	for _, status := range allowedTransitions[s] {
+		if status == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) IsActive() bool {
+	return s == StatusActive
+}
+
+func ParseStatus(s string) (Status, bool) {
+	status := Status(s)
+	return status, status.IsValid()
+}
+
+type Decision string
+
+const (
+	DecisionPending Decision = "pending"
+	DecisionKeep    Decision = "keep"
+	DecisionRevoke  Decision = "revoke"
+)
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+func (d Decision) IsPending() bool {
+	return d == DecisionPending
+}
+
+func ParseDecision(s string) (Decision, bool) {
+	decision := Decision(s)
+	return decision, decision == DecisionKeep || decision == DecisionRevoke
+}
@@ -0,0 +1,37 @@
+package auditlog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entry is one audit log row as read back for review. Entries are written
+// by the audit event handlers and never change afterwards, except for
+// pseudonymization on erasure.
+type Entry struct {
+	ID            uuid.UUID
+	Timestamp     time.Time
+	EventType     string
+	ActorID       *uuid.UUID
+	Action        string
+	ResourceType  string
+	ResourceID    string
+	IPAddress     string
+	UserAgent     string
+	Success       bool
+	FailureReason string
+	Metadata      map[string]any
+}
+
+// Filter narrows an audit log listing. Empty fields match everything.
+type Filter struct {
+	ActorID      *uuid.UUID
+	EventType    string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Success      *bool
+	From         *time.Time
+	To           *time.Time
+}
@@ -0,0 +1,13 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Repository interface {
+	// ListByCursor pages through entries matching the filter, newest first.
+	// Returns a validation error if the cursor is malformed.
+	ListByCursor(ctx context.Context, filter Filter, page shared.CursorPage) (shared.CursorResult[*Entry], error)
+}
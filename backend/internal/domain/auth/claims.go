@@ -7,15 +7,18 @@ import (
 )
 
 type Claims struct {
-	UserID      uuid.UUID
-	Email       string
-	Roles       []string
-	Permissions []string
-	TokenID     string
-	IssuedAt    time.Time
-	ExpiresAt   time.Time
-	Issuer      string
-	Audience    string
+	UserID            uuid.UUID
+	Email             string
+	OrganizationID    *uuid.UUID
+	Roles             []string
+	Permissions       []string
+	DeniedPermissions []string
+	Locale            string
+	TokenID           string
+	IssuedAt          time.Time
+	ExpiresAt         time.Time
+	Issuer            string
+	Audience          string
 }
 
 func NewClaims(
@@ -42,6 +45,10 @@ func NewClaims(
 	}
 }
 
+func (c Claims) HasOrganization() bool {
+	return c.OrganizationID != nil
+}
+
 func (c Claims) IsExpired() bool {
 	return time.Now().UTC().After(c.ExpiresAt)
 }
@@ -55,7 +62,19 @@ func (c Claims) HasRole(role string) bool {
 	return false
 }
 
+func (c Claims) IsDenied(permission string) bool {
+	for _, p := range c.DeniedPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
 func (c Claims) HasPermission(permission string) bool {
+	if c.IsDenied(permission) {
+		return false
+	}
 	for _, p := range c.Permissions {
 		if p == permission {
 			return true
@@ -70,7 +89,7 @@ func (c Claims) HasAnyPermission(permissions ...string) bool {
 		permissionSet[p] = true
 	}
 	for _, p := range permissions {
-		if permissionSet[p] {
+		if permissionSet[p] && !c.IsDenied(p) {
 			return true
 		}
 	}
@@ -83,7 +102,7 @@ func (c Claims) HasAllPermissions(permissions ...string) bool {
 		permissionSet[p] = true
 	}
 	for _, p := range permissions {
-		if !permissionSet[p] {
+		if !permissionSet[p] || c.IsDenied(p) {
 			return false
 		}
 	}
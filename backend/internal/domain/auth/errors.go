@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
@@ -22,6 +24,11 @@ var (
 		"account has been banned",
 	)
 
+	ErrAccountSuspended = shared.NewBusinessRuleViolationError(
+		"account_suspended",
+		"account is suspended",
+	)
+
 	ErrMissingToken = shared.NewAuthorizationError("authenticate", "missing token")
 
 	ErrInvalidTokenFormat = shared.NewAuthorizationError("authenticate", "invalid token format")
@@ -48,6 +55,8 @@ var (
 
 	ErrInvalidResetToken = shared.NewAuthorizationError("validate", "password reset token (invalid)")
 
+	ErrRegistrationDisabled = shared.NewAuthorizationError("register", "user (registration is invite-only)")
+
 	ErrSessionLimitExceeded = shared.NewBusinessRuleViolationError(
 		"session_limit_exceeded",
 		"maximum number of active sessions exceeded",
@@ -73,6 +82,16 @@ var (
 	ErrSessionNotFound = shared.NewNotFoundError("Session", "")
 )
 
+// NewAccountSuspendedError tells a suspended user when they can sign in
+// again. It matches ErrAccountSuspended.
+func NewAccountSuspendedError(until time.Time) *shared.BusinessRuleViolationError {
+	return shared.NewBusinessRuleViolationErrorWithParams(
+		"account_suspended",
+		"account is suspended until {until}",
+		map[string]string{"until": until.UTC().Format(time.RFC3339)},
+	)
+}
+
 func NewRefreshTokenNotFoundError(identifier string) *shared.NotFoundError {
 	return shared.NewNotFoundError("RefreshToken", identifier)
 }
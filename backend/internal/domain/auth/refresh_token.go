@@ -31,22 +31,24 @@ func DeviceInfoFromJSON(data []byte) (DeviceInfo, error) {
 
 type RefreshToken struct {
 	shared.Entity
-	userID     uuid.UUID
-	tokenHash  string
-	expiresAt  time.Time
-	createdAt  time.Time
-	lastUsedAt *time.Time
-	isRevoked  bool
-	deviceInfo *DeviceInfo
-	ipAddress  net.IP
+	userID         uuid.UUID
+	tokenHash      string
+	expiresAt      time.Time
+	createdAt      time.Time
+	lastUsedAt     *time.Time
+	isRevoked      bool
+	deviceInfo     *DeviceInfo
+	ipAddress      net.IP
+	organizationID *uuid.UUID
 }
 
 type NewRefreshTokenParams struct {
-	UserID     uuid.UUID
-	TokenHash  string
-	ExpiresAt  time.Time
-	DeviceInfo *DeviceInfo
-	IPAddress  net.IP
+	UserID         uuid.UUID
+	TokenHash      string
+	ExpiresAt      time.Time
+	DeviceInfo     *DeviceInfo
+	IPAddress      net.IP
+	OrganizationID *uuid.UUID
 }
 
 func NewRefreshToken(params NewRefreshTokenParams) (*RefreshToken, error) {
@@ -62,41 +64,44 @@ func NewRefreshToken(params NewRefreshTokenParams) (*RefreshToken, error) {
 
 	now := time.Now().UTC()
 	return &RefreshToken{
-		Entity:     shared.NewEntity(),
-		userID:     params.UserID,
-		tokenHash:  params.TokenHash,
-		expiresAt:  params.ExpiresAt,
-		createdAt:  now,
-		lastUsedAt: nil,
-		isRevoked:  false,
-		deviceInfo: params.DeviceInfo,
-		ipAddress:  params.IPAddress,
+		Entity:         shared.NewEntity(),
+		userID:         params.UserID,
+		tokenHash:      params.TokenHash,
+		expiresAt:      params.ExpiresAt,
+		createdAt:      now,
+		lastUsedAt:     nil,
+		isRevoked:      false,
+		deviceInfo:     params.DeviceInfo,
+		ipAddress:      params.IPAddress,
+		organizationID: params.OrganizationID,
 	}, nil
 }
 
 type ReconstructRefreshTokenParams struct {
-	ID         uuid.UUID
-	UserID     uuid.UUID
-	TokenHash  string
-	ExpiresAt  time.Time
-	CreatedAt  time.Time
-	LastUsedAt *time.Time
-	IsRevoked  bool
-	DeviceInfo *DeviceInfo
-	IPAddress  net.IP
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	TokenHash      string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	LastUsedAt     *time.Time
+	IsRevoked      bool
+	DeviceInfo     *DeviceInfo
+	IPAddress      net.IP
+	OrganizationID *uuid.UUID
 }
 
 func ReconstructRefreshToken(params ReconstructRefreshTokenParams) *RefreshToken {
 	return &RefreshToken{
-		Entity:     shared.NewEntityWithID(params.ID),
-		userID:     params.UserID,
-		tokenHash:  params.TokenHash,
-		expiresAt:  params.ExpiresAt,
-		createdAt:  params.CreatedAt,
-		lastUsedAt: params.LastUsedAt,
-		isRevoked:  params.IsRevoked,
-		deviceInfo: params.DeviceInfo,
-		ipAddress:  params.IPAddress,
+		Entity:         shared.NewEntityWithID(params.ID),
+		userID:         params.UserID,
+		tokenHash:      params.TokenHash,
+		expiresAt:      params.ExpiresAt,
+		createdAt:      params.CreatedAt,
+		lastUsedAt:     params.LastUsedAt,
+		isRevoked:      params.IsRevoked,
+		deviceInfo:     params.DeviceInfo,
+		ipAddress:      params.IPAddress,
+		organizationID: params.OrganizationID,
 	}
 }
 
@@ -132,6 +137,10 @@ func (rt *RefreshToken) IPAddress() net.IP {
 	return rt.ipAddress
 }
 
+func (rt *RefreshToken) OrganizationID() *uuid.UUID {
+	return rt.organizationID
+}
+
 func (rt *RefreshToken) IsExpired() bool {
 	return time.Now().UTC().After(rt.expiresAt)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
 type RefreshTokenRepository interface {
@@ -12,9 +14,12 @@ type RefreshTokenRepository interface {
 	FindByTokenHash(context context.Context, tokenHash string) (*RefreshToken, error)
 	FindByUserID(context context.Context, userID uuid.UUID) ([]*RefreshToken, error)
 	FindActiveByUserID(context context.Context, userID uuid.UUID) ([]*RefreshToken, error)
+	// ListActiveByCursor pages through the user's active tokens, newest first.
+	ListActiveByCursor(context context.Context, userID uuid.UUID, page shared.CursorPage) (shared.CursorResult[*RefreshToken], error)
 	Update(context context.Context, token *RefreshToken) error
 	Revoke(context context.Context, id uuid.UUID) error
 	RevokeAllByUserID(context context.Context, userID uuid.UUID) error
+	DeleteAllByUserID(context context.Context, userID uuid.UUID) (int64, error)
 	DeleteExpired(context context.Context) (int64, error)
 	CountActiveByUserID(context context.Context, userID uuid.UUID) (int, error)
 }
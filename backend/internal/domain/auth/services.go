@@ -15,7 +15,8 @@ type TokenGeneratorConfig struct {
 }
 
 type TokenGenerator interface {
-	GenerateAccessToken(userID uuid.UUID, email string, roles []string, permissions []string) (AccessToken, error)
+	GenerateAccessToken(userID uuid.UUID, email string, roles []string, permissions []string, deniedPermissions []string) (AccessToken, error)
+	GenerateOrganizationAccessToken(userID uuid.UUID, email string, organizationID *uuid.UUID, roles []string, permissions []string, deniedPermissions []string, locale string) (AccessToken, error)
 	GenerateRefreshToken() (string, error)
 	ParseAccessToken(token string) (*Claims, error)
 	HashRefreshToken(token string) string
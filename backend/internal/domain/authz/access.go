@@ -0,0 +1,161 @@
+package authz
+
+import (
+	"github.com/google/uuid"
+)
+
+const SuperPermissionCode = "system:admin"
+
+type GrantSource string
+
+const (
+	GrantSourceDirect GrantSource = "direct"
+	GrantSourceGroup  GrantSource = "group"
+)
+
+type RoleGrant struct {
+	RoleID          uuid.UUID
+	RoleName        string
+	OrganizationID  *uuid.UUID
+	Source          GrantSource
+	GroupID         *uuid.UUID
+	GroupName       string
+	PermissionCodes []string
+	DeniedCodes     []string
+}
+
+type MatchedGrant struct {
+	RoleGrant
+	MatchedPermission string
+}
+
+type Decision struct {
+	Permission     string
+	Allowed        bool
+	Reason         string
+	MatchedGrants  []MatchedGrant
+	DenyingGrants  []RoleGrant
+	DeniedDirectly bool
+}
+
+type EffectiveAccess struct {
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Grants         []RoleGrant
+	DirectDenies   []string
+	denialReason   string
+}
+
+func (a *EffectiveAccess) Deny(reason string) {
+	a.denialReason = reason
+}
+
+func (a *EffectiveAccess) DenialReason() string {
+	return a.denialReason
+}
+
+func (a *EffectiveAccess) RoleNames() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0, len(a.Grants))
+	for _, grant := range a.Grants {
+		if !seen[grant.RoleName] {
+			names = append(names, grant.RoleName)
+			seen[grant.RoleName] = true
+		}
+	}
+	return names
+}
+
+func (a *EffectiveAccess) DeniedPermissionCodes() []string {
+	seen := make(map[string]bool)
+	codes := make([]string, 0)
+	for _, code := range a.DirectDenies {
+		if !seen[code] {
+			codes = append(codes, code)
+			seen[code] = true
+		}
+	}
+	for _, grant := range a.Grants {
+		for _, code := range grant.DeniedCodes {
+			if !seen[code] {
+				codes = append(codes, code)
+				seen[code] = true
+			}
+		}
+	}
+	return codes
+}
+
+func (a *EffectiveAccess) PermissionCodes() []string {
+	seen := make(map[string]bool)
+	for _, code := range a.DeniedPermissionCodes() {
+		seen[code] = true
+	}
+
+	codes := make([]string, 0)
+	for _, grant := range a.Grants {
+		for _, code := range grant.PermissionCodes {
+			if !seen[code] {
+				codes = append(codes, code)
+				seen[code] = true
+			}
+		}
+	}
+	return codes
+}
+
+func (a *EffectiveAccess) Evaluate(permissionCode string) Decision {
+	decision := Decision{
+		Permission:    permissionCode,
+		MatchedGrants: make([]MatchedGrant, 0),
+		DenyingGrants: make([]RoleGrant, 0),
+	}
+
+	denied := make(map[string]bool)
+	for _, code := range a.DeniedPermissionCodes() {
+		denied[code] = true
+	}
+
+	for _, code := range a.DirectDenies {
+		if code == permissionCode {
+			decision.DeniedDirectly = true
+		}
+	}
+
+	for _, grant := range a.Grants {
+		for _, code := range grant.DeniedCodes {
+			if code == permissionCode {
+				decision.DenyingGrants = append(decision.DenyingGrants, grant)
+				break
+			}
+		}
+	}
+
+	for _, grant := range a.Grants {
+		for _, code := range grant.PermissionCodes {
+			if code == permissionCode || (code == SuperPermissionCode && !denied[SuperPermissionCode]) {
+				decision.MatchedGrants = append(decision.MatchedGrants, MatchedGrant{
+					RoleGrant:         grant,
+					MatchedPermission: code,
+				})
+				break
+			}
+		}
+	}
+
+	switch {
+	case a.denialReason != "":
+		decision.Reason = a.denialReason
+	case decision.DeniedDirectly:
+		decision.Reason = "explicitly denied for this user"
+	case len(decision.DenyingGrants) > 0:
+		decision.Reason = "denied by role " + decision.DenyingGrants[0].RoleName
+	case len(decision.MatchedGrants) == 0:
+		decision.Reason = "no role grants this permission"
+	default:
+		decision.Allowed = true
+		decision.Reason = "granted by role " + decision.MatchedGrants[0].RoleName
+	}
+
+	return decision
+}
@@ -0,0 +1,124 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+const unrankedPriority = math.MinInt32
+
+type DelegationPolicy struct {
+	userRepository  user.Repository
+	roleRepository  role.Repository
+	groupRepository group.Repository
+}
+
+func NewDelegationPolicy(
+	userRepository user.Repository,
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+) *DelegationPolicy {
+	return &DelegationPolicy{
+		userRepository:  userRepository,
+		roleRepository:  roleRepository,
+		groupRepository: groupRepository,
+	}
+}
+
+type Delegator struct {
+	policy       *DelegationPolicy
+	actorID      uuid.UUID
+	rank         int
+	unrestricted bool
+}
+
+func (policy *DelegationPolicy) ForActor(ctx context.Context, actorID *uuid.UUID) (*Delegator, error) {
+	if actorID == nil {
+		return &Delegator{policy: policy, unrestricted: true}, nil
+	}
+
+	actor, err := policy.userRepository.FindByID(ctx, *actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := policy.Rank(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Delegator{policy: policy, actorID: actor.ID(), rank: rank}, nil
+}
+
+func (policy *DelegationPolicy) Rank(ctx context.Context, subject *user.User) (int, error) {
+	roleIDs, err := group.EffectiveRoleIDs(ctx, policy.groupRepository, subject.ID(), subject.RoleIDs())
+	if err != nil {
+		return 0, fmt.Errorf("resolve effective roles: %w", err)
+	}
+	if len(roleIDs) == 0 {
+		return unrankedPriority, nil
+	}
+
+	roles, err := policy.roleRepository.FindByIDs(ctx, roleIDs)
+	if err != nil {
+		return 0, fmt.Errorf("load user roles: %w", err)
+	}
+
+	rank := unrankedPriority
+	for _, heldRole := range roles {
+		if heldRole.Priority() > rank {
+			rank = heldRole.Priority()
+		}
+	}
+
+	return rank, nil
+}
+
+func (delegator *Delegator) Rank() int {
+	return delegator.rank
+}
+
+func (delegator *Delegator) IsUnrestricted() bool {
+	return delegator.unrestricted
+}
+
+func (delegator *Delegator) CanManageRole(target *role.Role) bool {
+	return delegator.unrestricted || target.Priority() < delegator.rank
+}
+
+func (delegator *Delegator) EnsureCanManageRole(target *role.Role) error {
+	if !delegator.CanManageRole(target) {
+		return ErrRoleOutranksActor
+	}
+	return nil
+}
+
+func (delegator *Delegator) EnsureCanManageUser(ctx context.Context, target *user.User) error {
+	if delegator.unrestricted || target.ID() == delegator.actorID {
+		return nil
+	}
+
+	targetRank, err := delegator.policy.Rank(ctx, target)
+	if err != nil {
+		return err
+	}
+	if targetRank > delegator.rank {
+		return ErrUserOutranksActor
+	}
+
+	return nil
+}
+
+func (delegator *Delegator) EnsureCanGrantTo(target *user.User) error {
+	if !delegator.unrestricted && target.ID() == delegator.actorID {
+		return ErrSelfEscalation
+	}
+	return nil
+}
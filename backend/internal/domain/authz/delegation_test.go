@@ -0,0 +1,87 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestDelegationPolicy(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	roleRepo := testutil.NewMockRoleRepository()
+	groupRepo := testutil.NewMockGroupRepository()
+
+	superAdminRole, _ := role.NewRole(role.NewRoleParams{Name: "super_admin", DisplayName: "Super Admin", Priority: 100})
+	adminRole, _ := role.NewRole(role.NewRoleParams{Name: "admin", DisplayName: "Admin", Priority: 80})
+	managerRole, _ := role.NewRole(role.NewRoleParams{Name: "manager", DisplayName: "Manager", Priority: 50})
+	for _, r := range []*role.Role{superAdminRole, adminRole, managerRole} {
+		roleRepo.AddRole(r)
+	}
+
+	admin := testutil.CreateActiveUser()
+	_ = admin.AssignRole(managerRole.ID())
+	userRepo.AddUser(admin)
+	adminGroup, _ := group.NewGroup(group.NewGroupParams{Name: "Admins", RoleIDs: []uuid.UUID{adminRole.ID()}})
+	groupRepo.AddGroup(adminGroup)
+	groupRepo.AddMembership(adminGroup.ID(), admin.ID())
+
+	superAdmin := testutil.CreateActiveUser()
+	_ = superAdmin.AssignRole(superAdminRole.ID())
+	userRepo.AddUser(superAdmin)
+
+	peer := testutil.CreateActiveUser()
+	_ = peer.AssignRole(adminRole.ID())
+	userRepo.AddUser(peer)
+
+	policy := NewDelegationPolicy(userRepo, roleRepo, groupRepo)
+
+	adminID := admin.ID()
+	delegator, err := policy.ForActor(ctx, &adminID)
+	require.NoError(t, err)
+
+	t.Run("rank comes from the highest direct or group role", func(t *testing.T) {
+		assert.Equal(t, 80, delegator.Rank())
+	})
+
+	t.Run("roles must rank strictly below the actor", func(t *testing.T) {
+		assert.NoError(t, delegator.EnsureCanManageRole(managerRole))
+		assert.ErrorIs(t, delegator.EnsureCanManageRole(adminRole), ErrRoleOutranksActor)
+		assert.ErrorIs(t, delegator.EnsureCanManageRole(superAdminRole), ErrRoleOutranksActor)
+	})
+
+	t.Run("users who outrank the actor cannot be managed", func(t *testing.T) {
+		assert.NoError(t, delegator.EnsureCanManageUser(ctx, peer))
+		assert.ErrorIs(t, delegator.EnsureCanManageUser(ctx, superAdmin), ErrUserOutranksActor)
+	})
+
+	t.Run("actor cannot grant roles to themselves", func(t *testing.T) {
+		assert.ErrorIs(t, delegator.EnsureCanGrantTo(admin), ErrSelfEscalation)
+		assert.NoError(t, delegator.EnsureCanGrantTo(peer))
+	})
+
+	t.Run("system callers without an actor are unrestricted", func(t *testing.T) {
+		system, err := policy.ForActor(ctx, nil)
+		require.NoError(t, err)
+		assert.True(t, system.IsUnrestricted())
+		assert.NoError(t, system.EnsureCanManageRole(superAdminRole))
+		assert.NoError(t, system.EnsureCanManageUser(ctx, superAdmin))
+	})
+
+	t.Run("users without roles cannot manage any role", func(t *testing.T) {
+		newcomer := testutil.CreateActiveUser()
+		userRepo.AddUser(newcomer)
+		newcomerID := newcomer.ID()
+
+		newcomerDelegator, err := policy.ForActor(ctx, &newcomerID)
+		require.NoError(t, err)
+		assert.False(t, newcomerDelegator.CanManageRole(managerRole))
+	})
+}
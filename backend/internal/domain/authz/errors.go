@@ -0,0 +1,11 @@
+package authz
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrRoleOutranksActor = shared.NewAuthorizationError("manage", "role with equal or higher priority than your own")
+	ErrUserOutranksActor = shared.NewAuthorizationError("manage", "user who holds a higher priority role")
+	ErrSelfEscalation    = shared.NewAuthorizationError("grant", "roles to yourself")
+)
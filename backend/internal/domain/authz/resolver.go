@@ -0,0 +1,163 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+type Resolver struct {
+	roleRepository       role.Repository
+	groupRepository      group.Repository
+	permissionRepository permission.Repository
+}
+
+func NewResolver(
+	roleRepository role.Repository,
+	groupRepository group.Repository,
+	permissionRepository permission.Repository,
+) *Resolver {
+	return &Resolver{
+		roleRepository:       roleRepository,
+		groupRepository:      groupRepository,
+		permissionRepository: permissionRepository,
+	}
+}
+
+type roleSource struct {
+	source    GrantSource
+	groupID   *uuid.UUID
+	groupName string
+}
+
+func (resolver *Resolver) Resolve(ctx context.Context, subject *user.User, organizationID *uuid.UUID) (*EffectiveAccess, error) {
+	return resolver.resolve(ctx, subject, organizationID, nil)
+}
+
+func (resolver *Resolver) Simulate(ctx context.Context, subject *user.User, organizationID *uuid.UUID, change Change) (*EffectiveAccess, error) {
+	return resolver.resolve(ctx, subject, organizationID, &change)
+}
+
+func (resolver *Resolver) resolve(ctx context.Context, subject *user.User, organizationID *uuid.UUID, change *Change) (*EffectiveAccess, error) {
+	access := &EffectiveAccess{
+		UserID:         subject.ID(),
+		OrganizationID: organizationID,
+		Grants:         make([]RoleGrant, 0),
+		DirectDenies:   make([]string, 0),
+	}
+
+	roleIDs := make([]uuid.UUID, 0)
+	sources := make(map[uuid.UUID][]roleSource)
+	addSource := func(roleID uuid.UUID, source roleSource) {
+		if _, exists := sources[roleID]; !exists {
+			roleIDs = append(roleIDs, roleID)
+		}
+		sources[roleID] = append(sources[roleID], source)
+	}
+
+	for _, roleID := range change.directRoleIDs(subject) {
+		addSource(roleID, roleSource{source: GrantSourceDirect})
+	}
+
+	if resolver.groupRepository != nil {
+		groups, err := resolver.groupRepository.FindByMember(ctx, subject.ID())
+		if err != nil {
+			return nil, fmt.Errorf("load user groups: %w", err)
+		}
+		for _, memberGroup := range groups {
+			groupID := memberGroup.ID()
+			for _, roleID := range memberGroup.RoleIDs() {
+				addSource(roleID, roleSource{source: GrantSourceGroup, groupID: &groupID, groupName: memberGroup.Name()})
+			}
+		}
+	}
+
+	permissionIDSet := make(map[uuid.UUID]bool)
+	for _, permissionID := range subject.DeniedPermissionIDs() {
+		permissionIDSet[permissionID] = true
+	}
+
+	var roles []*role.Role
+	if len(roleIDs) > 0 {
+		loadedRoles, err := resolver.roleRepository.FindByIDs(ctx, roleIDs)
+		if err != nil {
+			return nil, fmt.Errorf("load user roles: %w", err)
+		}
+		roles = loadedRoles
+	}
+
+	applicableRoles := make([]*role.Role, 0, len(roles))
+	rolePermissionIDs := make(map[uuid.UUID][]uuid.UUID, len(roles))
+	for _, roleEntity := range roles {
+		if !roleEntity.AppliesTo(organizationID) || change.deletes(roleEntity.ID()) {
+			continue
+		}
+		applicableRoles = append(applicableRoles, roleEntity)
+		rolePermissionIDs[roleEntity.ID()] = change.permissionIDs(roleEntity)
+		for _, permissionID := range rolePermissionIDs[roleEntity.ID()] {
+			permissionIDSet[permissionID] = true
+		}
+		for _, permissionID := range roleEntity.DeniedPermissionIDs() {
+			permissionIDSet[permissionID] = true
+		}
+	}
+
+	permissionCodes := make(map[uuid.UUID]string, len(permissionIDSet))
+	if len(permissionIDSet) > 0 {
+		permissionIDs := make([]uuid.UUID, 0, len(permissionIDSet))
+		for permissionID := range permissionIDSet {
+			permissionIDs = append(permissionIDs, permissionID)
+		}
+
+		permissions, err := resolver.permissionRepository.FindByIDs(ctx, permissionIDs)
+		if err != nil {
+			return nil, fmt.Errorf("load permissions: %w", err)
+		}
+		for _, permissionEntity := range permissions {
+			permissionCodes[permissionEntity.ID()] = permissionEntity.CodeString()
+		}
+	}
+
+	for _, permissionID := range subject.DeniedPermissionIDs() {
+		if code, exists := permissionCodes[permissionID]; exists {
+			access.DirectDenies = append(access.DirectDenies, code)
+		}
+	}
+
+	for _, roleEntity := range applicableRoles {
+		codes := make([]string, 0, len(rolePermissionIDs[roleEntity.ID()]))
+		for _, permissionID := range rolePermissionIDs[roleEntity.ID()] {
+			if code, exists := permissionCodes[permissionID]; exists {
+				codes = append(codes, code)
+			}
+		}
+
+		deniedCodes := make([]string, 0, len(roleEntity.DeniedPermissionIDs()))
+		for _, permissionID := range roleEntity.DeniedPermissionIDs() {
+			if code, exists := permissionCodes[permissionID]; exists {
+				deniedCodes = append(deniedCodes, code)
+			}
+		}
+
+		for _, source := range sources[roleEntity.ID()] {
+			access.Grants = append(access.Grants, RoleGrant{
+				RoleID:          roleEntity.ID(),
+				RoleName:        roleEntity.Name(),
+				OrganizationID:  roleEntity.OrganizationID(),
+				Source:          source.source,
+				GroupID:         source.groupID,
+				GroupName:       source.groupName,
+				PermissionCodes: codes,
+				DeniedCodes:     deniedCodes,
+			})
+		}
+	}
+
+	return access, nil
+}
@@ -0,0 +1,230 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+	roleRepo := testutil.NewMockRoleRepository()
+	groupRepo := testutil.NewMockGroupRepository()
+	permRepo := testutil.NewMockPermissionRepository()
+
+	readPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "read"})
+	deletePerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "users", Action: "delete"})
+	billingPerm, _ := permission.NewPermission(permission.NewPermissionParams{Resource: "billing", Action: "read"})
+	permRepo.AddPermission(readPerm)
+	permRepo.AddPermission(deletePerm)
+	permRepo.AddPermission(billingPerm)
+
+	organizationID := uuid.New()
+	viewerRole, _ := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer", PermissionIDs: []uuid.UUID{readPerm.ID()}})
+	moderatorRole, _ := role.NewRole(role.NewRoleParams{Name: "moderator", DisplayName: "Moderator", PermissionIDs: []uuid.UUID{deletePerm.ID()}})
+	billingRole, _ := role.NewRole(role.NewRoleParams{Name: "billing", DisplayName: "Billing", PermissionIDs: []uuid.UUID{billingPerm.ID()}, OrganizationID: &organizationID})
+	roleRepo.AddRole(viewerRole)
+	roleRepo.AddRole(moderatorRole)
+	roleRepo.AddRole(billingRole)
+
+	subject := testutil.NewUserBuilder().Active().MustBuild()
+	_ = subject.AssignRole(viewerRole.ID())
+	_ = subject.AssignRole(billingRole.ID())
+
+	moderators, _ := group.NewGroup(group.NewGroupParams{Name: "Moderators", RoleIDs: []uuid.UUID{moderatorRole.ID()}})
+	groupRepo.AddGroup(moderators)
+	groupRepo.AddMembership(moderators.ID(), subject.ID())
+
+	resolver := NewResolver(roleRepo, groupRepo, permRepo)
+
+	t.Run("global scope skips organization roles", func(t *testing.T) {
+		access, err := resolver.Resolve(ctx, subject, nil)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"viewer", "moderator"}, access.RoleNames())
+		assert.ElementsMatch(t, []string{"users:read", "users:delete"}, access.PermissionCodes())
+
+		decision := access.Evaluate("users:delete")
+		assert.True(t, decision.Allowed)
+		require.Len(t, decision.MatchedGrants, 1)
+		assert.Equal(t, GrantSourceGroup, decision.MatchedGrants[0].Source)
+		assert.Equal(t, "Moderators", decision.MatchedGrants[0].GroupName)
+
+		assert.False(t, access.Evaluate("billing:read").Allowed)
+	})
+
+	t.Run("organization scope includes organization roles", func(t *testing.T) {
+		access, err := resolver.Resolve(ctx, subject, &organizationID)
+
+		require.NoError(t, err)
+		decision := access.Evaluate("billing:read")
+		assert.True(t, decision.Allowed)
+		require.Len(t, decision.MatchedGrants, 1)
+		assert.Equal(t, GrantSourceDirect, decision.MatchedGrants[0].Source)
+		assert.Equal(t, "billing", decision.MatchedGrants[0].RoleName)
+	})
+
+	t.Run("direct and role denies are resolved to codes", func(t *testing.T) {
+		restrictedRole, _ := role.NewRole(role.NewRoleParams{Name: "restricted", DisplayName: "Restricted"})
+		require.NoError(t, restrictedRole.DenyPermission(deletePerm.ID()))
+		roleRepo.AddRole(restrictedRole)
+
+		deniedSubject := testutil.NewUserBuilder().Active().MustBuild()
+		_ = deniedSubject.AssignRole(viewerRole.ID())
+		_ = deniedSubject.AssignRole(moderatorRole.ID())
+		_ = deniedSubject.AssignRole(restrictedRole.ID())
+		require.NoError(t, deniedSubject.DenyPermission(readPerm.ID()))
+
+		access, err := resolver.Resolve(ctx, deniedSubject, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, access.PermissionCodes())
+		assert.ElementsMatch(t, []string{"users:read", "users:delete"}, access.DeniedPermissionCodes())
+
+		decision := access.Evaluate("users:delete")
+		assert.False(t, decision.Allowed)
+		require.Len(t, decision.DenyingGrants, 1)
+		assert.Equal(t, "restricted", decision.DenyingGrants[0].RoleName)
+		assert.True(t, access.Evaluate("users:read").DeniedDirectly)
+	})
+
+	t.Run("group repository failure is returned", func(t *testing.T) {
+		groupRepo.FindError = errors.New("database error")
+		defer func() { groupRepo.FindError = nil }()
+
+		_, err := resolver.Resolve(ctx, subject, nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "load user groups")
+	})
+}
+
+func TestEffectiveAccess_Evaluate(t *testing.T) {
+	adminGrant := RoleGrant{RoleName: "super_admin", Source: GrantSourceDirect, PermissionCodes: []string{SuperPermissionCode}}
+	viewerGrant := RoleGrant{RoleName: "viewer", Source: GrantSourceDirect, PermissionCodes: []string{"users:read"}}
+	supportGrant := RoleGrant{RoleName: "support", Source: GrantSourceGroup, PermissionCodes: []string{"users:read", "users:delete"}, DeniedCodes: []string{"users:delete"}}
+	noAdminGrant := RoleGrant{RoleName: "restricted", Source: GrantSourceDirect, DeniedCodes: []string{SuperPermissionCode}}
+
+	tests := []struct {
+		name        string
+		grants      []RoleGrant
+		directDeny  []string
+		denial      string
+		permission  string
+		wantAllowed bool
+		wantMatches int
+		wantReason  string
+	}{
+		{
+			name:        "exact permission match",
+			grants:      []RoleGrant{viewerGrant},
+			permission:  "users:read",
+			wantAllowed: true,
+			wantMatches: 1,
+			wantReason:  "granted by role viewer",
+		},
+		{
+			name:        "system admin matches any permission",
+			grants:      []RoleGrant{adminGrant, viewerGrant},
+			permission:  "roles:delete",
+			wantAllowed: true,
+			wantMatches: 1,
+			wantReason:  "granted by role super_admin",
+		},
+		{
+			name:        "no matching grant",
+			grants:      []RoleGrant{viewerGrant},
+			permission:  "users:delete",
+			wantAllowed: false,
+			wantMatches: 0,
+			wantReason:  "no role grants this permission",
+		},
+		{
+			name:        "denial overrides matching grant",
+			grants:      []RoleGrant{viewerGrant},
+			denial:      "user is not active",
+			permission:  "users:read",
+			wantAllowed: false,
+			wantMatches: 1,
+			wantReason:  "user is not active",
+		},
+		{
+			name:        "role deny overrides allow from the same role",
+			grants:      []RoleGrant{supportGrant},
+			permission:  "users:delete",
+			wantAllowed: false,
+			wantMatches: 1,
+			wantReason:  "denied by role support",
+		},
+		{
+			name:        "role deny overrides system admin",
+			grants:      []RoleGrant{adminGrant, supportGrant},
+			permission:  "users:delete",
+			wantAllowed: false,
+			wantMatches: 2,
+			wantReason:  "denied by role support",
+		},
+		{
+			name:        "direct deny overrides allow",
+			grants:      []RoleGrant{viewerGrant},
+			directDeny:  []string{"users:read"},
+			permission:  "users:read",
+			wantAllowed: false,
+			wantMatches: 1,
+			wantReason:  "explicitly denied for this user",
+		},
+		{
+			name:        "denying system admin removes the wildcard",
+			grants:      []RoleGrant{adminGrant, noAdminGrant},
+			permission:  "roles:delete",
+			wantAllowed: false,
+			wantMatches: 0,
+			wantReason:  "no role grants this permission",
+		},
+		{
+			name:        "deny on another permission does not affect the check",
+			grants:      []RoleGrant{supportGrant},
+			permission:  "users:read",
+			wantAllowed: true,
+			wantMatches: 1,
+			wantReason:  "granted by role support",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &EffectiveAccess{Grants: tt.grants, DirectDenies: tt.directDeny}
+			if tt.denial != "" {
+				access.Deny(tt.denial)
+			}
+
+			decision := access.Evaluate(tt.permission)
+
+			assert.Equal(t, tt.permission, decision.Permission)
+			assert.Equal(t, tt.wantAllowed, decision.Allowed)
+			assert.Len(t, decision.MatchedGrants, tt.wantMatches)
+			assert.Equal(t, tt.wantReason, decision.Reason)
+		})
+	}
+}
+
+func TestEffectiveAccess_PermissionCodesExcludeDenies(t *testing.T) {
+	access := &EffectiveAccess{
+		Grants: []RoleGrant{
+			{RoleName: "support", PermissionCodes: []string{"users:read", "users:update", "users:delete"}, DeniedCodes: []string{"users:delete"}},
+		},
+		DirectDenies: []string{"users:update"},
+	}
+
+	assert.Equal(t, []string{"users:read"}, access.PermissionCodes())
+	assert.ElementsMatch(t, []string{"users:delete", "users:update"}, access.DeniedPermissionCodes())
+}
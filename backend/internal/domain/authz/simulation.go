@@ -0,0 +1,132 @@
+package authz
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeRolePermissions ChangeType = "role_permissions"
+	ChangeTypeUserRoles       ChangeType = "user_roles"
+	ChangeTypeRoleDeletion    ChangeType = "role_deletion"
+)
+
+func (t ChangeType) IsValid() bool {
+	switch t {
+	case ChangeTypeRolePermissions, ChangeTypeUserRoles, ChangeTypeRoleDeletion:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t ChangeType) String() string {
+	return string(t)
+}
+
+func ParseChangeType(value string) (ChangeType, error) {
+	changeType := ChangeType(value)
+	if !changeType.IsValid() {
+		return "", shared.NewValidationError("type", "must be one of role_permissions, user_roles, role_deletion")
+	}
+	return changeType, nil
+}
+
+type Change struct {
+	Type                ChangeType
+	RoleID              uuid.UUID
+	AddPermissionIDs    []uuid.UUID
+	RemovePermissionIDs []uuid.UUID
+	UserID              uuid.UUID
+	RoleIDs             []uuid.UUID
+}
+
+func (c Change) Validate() error {
+	switch c.Type {
+	case ChangeTypeRolePermissions:
+		if c.RoleID == uuid.Nil {
+			return shared.NewValidationError("role_id", "is required")
+		}
+		if len(c.AddPermissionIDs) == 0 && len(c.RemovePermissionIDs) == 0 {
+			return shared.NewValidationError("permissions", "at least one permission must be added or removed")
+		}
+	case ChangeTypeRoleDeletion:
+		if c.RoleID == uuid.Nil {
+			return shared.NewValidationError("role_id", "is required")
+		}
+	case ChangeTypeUserRoles:
+		if c.UserID == uuid.Nil {
+			return shared.NewValidationError("user_id", "is required")
+		}
+	default:
+		return shared.NewValidationError("type", "must be one of role_permissions, user_roles, role_deletion")
+	}
+	return nil
+}
+
+func (c *Change) directRoleIDs(subject *user.User) []uuid.UUID {
+	if c == nil || c.Type != ChangeTypeUserRoles || c.UserID != subject.ID() {
+		return subject.RoleIDs()
+	}
+	return c.RoleIDs
+}
+
+func (c *Change) deletes(roleID uuid.UUID) bool {
+	return c != nil && c.Type == ChangeTypeRoleDeletion && c.RoleID == roleID
+}
+
+func (c *Change) permissionIDs(roleEntity *role.Role) []uuid.UUID {
+	if c == nil || c.Type != ChangeTypeRolePermissions || c.RoleID != roleEntity.ID() {
+		return roleEntity.PermissionIDs()
+	}
+
+	removed := make(map[uuid.UUID]bool, len(c.RemovePermissionIDs))
+	for _, permissionID := range c.RemovePermissionIDs {
+		removed[permissionID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	permissionIDs := make([]uuid.UUID, 0, len(roleEntity.PermissionIDs())+len(c.AddPermissionIDs))
+	for _, permissionID := range append(roleEntity.PermissionIDs(), c.AddPermissionIDs...) {
+		if removed[permissionID] || seen[permissionID] {
+			continue
+		}
+		permissionIDs = append(permissionIDs, permissionID)
+		seen[permissionID] = true
+	}
+	return permissionIDs
+}
+
+func CompareAccess(before, after *EffectiveAccess) (gained, lost []string) {
+	candidates := make(map[string]bool)
+	for _, code := range before.PermissionCodes() {
+		candidates[code] = true
+	}
+	for _, code := range after.PermissionCodes() {
+		candidates[code] = true
+	}
+
+	gained = make([]string, 0)
+	lost = make([]string, 0)
+	for code := range candidates {
+		allowedBefore := before.Evaluate(code).Allowed
+		allowedAfter := after.Evaluate(code).Allowed
+		switch {
+		case allowedAfter && !allowedBefore:
+			gained = append(gained, code)
+		case allowedBefore && !allowedAfter:
+			lost = append(lost, code)
+		}
+	}
+
+	sort.Strings(gained)
+	sort.Strings(lost)
+	return gained, lost
+}
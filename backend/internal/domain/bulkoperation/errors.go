@@ -0,0 +1,48 @@
+package bulkoperation
+
+import (
+	"strconv"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const MaxTargets = 1000
+
+var (
+	ErrOperationNotFound = shared.NewNotFoundError("BulkOperation", "")
+
+	ErrInvalidAction = shared.NewValidationError("action", "action must be one of activate, deactivate, ban, delete, set_roles")
+
+	ErrNoTargets = shared.NewBusinessRuleViolationError(
+		"bulk_operation_no_targets",
+		"bulk operation does not match any users",
+	)
+
+	ErrTooManyTargets = shared.NewBusinessRuleViolationErrorWithParams(
+		"bulk_operation_too_many_targets",
+		"a bulk operation may target at most {max} users",
+		map[string]string{"max": strconv.Itoa(MaxTargets)},
+	)
+
+	ErrSelfTarget = shared.NewBusinessRuleViolationError(
+		"bulk_operation_self_target",
+		"bulk operations cannot be applied to your own account",
+	)
+
+	ErrItemNotPending = shared.NewBusinessRuleViolationError(
+		"bulk_operation_item_processed",
+		"user has already been processed by this operation",
+	)
+)
+
+func NewOperationNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("BulkOperation", identifier)
+}
+
+func NewItemNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("BulkOperationItem", identifier)
+}
+
+func NewInvalidStatusTransitionError(current, target Status) *shared.InvalidStatusTransitionError {
+	return shared.NewInvalidStatusTransitionError(current.String(), target.String())
+}
@@ -0,0 +1,69 @@
+package bulkoperation
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeItemProcessed      = "bulk_operation.item_processed"
+	EventTypeOperationCompleted = "bulk_operation.completed"
+	EventTypeOperationFailed    = "bulk_operation.failed"
+)
+
+type ItemProcessedEvent struct {
+	shared.BaseDomainEvent
+	Action    Action
+	CreatedBy uuid.UUID
+	UserID    uuid.UUID
+	Succeeded bool
+	Failure   string
+}
+
+func NewItemProcessedEvent(operationID uuid.UUID, action Action, createdBy, userID uuid.UUID, succeeded bool, failure string) ItemProcessedEvent {
+	return ItemProcessedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(operationID, EventTypeItemProcessed),
+		Action:          action,
+		CreatedBy:       createdBy,
+		UserID:          userID,
+		Succeeded:       succeeded,
+		Failure:         failure,
+	}
+}
+
+type OperationCompletedEvent struct {
+	shared.BaseDomainEvent
+	Action    Action
+	CreatedBy uuid.UUID
+	Total     int
+	Succeeded int
+	Failed    int
+}
+
+func NewOperationCompletedEvent(operationID uuid.UUID, action Action, createdBy uuid.UUID, total, succeeded, failed int) OperationCompletedEvent {
+	return OperationCompletedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(operationID, EventTypeOperationCompleted),
+		Action:          action,
+		CreatedBy:       createdBy,
+		Total:           total,
+		Succeeded:       succeeded,
+		Failed:          failed,
+	}
+}
+
+type OperationFailedEvent struct {
+	shared.BaseDomainEvent
+	Action    Action
+	CreatedBy uuid.UUID
+	Reason    string
+}
+
+func NewOperationFailedEvent(operationID uuid.UUID, action Action, createdBy uuid.UUID, reason string) OperationFailedEvent {
+	return OperationFailedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(operationID, EventTypeOperationFailed),
+		Action:          action,
+		CreatedBy:       createdBy,
+		Reason:          reason,
+	}
+}
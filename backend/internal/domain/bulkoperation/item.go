@@ -0,0 +1,46 @@
+package bulkoperation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Item struct {
+	userID      uuid.UUID
+	status      ItemStatus
+	failure     string
+	processedAt *time.Time
+}
+
+type ReconstructItemParams struct {
+	UserID      uuid.UUID
+	Status      ItemStatus
+	Failure     string
+	ProcessedAt *time.Time
+}
+
+func ReconstructItem(params ReconstructItemParams) *Item {
+	return &Item{
+		userID:      params.UserID,
+		status:      params.Status,
+		failure:     params.Failure,
+		processedAt: params.ProcessedAt,
+	}
+}
+
+func (i *Item) UserID() uuid.UUID {
+	return i.userID
+}
+
+func (i *Item) Status() ItemStatus {
+	return i.status
+}
+
+func (i *Item) Failure() string {
+	return i.failure
+}
+
+func (i *Item) ProcessedAt() *time.Time {
+	return i.processedAt
+}
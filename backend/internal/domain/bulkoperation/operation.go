@@ -0,0 +1,250 @@
+package bulkoperation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Operation struct {
+	shared.AggregateRoot
+	action        Action
+	reason        string
+	roleIDs       []uuid.UUID
+	status        Status
+	createdBy     uuid.UUID
+	items         []*Item
+	failureReason string
+	startedAt     *time.Time
+	completedAt   *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+type NewOperationParams struct {
+	Action    Action
+	Reason    string
+	RoleIDs   []uuid.UUID
+	UserIDs   []uuid.UUID
+	CreatedBy uuid.UUID
+}
+
+func NewOperation(params NewOperationParams) (*Operation, error) {
+	if !params.Action.IsValid() {
+		return nil, ErrInvalidAction
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	if len(reason) > 500 {
+		return nil, shared.NewValidationError("reason", "reason cannot exceed 500 characters")
+	}
+	if params.Action == ActionBan && reason == "" {
+		return nil, shared.NewValidationError("reason", "reason is required to ban users")
+	}
+	if params.Action == ActionSetRoles && params.RoleIDs == nil {
+		return nil, shared.NewValidationError("role_ids", "role_ids is required to set roles")
+	}
+
+	items := make([]*Item, 0, len(params.UserIDs))
+	seen := make(map[uuid.UUID]bool, len(params.UserIDs))
+	for _, userID := range params.UserIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		items = append(items, &Item{userID: userID, status: ItemStatusPending})
+	}
+	if len(items) == 0 {
+		return nil, ErrNoTargets
+	}
+	if len(items) > MaxTargets {
+		return nil, ErrTooManyTargets
+	}
+
+	now := time.Now().UTC()
+	return &Operation{
+		AggregateRoot: shared.NewAggregateRoot(),
+		action:        params.Action,
+		reason:        reason,
+		roleIDs:       params.RoleIDs,
+		status:        StatusPending,
+		createdBy:     params.CreatedBy,
+		items:         items,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+type ReconstructOperationParams struct {
+	ID            uuid.UUID
+	Action        Action
+	Reason        string
+	RoleIDs       []uuid.UUID
+	Status        Status
+	CreatedBy     uuid.UUID
+	Items         []*Item
+	FailureReason string
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func ReconstructOperation(params ReconstructOperationParams) *Operation {
+	return &Operation{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		action:        params.Action,
+		reason:        params.Reason,
+		roleIDs:       params.RoleIDs,
+		status:        params.Status,
+		createdBy:     params.CreatedBy,
+		items:         params.Items,
+		failureReason: params.FailureReason,
+		startedAt:     params.StartedAt,
+		completedAt:   params.CompletedAt,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}
+}
+
+func (o *Operation) Action() Action {
+	return o.action
+}
+
+func (o *Operation) Reason() string {
+	return o.reason
+}
+
+func (o *Operation) RoleIDs() []uuid.UUID {
+	return o.roleIDs
+}
+
+func (o *Operation) Status() Status {
+	return o.status
+}
+
+func (o *Operation) CreatedBy() uuid.UUID {
+	return o.createdBy
+}
+
+func (o *Operation) Items() []*Item {
+	return o.items
+}
+
+func (o *Operation) FailureReason() string {
+	return o.failureReason
+}
+
+func (o *Operation) StartedAt() *time.Time {
+	return o.startedAt
+}
+
+func (o *Operation) CompletedAt() *time.Time {
+	return o.completedAt
+}
+
+func (o *Operation) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Operation) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+func (o *Operation) Counts() (succeeded, failed, pending int) {
+	for _, item := range o.items {
+		switch item.status {
+		case ItemStatusSucceeded:
+			succeeded++
+		case ItemStatusFailed:
+			failed++
+		default:
+			pending++
+		}
+	}
+	return succeeded, failed, pending
+}
+
+func (o *Operation) Start() error {
+	if err := o.transitionTo(StatusRunning); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	o.startedAt = &now
+	o.updatedAt = now
+	return nil
+}
+
+func (o *Operation) RecordSuccess(userID uuid.UUID) error {
+	return o.record(userID, ItemStatusSucceeded, "")
+}
+
+func (o *Operation) RecordFailure(userID uuid.UUID, failure string) error {
+	return o.record(userID, ItemStatusFailed, failure)
+}
+
+func (o *Operation) Complete() error {
+	if err := o.transitionTo(StatusCompleted); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	o.completedAt = &now
+	o.updatedAt = now
+
+	succeeded, failed, _ := o.Counts()
+	o.AddDomainEvent(NewOperationCompletedEvent(o.ID(), o.action, o.createdBy, len(o.items), succeeded, failed))
+	return nil
+}
+
+func (o *Operation) Fail(reason string) error {
+	if err := o.transitionTo(StatusFailed); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	o.failureReason = reason
+	o.completedAt = &now
+	o.updatedAt = now
+
+	o.AddDomainEvent(NewOperationFailedEvent(o.ID(), o.action, o.createdBy, reason))
+	return nil
+}
+
+func (o *Operation) record(userID uuid.UUID, status ItemStatus, failure string) error {
+	if o.status != StatusRunning {
+		return NewInvalidStatusTransitionError(o.status, StatusRunning)
+	}
+
+	for _, item := range o.items {
+		if item.userID != userID {
+			continue
+		}
+		if !item.status.IsPending() {
+			return ErrItemNotPending
+		}
+
+		now := time.Now().UTC()
+		item.status = status
+		item.failure = failure
+		item.processedAt = &now
+		o.updatedAt = now
+
+		o.AddDomainEvent(NewItemProcessedEvent(o.ID(), o.action, o.createdBy, userID, status == ItemStatusSucceeded, failure))
+		return nil
+	}
+
+	return NewItemNotFoundError(userID.String())
+}
+
+func (o *Operation) transitionTo(target Status) error {
+	if !o.status.CanTransitionTo(target) {
+		return NewInvalidStatusTransitionError(o.status, target)
+	}
+	o.status = target
+	return nil
+}
@@ -0,0 +1,138 @@
+package bulkoperation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOperation(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("deduplicates targets", func(t *testing.T) {
+		operation, err := NewOperation(NewOperationParams{
+			Action:    ActionDeactivate,
+			UserIDs:   []uuid.UUID{userID, userID},
+			CreatedBy: uuid.New(),
+		})
+		require.NoError(t, err)
+		assert.Len(t, operation.Items(), 1)
+		assert.Equal(t, StatusPending, operation.Status())
+		assert.Equal(t, ItemStatusPending, operation.Items()[0].Status())
+	})
+
+	t.Run("rejects unknown action", func(t *testing.T) {
+		_, err := NewOperation(NewOperationParams{
+			Action:    Action("promote"),
+			UserIDs:   []uuid.UUID{userID},
+			CreatedBy: uuid.New(),
+		})
+		assert.ErrorIs(t, err, ErrInvalidAction)
+	})
+
+	t.Run("requires a reason to ban", func(t *testing.T) {
+		_, err := NewOperation(NewOperationParams{
+			Action:    ActionBan,
+			UserIDs:   []uuid.UUID{userID},
+			CreatedBy: uuid.New(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reason")
+	})
+
+	t.Run("requires role ids to set roles", func(t *testing.T) {
+		_, err := NewOperation(NewOperationParams{
+			Action:    ActionSetRoles,
+			UserIDs:   []uuid.UUID{userID},
+			CreatedBy: uuid.New(),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "role_ids")
+	})
+
+	t.Run("rejects empty target list", func(t *testing.T) {
+		_, err := NewOperation(NewOperationParams{
+			Action:    ActionActivate,
+			CreatedBy: uuid.New(),
+		})
+		assert.ErrorIs(t, err, ErrNoTargets)
+	})
+
+	t.Run("rejects too many targets", func(t *testing.T) {
+		userIDs := make([]uuid.UUID, MaxTargets+1)
+		for i := range userIDs {
+			userIDs[i] = uuid.New()
+		}
+		_, err := NewOperation(NewOperationParams{
+			Action:    ActionActivate,
+			UserIDs:   userIDs,
+			CreatedBy: uuid.New(),
+		})
+		assert.ErrorIs(t, err, ErrTooManyTargets)
+	})
+}
+
+func TestOperation_Lifecycle(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+	createdBy := uuid.New()
+
+	operation, err := NewOperation(NewOperationParams{
+		Action:    ActionBan,
+		Reason:    "compromised accounts",
+		UserIDs:   []uuid.UUID{first, second},
+		CreatedBy: createdBy,
+	})
+	require.NoError(t, err)
+
+	assert.Error(t, operation.RecordSuccess(first), "items cannot be recorded before the operation starts")
+
+	require.NoError(t, operation.Start())
+	assert.NotNil(t, operation.StartedAt())
+
+	require.NoError(t, operation.RecordSuccess(first))
+	require.NoError(t, operation.RecordFailure(second, "user is already banned"))
+	assert.ErrorIs(t, operation.RecordSuccess(first), ErrItemNotPending)
+	assert.Error(t, operation.RecordSuccess(uuid.New()))
+
+	require.NoError(t, operation.Complete())
+	assert.Equal(t, StatusCompleted, operation.Status())
+	assert.NotNil(t, operation.CompletedAt())
+
+	succeeded, failed, pending := operation.Counts()
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, 1, failed)
+	assert.Zero(t, pending)
+
+	events := operation.DomainEvents()
+	require.Len(t, events, 3)
+	processed, ok := events[1].(ItemProcessedEvent)
+	require.True(t, ok)
+	assert.Equal(t, operation.ID(), processed.AggregateID())
+	assert.Equal(t, second, processed.UserID)
+	assert.False(t, processed.Succeeded)
+
+	completed, ok := events[2].(OperationCompletedEvent)
+	require.True(t, ok)
+	assert.Equal(t, createdBy, completed.CreatedBy)
+	assert.Equal(t, 2, completed.Total)
+	assert.Equal(t, 1, completed.Failed)
+}
+
+func TestOperation_Fail(t *testing.T) {
+	operation, err := NewOperation(NewOperationParams{
+		Action:    ActionDelete,
+		UserIDs:   []uuid.UUID{uuid.New()},
+		CreatedBy: uuid.New(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, operation.Start())
+
+	require.NoError(t, operation.Fail("interrupted by shutdown"))
+	assert.Equal(t, StatusFailed, operation.Status())
+	assert.Equal(t, "interrupted by shutdown", operation.FailureReason())
+
+	assert.Error(t, operation.Complete())
+}
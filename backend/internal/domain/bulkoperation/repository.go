@@ -0,0 +1,13 @@
+package bulkoperation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, operation *Operation) error
+	Update(ctx context.Context, operation *Operation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Operation, error)
+}
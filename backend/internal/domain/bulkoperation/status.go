@@ -0,0 +1,97 @@
+package bulkoperation
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+var validStatuses = map[Status]bool{
+	StatusPending:   true,
+	StatusRunning:   true,
+	StatusCompleted: true,
+	StatusFailed:    true,
+}
+
+var allowedTransitions = map[Status][]Status{
+	StatusPending:   {StatusRunning, StatusFailed},
+	StatusRunning:   {StatusCompleted, StatusFailed},
+	StatusCompleted: {},
+	StatusFailed:    {},
+}
+
+func (s Status) IsValid() bool {
+	return validStatuses[s]
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) CanTransitionTo(target Status) bool {
+	for _, status := range allowedTransitions[s] {
+		if status == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) IsFinished() bool {
+	return s == StatusCompleted || s == StatusFailed
+}
+
+func ParseStatus(s string) (Status, bool) {
+	status := Status(s)
+	return status, status.IsValid()
+}
+
+type Action string
+
+const (
+	ActionActivate   Action = "activate"
+	ActionDeactivate Action = "deactivate"
+	ActionBan        Action = "ban"
+	ActionDelete     Action = "delete"
+	ActionSetRoles   Action = "set_roles"
+)
+
+var validActions = map[Action]bool{
+	ActionActivate:   true,
+	ActionDeactivate: true,
+	ActionBan:        true,
+	ActionDelete:     true,
+	ActionSetRoles:   true,
+}
+
+func (a Action) IsValid() bool {
+	return validActions[a]
+}
+
+func (a Action) String() string {
+	return string(a)
+}
+
+func ParseAction(s string) (Action, bool) {
+	action := Action(s)
+	return action, action.IsValid()
+}
+
+type ItemStatus string
+
+const (
+	ItemStatusPending   ItemStatus = "pending"
+	ItemStatusSucceeded ItemStatus = "succeeded"
+	ItemStatusFailed    ItemStatus = "failed"
+)
+
+func (s ItemStatus) String() string {
+	return string(s)
+}
+
+func (s ItemStatus) IsPending() bool {
+	return s == ItemStatusPending
+}
@@ -0,0 +1,36 @@
+package group
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func EffectiveRoleIDs(ctx context.Context, repository Repository, userID uuid.UUID, directRoleIDs []uuid.UUID) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(directRoleIDs))
+	seen := make(map[uuid.UUID]bool, len(directRoleIDs))
+	for _, id := range directRoleIDs {
+		if !seen[id] {
+			result = append(result, id)
+			seen[id] = true
+		}
+	}
+
+	if repository == nil {
+		return result, nil
+	}
+
+	groupRoleIDs, err := repository.FindRoleIDsByMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range groupRoleIDs {
+		if !seen[id] {
+			result = append(result, id)
+			seen[id] = true
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package group
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrGroupNotFound = shared.NewNotFoundError("Group", "")
+
+	ErrGroupNameExists = shared.NewConflictError("Group", "name", "")
+
+	ErrAlreadyMember = shared.NewBusinessRuleViolationError(
+		"already_group_member",
+		"user is already a member of this group",
+	)
+
+	ErrNotMember = shared.NewBusinessRuleViolationError(
+		"not_group_member",
+		"user is not a member of this group",
+	)
+
+	ErrRoleAlreadyAssigned = shared.NewBusinessRuleViolationError(
+		"role_already_assigned_to_group",
+		"role is already assigned to this group",
+	)
+
+	ErrRoleNotAssigned = shared.NewBusinessRuleViolationError(
+		"role_not_assigned_to_group",
+		"role is not assigned to this group",
+	)
+
+	ErrRoleOutsideGroupOrganization = shared.NewBusinessRuleViolationError(
+		"role_outside_group_organization",
+		"role scope does not match the group's organization",
+	)
+
+	ErrMemberOutsideGroupOrganization = shared.NewBusinessRuleViolationError(
+		"member_outside_group_organization",
+		"user is not a member of the group's organization",
+	)
+)
+
+func NewGroupNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("Group", identifier)
+}
+
+func NewGroupNameExistsError(name string) *shared.ConflictError {
+	return shared.NewConflictError("Group", "name", name)
+}
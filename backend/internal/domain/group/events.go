@@ -0,0 +1,101 @@
+package group
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeGroupCreated       = "group.created"
+	EventTypeGroupUpdated       = "group.updated"
+	EventTypeGroupDeleted       = "group.deleted"
+	EventTypeGroupRoleAssigned  = "group.role.assigned"
+	EventTypeGroupRoleRevoked   = "group.role.revoked"
+	EventTypeGroupMemberAdded   = "group.member.added"
+	EventTypeGroupMemberRemoved = "group.member.removed"
+)
+
+type GroupCreatedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+}
+
+func NewGroupCreatedEvent(groupID uuid.UUID, name string) GroupCreatedEvent {
+	return GroupCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupCreated),
+		Name:            name,
+	}
+}
+
+type GroupUpdatedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+}
+
+func NewGroupUpdatedEvent(groupID uuid.UUID, name string) GroupUpdatedEvent {
+	return GroupUpdatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupUpdated),
+		Name:            name,
+	}
+}
+
+type GroupDeletedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+}
+
+func NewGroupDeletedEvent(groupID uuid.UUID, name string) GroupDeletedEvent {
+	return GroupDeletedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupDeleted),
+		Name:            name,
+	}
+}
+
+type GroupRoleAssignedEvent struct {
+	shared.BaseDomainEvent
+	RoleID uuid.UUID
+}
+
+func NewGroupRoleAssignedEvent(groupID, roleID uuid.UUID) GroupRoleAssignedEvent {
+	return GroupRoleAssignedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupRoleAssigned),
+		RoleID:          roleID,
+	}
+}
+
+type GroupRoleRevokedEvent struct {
+	shared.BaseDomainEvent
+	RoleID uuid.UUID
+}
+
+func NewGroupRoleRevokedEvent(groupID, roleID uuid.UUID) GroupRoleRevokedEvent {
+	return GroupRoleRevokedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupRoleRevoked),
+		RoleID:          roleID,
+	}
+}
+
+type GroupMemberAddedEvent struct {
+	shared.BaseDomainEvent
+	UserID uuid.UUID
+}
+
+func NewGroupMemberAddedEvent(groupID, userID uuid.UUID) GroupMemberAddedEvent {
+	return GroupMemberAddedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupMemberAdded),
+		UserID:          userID,
+	}
+}
+
+type GroupMemberRemovedEvent struct {
+	shared.BaseDomainEvent
+	UserID uuid.UUID
+}
+
+func NewGroupMemberRemovedEvent(groupID, userID uuid.UUID) GroupMemberRemovedEvent {
+	return GroupMemberRemovedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(groupID, EventTypeGroupMemberRemoved),
+		UserID:          userID,
+	}
+}
@@ -0,0 +1,170 @@
+package group
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Group struct {
+	shared.AggregateRoot
+	name           string
+	description    string
+	roleIDs        []uuid.UUID
+	organizationID *uuid.UUID
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+type NewGroupParams struct {
+	Name           string
+	Description    string
+	RoleIDs        []uuid.UUID
+	OrganizationID *uuid.UUID
+}
+
+func NewGroup(params NewGroupParams) (*Group, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return nil, shared.NewValidationError("name", "group name cannot be empty")
+	}
+	if len(name) > 100 {
+		return nil, shared.NewValidationError("name", "group name cannot exceed 100 characters")
+	}
+	if len(params.Description) > 500 {
+		return nil, shared.NewValidationError("description", "group description cannot exceed 500 characters")
+	}
+
+	now := time.Now().UTC()
+	group := &Group{
+		AggregateRoot:  shared.NewAggregateRoot(),
+		name:           name,
+		description:    params.Description,
+		roleIDs:        shared.UniqueIDs(params.RoleIDs),
+		organizationID: params.OrganizationID,
+		createdAt:      now,
+		updatedAt:      now,
+	}
+
+	group.AddDomainEvent(NewGroupCreatedEvent(group.ID(), name))
+
+	return group, nil
+}
+
+type ReconstructGroupParams struct {
+	ID             uuid.UUID
+	Name           string
+	Description    string
+	RoleIDs        []uuid.UUID
+	OrganizationID *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func ReconstructGroup(params ReconstructGroupParams) *Group {
+	roleIDs := params.RoleIDs
+	if roleIDs == nil {
+		roleIDs = make([]uuid.UUID, 0)
+	}
+
+	return &Group{
+		AggregateRoot:  shared.NewAggregateRootWithID(params.ID),
+		name:           params.Name,
+		description:    params.Description,
+		roleIDs:        roleIDs,
+		organizationID: params.OrganizationID,
+		createdAt:      params.CreatedAt,
+		updatedAt:      params.UpdatedAt,
+	}
+}
+
+func (g *Group) Name() string {
+	return g.name
+}
+
+func (g *Group) Description() string {
+	return g.description
+}
+
+func (g *Group) RoleIDs() []uuid.UUID {
+	result := make([]uuid.UUID, len(g.roleIDs))
+	copy(result, g.roleIDs)
+	return result
+}
+
+func (g *Group) OrganizationID() *uuid.UUID {
+	return g.organizationID
+}
+
+func (g *Group) CreatedAt() time.Time {
+	return g.createdAt
+}
+
+func (g *Group) UpdatedAt() time.Time {
+	return g.updatedAt
+}
+
+func (g *Group) HasRole(roleID uuid.UUID) bool {
+	for _, id := range g.roleIDs {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Group) UpdateDetails(name, description string) error {
+	changed := false
+
+	name = strings.TrimSpace(name)
+	if name != "" && name != g.name {
+		if len(name) > 100 {
+			return shared.NewValidationError("name", "group name cannot exceed 100 characters")
+		}
+		g.name = name
+		changed = true
+	}
+
+	if description != g.description {
+		if len(description) > 500 {
+			return shared.NewValidationError("description", "group description cannot exceed 500 characters")
+		}
+		g.description = description
+		changed = true
+	}
+
+	if changed {
+		g.updatedAt = time.Now().UTC()
+		g.AddDomainEvent(NewGroupUpdatedEvent(g.ID(), g.name))
+	}
+
+	return nil
+}
+
+func (g *Group) AssignRole(roleID uuid.UUID) error {
+	if g.HasRole(roleID) {
+		return ErrRoleAlreadyAssigned
+	}
+
+	g.roleIDs = append(g.roleIDs, roleID)
+	g.updatedAt = time.Now().UTC()
+	g.AddDomainEvent(NewGroupRoleAssignedEvent(g.ID(), roleID))
+
+	return nil
+}
+
+func (g *Group) RevokeRole(roleID uuid.UUID) error {
+	for i, id := range g.roleIDs {
+		if id == roleID {
+			g.roleIDs = append(g.roleIDs[:i], g.roleIDs[i+1:]...)
+			g.updatedAt = time.Now().UTC()
+			g.AddDomainEvent(NewGroupRoleRevokedEvent(g.ID(), roleID))
+			return nil
+		}
+	}
+
+	return ErrRoleNotAssigned
+}
@@ -0,0 +1,123 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGroup(t *testing.T) {
+	duplicateRoleID := uuid.New()
+
+	tests := []struct {
+		name        string
+		params      NewGroupParams
+		wantRoles   int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "valid group",
+			params: NewGroupParams{
+				Name:        "Support Team",
+				Description: "Customer support staff",
+			},
+			wantRoles: 0,
+		},
+		{
+			name: "duplicate role ids are collapsed",
+			params: NewGroupParams{
+				Name:    "Operators",
+				RoleIDs: []uuid.UUID{duplicateRoleID, duplicateRoleID},
+			},
+			wantRoles: 1,
+		},
+		{
+			name:        "empty name",
+			params:      NewGroupParams{Name: "   "},
+			wantErr:     true,
+			errContains: "group name cannot be empty",
+		},
+		{
+			name:        "name too long",
+			params:      NewGroupParams{Name: strings.Repeat("a", 101)},
+			wantErr:     true,
+			errContains: "group name cannot exceed 100 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := NewGroup(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, group)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, group.ID())
+			assert.Len(t, group.RoleIDs(), tt.wantRoles)
+
+			events := group.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, EventTypeGroupCreated, events[0].EventType())
+		})
+	}
+}
+
+func TestGroup_AssignAndRevokeRole(t *testing.T) {
+	group, err := NewGroup(NewGroupParams{Name: "Support Team"})
+	require.NoError(t, err)
+	group.ClearDomainEvents()
+
+	roleID := uuid.New()
+	require.NoError(t, group.AssignRole(roleID))
+	assert.True(t, group.HasRole(roleID))
+	assert.ErrorIs(t, group.AssignRole(roleID), ErrRoleAlreadyAssigned)
+
+	require.NoError(t, group.RevokeRole(roleID))
+	assert.False(t, group.HasRole(roleID))
+	assert.ErrorIs(t, group.RevokeRole(roleID), ErrRoleNotAssigned)
+
+	events := group.DomainEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, EventTypeGroupRoleAssigned, events[0].EventType())
+	assert.Equal(t, EventTypeGroupRoleRevoked, events[1].EventType())
+}
+
+type stubRepository struct {
+	Repository
+	roleIDs []uuid.UUID
+	err     error
+}
+
+func (s *stubRepository) FindRoleIDsByMember(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return s.roleIDs, s.err
+}
+
+func TestEffectiveRoleIDs(t *testing.T) {
+	userID := uuid.New()
+	directRoleID := uuid.New()
+	groupRoleID := uuid.New()
+
+	roleIDs, err := EffectiveRoleIDs(context.Background(), nil, userID, []uuid.UUID{directRoleID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{directRoleID}, roleIDs)
+
+	repository := &stubRepository{roleIDs: []uuid.UUID{directRoleID, groupRoleID}}
+	roleIDs, err = EffectiveRoleIDs(context.Background(), repository, userID, []uuid.UUID{directRoleID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{directRoleID, groupRoleID}, roleIDs)
+
+	repository.err = errors.New("database error")
+	_, err = EffectiveRoleIDs(context.Background(), repository, userID, nil)
+	assert.Error(t, err)
+}
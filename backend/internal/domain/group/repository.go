@@ -0,0 +1,30 @@
+package group
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Filter struct {
+	OrganizationID *uuid.UUID
+	Search         *string
+}
+
+type Repository interface {
+	Create(ctx context.Context, group *Group) error
+	Update(ctx context.Context, group *Group) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Group, error)
+	ExistsByName(ctx context.Context, name string, organizationID *uuid.UUID) (bool, error)
+	List(ctx context.Context, filter Filter, pagination shared.Pagination) ([]*Group, int64, error)
+	FindByMember(ctx context.Context, userID uuid.UUID) ([]*Group, error)
+	FindRoleIDsByMember(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	FindMemberIDsByRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error)
+	AddMember(ctx context.Context, groupID, userID uuid.UUID) error
+	RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error
+	IsMember(ctx context.Context, groupID, userID uuid.UUID) (bool, error)
+	ListMemberIDs(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error)
+}
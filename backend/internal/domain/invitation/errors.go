@@ -0,0 +1,29 @@
+package invitation
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrInvitationNotFound = shared.NewNotFoundError("Invitation", "")
+
+	ErrPendingInvitationExists = shared.NewConflictError("Invitation", "email", "")
+
+	ErrInvalidInvitationToken = shared.NewBusinessRuleViolationError(
+		"invalid_invitation_token",
+		"invitation token is invalid or has already been used",
+	)
+
+	ErrInvitationExpired = shared.NewBusinessRuleViolationError(
+		"invitation_expired",
+		"invitation has expired",
+	)
+)
+
+func NewInvitationNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("Invitation", identifier)
+}
+
+func NewInvalidStatusTransitionError(current, target Status) *shared.InvalidStatusTransitionError {
+	return shared.NewInvalidStatusTransitionError(current.String(), target.String())
+}
@@ -0,0 +1,93 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeInvitationCreated  = "invitation.created"
+	EventTypeInvitationResent   = "invitation.resent"
+	EventTypeInvitationRevoked  = "invitation.revoked"
+	EventTypeInvitationAccepted = "invitation.accepted"
+	EventTypeInvitationExpired  = "invitation.expired"
+)
+
+type InvitationCreatedEvent struct {
+	shared.BaseDomainEvent
+	Email     string
+	RoleIDs   []uuid.UUID
+	InvitedBy uuid.UUID
+	ExpiresAt time.Time
+}
+
+func NewInvitationCreatedEvent(invitationID uuid.UUID, email string, roleIDs []uuid.UUID, invitedBy uuid.UUID, expiresAt time.Time) InvitationCreatedEvent {
+	return InvitationCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(invitationID, EventTypeInvitationCreated),
+		Email:           email,
+		RoleIDs:         roleIDs,
+		InvitedBy:       invitedBy,
+		ExpiresAt:       expiresAt,
+	}
+}
+
+type InvitationResentEvent struct {
+	shared.BaseDomainEvent
+	Email     string
+	ResentBy  uuid.UUID
+	ExpiresAt time.Time
+}
+
+func NewInvitationResentEvent(invitationID uuid.UUID, email string, resentBy uuid.UUID, expiresAt time.Time) InvitationResentEvent {
+	return InvitationResentEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(invitationID, EventTypeInvitationResent),
+		Email:           email,
+		ResentBy:        resentBy,
+		ExpiresAt:       expiresAt,
+	}
+}
+
+type InvitationRevokedEvent struct {
+	shared.BaseDomainEvent
+	Email     string
+	RevokedBy uuid.UUID
+}
+
+func NewInvitationRevokedEvent(invitationID uuid.UUID, email string, revokedBy uuid.UUID) InvitationRevokedEvent {
+	return InvitationRevokedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(invitationID, EventTypeInvitationRevoked),
+		Email:           email,
+		RevokedBy:       revokedBy,
+	}
+}
+
+type InvitationAcceptedEvent struct {
+	shared.BaseDomainEvent
+	Email     string
+	UserID    uuid.UUID
+	InvitedBy uuid.UUID
+}
+
+func NewInvitationAcceptedEvent(invitationID uuid.UUID, email string, userID, invitedBy uuid.UUID) InvitationAcceptedEvent {
+	return InvitationAcceptedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(invitationID, EventTypeInvitationAccepted),
+		Email:           email,
+		UserID:          userID,
+		InvitedBy:       invitedBy,
+	}
+}
+
+type InvitationExpiredEvent struct {
+	shared.BaseDomainEvent
+	Email string
+}
+
+func NewInvitationExpiredEvent(invitationID uuid.UUID, email string) InvitationExpiredEvent {
+	return InvitationExpiredEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(invitationID, EventTypeInvitationExpired),
+		Email:           email,
+	}
+}
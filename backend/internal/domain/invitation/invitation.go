@@ -0,0 +1,235 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// Invitation lets an administrator onboard a user without choosing their
+// password. The invitee receives a single-use link and, on acceptance,
+// sets their own name and password; the account is created active with the
+// roles chosen at invitation time. Only a hash of the link token is kept.
+type Invitation struct {
+	shared.AggregateRoot
+	email      shared.Email
+	roleIDs    []uuid.UUID
+	invitedBy  uuid.UUID
+	tokenHash  string
+	status     Status
+	expiresAt  time.Time
+	userID     *uuid.UUID
+	acceptedAt *time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+type NewInvitationParams struct {
+	Email     string
+	RoleIDs   []uuid.UUID
+	InvitedBy uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func NewInvitation(params NewInvitationParams) (*Invitation, error) {
+	email, err := shared.NewEmail(params.Email)
+	if err != nil {
+		return nil, err
+	}
+	if params.TokenHash == "" {
+		return nil, shared.NewValidationError("token_hash", "token hash is required")
+	}
+
+	now := time.Now().UTC()
+	if !params.ExpiresAt.After(now) {
+		return nil, shared.NewValidationError("expires_at", "expiration time must be in the future")
+	}
+
+	invitation := &Invitation{
+		AggregateRoot: shared.NewAggregateRoot(),
+		email:         email,
+		roleIDs:       shared.UniqueIDs(params.RoleIDs),
+		invitedBy:     params.InvitedBy,
+		tokenHash:     params.TokenHash,
+		status:        StatusPending,
+		expiresAt:     params.ExpiresAt,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	invitation.AddDomainEvent(NewInvitationCreatedEvent(invitation.ID(), email.String(), invitation.RoleIDs(), params.InvitedBy, params.ExpiresAt))
+
+	return invitation, nil
+}
+
+type ReconstructInvitationParams struct {
+	ID         uuid.UUID
+	Email      string
+	RoleIDs    []uuid.UUID
+	InvitedBy  uuid.UUID
+	TokenHash  string
+	Status     Status
+	ExpiresAt  time.Time
+	UserID     *uuid.UUID
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func ReconstructInvitation(params ReconstructInvitationParams) (*Invitation, error) {
+	email, err := shared.NewEmail(params.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(params.RoleIDs))
+	roleIDs = append(roleIDs, params.RoleIDs...)
+
+	return &Invitation{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		email:         email,
+		roleIDs:       roleIDs,
+		invitedBy:     params.InvitedBy,
+		tokenHash:     params.TokenHash,
+		status:        params.Status,
+		expiresAt:     params.ExpiresAt,
+		userID:        params.UserID,
+		acceptedAt:    params.AcceptedAt,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}, nil
+}
+
+func (i *Invitation) Email() shared.Email {
+	return i.email
+}
+
+func (i *Invitation) RoleIDs() []uuid.UUID {
+	result := make([]uuid.UUID, len(i.roleIDs))
+	copy(result, i.roleIDs)
+	return result
+}
+
+func (i *Invitation) InvitedBy() uuid.UUID {
+	return i.invitedBy
+}
+
+func (i *Invitation) TokenHash() string {
+	return i.tokenHash
+}
+
+func (i *Invitation) Status() Status {
+	return i.status
+}
+
+func (i *Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *Invitation) UserID() *uuid.UUID {
+	return i.userID
+}
+
+func (i *Invitation) AcceptedAt() *time.Time {
+	return i.acceptedAt
+}
+
+func (i *Invitation) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *Invitation) UpdatedAt() time.Time {
+	return i.updatedAt
+}
+
+// IsExpiredAt reports whether a pending invitation can no longer be
+// accepted. Expiry is only persisted when someone tries to use the link,
+// so callers should not rely on the stored status alone.
+func (i *Invitation) IsExpiredAt(now time.Time) bool {
+	return i.status.IsPending() && !now.Before(i.expiresAt)
+}
+
+// EffectiveStatus is the status as seen at the given time, reporting a
+// lapsed pending invitation as expired.
+func (i *Invitation) EffectiveStatus(now time.Time) Status {
+	if i.IsExpiredAt(now) {
+		return StatusExpired
+	}
+	return i.status
+}
+
+// Resend replaces the link token and extends the expiry. The previous link
+// stops working. An expired invitation becomes pending again.
+func (i *Invitation) Resend(resentBy uuid.UUID, tokenHash string, expiresAt time.Time) error {
+	if tokenHash == "" {
+		return shared.NewValidationError("token_hash", "token hash is required")
+	}
+	now := time.Now().UTC()
+	if !expiresAt.After(now) {
+		return shared.NewValidationError("expires_at", "expiration time must be in the future")
+	}
+	if i.status == StatusExpired {
+		if err := i.transitionTo(StatusPending); err != nil {
+			return err
+		}
+	} else if !i.status.IsPending() {
+		return NewInvalidStatusTransitionError(i.status, StatusPending)
+	}
+
+	i.tokenHash = tokenHash
+	i.expiresAt = expiresAt
+	i.updatedAt = now
+
+	i.AddDomainEvent(NewInvitationResentEvent(i.ID(), i.email.String(), resentBy, expiresAt))
+	return nil
+}
+
+func (i *Invitation) Revoke(revokedBy uuid.UUID) error {
+	if err := i.transitionTo(StatusRevoked); err != nil {
+		return err
+	}
+
+	i.AddDomainEvent(NewInvitationRevokedEvent(i.ID(), i.email.String(), revokedBy))
+	return nil
+}
+
+// Accept records that the invitee created their account. It fails with
+// ErrInvitationExpired once the link has lapsed; callers should then Expire
+// the invitation so the stored status catches up.
+func (i *Invitation) Accept(userID uuid.UUID, now time.Time) error {
+	if i.IsExpiredAt(now) {
+		return ErrInvitationExpired
+	}
+	if err := i.transitionTo(StatusAccepted); err != nil {
+		return err
+	}
+
+	acceptedAt := i.updatedAt
+	i.userID = &userID
+	i.acceptedAt = &acceptedAt
+
+	i.AddDomainEvent(NewInvitationAcceptedEvent(i.ID(), i.email.String(), userID, i.invitedBy))
+	return nil
+}
+
+func (i *Invitation) Expire() error {
+	if err := i.transitionTo(StatusExpired); err != nil {
+		return err
+	}
+
+	i.AddDomainEvent(NewInvitationExpiredEvent(i.ID(), i.email.String()))
+	return nil
+}
+
+func (i *Invitation) transitionTo(target Status) error {
+	if !i.status.CanTransitionTo(target) {
+		return NewInvalidStatusTransitionError(i.status, target)
+	}
+
+	i.status = target
+	i.updatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,171 @@
+package invitation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func newPendingInvitation(t *testing.T) *Invitation {
+	t.Helper()
+	invitation, err := NewInvitation(NewInvitationParams{
+		Email:     "invitee@example.com",
+		RoleIDs:   []uuid.UUID{uuid.New()},
+		InvitedBy: uuid.New(),
+		TokenHash: "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	invitation.ClearDomainEvents()
+	return invitation
+}
+
+func TestNewInvitation(t *testing.T) {
+	roleID := uuid.New()
+
+	tests := []struct {
+		name        string
+		params      NewInvitationParams
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "valid invitation",
+			params: NewInvitationParams{
+				Email:     "Invitee@Example.com",
+				RoleIDs:   []uuid.UUID{roleID, roleID},
+				InvitedBy: uuid.New(),
+				TokenHash: "hash",
+				ExpiresAt: time.Now().Add(time.Hour),
+			},
+		},
+		{
+			name: "invalid email",
+			params: NewInvitationParams{
+				Email:     "not-an-email",
+				TokenHash: "hash",
+				ExpiresAt: time.Now().Add(time.Hour),
+			},
+			wantErr:     true,
+			errContains: "invalid email format",
+		},
+		{
+			name: "missing token hash",
+			params: NewInvitationParams{
+				Email:     "invitee@example.com",
+				ExpiresAt: time.Now().Add(time.Hour),
+			},
+			wantErr:     true,
+			errContains: "token hash is required",
+		},
+		{
+			name: "expiry in the past",
+			params: NewInvitationParams{
+				Email:     "invitee@example.com",
+				TokenHash: "hash",
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			wantErr:     true,
+			errContains: "expiration time must be in the future",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation, err := NewInvitation(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, StatusPending, invitation.Status())
+			assert.Equal(t, "invitee@example.com", invitation.Email().String())
+			assert.Equal(t, []uuid.UUID{roleID}, invitation.RoleIDs())
+			events := invitation.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, EventTypeInvitationCreated, events[0].EventType())
+		})
+	}
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	t.Run("records the created user", func(t *testing.T) {
+		invitation := newPendingInvitation(t)
+		userID := uuid.New()
+
+		require.NoError(t, invitation.Accept(userID, time.Now()))
+
+		assert.Equal(t, StatusAccepted, invitation.Status())
+		assert.Equal(t, userID, *invitation.UserID())
+		assert.NotNil(t, invitation.AcceptedAt())
+		events := invitation.DomainEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTypeInvitationAccepted, events[0].EventType())
+	})
+
+	t.Run("lapsed invitation is expired", func(t *testing.T) {
+		invitation := newPendingInvitation(t)
+		later := invitation.ExpiresAt().Add(time.Second)
+
+		assert.ErrorIs(t, invitation.Accept(uuid.New(), later), ErrInvitationExpired)
+		assert.Equal(t, StatusPending, invitation.Status())
+		assert.Equal(t, StatusExpired, invitation.EffectiveStatus(later))
+	})
+
+	t.Run("cannot accept twice", func(t *testing.T) {
+		invitation := newPendingInvitation(t)
+		require.NoError(t, invitation.Accept(uuid.New(), time.Now()))
+
+		err := invitation.Accept(uuid.New(), time.Now())
+
+		assert.True(t, shared.IsInvalidStatusTransitionError(err))
+	})
+}
+
+func TestInvitation_Resend(t *testing.T) {
+	t.Run("replaces token and revives expired invitation", func(t *testing.T) {
+		invitation := newPendingInvitation(t)
+		require.NoError(t, invitation.Expire())
+		invitation.ClearDomainEvents()
+		expiresAt := time.Now().Add(48 * time.Hour)
+
+		require.NoError(t, invitation.Resend(uuid.New(), "new-hash", expiresAt))
+
+		assert.Equal(t, StatusPending, invitation.Status())
+		assert.Equal(t, "new-hash", invitation.TokenHash())
+		assert.Equal(t, expiresAt, invitation.ExpiresAt())
+		events := invitation.DomainEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, EventTypeInvitationResent, events[0].EventType())
+	})
+
+	t.Run("revoked invitation cannot be resent", func(t *testing.T) {
+		invitation := newPendingInvitation(t)
+		require.NoError(t, invitation.Revoke(uuid.New()))
+
+		err := invitation.Resend(uuid.New(), "new-hash", time.Now().Add(time.Hour))
+
+		assert.True(t, shared.IsInvalidStatusTransitionError(err))
+		assert.Equal(t, "hash", invitation.TokenHash())
+	})
+}
+
+func TestInvitation_Revoke(t *testing.T) {
+	invitation := newPendingInvitation(t)
+
+	require.NoError(t, invitation.Revoke(uuid.New()))
+	assert.Equal(t, StatusRevoked, invitation.Status())
+	assert.True(t, shared.IsInvalidStatusTransitionError(invitation.Revoke(uuid.New())))
+
+	accepted := newPendingInvitation(t)
+	require.NoError(t, accepted.Accept(uuid.New(), time.Now()))
+	assert.True(t, shared.IsInvalidStatusTransitionError(accepted.Revoke(uuid.New())))
+}
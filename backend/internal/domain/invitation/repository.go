@@ -0,0 +1,29 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// Filter narrows invitation listings. Pending and expired are matched on
+// the effective status, so a pending invitation past its expiry is listed
+// as expired even before anyone has tried to accept it.
+type Filter struct {
+	Status *Status
+	Email  string
+}
+
+type Repository interface {
+	Create(ctx context.Context, invitation *Invitation) error
+	Update(ctx context.Context, invitation *Invitation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Invitation, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+	List(ctx context.Context, filter Filter, pagination shared.Pagination) ([]*Invitation, int64, error)
+	ExistsPendingForEmail(ctx context.Context, email string) (bool, error)
+	// DeleteAllForEmail removes every invitation sent to email, whatever its
+	// status, and returns how many were removed.
+	DeleteAllForEmail(ctx context.Context, email string) (int64, error)
+}
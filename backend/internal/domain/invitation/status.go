@@ -0,0 +1,50 @@
+package invitation
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRevoked  Status = "revoked"
+	StatusExpired  Status = "expired"
+)
+
+var validStatuses = map[Status]bool{
+	StatusPending:  true,
+	StatusAccepted: true,
+	StatusRevoked:  true,
+	StatusExpired:  true,
+}
+
+var allowedTransitions = map[Status][]Status{
+	StatusPending:  {StatusAccepted, StatusRevoked, StatusExpired},
+	StatusAccepted: {},
+	StatusRevoked:  {},
+	StatusExpired:  {StatusPending, StatusRevoked},
+}
+
+func (s Status) IsValid() bool {
+	return validStatuses[s]
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) CanTransitionTo(target Status) bool {
+	for _, status := range allowedTransitions[s] {
+		if status == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) IsPending() bool {
+	return s == StatusPending
+}
+
+func ParseStatus(s string) (Status, bool) {
+	status := Status(s)
+	return status, status.IsValid()
+}
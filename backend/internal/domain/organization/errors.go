@@ -0,0 +1,31 @@
+package organization
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrOrganizationNotFound = shared.NewNotFoundError("Organization", "")
+
+	ErrOrganizationSlugExists = shared.NewConflictError("Organization", "slug", "")
+
+	ErrAlreadyMember = shared.NewBusinessRuleViolationError(
+		"already_organization_member",
+		"user is already a member of this organization",
+	)
+
+	ErrNotMember = shared.NewAuthorizationError("access", "organization (not a member)")
+
+	ErrRoleOutsideOrganization = shared.NewBusinessRuleViolationError(
+		"role_outside_organization",
+		"role belongs to an organization the user is not a member of",
+	)
+)
+
+func NewOrganizationNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("Organization", identifier)
+}
+
+func NewOrganizationSlugExistsError(slug string) *shared.ConflictError {
+	return shared.NewConflictError("Organization", "slug", slug)
+}
@@ -0,0 +1,77 @@
+package organization
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeOrganizationCreated       = "organization.created"
+	EventTypeOrganizationUpdated       = "organization.updated"
+	EventTypeOrganizationDeleted       = "organization.deleted"
+	EventTypeOrganizationMemberAdded   = "organization.member.added"
+	EventTypeOrganizationMemberRemoved = "organization.member.removed"
+)
+
+type OrganizationCreatedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+	Slug string
+}
+
+func NewOrganizationCreatedEvent(organizationID uuid.UUID, name, slug string) OrganizationCreatedEvent {
+	return OrganizationCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(organizationID, EventTypeOrganizationCreated),
+		Name:            name,
+		Slug:            slug,
+	}
+}
+
+type OrganizationUpdatedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+}
+
+func NewOrganizationUpdatedEvent(organizationID uuid.UUID, name string) OrganizationUpdatedEvent {
+	return OrganizationUpdatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(organizationID, EventTypeOrganizationUpdated),
+		Name:            name,
+	}
+}
+
+type OrganizationDeletedEvent struct {
+	shared.BaseDomainEvent
+	Slug string
+}
+
+func NewOrganizationDeletedEvent(organizationID uuid.UUID, slug string) OrganizationDeletedEvent {
+	return OrganizationDeletedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(organizationID, EventTypeOrganizationDeleted),
+		Slug:            slug,
+	}
+}
+
+type OrganizationMemberAddedEvent struct {
+	shared.BaseDomainEvent
+	UserID uuid.UUID
+}
+
+func NewOrganizationMemberAddedEvent(organizationID, userID uuid.UUID) OrganizationMemberAddedEvent {
+	return OrganizationMemberAddedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(organizationID, EventTypeOrganizationMemberAdded),
+		UserID:          userID,
+	}
+}
+
+type OrganizationMemberRemovedEvent struct {
+	shared.BaseDomainEvent
+	UserID uuid.UUID
+}
+
+func NewOrganizationMemberRemovedEvent(organizationID, userID uuid.UUID) OrganizationMemberRemovedEvent {
+	return OrganizationMemberRemovedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(organizationID, EventTypeOrganizationMemberRemoved),
+		UserID:          userID,
+	}
+}
@@ -0,0 +1,50 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Membership struct {
+	organizationID uuid.UUID
+	userID         uuid.UUID
+	joinedAt       time.Time
+}
+
+func NewMembership(organizationID, userID uuid.UUID) (*Membership, error) {
+	if organizationID == uuid.Nil {
+		return nil, shared.NewValidationError("organization_id", "organization ID is required")
+	}
+	if userID == uuid.Nil {
+		return nil, shared.NewValidationError("user_id", "user ID is required")
+	}
+
+	return &Membership{
+		organizationID: organizationID,
+		userID:         userID,
+		joinedAt:       time.Now().UTC(),
+	}, nil
+}
+
+func ReconstructMembership(organizationID, userID uuid.UUID, joinedAt time.Time) *Membership {
+	return &Membership{
+		organizationID: organizationID,
+		userID:         userID,
+		joinedAt:       joinedAt,
+	}
+}
+
+func (m *Membership) OrganizationID() uuid.UUID {
+	return m.organizationID
+}
+
+func (m *Membership) UserID() uuid.UUID {
+	return m.userID
+}
+
+func (m *Membership) JoinedAt() time.Time {
+	return m.joinedAt
+}
@@ -0,0 +1,132 @@
+package organization
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var organizationSlugRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+type Organization struct {
+	shared.AggregateRoot
+	name        string
+	slug        string
+	description string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type NewOrganizationParams struct {
+	Name        string
+	Slug        string
+	Description string
+}
+
+func NewOrganization(params NewOrganizationParams) (*Organization, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return nil, shared.NewValidationError("name", "organization name cannot be empty")
+	}
+	if len(name) > 255 {
+		return nil, shared.NewValidationError("name", "organization name cannot exceed 255 characters")
+	}
+
+	slug := strings.ToLower(strings.TrimSpace(params.Slug))
+	if slug == "" {
+		return nil, shared.NewValidationError("slug", "organization slug cannot be empty")
+	}
+	if len(slug) > 100 {
+		return nil, shared.NewValidationError("slug", "organization slug cannot exceed 100 characters")
+	}
+	if !organizationSlugRegex.MatchString(slug) {
+		return nil, shared.NewValidationError("slug", "organization slug must be lowercase alphanumeric with hyphens")
+	}
+
+	now := time.Now().UTC()
+	organization := &Organization{
+		AggregateRoot: shared.NewAggregateRoot(),
+		name:          name,
+		slug:          slug,
+		description:   params.Description,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	organization.AddDomainEvent(NewOrganizationCreatedEvent(organization.ID(), name, slug))
+
+	return organization, nil
+}
+
+type ReconstructOrganizationParams struct {
+	ID          uuid.UUID
+	Name        string
+	Slug        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func ReconstructOrganization(params ReconstructOrganizationParams) (*Organization, error) {
+	if params.Slug == "" {
+		return nil, shared.NewValidationError("slug", "organization slug cannot be empty")
+	}
+
+	return &Organization{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		name:          params.Name,
+		slug:          params.Slug,
+		description:   params.Description,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}, nil
+}
+
+func (o *Organization) Name() string {
+	return o.name
+}
+
+func (o *Organization) Slug() string {
+	return o.slug
+}
+
+func (o *Organization) Description() string {
+	return o.description
+}
+
+func (o *Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *Organization) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+func (o *Organization) UpdateDetails(name, description string) error {
+	changed := false
+
+	name = strings.TrimSpace(name)
+	if name != "" && name != o.name {
+		if len(name) > 255 {
+			return shared.NewValidationError("name", "organization name cannot exceed 255 characters")
+		}
+		o.name = name
+		changed = true
+	}
+
+	if description != o.description {
+		o.description = description
+		changed = true
+	}
+
+	if changed {
+		o.updatedAt = time.Now().UTC()
+		o.AddDomainEvent(NewOrganizationUpdatedEvent(o.ID(), o.name))
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package organization
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganization(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      NewOrganizationParams
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "valid organization",
+			params: NewOrganizationParams{
+				Name:        "Acme Corporation",
+				Slug:        "acme",
+				Description: "Acme customer tenant",
+			},
+			wantErr: false,
+		},
+		{
+			name: "slug is normalized to lowercase",
+			params: NewOrganizationParams{
+				Name: "Globex",
+				Slug: "  Globex-Corp ",
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty name",
+			params: NewOrganizationParams{
+				Name: "  ",
+				Slug: "acme",
+			},
+			wantErr:     true,
+			errContains: "organization name cannot be empty",
+		},
+		{
+			name: "empty slug",
+			params: NewOrganizationParams{
+				Name: "Acme",
+				Slug: "",
+			},
+			wantErr:     true,
+			errContains: "organization slug cannot be empty",
+		},
+		{
+			name: "invalid slug characters",
+			params: NewOrganizationParams{
+				Name: "Acme",
+				Slug: "acme_corp!",
+			},
+			wantErr:     true,
+			errContains: "lowercase alphanumeric with hyphens",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := NewOrganization(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, org)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, org)
+			assert.NotEqual(t, uuid.Nil, org.ID())
+			assert.Regexp(t, organizationSlugRegex, org.Slug())
+			assert.False(t, org.CreatedAt().IsZero())
+
+			events := org.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, EventTypeOrganizationCreated, events[0].EventType())
+		})
+	}
+}
+
+func TestOrganization_UpdateDetails(t *testing.T) {
+	org, err := NewOrganization(NewOrganizationParams{Name: "Acme", Slug: "acme"})
+	require.NoError(t, err)
+	org.ClearDomainEvents()
+
+	err = org.UpdateDetails("Acme Inc", "updated")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Inc", org.Name())
+	assert.Equal(t, "updated", org.Description())
+	require.Len(t, org.DomainEvents(), 1)
+	assert.Equal(t, EventTypeOrganizationUpdated, org.DomainEvents()[0].EventType())
+
+	org.ClearDomainEvents()
+	err = org.UpdateDetails("Acme Inc", "updated")
+	require.NoError(t, err)
+	assert.Empty(t, org.DomainEvents())
+}
+
+func TestNewMembership(t *testing.T) {
+	organizationID := uuid.New()
+	userID := uuid.New()
+
+	membership, err := NewMembership(organizationID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, organizationID, membership.OrganizationID())
+	assert.Equal(t, userID, membership.UserID())
+	assert.False(t, membership.JoinedAt().IsZero())
+
+	_, err = NewMembership(uuid.Nil, userID)
+	assert.Error(t, err)
+
+	_, err = NewMembership(organizationID, uuid.Nil)
+	assert.Error(t, err)
+}
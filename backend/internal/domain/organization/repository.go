@@ -0,0 +1,24 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Repository interface {
+	Create(ctx context.Context, organization *Organization) error
+	Update(ctx context.Context, organization *Organization) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Organization, error)
+	FindBySlug(ctx context.Context, slug string) (*Organization, error)
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	List(ctx context.Context, pagination shared.Pagination) ([]*Organization, int64, error)
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*Organization, error)
+	AddMember(ctx context.Context, membership *Membership) error
+	RemoveMember(ctx context.Context, organizationID, userID uuid.UUID) error
+	IsMember(ctx context.Context, organizationID, userID uuid.UUID) (bool, error)
+	ListMembers(ctx context.Context, organizationID uuid.UUID) ([]*Membership, error)
+}
@@ -23,12 +23,22 @@ var (
 		"permission_in_use",
 		"permission is currently assigned to one or more roles",
 	)
+
+	ErrConcurrentModification = shared.NewConcurrentModificationError("Permission", "")
 )
 
 func NewPermissionNotFoundError(identifier string) *shared.NotFoundError {
 	return shared.NewNotFoundError("Permission", identifier)
 }
 
+func NewConcurrentModificationError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewConcurrentModificationError("Permission", identifier)
+}
+
+func NewPreconditionFailedError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewPreconditionFailedError("Permission", identifier)
+}
+
 func NewPermissionCodeExistsError(code string) *shared.ConflictError {
 	return shared.NewConflictError("Permission", "code", code)
 }
@@ -10,6 +10,7 @@ import (
 
 type Permission struct {
 	shared.Entity
+	shared.Versioned
 	resource    Resource
 	action      Action
 	description string
@@ -56,6 +57,7 @@ type ReconstructPermissionParams struct {
 	IsSystem    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64
 }
 
 func ReconstructPermission(params ReconstructPermissionParams) (*Permission, error) {
@@ -71,6 +73,7 @@ func ReconstructPermission(params ReconstructPermissionParams) (*Permission, err
 
 	return &Permission{
 		Entity:      shared.NewEntityWithID(params.ID),
+		Versioned:   shared.NewVersioned(params.Version),
 		resource:    resource,
 		action:      action,
 		description: params.Description,
@@ -104,6 +107,15 @@ func (p *Permission) UpdatedAt() time.Time {
 	return p.updatedAt
 }
 
+// CheckVersion fails when expected is set and the permission is no longer
+// at that version.
+func (p *Permission) CheckVersion(expected *int64) error {
+	if expected != nil && *expected != p.Version() {
+		return NewPreconditionFailedError(p.ID().String())
+	}
+	return nil
+}
+
 func (p *Permission) Code() PermissionCode {
 	return NewPermissionCode(p.resource, p.action)
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
 type Repository interface {
 	Create(context context.Context, permission *Permission) error
 	Update(context context.Context, permission *Permission) error
-	Delete(context context.Context, id uuid.UUID) error
+	Delete(context context.Context, id uuid.UUID, version int64) error
 	FindByID(context context.Context, id uuid.UUID) (*Permission, error)
 	FindByCode(context context.Context, code PermissionCode) (*Permission, error)
 	FindByCodeString(context context.Context, code string) (*Permission, error)
@@ -17,4 +19,7 @@ type Repository interface {
 	FindAll(context context.Context) ([]*Permission, error)
 	FindByIDs(context context.Context, ids []uuid.UUID) ([]*Permission, error)
 	ExistsByCode(context context.Context, code PermissionCode) (bool, error)
+	// ListByCursor pages through permissions in FindAll order, restricted to
+	// one resource when resource is non-nil.
+	ListByCursor(context context.Context, resource *Resource, page shared.CursorPage) (shared.CursorResult[*Permission], error)
 }
@@ -134,3 +134,14 @@ func (pc PermissionCode) Action() Action {
 func (pc PermissionCode) Equals(other PermissionCode) bool {
 	return pc.resource.Equals(other.resource) && pc.action == other.action
 }
+
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+)
+
+func (effect Effect) String() string {
+	return string(effect)
+}
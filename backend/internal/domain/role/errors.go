@@ -34,18 +34,45 @@ var (
 		"permission is not assigned to this role",
 	)
 
+	ErrPermissionAlreadyDenied = shared.NewBusinessRuleViolationError(
+		"permission_already_denied",
+		"permission is already denied by this role",
+	)
+
+	ErrPermissionNotDenied = shared.NewBusinessRuleViolationError(
+		"permission_not_denied",
+		"permission is not denied by this role",
+	)
+
+	ErrPermissionAllowedAndDenied = shared.NewBusinessRuleViolationError(
+		"permission_allowed_and_denied",
+		"a role cannot both grant and deny the same permission",
+	)
+
 	ErrRoleInUse = shared.NewBusinessRuleViolationError(
 		"role_in_use",
 		"role is assigned to users and cannot be deleted",
 	)
 
 	ErrNoDefaultRole = shared.NewNotFoundError("Role", "default")
+
+	ErrGlobalRoleReadOnly = shared.NewAuthorizationError("change", "global role from inside an organization")
+
+	ErrConcurrentModification = shared.NewConcurrentModificationError("Role", "")
 )
 
 func NewRoleNotFoundError(identifier string) *shared.NotFoundError {
 	return shared.NewNotFoundError("Role", identifier)
 }
 
+func NewConcurrentModificationError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewConcurrentModificationError("Role", identifier)
+}
+
+func NewPreconditionFailedError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewPreconditionFailedError("Role", identifier)
+}
+
 func NewRoleNameExistsError(name string) *shared.ConflictError {
 	return shared.NewConflictError("Role", "name", name)
 }
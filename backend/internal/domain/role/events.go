@@ -13,6 +13,8 @@ const (
 	EventTypeRolePermissionAdded    = "role.permission.added"
 	EventTypeRolePermissionRemoved  = "role.permission.removed"
 	EventTypeRolePermissionsUpdated = "role.permissions.updated"
+	EventTypeRolePermissionDenied   = "role.permission.denied"
+	EventTypeRoleDenyRemoved        = "role.permission.deny_removed"
 )
 
 type RoleCreatedEvent struct {
@@ -90,3 +92,27 @@ func NewRolePermissionsUpdatedEvent(roleID uuid.UUID, oldIDs, newIDs []uuid.UUID
 		NewPermissionIDs: newIDs,
 	}
 }
+
+type RolePermissionDeniedEvent struct {
+	shared.BaseDomainEvent
+	PermissionID uuid.UUID
+}
+
+func NewRolePermissionDeniedEvent(roleID, permissionID uuid.UUID) RolePermissionDeniedEvent {
+	return RolePermissionDeniedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(roleID, EventTypeRolePermissionDenied),
+		PermissionID:    permissionID,
+	}
+}
+
+type RoleDenyRemovedEvent struct {
+	shared.BaseDomainEvent
+	PermissionID uuid.UUID
+}
+
+func NewRoleDenyRemovedEvent(roleID, permissionID uuid.UUID) RoleDenyRemovedEvent {
+	return RoleDenyRemovedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(roleID, EventTypeRoleDenyRemoved),
+		PermissionID:    permissionID,
+	}
+}
@@ -4,17 +4,60 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
 type Repository interface {
 	Create(context context.Context, role *Role) error
 	Update(context context.Context, role *Role) error
-	Delete(context context.Context, id uuid.UUID) error
+	Delete(context context.Context, id uuid.UUID, version int64) error
 	FindByID(context context.Context, id uuid.UUID) (*Role, error)
 	FindByName(context context.Context, name string) (*Role, error)
 	FindByIDs(context context.Context, ids []uuid.UUID) ([]*Role, error)
 	FindAll(context context.Context) ([]*Role, error)
+	// FindGlobal returns the roles that belong to no organization.
+	FindGlobal(context context.Context) ([]*Role, error)
 	FindDefault(context context.Context) (*Role, error)
 	ExistsByName(context context.Context, name string) (bool, error)
 	FindByPermission(context context.Context, permissionID uuid.UUID) ([]*Role, error)
+	FindAllForOrganization(context context.Context, organizationID uuid.UUID) ([]*Role, error)
+	ExistsByNameInOrganization(context context.Context, name string, organizationID uuid.UUID) (bool, error)
+	// ListByCursor pages through the roles in scope in FindAll order.
+	ListByCursor(context context.Context, scope Scope, page shared.CursorPage) (shared.CursorResult[*Role], error)
+}
+
+// Scope selects the roles a caller can see: the global roles plus those of
+// OrganizationID, as FindAllForOrganization does, or only the global ones
+// when OrganizationID is nil. AllOrganizations covers every tenant's roles and
+// is reserved for platform administrators.
+type Scope struct {
+	OrganizationID   *uuid.UUID
+	AllOrganizations bool
+}
+
+// Includes reports whether the scope lists r.
+func (s Scope) Includes(r *Role) bool {
+	return s.AllOrganizations || r.AppliesTo(s.OrganizationID)
+}
+
+// EnsureVisible reports a role outside the scope as not found, so callers
+// cannot probe the role IDs of other tenants.
+func (s Scope) EnsureVisible(r *Role) error {
+	if !s.Includes(r) {
+		return NewRoleNotFoundError(r.ID().String())
+	}
+	return nil
+}
+
+// EnsureChangeable also keeps callers inside an organization from changing
+// the global roles they can see.
+func (s Scope) EnsureChangeable(r *Role) error {
+	if err := s.EnsureVisible(r); err != nil {
+		return err
+	}
+	if s.OrganizationID != nil && r.IsGlobal() {
+		return ErrGlobalRoleReadOnly
+	}
+	return nil
 }
@@ -14,25 +14,29 @@ var roleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
 
 type Role struct {
 	shared.AggregateRoot
-	name          string
-	displayName   string
-	description   string
-	permissionIDs []uuid.UUID
-	isSystem      bool
-	isDefault     bool
-	priority      int
-	createdAt     time.Time
-	updatedAt     time.Time
+	shared.Versioned
+	organizationID *uuid.UUID
+	name           string
+	displayName    string
+	description    string
+	permissionIDs  []uuid.UUID
+	deniedIDs      []uuid.UUID
+	isSystem       bool
+	isDefault      bool
+	priority       int
+	createdAt      time.Time
+	updatedAt      time.Time
 }
 
 type NewRoleParams struct {
-	Name          string
-	DisplayName   string
-	Description   string
-	PermissionIDs []uuid.UUID
-	IsSystem      bool
-	IsDefault     bool
-	Priority      int
+	OrganizationID *uuid.UUID
+	Name           string
+	DisplayName    string
+	Description    string
+	PermissionIDs  []uuid.UUID
+	IsSystem       bool
+	IsDefault      bool
+	Priority       int
 }
 
 func NewRole(params NewRoleParams) (*Role, error) {
@@ -68,16 +72,18 @@ func NewRole(params NewRoleParams) (*Role, error) {
 
 	now := time.Now().UTC()
 	role := &Role{
-		AggregateRoot: shared.NewAggregateRoot(),
-		name:          name,
-		displayName:   displayName,
-		description:   params.Description,
-		permissionIDs: permissionIDs,
-		isSystem:      params.IsSystem,
-		isDefault:     params.IsDefault,
-		priority:      params.Priority,
-		createdAt:     now,
-		updatedAt:     now,
+		AggregateRoot:  shared.NewAggregateRoot(),
+		organizationID: params.OrganizationID,
+		name:           name,
+		displayName:    displayName,
+		description:    params.Description,
+		permissionIDs:  permissionIDs,
+		deniedIDs:      make([]uuid.UUID, 0),
+		isSystem:       params.IsSystem,
+		isDefault:      params.IsDefault,
+		priority:       params.Priority,
+		createdAt:      now,
+		updatedAt:      now,
 	}
 
 	role.AddDomainEvent(NewRoleCreatedEvent(role.ID(), name, displayName))
@@ -86,16 +92,19 @@ func NewRole(params NewRoleParams) (*Role, error) {
 }
 
 type ReconstructRoleParams struct {
-	ID            uuid.UUID
-	Name          string
-	DisplayName   string
-	Description   string
-	PermissionIDs []uuid.UUID
-	IsSystem      bool
-	IsDefault     bool
-	Priority      int
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID                  uuid.UUID
+	OrganizationID      *uuid.UUID
+	Name                string
+	DisplayName         string
+	Description         string
+	PermissionIDs       []uuid.UUID
+	DeniedPermissionIDs []uuid.UUID
+	IsSystem            bool
+	IsDefault           bool
+	Priority            int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Version             int64
 }
 
 func ReconstructRole(params ReconstructRoleParams) (*Role, error) {
@@ -108,20 +117,43 @@ func ReconstructRole(params ReconstructRoleParams) (*Role, error) {
 		permissionIDs = append(permissionIDs, params.PermissionIDs...)
 	}
 
+	deniedIDs := make([]uuid.UUID, 0)
+	if params.DeniedPermissionIDs != nil {
+		deniedIDs = append(deniedIDs, params.DeniedPermissionIDs...)
+	}
+
 	return &Role{
-		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
-		name:          params.Name,
-		displayName:   params.DisplayName,
-		description:   params.Description,
-		permissionIDs: permissionIDs,
-		isSystem:      params.IsSystem,
-		isDefault:     params.IsDefault,
-		priority:      params.Priority,
-		createdAt:     params.CreatedAt,
-		updatedAt:     params.UpdatedAt,
+		AggregateRoot:  shared.NewAggregateRootWithID(params.ID),
+		Versioned:      shared.NewVersioned(params.Version),
+		organizationID: params.OrganizationID,
+		name:           params.Name,
+		displayName:    params.DisplayName,
+		description:    params.Description,
+		permissionIDs:  permissionIDs,
+		deniedIDs:      deniedIDs,
+		isSystem:       params.IsSystem,
+		isDefault:      params.IsDefault,
+		priority:       params.Priority,
+		createdAt:      params.CreatedAt,
+		updatedAt:      params.UpdatedAt,
 	}, nil
 }
 
+func (r *Role) OrganizationID() *uuid.UUID {
+	return r.organizationID
+}
+
+func (r *Role) IsGlobal() bool {
+	return r.organizationID == nil
+}
+
+func (r *Role) AppliesTo(organizationID *uuid.UUID) bool {
+	if r.organizationID == nil {
+		return true
+	}
+	return organizationID != nil && *r.organizationID == *organizationID
+}
+
 func (r *Role) Name() string {
 	return r.name
 }
@@ -140,6 +172,12 @@ func (r *Role) PermissionIDs() []uuid.UUID {
 	return result
 }
 
+func (r *Role) DeniedPermissionIDs() []uuid.UUID {
+	result := make([]uuid.UUID, len(r.deniedIDs))
+	copy(result, r.deniedIDs)
+	return result
+}
+
 func (r *Role) IsSystem() bool {
 	return r.isSystem
 }
@@ -160,6 +198,15 @@ func (r *Role) UpdatedAt() time.Time {
 	return r.updatedAt
 }
 
+// CheckVersion fails when expected is set and the role is no longer at
+// that version.
+func (r *Role) CheckVersion(expected *int64) error {
+	if expected != nil && *expected != r.Version() {
+		return NewPreconditionFailedError(r.ID().String())
+	}
+	return nil
+}
+
 func (r *Role) HasPermission(permissionID uuid.UUID) bool {
 	for _, id := range r.permissionIDs {
 		if id == permissionID {
@@ -169,10 +216,22 @@ func (r *Role) HasPermission(permissionID uuid.UUID) bool {
 	return false
 }
 
+func (r *Role) DeniesPermission(permissionID uuid.UUID) bool {
+	for _, id := range r.deniedIDs {
+		if id == permissionID {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Role) AddPermission(permissionID uuid.UUID) error {
 	if r.HasPermission(permissionID) {
 		return ErrPermissionAlreadyAssigned
 	}
+	if r.DeniesPermission(permissionID) {
+		return ErrPermissionAllowedAndDenied
+	}
 
 	r.permissionIDs = append(r.permissionIDs, permissionID)
 	r.updatedAt = time.Now().UTC()
@@ -201,6 +260,41 @@ func (r *Role) RemovePermission(permissionID uuid.UUID) error {
 	return nil
 }
 
+func (r *Role) DenyPermission(permissionID uuid.UUID) error {
+	if r.DeniesPermission(permissionID) {
+		return ErrPermissionAlreadyDenied
+	}
+	if r.HasPermission(permissionID) {
+		return ErrPermissionAllowedAndDenied
+	}
+
+	r.deniedIDs = append(r.deniedIDs, permissionID)
+	r.updatedAt = time.Now().UTC()
+	r.AddDomainEvent(NewRolePermissionDeniedEvent(r.ID(), permissionID))
+
+	return nil
+}
+
+func (r *Role) RemoveDeny(permissionID uuid.UUID) error {
+	index := -1
+	for i, id := range r.deniedIDs {
+		if id == permissionID {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return ErrPermissionNotDenied
+	}
+
+	r.deniedIDs = append(r.deniedIDs[:index], r.deniedIDs[index+1:]...)
+	r.updatedAt = time.Now().UTC()
+	r.AddDomainEvent(NewRoleDenyRemovedEvent(r.ID(), permissionID))
+
+	return nil
+}
+
 func (r *Role) SetPermissions(permissionIDs []uuid.UUID) {
 	oldPermissionIDs := r.permissionIDs
 
@@ -301,3 +301,75 @@ func TestRole_PermissionIDs_ReturnsDefensiveCopy(t *testing.T) {
 
 	assert.Equal(t, permID, role.PermissionIDs()[0])
 }
+
+func TestRole_AppliesTo(t *testing.T) {
+	organizationID := uuid.New()
+	otherOrganizationID := uuid.New()
+
+	globalRole, err := NewRole(NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+	require.NoError(t, err)
+
+	scopedRole, err := NewRole(NewRoleParams{
+		OrganizationID: &organizationID,
+		Name:           "billing_admin",
+		DisplayName:    "Billing Admin",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, globalRole.IsGlobal())
+	assert.True(t, globalRole.AppliesTo(nil))
+	assert.True(t, globalRole.AppliesTo(&organizationID))
+
+	assert.False(t, scopedRole.IsGlobal())
+	assert.Equal(t, organizationID, *scopedRole.OrganizationID())
+	assert.True(t, scopedRole.AppliesTo(&organizationID))
+	assert.False(t, scopedRole.AppliesTo(&otherOrganizationID))
+	assert.False(t, scopedRole.AppliesTo(nil))
+}
+
+func TestScope(t *testing.T) {
+	organizationID := uuid.New()
+	otherOrganizationID := uuid.New()
+
+	globalRole, err := NewRole(NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+	require.NoError(t, err)
+	scopedRole, err := NewRole(NewRoleParams{OrganizationID: &organizationID, Name: "billing_admin", DisplayName: "Billing Admin"})
+	require.NoError(t, err)
+
+	tenant := Scope{OrganizationID: &organizationID}
+	assert.NoError(t, tenant.EnsureVisible(globalRole))
+	assert.NoError(t, tenant.EnsureChangeable(scopedRole))
+	assert.ErrorIs(t, tenant.EnsureChangeable(globalRole), ErrGlobalRoleReadOnly)
+
+	otherTenant := Scope{OrganizationID: &otherOrganizationID}
+	assert.ErrorIs(t, otherTenant.EnsureVisible(scopedRole), ErrRoleNotFound)
+	assert.ErrorIs(t, otherTenant.EnsureChangeable(scopedRole), ErrRoleNotFound)
+
+	platform := Scope{}
+	assert.NoError(t, platform.EnsureChangeable(globalRole))
+	assert.ErrorIs(t, platform.EnsureVisible(scopedRole), ErrRoleNotFound)
+	assert.NoError(t, Scope{AllOrganizations: true}.EnsureChangeable(scopedRole))
+}
+
+func TestRole_DenyPermission(t *testing.T) {
+	role, err := NewRole(NewRoleParams{Name: "editor", DisplayName: "Editor"})
+	require.NoError(t, err)
+	role.ClearDomainEvents()
+
+	permissionID := uuid.New()
+	require.NoError(t, role.DenyPermission(permissionID))
+	assert.True(t, role.DeniesPermission(permissionID))
+	assert.False(t, role.HasPermission(permissionID))
+	require.Len(t, role.DomainEvents(), 1)
+	assert.Equal(t, EventTypeRolePermissionDenied, role.DomainEvents()[0].EventType())
+
+	assert.ErrorIs(t, role.DenyPermission(permissionID), ErrPermissionAlreadyDenied)
+	assert.ErrorIs(t, role.AddPermission(permissionID), ErrPermissionAllowedAndDenied)
+
+	require.NoError(t, role.RemoveDeny(permissionID))
+	assert.False(t, role.DeniesPermission(permissionID))
+	assert.ErrorIs(t, role.RemoveDeny(permissionID), ErrPermissionNotDenied)
+
+	require.NoError(t, role.AddPermission(permissionID))
+	assert.ErrorIs(t, role.DenyPermission(permissionID), ErrPermissionAllowedAndDenied)
+}
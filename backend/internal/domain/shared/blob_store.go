@@ -0,0 +1,13 @@
+package shared
+
+import "context"
+
+// BlobStore keeps binary objects such as uploaded images outside the
+// database. Keys are slash-separated relative paths.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	// Delete removes the object; deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns where clients can fetch the object.
+	URL(key string) string
+}
@@ -0,0 +1,106 @@
+package shared
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor marks a position in a keyset-paginated listing. It holds the sort
+// key values of the row a page starts after, or ends before when Backward
+// is set. Sort records the ordering the cursor was issued for, so a cursor
+// cannot be replayed against a different sort.
+type Cursor struct {
+	Sort     string    `json:"s"`
+	Values   []*string `json:"k"`
+	Backward bool      `json:"b,omitempty"`
+}
+
+// EncodeCursor returns the opaque token handed to clients.
+func EncodeCursor(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeCursor parses a token produced by EncodeCursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, NewValidationError("cursor", "is malformed")
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.Sort == "" || len(cursor.Values) == 0 {
+		return nil, NewValidationError("cursor", "is malformed")
+	}
+	return &cursor, nil
+}
+
+// CursorPage requests one page of a keyset-paginated listing. A page
+// without a cursor is the first page.
+type CursorPage struct {
+	cursor    *Cursor
+	limit     int
+	withTotal bool
+}
+
+func NewCursorPage(token string, limit int, withTotal bool) (CursorPage, error) {
+	if limit < 1 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	page := CursorPage{limit: limit, withTotal: withTotal}
+	if token == "" {
+		return page, nil
+	}
+	cursor, err := DecodeCursor(token)
+	if err != nil {
+		return CursorPage{}, err
+	}
+	page.cursor = cursor
+	return page, nil
+}
+
+func (p CursorPage) Cursor() *Cursor {
+	return p.cursor
+}
+
+func (p CursorPage) Limit() int {
+	return p.limit
+}
+
+// WithTotal reports whether the caller asked for the total row count,
+// which costs an extra query.
+func (p CursorPage) WithTotal() bool {
+	return p.withTotal
+}
+
+func (p CursorPage) IsBackward() bool {
+	return p.cursor != nil && p.cursor.Backward
+}
+
+// CursorResult is one page of a keyset-paginated listing. NextCursor and
+// PrevCursor are empty when there is nothing further in that direction;
+// Total is only set when it was requested.
+type CursorResult[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+	Limit      int
+	Total      *int64
+}
+
+// MapCursorResult converts the items of a page, keeping its cursors.
+func MapCursorResult[T, U any](result CursorResult[T], convert func(T) U) CursorResult[U] {
+	items := make([]U, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, convert(item))
+	}
+	return CursorResult[U]{
+		Items:      items,
+		NextCursor: result.NextCursor,
+		PrevCursor: result.PrevCursor,
+		Limit:      result.Limit,
+		Total:      result.Total,
+	}
+}
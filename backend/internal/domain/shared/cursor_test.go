@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	value := "2026-03-01T10:00:00Z"
+	cursor := Cursor{Sort: "created_at:DESC,id:DESC", Values: []*string{&value, nil}, Backward: true}
+
+	decoded, err := DecodeCursor(EncodeCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+}
+
+func TestDecodeCursor_RejectsMalformedTokens(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm90IGpzb24", EncodeCursor(Cursor{Sort: "id:ASC"})} {
+		_, err := DecodeCursor(token)
+		assert.True(t, IsValidationError(err), token)
+	}
+}
+
+func TestNewCursorPage(t *testing.T) {
+	page, err := NewCursorPage("", 0, true)
+	require.NoError(t, err)
+	assert.Nil(t, page.Cursor())
+	assert.Equal(t, DefaultLimit, page.Limit())
+	assert.True(t, page.WithTotal())
+	assert.False(t, page.IsBackward())
+
+	value := "x"
+	page, err = NewCursorPage(EncodeCursor(Cursor{Sort: "name:ASC", Values: []*string{&value}, Backward: true}), 500, false)
+	require.NoError(t, err)
+	assert.Equal(t, MaxLimit, page.Limit())
+	assert.True(t, page.IsBackward())
+}
@@ -30,6 +30,49 @@ func (e Entity) IsZero() bool {
 	return e.id == uuid.Nil
 }
 
+// UniqueIDs returns ids without duplicates, keeping the first occurrence of
+// each in its original order.
+func UniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			result = append(result, id)
+			seen[id] = true
+		}
+	}
+	return result
+}
+
+// InitialVersion is the version of an entity that has never been updated.
+const InitialVersion int64 = 1
+
+// Versioned tracks the stored version of an entity for optimistic
+// concurrency control. Repositories only apply an update when the stored
+// version still matches, then advance it.
+type Versioned struct {
+	version int64
+}
+
+func NewVersioned(version int64) Versioned {
+	if version < InitialVersion {
+		version = InitialVersion
+	}
+	return Versioned{version: version}
+}
+
+func (v Versioned) Version() int64 {
+	if v.version < InitialVersion {
+		return InitialVersion
+	}
+	return v.version
+}
+
+// AdvanceVersion is called by repositories once an update is stored.
+func (v *Versioned) AdvanceVersion() {
+	v.version = v.Version() + 1
+}
+
 type DomainEvent interface {
 	EventType() string
 	OccurredAt() time.Time
@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueIDs(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	assert.Equal(t, []uuid.UUID{first, second}, UniqueIDs([]uuid.UUID{first, second, first, second}))
+	assert.Empty(t, UniqueIDs(nil))
+}
+
+func TestVersioned(t *testing.T) {
+	var zero Versioned
+	assert.Equal(t, InitialVersion, zero.Version())
+
+	v := NewVersioned(4)
+	assert.Equal(t, int64(4), v.Version())
+	v.AdvanceVersion()
+	assert.Equal(t, int64(5), v.Version())
+
+	assert.Equal(t, InitialVersion, NewVersioned(0).Version())
+}
+
+func TestConcurrentModificationErrors(t *testing.T) {
+	stale := NewConcurrentModificationError("Role", "42")
+	mismatch := NewPreconditionFailedError("Role", "42")
+
+	assert.Equal(t, ErrCodeConcurrentModification, stale.Code())
+	assert.Equal(t, ErrCodePreconditionFailed, mismatch.Code())
+	assert.True(t, IsConcurrentModificationError(stale))
+	assert.True(t, IsConcurrentModificationError(mismatch))
+	assert.ErrorIs(t, mismatch, NewConcurrentModificationError("Role", ""))
+	assert.NotErrorIs(t, mismatch, NewConcurrentModificationError("User", ""))
+}
@@ -3,18 +3,21 @@ package shared
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 type ErrorCode string
 
 const (
-	ErrCodeNotFound           ErrorCode = "NOT_FOUND"
-	ErrCodeValidation         ErrorCode = "VALIDATION_ERROR"
-	ErrCodeConflict           ErrorCode = "CONFLICT"
-	ErrCodeAuthorization      ErrorCode = "AUTHORIZATION_ERROR"
-	ErrCodeBusinessRule       ErrorCode = "BUSINESS_RULE_VIOLATION"
-	ErrCodeInternal           ErrorCode = "INTERNAL_ERROR"
-	ErrCodeInvalidStatusTrans ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrCodeNotFound               ErrorCode = "NOT_FOUND"
+	ErrCodeValidation             ErrorCode = "VALIDATION_ERROR"
+	ErrCodeConflict               ErrorCode = "CONFLICT"
+	ErrCodeAuthorization          ErrorCode = "AUTHORIZATION_ERROR"
+	ErrCodeBusinessRule           ErrorCode = "BUSINESS_RULE_VIOLATION"
+	ErrCodeInternal               ErrorCode = "INTERNAL_ERROR"
+	ErrCodeInvalidStatusTrans     ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrCodeConcurrentModification ErrorCode = "CONCURRENT_MODIFICATION"
+	ErrCodePreconditionFailed     ErrorCode = "PRECONDITION_FAILED"
 )
 
 type DomainError interface {
@@ -122,6 +125,46 @@ func (e *ConflictError) Is(target error) bool {
 		(e.Field == t.Field || t.Field == "")
 }
 
+// ConcurrentModificationError reports that an entity changed after the
+// caller read it. Its code is ErrCodePreconditionFailed when the caller
+// named the version it expected, and ErrCodeConcurrentModification when
+// another write got in between the read and the update.
+type ConcurrentModificationError struct {
+	baseDomainError
+	EntityType string
+	Identifier string
+}
+
+func NewConcurrentModificationError(entityType, identifier string) *ConcurrentModificationError {
+	return &ConcurrentModificationError{
+		baseDomainError: baseDomainError{
+			code:    ErrCodeConcurrentModification,
+			message: fmt.Sprintf("%s with identifier '%s' was modified by another request", entityType, identifier),
+		},
+		EntityType: entityType,
+		Identifier: identifier,
+	}
+}
+
+func NewPreconditionFailedError(entityType, identifier string) *ConcurrentModificationError {
+	return &ConcurrentModificationError{
+		baseDomainError: baseDomainError{
+			code:    ErrCodePreconditionFailed,
+			message: fmt.Sprintf("%s with identifier '%s' does not match the expected version", entityType, identifier),
+		},
+		EntityType: entityType,
+		Identifier: identifier,
+	}
+}
+
+func (e *ConcurrentModificationError) Is(target error) bool {
+	t, ok := target.(*ConcurrentModificationError)
+	if !ok {
+		return false
+	}
+	return e.EntityType == t.EntityType || t.EntityType == ""
+}
+
 type AuthorizationError struct {
 	baseDomainError
 	Action   string
@@ -144,9 +187,14 @@ func (e *AuthorizationError) Is(target error) bool {
 	return ok
 }
 
+// BusinessRuleViolationError reports a broken invariant. Template and Params
+// are set when the message embeds values, so that it can be rendered in
+// another language; otherwise the message itself is the template.
 type BusinessRuleViolationError struct {
 	baseDomainError
-	Rule string
+	Rule     string
+	Template string
+	Params   map[string]string
 }
 
 func NewBusinessRuleViolationError(rule, message string) *BusinessRuleViolationError {
@@ -159,6 +207,24 @@ func NewBusinessRuleViolationError(rule, message string) *BusinessRuleViolationE
 	}
 }
 
+// NewBusinessRuleViolationErrorWithParams builds the message by filling the
+// {name} placeholders of template from params.
+func NewBusinessRuleViolationErrorWithParams(rule, template string, params map[string]string) *BusinessRuleViolationError {
+	message := template
+	for name, value := range params {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+	return &BusinessRuleViolationError{
+		baseDomainError: baseDomainError{
+			code:    ErrCodeBusinessRule,
+			message: message,
+		},
+		Rule:     rule,
+		Template: template,
+		Params:   params,
+	}
+}
+
 func (e *BusinessRuleViolationError) Is(target error) bool {
 	t, ok := target.(*BusinessRuleViolationError)
 	if !ok {
@@ -223,6 +289,11 @@ func IsConflictError(err error) bool {
 	return errors.As(err, &e)
 }
 
+func IsConcurrentModificationError(err error) bool {
+	var e *ConcurrentModificationError
+	return errors.As(err, &e)
+}
+
 func IsAuthorizationError(err error) bool {
 	var e *AuthorizationError
 	return errors.As(err, &e)
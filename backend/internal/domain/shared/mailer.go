@@ -0,0 +1,15 @@
+package shared
+
+import "context"
+
+// MailMessage is a plain-text email addressed to a single recipient.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional email such as confirmation links.
+type Mailer interface {
+	Send(ctx context.Context, message MailMessage) error
+}
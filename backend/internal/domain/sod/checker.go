@@ -0,0 +1,184 @@
+package sod
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+)
+
+type Violation struct {
+	RuleID   uuid.UUID
+	RuleName string
+	RuleType RuleType
+	UserID   uuid.UUID
+	RoleIDs  []uuid.UUID
+}
+
+type Checker struct {
+	ruleRepository  Repository
+	userRepository  user.Repository
+	groupRepository group.Repository
+}
+
+func NewChecker(
+	ruleRepository Repository,
+	userRepository user.Repository,
+	groupRepository group.Repository,
+) *Checker {
+	return &Checker{
+		ruleRepository:  ruleRepository,
+		userRepository:  userRepository,
+		groupRepository: groupRepository,
+	}
+}
+
+func (checker *Checker) CheckUser(ctx context.Context, subject *user.User, addedRoleIDs ...uuid.UUID) error {
+	return checker.CheckUsers(ctx, []*user.User{subject}, addedRoleIDs...)
+}
+
+// CheckUsers fails when subjects, holding addedRoleIDs on top of the roles
+// they already have, would break a rule. Only rules that involve an added
+// role are checked, so a violation that predates the change does not block
+// unrelated grants, and removing roles never fails.
+func (checker *Checker) CheckUsers(ctx context.Context, subjects []*user.User, addedRoleIDs ...uuid.UUID) error {
+	if len(addedRoleIDs) == 0 {
+		return nil
+	}
+
+	assignments := make(map[uuid.UUID]map[uuid.UUID]bool, len(subjects))
+	for _, subject := range subjects {
+		effectiveRoleIDs, err := checker.effectiveRoleIDs(ctx, subject, addedRoleIDs)
+		if err != nil {
+			return err
+		}
+		held := make(map[uuid.UUID]bool, len(effectiveRoleIDs))
+		for _, roleID := range effectiveRoleIDs {
+			held[roleID] = true
+		}
+		assignments[subject.ID()] = held
+	}
+
+	rules, err := checker.ruleRepository.FindByRoles(ctx, addedRoleIDs)
+	if err != nil {
+		return fmt.Errorf("find sod rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		switch rule.Type() {
+		case RuleTypeMutualExclusion:
+			for _, held := range assignments {
+				if len(heldRuleRoles(rule, held)) > 1 {
+					return NewViolationError(rule)
+				}
+			}
+		case RuleTypeMaxCardinality:
+			roleID := rule.RoleIDs()[0]
+			holders, err := checker.holderIDs(ctx, roleID)
+			if err != nil {
+				return err
+			}
+			for userID, held := range assignments {
+				if held[roleID] {
+					holders[userID] = true
+				} else {
+					delete(holders, userID)
+				}
+			}
+			if len(holders) > rule.MaxHolders() {
+				return NewViolationError(rule)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (checker *Checker) FindViolations(ctx context.Context, rules []*Rule) ([]Violation, error) {
+	violations := make([]Violation, 0)
+
+	for _, rule := range rules {
+		holdings := make(map[uuid.UUID][]uuid.UUID)
+		for _, roleID := range rule.RoleIDs() {
+			holders, err := checker.holderIDs(ctx, roleID)
+			if err != nil {
+				return nil, err
+			}
+			for userID := range holders {
+				holdings[userID] = append(holdings[userID], roleID)
+			}
+		}
+
+		userIDs := make([]uuid.UUID, 0, len(holdings))
+		for userID, heldRoleIDs := range holdings {
+			if rule.Type() == RuleTypeMutualExclusion && len(heldRoleIDs) < 2 {
+				continue
+			}
+			userIDs = append(userIDs, userID)
+		}
+		if rule.Type() == RuleTypeMaxCardinality && len(userIDs) <= rule.MaxHolders() {
+			continue
+		}
+
+		sort.Slice(userIDs, func(i, j int) bool {
+			return userIDs[i].String() < userIDs[j].String()
+		})
+		for _, userID := range userIDs {
+			violations = append(violations, Violation{
+				RuleID:   rule.ID(),
+				RuleName: rule.Name(),
+				RuleType: rule.Type(),
+				UserID:   userID,
+				RoleIDs:  holdings[userID],
+			})
+		}
+	}
+
+	return violations, nil
+}
+
+func (checker *Checker) effectiveRoleIDs(ctx context.Context, subject *user.User, additionalRoleIDs []uuid.UUID) ([]uuid.UUID, error) {
+	roleIDs, err := group.EffectiveRoleIDs(ctx, checker.groupRepository, subject.ID(), append(subject.RoleIDs(), additionalRoleIDs...))
+	if err != nil {
+		return nil, fmt.Errorf("resolve effective roles: %w", err)
+	}
+	return roleIDs, nil
+}
+
+func (checker *Checker) holderIDs(ctx context.Context, roleID uuid.UUID) (map[uuid.UUID]bool, error) {
+	holders := make(map[uuid.UUID]bool)
+
+	directHolders, err := checker.userRepository.FindByRole(ctx, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("find role holders: %w", err)
+	}
+	for _, holder := range directHolders {
+		holders[holder.ID()] = true
+	}
+
+	if checker.groupRepository != nil {
+		groupMemberIDs, err := checker.groupRepository.FindMemberIDsByRole(ctx, roleID)
+		if err != nil {
+			return nil, fmt.Errorf("find group role holders: %w", err)
+		}
+		for _, userID := range groupMemberIDs {
+			holders[userID] = true
+		}
+	}
+
+	return holders, nil
+}
+
+func heldRuleRoles(rule *Rule, held map[uuid.UUID]bool) []uuid.UUID {
+	result := make([]uuid.UUID, 0)
+	for _, roleID := range rule.RoleIDs() {
+		if held[roleID] {
+			result = append(result, roleID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package sod
+
+import (
+	"fmt"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const violationRuleCode = "separation_of_duties_violation"
+
+var (
+	ErrRuleNotFound = shared.NewNotFoundError("SoDRule", "")
+
+	ErrRuleNameExists = shared.NewConflictError("SoDRule", "name", "")
+
+	ErrViolation = shared.NewBusinessRuleViolationError(violationRuleCode, "")
+)
+
+func NewRuleNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("SoDRule", identifier)
+}
+
+func NewRuleNameExistsError(name string) *shared.ConflictError {
+	return shared.NewConflictError("SoDRule", "name", name)
+}
+
+func NewViolationError(rule *Rule) *shared.BusinessRuleViolationError {
+	var message string
+	switch rule.Type() {
+	case RuleTypeMaxCardinality:
+		message = fmt.Sprintf("separation-of-duties rule %q allows at most %d holders of this role", rule.Name(), rule.MaxHolders())
+	default:
+		message = fmt.Sprintf("separation-of-duties rule %q forbids holding these roles together", rule.Name())
+	}
+	return shared.NewBusinessRuleViolationError(violationRuleCode, message)
+}
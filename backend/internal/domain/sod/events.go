@@ -0,0 +1,55 @@
+package sod
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeRuleCreated = "sod_rule.created"
+	EventTypeRuleUpdated = "sod_rule.updated"
+	EventTypeRuleDeleted = "sod_rule.deleted"
+)
+
+type RuleCreatedEvent struct {
+	shared.BaseDomainEvent
+	Name     string
+	RuleType RuleType
+	RoleIDs  []uuid.UUID
+}
+
+func NewRuleCreatedEvent(ruleID uuid.UUID, name string, ruleType RuleType, roleIDs []uuid.UUID) RuleCreatedEvent {
+	return RuleCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(ruleID, EventTypeRuleCreated),
+		Name:            name,
+		RuleType:        ruleType,
+		RoleIDs:         roleIDs,
+	}
+}
+
+type RuleUpdatedEvent struct {
+	shared.BaseDomainEvent
+	Name    string
+	RoleIDs []uuid.UUID
+}
+
+func NewRuleUpdatedEvent(ruleID uuid.UUID, name string, roleIDs []uuid.UUID) RuleUpdatedEvent {
+	return RuleUpdatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(ruleID, EventTypeRuleUpdated),
+		Name:            name,
+		RoleIDs:         roleIDs,
+	}
+}
+
+type RuleDeletedEvent struct {
+	shared.BaseDomainEvent
+	Name string
+}
+
+func NewRuleDeletedEvent(ruleID uuid.UUID, name string) RuleDeletedEvent {
+	return RuleDeletedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(ruleID, EventTypeRuleDeleted),
+		Name:            name,
+	}
+}
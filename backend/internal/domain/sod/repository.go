@@ -0,0 +1,16 @@
+package sod
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, rule *Rule) error
+	Update(ctx context.Context, rule *Rule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindByID(ctx context.Context, id uuid.UUID) (*Rule, error)
+	FindAll(ctx context.Context) ([]*Rule, error)
+	FindByRoles(ctx context.Context, roleIDs []uuid.UUID) ([]*Rule, error)
+}
@@ -0,0 +1,189 @@
+package sod
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type Rule struct {
+	shared.AggregateRoot
+	name        string
+	description string
+	ruleType    RuleType
+	roleIDs     []uuid.UUID
+	maxHolders  int
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type NewRuleParams struct {
+	Name        string
+	Description string
+	Type        RuleType
+	RoleIDs     []uuid.UUID
+	MaxHolders  int
+}
+
+func NewRule(params NewRuleParams) (*Rule, error) {
+	name := strings.TrimSpace(params.Name)
+	roleIDs := shared.UniqueIDs(params.RoleIDs)
+	if err := validateRule(name, params.Description, params.Type, roleIDs, params.MaxHolders); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rule := &Rule{
+		AggregateRoot: shared.NewAggregateRoot(),
+		name:          name,
+		description:   params.Description,
+		ruleType:      params.Type,
+		roleIDs:       roleIDs,
+		maxHolders:    params.MaxHolders,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	rule.AddDomainEvent(NewRuleCreatedEvent(rule.ID(), name, params.Type, rule.RoleIDs()))
+
+	return rule, nil
+}
+
+type ReconstructRuleParams struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Type        RuleType
+	RoleIDs     []uuid.UUID
+	MaxHolders  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func ReconstructRule(params ReconstructRuleParams) *Rule {
+	roleIDs := params.RoleIDs
+	if roleIDs == nil {
+		roleIDs = make([]uuid.UUID, 0)
+	}
+
+	return &Rule{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		name:          params.Name,
+		description:   params.Description,
+		ruleType:      params.Type,
+		roleIDs:       roleIDs,
+		maxHolders:    params.MaxHolders,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}
+}
+
+func (r *Rule) Name() string {
+	return r.name
+}
+
+func (r *Rule) Description() string {
+	return r.description
+}
+
+func (r *Rule) Type() RuleType {
+	return r.ruleType
+}
+
+func (r *Rule) RoleIDs() []uuid.UUID {
+	result := make([]uuid.UUID, len(r.roleIDs))
+	copy(result, r.roleIDs)
+	return result
+}
+
+func (r *Rule) MaxHolders() int {
+	return r.maxHolders
+}
+
+func (r *Rule) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *Rule) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *Rule) CoversRole(roleID uuid.UUID) bool {
+	for _, id := range r.roleIDs {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+type UpdateRuleParams struct {
+	Name        string
+	Description string
+	RoleIDs     []uuid.UUID
+	MaxHolders  int
+}
+
+func (r *Rule) Update(params UpdateRuleParams) error {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		name = r.name
+	}
+	roleIDs := r.roleIDs
+	if params.RoleIDs != nil {
+		roleIDs = shared.UniqueIDs(params.RoleIDs)
+	}
+	maxHolders := r.maxHolders
+	if params.MaxHolders != 0 {
+		maxHolders = params.MaxHolders
+	}
+
+	if err := validateRule(name, params.Description, r.ruleType, roleIDs, maxHolders); err != nil {
+		return err
+	}
+
+	r.name = name
+	r.description = params.Description
+	r.roleIDs = roleIDs
+	r.maxHolders = maxHolders
+	r.updatedAt = time.Now().UTC()
+	r.AddDomainEvent(NewRuleUpdatedEvent(r.ID(), r.name, r.RoleIDs()))
+
+	return nil
+}
+
+func validateRule(name, description string, ruleType RuleType, roleIDs []uuid.UUID, maxHolders int) error {
+	if name == "" {
+		return shared.NewValidationError("name", "rule name cannot be empty")
+	}
+	if len(name) > 100 {
+		return shared.NewValidationError("name", "rule name cannot exceed 100 characters")
+	}
+	if len(description) > 500 {
+		return shared.NewValidationError("description", "rule description cannot exceed 500 characters")
+	}
+
+	switch ruleType {
+	case RuleTypeMutualExclusion:
+		if len(roleIDs) < 2 {
+			return shared.NewValidationError("role_ids", "mutual exclusion rules require at least two roles")
+		}
+		if maxHolders != 0 {
+			return shared.NewValidationError("max_holders", "mutual exclusion rules do not accept max_holders")
+		}
+	case RuleTypeMaxCardinality:
+		if len(roleIDs) != 1 {
+			return shared.NewValidationError("role_ids", "cardinality rules require exactly one role")
+		}
+		if maxHolders < 1 {
+			return shared.NewValidationError("max_holders", "max_holders must be at least 1")
+		}
+	default:
+		return shared.NewValidationError("type", "invalid rule type")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package sod
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func TestNewRule(t *testing.T) {
+	auditorRoleID := uuid.New()
+	adminRoleID := uuid.New()
+
+	tests := []struct {
+		name      string
+		params    NewRuleParams
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name: "valid mutual exclusion",
+			params: NewRuleParams{
+				Name:    "auditor-admin",
+				Type:    RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{auditorRoleID, adminRoleID},
+			},
+		},
+		{
+			name: "valid cardinality",
+			params: NewRuleParams{
+				Name:       "few-admins",
+				Type:       RuleTypeMaxCardinality,
+				RoleIDs:    []uuid.UUID{adminRoleID},
+				MaxHolders: 3,
+			},
+		},
+		{
+			name: "mutual exclusion collapses duplicate roles",
+			params: NewRuleParams{
+				Name:    "duplicate",
+				Type:    RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{adminRoleID, adminRoleID},
+			},
+			wantErr:   true,
+			wantField: "role_ids",
+		},
+		{
+			name: "cardinality requires max holders",
+			params: NewRuleParams{
+				Name:    "unbounded",
+				Type:    RuleTypeMaxCardinality,
+				RoleIDs: []uuid.UUID{adminRoleID},
+			},
+			wantErr:   true,
+			wantField: "max_holders",
+		},
+		{
+			name: "cardinality requires a single role",
+			params: NewRuleParams{
+				Name:       "two-roles",
+				Type:       RuleTypeMaxCardinality,
+				RoleIDs:    []uuid.UUID{auditorRoleID, adminRoleID},
+				MaxHolders: 1,
+			},
+			wantErr:   true,
+			wantField: "role_ids",
+		},
+		{
+			name: "unknown type",
+			params: NewRuleParams{
+				Name:    "unknown",
+				Type:    RuleType("quorum"),
+				RoleIDs: []uuid.UUID{auditorRoleID, adminRoleID},
+			},
+			wantErr:   true,
+			wantField: "type",
+		},
+		{
+			name: "empty name",
+			params: NewRuleParams{
+				Name:    "  ",
+				Type:    RuleTypeMutualExclusion,
+				RoleIDs: []uuid.UUID{auditorRoleID, adminRoleID},
+			},
+			wantErr:   true,
+			wantField: "name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewRule(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				var validationErr *shared.ValidationError
+				require.True(t, errors.As(err, &validationErr))
+				assert.Equal(t, tt.wantField, validationErr.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.params.Type, rule.Type())
+			assert.Len(t, rule.DomainEvents(), 1)
+			assert.Equal(t, EventTypeRuleCreated, rule.DomainEvents()[0].EventType())
+		})
+	}
+}
+
+func TestRule_Update(t *testing.T) {
+	adminRoleID := uuid.New()
+	rule, err := NewRule(NewRuleParams{Name: "few-admins", Type: RuleTypeMaxCardinality, RoleIDs: []uuid.UUID{adminRoleID}, MaxHolders: 2})
+	require.NoError(t, err)
+	rule.ClearDomainEvents()
+
+	require.NoError(t, rule.Update(UpdateRuleParams{Description: "keep the admin pool small", MaxHolders: 5}))
+	assert.Equal(t, "few-admins", rule.Name())
+	assert.Equal(t, 5, rule.MaxHolders())
+	assert.Equal(t, []uuid.UUID{adminRoleID}, rule.RoleIDs())
+	assert.Equal(t, EventTypeRuleUpdated, rule.DomainEvents()[0].EventType())
+
+	err = rule.Update(UpdateRuleParams{RoleIDs: []uuid.UUID{adminRoleID, uuid.New()}})
+	assert.Error(t, err)
+}
+
+func TestNewViolationError(t *testing.T) {
+	rule, err := NewRule(NewRuleParams{Name: "auditor-admin", Type: RuleTypeMutualExclusion, RoleIDs: []uuid.UUID{uuid.New(), uuid.New()}})
+	require.NoError(t, err)
+
+	violation := NewViolationError(rule)
+	assert.ErrorIs(t, violation, ErrViolation)
+	assert.Contains(t, violation.Error(), "auditor-admin")
+}
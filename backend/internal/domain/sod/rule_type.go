@@ -0,0 +1,26 @@
+package sod
+
+type RuleType string
+
+const (
+	RuleTypeMutualExclusion RuleType = "mutual_exclusion"
+	RuleTypeMaxCardinality  RuleType = "max_cardinality"
+)
+
+var validRuleTypes = map[RuleType]bool{
+	RuleTypeMutualExclusion: true,
+	RuleTypeMaxCardinality:  true,
+}
+
+func (t RuleType) IsValid() bool {
+	return validRuleTypes[t]
+}
+
+func (t RuleType) String() string {
+	return string(t)
+}
+
+func ParseRuleType(s string) (RuleType, bool) {
+	ruleType := RuleType(s)
+	return ruleType, ruleType.IsValid()
+}
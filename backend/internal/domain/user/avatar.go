@@ -0,0 +1,27 @@
+package user
+
+import "time"
+
+// Avatar points at the stored renditions of a user's profile picture. Each
+// rendition named in URLs, such as "small", is stored at BlobKey(name).
+type Avatar struct {
+	Key       string
+	Extension string
+	URLs      map[string]string
+	UpdatedAt time.Time
+}
+
+func (a Avatar) BlobKey(rendition string) string {
+	return a.Key + "/" + rendition + "." + a.Extension
+}
+
+func (a *Avatar) clone() *Avatar {
+	if a == nil {
+		return nil
+	}
+	urls := make(map[string]string, len(a.URLs))
+	for name, url := range a.URLs {
+		urls[name] = url
+	}
+	return &Avatar{Key: a.Key, Extension: a.Extension, URLs: urls, UpdatedAt: a.UpdatedAt}
+}
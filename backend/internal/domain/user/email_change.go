@@ -0,0 +1,117 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// EmailChange is a self-service request to move a user to a new address.
+// The new address only takes effect once the token mailed to it is
+// confirmed; the token mailed to the current address cancels the request.
+// Only hashes of the tokens are kept. A user has at most one pending change.
+type EmailChange struct {
+	userID           uuid.UUID
+	newEmail         shared.Email
+	confirmTokenHash string
+	cancelTokenHash  string
+	requestedAt      time.Time
+	expiresAt        time.Time
+}
+
+type NewEmailChangeParams struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	ExpiresAt        time.Time
+}
+
+func NewEmailChange(params NewEmailChangeParams) (*EmailChange, error) {
+	email, err := shared.NewEmail(params.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if params.ConfirmTokenHash == "" || params.CancelTokenHash == "" {
+		return nil, shared.NewValidationError("token_hash", "token hashes are required")
+	}
+
+	now := time.Now().UTC()
+	if !params.ExpiresAt.After(now) {
+		return nil, shared.NewValidationError("expires_at", "expiration time must be in the future")
+	}
+
+	return &EmailChange{
+		userID:           params.UserID,
+		newEmail:         email,
+		confirmTokenHash: params.ConfirmTokenHash,
+		cancelTokenHash:  params.CancelTokenHash,
+		requestedAt:      now,
+		expiresAt:        params.ExpiresAt,
+	}, nil
+}
+
+type ReconstructEmailChangeParams struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	RequestedAt      time.Time
+	ExpiresAt        time.Time
+}
+
+func ReconstructEmailChange(params ReconstructEmailChangeParams) (*EmailChange, error) {
+	email, err := shared.NewEmail(params.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmailChange{
+		userID:           params.UserID,
+		newEmail:         email,
+		confirmTokenHash: params.ConfirmTokenHash,
+		cancelTokenHash:  params.CancelTokenHash,
+		requestedAt:      params.RequestedAt,
+		expiresAt:        params.ExpiresAt,
+	}, nil
+}
+
+func (c *EmailChange) UserID() uuid.UUID {
+	return c.userID
+}
+
+func (c *EmailChange) NewEmail() shared.Email {
+	return c.newEmail
+}
+
+func (c *EmailChange) ConfirmTokenHash() string {
+	return c.confirmTokenHash
+}
+
+func (c *EmailChange) CancelTokenHash() string {
+	return c.cancelTokenHash
+}
+
+func (c *EmailChange) RequestedAt() time.Time {
+	return c.requestedAt
+}
+
+func (c *EmailChange) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *EmailChange) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+type EmailChangeRepository interface {
+	// Save stores the change, replacing any pending change of the same user.
+	Save(ctx context.Context, change *EmailChange) error
+	FindByConfirmTokenHash(ctx context.Context, tokenHash string) (*EmailChange, error)
+	FindByCancelTokenHash(ctx context.Context, tokenHash string) (*EmailChange, error)
+	// DeleteByUserID removes the user's pending change, if any.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
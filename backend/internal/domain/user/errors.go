@@ -13,8 +13,24 @@ var (
 	ErrUserAlreadyActive = shared.NewBusinessRuleViolationError("user_already_active", "user is already active")
 	ErrUserAlreadyInactive = shared.NewBusinessRuleViolationError("user_already_inactive", "user is already inactive")
 	ErrUserIsBanned = shared.NewBusinessRuleViolationError("user_is_banned", "user is banned and cannot perform this action")
+	ErrUserIsSuspended = shared.NewBusinessRuleViolationError("user_is_suspended", "user is suspended and cannot perform this action")
+	ErrUserNotBanned = shared.NewBusinessRuleViolationError("user_not_banned", "user is neither banned nor suspended")
+	ErrSuspensionNotOver = shared.NewBusinessRuleViolationError("suspension_not_over", "user's suspension has not ended yet")
+	ErrSuspensionEndNotInFuture = shared.NewValidationError("until", "must be in the future")
+	ErrSuspensionReasonRequired = shared.NewValidationError("reason", "is required")
+	ErrUnbanJustificationRequired = shared.NewValidationError("justification", "is required")
 	ErrRoleAlreadyAssigned = shared.NewBusinessRuleViolationError("role_already_assigned", "role is already assigned to this user")
 	ErrRoleNotAssigned = shared.NewBusinessRuleViolationError("role_not_assigned", "role is not assigned to this user")
+	ErrPermissionAlreadyDenied = shared.NewBusinessRuleViolationError("permission_already_denied", "permission is already denied for this user")
+	ErrPermissionNotDenied = shared.NewBusinessRuleViolationError("permission_not_denied", "permission is not denied for this user")
+	ErrUserAlreadyErased = shared.NewBusinessRuleViolationError("user_already_erased", "user's personal data has already been erased")
+	ErrUserNotDeleted = shared.NewBusinessRuleViolationError("user_not_deleted", "user is not deleted")
+	ErrErasedUserNotRestorable = shared.NewBusinessRuleViolationError("erased_user_not_restorable", "user's personal data has been erased and cannot be restored")
+	ErrEmailUnchanged = shared.NewBusinessRuleViolationError("email_unchanged", "new email is the same as the current email")
+	ErrInvalidEmailChangeToken = shared.NewBusinessRuleViolationError("invalid_email_change_token", "email change token is invalid or has expired")
+	ErrConcurrentModification = shared.NewConcurrentModificationError("User", "")
+	ErrPreferencesNotFound = shared.NewNotFoundError("Preferences", "")
+	ErrUnsupportedLocale = shared.NewValidationError("locale", "is not a supported locale")
 )
 
 func NewUserNotFoundError(identifier string) *shared.NotFoundError {
@@ -25,6 +41,14 @@ func NewEmailAlreadyExistsError(email string) *shared.ConflictError {
 	return shared.NewConflictError("User", "email", email)
 }
 
+func NewConcurrentModificationError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewConcurrentModificationError("User", identifier)
+}
+
+func NewPreconditionFailedError(identifier string) *shared.ConcurrentModificationError {
+	return shared.NewPreconditionFailedError("User", identifier)
+}
+
 func NewInvalidStatusTransitionError(current, target Status) *shared.InvalidStatusTransitionError {
 	return shared.NewInvalidStatusTransitionError(current.String(), target.String())
 }
@@ -19,6 +19,18 @@ const (
 	EventTypeUserRoleAssigned  = "user.role.assigned"
 	EventTypeUserRoleRevoked   = "user.role.revoked"
 	EventTypeUserRolesUpdated  = "user.roles.updated"
+	EventTypeUserPermissionDenied = "user.permission.denied"
+	EventTypeUserDenyRemoved      = "user.permission.deny_removed"
+	EventTypeUserInvited          = "user.invited"
+	EventTypeUserErased           = "user.erased"
+	EventTypeUserRestored         = "user.restored"
+	EventTypeUserPurged           = "user.purged"
+	EventTypeEmailChangeRequested = "user.email_change_requested"
+	EventTypeEmailChangeCancelled = "user.email_change_cancelled"
+	EventTypeEmailChanged         = "user.email_changed"
+	EventTypeUserSuspended        = "user.suspended"
+	EventTypeUserReinstated       = "user.reinstated"
+	EventTypeUserUnbanned         = "user.unbanned"
 )
 
 type UserCreatedEvent struct {
@@ -67,6 +79,53 @@ func NewUserBannedEvent(userID uuid.UUID, reason string) UserBannedEvent {
 	}
 }
 
+type UserSuspendedEvent struct {
+	shared.BaseDomainEvent
+	Until       time.Time
+	Reason      string
+	SuspendedBy *uuid.UUID
+}
+
+func NewUserSuspendedEvent(userID uuid.UUID, until time.Time, reason string, suspendedBy *uuid.UUID) UserSuspendedEvent {
+	return UserSuspendedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserSuspended),
+		Until:           until,
+		Reason:          reason,
+		SuspendedBy:     suspendedBy,
+	}
+}
+
+// UserReinstatedEvent is raised when a suspension runs out on its own.
+type UserReinstatedEvent struct {
+	shared.BaseDomainEvent
+	SuspendedUntil time.Time
+}
+
+func NewUserReinstatedEvent(userID uuid.UUID, suspendedUntil time.Time) UserReinstatedEvent {
+	return UserReinstatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserReinstated),
+		SuspendedUntil:  suspendedUntil,
+	}
+}
+
+// UserUnbannedEvent is raised when an administrator lifts a ban or ends a
+// suspension early.
+type UserUnbannedEvent struct {
+	shared.BaseDomainEvent
+	PreviousStatus Status
+	Justification  string
+	UnbannedBy     *uuid.UUID
+}
+
+func NewUserUnbannedEvent(userID uuid.UUID, previousStatus Status, justification string, unbannedBy *uuid.UUID) UserUnbannedEvent {
+	return UserUnbannedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserUnbanned),
+		PreviousStatus:  previousStatus,
+		Justification:   justification,
+		UnbannedBy:      unbannedBy,
+	}
+}
+
 type PasswordChangedEvent struct {
 	shared.BaseDomainEvent
 }
@@ -101,6 +160,79 @@ func NewUserDeletedEvent(userID uuid.UUID, deletedAt time.Time) UserDeletedEvent
 	}
 }
 
+type UserRestoredEvent struct {
+	shared.BaseDomainEvent
+	RestoredBy *uuid.UUID
+}
+
+func NewUserRestoredEvent(userID uuid.UUID, restoredBy *uuid.UUID) UserRestoredEvent {
+	return UserRestoredEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserRestored),
+		RestoredBy:      restoredBy,
+	}
+}
+
+// UserPurgedEvent records that a soft-deleted user was removed for good once
+// the retention period ran out.
+type UserPurgedEvent struct {
+	shared.BaseDomainEvent
+	DeletedAt time.Time
+}
+
+func NewUserPurgedEvent(userID uuid.UUID, deletedAt time.Time) UserPurgedEvent {
+	return UserPurgedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserPurged),
+		DeletedAt:       deletedAt,
+	}
+}
+
+type EmailChangeRequestedEvent struct {
+	shared.BaseDomainEvent
+	NewEmail  string
+	ExpiresAt time.Time
+}
+
+func NewEmailChangeRequestedEvent(userID uuid.UUID, newEmail string, expiresAt time.Time) EmailChangeRequestedEvent {
+	return EmailChangeRequestedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeEmailChangeRequested),
+		NewEmail:        newEmail,
+		ExpiresAt:       expiresAt,
+	}
+}
+
+type EmailChangeCancelledEvent struct {
+	shared.BaseDomainEvent
+	NewEmail string
+}
+
+func NewEmailChangeCancelledEvent(userID uuid.UUID, newEmail string) EmailChangeCancelledEvent {
+	return EmailChangeCancelledEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeEmailChangeCancelled),
+		NewEmail:        newEmail,
+	}
+}
+
+// EmailChangedEvent records a completed email change. Override is set when
+// an administrator changed the address directly instead of the user
+// confirming it.
+type EmailChangedEvent struct {
+	shared.BaseDomainEvent
+	OldEmail  string
+	NewEmail  string
+	ChangedBy *uuid.UUID
+	Override  bool
+}
+
+func NewEmailChangedEvent(userID uuid.UUID, oldEmail, newEmail string, changedBy *uuid.UUID, override bool) EmailChangedEvent {
+	return EmailChangedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeEmailChanged),
+		OldEmail:        oldEmail,
+		NewEmail:        newEmail,
+		ChangedBy:       changedBy,
+		Override:        override,
+	}
+}
+
 type UserRoleAssignedEvent struct {
 	shared.BaseDomainEvent
 	RoleID uuid.UUID
@@ -138,3 +270,55 @@ func NewUserRolesUpdatedEvent(userID uuid.UUID, oldRoleIDs, newRoleIDs []uuid.UU
 		NewRoleIDs:      newRoleIDs,
 	}
 }
+
+type UserPermissionDeniedEvent struct {
+	shared.BaseDomainEvent
+	PermissionID uuid.UUID
+}
+
+func NewUserPermissionDeniedEvent(userID, permissionID uuid.UUID) UserPermissionDeniedEvent {
+	return UserPermissionDeniedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserPermissionDenied),
+		PermissionID:    permissionID,
+	}
+}
+
+type UserDenyRemovedEvent struct {
+	shared.BaseDomainEvent
+	PermissionID uuid.UUID
+}
+
+func NewUserDenyRemovedEvent(userID, permissionID uuid.UUID) UserDenyRemovedEvent {
+	return UserDenyRemovedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserDenyRemoved),
+		PermissionID:    permissionID,
+	}
+}
+
+type UserInvitedEvent struct {
+	shared.BaseDomainEvent
+	Email     string
+	InvitedBy *uuid.UUID
+}
+
+func NewUserInvitedEvent(userID uuid.UUID, email string, invitedBy *uuid.UUID) UserInvitedEvent {
+	return UserInvitedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(userID, EventTypeUserInvited),
+		Email:           email,
+		InvitedBy:       invitedBy,
+	}
+}
+
+// UserErasedEvent is the receipt of a right-to-erasure request. It records
+// what was removed without repeating any of the erased data.
+type UserErasedEvent struct {
+	shared.BaseDomainEvent
+	Receipt ErasureReceipt
+}
+
+func NewUserErasedEvent(receipt ErasureReceipt) UserErasedEvent {
+	return UserErasedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(receipt.UserID, EventTypeUserErased),
+		Receipt:         receipt,
+	}
+}
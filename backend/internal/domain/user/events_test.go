@@ -51,6 +51,31 @@ func TestNewUserBannedEvent(t *testing.T) {
 	assert.Equal(t, reason, event.Reason)
 }
 
+func TestNewUserSuspendedEvent(t *testing.T) {
+	userID := uuid.New()
+	actorID := uuid.New()
+	until := time.Now().Add(time.Hour)
+
+	event := NewUserSuspendedEvent(userID, until, "spam", &actorID)
+
+	assert.Equal(t, userID, event.AggregateID())
+	assert.Equal(t, EventTypeUserSuspended, event.EventType())
+	assert.Equal(t, until, event.Until)
+	assert.Equal(t, "spam", event.Reason)
+	assert.Equal(t, &actorID, event.SuspendedBy)
+}
+
+func TestNewUserUnbannedEvent(t *testing.T) {
+	userID := uuid.New()
+
+	event := NewUserUnbannedEvent(userID, StatusBanned, "appeal upheld", nil)
+
+	assert.Equal(t, userID, event.AggregateID())
+	assert.Equal(t, EventTypeUserUnbanned, event.EventType())
+	assert.Equal(t, StatusBanned, event.PreviousStatus)
+	assert.Equal(t, "appeal upheld", event.Justification)
+}
+
 func TestNewPasswordChangedEvent(t *testing.T) {
 	userID := uuid.New()
 
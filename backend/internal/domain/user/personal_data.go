@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ErasedEmailDomain is reserved (RFC 2606), so pseudonymized addresses can
+	// never collide with or deliver to a real mailbox.
+	ErasedEmailDomain = "erased.invalid"
+	ErasedFullName    = "Erased User"
+)
+
+// AuditRecord is an audit log entry performed by or about a user.
+type AuditRecord struct {
+	ID            uuid.UUID
+	Timestamp     time.Time
+	EventType     string
+	ActorID       *uuid.UUID
+	Action        string
+	ResourceType  string
+	ResourceID    string
+	IPAddress     string
+	UserAgent     string
+	Success       bool
+	FailureReason string
+	Metadata      map[string]any
+}
+
+// AuditTrail reads and pseudonymizes the audit entries that concern a user.
+type AuditTrail interface {
+	// FindByUser returns the entries the user performed or that target the
+	// user, oldest first.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]AuditRecord, error)
+
+	// Pseudonymize strips network identifiers from those entries and from
+	// entries whose metadata mentions one of identifiers, such as the email
+	// address or full name, and replaces every such mention. Entry ids,
+	// timestamps, actions and outcomes are kept. Returns the number of
+	// entries changed.
+	Pseudonymize(ctx context.Context, userID uuid.UUID, identifiers []string) (int64, error)
+}
+
+// ErasureReceipt summarizes a completed erasure for the requester and the
+// audit trail.
+type ErasureReceipt struct {
+	UserID                    uuid.UUID
+	ErasedAt                  time.Time
+	ErasedBy                  *uuid.UUID
+	RefreshTokensDeleted      int64
+	ResetTokensDeleted        int64
+	InvitationsDeleted        int64
+	AuditEntriesPseudonymized int64
+	AvatarRemoved             bool
+}
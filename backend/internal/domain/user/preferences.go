@@ -0,0 +1,189 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// DateFormat is the order in which dates are shown to a user in mails.
+type DateFormat string
+
+const (
+	DateFormatISO DateFormat = "iso"
+	DateFormatDMY DateFormat = "dmy"
+	DateFormatMDY DateFormat = "mdy"
+)
+
+const DefaultTimezone = "UTC"
+
+func (f DateFormat) IsValid() bool {
+	switch f {
+	case DateFormatISO, DateFormatDMY, DateFormatMDY:
+		return true
+	}
+	return false
+}
+
+func (f DateFormat) layout() string {
+	switch f {
+	case DateFormatDMY:
+		return "02/01/2006"
+	case DateFormatMDY:
+		return "01/02/2006"
+	default:
+		return "2006-01-02"
+	}
+}
+
+func (f DateFormat) String() string {
+	return string(f)
+}
+
+type NotificationSettings struct {
+	// AccountUpdates covers notices about changes an administrator made to
+	// the account. Mails the user needs to secure the account, such as the
+	// notice of a pending email change, are always sent.
+	AccountUpdates bool
+}
+
+// Preferences holds the self-service settings of a user. An empty locale
+// leaves the language to each request's Accept-Language header. Users who
+// never saved preferences get DefaultPreferences.
+type Preferences struct {
+	userID        uuid.UUID
+	locale        string
+	timezone      string
+	location      *time.Location
+	dateFormat    DateFormat
+	notifications NotificationSettings
+	updatedAt     time.Time
+}
+
+func DefaultPreferences(userID uuid.UUID) *Preferences {
+	return &Preferences{
+		userID:        userID,
+		timezone:      DefaultTimezone,
+		location:      time.UTC,
+		dateFormat:    DateFormatISO,
+		notifications: NotificationSettings{AccountUpdates: true},
+	}
+}
+
+type ReconstructPreferencesParams struct {
+	UserID         uuid.UUID
+	Locale         string
+	Timezone       string
+	DateFormat     string
+	AccountUpdates bool
+	UpdatedAt      time.Time
+}
+
+func ReconstructPreferences(params ReconstructPreferencesParams) (*Preferences, error) {
+	location, err := loadTimezone(params.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Preferences{
+		userID:        params.UserID,
+		locale:        params.Locale,
+		timezone:      params.Timezone,
+		location:      location,
+		dateFormat:    DateFormat(params.DateFormat),
+		notifications: NotificationSettings{AccountUpdates: params.AccountUpdates},
+		updatedAt:     params.UpdatedAt,
+	}, nil
+}
+
+// UpdatePreferencesParams leaves a setting unchanged when its field is nil.
+// Whether Locale is supported is checked by the caller, which knows the
+// message catalogs.
+type UpdatePreferencesParams struct {
+	Locale         *string
+	Timezone       *string
+	DateFormat     *string
+	AccountUpdates *bool
+}
+
+func (p *Preferences) Update(params UpdatePreferencesParams) error {
+	location := p.location
+	if params.Timezone != nil {
+		loaded, err := loadTimezone(*params.Timezone)
+		if err != nil {
+			return err
+		}
+		location = loaded
+	}
+	if params.DateFormat != nil && !DateFormat(*params.DateFormat).IsValid() {
+		return shared.NewValidationError("date_format", "must be one of iso, dmy or mdy")
+	}
+
+	if params.Locale != nil {
+		p.locale = *params.Locale
+	}
+	if params.Timezone != nil {
+		p.timezone = *params.Timezone
+		p.location = location
+	}
+	if params.DateFormat != nil {
+		p.dateFormat = DateFormat(*params.DateFormat)
+	}
+	if params.AccountUpdates != nil {
+		p.notifications.AccountUpdates = *params.AccountUpdates
+	}
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, shared.NewValidationError("timezone", "is required")
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, shared.NewValidationError("timezone", "is not a known time zone")
+	}
+	return location, nil
+}
+
+func (p *Preferences) UserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *Preferences) Locale() string {
+	return p.locale
+}
+
+func (p *Preferences) Timezone() string {
+	return p.timezone
+}
+
+func (p *Preferences) DateFormat() DateFormat {
+	return p.dateFormat
+}
+
+func (p *Preferences) Notifications() NotificationSettings {
+	return p.notifications
+}
+
+// UpdatedAt is zero for preferences that were never saved.
+func (p *Preferences) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// FormatDateTime renders t in the user's time zone and date format.
+func (p *Preferences) FormatDateTime(t time.Time) string {
+	return t.In(p.location).Format(p.dateFormat.layout() + " 15:04 MST")
+}
+
+type PreferencesRepository interface {
+	// FindByUserID returns ErrPreferencesNotFound for users who never saved
+	// preferences.
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*Preferences, error)
+	// Save stores the preferences, replacing the user's previous ones.
+	Save(ctx context.Context, preferences *Preferences) error
+}
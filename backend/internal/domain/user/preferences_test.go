@@ -0,0 +1,100 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func TestDefaultPreferences(t *testing.T) {
+	userID := uuid.New()
+
+	preferences := DefaultPreferences(userID)
+
+	assert.Equal(t, userID, preferences.UserID())
+	assert.Empty(t, preferences.Locale())
+	assert.Equal(t, DefaultTimezone, preferences.Timezone())
+	assert.Equal(t, DateFormatISO, preferences.DateFormat())
+	assert.True(t, preferences.Notifications().AccountUpdates)
+	assert.True(t, preferences.UpdatedAt().IsZero())
+}
+
+func TestPreferences_Update(t *testing.T) {
+	stringPtr := func(value string) *string { return &value }
+	boolPtr := func(value bool) *bool { return &value }
+
+	t.Run("applies the given settings only", func(t *testing.T) {
+		preferences := DefaultPreferences(uuid.New())
+
+		err := preferences.Update(UpdatePreferencesParams{
+			Locale:     stringPtr("vi"),
+			Timezone:   stringPtr("Asia/Ho_Chi_Minh"),
+			DateFormat: stringPtr("dmy"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "vi", preferences.Locale())
+		assert.Equal(t, "Asia/Ho_Chi_Minh", preferences.Timezone())
+		assert.Equal(t, DateFormatDMY, preferences.DateFormat())
+		assert.True(t, preferences.Notifications().AccountUpdates)
+		assert.False(t, preferences.UpdatedAt().IsZero())
+
+		require.NoError(t, preferences.Update(UpdatePreferencesParams{AccountUpdates: boolPtr(false)}))
+		assert.False(t, preferences.Notifications().AccountUpdates)
+		assert.Equal(t, "vi", preferences.Locale())
+	})
+
+	t.Run("rejects an unknown time zone", func(t *testing.T) {
+		preferences := DefaultPreferences(uuid.New())
+
+		err := preferences.Update(UpdatePreferencesParams{
+			Locale:   stringPtr("vi"),
+			Timezone: stringPtr("Mars/Olympus_Mons"),
+		})
+
+		assert.ErrorIs(t, err, shared.NewValidationError("timezone", ""))
+		assert.Empty(t, preferences.Locale(), "a rejected update changes nothing")
+	})
+
+	t.Run("rejects an unknown date format", func(t *testing.T) {
+		preferences := DefaultPreferences(uuid.New())
+
+		err := preferences.Update(UpdatePreferencesParams{DateFormat: stringPtr("ymd")})
+
+		assert.ErrorIs(t, err, shared.NewValidationError("date_format", ""))
+		assert.Equal(t, DateFormatISO, preferences.DateFormat())
+	})
+}
+
+func TestPreferences_FormatDateTime(t *testing.T) {
+	moment := time.Date(2026, 3, 4, 17, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		timezone   string
+		dateFormat string
+		expected   string
+	}{
+		{name: "defaults", timezone: "UTC", dateFormat: "iso", expected: "2026-03-04 17:30 UTC"},
+		{name: "day first in a later time zone", timezone: "Asia/Ho_Chi_Minh", dateFormat: "dmy", expected: "05/03/2026 00:30 +07"},
+		{name: "month first", timezone: "UTC", dateFormat: "mdy", expected: "03/04/2026 17:30 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preferences, err := ReconstructPreferences(ReconstructPreferencesParams{
+				UserID:     uuid.New(),
+				Timezone:   tt.timezone,
+				DateFormat: tt.dateFormat,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, preferences.FormatDateTime(moment))
+		})
+	}
+}
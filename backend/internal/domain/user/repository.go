@@ -2,16 +2,52 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
+// DeletedScope selects which users a listing returns by deletion state.
+type DeletedScope string
+
+const (
+	// DeletedScopeExclude lists live users only. It is the zero value.
+	DeletedScopeExclude DeletedScope = ""
+	DeletedScopeInclude DeletedScope = "include"
+	DeletedScopeOnly    DeletedScope = "only"
+)
+
+func ParseDeletedScope(s string) (DeletedScope, bool) {
+	switch scope := DeletedScope(s); scope {
+	case DeletedScopeExclude, DeletedScopeInclude, DeletedScopeOnly:
+		return scope, true
+	default:
+		return "", false
+	}
+}
+
 type Filter struct {
-	Status     *Status
-	Search     *string
-	DateRange  shared.DateRange
+	OrganizationID *uuid.UUID
+	// Statuses matches users in any of the statuses.
+	Statuses []Status
+	// RoleIDs matches users directly assigned any of the roles.
+	RoleIDs []uuid.UUID
+	// Search matches words and prefixes of the name and email, and close
+	// misspellings of either.
+	Search    *string
+	DateRange shared.DateRange
+	// LastLoginRange bounds the last successful sign-in. Users who never
+	// signed in fall outside every range.
+	LastLoginRange shared.DateRange
+	NeverLoggedIn  bool
+	// Attributes matches users whose custom attributes equal every value.
+	Attributes map[string]any
+	Deleted    DeletedScope
+	// Sort lists the ordering keys; ties are broken by ID. Without keys,
+	// searches are ordered by relevance and other listings newest first.
+	Sort []Sort
 }
 
 type Repository interface {
@@ -35,6 +71,30 @@ type Repository interface {
 	// Never returns (nil, nil) - always returns an error for not found.
 	FindByID(ctx context.Context, id uuid.UUID) (*User, error)
 
+	// Restore clears deleted_at on a soft-deleted, unerased user.
+	// Returns ErrUserNotFound if the user does not exist or is not soft-deleted.
+	// Returns ErrEmailAlreadyExists if a live user has taken the email since.
+	Restore(ctx context.Context, user *User) error
+
+	// Purge permanently removes a soft-deleted user and the rows that
+	// cascade from it.
+	// Returns ErrUserNotFound if the user does not exist or is not soft-deleted.
+	Purge(ctx context.Context, id uuid.UUID) error
+
+	// FindSuspensionsEndedBy retrieves up to limit suspended users whose
+	// suspension ends at or before now, earliest first.
+	// Returns empty slice (not nil) if there are none.
+	FindSuspensionsEndedBy(ctx context.Context, now time.Time, limit int) ([]*User, error)
+
+	// FindDeletedBefore retrieves up to limit users soft-deleted before the
+	// cutoff, oldest first.
+	// Returns empty slice (not nil) if there are none.
+	FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*User, error)
+
+	// FindByIDIncludingDeleted retrieves a user by id even when soft-deleted.
+	// Returns ErrUserNotFound if no such user exists.
+	FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*User, error)
+
 	// FindByEmail retrieves a user by their email address (case-insensitive).
 	// Returns ErrUserNotFound if the user does not exist or is soft-deleted.
 	// Never returns (nil, nil) - always returns an error for not found.
@@ -50,9 +110,21 @@ type Repository interface {
 	// Returns wrapped database errors for failures.
 	FindByRole(ctx context.Context, roleID uuid.UUID) ([]*User, error)
 
+	// RecordLogin stores the time of a successful sign-in without touching
+	// updated_at.
+	// Returns ErrUserNotFound if the user does not exist or is soft-deleted.
+	RecordLogin(ctx context.Context, id uuid.UUID, at time.Time) error
+
 	// List retrieves users matching the filter with pagination.
+	// When filter.OrganizationID is set, only members of that organization are returned.
 	// Returns (users, totalCount, nil) on success.
 	// Returns empty slice (not nil) when no results match.
 	// Returns wrapped database errors for failures.
 	List(ctx context.Context, filter Filter, pagination shared.Pagination) ([]*User, int64, error)
+
+	// ListByCursor retrieves one keyset page of users matching the filter,
+	// ordered by filter.Sort. Relevance is not a valid key in this mode.
+	// Returns a validation error if the cursor was issued for another sort.
+	// The total count is only computed when the page asks for it.
+	ListByCursor(ctx context.Context, filter Filter, page shared.CursorPage) (shared.CursorResult[*User], error)
 }
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// SortField is a key the user listing can be ordered by. Only these keys
+// reach the repository, which maps each one to a column.
+type SortField string
+
+const (
+	SortFieldCreatedAt   SortField = "created_at"
+	SortFieldUpdatedAt   SortField = "updated_at"
+	SortFieldEmail       SortField = "email"
+	SortFieldFullName    SortField = "full_name"
+	SortFieldStatus      SortField = "status"
+	SortFieldLastLoginAt SortField = "last_login_at"
+	// SortFieldRelevance orders by search rank and requires a search term.
+	SortFieldRelevance SortField = "relevance"
+)
+
+// MaxSortFields caps how many keys one listing may sort by.
+const MaxSortFields = 4
+
+var validSortFields = map[SortField]bool{
+	SortFieldCreatedAt:   true,
+	SortFieldUpdatedAt:   true,
+	SortFieldEmail:       true,
+	SortFieldFullName:    true,
+	SortFieldStatus:      true,
+	SortFieldLastLoginAt: true,
+	SortFieldRelevance:   true,
+}
+
+func (f SortField) IsValid() bool {
+	return validSortFields[f]
+}
+
+func (f SortField) String() string {
+	return string(f)
+}
+
+// DefaultOrder is used when a key is given without a direction: newest and
+// best-matching first, text alphabetically.
+func (f SortField) DefaultOrder() shared.SortOrder {
+	switch f {
+	case SortFieldCreatedAt, SortFieldUpdatedAt, SortFieldLastLoginAt, SortFieldRelevance:
+		return shared.SortOrderDesc
+	default:
+		return shared.SortOrderAsc
+	}
+}
+
+type Sort struct {
+	Field SortField
+	Order shared.SortOrder
+}
+
+// ParseSort reads comma-separated sort keys and directions. A single
+// direction applies to every key; otherwise there must be one per key.
+// Keys without a direction use their default order.
+func ParseSort(sortBy, sortOrder string) ([]Sort, error) {
+	fields := splitSortList(sortBy)
+	orders := splitSortList(sortOrder)
+	if len(fields) == 0 {
+		if len(orders) > 0 {
+			return nil, shared.NewValidationError("sort_order", "requires sort_by")
+		}
+		return nil, nil
+	}
+	if len(fields) > MaxSortFields {
+		return nil, shared.NewValidationError("sort_by", "too many sort keys")
+	}
+	if len(orders) > 1 && len(orders) != len(fields) {
+		return nil, shared.NewValidationError("sort_order", "must have one direction or one per sort key")
+	}
+
+	sorts := make([]Sort, 0, len(fields))
+	seen := make(map[SortField]bool, len(fields))
+	for i, name := range fields {
+		field := SortField(strings.ToLower(name))
+		if !field.IsValid() {
+			return nil, shared.NewValidationError("sort_by", "unknown sort key "+name)
+		}
+		if seen[field] {
+			return nil, shared.NewValidationError("sort_by", "duplicate sort key "+name)
+		}
+		seen[field] = true
+
+		order := field.DefaultOrder()
+		if len(orders) > 0 {
+			direction := orders[0]
+			if len(orders) > 1 {
+				direction = orders[i]
+			}
+			order = shared.SortOrder(strings.ToLower(direction))
+			if !order.IsValid() {
+				return nil, shared.NewValidationError("sort_order", "must be asc or desc")
+			}
+		}
+		sorts = append(sorts, Sort{Field: field, Order: order})
+	}
+	return sorts, nil
+}
+
+func splitSortList(s string) []string {
+	values := make([]string, 0)
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
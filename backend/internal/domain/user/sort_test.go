@@ -0,0 +1,77 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		want      []Sort
+	}{
+		{name: "no keys", want: nil},
+		{
+			name:   "default directions",
+			sortBy: "status, last_login_at",
+			want: []Sort{
+				{Field: SortFieldStatus, Order: shared.SortOrderAsc},
+				{Field: SortFieldLastLoginAt, Order: shared.SortOrderDesc},
+			},
+		},
+		{
+			name:      "one direction for every key",
+			sortBy:    "email,created_at",
+			sortOrder: "DESC",
+			want: []Sort{
+				{Field: SortFieldEmail, Order: shared.SortOrderDesc},
+				{Field: SortFieldCreatedAt, Order: shared.SortOrderDesc},
+			},
+		},
+		{
+			name:      "direction per key",
+			sortBy:    "full_name,updated_at",
+			sortOrder: "desc,asc",
+			want: []Sort{
+				{Field: SortFieldFullName, Order: shared.SortOrderDesc},
+				{Field: SortFieldUpdatedAt, Order: shared.SortOrderAsc},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorts, err := ParseSort(tt.sortBy, tt.sortOrder)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sorts)
+		})
+	}
+}
+
+func TestParseSort_Rejects(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+	}{
+		{name: "unknown key", sortBy: "password_hash"},
+		{name: "duplicate key", sortBy: "email,EMAIL"},
+		{name: "too many keys", sortBy: "email,full_name,status,created_at,updated_at"},
+		{name: "direction count mismatch", sortBy: "email,status,created_at", sortOrder: "asc,desc"},
+		{name: "unknown direction", sortBy: "email", sortOrder: "up"},
+		{name: "direction without key", sortOrder: "asc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSort(tt.sortBy, tt.sortOrder)
+			assert.True(t, shared.IsValidationError(err))
+		})
+	}
+}
@@ -3,24 +3,29 @@ package user
 type Status string
 
 const (
-	StatusPending  Status = "pending"
-	StatusActive   Status = "active"
-	StatusInactive Status = "inactive"
-	StatusBanned   Status = "banned"
+	StatusPending   Status = "pending"
+	StatusActive    Status = "active"
+	StatusInactive  Status = "inactive"
+	StatusSuspended Status = "suspended"
+	StatusBanned    Status = "banned"
 )
 
 var validStatuses = map[Status]bool{
-	StatusPending:  true,
-	StatusActive:   true,
-	StatusInactive: true,
-	StatusBanned:   true,
+	StatusPending:   true,
+	StatusActive:    true,
+	StatusInactive:  true,
+	StatusSuspended: true,
+	StatusBanned:    true,
 }
 
+// Suspended and banned users only return to active through a reinstatement
+// or an unban, never through a plain activation.
 var allowedTransitions = map[Status][]Status{
-	StatusPending:  {StatusActive, StatusBanned},
-	StatusActive:   {StatusInactive, StatusBanned},
-	StatusInactive: {StatusActive, StatusBanned},
-	StatusBanned:   {},
+	StatusPending:   {StatusActive, StatusBanned},
+	StatusActive:    {StatusInactive, StatusSuspended, StatusBanned},
+	StatusInactive:  {StatusActive, StatusBanned},
+	StatusSuspended: {StatusActive, StatusBanned},
+	StatusBanned:    {StatusActive},
 }
 
 func (s Status) IsValid() bool {
@@ -56,6 +61,10 @@ func (s Status) IsInactive() bool {
 	return s == StatusInactive
 }
 
+func (s Status) IsSuspended() bool {
+	return s == StatusSuspended
+}
+
 func (s Status) IsBanned() bool {
 	return s == StatusBanned
 }
@@ -1,6 +1,11 @@
 package user
 
 import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,20 +15,36 @@ import (
 
 type User struct {
 	shared.AggregateRoot
+	shared.Versioned
 	email        shared.Email
 	passwordHash shared.PasswordHash
 	fullName     shared.FullName
 	status       Status
 	roleIDs      []uuid.UUID
+	timedRoles   map[uuid.UUID]uuid.UUID
+	deniedIDs    []uuid.UUID
+	attributes   map[string]any
+	avatar       *Avatar
 	createdAt    time.Time
 	updatedAt    time.Time
 	deletedAt    *time.Time
+	erasedAt     *time.Time
+	lastLoginAt  *time.Time
+	suspension   *suspension
+}
+
+// suspension records why and until when a suspended user is locked out.
+type suspension struct {
+	until  time.Time
+	reason string
 }
 
 type NewUserParams struct {
 	Email        string
 	PasswordHash string
 	FullName     string
+	// Attributes must already be validated against the attribute schema.
+	Attributes map[string]any
 }
 
 func NewUser(params NewUserParams) (*User, error) {
@@ -50,6 +71,9 @@ func NewUser(params NewUserParams) (*User, error) {
 		fullName:      fullName,
 		status:        StatusPending,
 		roleIDs:       make([]uuid.UUID, 0),
+		timedRoles:    make(map[uuid.UUID]uuid.UUID),
+		deniedIDs:     make([]uuid.UUID, 0),
+		attributes:    copyAttributes(params.Attributes),
 		createdAt:     now,
 		updatedAt:     now,
 		deletedAt:     nil,
@@ -61,15 +85,24 @@ func NewUser(params NewUserParams) (*User, error) {
 }
 
 type ReconstructUserParams struct {
-	ID           uuid.UUID
-	Email        string
-	PasswordHash string
-	FullName     string
-	Status       Status
-	RoleIDs      []uuid.UUID
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    *time.Time
+	ID                  uuid.UUID
+	Email               string
+	PasswordHash        string
+	FullName            string
+	Status              Status
+	RoleIDs             []uuid.UUID
+	TemporaryRoles      map[uuid.UUID]uuid.UUID
+	DeniedPermissionIDs []uuid.UUID
+	Attributes          map[string]any
+	Avatar              *Avatar
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	DeletedAt           *time.Time
+	ErasedAt            *time.Time
+	LastLoginAt         *time.Time
+	SuspendedUntil      *time.Time
+	SuspensionReason    string
+	Version             int64
 }
 
 func ReconstructUser(params ReconstructUserParams) (*User, error) {
@@ -97,16 +130,39 @@ func ReconstructUser(params ReconstructUserParams) (*User, error) {
 		roleIDs = append(roleIDs, params.RoleIDs...)
 	}
 
+	temporaryRoles := make(map[uuid.UUID]uuid.UUID, len(params.TemporaryRoles))
+	for roleID, accessRequestID := range params.TemporaryRoles {
+		temporaryRoles[roleID] = accessRequestID
+	}
+
+	deniedIDs := make([]uuid.UUID, 0)
+	if params.DeniedPermissionIDs != nil {
+		deniedIDs = append(deniedIDs, params.DeniedPermissionIDs...)
+	}
+
+	var currentSuspension *suspension
+	if params.Status.IsSuspended() && params.SuspendedUntil != nil {
+		currentSuspension = &suspension{until: params.SuspendedUntil.UTC(), reason: params.SuspensionReason}
+	}
+
 	return &User{
 		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		Versioned:     shared.NewVersioned(params.Version),
 		email:         email,
 		passwordHash:  passwordHash,
 		fullName:      fullName,
 		status:        params.Status,
 		roleIDs:       roleIDs,
+		timedRoles:    temporaryRoles,
+		deniedIDs:     deniedIDs,
+		attributes:    copyAttributes(params.Attributes),
+		avatar:        params.Avatar.clone(),
 		createdAt:     params.CreatedAt,
 		updatedAt:     params.UpdatedAt,
 		deletedAt:     params.DeletedAt,
+		erasedAt:      params.ErasedAt,
+		lastLoginAt:   params.LastLoginAt,
+		suspension:    currentSuspension,
 	}, nil
 }
 
@@ -142,10 +198,61 @@ func (u *User) IsDeleted() bool {
 	return u.deletedAt != nil
 }
 
+func (u *User) ErasedAt() *time.Time {
+	return u.erasedAt
+}
+
+func (u *User) IsErased() bool {
+	return u.erasedAt != nil
+}
+
+func (u *User) LastLoginAt() *time.Time {
+	return u.lastLoginAt
+}
+
+// SuspendedUntil returns when the current suspension ends, or nil when the
+// user is not suspended.
+func (u *User) SuspendedUntil() *time.Time {
+	if u.suspension == nil {
+		return nil
+	}
+	until := u.suspension.until
+	return &until
+}
+
+func (u *User) SuspensionReason() string {
+	if u.suspension == nil {
+		return ""
+	}
+	return u.suspension.reason
+}
+
+// CheckVersion fails when expected is set and the user is no longer at
+// that version.
+func (u *User) CheckVersion(expected *int64) error {
+	if expected != nil && *expected != u.Version() {
+		return NewPreconditionFailedError(u.ID().String())
+	}
+	return nil
+}
+
+// RecordLogin notes a successful sign-in. It is bookkeeping rather than a
+// profile change, so updatedAt is left alone.
+func (u *User) RecordLogin(at time.Time) {
+	loginAt := at.UTC()
+	u.lastLoginAt = &loginAt
+}
+
 func (u *User) Activate() error {
 	if u.status.IsActive() {
 		return ErrUserAlreadyActive
 	}
+	if u.status.IsBanned() {
+		return ErrUserIsBanned
+	}
+	if u.status.IsSuspended() {
+		return ErrUserIsSuspended
+	}
 
 	if !u.status.CanTransitionTo(StatusActive) {
 		return NewInvalidStatusTransitionError(u.status, StatusActive)
@@ -184,12 +291,84 @@ func (u *User) Ban(reason string) error {
 	}
 
 	u.status = StatusBanned
+	u.suspension = nil
 	u.updatedAt = time.Now().UTC()
 	u.AddDomainEvent(NewUserBannedEvent(u.ID(), reason))
 
 	return nil
 }
 
+// Suspend locks the user out until the given time, after which Reinstate
+// makes them active again.
+func (u *User) Suspend(until time.Time, reason string, suspendedBy *uuid.UUID) error {
+	if u.status.IsSuspended() {
+		return ErrUserIsSuspended
+	}
+	if u.status.IsBanned() {
+		return ErrUserIsBanned
+	}
+
+	if !u.status.CanTransitionTo(StatusSuspended) {
+		return NewInvalidStatusTransitionError(u.status, StatusSuspended)
+	}
+
+	now := time.Now().UTC()
+	if !until.After(now) {
+		return ErrSuspensionEndNotInFuture
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrSuspensionReasonRequired
+	}
+
+	until = until.UTC()
+	u.status = StatusSuspended
+	u.suspension = &suspension{until: until, reason: reason}
+	u.updatedAt = now
+	u.AddDomainEvent(NewUserSuspendedEvent(u.ID(), until, reason, suspendedBy))
+
+	return nil
+}
+
+// Reinstate makes a suspended user active again once the suspension has
+// run out.
+func (u *User) Reinstate(now time.Time) error {
+	if !u.status.IsSuspended() || u.suspension == nil {
+		return NewInvalidStatusTransitionError(u.status, StatusActive)
+	}
+	if now.Before(u.suspension.until) {
+		return ErrSuspensionNotOver
+	}
+
+	until := u.suspension.until
+	u.status = StatusActive
+	u.suspension = nil
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserReinstatedEvent(u.ID(), until))
+
+	return nil
+}
+
+// Unban lifts a ban or ends a suspension early. Unlike a ban it has to be
+// justified, since it restores access the user was deliberately denied.
+func (u *User) Unban(justification string, unbannedBy *uuid.UUID) error {
+	if !u.status.IsBanned() && !u.status.IsSuspended() {
+		return ErrUserNotBanned
+	}
+	justification = strings.TrimSpace(justification)
+	if justification == "" {
+		return ErrUnbanJustificationRequired
+	}
+
+	previousStatus := u.status
+	u.status = StatusActive
+	u.suspension = nil
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserUnbannedEvent(u.ID(), previousStatus, justification, unbannedBy))
+
+	return nil
+}
+
 func (u *User) ChangePassword(newPasswordHash string) error {
 	passwordHash, err := shared.NewPasswordHash(newPasswordHash)
 	if err != nil {
@@ -223,6 +402,82 @@ func (u *User) UpdateProfile(fullName string) error {
 	return nil
 }
 
+// ChangeEmail switches the user to newEmail. Self-service changes must only
+// call this once the new address has been confirmed; override marks an
+// administrator changing the address directly.
+func (u *User) ChangeEmail(newEmail string, changedBy *uuid.UUID, override bool) error {
+	email, err := shared.NewEmail(newEmail)
+	if err != nil {
+		return err
+	}
+	if email.Equals(u.email) {
+		return ErrEmailUnchanged
+	}
+
+	oldEmail := u.email
+	u.email = email
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewEmailChangedEvent(u.ID(), oldEmail.String(), email.String(), changedBy, override))
+
+	return nil
+}
+
+func (u *User) Attributes() map[string]any {
+	return copyAttributes(u.attributes)
+}
+
+// UpdateAttributes replaces the custom profile attributes. The values must
+// already be validated against the attribute schema.
+func (u *User) UpdateAttributes(attributes map[string]any) {
+	var changedFields []string
+	for key, value := range attributes {
+		if current, ok := u.attributes[key]; !ok || !reflect.DeepEqual(current, value) {
+			changedFields = append(changedFields, "attributes."+key)
+		}
+	}
+	for key := range u.attributes {
+		if _, ok := attributes[key]; !ok {
+			changedFields = append(changedFields, "attributes."+key)
+		}
+	}
+
+	if len(changedFields) == 0 {
+		return
+	}
+
+	sort.Strings(changedFields)
+	u.attributes = copyAttributes(attributes)
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewProfileUpdatedEvent(u.ID(), changedFields))
+}
+
+func (u *User) Avatar() *Avatar {
+	return u.avatar.clone()
+}
+
+// SetAvatar points the user at newly stored avatar renditions and returns
+// the previous avatar, if any, so its renditions can be removed.
+func (u *User) SetAvatar(avatar Avatar) *Avatar {
+	previous := u.avatar
+	u.avatar = avatar.clone()
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewProfileUpdatedEvent(u.ID(), []string{"avatar"}))
+	return previous
+}
+
+// RemoveAvatar clears the avatar and returns it, or nil when the user had
+// none.
+func (u *User) RemoveAvatar() *Avatar {
+	previous := u.avatar
+	if previous == nil {
+		return nil
+	}
+	u.avatar = nil
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewProfileUpdatedEvent(u.ID(), []string{"avatar"}))
+	return previous
+}
+
 func (u *User) Delete() error {
 	if u.IsDeleted() {
 		return shared.NewBusinessRuleViolationError("user_already_deleted", "user is already deleted")
@@ -236,6 +491,65 @@ func (u *User) Delete() error {
 	return nil
 }
 
+// Restore brings back a soft-deleted user. Erased users cannot be restored
+// because their personal data is gone.
+func (u *User) Restore(restoredBy *uuid.UUID) error {
+	if !u.IsDeleted() {
+		return ErrUserNotDeleted
+	}
+	if u.IsErased() {
+		return ErrErasedUserNotRestorable
+	}
+
+	u.deletedAt = nil
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserRestoredEvent(u.ID(), restoredBy))
+
+	return nil
+}
+
+// Erase irreversibly replaces the user's personal data with placeholders
+// derived from the id and soft-deletes the user. The row itself survives so
+// that audit entries and other records still resolve to an identity.
+func (u *User) Erase() error {
+	if u.IsErased() {
+		return ErrUserAlreadyErased
+	}
+
+	email, err := shared.NewEmail(fmt.Sprintf("erased-%s@%s", u.ID(), ErasedEmailDomain))
+	if err != nil {
+		return err
+	}
+	fullName, err := shared.NewFullName(ErasedFullName)
+	if err != nil {
+		return err
+	}
+	// Not a valid hash for any hasher, so no password can ever match it.
+	passwordHash, err := shared.NewPasswordHash("!erased")
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	u.email = email
+	u.fullName = fullName
+	u.passwordHash = passwordHash
+	u.status = StatusInactive
+	u.suspension = nil
+	u.roleIDs = make([]uuid.UUID, 0)
+	u.timedRoles = make(map[uuid.UUID]uuid.UUID)
+	u.deniedIDs = make([]uuid.UUID, 0)
+	u.attributes = nil
+	u.avatar = nil
+	if u.deletedAt == nil {
+		u.deletedAt = &now
+	}
+	u.erasedAt = &now
+	u.updatedAt = now
+
+	return nil
+}
+
 func (u *User) RoleIDs() []uuid.UUID {
 	result := make([]uuid.UUID, len(u.roleIDs))
 	copy(result, u.roleIDs)
@@ -251,12 +565,36 @@ func (u *User) HasRole(roleID uuid.UUID) bool {
 	return false
 }
 
+// AssignRole grants roleID permanently. Assigning a role the user holds only
+// through a time-boxed grant makes it permanent, so the grant's expiry no
+// longer revokes it.
 func (u *User) AssignRole(roleID uuid.UUID) error {
+	if u.HasRole(roleID) {
+		if _, temporary := u.timedRoles[roleID]; !temporary {
+			return ErrRoleAlreadyAssigned
+		}
+		delete(u.timedRoles, roleID)
+		u.updatedAt = time.Now().UTC()
+		u.AddDomainEvent(NewUserRoleAssignedEvent(u.ID(), roleID))
+		return nil
+	}
+
+	u.roleIDs = append(u.roleIDs, roleID)
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserRoleAssignedEvent(u.ID(), roleID))
+
+	return nil
+}
+
+// AssignTemporaryRole grants roleID until the access request that approved
+// it expires.
+func (u *User) AssignTemporaryRole(roleID, accessRequestID uuid.UUID) error {
 	if u.HasRole(roleID) {
 		return ErrRoleAlreadyAssigned
 	}
 
 	u.roleIDs = append(u.roleIDs, roleID)
+	u.timedRoles[roleID] = accessRequestID
 	u.updatedAt = time.Now().UTC()
 	u.AddDomainEvent(NewUserRoleAssignedEvent(u.ID(), roleID))
 
@@ -277,21 +615,42 @@ func (u *User) RevokeRole(roleID uuid.UUID) error {
 	}
 
 	u.roleIDs = append(u.roleIDs[:index], u.roleIDs[index+1:]...)
+	delete(u.timedRoles, roleID)
 	u.updatedAt = time.Now().UTC()
 	u.AddDomainEvent(NewUserRoleRevokedEvent(u.ID(), roleID))
 
 	return nil
 }
 
+// RevokeTemporaryRole revokes roleID only while the user still holds it
+// through the grant of accessRequestID. It returns ErrRoleNotAssigned once
+// the role was revoked or granted again by other means.
+func (u *User) RevokeTemporaryRole(roleID, accessRequestID uuid.UUID) error {
+	if grantedBy, temporary := u.timedRoles[roleID]; !temporary || grantedBy != accessRequestID {
+		return ErrRoleNotAssigned
+	}
+	return u.RevokeRole(roleID)
+}
+
+// TemporaryRoles maps each time-boxed role of the user to the access request
+// that granted it. Roles missing from it are held permanently.
+func (u *User) TemporaryRoles() map[uuid.UUID]uuid.UUID {
+	result := make(map[uuid.UUID]uuid.UUID, len(u.timedRoles))
+	for roleID, accessRequestID := range u.timedRoles {
+		result[roleID] = accessRequestID
+	}
+	return result
+}
+
+// SetRoles replaces the roles of the user. Time-boxed roles that remain in
+// the set keep their expiry; the others are held permanently.
 func (u *User) SetRoles(roleIDs []uuid.UUID) {
 	oldRoleIDs := u.roleIDs
 
-	seen := make(map[uuid.UUID]bool)
-	newRoleIDs := make([]uuid.UUID, 0)
-	for _, id := range roleIDs {
-		if !seen[id] {
-			newRoleIDs = append(newRoleIDs, id)
-			seen[id] = true
+	newRoleIDs := shared.UniqueIDs(roleIDs)
+	for roleID := range u.timedRoles {
+		if !slices.Contains(newRoleIDs, roleID) {
+			delete(u.timedRoles, roleID)
 		}
 	}
 
@@ -299,3 +658,58 @@ func (u *User) SetRoles(roleIDs []uuid.UUID) {
 	u.updatedAt = time.Now().UTC()
 	u.AddDomainEvent(NewUserRolesUpdatedEvent(u.ID(), oldRoleIDs, newRoleIDs))
 }
+
+func (u *User) DeniedPermissionIDs() []uuid.UUID {
+	result := make([]uuid.UUID, len(u.deniedIDs))
+	copy(result, u.deniedIDs)
+	return result
+}
+
+func (u *User) DeniesPermission(permissionID uuid.UUID) bool {
+	for _, id := range u.deniedIDs {
+		if id == permissionID {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *User) DenyPermission(permissionID uuid.UUID) error {
+	if u.DeniesPermission(permissionID) {
+		return ErrPermissionAlreadyDenied
+	}
+
+	u.deniedIDs = append(u.deniedIDs, permissionID)
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserPermissionDeniedEvent(u.ID(), permissionID))
+
+	return nil
+}
+
+func (u *User) RemoveDeny(permissionID uuid.UUID) error {
+	index := -1
+	for i, id := range u.deniedIDs {
+		if id == permissionID {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return ErrPermissionNotDenied
+	}
+
+	u.deniedIDs = append(u.deniedIDs[:index], u.deniedIDs[index+1:]...)
+	u.updatedAt = time.Now().UTC()
+	u.AddDomainEvent(NewUserDenyRemovedEvent(u.ID(), permissionID))
+
+	return nil
+}
+
+func copyAttributes(attributes map[string]any) map[string]any {
+	result := make(map[string]any, len(attributes))
+	for key, value := range attributes {
+		result[key] = value
+	}
+	return result
+}
@@ -1,12 +1,15 @@
 package user
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 )
 
 func createTestUser(t *testing.T) *User {
@@ -337,6 +340,47 @@ func TestUser_SetRoles_UpdatesTimestamp(t *testing.T) {
 	assert.True(t, user.UpdatedAt().After(originalUpdatedAt))
 }
 
+func TestUser_TemporaryRoles(t *testing.T) {
+	t.Run("expiry revokes only the grant of the access request", func(t *testing.T) {
+		user := createTestUser(t)
+		roleID, accessRequestID := uuid.New(), uuid.New()
+
+		require.NoError(t, user.AssignTemporaryRole(roleID, accessRequestID))
+		assert.Equal(t, map[uuid.UUID]uuid.UUID{roleID: accessRequestID}, user.TemporaryRoles())
+		assert.ErrorIs(t, user.AssignTemporaryRole(roleID, uuid.New()), ErrRoleAlreadyAssigned)
+
+		assert.ErrorIs(t, user.RevokeTemporaryRole(roleID, uuid.New()), ErrRoleNotAssigned)
+		require.NoError(t, user.RevokeTemporaryRole(roleID, accessRequestID))
+		assert.False(t, user.HasRole(roleID))
+		assert.Empty(t, user.TemporaryRoles())
+	})
+
+	t.Run("permanent assignment outlives the grant", func(t *testing.T) {
+		user := createTestUser(t)
+		roleID, accessRequestID := uuid.New(), uuid.New()
+		require.NoError(t, user.AssignTemporaryRole(roleID, accessRequestID))
+
+		require.NoError(t, user.AssignRole(roleID))
+
+		assert.Empty(t, user.TemporaryRoles())
+		assert.ErrorIs(t, user.RevokeTemporaryRole(roleID, accessRequestID), ErrRoleNotAssigned)
+		assert.True(t, user.HasRole(roleID))
+		assert.ErrorIs(t, user.AssignRole(roleID), ErrRoleAlreadyAssigned)
+	})
+
+	t.Run("replacing roles keeps the expiry of retained grants", func(t *testing.T) {
+		user := createTestUser(t)
+		keptRoleID, droppedRoleID := uuid.New(), uuid.New()
+		require.NoError(t, user.AssignTemporaryRole(keptRoleID, uuid.New()))
+		require.NoError(t, user.AssignTemporaryRole(droppedRoleID, uuid.New()))
+
+		user.SetRoles([]uuid.UUID{keptRoleID})
+
+		assert.Contains(t, user.TemporaryRoles(), keptRoleID)
+		assert.NotContains(t, user.TemporaryRoles(), droppedRoleID)
+	})
+}
+
 func TestUser_NewUser_StartsWithNoRoles(t *testing.T) {
 	user := createTestUser(t)
 
@@ -353,3 +397,260 @@ func TestUser_ReconstructUser_PreservesRoles(t *testing.T) {
 	assert.True(t, user.HasRole(roleID1))
 	assert.True(t, user.HasRole(roleID2))
 }
+
+func TestUser_DenyPermission(t *testing.T) {
+	user := createTestUser(t)
+	user.ClearDomainEvents()
+	permissionID := uuid.New()
+
+	err := user.DenyPermission(permissionID)
+
+	require.NoError(t, err)
+	assert.True(t, user.DeniesPermission(permissionID))
+	assert.Equal(t, []uuid.UUID{permissionID}, user.DeniedPermissionIDs())
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, EventTypeUserPermissionDenied, events[0].EventType())
+	assert.ErrorIs(t, user.DenyPermission(permissionID), ErrPermissionAlreadyDenied)
+}
+
+func TestUser_RemoveDeny(t *testing.T) {
+	user := createTestUser(t)
+	permissionID := uuid.New()
+	require.NoError(t, user.DenyPermission(permissionID))
+
+	err := user.RemoveDeny(permissionID)
+
+	require.NoError(t, err)
+	assert.False(t, user.DeniesPermission(permissionID))
+	assert.ErrorIs(t, user.RemoveDeny(permissionID), ErrPermissionNotDenied)
+}
+
+func TestUser_UpdateAttributes(t *testing.T) {
+	user, err := NewUser(NewUserParams{
+		Email:        "test@example.com",
+		PasswordHash: "$2a$10$hashedpassword",
+		FullName:     "Test User",
+		Attributes:   map[string]any{"department": "sales", "phone": "555-0100"},
+	})
+	require.NoError(t, err)
+	user.ClearDomainEvents()
+
+	user.UpdateAttributes(map[string]any{"department": "engineering", "level": 3.0})
+
+	assert.Equal(t, map[string]any{"department": "engineering", "level": 3.0}, user.Attributes())
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	event, ok := events[0].(ProfileUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, []string{"attributes.department", "attributes.level", "attributes.phone"}, event.ChangedFields)
+
+	user.ClearDomainEvents()
+	user.UpdateAttributes(map[string]any{"department": "engineering", "level": 3.0})
+	assert.Empty(t, user.DomainEvents(), "unchanged attributes do not emit an event")
+
+	user.Attributes()["department"] = "legal"
+	assert.Equal(t, "engineering", user.Attributes()["department"])
+}
+
+func TestUser_Avatar(t *testing.T) {
+	user := createTestUser(t)
+	user.ClearDomainEvents()
+
+	assert.Nil(t, user.RemoveAvatar(), "removing a missing avatar is a no-op")
+	assert.Empty(t, user.DomainEvents())
+
+	first := Avatar{Key: "avatars/1", URLs: map[string]string{"small": "/media/avatars/1/small.png"}}
+	assert.Nil(t, user.SetAvatar(first))
+
+	previous := user.SetAvatar(Avatar{Key: "avatars/2", URLs: map[string]string{"small": "/media/avatars/2/small.png"}})
+	require.NotNil(t, previous)
+	assert.Equal(t, "avatars/1", previous.Key)
+	assert.Equal(t, "avatars/2", user.Avatar().Key)
+
+	user.Avatar().URLs["small"] = "tampered"
+	assert.Equal(t, "/media/avatars/2/small.png", user.Avatar().URLs["small"])
+
+	removed := user.RemoveAvatar()
+	require.NotNil(t, removed)
+	assert.Equal(t, "avatars/2", removed.Key)
+	assert.Nil(t, user.Avatar())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 3)
+	for _, domainEvent := range events {
+		event, ok := domainEvent.(ProfileUpdatedEvent)
+		require.True(t, ok)
+		assert.Equal(t, []string{"avatar"}, event.ChangedFields)
+	}
+}
+
+func TestUser_Restore(t *testing.T) {
+	user := createTestUser(t)
+
+	assert.ErrorIs(t, user.Restore(nil), ErrUserNotDeleted)
+
+	require.NoError(t, user.Delete())
+	user.ClearDomainEvents()
+
+	actorID := uuid.New()
+	require.NoError(t, user.Restore(&actorID))
+	assert.False(t, user.IsDeleted())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	event, ok := events[0].(UserRestoredEvent)
+	require.True(t, ok)
+	assert.Equal(t, &actorID, event.RestoredBy)
+
+	require.NoError(t, user.Erase())
+	assert.ErrorIs(t, user.Restore(nil), ErrErasedUserNotRestorable)
+}
+
+func TestUser_Suspend(t *testing.T) {
+	user := createTestUserWithRoles(t, nil)
+
+	assert.ErrorIs(t, user.Suspend(time.Now().Add(-time.Minute), "spam", nil), ErrSuspensionEndNotInFuture)
+	assert.ErrorIs(t, user.Suspend(time.Now().Add(time.Hour), "  ", nil), ErrSuspensionReasonRequired)
+
+	actorID := uuid.New()
+	until := time.Now().Add(time.Hour)
+	require.NoError(t, user.Suspend(until, " spam ", &actorID))
+	assert.Equal(t, StatusSuspended, user.Status())
+	require.NotNil(t, user.SuspendedUntil())
+	assert.True(t, until.Equal(*user.SuspendedUntil()))
+	assert.Equal(t, "spam", user.SuspensionReason())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	event, ok := events[0].(UserSuspendedEvent)
+	require.True(t, ok)
+	assert.Equal(t, &actorID, event.SuspendedBy)
+
+	assert.ErrorIs(t, user.Suspend(until, "again", nil), ErrUserIsSuspended)
+	assert.ErrorIs(t, user.Activate(), ErrUserIsSuspended)
+
+	pending := createTestUser(t)
+	var transitionErr *shared.InvalidStatusTransitionError
+	assert.ErrorAs(t, pending.Suspend(until, "spam", nil), &transitionErr)
+}
+
+func TestUser_Reinstate(t *testing.T) {
+	user := createTestUserWithRoles(t, nil)
+	var transitionErr *shared.InvalidStatusTransitionError
+	assert.ErrorAs(t, user.Reinstate(time.Now()), &transitionErr)
+
+	until := time.Now().Add(time.Hour)
+	require.NoError(t, user.Suspend(until, "spam", nil))
+	user.ClearDomainEvents()
+
+	assert.ErrorIs(t, user.Reinstate(until.Add(-time.Minute)), ErrSuspensionNotOver)
+
+	require.NoError(t, user.Reinstate(until))
+	assert.Equal(t, StatusActive, user.Status())
+	assert.Nil(t, user.SuspendedUntil())
+	assert.Empty(t, user.SuspensionReason())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, EventTypeUserReinstated, events[0].EventType())
+}
+
+func TestUser_Unban(t *testing.T) {
+	user := createTestUserWithRoles(t, nil)
+	assert.ErrorIs(t, user.Unban("appeal", nil), ErrUserNotBanned)
+
+	require.NoError(t, user.Ban("abuse"))
+	assert.ErrorIs(t, user.Activate(), ErrUserIsBanned)
+	assert.ErrorIs(t, user.Unban(" ", nil), ErrUnbanJustificationRequired)
+	user.ClearDomainEvents()
+
+	actorID := uuid.New()
+	require.NoError(t, user.Unban("appeal upheld", &actorID))
+	assert.Equal(t, StatusActive, user.Status())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	event, ok := events[0].(UserUnbannedEvent)
+	require.True(t, ok)
+	assert.Equal(t, StatusBanned, event.PreviousStatus)
+	assert.Equal(t, "appeal upheld", event.Justification)
+	assert.Equal(t, &actorID, event.UnbannedBy)
+
+	require.NoError(t, user.Suspend(time.Now().Add(time.Hour), "spam", nil))
+	require.NoError(t, user.Unban("mistake", nil))
+	assert.Equal(t, StatusActive, user.Status())
+	assert.Nil(t, user.SuspendedUntil())
+}
+
+func TestUser_ReconstructUser_Suspension(t *testing.T) {
+	now := time.Now().UTC()
+	until := now.Add(time.Hour)
+	user, err := ReconstructUser(ReconstructUserParams{
+		ID:               uuid.New(),
+		Email:            "test@example.com",
+		PasswordHash:     "$2a$10$hashedpassword",
+		FullName:         "Test User",
+		Status:           StatusSuspended,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		SuspendedUntil:   &until,
+		SuspensionReason: "spam",
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, user.SuspendedUntil())
+	assert.True(t, until.Equal(*user.SuspendedUntil()))
+	assert.Equal(t, "spam", user.SuspensionReason())
+}
+
+func TestUser_ChangeEmail(t *testing.T) {
+	user := createTestUser(t)
+	oldEmail := user.Email().String()
+	user.ClearDomainEvents()
+
+	assert.ErrorIs(t, user.ChangeEmail(strings.ToUpper(oldEmail), nil, false), ErrEmailUnchanged)
+	assert.Error(t, user.ChangeEmail("not-an-email", nil, false))
+
+	actorID := uuid.New()
+	require.NoError(t, user.ChangeEmail("New.Address@Example.com", &actorID, true))
+	assert.Equal(t, "new.address@example.com", user.Email().String())
+
+	events := user.DomainEvents()
+	require.Len(t, events, 1)
+	event, ok := events[0].(EmailChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, EventTypeEmailChanged, event.EventType())
+	assert.Equal(t, oldEmail, event.OldEmail)
+	assert.Equal(t, "new.address@example.com", event.NewEmail)
+	assert.Equal(t, &actorID, event.ChangedBy)
+	assert.True(t, event.Override)
+}
+
+func TestNewEmailChange(t *testing.T) {
+	userID := uuid.New()
+	params := NewEmailChangeParams{
+		UserID:           userID,
+		NewEmail:         "new@example.com",
+		ConfirmTokenHash: "confirm-hash",
+		CancelTokenHash:  "cancel-hash",
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}
+
+	change, err := NewEmailChange(params)
+	require.NoError(t, err)
+	assert.Equal(t, userID, change.UserID())
+	assert.Equal(t, "new@example.com", change.NewEmail().String())
+	assert.False(t, change.IsExpired(time.Now()))
+	assert.True(t, change.IsExpired(params.ExpiresAt))
+
+	expired := params
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	_, err = NewEmailChange(expired)
+	assert.Error(t, err)
+
+	missingHash := params
+	missingHash.CancelTokenHash = ""
+	_, err = NewEmailChange(missingHash)
+	assert.Error(t, err)
+}
@@ -0,0 +1,413 @@
+package userattribute
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	// DateLayout is the only accepted format for date attributes.
+	DateLayout = "2006-01-02"
+
+	// MaxStringLength caps string values even when no max_length rule is set.
+	MaxStringLength = 1000
+
+	maxOptions = 100
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// Rules constrain attribute values. Which rules apply depends on the type:
+// strings accept length and pattern, numbers accept min and max, enums
+// require options, and booleans and dates accept none.
+type Rules struct {
+	MinLength *int
+	MaxLength *int
+	Pattern   string
+	Min       *float64
+	Max       *float64
+	Options   []string
+}
+
+type Definition struct {
+	shared.AggregateRoot
+	key           string
+	label         string
+	description   string
+	attributeType Type
+	required      bool
+	visibility    Visibility
+	rules         Rules
+	pattern       *regexp.Regexp
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+type NewDefinitionParams struct {
+	Key         string
+	Label       string
+	Description string
+	Type        Type
+	Required    bool
+	Visibility  Visibility
+	Rules       Rules
+}
+
+func NewDefinition(params NewDefinitionParams) (*Definition, error) {
+	key := strings.TrimSpace(params.Key)
+	if !keyPattern.MatchString(key) {
+		return nil, shared.NewValidationError("key", "key must start with a lowercase letter and contain only lowercase letters, digits and underscores (max 63)")
+	}
+	if !params.Type.IsValid() {
+		return nil, shared.NewValidationError("type", "invalid attribute type")
+	}
+
+	label := strings.TrimSpace(params.Label)
+	if label == "" {
+		label = key
+	}
+	visibility := params.Visibility
+	if visibility == "" {
+		visibility = VisibilityAdmin
+	}
+
+	pattern, err := validateDefinition(label, params.Description, params.Type, visibility, params.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	definition := &Definition{
+		AggregateRoot: shared.NewAggregateRoot(),
+		key:           key,
+		label:         label,
+		description:   params.Description,
+		attributeType: params.Type,
+		required:      params.Required,
+		visibility:    visibility,
+		rules:         params.Rules,
+		pattern:       pattern,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	definition.AddDomainEvent(NewDefinitionCreatedEvent(definition.ID(), key, params.Type, visibility))
+
+	return definition, nil
+}
+
+type ReconstructDefinitionParams struct {
+	ID          uuid.UUID
+	Key         string
+	Label       string
+	Description string
+	Type        Type
+	Required    bool
+	Visibility  Visibility
+	Rules       Rules
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func ReconstructDefinition(params ReconstructDefinitionParams) *Definition {
+	var pattern *regexp.Regexp
+	if params.Rules.Pattern != "" {
+		pattern, _ = regexp.Compile(params.Rules.Pattern)
+	}
+
+	return &Definition{
+		AggregateRoot: shared.NewAggregateRootWithID(params.ID),
+		key:           params.Key,
+		label:         params.Label,
+		description:   params.Description,
+		attributeType: params.Type,
+		required:      params.Required,
+		visibility:    params.Visibility,
+		rules:         params.Rules,
+		pattern:       pattern,
+		createdAt:     params.CreatedAt,
+		updatedAt:     params.UpdatedAt,
+	}
+}
+
+func (d *Definition) Key() string {
+	return d.key
+}
+
+func (d *Definition) Label() string {
+	return d.label
+}
+
+func (d *Definition) Description() string {
+	return d.description
+}
+
+func (d *Definition) Type() Type {
+	return d.attributeType
+}
+
+func (d *Definition) Required() bool {
+	return d.required
+}
+
+func (d *Definition) Visibility() Visibility {
+	return d.visibility
+}
+
+func (d *Definition) Rules() Rules {
+	rules := d.rules
+	if rules.Options != nil {
+		rules.Options = append([]string(nil), rules.Options...)
+	}
+	return rules
+}
+
+func (d *Definition) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Definition) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// UpdateDefinitionParams changes everything but the key and the type, which
+// would invalidate stored values. Empty or nil fields keep their value.
+type UpdateDefinitionParams struct {
+	Label       string
+	Description *string
+	Required    *bool
+	Visibility  Visibility
+	Rules       *Rules
+}
+
+func (d *Definition) Update(params UpdateDefinitionParams) error {
+	label := strings.TrimSpace(params.Label)
+	if label == "" {
+		label = d.label
+	}
+	description := d.description
+	if params.Description != nil {
+		description = *params.Description
+	}
+	required := d.required
+	if params.Required != nil {
+		required = *params.Required
+	}
+	visibility := d.visibility
+	if params.Visibility != "" {
+		visibility = params.Visibility
+	}
+	rules := d.rules
+	if params.Rules != nil {
+		rules = *params.Rules
+	}
+
+	pattern, err := validateDefinition(label, description, d.attributeType, visibility, rules)
+	if err != nil {
+		return err
+	}
+
+	d.label = label
+	d.description = description
+	d.required = required
+	d.visibility = visibility
+	d.rules = rules
+	d.pattern = pattern
+	d.updatedAt = time.Now().UTC()
+	d.AddDomainEvent(NewDefinitionUpdatedEvent(d.ID(), d.key, visibility))
+
+	return nil
+}
+
+// Validate checks a decoded JSON value against the definition and returns it
+// in its canonical form: float64 for numbers and YYYY-MM-DD for dates.
+func (d *Definition) Validate(value any) (any, error) {
+	switch d.attributeType {
+	case TypeString:
+		text, ok := value.(string)
+		if !ok {
+			return nil, newValueError(d.key, "must be a string")
+		}
+		length := utf8.RuneCountInString(text)
+		if length > MaxStringLength {
+			return nil, newValueError(d.key, fmt.Sprintf("must be at most %d characters", MaxStringLength))
+		}
+		if d.rules.MinLength != nil && length < *d.rules.MinLength {
+			return nil, newValueError(d.key, fmt.Sprintf("must be at least %d characters", *d.rules.MinLength))
+		}
+		if d.rules.MaxLength != nil && length > *d.rules.MaxLength {
+			return nil, newValueError(d.key, fmt.Sprintf("must be at most %d characters", *d.rules.MaxLength))
+		}
+		if d.pattern != nil && !d.pattern.MatchString(text) {
+			return nil, newValueError(d.key, "does not match the required pattern")
+		}
+		return text, nil
+
+	case TypeNumber:
+		number, ok := toFloat(value)
+		if !ok {
+			return nil, newValueError(d.key, "must be a number")
+		}
+		if d.rules.Min != nil && number < *d.rules.Min {
+			return nil, newValueError(d.key, fmt.Sprintf("must be at least %v", *d.rules.Min))
+		}
+		if d.rules.Max != nil && number > *d.rules.Max {
+			return nil, newValueError(d.key, fmt.Sprintf("must be at most %v", *d.rules.Max))
+		}
+		return number, nil
+
+	case TypeBoolean:
+		flag, ok := value.(bool)
+		if !ok {
+			return nil, newValueError(d.key, "must be a boolean")
+		}
+		return flag, nil
+
+	case TypeDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, newValueError(d.key, "must be a date in YYYY-MM-DD format")
+		}
+		date, err := time.Parse(DateLayout, text)
+		if err != nil {
+			return nil, newValueError(d.key, "must be a date in YYYY-MM-DD format")
+		}
+		return date.Format(DateLayout), nil
+
+	case TypeEnum:
+		text, ok := value.(string)
+		if !ok {
+			return nil, newValueError(d.key, "must be a string")
+		}
+		for _, option := range d.rules.Options {
+			if option == text {
+				return text, nil
+			}
+		}
+		return nil, newValueError(d.key, "must be one of "+strings.Join(d.rules.Options, ", "))
+
+	default:
+		return nil, newValueError(d.key, "has an unknown type")
+	}
+}
+
+// ParseFilterValue converts a query string value into the type stored for
+// this attribute so it can be compared for equality.
+func (d *Definition) ParseFilterValue(raw string) (any, error) {
+	switch d.attributeType {
+	case TypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, newValueError(d.key, "must be a number")
+		}
+		return number, nil
+	case TypeBoolean:
+		flag, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, newValueError(d.key, "must be a boolean")
+		}
+		return flag, nil
+	default:
+		return d.Validate(raw)
+	}
+}
+
+func validateDefinition(label, description string, attributeType Type, visibility Visibility, rules Rules) (*regexp.Regexp, error) {
+	if len(label) > 100 {
+		return nil, shared.NewValidationError("label", "label cannot exceed 100 characters")
+	}
+	if len(description) > 500 {
+		return nil, shared.NewValidationError("description", "description cannot exceed 500 characters")
+	}
+	if !visibility.IsValid() {
+		return nil, shared.NewValidationError("visibility", "visibility must be public, self or admin")
+	}
+
+	hasLength := rules.MinLength != nil || rules.MaxLength != nil
+	hasRange := rules.Min != nil || rules.Max != nil
+	hasPattern := rules.Pattern != ""
+	hasOptions := len(rules.Options) > 0
+
+	switch attributeType {
+	case TypeString:
+		if hasRange || hasOptions {
+			return nil, shared.NewValidationError("rules", "string attributes accept only min_length, max_length and pattern")
+		}
+		if rules.MinLength != nil && *rules.MinLength < 0 {
+			return nil, shared.NewValidationError("rules.min_length", "min_length cannot be negative")
+		}
+		if rules.MaxLength != nil && (*rules.MaxLength < 1 || *rules.MaxLength > MaxStringLength) {
+			return nil, shared.NewValidationError("rules.max_length", fmt.Sprintf("max_length must be between 1 and %d", MaxStringLength))
+		}
+		if rules.MinLength != nil && rules.MaxLength != nil && *rules.MinLength > *rules.MaxLength {
+			return nil, shared.NewValidationError("rules.min_length", "min_length cannot exceed max_length")
+		}
+		if hasPattern {
+			pattern, err := regexp.Compile(rules.Pattern)
+			if err != nil {
+				return nil, shared.NewValidationError("rules.pattern", "pattern is not a valid regular expression")
+			}
+			return pattern, nil
+		}
+
+	case TypeNumber:
+		if hasLength || hasPattern || hasOptions {
+			return nil, shared.NewValidationError("rules", "number attributes accept only min and max")
+		}
+		if rules.Min != nil && rules.Max != nil && *rules.Min > *rules.Max {
+			return nil, shared.NewValidationError("rules.min", "min cannot exceed max")
+		}
+
+	case TypeEnum:
+		if hasLength || hasRange || hasPattern {
+			return nil, shared.NewValidationError("rules", "enum attributes accept only options")
+		}
+		if !hasOptions || len(rules.Options) > maxOptions {
+			return nil, shared.NewValidationError("rules.options", fmt.Sprintf("enum attributes require between 1 and %d options", maxOptions))
+		}
+		seen := make(map[string]bool, len(rules.Options))
+		for _, option := range rules.Options {
+			if option == "" || seen[option] {
+				return nil, shared.NewValidationError("rules.options", "options must be unique and non-empty")
+			}
+			seen[option] = true
+		}
+
+	case TypeBoolean, TypeDate:
+		if hasLength || hasRange || hasPattern || hasOptions {
+			return nil, shared.NewValidationError("rules", attributeType.String()+" attributes do not accept rules")
+		}
+
+	default:
+		return nil, shared.NewValidationError("type", "invalid attribute type")
+	}
+
+	return nil, nil
+}
+
+func toFloat(value any) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case float32:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case json.Number:
+		parsed, err := number.Float64()
+		return parsed, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,187 @@
+package userattribute
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func floatPointer(value float64) *float64 {
+	return &value
+}
+
+func TestNewDefinition(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    NewDefinitionParams
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name: "valid string with rules",
+			params: NewDefinitionParams{
+				Key:   "employee_id",
+				Type:  TypeString,
+				Rules: Rules{MinLength: intPointer(3), MaxLength: intPointer(10), Pattern: `^E[0-9]+$`},
+			},
+		},
+		{
+			name: "valid enum",
+			params: NewDefinitionParams{
+				Key:        "department",
+				Type:       TypeEnum,
+				Visibility: VisibilityPublic,
+				Rules:      Rules{Options: []string{"engineering", "sales"}},
+			},
+		},
+		{
+			name:      "key with uppercase letters",
+			params:    NewDefinitionParams{Key: "EmployeeID", Type: TypeString},
+			wantErr:   true,
+			wantField: "key",
+		},
+		{
+			name:      "unknown type",
+			params:    NewDefinitionParams{Key: "shoe_size", Type: Type("decimal")},
+			wantErr:   true,
+			wantField: "type",
+		},
+		{
+			name:      "unknown visibility",
+			params:    NewDefinitionParams{Key: "nickname", Type: TypeString, Visibility: Visibility("friends")},
+			wantErr:   true,
+			wantField: "visibility",
+		},
+		{
+			name:      "enum without options",
+			params:    NewDefinitionParams{Key: "department", Type: TypeEnum},
+			wantErr:   true,
+			wantField: "rules.options",
+		},
+		{
+			name:      "number with a pattern",
+			params:    NewDefinitionParams{Key: "cost_center", Type: TypeNumber, Rules: Rules{Pattern: `^[0-9]+$`}},
+			wantErr:   true,
+			wantField: "rules",
+		},
+		{
+			name:      "invalid pattern",
+			params:    NewDefinitionParams{Key: "employee_id", Type: TypeString, Rules: Rules{Pattern: `(`}},
+			wantErr:   true,
+			wantField: "rules.pattern",
+		},
+		{
+			name:      "inverted range",
+			params:    NewDefinitionParams{Key: "level", Type: TypeNumber, Rules: Rules{Min: floatPointer(5), Max: floatPointer(1)}},
+			wantErr:   true,
+			wantField: "rules.min",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition, err := NewDefinition(tt.params)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				var validationErr *shared.ValidationError
+				require.True(t, errors.As(err, &validationErr))
+				assert.Equal(t, tt.wantField, validationErr.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.params.Key, definition.Key())
+			assert.Len(t, definition.DomainEvents(), 1)
+			assert.Equal(t, EventTypeDefinitionCreated, definition.DomainEvents()[0].EventType())
+		})
+	}
+
+	t.Run("defaults label and visibility", func(t *testing.T) {
+		definition, err := NewDefinition(NewDefinitionParams{Key: "nickname", Type: TypeString})
+		require.NoError(t, err)
+		assert.Equal(t, "nickname", definition.Label())
+		assert.Equal(t, VisibilityAdmin, definition.Visibility())
+	})
+}
+
+func TestDefinition_Validate(t *testing.T) {
+	employeeID, err := NewDefinition(NewDefinitionParams{
+		Key:   "employee_id",
+		Type:  TypeString,
+		Rules: Rules{MaxLength: intPointer(6), Pattern: `^E[0-9]+$`},
+	})
+	require.NoError(t, err)
+	level, err := NewDefinition(NewDefinitionParams{Key: "level", Type: TypeNumber, Rules: Rules{Min: floatPointer(1), Max: floatPointer(10)}})
+	require.NoError(t, err)
+	hiredOn, err := NewDefinition(NewDefinitionParams{Key: "hired_on", Type: TypeDate})
+	require.NoError(t, err)
+	department, err := NewDefinition(NewDefinitionParams{Key: "department", Type: TypeEnum, Rules: Rules{Options: []string{"engineering", "sales"}}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		definition *Definition
+		value      any
+		want       any
+		wantErr    bool
+	}{
+		{name: "matching string", definition: employeeID, value: "E1234", want: "E1234"},
+		{name: "string too long", definition: employeeID, value: "E123456", wantErr: true},
+		{name: "string not matching pattern", definition: employeeID, value: "X12", wantErr: true},
+		{name: "number given to string", definition: employeeID, value: 12.0, wantErr: true},
+		{name: "number in range", definition: level, value: 3.0, want: 3.0},
+		{name: "integer is normalized", definition: level, value: 4, want: 4.0},
+		{name: "number out of range", definition: level, value: 11.0, wantErr: true},
+		{name: "valid date", definition: hiredOn, value: "2024-02-29", want: "2024-02-29"},
+		{name: "invalid date", definition: hiredOn, value: "2023-02-29", wantErr: true},
+		{name: "known option", definition: department, value: "sales", want: "sales"},
+		{name: "unknown option", definition: department, value: "legal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.definition.Validate(tt.value)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				var validationErr *shared.ValidationError
+				require.True(t, errors.As(err, &validationErr))
+				assert.Equal(t, "attributes."+tt.definition.Key(), validationErr.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDefinition_Update(t *testing.T) {
+	definition, err := NewDefinition(NewDefinitionParams{Key: "department", Type: TypeEnum, Rules: Rules{Options: []string{"engineering"}}})
+	require.NoError(t, err)
+	definition.ClearDomainEvents()
+
+	required := true
+	require.NoError(t, definition.Update(UpdateDefinitionParams{
+		Required:   &required,
+		Visibility: VisibilityPublic,
+		Rules:      &Rules{Options: []string{"engineering", "sales"}},
+	}))
+	assert.True(t, definition.Required())
+	assert.Equal(t, VisibilityPublic, definition.Visibility())
+	assert.Equal(t, []string{"engineering", "sales"}, definition.Rules().Options)
+	assert.Equal(t, TypeEnum, definition.Type())
+	assert.Equal(t, EventTypeDefinitionUpdated, definition.DomainEvents()[0].EventType())
+
+	err = definition.Update(UpdateDefinitionParams{Rules: &Rules{MaxLength: intPointer(5)}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package userattribute
+
+import (
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+var (
+	ErrDefinitionNotFound = shared.NewNotFoundError("AttributeDefinition", "")
+
+	ErrKeyExists = shared.NewConflictError("AttributeDefinition", "key", "")
+)
+
+func NewDefinitionNotFoundError(identifier string) *shared.NotFoundError {
+	return shared.NewNotFoundError("AttributeDefinition", identifier)
+}
+
+func NewKeyExistsError(key string) *shared.ConflictError {
+	return shared.NewConflictError("AttributeDefinition", "key", key)
+}
+
+func newValueError(key, message string) *shared.ValidationError {
+	return shared.NewValidationError("attributes."+key, message)
+}
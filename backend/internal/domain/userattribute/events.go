@@ -0,0 +1,55 @@
+package userattribute
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+const (
+	EventTypeDefinitionCreated = "user_attribute.created"
+	EventTypeDefinitionUpdated = "user_attribute.updated"
+	EventTypeDefinitionDeleted = "user_attribute.deleted"
+)
+
+type DefinitionCreatedEvent struct {
+	shared.BaseDomainEvent
+	Key        string
+	Type       Type
+	Visibility Visibility
+}
+
+func NewDefinitionCreatedEvent(definitionID uuid.UUID, key string, attributeType Type, visibility Visibility) DefinitionCreatedEvent {
+	return DefinitionCreatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(definitionID, EventTypeDefinitionCreated),
+		Key:             key,
+		Type:            attributeType,
+		Visibility:      visibility,
+	}
+}
+
+type DefinitionUpdatedEvent struct {
+	shared.BaseDomainEvent
+	Key        string
+	Visibility Visibility
+}
+
+func NewDefinitionUpdatedEvent(definitionID uuid.UUID, key string, visibility Visibility) DefinitionUpdatedEvent {
+	return DefinitionUpdatedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(definitionID, EventTypeDefinitionUpdated),
+		Key:             key,
+		Visibility:      visibility,
+	}
+}
+
+type DefinitionDeletedEvent struct {
+	shared.BaseDomainEvent
+	Key string
+}
+
+func NewDefinitionDeletedEvent(definitionID uuid.UUID, key string) DefinitionDeletedEvent {
+	return DefinitionDeletedEvent{
+		BaseDomainEvent: shared.NewBaseDomainEvent(definitionID, EventTypeDefinitionDeleted),
+		Key:             key,
+	}
+}
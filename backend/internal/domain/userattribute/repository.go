@@ -0,0 +1,14 @@
+package userattribute
+
+import (
+	"context"
+)
+
+type Repository interface {
+	Create(ctx context.Context, definition *Definition) error
+	Update(ctx context.Context, definition *Definition) error
+	// Delete removes the definition and strips its key from every user.
+	Delete(ctx context.Context, definition *Definition) error
+	FindByKey(ctx context.Context, key string) (*Definition, error)
+	FindAll(ctx context.Context) ([]*Definition, error)
+}
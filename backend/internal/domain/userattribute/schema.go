@@ -0,0 +1,119 @@
+package userattribute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Schema is the set of attribute definitions that user attribute values are
+// checked against.
+type Schema struct {
+	definitions map[string]*Definition
+}
+
+func NewSchema(definitions []*Definition) Schema {
+	schema := Schema{definitions: make(map[string]*Definition, len(definitions))}
+	for _, definition := range definitions {
+		schema.definitions[definition.Key()] = definition
+	}
+	return schema
+}
+
+func LoadSchema(ctx context.Context, repository Repository) (Schema, error) {
+	definitions, err := repository.FindAll(ctx)
+	if err != nil {
+		return Schema{}, fmt.Errorf("load attribute definitions: %w", err)
+	}
+	return NewSchema(definitions), nil
+}
+
+func (s Schema) Definition(key string) (*Definition, bool) {
+	definition, ok := s.definitions[key]
+	return definition, ok
+}
+
+// Apply merges changes into current and validates the result. A nil value in
+// changes removes the attribute. Keys without a definition are rejected, as
+// is a result that lacks a required attribute.
+func (s Schema) Apply(current, changes map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(current)+len(changes))
+	for key, value := range current {
+		if _, ok := s.definitions[key]; ok {
+			result[key] = value
+		}
+	}
+
+	for _, key := range sortedKeys(changes) {
+		definition, ok := s.definitions[key]
+		if !ok {
+			return nil, newValueError(key, "is not a defined attribute")
+		}
+
+		value := changes[key]
+		if value == nil {
+			delete(result, key)
+			continue
+		}
+
+		normalized, err := definition.Validate(value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = normalized
+	}
+
+	for _, key := range sortedKeys(s.definitions) {
+		if _, ok := result[key]; s.definitions[key].Required() && !ok {
+			return nil, newValueError(key, "is required")
+		}
+	}
+
+	return result, nil
+}
+
+// Visible returns the values the audience may read. Values whose definition
+// no longer exists are dropped.
+func (s Schema) Visible(values map[string]any, audience Audience) map[string]any {
+	result := make(map[string]any)
+	for key, value := range values {
+		definition, ok := s.definitions[key]
+		if ok && definition.Visibility().VisibleTo(audience) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// ParseFilter converts raw query string filters into typed values. Filtering
+// on an attribute the audience cannot read is rejected so that the filter
+// cannot be used to probe hidden values.
+func (s Schema) ParseFilter(raw map[string]string, audience Audience) (map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]any, len(raw))
+	for _, key := range sortedKeys(raw) {
+		definition, ok := s.definitions[key]
+		if !ok || !definition.Visibility().VisibleTo(audience) {
+			return nil, newValueError(key, "is not a filterable attribute")
+		}
+
+		value, err := definition.ParseFilterValue(raw[key])
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
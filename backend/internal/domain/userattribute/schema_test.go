@@ -0,0 +1,78 @@
+package userattribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSchema(t *testing.T) Schema {
+	t.Helper()
+
+	department, err := NewDefinition(NewDefinitionParams{
+		Key:        "department",
+		Type:       TypeEnum,
+		Required:   true,
+		Visibility: VisibilityPublic,
+		Rules:      Rules{Options: []string{"engineering", "sales"}},
+	})
+	require.NoError(t, err)
+	phone, err := NewDefinition(NewDefinitionParams{Key: "phone", Type: TypeString, Visibility: VisibilitySelf})
+	require.NoError(t, err)
+	salaryBand, err := NewDefinition(NewDefinitionParams{Key: "salary_band", Type: TypeNumber, Visibility: VisibilityAdmin})
+	require.NoError(t, err)
+
+	return NewSchema([]*Definition{department, phone, salaryBand})
+}
+
+func TestSchema_Apply(t *testing.T) {
+	schema := newTestSchema(t)
+
+	t.Run("merges and removes values", func(t *testing.T) {
+		current := map[string]any{"department": "sales", "phone": "555-0100", "retired_key": "x"}
+
+		result, err := schema.Apply(current, map[string]any{"phone": nil, "salary_band": 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"department": "sales", "salary_band": 3.0}, result)
+	})
+
+	t.Run("rejects undefined keys", func(t *testing.T) {
+		_, err := schema.Apply(nil, map[string]any{"department": "sales", "shoe_size": 42})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "attributes.shoe_size")
+	})
+
+	t.Run("enforces required attributes", func(t *testing.T) {
+		_, err := schema.Apply(nil, map[string]any{"phone": "555-0100"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "attributes.department")
+
+		_, err = schema.Apply(map[string]any{"department": "sales"}, map[string]any{"department": nil})
+		assert.Error(t, err)
+	})
+}
+
+func TestSchema_Visible(t *testing.T) {
+	schema := newTestSchema(t)
+	values := map[string]any{"department": "sales", "phone": "555-0100", "salary_band": 3.0}
+
+	assert.Equal(t, map[string]any{"department": "sales"}, schema.Visible(values, AudiencePublic))
+	assert.Equal(t, map[string]any{"department": "sales", "phone": "555-0100"}, schema.Visible(values, AudienceSelf))
+	assert.Equal(t, values, schema.Visible(values, AudienceAdmin))
+}
+
+func TestSchema_ParseFilter(t *testing.T) {
+	schema := newTestSchema(t)
+
+	filter, err := schema.ParseFilter(map[string]string{"salary_band": "3"}, AudienceAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"salary_band": 3.0}, filter)
+
+	_, err = schema.ParseFilter(map[string]string{"salary_band": "3"}, AudiencePublic)
+	assert.Error(t, err, "hidden attributes cannot be filtered on")
+
+	_, err = schema.ParseFilter(map[string]string{"department": "legal"}, AudiencePublic)
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package userattribute
+
+type Type string
+
+const (
+	TypeString  Type = "string"
+	TypeNumber  Type = "number"
+	TypeBoolean Type = "boolean"
+	TypeDate    Type = "date"
+	TypeEnum    Type = "enum"
+)
+
+var validTypes = map[Type]bool{
+	TypeString:  true,
+	TypeNumber:  true,
+	TypeBoolean: true,
+	TypeDate:    true,
+	TypeEnum:    true,
+}
+
+func (t Type) IsValid() bool {
+	return validTypes[t]
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+func ParseType(s string) (Type, bool) {
+	attributeType := Type(s)
+	return attributeType, attributeType.IsValid()
+}
+
+// Visibility controls who can read an attribute value. Writing is always
+// reserved to administrators.
+type Visibility string
+
+const (
+	// VisibilityPublic values are shown to anyone who can see the user.
+	VisibilityPublic Visibility = "public"
+	// VisibilitySelf values are shown to the user and to administrators.
+	VisibilitySelf Visibility = "self"
+	// VisibilityAdmin values are shown to administrators only.
+	VisibilityAdmin Visibility = "admin"
+)
+
+var validVisibilities = map[Visibility]bool{
+	VisibilityPublic: true,
+	VisibilitySelf:   true,
+	VisibilityAdmin:  true,
+}
+
+func (v Visibility) IsValid() bool {
+	return validVisibilities[v]
+}
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+func (v Visibility) VisibleTo(audience Audience) bool {
+	switch v {
+	case VisibilityPublic:
+		return true
+	case VisibilitySelf:
+		return audience == AudienceSelf || audience == AudienceAdmin
+	case VisibilityAdmin:
+		return audience == AudienceAdmin
+	default:
+		return false
+	}
+}
+
+func ParseVisibility(s string) (Visibility, bool) {
+	visibility := Visibility(s)
+	return visibility, visibility.IsValid()
+}
+
+// Audience is the relationship between a caller and the user being viewed.
+type Audience int
+
+const (
+	AudiencePublic Audience = iota
+	AudienceSelf
+	AudienceAdmin
+)
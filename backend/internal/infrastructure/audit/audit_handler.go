@@ -6,8 +6,15 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
@@ -168,6 +175,540 @@ func (handler *AuthAuditHandler) HandleEvent(ctx context.Context, event shared.D
 			},
 		}
 
+	case organization.OrganizationMemberAddedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.UserID,
+			Action:       "organization_member_added",
+			ResourceType: "organization",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+		}
+
+	case organization.OrganizationMemberRemovedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.UserID,
+			Action:       "organization_member_removed",
+			ResourceType: "organization",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+		}
+
+	case group.GroupMemberAddedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.UserID,
+			Action:       "group_member_added",
+			ResourceType: "group",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+		}
+
+	case group.GroupMemberRemovedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.UserID,
+			Action:       "group_member_removed",
+			ResourceType: "group",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+		}
+
+	case group.GroupRoleAssignedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			Action:       "group_role_assigned",
+			ResourceType: "group",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id": e.RoleID.String(),
+			},
+		}
+
+	case group.GroupRoleRevokedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			Action:       "group_role_revoked",
+			ResourceType: "group",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id": e.RoleID.String(),
+			},
+		}
+
+	case accessrequest.AccessRequestCreatedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.RequesterID,
+			Action:       "access_request_created",
+			ResourceType: "access_request",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id":          e.RoleID.String(),
+				"duration_seconds": int64(e.Duration.Seconds()),
+			},
+		}
+
+	case accessrequest.AccessRequestApprovedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.ReviewerID,
+			Action:       "access_request_approved",
+			ResourceType: "access_request",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"requester_id": e.RequesterID.String(),
+				"role_id":      e.RoleID.String(),
+				"expires_at":   e.ExpiresAt,
+			},
+		}
+
+	case accessrequest.AccessRequestDeniedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.ReviewerID,
+			Action:       "access_request_denied",
+			ResourceType: "access_request",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"requester_id": e.RequesterID.String(),
+				"role_id":      e.RoleID.String(),
+			},
+		}
+
+	case accessrequest.AccessRequestCancelledEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.RequesterID,
+			Action:       "access_request_cancelled",
+			ResourceType: "access_request",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id": e.RoleID.String(),
+			},
+		}
+
+	case accessrequest.AccessRequestExpiredEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.RequesterID,
+			Action:       "access_request_expired",
+			ResourceType: "access_request",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id": e.RoleID.String(),
+			},
+		}
+
+	case accessreview.CampaignLaunchedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.CreatedBy,
+			Action:       "access_review_launched",
+			ResourceType: "access_review",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"name":       e.Name,
+				"item_count": e.ItemCount,
+				"due_at":     e.DueAt,
+			},
+		}
+
+	case accessreview.ReviewerAssignedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.AssignedBy,
+			Action:       "access_reviewer_assigned",
+			ResourceType: "access_review",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"role_id":     e.RoleID.String(),
+				"reviewer_id": e.ReviewerID.String(),
+			},
+		}
+
+	case accessreview.ReviewItemDecidedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.ReviewerID,
+			Action:       "access_review_item_decided",
+			ResourceType: "access_review",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"item_id":  e.ItemID.String(),
+				"user_id":  e.UserID.String(),
+				"role_id":  e.RoleID.String(),
+				"decision": e.Decision.String(),
+				"comment":  e.Comment,
+			},
+		}
+
+	case accessreview.ReviewItemEscalatedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.EscalatedTo,
+			Action:       "access_review_item_escalated",
+			ResourceType: "access_review",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"item_id": e.ItemID.String(),
+				"user_id": e.UserID.String(),
+				"role_id": e.RoleID.String(),
+			},
+		}
+
+	case accessreview.CampaignClosedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.ClosedBy,
+			Action:       "access_review_closed",
+			ResourceType: "access_review",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"kept":    e.Kept,
+				"revoked": e.Revoked,
+				"pending": e.Pending,
+			},
+		}
+
+	case bulkoperation.ItemProcessedEvent:
+		entry = AuditEntry{
+			ID:            uuid.New(),
+			Timestamp:     e.OccurredAt(),
+			EventType:     e.EventType(),
+			UserID:        e.CreatedBy,
+			Action:        "bulk_" + e.Action.String(),
+			ResourceType:  "user",
+			ResourceID:    e.UserID.String(),
+			Success:       e.Succeeded,
+			FailureReason: e.Failure,
+			Metadata: map[string]interface{}{
+				"correlation_id": e.AggregateID().String(),
+			},
+		}
+
+	case bulkoperation.OperationCompletedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.CreatedBy,
+			Action:       "bulk_" + e.Action.String(),
+			ResourceType: "bulk_operation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"correlation_id": e.AggregateID().String(),
+				"total":          e.Total,
+				"succeeded":      e.Succeeded,
+				"failed":         e.Failed,
+			},
+		}
+
+	case bulkoperation.OperationFailedEvent:
+		entry = AuditEntry{
+			ID:            uuid.New(),
+			Timestamp:     e.OccurredAt(),
+			EventType:     e.EventType(),
+			UserID:        e.CreatedBy,
+			Action:        "bulk_" + e.Action.String(),
+			ResourceType:  "bulk_operation",
+			ResourceID:    e.AggregateID().String(),
+			Success:       false,
+			FailureReason: e.Reason,
+			Metadata: map[string]interface{}{
+				"correlation_id": e.AggregateID().String(),
+			},
+		}
+
+	case user.UserErasedEvent:
+		actorID := uuid.Nil
+		if e.Receipt.ErasedBy != nil {
+			actorID = *e.Receipt.ErasedBy
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       actorID,
+			Action:       "erase",
+			ResourceType: "user",
+			ResourceID:   e.Receipt.UserID.String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"refresh_tokens_deleted":      e.Receipt.RefreshTokensDeleted,
+				"reset_tokens_deleted":        e.Receipt.ResetTokensDeleted,
+				"invitations_deleted":         e.Receipt.InvitationsDeleted,
+				"audit_entries_pseudonymized": e.Receipt.AuditEntriesPseudonymized,
+				"avatar_removed":              e.Receipt.AvatarRemoved,
+			},
+		}
+
+	case user.UserRestoredEvent:
+		actorID := uuid.Nil
+		if e.RestoredBy != nil {
+			actorID = *e.RestoredBy
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       actorID,
+			Action:       "restore",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+		}
+
+	case user.UserPurgedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       uuid.Nil,
+			Action:       "purge",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"deleted_at": e.DeletedAt,
+			},
+		}
+
+	case user.UserSuspendedEvent:
+		actorID := uuid.Nil
+		if e.SuspendedBy != nil {
+			actorID = *e.SuspendedBy
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       actorID,
+			Action:       "suspend",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"until":  e.Until,
+				"reason": e.Reason,
+			},
+		}
+
+	case user.UserReinstatedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       uuid.Nil,
+			Action:       "reinstate",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"suspended_until": e.SuspendedUntil,
+			},
+		}
+
+	case user.UserUnbannedEvent:
+		actorID := uuid.Nil
+		if e.UnbannedBy != nil {
+			actorID = *e.UnbannedBy
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       actorID,
+			Action:       "unban",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"previous_status": e.PreviousStatus.String(),
+				"justification":   e.Justification,
+			},
+		}
+
+	case user.EmailChangeRequestedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.AggregateID(),
+			Action:       "request_email_change",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"new_email":  e.NewEmail,
+				"expires_at": e.ExpiresAt,
+			},
+		}
+
+	case user.EmailChangeCancelledEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.AggregateID(),
+			Action:       "cancel_email_change",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"new_email": e.NewEmail,
+			},
+		}
+
+	case user.EmailChangedEvent:
+		actorID := uuid.Nil
+		if e.ChangedBy != nil {
+			actorID = *e.ChangedBy
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       actorID,
+			Action:       "change_email",
+			ResourceType: "user",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"old_email": e.OldEmail,
+				"new_email": e.NewEmail,
+				"override":  e.Override,
+			},
+		}
+
+	case invitation.InvitationCreatedEvent:
+		roleIDs := make([]string, len(e.RoleIDs))
+		for i, roleID := range e.RoleIDs {
+			roleIDs[i] = roleID.String()
+		}
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.InvitedBy,
+			Action:       "invite_user",
+			ResourceType: "invitation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"email":      e.Email,
+				"role_ids":   roleIDs,
+				"expires_at": e.ExpiresAt,
+			},
+		}
+
+	case invitation.InvitationResentEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.ResentBy,
+			Action:       "resend_invitation",
+			ResourceType: "invitation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"email":      e.Email,
+				"expires_at": e.ExpiresAt,
+			},
+		}
+
+	case invitation.InvitationRevokedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.RevokedBy,
+			Action:       "revoke_invitation",
+			ResourceType: "invitation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"email": e.Email,
+			},
+		}
+
+	case invitation.InvitationAcceptedEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			UserID:       e.UserID,
+			Action:       "accept_invitation",
+			ResourceType: "invitation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"email":      e.Email,
+				"invited_by": e.InvitedBy.String(),
+			},
+		}
+
+	case invitation.InvitationExpiredEvent:
+		entry = AuditEntry{
+			ID:           uuid.New(),
+			Timestamp:    e.OccurredAt(),
+			EventType:    e.EventType(),
+			Action:       "expire_invitation",
+			ResourceType: "invitation",
+			ResourceID:   e.AggregateID().String(),
+			Success:      true,
+			Metadata: map[string]interface{}{
+				"email": e.Email,
+			},
+		}
+
 	default:
 		return nil
 	}
@@ -202,5 +743,38 @@ func (handler *AuthAuditHandler) SubscribedEventTypes() []string {
 		auth.EventTypeRefreshTokenRotated,
 		auth.EventTypeLoginFailed,
 		auth.EventTypeAccountLocked,
+		organization.EventTypeOrganizationMemberAdded,
+		organization.EventTypeOrganizationMemberRemoved,
+		group.EventTypeGroupMemberAdded,
+		group.EventTypeGroupMemberRemoved,
+		group.EventTypeGroupRoleAssigned,
+		group.EventTypeGroupRoleRevoked,
+		accessrequest.EventTypeAccessRequestCreated,
+		accessrequest.EventTypeAccessRequestApproved,
+		accessrequest.EventTypeAccessRequestDenied,
+		accessrequest.EventTypeAccessRequestCancelled,
+		accessrequest.EventTypeAccessRequestExpired,
+		accessreview.EventTypeCampaignLaunched,
+		accessreview.EventTypeReviewerAssigned,
+		accessreview.EventTypeReviewItemDecided,
+		accessreview.EventTypeReviewItemEscalated,
+		accessreview.EventTypeCampaignClosed,
+		bulkoperation.EventTypeItemProcessed,
+		bulkoperation.EventTypeOperationCompleted,
+		bulkoperation.EventTypeOperationFailed,
+		user.EventTypeUserErased,
+		user.EventTypeUserRestored,
+		user.EventTypeUserPurged,
+		user.EventTypeUserSuspended,
+		user.EventTypeUserReinstated,
+		user.EventTypeUserUnbanned,
+		user.EventTypeEmailChangeRequested,
+		user.EventTypeEmailChangeCancelled,
+		user.EventTypeEmailChanged,
+		invitation.EventTypeInvitationCreated,
+		invitation.EventTypeInvitationResent,
+		invitation.EventTypeInvitationRevoked,
+		invitation.EventTypeInvitationAccepted,
+		invitation.EventTypeInvitationExpired,
 	}
 }
@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	querySelectAuditLogs = `
+		SELECT id, timestamp, event_type, user_id, action,
+			COALESCE(resource_type, ''), COALESCE(resource_id, ''), COALESCE(host(ip_address), ''),
+			COALESCE(user_agent, ''), success, COALESCE(failure_reason, ''), COALESCE(metadata, '{}'::jsonb)
+		FROM audit_logs`
+
+	queryCountAuditLogs = `
+		SELECT COUNT(*) FROM audit_logs`
+)
+
+var auditLogKeyset = postgres.NewKeyset(
+	postgres.KeysetColumn{Column: "timestamp", Direction: postgres.OrderDesc, Type: postgres.KeyTime},
+	postgres.KeysetColumn{Column: "id", Direction: postgres.OrderDesc, Type: postgres.KeyUUID},
+)
+
+type PostgresAuditLogRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAuditLogRepository(pool *pgxpool.Pool) *PostgresAuditLogRepository {
+	return &PostgresAuditLogRepository{pool: pool}
+}
+
+func (repository *PostgresAuditLogRepository) ListByCursor(ctx context.Context, filter auditlog.Filter, page shared.CursorPage) (shared.CursorResult[*auditlog.Entry], error) {
+	querier := postgres.GetQuerier(ctx, repository.pool)
+
+	where := postgres.NewWhereClause()
+	if filter.ActorID != nil {
+		where.Eq("user_id", *filter.ActorID)
+	}
+	where.EqIf(filter.EventType != "", "event_type", filter.EventType)
+	where.EqIf(filter.Action != "", "action", filter.Action)
+	where.EqIf(filter.ResourceType != "", "resource_type", filter.ResourceType)
+	where.EqIf(filter.ResourceID != "", "resource_id", filter.ResourceID)
+	if filter.Success != nil {
+		where.Eq("success", *filter.Success)
+	}
+	if filter.From != nil {
+		where.Gte("timestamp", *filter.From)
+	}
+	if filter.To != nil {
+		where.Lte("timestamp", *filter.To)
+	}
+
+	var total *int64
+	if page.WithTotal() {
+		whereClause, args := where.Build()
+		var count int64
+		if err := querier.QueryRow(ctx, queryCountAuditLogs+" "+whereClause, args...).Scan(&count); err != nil {
+			return shared.CursorResult[*auditlog.Entry]{}, postgres.NewDBError("count audit logs", err)
+		}
+		total = &count
+	}
+
+	if err := auditLogKeyset.Seek(where, page.Cursor()); err != nil {
+		return shared.CursorResult[*auditlog.Entry]{}, err
+	}
+	whereClause, args := where.Build()
+	query := querySelectAuditLogs + " " + whereClause + " " + auditLogKeyset.OrderBy(page.IsBackward()).Build() + " " + auditLogKeyset.Limit(page)
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return shared.CursorResult[*auditlog.Entry]{}, postgres.NewDBError("list audit logs", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*auditlog.Entry, 0)
+	for rows.Next() {
+		var (
+			entry    auditlog.Entry
+			actorID  *uuid.UUID
+			metadata []byte
+		)
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Timestamp,
+			&entry.EventType,
+			&actorID,
+			&entry.Action,
+			&entry.ResourceType,
+			&entry.ResourceID,
+			&entry.IPAddress,
+			&entry.UserAgent,
+			&entry.Success,
+			&entry.FailureReason,
+			&metadata,
+		)
+		if err != nil {
+			return shared.CursorResult[*auditlog.Entry]{}, postgres.NewDBError("scan audit log row", err)
+		}
+		if actorID != nil && *actorID != uuid.Nil {
+			entry.ActorID = actorID
+		}
+		if err := json.Unmarshal(metadata, &entry.Metadata); err != nil {
+			return shared.CursorResult[*auditlog.Entry]{}, fmt.Errorf("decode audit log metadata: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return shared.CursorResult[*auditlog.Entry]{}, postgres.NewDBError("iterate audit log rows", err)
+	}
+
+	result := postgres.PageKeyset(auditLogKeyset, entries, page, func(entry *auditlog.Entry) []any {
+		return []any{entry.Timestamp, entry.ID}
+	})
+	result.Total = total
+	return result, nil
+}
@@ -0,0 +1,308 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+// ErasedValue replaces personal values in pseudonymized audit metadata.
+const ErasedValue = "[erased]"
+
+const (
+	queryFindAuditLogsByUser = `
+		SELECT id, timestamp, event_type, user_id, action,
+			COALESCE(resource_type, ''), COALESCE(resource_id, ''), COALESCE(host(ip_address), ''),
+			COALESCE(user_agent, ''), success, COALESCE(failure_reason, ''), COALESCE(metadata, '{}'::jsonb)
+		FROM audit_logs
+		WHERE user_id = $1 OR resource_id = $2
+		ORDER BY timestamp, id`
+
+	// Failed logins for an address are recorded without a user id, so entries
+	// whose metadata contains one of the identifiers are candidates as well.
+	// strpos matches the identifiers literally; whether a candidate really
+	// mentions one is decided by mentionsIdentifier.
+	querySelectAuditLogsToPseudonymize = `
+		SELECT id, (user_id IS NOT DISTINCT FROM $1 OR resource_id IS NOT DISTINCT FROM $2), COALESCE(metadata, '{}'::jsonb)
+		FROM audit_logs
+		WHERE user_id = $1 OR resource_id = $2 OR EXISTS (
+			SELECT 1 FROM unnest($3::text[]) AS identifier
+			WHERE identifier <> '' AND strpos(lower(metadata::text), lower(identifier)) > 0
+		)`
+
+	queryPseudonymizeAuditLog = `
+		UPDATE audit_logs SET ip_address = NULL, user_agent = NULL, metadata = $2 WHERE id = $1`
+
+	// Entries that only mention the user keep the address and user agent of
+	// whoever made the request.
+	queryScrubAuditLogMetadata = `
+		UPDATE audit_logs SET metadata = $2 WHERE id = $1`
+)
+
+// personalMetadataKeys are erased whatever their value. Email change entries
+// hold addresses the user no longer has, which the identifiers would miss.
+var personalMetadataKeys = map[string]bool{
+	"ip_address": true,
+	"user_agent": true,
+	"old_email":  true,
+	"new_email":  true,
+}
+
+type PostgresAuditTrail struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresAuditTrail(pool *pgxpool.Pool) *PostgresAuditTrail {
+	return &PostgresAuditTrail{pool: pool}
+}
+
+func (trail *PostgresAuditTrail) FindByUser(ctx context.Context, userID uuid.UUID) ([]user.AuditRecord, error) {
+	querier := postgres.GetQuerier(ctx, trail.pool)
+
+	rows, err := querier.Query(ctx, queryFindAuditLogsByUser, userID, userID.String())
+	if err != nil {
+		return nil, postgres.NewDBError("find audit logs by user", err)
+	}
+	defer rows.Close()
+
+	records := make([]user.AuditRecord, 0)
+	for rows.Next() {
+		var (
+			record   user.AuditRecord
+			actorID  *uuid.UUID
+			metadata []byte
+		)
+		err := rows.Scan(
+			&record.ID,
+			&record.Timestamp,
+			&record.EventType,
+			&actorID,
+			&record.Action,
+			&record.ResourceType,
+			&record.ResourceID,
+			&record.IPAddress,
+			&record.UserAgent,
+			&record.Success,
+			&record.FailureReason,
+			&metadata,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan audit log row", err)
+		}
+		if actorID != nil && *actorID != uuid.Nil {
+			record.ActorID = actorID
+		}
+		if err := json.Unmarshal(metadata, &record.Metadata); err != nil {
+			return nil, fmt.Errorf("decode audit log metadata: %w", err)
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate audit log rows", err)
+	}
+
+	return records, nil
+}
+
+func (trail *PostgresAuditTrail) Pseudonymize(ctx context.Context, userID uuid.UUID, identifiers []string) (int64, error) {
+	querier := postgres.GetQuerier(ctx, trail.pool)
+
+	rows, err := querier.Query(ctx, querySelectAuditLogsToPseudonymize, userID, userID.String(), identifiers)
+	if err != nil {
+		return 0, postgres.NewDBError("find audit logs to pseudonymize", err)
+	}
+
+	type pendingEntry struct {
+		id       uuid.UUID
+		owned    bool
+		metadata []byte
+	}
+	var pending []pendingEntry
+	for rows.Next() {
+		var entry pendingEntry
+		if err := rows.Scan(&entry.id, &entry.owned, &entry.metadata); err != nil {
+			rows.Close()
+			return 0, postgres.NewDBError("scan audit log row", err)
+		}
+		pending = append(pending, entry)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, postgres.NewDBError("iterate audit log rows", err)
+	}
+
+	patterns := identifierPatterns(identifiers)
+	var pseudonymized int64
+	for _, entry := range pending {
+		var metadata map[string]any
+		if err := json.Unmarshal(entry.metadata, &metadata); err != nil {
+			return 0, fmt.Errorf("decode audit log metadata: %w", err)
+		}
+		if !entry.owned && !mentionsIdentifier(metadata, patterns) {
+			continue
+		}
+
+		scrubbed, err := json.Marshal(scrubValue(metadata, patterns))
+		if err != nil {
+			return 0, fmt.Errorf("encode audit log metadata: %w", err)
+		}
+
+		query := queryScrubAuditLogMetadata
+		if entry.owned {
+			query = queryPseudonymizeAuditLog
+		}
+		if _, err := querier.Exec(ctx, query, entry.id, scrubbed); err != nil {
+			return 0, postgres.NewDBError("pseudonymize audit log", err)
+		}
+		pseudonymized++
+	}
+
+	return pseudonymized, nil
+}
+
+// identifierPatterns matches each identifier case-insensitively. Matches are
+// only taken as a whole token, see isWholeToken.
+func identifierPatterns(identifiers []string) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+		patterns = append(patterns, regexp.MustCompile("(?i)"+regexp.QuoteMeta(identifier)))
+	}
+	return patterns
+}
+
+// tokenMatches returns the matches of pattern in s that are whole tokens.
+func tokenMatches(pattern *regexp.Regexp, s string) [][]int {
+	var matches [][]int
+	for _, match := range pattern.FindAllStringIndex(s, -1) {
+		if isWholeToken(s, match[0], match[1]) {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// isWholeToken reports whether s[start:end] is not part of a longer address
+// or word, so that "jane@example.com" is not found inside
+// "mary.jane@example.com" and "Jane Doe" is not found inside "Jane Doeson".
+// A trailing dot only ends the token when no letter or digit follows it.
+func isWholeToken(s string, start, end int) bool {
+	if start > 0 {
+		previous, _ := utf8.DecodeLastRuneInString(s[:start])
+		if isTokenRune(previous) || previous == '.' {
+			return false
+		}
+	}
+	if end < len(s) {
+		next, size := utf8.DecodeRuneInString(s[end:])
+		if isTokenRune(next) {
+			return false
+		}
+		if next == '.' && end+size < len(s) {
+			afterDot, _ := utf8.DecodeRuneInString(s[end+size:])
+			if unicode.IsLetter(afterDot) || unicode.IsDigit(afterDot) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isTokenRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_%+@-", r)
+}
+
+// mentionsIdentifier reports whether any string in value contains one of the
+// identifiers.
+func mentionsIdentifier(value any, patterns []*regexp.Regexp) bool {
+	switch typed := value.(type) {
+	case map[string]any:
+		for _, nested := range typed {
+			if mentionsIdentifier(nested, patterns) {
+				return true
+			}
+		}
+	case []any:
+		for _, nested := range typed {
+			if mentionsIdentifier(nested, patterns) {
+				return true
+			}
+		}
+	case string:
+		for _, pattern := range patterns {
+			if len(tokenMatches(pattern, typed)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scrubMetadata returns a copy of metadata with personal keys and every
+// occurrence of an identifier, matched case-insensitively, replaced by
+// ErasedValue. Structure and all other values are preserved.
+func scrubMetadata(metadata map[string]any, identifiers []string) map[string]any {
+	scrubbed, _ := scrubValue(metadata, identifierPatterns(identifiers)).(map[string]any)
+	return scrubbed
+}
+
+func scrubValue(value any, patterns []*regexp.Regexp) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		if typed == nil {
+			return typed
+		}
+		result := make(map[string]any, len(typed))
+		for key, nested := range typed {
+			if personalMetadataKeys[key] {
+				result[key] = ErasedValue
+				continue
+			}
+			result[key] = scrubValue(nested, patterns)
+		}
+		return result
+	case []any:
+		result := make([]any, len(typed))
+		for i, nested := range typed {
+			result[i] = scrubValue(nested, patterns)
+		}
+		return result
+	case string:
+		for _, pattern := range patterns {
+			typed = replaceIdentifier(pattern, typed)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+// replaceIdentifier replaces the whole-token matches of pattern in s.
+func replaceIdentifier(pattern *regexp.Regexp, s string) string {
+	matches := tokenMatches(pattern, s)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, match := range matches {
+		builder.WriteString(s[last:match[0]])
+		builder.WriteString(ErasedValue)
+		last = match[1]
+	}
+	builder.WriteString(s[last:])
+	return builder.String()
+}
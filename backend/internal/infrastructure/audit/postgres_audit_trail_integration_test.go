@@ -0,0 +1,73 @@
+//go:build integration
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestPostgresAuditTrail_Integration(t *testing.T) {
+	suite, cleanup := testutil.SetupIntegrationTest(t)
+	defer cleanup()
+
+	trail := NewPostgresAuditTrail(suite.DatabasePool)
+
+	t.Run("Pseudonymize leaves entries of similar addresses untouched", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		userID := uuid.New()
+		insert := func(actorID *uuid.UUID, metadata map[string]any) uuid.UUID {
+			encoded, err := json.Marshal(metadata)
+			require.NoError(t, err)
+			id := uuid.New()
+			suite.ExecuteSQL(t, `
+				INSERT INTO audit_logs (id, event_type, user_id, action, ip_address, user_agent, metadata)
+				VALUES ($1, 'auth', $2, 'login', '10.0.0.1', 'curl/8', $3)`,
+				id, actorID, encoded)
+			return id
+		}
+
+		owned := insert(&userID, map[string]any{})
+		failedLogin := insert(nil, map[string]any{"email": "john_doe@x.com"})
+		renamed := insert(nil, map[string]any{"note": "renamed to John Doe"})
+		similar := insert(nil, map[string]any{"email": "johnXdoe@x.com"})
+
+		changed, err := trail.Pseudonymize(context.Background(), userID, []string{"john_doe@x.com", "John Doe"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), changed)
+
+		entry := func(id uuid.UUID) (ipAddress *string, metadata map[string]any) {
+			var encoded []byte
+			err := suite.DatabasePool.QueryRow(context.Background(),
+				`SELECT host(ip_address), metadata FROM audit_logs WHERE id = $1`, id).Scan(&ipAddress, &encoded)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(encoded, &metadata))
+			return ipAddress, metadata
+		}
+
+		ipAddress, _ := entry(owned)
+		assert.Nil(t, ipAddress)
+
+		ipAddress, metadata := entry(failedLogin)
+		require.NotNil(t, ipAddress)
+		assert.Equal(t, "10.0.0.1", *ipAddress)
+		assert.Equal(t, ErasedValue, metadata["email"])
+		ipAddress, metadata = entry(renamed)
+		require.NotNil(t, ipAddress)
+		assert.Equal(t, "10.0.0.1", *ipAddress)
+		assert.Equal(t, "renamed to "+ErasedValue, metadata["note"])
+
+		ipAddress, metadata = entry(similar)
+		require.NotNil(t, ipAddress)
+		assert.Equal(t, "10.0.0.1", *ipAddress)
+		assert.Equal(t, "johnXdoe@x.com", metadata["email"])
+	})
+}
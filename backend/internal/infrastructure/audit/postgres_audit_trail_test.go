@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubMetadata(t *testing.T) {
+	identifiers := []string{"jane@example.com", "Jane Doe"}
+
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "replaces identifier values",
+			metadata: map[string]any{"email": "jane@example.com", "full_name": "Jane Doe"},
+			expected: map[string]any{"email": ErasedValue, "full_name": ErasedValue},
+		},
+		{
+			name:     "matches case-insensitively inside longer strings",
+			metadata: map[string]any{"note": "invited JANE@example.com"},
+			expected: map[string]any{"note": "invited " + ErasedValue},
+		},
+		{
+			name:     "erases network identifiers whatever their value",
+			metadata: map[string]any{"ip_address": "10.0.0.1", "user_agent": "curl/8"},
+			expected: map[string]any{"ip_address": ErasedValue, "user_agent": ErasedValue},
+		},
+		{
+			name:     "erases addresses from email changes",
+			metadata: map[string]any{"old_email": "previous@example.com", "new_email": "jane@example.com", "override": true},
+			expected: map[string]any{"old_email": ErasedValue, "new_email": ErasedValue, "override": true},
+		},
+		{
+			name: "walks nested objects and arrays",
+			metadata: map[string]any{
+				"changes": []any{map[string]any{"from": "Jane Doe", "to": "Someone"}},
+				"count":   float64(2),
+			},
+			expected: map[string]any{
+				"changes": []any{map[string]any{"from": ErasedValue, "to": "Someone"}},
+				"count":   float64(2),
+			},
+		},
+		{
+			name:     "leaves similar addresses and longer names alone",
+			metadata: map[string]any{"email": "mary.jane@example.com", "note": "jane@example.com.vn and Jane Doeson"},
+			expected: map[string]any{"email": "mary.jane@example.com", "note": "jane@example.com.vn and Jane Doeson"},
+		},
+		{
+			name:     "replaces adjacent mentions and keeps surrounding punctuation",
+			metadata: map[string]any{"note": "jane@example.com,jane@example.com. Signed (Jane Doe)"},
+			expected: map[string]any{"note": ErasedValue + "," + ErasedValue + ". Signed (" + ErasedValue + ")"},
+		},
+		{
+			name:     "keeps unrelated values",
+			metadata: map[string]any{"role_id": "b0000000-0000-0000-0000-000000000001", "success": true},
+			expected: map[string]any{"role_id": "b0000000-0000-0000-0000-000000000001", "success": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, scrubMetadata(tt.metadata, identifiers))
+		})
+	}
+}
+
+func TestMentionsIdentifier(t *testing.T) {
+	patterns := identifierPatterns([]string{"john_doe@x.com", "John Doe", ""})
+
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		expected bool
+	}{
+		{name: "exact address", metadata: map[string]any{"email": "john_doe@x.com"}, expected: true},
+		{name: "full name in a nested value", metadata: map[string]any{"changes": []any{"renamed to John Doe"}}, expected: true},
+		{name: "address differing in the underscore", metadata: map[string]any{"email": "johnXdoe@x.com"}, expected: false},
+		{name: "address ending in ours", metadata: map[string]any{"email": "bigjohn_doe@x.com"}, expected: false},
+		{name: "longer name", metadata: map[string]any{"full_name": "John Doerr"}, expected: false},
+		{name: "no strings", metadata: map[string]any{"count": float64(1)}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mentionsIdentifier(tt.metadata, patterns))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	accessrequestcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/command"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AccessRequestExpiryJob struct {
+	expireHandler *accessrequestcommand.ExpireAccessRequestsHandler
+	interval      time.Duration
+	logger        logger.Logger
+	stop          chan struct{}
+	done          chan struct{}
+	once          sync.Once
+}
+
+func NewAccessRequestExpiryJob(
+	expireHandler *accessrequestcommand.ExpireAccessRequestsHandler,
+	interval time.Duration,
+	logger logger.Logger,
+) *AccessRequestExpiryJob {
+	return &AccessRequestExpiryJob{
+		expireHandler: expireHandler,
+		interval:      interval,
+		logger:        logger,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+func (job *AccessRequestExpiryJob) Start() {
+	go job.run()
+}
+
+func (job *AccessRequestExpiryJob) Stop(ctx context.Context) {
+	job.once.Do(func() {
+		close(job.stop)
+	})
+
+	select {
+	case <-job.done:
+	case <-ctx.Done():
+	}
+}
+
+func (job *AccessRequestExpiryJob) run() {
+	defer close(job.done)
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	job.tick()
+	for {
+		select {
+		case <-job.stop:
+			return
+		case <-ticker.C:
+			job.tick()
+		}
+	}
+}
+
+func (job *AccessRequestExpiryJob) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), job.interval)
+	defer cancel()
+
+	command := accessrequestcommand.ExpireAccessRequestsCommand{Now: time.Now().UTC()}
+	if _, err := job.expireHandler.Handle(ctx, command); err != nil {
+		job.logger.Error("access request expiry run failed", logger.Err(err))
+	}
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	accessreviewcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/command"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AccessReviewEscalationJob struct {
+	escalateHandler *accessreviewcommand.EscalateOverdueAccessReviewsHandler
+	interval        time.Duration
+	logger          logger.Logger
+	stop            chan struct{}
+	done            chan struct{}
+	once            sync.Once
+}
+
+func NewAccessReviewEscalationJob(
+	escalateHandler *accessreviewcommand.EscalateOverdueAccessReviewsHandler,
+	interval time.Duration,
+	logger logger.Logger,
+) *AccessReviewEscalationJob {
+	return &AccessReviewEscalationJob{
+		escalateHandler: escalateHandler,
+		interval:        interval,
+		logger:          logger,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+}
+
+func (job *AccessReviewEscalationJob) Start() {
+	go job.run()
+}
+
+func (job *AccessReviewEscalationJob) Stop(ctx context.Context) {
+	job.once.Do(func() {
+		close(job.stop)
+	})
+
+	select {
+	case <-job.done:
+	case <-ctx.Done():
+	}
+}
+
+func (job *AccessReviewEscalationJob) run() {
+	defer close(job.done)
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	job.tick()
+	for {
+		select {
+		case <-job.stop:
+			return
+		case <-ticker.C:
+			job.tick()
+		}
+	}
+}
+
+func (job *AccessReviewEscalationJob) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), job.interval)
+	defer cancel()
+
+	command := accessreviewcommand.EscalateOverdueAccessReviewsCommand{Now: time.Now().UTC()}
+	if _, err := job.escalateHandler.Handle(ctx, command); err != nil {
+		job.logger.Error("access review escalation run failed", logger.Err(err))
+	}
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UserPurgeJob struct {
+	purgeHandler *usercommand.PurgeDeletedUsersHandler
+	interval     time.Duration
+	logger       logger.Logger
+	stop         chan struct{}
+	done         chan struct{}
+	once         sync.Once
+}
+
+func NewUserPurgeJob(
+	purgeHandler *usercommand.PurgeDeletedUsersHandler,
+	interval time.Duration,
+	logger logger.Logger,
+) *UserPurgeJob {
+	return &UserPurgeJob{
+		purgeHandler: purgeHandler,
+		interval:     interval,
+		logger:       logger,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+func (job *UserPurgeJob) Start() {
+	go job.run()
+}
+
+func (job *UserPurgeJob) Stop(ctx context.Context) {
+	job.once.Do(func() {
+		close(job.stop)
+	})
+
+	select {
+	case <-job.done:
+	case <-ctx.Done():
+	}
+}
+
+func (job *UserPurgeJob) run() {
+	defer close(job.done)
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	job.tick()
+	for {
+		select {
+		case <-job.stop:
+			return
+		case <-ticker.C:
+			job.tick()
+		}
+	}
+}
+
+func (job *UserPurgeJob) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), job.interval)
+	defer cancel()
+
+	command := usercommand.PurgeDeletedUsersCommand{Now: time.Now().UTC()}
+	if _, err := job.purgeHandler.Handle(ctx, command); err != nil {
+		job.logger.Error("deleted user purge run failed", logger.Err(err))
+	}
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type UserReinstatementJob struct {
+	reinstateHandler *usercommand.ReinstateSuspendedUsersHandler
+	interval         time.Duration
+	logger           logger.Logger
+	stop             chan struct{}
+	done             chan struct{}
+	once             sync.Once
+}
+
+func NewUserReinstatementJob(
+	reinstateHandler *usercommand.ReinstateSuspendedUsersHandler,
+	interval time.Duration,
+	logger logger.Logger,
+) *UserReinstatementJob {
+	return &UserReinstatementJob{
+		reinstateHandler: reinstateHandler,
+		interval:         interval,
+		logger:           logger,
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+}
+
+func (job *UserReinstatementJob) Start() {
+	go job.run()
+}
+
+func (job *UserReinstatementJob) Stop(ctx context.Context) {
+	job.once.Do(func() {
+		close(job.stop)
+	})
+
+	select {
+	case <-job.done:
+	case <-ctx.Done():
+	}
+}
+
+func (job *UserReinstatementJob) run() {
+	defer close(job.done)
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	job.tick()
+	for {
+		select {
+		case <-job.stop:
+			return
+		case <-ticker.C:
+			job.tick()
+		}
+	}
+}
+
+func (job *UserReinstatementJob) tick() {
+	ctx, cancel := context.WithTimeout(context.Background(), job.interval)
+	defer cancel()
+
+	command := usercommand.ReinstateSuspendedUsersCommand{Now: time.Now().UTC()}
+	if _, err := job.reinstateHandler.Handle(ctx, command); err != nil {
+		job.logger.Error("suspended user reinstatement run failed", logger.Err(err))
+	}
+}
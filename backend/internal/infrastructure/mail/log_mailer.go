@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+// LogMailer writes messages to the log instead of delivering them. It is
+// meant for local development, where the links in the body can be copied
+// from the log output.
+type LogMailer struct {
+	logger logger.Logger
+}
+
+func NewLogMailer(logger logger.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+func (mailer *LogMailer) Send(ctx context.Context, message shared.MailMessage) error {
+	mailer.logger.Info("mail not delivered, log driver in use",
+		logger.String("to", message.To),
+		logger.String("subject", message.Subject),
+		logger.String("body", message.Body),
+	)
+	return nil
+}
@@ -0,0 +1,84 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type SMTPMailerConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPMailer delivers messages through an SMTP relay. STARTTLS is used when
+// the server offers it, and authentication only when a username is set.
+type SMTPMailer struct {
+	address string
+	auth    smtp.Auth
+	from    string
+}
+
+func NewSMTPMailer(config SMTPMailerConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+	return &SMTPMailer{
+		address: net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+		auth:    auth,
+		from:    config.From,
+	}
+}
+
+func (mailer *SMTPMailer) Send(ctx context.Context, message shared.MailMessage) error {
+	content, err := buildMessage(mailer.from, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// net/smtp has no context support, so the deadline only bounds the wait
+	// for the message to go out; the send itself runs to completion.
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(mailer.address, mailer.auth, mailer.from, []string{message.To}, content)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("send mail to %s: %w", message.To, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("send mail to %s: %w", message.To, ctx.Err())
+	}
+}
+
+func buildMessage(from string, message shared.MailMessage, now time.Time) ([]byte, error) {
+	for _, header := range []string{from, message.To, message.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, fmt.Errorf("mail header contains a line break")
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString("From: " + from + "\r\n")
+	builder.WriteString("To: " + message.To + "\r\n")
+	builder.WriteString("Subject: " + message.Subject + "\r\n")
+	builder.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(builder.String()), nil
+}
@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+func TestBuildMessage(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	content, err := buildMessage("no-reply@example.com", shared.MailMessage{
+		To:      "user@example.com",
+		Subject: "Confirm your new email",
+		Body:    "line one\nline two",
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"From: no-reply@example.com\r\n"+
+			"To: user@example.com\r\n"+
+			"Subject: Confirm your new email\r\n"+
+			"Date: Fri, 02 Jan 2026 03:04:05 +0000\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n"+
+			"\r\n"+
+			"line one\r\nline two",
+		string(content))
+
+	_, err = buildMessage("no-reply@example.com", shared.MailMessage{
+		To:      "user@example.com\r\nBcc: victim@example.com",
+		Subject: "hi",
+	}, now)
+	assert.Error(t, err, "line breaks in headers are rejected")
+}
@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+// KeyType tells the keyset how to turn a cursor value back into a query
+// argument.
+type KeyType int
+
+const (
+	KeyText KeyType = iota
+	KeyTime
+	KeyUUID
+	KeyInt
+)
+
+type KeysetColumn struct {
+	Column    string
+	Direction OrderDirection
+	Type      KeyType
+	// Nullable columns sort their NULLs after every other row.
+	Nullable bool
+}
+
+// Keyset pages through rows ordered by a fixed list of columns that
+// together identify a row. Instead of an OFFSET it seeks past the sort key
+// values of the boundary row, so every page costs the same and rows
+// inserted meanwhile do not shift pages.
+type Keyset struct {
+	columns []KeysetColumn
+}
+
+func NewKeyset(columns ...KeysetColumn) *Keyset {
+	return &Keyset{columns: columns}
+}
+
+// Signature identifies the ordering; cursors only apply to the ordering
+// they were issued for.
+func (k *Keyset) Signature() string {
+	parts := make([]string, len(k.columns))
+	for i, column := range k.columns {
+		parts[i] = column.Column + ":" + string(column.Direction)
+	}
+	return strings.Join(parts, ",")
+}
+
+// OrderBy returns the ordering of the query. Backward pages are read in
+// reverse and flipped back by PageKeyset.
+func (k *Keyset) OrderBy(backward bool) *OrderByClause {
+	orderBy := NewOrderByClause()
+	for _, column := range k.columns {
+		direction := column.Direction
+		if backward {
+			direction = reverseDirection(direction)
+		}
+		switch {
+		case column.Nullable && backward:
+			orderBy.AddNullsFirst(column.Column, direction)
+		case column.Nullable:
+			orderBy.AddNullsLast(column.Column, direction)
+		default:
+			orderBy.Add(column.Column, direction)
+		}
+	}
+	return orderBy
+}
+
+// Limit fetches one row more than the page holds, to learn whether another
+// page follows.
+func (k *Keyset) Limit(page shared.CursorPage) string {
+	return fmt.Sprintf("LIMIT %d", page.Limit()+1)
+}
+
+// Seek restricts where to the rows after the cursor, or before it for a
+// backward cursor. A nil cursor leaves where untouched.
+func (k *Keyset) Seek(where *WhereClause, cursor *shared.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+	if cursor.Sort != k.Signature() {
+		return shared.NewValidationError("cursor", "does not match the requested sort")
+	}
+	if len(cursor.Values) != len(k.columns) {
+		return shared.NewValidationError("cursor", "is malformed")
+	}
+
+	values := make([]any, len(k.columns))
+	for i, column := range k.columns {
+		value, err := parseKeyValue(column, cursor.Values[i])
+		if err != nil {
+			return err
+		}
+		values[i] = value
+	}
+
+	var (
+		branches []string
+		args     []any
+	)
+	for i, column := range k.columns {
+		comparison, comparisonArgs, ok := keyComparison(column, values[i], cursor.Backward)
+		if !ok {
+			continue
+		}
+		parts := make([]string, 0, i+1)
+		branchArgs := make([]any, 0, i+1)
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				parts = append(parts, k.columns[j].Column+" IS NULL")
+				continue
+			}
+			parts = append(parts, k.columns[j].Column+" = $%d")
+			branchArgs = append(branchArgs, values[j])
+		}
+		parts = append(parts, comparison)
+		branches = append(branches, "("+strings.Join(parts, " AND ")+")")
+		args = append(args, branchArgs...)
+		args = append(args, comparisonArgs...)
+	}
+
+	if len(branches) == 0 {
+		where.AddCondition("FALSE")
+		return nil
+	}
+	where.AddCondition("("+strings.Join(branches, " OR ")+")", args...)
+	return nil
+}
+
+// keyComparison returns the condition for rows strictly past value in one
+// column. ok is false when no row can be past it, such as a NULL in a
+// nulls-last column read forwards.
+func keyComparison(column KeysetColumn, value any, backward bool) (string, []any, bool) {
+	ascending := column.Direction != OrderDesc
+	if backward {
+		ascending = !ascending
+	}
+	operator := "<"
+	if ascending {
+		operator = ">"
+	}
+
+	if !column.Nullable {
+		return column.Column + " " + operator + " $%d", []any{value}, true
+	}
+	switch {
+	case !backward && value == nil:
+		return "", nil, false
+	case !backward:
+		return "(" + column.Column + " " + operator + " $%d OR " + column.Column + " IS NULL)", []any{value}, true
+	case value == nil:
+		return column.Column + " IS NOT NULL", nil, true
+	default:
+		return column.Column + " " + operator + " $%d", []any{value}, true
+	}
+}
+
+func parseKeyValue(column KeysetColumn, raw *string) (any, error) {
+	if raw == nil {
+		if !column.Nullable {
+			return nil, shared.NewValidationError("cursor", "is malformed")
+		}
+		return nil, nil
+	}
+
+	var (
+		value any
+		err   error
+	)
+	switch column.Type {
+	case KeyTime:
+		value, err = time.Parse(time.RFC3339Nano, *raw)
+	case KeyUUID:
+		value, err = uuid.Parse(*raw)
+	case KeyInt:
+		value, err = strconv.ParseInt(*raw, 10, 64)
+	default:
+		value = *raw
+	}
+	if err != nil {
+		return nil, shared.NewValidationError("cursor", "is malformed")
+	}
+	return value, nil
+}
+
+// PageKeyset trims the look-ahead row, restores display order for backward
+// reads and builds the cursors of the neighbouring pages. rows must come
+// from a query using the keyset's OrderBy, Seek and Limit, and keys returns
+// a row's values in keyset column order.
+func PageKeyset[T any](keyset *Keyset, rows []T, page shared.CursorPage, keys func(T) []any) shared.CursorResult[T] {
+	hasMore := len(rows) > page.Limit()
+	if hasMore {
+		rows = rows[:page.Limit()]
+	}
+	backward := page.IsBackward()
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	result := shared.CursorResult[T]{Items: rows, Limit: page.Limit()}
+	if result.Items == nil {
+		result.Items = make([]T, 0)
+	}
+	if len(rows) == 0 {
+		return result
+	}
+
+	hasNext := hasMore
+	hasPrev := page.Cursor() != nil
+	if backward {
+		hasNext, hasPrev = true, hasMore
+	}
+	if hasNext {
+		result.NextCursor = keyset.cursor(keys(rows[len(rows)-1]), false)
+	}
+	if hasPrev {
+		result.PrevCursor = keyset.cursor(keys(rows[0]), true)
+	}
+	return result
+}
+
+func (k *Keyset) cursor(keys []any, backward bool) string {
+	values := make([]*string, len(keys))
+	for i, key := range keys {
+		values[i] = formatKeyValue(key)
+	}
+	return shared.EncodeCursor(shared.Cursor{Sort: k.Signature(), Values: values, Backward: backward})
+}
+
+func formatKeyValue(key any) *string {
+	var value string
+	switch typed := key.(type) {
+	case nil:
+		return nil
+	case *time.Time:
+		if typed == nil {
+			return nil
+		}
+		value = typed.UTC().Format(time.RFC3339Nano)
+	case time.Time:
+		value = typed.UTC().Format(time.RFC3339Nano)
+	case *string:
+		if typed == nil {
+			return nil
+		}
+		value = *typed
+	case string:
+		value = typed
+	default:
+		value = fmt.Sprint(typed)
+	}
+	return &value
+}
+
+func reverseDirection(direction OrderDirection) OrderDirection {
+	if direction == OrderDesc {
+		return OrderAsc
+	}
+	return OrderDesc
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+)
+
+type keysetRow struct {
+	lastLogin *time.Time
+	id        uuid.UUID
+}
+
+var testKeyset = NewKeyset(
+	KeysetColumn{Column: "last_login_at", Direction: OrderDesc, Type: KeyTime, Nullable: true},
+	KeysetColumn{Column: "id", Direction: OrderDesc, Type: KeyUUID},
+)
+
+func testKeys(row keysetRow) []any {
+	return []any{row.lastLogin, row.id}
+}
+
+func cursorPage(t *testing.T, token string, limit int) shared.CursorPage {
+	t.Helper()
+	page, err := shared.NewCursorPage(token, limit, false)
+	require.NoError(t, err)
+	return page
+}
+
+func TestKeyset_OrderBy(t *testing.T) {
+	assert.Equal(t, "ORDER BY last_login_at DESC NULLS LAST, id DESC", testKeyset.OrderBy(false).Build())
+	assert.Equal(t, "ORDER BY last_login_at ASC NULLS FIRST, id ASC", testKeyset.OrderBy(true).Build())
+}
+
+func TestKeyset_Seek(t *testing.T) {
+	loginAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	row := keysetRow{lastLogin: &loginAt, id: uuid.New()}
+	nullRow := keysetRow{id: uuid.New()}
+	rows := []keysetRow{row, nullRow}
+
+	first := PageKeyset(testKeyset, rows, cursorPage(t, "", 1), testKeys)
+	require.NotEmpty(t, first.NextCursor)
+	assert.Empty(t, first.PrevCursor)
+
+	where := NewWhereClause()
+	require.NoError(t, testKeyset.Seek(where, cursorPage(t, first.NextCursor, 1).Cursor()))
+	clause, args := where.Build()
+	assert.Equal(t, "WHERE (((last_login_at < $1 OR last_login_at IS NULL)) OR (last_login_at = $2 AND id < $3))", clause)
+	assert.Equal(t, []any{loginAt, loginAt, row.id}, args)
+
+	second := PageKeyset(testKeyset, []keysetRow{nullRow}, cursorPage(t, first.NextCursor, 1), testKeys)
+	require.NotEmpty(t, second.PrevCursor)
+	assert.Empty(t, second.NextCursor)
+
+	where = NewWhereClause()
+	require.NoError(t, testKeyset.Seek(where, cursorPage(t, second.PrevCursor, 1).Cursor()))
+	clause, args = where.Build()
+	assert.Equal(t, "WHERE ((last_login_at IS NOT NULL) OR (last_login_at IS NULL AND id > $1))", clause)
+	assert.Equal(t, []any{nullRow.id}, args)
+}
+
+func TestKeyset_SeekRejectsForeignCursor(t *testing.T) {
+	other := NewKeyset(KeysetColumn{Column: "id", Direction: OrderAsc, Type: KeyUUID})
+	result := PageKeyset(other, []keysetRow{{id: uuid.New()}, {id: uuid.New()}}, cursorPage(t, "", 1), func(row keysetRow) []any {
+		return []any{row.id}
+	})
+
+	err := testKeyset.Seek(NewWhereClause(), cursorPage(t, result.NextCursor, 1).Cursor())
+	assert.True(t, shared.IsValidationError(err))
+}
+
+func TestPageKeyset_RestoresOrderOfBackwardPages(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	keyset := NewKeyset(KeysetColumn{Column: "id", Direction: OrderAsc, Type: KeyUUID})
+	keys := func(id uuid.UUID) []any { return []any{id} }
+
+	forward := PageKeyset(keyset, ids, cursorPage(t, "", 2), keys)
+	secondPage := cursorPage(t, forward.NextCursor, 2)
+	next := PageKeyset(keyset, ids[2:], secondPage, keys)
+	require.NotEmpty(t, next.PrevCursor)
+
+	// A backward read returns rows nearest the cursor first.
+	previous := PageKeyset(keyset, []uuid.UUID{ids[1], ids[0]}, cursorPage(t, next.PrevCursor, 2), keys)
+	assert.Equal(t, []uuid.UUID{ids[0], ids[1]}, previous.Items)
+	assert.Empty(t, previous.PrevCursor)
+	assert.NotEmpty(t, previous.NextCursor)
+}
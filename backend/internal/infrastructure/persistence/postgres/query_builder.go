@@ -163,6 +163,20 @@ func (o *OrderByClause) AddIf(condition bool, column string, direction OrderDire
 	return o
 }
 
+// AddNullsLast sorts rows with a NULL column after all others, whatever
+// the direction.
+func (o *OrderByClause) AddNullsLast(column string, direction OrderDirection) *OrderByClause {
+	o.orders = append(o.orders, fmt.Sprintf("%s %s NULLS LAST", column, direction))
+	return o
+}
+
+// AddNullsFirst is the mirror of AddNullsLast, used when a keyset page is
+// read backwards.
+func (o *OrderByClause) AddNullsFirst(column string, direction OrderDirection) *OrderByClause {
+	o.orders = append(o.orders, fmt.Sprintf("%s %s NULLS FIRST", column, direction))
+	return o
+}
+
 func (o *OrderByClause) Asc(column string) *OrderByClause {
 	return o.Add(column, OrderAsc)
 }
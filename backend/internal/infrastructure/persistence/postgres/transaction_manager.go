@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TransactionManager struct {
+	pool *pgxpool.Pool
+}
+
+func NewTransactionManager(pool *pgxpool.Pool) *TransactionManager {
+	return &TransactionManager{pool: pool}
+}
+
+func (m *TransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	return WithTransaction(ctx, m.pool, fn)
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertAccessRequest = `
+		INSERT INTO access_requests (id, requester_id, role_id, organization_id, justification, duration_seconds, status,
+			reviewer_id, review_comment, reviewed_at, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	queryUpdateAccessRequest = `
+		UPDATE access_requests
+		SET status = $2, reviewer_id = $3, review_comment = $4, reviewed_at = $5, expires_at = $6, updated_at = $7
+		WHERE id = $1`
+
+	querySelectAccessRequests = `
+		SELECT id, requester_id, role_id, organization_id, justification, duration_seconds, status,
+			reviewer_id, review_comment, reviewed_at, expires_at, created_at, updated_at
+		FROM access_requests`
+
+	queryFindAccessRequestByID = querySelectAccessRequests + `
+		WHERE id = $1`
+
+	queryCountAccessRequests = `SELECT COUNT(*) FROM access_requests`
+
+	queryExistsPendingAccessRequest = `
+		SELECT EXISTS(SELECT 1 FROM access_requests WHERE requester_id = $1 AND role_id = $2 AND status = 'pending')`
+
+	queryFindExpiredAccessRequests = querySelectAccessRequests + `
+		WHERE status = 'approved' AND expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2`
+)
+
+type accessRequestRow struct {
+	ID              uuid.UUID
+	RequesterID     uuid.UUID
+	RoleID          uuid.UUID
+	OrganizationID  *uuid.UUID
+	Justification   string
+	DurationSeconds int64
+	Status          string
+	ReviewerID      *uuid.UUID
+	ReviewComment   *string
+	ReviewedAt      *time.Time
+	ExpiresAt       *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (r *accessRequestRow) toDomain() *accessrequest.AccessRequest {
+	reviewComment := ""
+	if r.ReviewComment != nil {
+		reviewComment = *r.ReviewComment
+	}
+	return accessrequest.ReconstructAccessRequest(accessrequest.ReconstructAccessRequestParams{
+		ID:             r.ID,
+		RequesterID:    r.RequesterID,
+		RoleID:         r.RoleID,
+		OrganizationID: r.OrganizationID,
+		Justification:  r.Justification,
+		Duration:       time.Duration(r.DurationSeconds) * time.Second,
+		Status:         accessrequest.Status(r.Status),
+		ReviewerID:     r.ReviewerID,
+		ReviewComment:  reviewComment,
+		ReviewedAt:     r.ReviewedAt,
+		ExpiresAt:      r.ExpiresAt,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	})
+}
+
+func accessRequestToRow(request *accessrequest.AccessRequest) *accessRequestRow {
+	reviewComment := request.ReviewComment()
+	return &accessRequestRow{
+		ID:              request.ID(),
+		RequesterID:     request.RequesterID(),
+		RoleID:          request.RoleID(),
+		OrganizationID:  request.OrganizationID(),
+		Justification:   request.Justification(),
+		DurationSeconds: int64(request.Duration() / time.Second),
+		Status:          request.Status().String(),
+		ReviewerID:      request.ReviewerID(),
+		ReviewComment:   &reviewComment,
+		ReviewedAt:      request.ReviewedAt(),
+		ExpiresAt:       request.ExpiresAt(),
+		CreatedAt:       request.CreatedAt(),
+		UpdatedAt:       request.UpdatedAt(),
+	}
+}
+
+type AccessRequestRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAccessRequestRepository(pool *pgxpool.Pool) *AccessRequestRepository {
+	return &AccessRequestRepository{pool: pool}
+}
+
+func (r *AccessRequestRepository) Create(ctx context.Context, request *accessrequest.AccessRequest) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := accessRequestToRow(request)
+
+	_, err := querier.Exec(ctx, queryInsertAccessRequest,
+		row.ID,
+		row.RequesterID,
+		row.RoleID,
+		row.OrganizationID,
+		row.Justification,
+		row.DurationSeconds,
+		row.Status,
+		row.ReviewerID,
+		row.ReviewComment,
+		row.ReviewedAt,
+		row.ExpiresAt,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return accessrequest.ErrPendingRequestExists
+		}
+		return postgres.NewDBError("create access request", err)
+	}
+
+	return nil
+}
+
+func (r *AccessRequestRepository) Update(ctx context.Context, request *accessrequest.AccessRequest) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := accessRequestToRow(request)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateAccessRequest,
+		row.ID,
+		row.Status,
+		row.ReviewerID,
+		row.ReviewComment,
+		row.ReviewedAt,
+		row.ExpiresAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("update access request", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return accessrequest.NewAccessRequestNotFoundError(row.ID.String())
+	}
+
+	return nil
+}
+
+func (r *AccessRequestRepository) FindByID(ctx context.Context, id uuid.UUID) (*accessrequest.AccessRequest, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &accessRequestRow{}
+	err := r.scanRow(querier.QueryRow(ctx, queryFindAccessRequestByID, id), row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, accessrequest.NewAccessRequestNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find access request by id", err)
+	}
+
+	return row.toDomain(), nil
+}
+
+func (r *AccessRequestRepository) List(ctx context.Context, filter accessrequest.Filter, pagination shared.Pagination) ([]*accessrequest.AccessRequest, int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+	if filter.RequesterID != nil {
+		where.Eq("requester_id", *filter.RequesterID)
+	}
+	if filter.Status != nil {
+		where.Eq("status", filter.Status.String())
+	}
+	if filter.OrganizationID != nil {
+		where.Eq("organization_id", *filter.OrganizationID)
+	}
+
+	whereClause, args := where.Build()
+
+	countQuery := queryCountAccessRequests
+	if whereClause != "" {
+		countQuery = countQuery + " " + whereClause
+	}
+
+	var total int64
+	if err := querier.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, postgres.NewDBError("count access requests", err)
+	}
+
+	if total == 0 {
+		return []*accessrequest.AccessRequest{}, 0, nil
+	}
+
+	orderBy := postgres.NewOrderByClause().Desc("created_at")
+	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+
+	dataQuery := querySelectAccessRequests
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
+
+	rows, err := querier.Query(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, postgres.NewDBError("list access requests", err)
+	}
+	defer rows.Close()
+
+	requests, err := r.scanRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, total, nil
+}
+
+func (r *AccessRequestRepository) ExistsPending(ctx context.Context, requesterID, roleID uuid.UUID) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	if err := querier.QueryRow(ctx, queryExistsPendingAccessRequest, requesterID, roleID).Scan(&exists); err != nil {
+		return false, postgres.NewDBError("check pending access request", err)
+	}
+
+	return exists, nil
+}
+
+func (r *AccessRequestRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*accessrequest.AccessRequest, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindExpiredAccessRequests, now, limit)
+	if err != nil {
+		return nil, postgres.NewDBError("find expired access requests", err)
+	}
+	defer rows.Close()
+
+	return r.scanRows(rows)
+}
+
+func (r *AccessRequestRepository) scanRow(scanner pgx.Row, row *accessRequestRow) error {
+	return scanner.Scan(
+		&row.ID,
+		&row.RequesterID,
+		&row.RoleID,
+		&row.OrganizationID,
+		&row.Justification,
+		&row.DurationSeconds,
+		&row.Status,
+		&row.ReviewerID,
+		&row.ReviewComment,
+		&row.ReviewedAt,
+		&row.ExpiresAt,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+}
+
+func (r *AccessRequestRepository) scanRows(rows pgx.Rows) ([]*accessrequest.AccessRequest, error) {
+	requests := make([]*accessrequest.AccessRequest, 0)
+	for rows.Next() {
+		row := &accessRequestRow{}
+		if err := r.scanRow(rows, row); err != nil {
+			return nil, postgres.NewDBError("scan access request row", err)
+		}
+		requests = append(requests, row.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate access request rows", err)
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertAccessReviewCampaign = `
+		INSERT INTO access_review_campaigns (id, name, description, scope_role_ids, scope_user_ids, status, due_at,
+			created_by, closed_by, closed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	queryUpdateAccessReviewCampaign = `
+		UPDATE access_review_campaigns
+		SET status = $2, closed_by = $3, closed_at = $4, updated_at = $5
+		WHERE id = $1`
+
+	querySelectAccessReviewCampaigns = `
+		SELECT id, name, description, scope_role_ids, scope_user_ids, status, due_at,
+			created_by, closed_by, closed_at, created_at, updated_at
+		FROM access_review_campaigns`
+
+	queryFindAccessReviewCampaignByID = querySelectAccessReviewCampaigns + `
+		WHERE id = $1`
+
+	queryCountAccessReviewCampaigns = `SELECT COUNT(*) FROM access_review_campaigns`
+
+	queryFindActiveAccessReviewsByReviewer = querySelectAccessReviewCampaigns + `
+		WHERE status = 'active' AND (
+			EXISTS (SELECT 1 FROM access_review_items i WHERE i.campaign_id = access_review_campaigns.id AND i.reviewer_id = $1 AND i.decision = 'pending')
+			OR (created_by = $1 AND EXISTS (
+				SELECT 1 FROM access_review_items i WHERE i.campaign_id = access_review_campaigns.id AND i.escalated_at IS NOT NULL AND i.decision = 'pending'))
+		)
+		ORDER BY due_at`
+
+	queryFindOverdueAccessReviews = querySelectAccessReviewCampaigns + `
+		WHERE status = 'active' AND due_at < $1 AND EXISTS (
+			SELECT 1 FROM access_review_items i
+			WHERE i.campaign_id = access_review_campaigns.id AND i.decision = 'pending' AND i.escalated_at IS NULL)
+		ORDER BY due_at
+		LIMIT $2`
+
+	queryFindAccessReviewItems = `
+		SELECT id, user_id, role_id, reviewer_id, decision, comment, decided_by, decided_at, escalated_at, revoked_at
+		FROM access_review_items
+		WHERE campaign_id = $1
+		ORDER BY role_id, user_id`
+
+	queryUpsertAccessReviewItem = `
+		INSERT INTO access_review_items (id, campaign_id, user_id, role_id, reviewer_id, decision, comment,
+			decided_by, decided_at, escalated_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE
+		SET reviewer_id = EXCLUDED.reviewer_id, decision = EXCLUDED.decision, comment = EXCLUDED.comment,
+			decided_by = EXCLUDED.decided_by, decided_at = EXCLUDED.decided_at,
+			escalated_at = EXCLUDED.escalated_at, revoked_at = EXCLUDED.revoked_at`
+)
+
+type accessReviewCampaignRow struct {
+	ID           uuid.UUID
+	Name         string
+	Description  *string
+	ScopeRoleIDs []uuid.UUID
+	ScopeUserIDs []uuid.UUID
+	Status       string
+	DueAt        time.Time
+	CreatedBy    uuid.UUID
+	ClosedBy     *uuid.UUID
+	ClosedAt     *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (r *accessReviewCampaignRow) toDomain(items []*accessreview.Item) *accessreview.Campaign {
+	description := ""
+	if r.Description != nil {
+		description = *r.Description
+	}
+	return accessreview.ReconstructCampaign(accessreview.ReconstructCampaignParams{
+		ID:           r.ID,
+		Name:         r.Name,
+		Description:  description,
+		ScopeRoleIDs: r.ScopeRoleIDs,
+		ScopeUserIDs: r.ScopeUserIDs,
+		Status:       accessreview.Status(r.Status),
+		DueAt:        r.DueAt,
+		CreatedBy:    r.CreatedBy,
+		ClosedBy:     r.ClosedBy,
+		ClosedAt:     r.ClosedAt,
+		Items:        items,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	})
+}
+
+func accessReviewCampaignToRow(campaign *accessreview.Campaign) *accessReviewCampaignRow {
+	description := campaign.Description()
+	scopeRoleIDs := campaign.ScopeRoleIDs()
+	if scopeRoleIDs == nil {
+		scopeRoleIDs = []uuid.UUID{}
+	}
+	scopeUserIDs := campaign.ScopeUserIDs()
+	if scopeUserIDs == nil {
+		scopeUserIDs = []uuid.UUID{}
+	}
+	return &accessReviewCampaignRow{
+		ID:           campaign.ID(),
+		Name:         campaign.Name(),
+		Description:  &description,
+		ScopeRoleIDs: scopeRoleIDs,
+		ScopeUserIDs: scopeUserIDs,
+		Status:       campaign.Status().String(),
+		DueAt:        campaign.DueAt(),
+		CreatedBy:    campaign.CreatedBy(),
+		ClosedBy:     campaign.ClosedBy(),
+		ClosedAt:     campaign.ClosedAt(),
+		CreatedAt:    campaign.CreatedAt(),
+		UpdatedAt:    campaign.UpdatedAt(),
+	}
+}
+
+type AccessReviewRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAccessReviewRepository(pool *pgxpool.Pool) *AccessReviewRepository {
+	return &AccessReviewRepository{pool: pool}
+}
+
+func (r *AccessReviewRepository) Create(ctx context.Context, campaign *accessreview.Campaign) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := accessReviewCampaignToRow(campaign)
+
+	_, err := querier.Exec(ctx, queryInsertAccessReviewCampaign,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.ScopeRoleIDs,
+		row.ScopeUserIDs,
+		row.Status,
+		row.DueAt,
+		row.CreatedBy,
+		row.ClosedBy,
+		row.ClosedAt,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("create access review", err)
+	}
+
+	return r.syncItems(ctx, querier, campaign)
+}
+
+func (r *AccessReviewRepository) Update(ctx context.Context, campaign *accessreview.Campaign) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := accessReviewCampaignToRow(campaign)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateAccessReviewCampaign,
+		row.ID,
+		row.Status,
+		row.ClosedBy,
+		row.ClosedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("update access review", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return accessreview.NewCampaignNotFoundError(row.ID.String())
+	}
+
+	return r.syncItems(ctx, querier, campaign)
+}
+
+func (r *AccessReviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*accessreview.Campaign, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &accessReviewCampaignRow{}
+	err := r.scanRow(querier.QueryRow(ctx, queryFindAccessReviewCampaignByID, id), row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, accessreview.NewCampaignNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find access review by id", err)
+	}
+
+	items, err := r.loadItems(ctx, querier, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toDomain(items), nil
+}
+
+func (r *AccessReviewRepository) List(ctx context.Context, filter accessreview.Filter, pagination shared.Pagination) ([]*accessreview.Campaign, int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+	if filter.Status != nil {
+		where.Eq("status", filter.Status.String())
+	}
+
+	whereClause, args := where.Build()
+
+	countQuery := queryCountAccessReviewCampaigns
+	if whereClause != "" {
+		countQuery = countQuery + " " + whereClause
+	}
+
+	var total int64
+	if err := querier.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, postgres.NewDBError("count access reviews", err)
+	}
+
+	if total == 0 {
+		return []*accessreview.Campaign{}, 0, nil
+	}
+
+	orderBy := postgres.NewOrderByClause().Desc("created_at")
+	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+
+	dataQuery := querySelectAccessReviewCampaigns
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
+
+	rows, err := querier.Query(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, postgres.NewDBError("list access reviews", err)
+	}
+	defer rows.Close()
+
+	campaigns, err := r.scanCampaigns(ctx, querier, rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return campaigns, total, nil
+}
+
+func (r *AccessReviewRepository) FindActiveByReviewer(ctx context.Context, reviewerID uuid.UUID) ([]*accessreview.Campaign, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindActiveAccessReviewsByReviewer, reviewerID)
+	if err != nil {
+		return nil, postgres.NewDBError("find access reviews by reviewer", err)
+	}
+	defer rows.Close()
+
+	return r.scanCampaigns(ctx, querier, rows)
+}
+
+func (r *AccessReviewRepository) FindOverdue(ctx context.Context, now time.Time, limit int) ([]*accessreview.Campaign, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindOverdueAccessReviews, now, limit)
+	if err != nil {
+		return nil, postgres.NewDBError("find overdue access reviews", err)
+	}
+	defer rows.Close()
+
+	return r.scanCampaigns(ctx, querier, rows)
+}
+
+func (r *AccessReviewRepository) scanRow(scanner pgx.Row, row *accessReviewCampaignRow) error {
+	return scanner.Scan(
+		&row.ID,
+		&row.Name,
+		&row.Description,
+		&row.ScopeRoleIDs,
+		&row.ScopeUserIDs,
+		&row.Status,
+		&row.DueAt,
+		&row.CreatedBy,
+		&row.ClosedBy,
+		&row.ClosedAt,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+}
+
+func (r *AccessReviewRepository) scanCampaigns(ctx context.Context, querier postgres.Querier, rows pgx.Rows) ([]*accessreview.Campaign, error) {
+	campaignRows := make([]*accessReviewCampaignRow, 0)
+	for rows.Next() {
+		row := &accessReviewCampaignRow{}
+		if err := r.scanRow(rows, row); err != nil {
+			return nil, postgres.NewDBError("scan access review row", err)
+		}
+		campaignRows = append(campaignRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate access review rows", err)
+	}
+	rows.Close()
+
+	campaigns := make([]*accessreview.Campaign, 0, len(campaignRows))
+	for _, row := range campaignRows {
+		items, err := r.loadItems(ctx, querier, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, row.toDomain(items))
+	}
+
+	return campaigns, nil
+}
+
+func (r *AccessReviewRepository) loadItems(ctx context.Context, querier postgres.Querier, campaignID uuid.UUID) ([]*accessreview.Item, error) {
+	rows, err := querier.Query(ctx, queryFindAccessReviewItems, campaignID)
+	if err != nil {
+		return nil, postgres.NewDBError("load access review items", err)
+	}
+	defer rows.Close()
+
+	items := make([]*accessreview.Item, 0)
+	for rows.Next() {
+		var (
+			params   accessreview.ReconstructItemParams
+			decision string
+			comment  *string
+		)
+		err := rows.Scan(
+			&params.ID,
+			&params.UserID,
+			&params.RoleID,
+			&params.ReviewerID,
+			&decision,
+			&comment,
+			&params.DecidedBy,
+			&params.DecidedAt,
+			&params.EscalatedAt,
+			&params.RevokedAt,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan access review item row", err)
+		}
+		params.Decision = accessreview.Decision(decision)
+		if comment != nil {
+			params.Comment = *comment
+		}
+		items = append(items, accessreview.ReconstructItem(params))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate access review item rows", err)
+	}
+
+	return items, nil
+}
+
+func (r *AccessReviewRepository) syncItems(ctx context.Context, querier postgres.Querier, campaign *accessreview.Campaign) error {
+	for _, item := range campaign.Items() {
+		comment := item.Comment()
+		_, err := querier.Exec(ctx, queryUpsertAccessReviewItem,
+			item.ID(),
+			campaign.ID(),
+			item.UserID(),
+			item.RoleID(),
+			item.ReviewerID(),
+			item.Decision().String(),
+			&comment,
+			item.DecidedBy(),
+			item.DecidedAt(),
+			item.EscalatedAt(),
+			item.RevokedAt(),
+		)
+		if err != nil {
+			return postgres.NewDBError("upsert access review item", err)
+		}
+	}
+
+	return nil
+}
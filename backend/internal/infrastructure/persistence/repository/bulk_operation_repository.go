@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertBulkOperation = `
+		INSERT INTO bulk_operations (id, action, reason, role_ids, status, created_by, failure_reason,
+			started_at, completed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	queryUpdateBulkOperation = `
+		UPDATE bulk_operations
+		SET status = $2, failure_reason = $3, started_at = $4, completed_at = $5, updated_at = $6
+		WHERE id = $1`
+
+	queryFindBulkOperationByID = `
+		SELECT id, action, reason, role_ids, status, created_by, failure_reason,
+			started_at, completed_at, created_at, updated_at
+		FROM bulk_operations
+		WHERE id = $1`
+
+	queryInsertBulkOperationItems = `
+		INSERT INTO bulk_operation_items (operation_id, position, user_id, status)
+		SELECT $1, item.position, item.user_id, 'pending'
+		FROM UNNEST($2::UUID[]) WITH ORDINALITY AS item(user_id, position)`
+
+	// Items only ever move out of pending, so rows that were already written
+	// by an earlier progress save are skipped.
+	queryRecordBulkOperationItems = `
+		UPDATE bulk_operation_items AS item
+		SET status = result.status, failure = result.failure, processed_at = result.processed_at
+		FROM UNNEST($2::UUID[], $3::TEXT[], $4::TEXT[], $5::TIMESTAMPTZ[]) AS result(user_id, status, failure, processed_at)
+		WHERE item.operation_id = $1 AND item.user_id = result.user_id AND item.status = 'pending'`
+
+	queryFindBulkOperationItems = `
+		SELECT user_id, status, failure, processed_at
+		FROM bulk_operation_items
+		WHERE operation_id = $1
+		ORDER BY position`
+)
+
+type bulkOperationRow struct {
+	ID            uuid.UUID
+	Action        string
+	Reason        *string
+	RoleIDs       []uuid.UUID
+	Status        string
+	CreatedBy     uuid.UUID
+	FailureReason *string
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (r *bulkOperationRow) toDomain(items []*bulkoperation.Item) *bulkoperation.Operation {
+	reason := ""
+	if r.Reason != nil {
+		reason = *r.Reason
+	}
+	failureReason := ""
+	if r.FailureReason != nil {
+		failureReason = *r.FailureReason
+	}
+	return bulkoperation.ReconstructOperation(bulkoperation.ReconstructOperationParams{
+		ID:            r.ID,
+		Action:        bulkoperation.Action(r.Action),
+		Reason:        reason,
+		RoleIDs:       r.RoleIDs,
+		Status:        bulkoperation.Status(r.Status),
+		CreatedBy:     r.CreatedBy,
+		Items:         items,
+		FailureReason: failureReason,
+		StartedAt:     r.StartedAt,
+		CompletedAt:   r.CompletedAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	})
+}
+
+func bulkOperationToRow(operation *bulkoperation.Operation) *bulkOperationRow {
+	reason := operation.Reason()
+	failureReason := operation.FailureReason()
+	roleIDs := operation.RoleIDs()
+	if roleIDs == nil {
+		roleIDs = []uuid.UUID{}
+	}
+	return &bulkOperationRow{
+		ID:            operation.ID(),
+		Action:        operation.Action().String(),
+		Reason:        &reason,
+		RoleIDs:       roleIDs,
+		Status:        operation.Status().String(),
+		CreatedBy:     operation.CreatedBy(),
+		FailureReason: &failureReason,
+		StartedAt:     operation.StartedAt(),
+		CompletedAt:   operation.CompletedAt(),
+		CreatedAt:     operation.CreatedAt(),
+		UpdatedAt:     operation.UpdatedAt(),
+	}
+}
+
+type BulkOperationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewBulkOperationRepository(pool *pgxpool.Pool) *BulkOperationRepository {
+	return &BulkOperationRepository{pool: pool}
+}
+
+func (r *BulkOperationRepository) Create(ctx context.Context, operation *bulkoperation.Operation) error {
+	return postgres.WithTransaction(ctx, r.pool, func(txContext context.Context) error {
+		querier := postgres.GetQuerier(txContext, r.pool)
+		row := bulkOperationToRow(operation)
+
+		_, err := querier.Exec(txContext, queryInsertBulkOperation,
+			row.ID,
+			row.Action,
+			row.Reason,
+			row.RoleIDs,
+			row.Status,
+			row.CreatedBy,
+			row.FailureReason,
+			row.StartedAt,
+			row.CompletedAt,
+			row.CreatedAt,
+			row.UpdatedAt,
+		)
+		if err != nil {
+			return postgres.NewDBError("create bulk operation", err)
+		}
+
+		userIDs := make([]uuid.UUID, len(operation.Items()))
+		for i, item := range operation.Items() {
+			userIDs[i] = item.UserID()
+		}
+		if _, err := querier.Exec(txContext, queryInsertBulkOperationItems, row.ID, userIDs); err != nil {
+			return postgres.NewDBError("create bulk operation items", err)
+		}
+
+		return r.recordItems(txContext, querier, operation)
+	})
+}
+
+func (r *BulkOperationRepository) Update(ctx context.Context, operation *bulkoperation.Operation) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := bulkOperationToRow(operation)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateBulkOperation,
+		row.ID,
+		row.Status,
+		row.FailureReason,
+		row.StartedAt,
+		row.CompletedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("update bulk operation", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return bulkoperation.NewOperationNotFoundError(row.ID.String())
+	}
+
+	return r.recordItems(ctx, querier, operation)
+}
+
+func (r *BulkOperationRepository) FindByID(ctx context.Context, id uuid.UUID) (*bulkoperation.Operation, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &bulkOperationRow{}
+	err := querier.QueryRow(ctx, queryFindBulkOperationByID, id).Scan(
+		&row.ID,
+		&row.Action,
+		&row.Reason,
+		&row.RoleIDs,
+		&row.Status,
+		&row.CreatedBy,
+		&row.FailureReason,
+		&row.StartedAt,
+		&row.CompletedAt,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, bulkoperation.NewOperationNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find bulk operation by id", err)
+	}
+
+	items, err := r.loadItems(ctx, querier, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toDomain(items), nil
+}
+
+func (r *BulkOperationRepository) loadItems(ctx context.Context, querier postgres.Querier, operationID uuid.UUID) ([]*bulkoperation.Item, error) {
+	rows, err := querier.Query(ctx, queryFindBulkOperationItems, operationID)
+	if err != nil {
+		return nil, postgres.NewDBError("load bulk operation items", err)
+	}
+	defer rows.Close()
+
+	items := make([]*bulkoperation.Item, 0)
+	for rows.Next() {
+		var (
+			params  bulkoperation.ReconstructItemParams
+			status  string
+			failure *string
+		)
+		if err := rows.Scan(&params.UserID, &status, &failure, &params.ProcessedAt); err != nil {
+			return nil, postgres.NewDBError("scan bulk operation item row", err)
+		}
+		params.Status = bulkoperation.ItemStatus(status)
+		if failure != nil {
+			params.Failure = *failure
+		}
+		items = append(items, bulkoperation.ReconstructItem(params))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate bulk operation item rows", err)
+	}
+
+	return items, nil
+}
+
+func (r *BulkOperationRepository) recordItems(ctx context.Context, querier postgres.Querier, operation *bulkoperation.Operation) error {
+	var (
+		userIDs      []uuid.UUID
+		statuses     []string
+		failures     []string
+		processedAts []time.Time
+	)
+	for _, item := range operation.Items() {
+		if item.ProcessedAt() == nil {
+			continue
+		}
+		userIDs = append(userIDs, item.UserID())
+		statuses = append(statuses, item.Status().String())
+		failures = append(failures, item.Failure())
+		processedAts = append(processedAts, *item.ProcessedAt())
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	if _, err := querier.Exec(ctx, queryRecordBulkOperationItems, operation.ID(), userIDs, statuses, failures, processedAts); err != nil {
+		return postgres.NewDBError("record bulk operation items", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryUpsertEmailChange = `
+		INSERT INTO user_email_changes (user_id, new_email, confirm_token_hash, cancel_token_hash, requested_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE
+		SET new_email = EXCLUDED.new_email,
+			confirm_token_hash = EXCLUDED.confirm_token_hash,
+			cancel_token_hash = EXCLUDED.cancel_token_hash,
+			requested_at = EXCLUDED.requested_at,
+			expires_at = EXCLUDED.expires_at`
+
+	queryFindEmailChangeByConfirmHash = `
+		SELECT user_id, new_email, confirm_token_hash, cancel_token_hash, requested_at, expires_at
+		FROM user_email_changes
+		WHERE confirm_token_hash = $1`
+
+	queryFindEmailChangeByCancelHash = `
+		SELECT user_id, new_email, confirm_token_hash, cancel_token_hash, requested_at, expires_at
+		FROM user_email_changes
+		WHERE cancel_token_hash = $1`
+
+	queryDeleteEmailChangeByUserID = `
+		DELETE FROM user_email_changes WHERE user_id = $1`
+)
+
+type emailChangeRow struct {
+	UserID           uuid.UUID
+	NewEmail         string
+	ConfirmTokenHash string
+	CancelTokenHash  string
+	RequestedAt      time.Time
+	ExpiresAt        time.Time
+}
+
+func (r *emailChangeRow) toDomain() (*user.EmailChange, error) {
+	return user.ReconstructEmailChange(user.ReconstructEmailChangeParams{
+		UserID:           r.UserID,
+		NewEmail:         r.NewEmail,
+		ConfirmTokenHash: r.ConfirmTokenHash,
+		CancelTokenHash:  r.CancelTokenHash,
+		RequestedAt:      r.RequestedAt,
+		ExpiresAt:        r.ExpiresAt,
+	})
+}
+
+type EmailChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewEmailChangeRepository(pool *pgxpool.Pool) *EmailChangeRepository {
+	return &EmailChangeRepository{pool: pool}
+}
+
+func (r *EmailChangeRepository) Save(ctx context.Context, change *user.EmailChange) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, queryUpsertEmailChange,
+		change.UserID(),
+		change.NewEmail().String(),
+		change.ConfirmTokenHash(),
+		change.CancelTokenHash(),
+		change.RequestedAt(),
+		change.ExpiresAt(),
+	)
+	if err != nil {
+		return postgres.NewDBError("save email change", err)
+	}
+
+	return nil
+}
+
+func (r *EmailChangeRepository) FindByConfirmTokenHash(ctx context.Context, tokenHash string) (*user.EmailChange, error) {
+	return r.findOne(ctx, queryFindEmailChangeByConfirmHash, tokenHash)
+}
+
+func (r *EmailChangeRepository) FindByCancelTokenHash(ctx context.Context, tokenHash string) (*user.EmailChange, error) {
+	return r.findOne(ctx, queryFindEmailChangeByCancelHash, tokenHash)
+}
+
+func (r *EmailChangeRepository) findOne(ctx context.Context, query string, tokenHash string) (*user.EmailChange, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &emailChangeRow{}
+	err := querier.QueryRow(ctx, query, tokenHash).Scan(
+		&row.UserID,
+		&row.NewEmail,
+		&row.ConfirmTokenHash,
+		&row.CancelTokenHash,
+		&row.RequestedAt,
+		&row.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrInvalidEmailChangeToken
+		}
+		return nil, postgres.NewDBError("find email change", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *EmailChangeRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	if _, err := querier.Exec(ctx, queryDeleteEmailChangeByUserID, userID); err != nil {
+		return postgres.NewDBError("delete email change", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,476 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertGroup = `
+		INSERT INTO groups (id, name, description, organization_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	queryUpdateGroup = `
+		UPDATE groups
+		SET name = $2, description = $3, updated_at = $4
+		WHERE id = $1`
+
+	queryDeleteGroup = `
+		DELETE FROM groups WHERE id = $1`
+
+	querySelectGroups = `
+		SELECT id, name, description, organization_id, created_at, updated_at
+		FROM groups`
+
+	queryFindGroupByID = `
+		SELECT id, name, description, organization_id, created_at, updated_at
+		FROM groups
+		WHERE id = $1`
+
+	queryExistsGlobalGroupByName = `
+		SELECT EXISTS(SELECT 1 FROM groups WHERE LOWER(name) = LOWER($1) AND organization_id IS NULL)`
+
+	queryExistsGroupByNameInOrganization = `
+		SELECT EXISTS(SELECT 1 FROM groups WHERE LOWER(name) = LOWER($1) AND organization_id = $2)`
+
+	queryCountGroups = `SELECT COUNT(*) FROM groups`
+
+	queryFindGroupsByMember = `
+		SELECT g.id, g.name, g.description, g.organization_id, g.created_at, g.updated_at
+		FROM groups g
+		INNER JOIN group_members gm ON g.id = gm.group_id
+		WHERE gm.user_id = $1
+		ORDER BY g.name`
+
+	queryFindGroupRoleIDsByMember = `
+		SELECT DISTINCT gr.role_id
+		FROM group_roles gr
+		INNER JOIN group_members gm ON gr.group_id = gm.group_id
+		WHERE gm.user_id = $1`
+
+	queryFindGroupMemberIDsByRole = `
+		SELECT DISTINCT gm.user_id
+		FROM group_members gm
+		INNER JOIN group_roles gr ON gr.group_id = gm.group_id
+		WHERE gr.role_id = $1`
+
+	queryFindGroupRoles = `
+		SELECT role_id FROM group_roles WHERE group_id = $1`
+
+	queryDeleteGroupRoles = `
+		DELETE FROM group_roles WHERE group_id = $1`
+
+	queryInsertGroupRole = `
+		INSERT INTO group_roles (group_id, role_id, assigned_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (group_id, role_id) DO NOTHING`
+
+	queryInsertGroupMember = `
+		INSERT INTO group_members (group_id, user_id, joined_at)
+		VALUES ($1, $2, $3)`
+
+	queryDeleteGroupMember = `
+		DELETE FROM group_members WHERE group_id = $1 AND user_id = $2`
+
+	queryExistsGroupMember = `
+		SELECT EXISTS(SELECT 1 FROM group_members WHERE group_id = $1 AND user_id = $2)`
+
+	queryListGroupMemberIDs = `
+		SELECT user_id FROM group_members WHERE group_id = $1 ORDER BY joined_at`
+)
+
+type groupRow struct {
+	ID             uuid.UUID
+	Name           string
+	Description    *string
+	OrganizationID *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (r *groupRow) toDomain(roleIDs []uuid.UUID) *group.Group {
+	description := ""
+	if r.Description != nil {
+		description = *r.Description
+	}
+	return group.ReconstructGroup(group.ReconstructGroupParams{
+		ID:             r.ID,
+		Name:           r.Name,
+		Description:    description,
+		RoleIDs:        roleIDs,
+		OrganizationID: r.OrganizationID,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	})
+}
+
+func groupToRow(g *group.Group) *groupRow {
+	description := g.Description()
+	return &groupRow{
+		ID:             g.ID(),
+		Name:           g.Name(),
+		Description:    &description,
+		OrganizationID: g.OrganizationID(),
+		CreatedAt:      g.CreatedAt(),
+		UpdatedAt:      g.UpdatedAt(),
+	}
+}
+
+type GroupRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewGroupRepository(pool *pgxpool.Pool) *GroupRepository {
+	return &GroupRepository{pool: pool}
+}
+
+func (r *GroupRepository) Create(ctx context.Context, g *group.Group) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := groupToRow(g)
+
+	_, err := querier.Exec(ctx, queryInsertGroup,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.OrganizationID,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return group.NewGroupNameExistsError(row.Name)
+		}
+		return postgres.NewDBError("create group", err)
+	}
+
+	return r.syncRoles(ctx, querier, g.ID(), g.RoleIDs())
+}
+
+func (r *GroupRepository) Update(ctx context.Context, g *group.Group) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := groupToRow(g)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateGroup,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return group.NewGroupNameExistsError(row.Name)
+		}
+		return postgres.NewDBError("update group", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return group.NewGroupNotFoundError(row.ID.String())
+	}
+
+	return r.syncRoles(ctx, querier, g.ID(), g.RoleIDs())
+}
+
+func (r *GroupRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteGroup, id)
+	if err != nil {
+		return postgres.NewDBError("delete group", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return group.NewGroupNotFoundError(id.String())
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) FindByID(ctx context.Context, id uuid.UUID) (*group.Group, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &groupRow{}
+	err := querier.QueryRow(ctx, queryFindGroupByID, id).Scan(
+		&row.ID,
+		&row.Name,
+		&row.Description,
+		&row.OrganizationID,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, group.NewGroupNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find group by id", err)
+	}
+
+	roleIDs, err := r.loadRoleIDs(ctx, querier, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toDomain(roleIDs), nil
+}
+
+func (r *GroupRepository) ExistsByName(ctx context.Context, name string, organizationID *uuid.UUID) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	var err error
+	if organizationID == nil {
+		err = querier.QueryRow(ctx, queryExistsGlobalGroupByName, name).Scan(&exists)
+	} else {
+		err = querier.QueryRow(ctx, queryExistsGroupByNameInOrganization, name, *organizationID).Scan(&exists)
+	}
+	if err != nil {
+		return false, postgres.NewDBError("check group exists by name", err)
+	}
+
+	return exists, nil
+}
+
+func (r *GroupRepository) List(ctx context.Context, filter group.Filter, pagination shared.Pagination) ([]*group.Group, int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+
+	if filter.OrganizationID != nil {
+		where.Eq("organization_id", *filter.OrganizationID)
+	}
+
+	if filter.Search != nil && *filter.Search != "" {
+		searchPattern := "%" + *filter.Search + "%"
+		where.AddCondition("LOWER(name) LIKE LOWER($%d)", searchPattern)
+	}
+
+	whereClause, args := where.Build()
+
+	countQuery := queryCountGroups
+	if whereClause != "" {
+		countQuery = countQuery + " " + whereClause
+	}
+
+	var total int64
+	if err := querier.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, postgres.NewDBError("count groups", err)
+	}
+
+	if total == 0 {
+		return []*group.Group{}, 0, nil
+	}
+
+	orderBy := postgres.NewOrderByClause().Asc("name")
+	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+
+	dataQuery := querySelectGroups
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
+
+	rows, err := querier.Query(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, postgres.NewDBError("list groups", err)
+	}
+	defer rows.Close()
+
+	groupRows, err := r.scanGroupRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	groups, err := r.withRoles(ctx, querier, groupRows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, total, nil
+}
+
+func (r *GroupRepository) FindByMember(ctx context.Context, userID uuid.UUID) ([]*group.Group, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindGroupsByMember, userID)
+	if err != nil {
+		return nil, postgres.NewDBError("find groups by member", err)
+	}
+	defer rows.Close()
+
+	groupRows, err := r.scanGroupRows(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withRoles(ctx, querier, groupRows)
+}
+
+func (r *GroupRepository) FindRoleIDsByMember(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindGroupRoleIDsByMember, userID)
+	if err != nil {
+		return nil, postgres.NewDBError("find group role ids by member", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDs(rows, "group role id")
+}
+
+func (r *GroupRepository) FindMemberIDsByRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindGroupMemberIDsByRole, roleID)
+	if err != nil {
+		return nil, postgres.NewDBError("find group member ids by role", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDs(rows, "group member id")
+}
+
+func (r *GroupRepository) AddMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, queryInsertGroupMember, groupID, userID, time.Now().UTC())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return group.ErrAlreadyMember
+		}
+		return postgres.NewDBError("add group member", err)
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteGroupMember, groupID, userID)
+	if err != nil {
+		return postgres.NewDBError("remove group member", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return group.ErrNotMember
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) IsMember(ctx context.Context, groupID, userID uuid.UUID) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	err := querier.QueryRow(ctx, queryExistsGroupMember, groupID, userID).Scan(&exists)
+	if err != nil {
+		return false, postgres.NewDBError("check group membership", err)
+	}
+
+	return exists, nil
+}
+
+func (r *GroupRepository) ListMemberIDs(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryListGroupMemberIDs, groupID)
+	if err != nil {
+		return nil, postgres.NewDBError("list group members", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDs(rows, "group member id")
+}
+
+func (r *GroupRepository) scanGroupRows(rows pgx.Rows) ([]*groupRow, error) {
+	groupRows := make([]*groupRow, 0)
+	for rows.Next() {
+		row := &groupRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Description,
+			&row.OrganizationID,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan group row", err)
+		}
+		groupRows = append(groupRows, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate group rows", err)
+	}
+
+	return groupRows, nil
+}
+
+func (r *GroupRepository) withRoles(ctx context.Context, querier postgres.Querier, groupRows []*groupRow) ([]*group.Group, error) {
+	groups := make([]*group.Group, 0, len(groupRows))
+	for _, row := range groupRows {
+		roleIDs, err := r.loadRoleIDs(ctx, querier, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, row.toDomain(roleIDs))
+	}
+	return groups, nil
+}
+
+func (r *GroupRepository) loadRoleIDs(ctx context.Context, querier postgres.Querier, groupID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := querier.Query(ctx, queryFindGroupRoles, groupID)
+	if err != nil {
+		return nil, postgres.NewDBError("load group roles", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDs(rows, "group role id")
+}
+
+func (r *GroupRepository) syncRoles(ctx context.Context, querier postgres.Querier, groupID uuid.UUID, roleIDs []uuid.UUID) error {
+	_, err := querier.Exec(ctx, queryDeleteGroupRoles, groupID)
+	if err != nil {
+		return postgres.NewDBError("delete group roles", err)
+	}
+
+	now := time.Now().UTC()
+	for _, roleID := range roleIDs {
+		_, err := querier.Exec(ctx, queryInsertGroupRole, groupID, roleID, now)
+		if err != nil {
+			return postgres.NewDBError("insert group role", err)
+		}
+	}
+
+	return nil
+}
+
+func scanUUIDs(rows pgx.Rows, name string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, postgres.NewDBError("scan "+name, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate "+name+"s", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertInvitation = `
+		INSERT INTO invitations (id, email, role_ids, invited_by, token_hash, status, expires_at,
+			user_id, accepted_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	queryUpdateInvitation = `
+		UPDATE invitations
+		SET token_hash = $2, status = $3, expires_at = $4, user_id = $5, accepted_at = $6, updated_at = $7
+		WHERE id = $1`
+
+	querySelectInvitations = `
+		SELECT id, email, role_ids, invited_by, token_hash, status, expires_at,
+			user_id, accepted_at, created_at, updated_at
+		FROM invitations`
+
+	queryFindInvitationByID = querySelectInvitations + `
+		WHERE id = $1`
+
+	queryFindInvitationByTokenHash = querySelectInvitations + `
+		WHERE token_hash = $1`
+
+	queryCountInvitations = `SELECT COUNT(*) FROM invitations`
+
+	queryExistsPendingInvitation = `
+		SELECT EXISTS(SELECT 1 FROM invitations WHERE LOWER(email) = LOWER($1) AND status = 'pending')`
+
+	queryDeleteInvitationsForEmail = `
+		DELETE FROM invitations WHERE LOWER(email) = LOWER($1)`
+)
+
+type invitationRow struct {
+	ID         uuid.UUID
+	Email      string
+	RoleIDs    []uuid.UUID
+	InvitedBy  *uuid.UUID
+	TokenHash  string
+	Status     string
+	ExpiresAt  time.Time
+	UserID     *uuid.UUID
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (r *invitationRow) toDomain() (*invitation.Invitation, error) {
+	invitedBy := uuid.Nil
+	if r.InvitedBy != nil {
+		invitedBy = *r.InvitedBy
+	}
+	return invitation.ReconstructInvitation(invitation.ReconstructInvitationParams{
+		ID:         r.ID,
+		Email:      r.Email,
+		RoleIDs:    r.RoleIDs,
+		InvitedBy:  invitedBy,
+		TokenHash:  r.TokenHash,
+		Status:     invitation.Status(r.Status),
+		ExpiresAt:  r.ExpiresAt,
+		UserID:     r.UserID,
+		AcceptedAt: r.AcceptedAt,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	})
+}
+
+func invitationToRow(inv *invitation.Invitation) *invitationRow {
+	var invitedBy *uuid.UUID
+	if inv.InvitedBy() != uuid.Nil {
+		id := inv.InvitedBy()
+		invitedBy = &id
+	}
+	return &invitationRow{
+		ID:         inv.ID(),
+		Email:      inv.Email().String(),
+		RoleIDs:    inv.RoleIDs(),
+		InvitedBy:  invitedBy,
+		TokenHash:  inv.TokenHash(),
+		Status:     inv.Status().String(),
+		ExpiresAt:  inv.ExpiresAt(),
+		UserID:     inv.UserID(),
+		AcceptedAt: inv.AcceptedAt(),
+		CreatedAt:  inv.CreatedAt(),
+		UpdatedAt:  inv.UpdatedAt(),
+	}
+}
+
+type InvitationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewInvitationRepository(pool *pgxpool.Pool) *InvitationRepository {
+	return &InvitationRepository{pool: pool}
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, inv *invitation.Invitation) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := invitationToRow(inv)
+
+	_, err := querier.Exec(ctx, queryInsertInvitation,
+		row.ID,
+		row.Email,
+		row.RoleIDs,
+		row.InvitedBy,
+		row.TokenHash,
+		row.Status,
+		row.ExpiresAt,
+		row.UserID,
+		row.AcceptedAt,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return invitation.ErrPendingInvitationExists
+		}
+		return postgres.NewDBError("create invitation", err)
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) Update(ctx context.Context, inv *invitation.Invitation) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := invitationToRow(inv)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateInvitation,
+		row.ID,
+		row.TokenHash,
+		row.Status,
+		row.ExpiresAt,
+		row.UserID,
+		row.AcceptedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return invitation.ErrPendingInvitationExists
+		}
+		return postgres.NewDBError("update invitation", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return invitation.NewInvitationNotFoundError(row.ID.String())
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) FindByID(ctx context.Context, id uuid.UUID) (*invitation.Invitation, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &invitationRow{}
+	err := r.scanRow(querier.QueryRow(ctx, queryFindInvitationByID, id), row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, invitation.NewInvitationNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find invitation by id", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *InvitationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*invitation.Invitation, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &invitationRow{}
+	err := r.scanRow(querier.QueryRow(ctx, queryFindInvitationByTokenHash, tokenHash), row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, invitation.ErrInvalidInvitationToken
+		}
+		return nil, postgres.NewDBError("find invitation by token hash", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *InvitationRepository) List(ctx context.Context, filter invitation.Filter, pagination shared.Pagination) ([]*invitation.Invitation, int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+	if filter.Status != nil {
+		switch *filter.Status {
+		case invitation.StatusPending:
+			where.Eq("status", invitation.StatusPending.String())
+			where.AddCondition("expires_at > NOW()")
+		case invitation.StatusExpired:
+			where.AddCondition("(status = 'expired' OR (status = 'pending' AND expires_at <= NOW()))")
+		default:
+			where.Eq("status", filter.Status.String())
+		}
+	}
+	if filter.Email != "" {
+		where.ILike("email", "%"+filter.Email+"%")
+	}
+
+	whereClause, args := where.Build()
+
+	countQuery := queryCountInvitations
+	if whereClause != "" {
+		countQuery = countQuery + " " + whereClause
+	}
+
+	var total int64
+	if err := querier.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, postgres.NewDBError("count invitations", err)
+	}
+
+	if total == 0 {
+		return []*invitation.Invitation{}, 0, nil
+	}
+
+	orderBy := postgres.NewOrderByClause().Desc("created_at")
+	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+
+	dataQuery := querySelectInvitations
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
+
+	rows, err := querier.Query(ctx, dataQuery, args...)
+	if err != nil {
+		return nil, 0, postgres.NewDBError("list invitations", err)
+	}
+	defer rows.Close()
+
+	invitations, err := r.scanRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return invitations, total, nil
+}
+
+func (r *InvitationRepository) ExistsPendingForEmail(ctx context.Context, email string) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	if err := querier.QueryRow(ctx, queryExistsPendingInvitation, email).Scan(&exists); err != nil {
+		return false, postgres.NewDBError("check pending invitation", err)
+	}
+
+	return exists, nil
+}
+
+func (r *InvitationRepository) DeleteAllForEmail(ctx context.Context, email string) (int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteInvitationsForEmail, email)
+	if err != nil {
+		return 0, postgres.NewDBError("delete invitations for email", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+func (r *InvitationRepository) scanRow(scanner pgx.Row, row *invitationRow) error {
+	return scanner.Scan(
+		&row.ID,
+		&row.Email,
+		&row.RoleIDs,
+		&row.InvitedBy,
+		&row.TokenHash,
+		&row.Status,
+		&row.ExpiresAt,
+		&row.UserID,
+		&row.AcceptedAt,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+}
+
+func (r *InvitationRepository) scanRows(rows pgx.Rows) ([]*invitation.Invitation, error) {
+	invitations := make([]*invitation.Invitation, 0)
+	for rows.Next() {
+		row := &invitationRow{}
+		if err := r.scanRow(rows, row); err != nil {
+			return nil, postgres.NewDBError("scan invitation row", err)
+		}
+		inv, err := row.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, inv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate invitation rows", err)
+	}
+
+	return invitations, nil
+}
@@ -0,0 +1,373 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertOrganization = `
+		INSERT INTO organizations (id, name, slug, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	queryUpdateOrganization = `
+		UPDATE organizations
+		SET name = $2, description = $3, updated_at = $4
+		WHERE id = $1`
+
+	queryDeleteOrganization = `
+		DELETE FROM organizations WHERE id = $1`
+
+	queryFindOrganizationByID = `
+		SELECT id, name, slug, description, created_at, updated_at
+		FROM organizations
+		WHERE id = $1`
+
+	queryFindOrganizationBySlug = `
+		SELECT id, name, slug, description, created_at, updated_at
+		FROM organizations
+		WHERE slug = $1`
+
+	queryExistsOrganizationBySlug = `
+		SELECT EXISTS(SELECT 1 FROM organizations WHERE slug = $1)`
+
+	queryCountOrganizations = `SELECT COUNT(*) FROM organizations`
+
+	queryListOrganizations = `
+		SELECT id, name, slug, description, created_at, updated_at
+		FROM organizations
+		ORDER BY name
+		LIMIT $1 OFFSET $2`
+
+	queryFindOrganizationsByUser = `
+		SELECT o.id, o.name, o.slug, o.description, o.created_at, o.updated_at
+		FROM organizations o
+		INNER JOIN organization_members om ON o.id = om.organization_id
+		WHERE om.user_id = $1
+		ORDER BY o.name`
+
+	queryInsertOrganizationMember = `
+		INSERT INTO organization_members (organization_id, user_id, joined_at)
+		VALUES ($1, $2, $3)`
+
+	queryDeleteOrganizationMember = `
+		DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2`
+
+	queryExistsOrganizationMember = `
+		SELECT EXISTS(SELECT 1 FROM organization_members WHERE organization_id = $1 AND user_id = $2)`
+
+	queryListOrganizationMembers = `
+		SELECT organization_id, user_id, joined_at
+		FROM organization_members
+		WHERE organization_id = $1
+		ORDER BY joined_at`
+)
+
+type organizationRow struct {
+	ID          uuid.UUID
+	Name        string
+	Slug        string
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (r *organizationRow) toDomain() (*organization.Organization, error) {
+	description := ""
+	if r.Description != nil {
+		description = *r.Description
+	}
+	return organization.ReconstructOrganization(organization.ReconstructOrganizationParams{
+		ID:          r.ID,
+		Name:        r.Name,
+		Slug:        r.Slug,
+		Description: description,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	})
+}
+
+func organizationToRow(o *organization.Organization) *organizationRow {
+	description := o.Description()
+	return &organizationRow{
+		ID:          o.ID(),
+		Name:        o.Name(),
+		Slug:        o.Slug(),
+		Description: &description,
+		CreatedAt:   o.CreatedAt(),
+		UpdatedAt:   o.UpdatedAt(),
+	}
+}
+
+type OrganizationRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewOrganizationRepository(pool *pgxpool.Pool) *OrganizationRepository {
+	return &OrganizationRepository{pool: pool}
+}
+
+func (r *OrganizationRepository) Create(ctx context.Context, o *organization.Organization) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := organizationToRow(o)
+
+	_, err := querier.Exec(ctx, queryInsertOrganization,
+		row.ID,
+		row.Name,
+		row.Slug,
+		row.Description,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return organization.NewOrganizationSlugExistsError(row.Slug)
+		}
+		return postgres.NewDBError("create organization", err)
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) Update(ctx context.Context, o *organization.Organization) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := organizationToRow(o)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateOrganization,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("update organization", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return organization.NewOrganizationNotFoundError(row.ID.String())
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteOrganization, id)
+	if err != nil {
+		return postgres.NewDBError("delete organization", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return organization.NewOrganizationNotFoundError(id.String())
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &organizationRow{}
+	err := querier.QueryRow(ctx, queryFindOrganizationByID, id).Scan(
+		&row.ID,
+		&row.Name,
+		&row.Slug,
+		&row.Description,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, organization.NewOrganizationNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find organization by id", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *OrganizationRepository) FindBySlug(ctx context.Context, slug string) (*organization.Organization, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &organizationRow{}
+	err := querier.QueryRow(ctx, queryFindOrganizationBySlug, slug).Scan(
+		&row.ID,
+		&row.Name,
+		&row.Slug,
+		&row.Description,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, organization.NewOrganizationNotFoundError(slug)
+		}
+		return nil, postgres.NewDBError("find organization by slug", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *OrganizationRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	err := querier.QueryRow(ctx, queryExistsOrganizationBySlug, slug).Scan(&exists)
+	if err != nil {
+		return false, postgres.NewDBError("check organization exists by slug", err)
+	}
+
+	return exists, nil
+}
+
+func (r *OrganizationRepository) List(ctx context.Context, pagination shared.Pagination) ([]*organization.Organization, int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var total int64
+	if err := querier.QueryRow(ctx, queryCountOrganizations).Scan(&total); err != nil {
+		return nil, 0, postgres.NewDBError("count organizations", err)
+	}
+
+	if total == 0 {
+		return []*organization.Organization{}, 0, nil
+	}
+
+	rows, err := querier.Query(ctx, queryListOrganizations, pagination.Limit(), pagination.Offset())
+	if err != nil {
+		return nil, 0, postgres.NewDBError("list organizations", err)
+	}
+	defer rows.Close()
+
+	organizations, err := r.scanOrganizations(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return organizations, total, nil
+}
+
+func (r *OrganizationRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*organization.Organization, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindOrganizationsByUser, userID)
+	if err != nil {
+		return nil, postgres.NewDBError("find organizations by user", err)
+	}
+	defer rows.Close()
+
+	return r.scanOrganizations(rows)
+}
+
+func (r *OrganizationRepository) AddMember(ctx context.Context, membership *organization.Membership) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, queryInsertOrganizationMember,
+		membership.OrganizationID(),
+		membership.UserID(),
+		membership.JoinedAt(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return organization.ErrAlreadyMember
+		}
+		return postgres.NewDBError("add organization member", err)
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, organizationID, userID uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteOrganizationMember, organizationID, userID)
+	if err != nil {
+		return postgres.NewDBError("remove organization member", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return organization.ErrNotMember
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) IsMember(ctx context.Context, organizationID, userID uuid.UUID) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	err := querier.QueryRow(ctx, queryExistsOrganizationMember, organizationID, userID).Scan(&exists)
+	if err != nil {
+		return false, postgres.NewDBError("check organization membership", err)
+	}
+
+	return exists, nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]*organization.Membership, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryListOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, postgres.NewDBError("list organization members", err)
+	}
+	defer rows.Close()
+
+	memberships := make([]*organization.Membership, 0)
+	for rows.Next() {
+		var memberOrganizationID, userID uuid.UUID
+		var joinedAt time.Time
+		if err := rows.Scan(&memberOrganizationID, &userID, &joinedAt); err != nil {
+			return nil, postgres.NewDBError("scan organization member row", err)
+		}
+		memberships = append(memberships, organization.ReconstructMembership(memberOrganizationID, userID, joinedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate organization member rows", err)
+	}
+
+	return memberships, nil
+}
+
+func (r *OrganizationRepository) scanOrganizations(rows pgx.Rows) ([]*organization.Organization, error) {
+	organizations := make([]*organization.Organization, 0)
+	for rows.Next() {
+		row := &organizationRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Slug,
+			&row.Description,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan organization row", err)
+		}
+
+		o, err := row.toDomain()
+		if err != nil {
+			return nil, postgres.NewDBError("convert organization row to domain", err)
+		}
+		organizations = append(organizations, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate organization rows", err)
+	}
+
+	return organizations, nil
+}
@@ -11,53 +11,64 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
 )
 
 const (
 	queryInsertPermission = `
-		INSERT INTO permissions (id, resource, action, description, is_system, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		INSERT INTO permissions (id, resource, action, description, is_system, created_at, updated_at, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 
 	queryUpdatePermission = `
 		UPDATE permissions
-		SET description = $2, updated_at = $3
-		WHERE id = $1`
+		SET description = $2, updated_at = $3, version = version + 1
+		WHERE id = $1 AND version = $4`
+
+	queryExistsPermissionByID = `
+		SELECT EXISTS(SELECT 1 FROM permissions WHERE id = $1)`
 
 	queryDeletePermission = `
-		DELETE FROM permissions WHERE id = $1`
+		DELETE FROM permissions WHERE id = $1 AND version = $2`
 
 	queryFindPermissionByID = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		WHERE id = $1`
 
 	queryFindPermissionByCode = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		WHERE resource = $1 AND action = $2`
 
 	queryFindPermissionByCodeString = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		WHERE CONCAT(resource, ':', action) = $1`
 
 	queryFindPermissionsByResource = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		WHERE resource = $1
 		ORDER BY action`
 
 	queryFindAllPermissions = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		ORDER BY resource, action`
 
 	queryFindPermissionsByIDs = `
-		SELECT id, resource, action, description, is_system, created_at, updated_at
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
 		FROM permissions
 		WHERE id = ANY($1)`
 
+	querySelectPermissions = `
+		SELECT id, resource, action, description, is_system, created_at, updated_at, version
+		FROM permissions`
+
+	queryCountPermissions = `
+		SELECT COUNT(*) FROM permissions`
+
 	queryExistsPermissionByCode = `
 		SELECT EXISTS(SELECT 1 FROM permissions WHERE resource = $1 AND action = $2)`
 )
@@ -70,6 +81,7 @@ type permissionRow struct {
 	IsSystem    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64
 }
 
 func (r *permissionRow) toDomain() (*permission.Permission, error) {
@@ -85,6 +97,7 @@ func (r *permissionRow) toDomain() (*permission.Permission, error) {
 		IsSystem:    r.IsSystem,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+		Version:     r.Version,
 	})
 }
 
@@ -98,6 +111,7 @@ func permissionToRow(p *permission.Permission) *permissionRow {
 		IsSystem:    p.IsSystem(),
 		CreatedAt:   p.CreatedAt(),
 		UpdatedAt:   p.UpdatedAt(),
+		Version:     p.Version(),
 	}
 }
 
@@ -121,6 +135,7 @@ func (r *PermissionRepository) Create(ctx context.Context, p *permission.Permiss
 		row.IsSystem,
 		row.CreatedAt,
 		row.UpdatedAt,
+		row.Version,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -140,27 +155,43 @@ func (r *PermissionRepository) Update(ctx context.Context, p *permission.Permiss
 		p.ID(),
 		p.Description(),
 		p.UpdatedAt(),
+		p.Version(),
 	)
 	if err != nil {
 		return postgres.NewDBError("update permission", err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := querier.QueryRow(ctx, queryExistsPermissionByID, p.ID()).Scan(&exists); err != nil {
+			return postgres.NewDBError("check permission exists", err)
+		}
+		if exists {
+			return permission.NewConcurrentModificationError(p.ID().String())
+		}
 		return permission.NewPermissionNotFoundError(p.ID().String())
 	}
+	p.AdvanceVersion()
 
 	return nil
 }
 
-func (r *PermissionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (r *PermissionRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
-	cmdTag, err := querier.Exec(ctx, queryDeletePermission, id)
+	cmdTag, err := querier.Exec(ctx, queryDeletePermission, id, version)
 	if err != nil {
 		return postgres.NewDBError("delete permission", err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := querier.QueryRow(ctx, queryExistsPermissionByID, id).Scan(&exists); err != nil {
+			return postgres.NewDBError("check permission exists", err)
+		}
+		if exists {
+			return permission.NewConcurrentModificationError(id.String())
+		}
 		return permission.NewPermissionNotFoundError(id.String())
 	}
 
@@ -179,6 +210,7 @@ func (r *PermissionRepository) FindByID(ctx context.Context, id uuid.UUID) (*per
 		&row.IsSystem,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -202,6 +234,7 @@ func (r *PermissionRepository) FindByCode(ctx context.Context, code permission.P
 		&row.IsSystem,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -225,6 +258,7 @@ func (r *PermissionRepository) FindByCodeString(ctx context.Context, code string
 		&row.IsSystem,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -256,6 +290,7 @@ func (r *PermissionRepository) FindByResource(ctx context.Context, resource perm
 			&row.IsSystem,
 			&row.CreatedAt,
 			&row.UpdatedAt,
+			&row.Version,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan permission row", err)
@@ -295,6 +330,7 @@ func (r *PermissionRepository) FindAll(ctx context.Context) ([]*permission.Permi
 			&row.IsSystem,
 			&row.CreatedAt,
 			&row.UpdatedAt,
+			&row.Version,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan permission row", err)
@@ -314,6 +350,77 @@ func (r *PermissionRepository) FindAll(ctx context.Context) ([]*permission.Permi
 	return permissions, nil
 }
 
+func (r *PermissionRepository) ListByCursor(ctx context.Context, resource *permission.Resource, page shared.CursorPage) (shared.CursorResult[*permission.Permission], error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+	if resource != nil {
+		where.Eq("resource", resource.String())
+	}
+
+	var total *int64
+	if page.WithTotal() {
+		whereClause, args := where.Build()
+		var count int64
+		if err := querier.QueryRow(ctx, queryCountPermissions+" "+whereClause, args...).Scan(&count); err != nil {
+			return shared.CursorResult[*permission.Permission]{}, postgres.NewDBError("count permissions", err)
+		}
+		total = &count
+	}
+
+	if err := permissionKeyset.Seek(where, page.Cursor()); err != nil {
+		return shared.CursorResult[*permission.Permission]{}, err
+	}
+	whereClause, args := where.Build()
+	query := querySelectPermissions + " " + whereClause + " " + permissionKeyset.OrderBy(page.IsBackward()).Build() + " " + permissionKeyset.Limit(page)
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return shared.CursorResult[*permission.Permission]{}, postgres.NewDBError("list permissions", err)
+	}
+	defer rows.Close()
+
+	permissions := make([]*permission.Permission, 0)
+	for rows.Next() {
+		row := &permissionRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.Resource,
+			&row.Action,
+			&row.Description,
+			&row.IsSystem,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+			&row.Version,
+		)
+		if err != nil {
+			return shared.CursorResult[*permission.Permission]{}, postgres.NewDBError("scan permission row", err)
+		}
+
+		p, err := row.toDomain()
+		if err != nil {
+			return shared.CursorResult[*permission.Permission]{}, postgres.NewDBError("convert permission row to domain", err)
+		}
+		permissions = append(permissions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return shared.CursorResult[*permission.Permission]{}, postgres.NewDBError("iterate permission rows", err)
+	}
+
+	result := postgres.PageKeyset(permissionKeyset, permissions, page, func(p *permission.Permission) []any {
+		return []any{p.Resource().String(), p.Action().String()}
+	})
+	result.Total = total
+	return result, nil
+}
+
+// permissionKeyset relies on resource and action being unique together.
+var permissionKeyset = postgres.NewKeyset(
+	postgres.KeysetColumn{Column: "resource", Direction: postgres.OrderAsc, Type: postgres.KeyText},
+	postgres.KeysetColumn{Column: "action", Direction: postgres.OrderAsc, Type: postgres.KeyText},
+)
+
 func (r *PermissionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*permission.Permission, error) {
 	if len(ids) == 0 {
 		return []*permission.Permission{}, nil
@@ -338,6 +445,7 @@ func (r *PermissionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) (
 			&row.IsSystem,
 			&row.CreatedAt,
 			&row.UpdatedAt,
+			&row.Version,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan permission row", err)
@@ -0,0 +1,48 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestPermissionRepository_Integration(t *testing.T) {
+	suite, cleanup := testutil.SetupIntegrationTest(t)
+	defer cleanup()
+
+	repository := NewPermissionRepository(suite.DatabasePool)
+
+	t.Run("Delete rejects a stale version", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		testPermission, err := permission.NewPermission(permission.NewPermissionParams{Resource: "reports", Action: "export"})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testPermission))
+
+		stale, err := repository.FindByID(context.Background(), testPermission.ID())
+		require.NoError(t, err)
+
+		current, err := repository.FindByID(context.Background(), testPermission.ID())
+		require.NoError(t, err)
+		current.UpdateDescription("Export reports as CSV")
+		require.NoError(t, repository.Update(context.Background(), current))
+
+		err = repository.Delete(context.Background(), stale.ID(), stale.Version())
+		assert.ErrorIs(t, err, permission.ErrConcurrentModification)
+
+		foundPermission, err := repository.FindByID(context.Background(), testPermission.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Export reports as CSV", foundPermission.Description())
+
+		require.NoError(t, repository.Delete(context.Background(), foundPermission.ID(), foundPermission.Version()))
+		err = repository.Delete(context.Background(), foundPermission.ID(), foundPermission.Version())
+		assert.ErrorIs(t, err, permission.ErrPermissionNotFound)
+	})
+}
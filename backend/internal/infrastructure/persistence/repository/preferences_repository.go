@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryUpsertPreferences = `
+		INSERT INTO user_preferences (user_id, locale, timezone, date_format, notify_account_updates, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE
+		SET locale = EXCLUDED.locale,
+			timezone = EXCLUDED.timezone,
+			date_format = EXCLUDED.date_format,
+			notify_account_updates = EXCLUDED.notify_account_updates,
+			updated_at = EXCLUDED.updated_at`
+
+	queryFindPreferencesByUserID = `
+		SELECT user_id, locale, timezone, date_format, notify_account_updates, updated_at
+		FROM user_preferences
+		WHERE user_id = $1`
+)
+
+type preferencesRow struct {
+	UserID               uuid.UUID
+	Locale               string
+	Timezone             string
+	DateFormat           string
+	NotifyAccountUpdates bool
+	UpdatedAt            time.Time
+}
+
+func (r *preferencesRow) toDomain() (*user.Preferences, error) {
+	return user.ReconstructPreferences(user.ReconstructPreferencesParams{
+		UserID:         r.UserID,
+		Locale:         r.Locale,
+		Timezone:       r.Timezone,
+		DateFormat:     r.DateFormat,
+		AccountUpdates: r.NotifyAccountUpdates,
+		UpdatedAt:      r.UpdatedAt,
+	})
+}
+
+type PreferencesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPreferencesRepository(pool *pgxpool.Pool) *PreferencesRepository {
+	return &PreferencesRepository{pool: pool}
+}
+
+func (r *PreferencesRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &preferencesRow{}
+	err := querier.QueryRow(ctx, queryFindPreferencesByUserID, userID).Scan(
+		&row.UserID,
+		&row.Locale,
+		&row.Timezone,
+		&row.DateFormat,
+		&row.NotifyAccountUpdates,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrPreferencesNotFound
+		}
+		return nil, postgres.NewDBError("find preferences", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *PreferencesRepository) Save(ctx context.Context, preferences *user.Preferences) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, queryUpsertPreferences,
+		preferences.UserID(),
+		preferences.Locale(),
+		preferences.Timezone(),
+		preferences.DateFormat().String(),
+		preferences.Notifications().AccountUpdates,
+		preferences.UpdatedAt(),
+	)
+	if err != nil {
+		return postgres.NewDBError("save preferences", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+var benchmarkPageSizes = []int{20, 50, 100}
+
+// queryCounter counts the statements sent through a pool.
+type queryCounter struct {
+	queries atomic.Int64
+}
+
+func (counter *queryCounter) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	counter.queries.Add(1)
+	return ctx
+}
+
+func (counter *queryCounter) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+func newCountingPool(t testing.TB, databaseURL string) (*pgxpool.Pool, *queryCounter) {
+	t.Helper()
+
+	config, err := pgxpool.ParseConfig(databaseURL)
+	require.NoError(t, err)
+
+	counter := &queryCounter{}
+	config.ConnConfig.Tracer = counter
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool, counter
+}
+
+// seedReadPath creates users with rolesPerUser roles each, roles with
+// permissionsPerRole permissions each, and one denied permission per user.
+func seedReadPath(t testing.TB, suite *testutil.IntegrationTestSuite, users, roles, rolesPerUser, permissionsPerRole int) {
+	t.Helper()
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO permissions (id, resource, action)
+		SELECT gen_random_uuid(), 'bench', 'action_' || n
+		FROM generate_series(1, $1) AS n`, permissionsPerRole*2)
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO roles (id, name, display_name, priority)
+		SELECT gen_random_uuid(), 'bench_role_' || n, 'Bench Role ' || n, n
+		FROM generate_series(1, $1) AS n`, roles)
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO role_permissions (role_id, permission_id, effect)
+		SELECT r.id, p.id, 'allow'
+		FROM roles r
+		CROSS JOIN LATERAL (
+			SELECT id FROM permissions ORDER BY md5(r.id::text || id::text) LIMIT $1
+		) p`, permissionsPerRole)
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO users (id, email, password_hash, full_name, status, created_at, updated_at)
+		SELECT gen_random_uuid(), 'bench' || n || '@example.com', '$2a$10$hashedpassword', 'Bench User ' || n, 'active',
+			NOW() - n * INTERVAL '1 minute', NOW()
+		FROM generate_series(1, $1) AS n`, users)
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO user_roles (user_id, role_id)
+		SELECT u.id, r.id
+		FROM users u
+		CROSS JOIN LATERAL (
+			SELECT id FROM roles ORDER BY md5(u.id::text || id::text) LIMIT $1
+		) r`, rolesPerUser)
+
+	suite.ExecuteSQL(t, `
+		INSERT INTO user_denied_permissions (user_id, permission_id)
+		SELECT u.id, (SELECT id FROM permissions ORDER BY md5(u.id::text || id::text) LIMIT 1)
+		FROM users u`)
+}
+
+func TestUserRepository_ListQueryCount(t *testing.T) {
+	suite, cleanup := testutil.SetupIntegrationTest(t)
+	defer cleanup()
+
+	seedReadPath(t, suite, 120, 10, 3, 5)
+	pool, counter := newCountingPool(t, suite.DatabaseURL)
+	repository := NewUserRepository(pool)
+
+	for _, size := range benchmarkPageSizes {
+		counter.queries.Store(0)
+
+		users, _, err := repository.List(context.Background(), user.Filter{}, shared.NewPagination(1, size))
+		require.NoError(t, err)
+		require.Len(t, users, size)
+		for _, u := range users {
+			assert.Len(t, u.RoleIDs(), 3)
+		}
+
+		// count, page, roles and denied permissions
+		assert.Equal(t, int64(4), counter.queries.Load(), "page size %d", size)
+	}
+}
+
+func BenchmarkUserRepository_List(b *testing.B) {
+	suite, cleanup := testutil.SetupIntegrationTest(b)
+	defer cleanup()
+
+	seedReadPath(b, suite, 5000, 20, 3, 10)
+	pool, counter := newCountingPool(b, suite.DatabaseURL)
+	repository := NewUserRepository(pool)
+
+	for _, size := range benchmarkPageSizes {
+		b.Run(fmt.Sprintf("offset/page=%d", size), func(b *testing.B) {
+			pagination := shared.NewPagination(2, size)
+			counter.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := repository.List(context.Background(), user.Filter{}, pagination); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+		})
+
+		b.Run(fmt.Sprintf("cursor/page=%d", size), func(b *testing.B) {
+			page, err := shared.NewCursorPage("", size, false)
+			require.NoError(b, err)
+			counter.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repository.ListByCursor(context.Background(), user.Filter{}, page); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
+
+func BenchmarkUserRepository_FindByRole(b *testing.B) {
+	suite, cleanup := testutil.SetupIntegrationTest(b)
+	defer cleanup()
+
+	seedReadPath(b, suite, 2000, 20, 3, 10)
+	pool, counter := newCountingPool(b, suite.DatabaseURL)
+	repository := NewUserRepository(pool)
+
+	roles, err := NewRoleRepository(pool).FindAll(context.Background())
+	require.NoError(b, err)
+	require.NotEmpty(b, roles)
+	roleID := roles[0].ID()
+
+	counter.queries.Store(0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repository.FindByRole(context.Background(), roleID); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+}
+
+func BenchmarkRoleRepository_ListByCursor(b *testing.B) {
+	suite, cleanup := testutil.SetupIntegrationTest(b)
+	defer cleanup()
+
+	seedReadPath(b, suite, 0, 200, 0, 25)
+	pool, counter := newCountingPool(b, suite.DatabaseURL)
+	repository := NewRoleRepository(pool)
+
+	for _, size := range benchmarkPageSizes {
+		b.Run(fmt.Sprintf("page=%d", size), func(b *testing.B) {
+			page, err := shared.NewCursorPage("", size, false)
+			require.NoError(b, err)
+			counter.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := repository.ListByCursor(context.Background(), role.Scope{AllOrganizations: true}, page); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(counter.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
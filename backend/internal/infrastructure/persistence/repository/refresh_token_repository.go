@@ -11,13 +11,14 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
 )
 
 const (
 	queryInsertRefreshToken = `
-		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
 
 	queryUpdateRefreshToken = `
 		UPDATE refresh_tokens
@@ -25,33 +26,40 @@ const (
 		WHERE id = $1`
 
 	queryFindRefreshTokenByID = `
-		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address
+		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id
 		FROM refresh_tokens
 		WHERE id = $1`
 
 	queryFindRefreshTokenByHash = `
-		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address
+		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id
 		FROM refresh_tokens
 		WHERE token_hash = $1`
 
 	queryFindRefreshTokensByUserID = `
-		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address
+		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id
 		FROM refresh_tokens
 		WHERE user_id = $1
 		ORDER BY created_at DESC`
 
 	queryFindActiveRefreshTokensByUserID = `
-		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address
+		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id
 		FROM refresh_tokens
 		WHERE user_id = $1 AND is_revoked = FALSE AND expires_at > NOW()
 		ORDER BY created_at DESC`
 
+	querySelectRefreshTokens = `
+		SELECT id, user_id, token_hash, expires_at, created_at, last_used_at, is_revoked, device_info, ip_address, organization_id
+		FROM refresh_tokens`
+
 	queryRevokeRefreshToken = `
 		UPDATE refresh_tokens SET is_revoked = TRUE WHERE id = $1`
 
 	queryRevokeAllRefreshTokensByUserID = `
 		UPDATE refresh_tokens SET is_revoked = TRUE WHERE user_id = $1 AND is_revoked = FALSE`
 
+	queryDeleteRefreshTokensByUserID = `
+		DELETE FROM refresh_tokens WHERE user_id = $1`
+
 	queryDeleteExpiredRefreshTokens = `
 		DELETE FROM refresh_tokens WHERE expires_at < NOW() AND is_revoked = TRUE`
 
@@ -60,15 +68,16 @@ const (
 )
 
 type refreshTokenRow struct {
-	ID         uuid.UUID
-	UserID     uuid.UUID
-	TokenHash  string
-	ExpiresAt  time.Time
-	CreatedAt  time.Time
-	LastUsedAt *time.Time
-	IsRevoked  bool
-	DeviceInfo []byte
-	IPAddress  net.IP
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	TokenHash      string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	LastUsedAt     *time.Time
+	IsRevoked      bool
+	DeviceInfo     []byte
+	IPAddress      net.IP
+	OrganizationID *uuid.UUID
 }
 
 func (r *refreshTokenRow) toDomain() *auth.RefreshToken {
@@ -81,15 +90,16 @@ func (r *refreshTokenRow) toDomain() *auth.RefreshToken {
 	}
 
 	return auth.ReconstructRefreshToken(auth.ReconstructRefreshTokenParams{
-		ID:         r.ID,
-		UserID:     r.UserID,
-		TokenHash:  r.TokenHash,
-		ExpiresAt:  r.ExpiresAt,
-		CreatedAt:  r.CreatedAt,
-		LastUsedAt: r.LastUsedAt,
-		IsRevoked:  r.IsRevoked,
-		DeviceInfo: deviceInfo,
-		IPAddress:  r.IPAddress,
+		ID:             r.ID,
+		UserID:         r.UserID,
+		TokenHash:      r.TokenHash,
+		ExpiresAt:      r.ExpiresAt,
+		CreatedAt:      r.CreatedAt,
+		LastUsedAt:     r.LastUsedAt,
+		IsRevoked:      r.IsRevoked,
+		DeviceInfo:     deviceInfo,
+		IPAddress:      r.IPAddress,
+		OrganizationID: r.OrganizationID,
 	})
 }
 
@@ -128,6 +138,7 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, token *auth.Refresh
 		token.IsRevoked(),
 		deviceInfoBytes,
 		ipAddress,
+		token.OrganizationID(),
 	)
 	if err != nil {
 		return postgres.NewDBError("create refresh token", err)
@@ -150,6 +161,7 @@ func (r *RefreshTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*a
 		&row.IsRevoked,
 		&row.DeviceInfo,
 		&row.IPAddress,
+		&row.OrganizationID,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -175,6 +187,7 @@ func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash
 		&row.IsRevoked,
 		&row.DeviceInfo,
 		&row.IPAddress,
+		&row.OrganizationID,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -208,6 +221,7 @@ func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.U
 			&row.IsRevoked,
 			&row.DeviceInfo,
 			&row.IPAddress,
+			&row.OrganizationID,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan refresh token row", err)
@@ -244,6 +258,7 @@ func (r *RefreshTokenRepository) FindActiveByUserID(ctx context.Context, userID
 			&row.IsRevoked,
 			&row.DeviceInfo,
 			&row.IPAddress,
+			&row.OrganizationID,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan refresh token row", err)
@@ -258,6 +273,71 @@ func (r *RefreshTokenRepository) FindActiveByUserID(ctx context.Context, userID
 	return tokens, nil
 }
 
+func (r *RefreshTokenRepository) ListActiveByCursor(ctx context.Context, userID uuid.UUID, page shared.CursorPage) (shared.CursorResult[*auth.RefreshToken], error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var total *int64
+	if page.WithTotal() {
+		var count int64
+		if err := querier.QueryRow(ctx, queryCountActiveRefreshTokensByUserID, userID).Scan(&count); err != nil {
+			return shared.CursorResult[*auth.RefreshToken]{}, postgres.NewDBError("count active refresh tokens", err)
+		}
+		total = &count
+	}
+
+	where := postgres.NewWhereClause().
+		Eq("user_id", userID).
+		AddCondition("is_revoked = FALSE").
+		AddCondition("expires_at > NOW()")
+	if err := sessionKeyset.Seek(where, page.Cursor()); err != nil {
+		return shared.CursorResult[*auth.RefreshToken]{}, err
+	}
+	whereClause, args := where.Build()
+	query := querySelectRefreshTokens + " " + whereClause + " " + sessionKeyset.OrderBy(page.IsBackward()).Build() + " " + sessionKeyset.Limit(page)
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return shared.CursorResult[*auth.RefreshToken]{}, postgres.NewDBError("list active refresh tokens", err)
+	}
+	defer rows.Close()
+
+	tokens := make([]*auth.RefreshToken, 0)
+	for rows.Next() {
+		row := &refreshTokenRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.UserID,
+			&row.TokenHash,
+			&row.ExpiresAt,
+			&row.CreatedAt,
+			&row.LastUsedAt,
+			&row.IsRevoked,
+			&row.DeviceInfo,
+			&row.IPAddress,
+			&row.OrganizationID,
+		)
+		if err != nil {
+			return shared.CursorResult[*auth.RefreshToken]{}, postgres.NewDBError("scan refresh token row", err)
+		}
+		tokens = append(tokens, row.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return shared.CursorResult[*auth.RefreshToken]{}, postgres.NewDBError("iterate refresh token rows", err)
+	}
+
+	result := postgres.PageKeyset(sessionKeyset, tokens, page, func(token *auth.RefreshToken) []any {
+		return []any{token.CreatedAt(), token.ID()}
+	})
+	result.Total = total
+	return result, nil
+}
+
+var sessionKeyset = postgres.NewKeyset(
+	postgres.KeysetColumn{Column: "created_at", Direction: postgres.OrderDesc, Type: postgres.KeyTime},
+	postgres.KeysetColumn{Column: "id", Direction: postgres.OrderDesc, Type: postgres.KeyUUID},
+)
+
 func (r *RefreshTokenRepository) Update(ctx context.Context, token *auth.RefreshToken) error {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
@@ -303,6 +383,17 @@ func (r *RefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID u
 	return nil
 }
 
+func (r *RefreshTokenRepository) DeleteAllByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteRefreshTokensByUserID, userID)
+	if err != nil {
+		return 0, postgres.NewDBError("delete refresh tokens by user id", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
 func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
@@ -10,114 +10,148 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
 )
 
 const (
 	queryInsertRole = `
-		INSERT INTO roles (id, name, display_name, description, is_system, is_default, priority, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		INSERT INTO roles (id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
 
 	queryUpdateRole = `
 		UPDATE roles
-		SET display_name = $2, description = $3, is_default = $4, priority = $5, updated_at = $6
-		WHERE id = $1`
+		SET display_name = $2, description = $3, is_default = $4, priority = $5, updated_at = $6, version = version + 1
+		WHERE id = $1 AND version = $7`
+
+	queryExistsRoleByID = `
+		SELECT EXISTS(SELECT 1 FROM roles WHERE id = $1)`
 
 	queryDeleteRole = `
-		DELETE FROM roles WHERE id = $1`
+		DELETE FROM roles WHERE id = $1 AND version = $2`
 
 	queryFindRoleByID = `
-		SELECT id, name, display_name, description, is_system, is_default, priority, created_at, updated_at
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
 		FROM roles
 		WHERE id = $1`
 
 	queryFindRoleByName = `
-		SELECT id, name, display_name, description, is_system, is_default, priority, created_at, updated_at
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
 		FROM roles
-		WHERE name = $1`
+		WHERE name = $1 AND organization_id IS NULL`
 
 	queryFindRolesByIDs = `
-		SELECT id, name, display_name, description, is_system, is_default, priority, created_at, updated_at
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
 		FROM roles
 		WHERE id = ANY($1)`
 
 	queryFindAllRoles = `
-		SELECT id, name, display_name, description, is_system, is_default, priority, created_at, updated_at
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
+		FROM roles
+		ORDER BY priority DESC, name`
+
+	queryFindGlobalRoles = `
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
 		FROM roles
+		WHERE organization_id IS NULL
 		ORDER BY priority DESC, name`
 
+	querySelectRoles = `
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
+		FROM roles`
+
+	queryCountRoles = `
+		SELECT COUNT(*) FROM roles`
+
 	queryFindDefaultRole = `
-		SELECT id, name, display_name, description, is_system, is_default, priority, created_at, updated_at
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
 		FROM roles
 		WHERE is_default = TRUE
 		LIMIT 1`
 
 	queryExistsRoleByName = `
-		SELECT EXISTS(SELECT 1 FROM roles WHERE name = $1)`
+		SELECT EXISTS(SELECT 1 FROM roles WHERE name = $1 AND organization_id IS NULL)`
+
+	queryExistsRoleByNameInOrganization = `
+		SELECT EXISTS(SELECT 1 FROM roles WHERE name = $1 AND organization_id = $2)`
+
+	queryFindRolesForOrganization = `
+		SELECT id, organization_id, name, display_name, description, is_system, is_default, priority, created_at, updated_at, version
+		FROM roles
+		WHERE organization_id IS NULL OR organization_id = $1
+		ORDER BY priority DESC, name`
 
 	queryFindRolesByPermission = `
-		SELECT r.id, r.name, r.display_name, r.description, r.is_system, r.is_default, r.priority, r.created_at, r.updated_at
+		SELECT r.id, r.organization_id, r.name, r.display_name, r.description, r.is_system, r.is_default, r.priority, r.created_at, r.updated_at, r.version
 		FROM roles r
 		INNER JOIN role_permissions rp ON r.id = rp.role_id
-		WHERE rp.permission_id = $1
+		WHERE rp.permission_id = $1 AND rp.effect = 'allow'
 		ORDER BY r.priority DESC, r.name`
 
-	queryFindRolePermissions = `
-		SELECT permission_id FROM role_permissions WHERE role_id = $1`
+	queryFindPermissionsByRoles = `
+		SELECT role_id, permission_id, effect FROM role_permissions WHERE role_id = ANY($1)`
 
 	queryDeleteRolePermissions = `
 		DELETE FROM role_permissions WHERE role_id = $1`
 
 	queryInsertRolePermission = `
-		INSERT INTO role_permissions (role_id, permission_id, created_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO role_permissions (role_id, permission_id, effect, created_at)
+		VALUES ($1, $2, $3, $4)
 		ON CONFLICT (role_id, permission_id) DO NOTHING`
 )
 
 type roleRow struct {
-	ID          uuid.UUID
-	Name        string
-	DisplayName string
-	Description *string
-	IsSystem    bool
-	IsDefault   bool
-	Priority    int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID             uuid.UUID
+	OrganizationID *uuid.UUID
+	Name           string
+	DisplayName    string
+	Description    *string
+	IsSystem       bool
+	IsDefault      bool
+	Priority       int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Version        int64
 }
 
-func (r *roleRow) toDomain(permissionIDs []uuid.UUID) (*role.Role, error) {
+func (r *roleRow) toDomain(permissionIDs, deniedPermissionIDs []uuid.UUID) (*role.Role, error) {
 	description := ""
 	if r.Description != nil {
 		description = *r.Description
 	}
 	return role.ReconstructRole(role.ReconstructRoleParams{
-		ID:            r.ID,
-		Name:          r.Name,
-		DisplayName:   r.DisplayName,
-		Description:   description,
-		PermissionIDs: permissionIDs,
-		IsSystem:      r.IsSystem,
-		IsDefault:     r.IsDefault,
-		Priority:      r.Priority,
-		CreatedAt:     r.CreatedAt,
-		UpdatedAt:     r.UpdatedAt,
+		ID:                  r.ID,
+		OrganizationID:      r.OrganizationID,
+		Name:                r.Name,
+		DisplayName:         r.DisplayName,
+		Description:         description,
+		PermissionIDs:       permissionIDs,
+		DeniedPermissionIDs: deniedPermissionIDs,
+		IsSystem:            r.IsSystem,
+		IsDefault:           r.IsDefault,
+		Priority:            r.Priority,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+		Version:             r.Version,
 	})
 }
 
 func roleToRow(r *role.Role) *roleRow {
 	desc := r.Description()
 	return &roleRow{
-		ID:          r.ID(),
-		Name:        r.Name(),
-		DisplayName: r.DisplayName(),
-		Description: &desc,
-		IsSystem:    r.IsSystem(),
-		IsDefault:   r.IsDefault(),
-		Priority:    r.Priority(),
-		CreatedAt:   r.CreatedAt(),
-		UpdatedAt:   r.UpdatedAt(),
+		ID:             r.ID(),
+		OrganizationID: r.OrganizationID(),
+		Name:           r.Name(),
+		DisplayName:    r.DisplayName(),
+		Description:    &desc,
+		IsSystem:       r.IsSystem(),
+		IsDefault:      r.IsDefault(),
+		Priority:       r.Priority(),
+		CreatedAt:      r.CreatedAt(),
+		UpdatedAt:      r.UpdatedAt(),
+		Version:        r.Version(),
 	}
 }
 
@@ -135,6 +169,7 @@ func (r *RoleRepository) Create(ctx context.Context, rl *role.Role) error {
 
 	_, err := querier.Exec(ctx, queryInsertRole,
 		row.ID,
+		row.OrganizationID,
 		row.Name,
 		row.DisplayName,
 		row.Description,
@@ -143,6 +178,7 @@ func (r *RoleRepository) Create(ctx context.Context, rl *role.Role) error {
 		row.Priority,
 		row.CreatedAt,
 		row.UpdatedAt,
+		row.Version,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -152,7 +188,7 @@ func (r *RoleRepository) Create(ctx context.Context, rl *role.Role) error {
 		return postgres.NewDBError("create role", err)
 	}
 
-	if err := r.syncPermissions(ctx, querier, rl.ID(), rl.PermissionIDs()); err != nil {
+	if err := r.syncPermissions(ctx, querier, rl); err != nil {
 		return err
 	}
 
@@ -170,31 +206,47 @@ func (r *RoleRepository) Update(ctx context.Context, rl *role.Role) error {
 		row.IsDefault,
 		row.Priority,
 		row.UpdatedAt,
+		row.Version,
 	)
 	if err != nil {
 		return postgres.NewDBError("update role", err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := querier.QueryRow(ctx, queryExistsRoleByID, row.ID).Scan(&exists); err != nil {
+			return postgres.NewDBError("check role exists", err)
+		}
+		if exists {
+			return role.NewConcurrentModificationError(row.ID.String())
+		}
 		return role.NewRoleNotFoundError(row.ID.String())
 	}
+	rl.AdvanceVersion()
 
-	if err := r.syncPermissions(ctx, querier, rl.ID(), rl.PermissionIDs()); err != nil {
+	if err := r.syncPermissions(ctx, querier, rl); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *RoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (r *RoleRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
-	cmdTag, err := querier.Exec(ctx, queryDeleteRole, id)
+	cmdTag, err := querier.Exec(ctx, queryDeleteRole, id, version)
 	if err != nil {
 		return postgres.NewDBError("delete role", err)
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := querier.QueryRow(ctx, queryExistsRoleByID, id).Scan(&exists); err != nil {
+			return postgres.NewDBError("check role exists", err)
+		}
+		if exists {
+			return role.NewConcurrentModificationError(id.String())
+		}
 		return role.NewRoleNotFoundError(id.String())
 	}
 
@@ -207,6 +259,7 @@ func (r *RoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*role.Role
 	row := &roleRow{}
 	err := querier.QueryRow(ctx, queryFindRoleByID, id).Scan(
 		&row.ID,
+		&row.OrganizationID,
 		&row.Name,
 		&row.DisplayName,
 		&row.Description,
@@ -215,6 +268,7 @@ func (r *RoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*role.Role
 		&row.Priority,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -223,12 +277,12 @@ func (r *RoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*role.Role
 		return nil, postgres.NewDBError("find role by id", err)
 	}
 
-	permissionIDs, err := r.loadPermissionIDs(ctx, querier, id)
+	roles, err := r.withPermissions(ctx, querier, []*roleRow{row})
 	if err != nil {
 		return nil, err
 	}
 
-	return row.toDomain(permissionIDs)
+	return roles[0], nil
 }
 
 func (r *RoleRepository) FindByName(ctx context.Context, name string) (*role.Role, error) {
@@ -237,6 +291,7 @@ func (r *RoleRepository) FindByName(ctx context.Context, name string) (*role.Rol
 	row := &roleRow{}
 	err := querier.QueryRow(ctx, queryFindRoleByName, name).Scan(
 		&row.ID,
+		&row.OrganizationID,
 		&row.Name,
 		&row.DisplayName,
 		&row.Description,
@@ -245,6 +300,7 @@ func (r *RoleRepository) FindByName(ctx context.Context, name string) (*role.Rol
 		&row.Priority,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -253,12 +309,12 @@ func (r *RoleRepository) FindByName(ctx context.Context, name string) (*role.Rol
 		return nil, postgres.NewDBError("find role by name", err)
 	}
 
-	permissionIDs, err := r.loadPermissionIDs(ctx, querier, row.ID)
+	roles, err := r.withPermissions(ctx, querier, []*roleRow{row})
 	if err != nil {
 		return nil, err
 	}
 
-	return row.toDomain(permissionIDs)
+	return roles[0], nil
 }
 
 func (r *RoleRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*role.Role, error) {
@@ -274,95 +330,116 @@ func (r *RoleRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*rol
 	}
 	defer rows.Close()
 
-	roles := make([]*role.Role, 0)
-	for rows.Next() {
-		row := &roleRow{}
-		err := rows.Scan(
-			&row.ID,
-			&row.Name,
-			&row.DisplayName,
-			&row.Description,
-			&row.IsSystem,
-			&row.IsDefault,
-			&row.Priority,
-			&row.CreatedAt,
-			&row.UpdatedAt,
-		)
-		if err != nil {
-			return nil, postgres.NewDBError("scan role row", err)
-		}
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
 
-		permissionIDs, err := r.loadPermissionIDs(ctx, querier, row.ID)
-		if err != nil {
-			return nil, err
-		}
+	return r.withPermissions(ctx, querier, roleRows)
+}
 
-		rl, err := row.toDomain(permissionIDs)
-		if err != nil {
-			return nil, postgres.NewDBError("convert role row to domain", err)
-		}
-		roles = append(roles, rl)
+func (r *RoleRepository) FindAll(ctx context.Context) ([]*role.Role, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindAllRoles)
+	if err != nil {
+		return nil, postgres.NewDBError("find all roles", err)
 	}
+	defer rows.Close()
 
-	if err := rows.Err(); err != nil {
-		return nil, postgres.NewDBError("iterate role rows", err)
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return nil, err
 	}
+	rows.Close()
 
-	return roles, nil
+	return r.withPermissions(ctx, querier, roleRows)
 }
 
-func (r *RoleRepository) FindAll(ctx context.Context) ([]*role.Role, error) {
+func (r *RoleRepository) FindGlobal(ctx context.Context) ([]*role.Role, error) {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
-	rows, err := querier.Query(ctx, queryFindAllRoles)
+	rows, err := querier.Query(ctx, queryFindGlobalRoles)
 	if err != nil {
-		return nil, postgres.NewDBError("find all roles", err)
+		return nil, postgres.NewDBError("find global roles", err)
 	}
 	defer rows.Close()
 
-	roles := make([]*role.Role, 0)
-	for rows.Next() {
-		row := &roleRow{}
-		err := rows.Scan(
-			&row.ID,
-			&row.Name,
-			&row.DisplayName,
-			&row.Description,
-			&row.IsSystem,
-			&row.IsDefault,
-			&row.Priority,
-			&row.CreatedAt,
-			&row.UpdatedAt,
-		)
-		if err != nil {
-			return nil, postgres.NewDBError("scan role row", err)
-		}
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
 
-		permissionIDs, err := r.loadPermissionIDs(ctx, querier, row.ID)
-		if err != nil {
-			return nil, err
-		}
+	return r.withPermissions(ctx, querier, roleRows)
+}
 
-		rl, err := row.toDomain(permissionIDs)
-		if err != nil {
-			return nil, postgres.NewDBError("convert role row to domain", err)
+func (r *RoleRepository) ListByCursor(ctx context.Context, scope role.Scope, page shared.CursorPage) (shared.CursorResult[*role.Role], error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where := postgres.NewWhereClause()
+	switch {
+	case scope.AllOrganizations:
+	case scope.OrganizationID != nil:
+		where.AddCondition("(organization_id IS NULL OR organization_id = $%d)", *scope.OrganizationID)
+	default:
+		where.IsNull("organization_id")
+	}
+
+	var total *int64
+	if page.WithTotal() {
+		whereClause, args := where.Build()
+		var count int64
+		if err := querier.QueryRow(ctx, queryCountRoles+" "+whereClause, args...).Scan(&count); err != nil {
+			return shared.CursorResult[*role.Role]{}, postgres.NewDBError("count roles", err)
 		}
-		roles = append(roles, rl)
+		total = &count
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, postgres.NewDBError("iterate role rows", err)
+	if err := roleKeyset.Seek(where, page.Cursor()); err != nil {
+		return shared.CursorResult[*role.Role]{}, err
 	}
+	whereClause, args := where.Build()
+	query := querySelectRoles + " " + whereClause + " " + roleKeyset.OrderBy(page.IsBackward()).Build() + " " + roleKeyset.Limit(page)
 
-	return roles, nil
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return shared.CursorResult[*role.Role]{}, postgres.NewDBError("list roles", err)
+	}
+	defer rows.Close()
+
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return shared.CursorResult[*role.Role]{}, err
+	}
+	rows.Close()
+
+	roles, err := r.withPermissions(ctx, querier, roleRows)
+	if err != nil {
+		return shared.CursorResult[*role.Role]{}, err
+	}
+
+	result := postgres.PageKeyset(roleKeyset, roles, page, func(rl *role.Role) []any {
+		return []any{rl.Priority(), rl.Name(), rl.ID()}
+	})
+	result.Total = total
+	return result, nil
 }
 
+var roleKeyset = postgres.NewKeyset(
+	postgres.KeysetColumn{Column: "priority", Direction: postgres.OrderDesc, Type: postgres.KeyInt},
+	postgres.KeysetColumn{Column: "name", Direction: postgres.OrderAsc, Type: postgres.KeyText},
+	postgres.KeysetColumn{Column: "id", Direction: postgres.OrderAsc, Type: postgres.KeyUUID},
+)
+
 func (r *RoleRepository) FindDefault(ctx context.Context) (*role.Role, error) {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
 	row := &roleRow{}
 	err := querier.QueryRow(ctx, queryFindDefaultRole).Scan(
 		&row.ID,
+		&row.OrganizationID,
 		&row.Name,
 		&row.DisplayName,
 		&row.Description,
@@ -371,6 +448,7 @@ func (r *RoleRepository) FindDefault(ctx context.Context) (*role.Role, error) {
 		&row.Priority,
 		&row.CreatedAt,
 		&row.UpdatedAt,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -379,12 +457,12 @@ func (r *RoleRepository) FindDefault(ctx context.Context) (*role.Role, error) {
 		return nil, postgres.NewDBError("find default role", err)
 	}
 
-	permissionIDs, err := r.loadPermissionIDs(ctx, querier, row.ID)
+	roles, err := r.withPermissions(ctx, querier, []*roleRow{row})
 	if err != nil {
 		return nil, err
 	}
 
-	return row.toDomain(permissionIDs)
+	return roles[0], nil
 }
 
 func (r *RoleRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
@@ -408,11 +486,52 @@ func (r *RoleRepository) FindByPermission(ctx context.Context, permissionID uuid
 	}
 	defer rows.Close()
 
-	roles := make([]*role.Role, 0)
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withPermissions(ctx, querier, roleRows)
+}
+
+func (r *RoleRepository) FindAllForOrganization(ctx context.Context, organizationID uuid.UUID) ([]*role.Role, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindRolesForOrganization, organizationID)
+	if err != nil {
+		return nil, postgres.NewDBError("find roles for organization", err)
+	}
+	defer rows.Close()
+
+	roleRows, err := scanRoleRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withPermissions(ctx, querier, roleRows)
+}
+
+func (r *RoleRepository) ExistsByNameInOrganization(ctx context.Context, name string, organizationID uuid.UUID) (bool, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	var exists bool
+	err := querier.QueryRow(ctx, queryExistsRoleByNameInOrganization, name, organizationID).Scan(&exists)
+	if err != nil {
+		return false, postgres.NewDBError("check role exists by name in organization", err)
+	}
+
+	return exists, nil
+}
+
+func scanRoleRows(rows pgx.Rows) ([]*roleRow, error) {
+	roleRows := make([]*roleRow, 0)
 	for rows.Next() {
 		row := &roleRow{}
 		err := rows.Scan(
 			&row.ID,
+			&row.OrganizationID,
 			&row.Name,
 			&row.DisplayName,
 			&row.Description,
@@ -421,66 +540,104 @@ func (r *RoleRepository) FindByPermission(ctx context.Context, permissionID uuid
 			&row.Priority,
 			&row.CreatedAt,
 			&row.UpdatedAt,
+			&row.Version,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan role row", err)
 		}
+		roleRows = append(roleRows, row)
+	}
 
-		permissionIDs, err := r.loadPermissionIDs(ctx, querier, row.ID)
-		if err != nil {
-			return nil, err
-		}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate role rows", err)
+	}
+
+	return roleRows, nil
+}
 
-		rl, err := row.toDomain(permissionIDs)
+// withPermissions loads the granted and denied permission IDs of all the
+// roles in a single query.
+func (r *RoleRepository) withPermissions(ctx context.Context, querier postgres.Querier, roleRows []*roleRow) ([]*role.Role, error) {
+	roles := make([]*role.Role, 0, len(roleRows))
+	if len(roleRows) == 0 {
+		return roles, nil
+	}
+
+	roleIDs := make([]uuid.UUID, len(roleRows))
+	for i, row := range roleRows {
+		roleIDs[i] = row.ID
+	}
+
+	permissionIDs, deniedPermissionIDs, err := r.loadPermissionIDs(ctx, querier, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range roleRows {
+		rl, err := row.toDomain(permissionIDs[row.ID], deniedPermissionIDs[row.ID])
 		if err != nil {
 			return nil, postgres.NewDBError("convert role row to domain", err)
 		}
 		roles = append(roles, rl)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, postgres.NewDBError("iterate role rows", err)
-	}
-
 	return roles, nil
 }
 
-func (r *RoleRepository) loadPermissionIDs(ctx context.Context, querier postgres.Querier, roleID uuid.UUID) ([]uuid.UUID, error) {
-	rows, err := querier.Query(ctx, queryFindRolePermissions, roleID)
+func (r *RoleRepository) loadPermissionIDs(ctx context.Context, querier postgres.Querier, roleIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := querier.Query(ctx, queryFindPermissionsByRoles, roleIDs)
 	if err != nil {
-		return nil, postgres.NewDBError("load role permissions", err)
+		return nil, nil, postgres.NewDBError("load role permissions", err)
 	}
 	defer rows.Close()
 
-	permissionIDs := make([]uuid.UUID, 0)
+	permissionIDs := make(map[uuid.UUID][]uuid.UUID, len(roleIDs))
+	deniedPermissionIDs := make(map[uuid.UUID][]uuid.UUID, len(roleIDs))
+	for _, roleID := range roleIDs {
+		permissionIDs[roleID] = make([]uuid.UUID, 0)
+		deniedPermissionIDs[roleID] = make([]uuid.UUID, 0)
+	}
+
 	for rows.Next() {
-		var permissionID uuid.UUID
-		if err := rows.Scan(&permissionID); err != nil {
-			return nil, postgres.NewDBError("scan permission id", err)
+		var roleID, permissionID uuid.UUID
+		var effect string
+		if err := rows.Scan(&roleID, &permissionID, &effect); err != nil {
+			return nil, nil, postgres.NewDBError("scan permission id", err)
+		}
+		if permission.Effect(effect) == permission.EffectDeny {
+			deniedPermissionIDs[roleID] = append(deniedPermissionIDs[roleID], permissionID)
+			continue
 		}
-		permissionIDs = append(permissionIDs, permissionID)
+		permissionIDs[roleID] = append(permissionIDs[roleID], permissionID)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, postgres.NewDBError("iterate permission ids", err)
+		return nil, nil, postgres.NewDBError("iterate permission ids", err)
 	}
 
-	return permissionIDs, nil
+	return permissionIDs, deniedPermissionIDs, nil
 }
 
-func (r *RoleRepository) syncPermissions(ctx context.Context, querier postgres.Querier, roleID uuid.UUID, permissionIDs []uuid.UUID) error {
-	_, err := querier.Exec(ctx, queryDeleteRolePermissions, roleID)
+func (r *RoleRepository) syncPermissions(ctx context.Context, querier postgres.Querier, rl *role.Role) error {
+	_, err := querier.Exec(ctx, queryDeleteRolePermissions, rl.ID())
 	if err != nil {
 		return postgres.NewDBError("delete role permissions", err)
 	}
 
 	now := time.Now().UTC()
-	for _, permissionID := range permissionIDs {
-		_, err := querier.Exec(ctx, queryInsertRolePermission, roleID, permissionID, now)
+	for _, permissionID := range rl.PermissionIDs() {
+		_, err := querier.Exec(ctx, queryInsertRolePermission, rl.ID(), permissionID, permission.EffectAllow.String(), now)
 		if err != nil {
 			return postgres.NewDBError("insert role permission", err)
 		}
 	}
 
+	for _, permissionID := range rl.DeniedPermissionIDs() {
+		_, err := querier.Exec(ctx, queryInsertRolePermission, rl.ID(), permissionID, permission.EffectDeny.String(), now)
+		if err != nil {
+			return postgres.NewDBError("insert role permission deny", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,48 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestRoleRepository_Integration(t *testing.T) {
+	suite, cleanup := testutil.SetupIntegrationTest(t)
+	defer cleanup()
+
+	repository := NewRoleRepository(suite.DatabasePool)
+
+	t.Run("Delete rejects a stale version", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		testRole, err := role.NewRole(role.NewRoleParams{Name: "editor", DisplayName: "Editor"})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testRole))
+
+		stale, err := repository.FindByID(context.Background(), testRole.ID())
+		require.NoError(t, err)
+
+		current, err := repository.FindByID(context.Background(), testRole.ID())
+		require.NoError(t, err)
+		require.NoError(t, current.UpdateDetails("Senior Editor", "Edits everything"))
+		require.NoError(t, repository.Update(context.Background(), current))
+
+		err = repository.Delete(context.Background(), stale.ID(), stale.Version())
+		assert.ErrorIs(t, err, role.ErrConcurrentModification)
+
+		foundRole, err := repository.FindByID(context.Background(), testRole.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Senior Editor", foundRole.DisplayName())
+
+		require.NoError(t, repository.Delete(context.Background(), foundRole.ID(), foundRole.Version()))
+		err = repository.Delete(context.Background(), foundRole.ID(), foundRole.Version())
+		assert.ErrorIs(t, err, role.ErrRoleNotFound)
+	})
+}
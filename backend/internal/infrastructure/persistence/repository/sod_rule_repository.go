@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertSoDRule = `
+		INSERT INTO sod_rules (id, name, description, rule_type, max_holders, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	queryUpdateSoDRule = `
+		UPDATE sod_rules
+		SET name = $2, description = $3, max_holders = $4, updated_at = $5
+		WHERE id = $1`
+
+	queryDeleteSoDRule = `
+		DELETE FROM sod_rules WHERE id = $1`
+
+	queryFindSoDRuleByID = `
+		SELECT id, name, description, rule_type, max_holders, created_at, updated_at
+		FROM sod_rules
+		WHERE id = $1`
+
+	queryFindAllSoDRules = `
+		SELECT id, name, description, rule_type, max_holders, created_at, updated_at
+		FROM sod_rules
+		ORDER BY name`
+
+	queryFindSoDRulesByRoles = `
+		SELECT DISTINCT r.id, r.name, r.description, r.rule_type, r.max_holders, r.created_at, r.updated_at
+		FROM sod_rules r
+		INNER JOIN sod_rule_roles rr ON r.id = rr.rule_id
+		WHERE rr.role_id = ANY($1)
+		ORDER BY r.name`
+
+	queryFindSoDRuleRoles = `
+		SELECT role_id FROM sod_rule_roles WHERE rule_id = $1`
+
+	queryDeleteSoDRuleRoles = `
+		DELETE FROM sod_rule_roles WHERE rule_id = $1`
+
+	queryInsertSoDRuleRole = `
+		INSERT INTO sod_rule_roles (rule_id, role_id)
+		VALUES ($1, $2)
+		ON CONFLICT (rule_id, role_id) DO NOTHING`
+)
+
+type sodRuleRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	RuleType    string
+	MaxHolders  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (r *sodRuleRow) toDomain(roleIDs []uuid.UUID) *sod.Rule {
+	description := ""
+	if r.Description != nil {
+		description = *r.Description
+	}
+	return sod.ReconstructRule(sod.ReconstructRuleParams{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: description,
+		Type:        sod.RuleType(r.RuleType),
+		RoleIDs:     roleIDs,
+		MaxHolders:  r.MaxHolders,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	})
+}
+
+func sodRuleToRow(rule *sod.Rule) *sodRuleRow {
+	description := rule.Description()
+	return &sodRuleRow{
+		ID:          rule.ID(),
+		Name:        rule.Name(),
+		Description: &description,
+		RuleType:    rule.Type().String(),
+		MaxHolders:  rule.MaxHolders(),
+		CreatedAt:   rule.CreatedAt(),
+		UpdatedAt:   rule.UpdatedAt(),
+	}
+}
+
+type SoDRuleRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSoDRuleRepository(pool *pgxpool.Pool) *SoDRuleRepository {
+	return &SoDRuleRepository{pool: pool}
+}
+
+func (r *SoDRuleRepository) Create(ctx context.Context, rule *sod.Rule) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := sodRuleToRow(rule)
+
+	_, err := querier.Exec(ctx, queryInsertSoDRule,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.RuleType,
+		row.MaxHolders,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return sod.NewRuleNameExistsError(row.Name)
+		}
+		return postgres.NewDBError("create sod rule", err)
+	}
+
+	return r.syncRoles(ctx, querier, rule.ID(), rule.RoleIDs())
+}
+
+func (r *SoDRuleRepository) Update(ctx context.Context, rule *sod.Rule) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row := sodRuleToRow(rule)
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateSoDRule,
+		row.ID,
+		row.Name,
+		row.Description,
+		row.MaxHolders,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return sod.NewRuleNameExistsError(row.Name)
+		}
+		return postgres.NewDBError("update sod rule", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return sod.NewRuleNotFoundError(row.ID.String())
+	}
+
+	return r.syncRoles(ctx, querier, rule.ID(), rule.RoleIDs())
+}
+
+func (r *SoDRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryDeleteSoDRule, id)
+	if err != nil {
+		return postgres.NewDBError("delete sod rule", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return sod.NewRuleNotFoundError(id.String())
+	}
+
+	return nil
+}
+
+func (r *SoDRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*sod.Rule, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &sodRuleRow{}
+	err := querier.QueryRow(ctx, queryFindSoDRuleByID, id).Scan(
+		&row.ID,
+		&row.Name,
+		&row.Description,
+		&row.RuleType,
+		&row.MaxHolders,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sod.NewRuleNotFoundError(id.String())
+		}
+		return nil, postgres.NewDBError("find sod rule by id", err)
+	}
+
+	roleIDs, err := r.loadRoleIDs(ctx, querier, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toDomain(roleIDs), nil
+}
+
+func (r *SoDRuleRepository) FindAll(ctx context.Context) ([]*sod.Rule, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindAllSoDRules)
+	if err != nil {
+		return nil, postgres.NewDBError("find sod rules", err)
+	}
+	defer rows.Close()
+
+	return r.scanRules(ctx, querier, rows)
+}
+
+func (r *SoDRuleRepository) FindByRoles(ctx context.Context, roleIDs []uuid.UUID) ([]*sod.Rule, error) {
+	if len(roleIDs) == 0 {
+		return []*sod.Rule{}, nil
+	}
+
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindSoDRulesByRoles, roleIDs)
+	if err != nil {
+		return nil, postgres.NewDBError("find sod rules by roles", err)
+	}
+	defer rows.Close()
+
+	return r.scanRules(ctx, querier, rows)
+}
+
+func (r *SoDRuleRepository) scanRules(ctx context.Context, querier postgres.Querier, rows pgx.Rows) ([]*sod.Rule, error) {
+	ruleRows := make([]*sodRuleRow, 0)
+	for rows.Next() {
+		row := &sodRuleRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Description,
+			&row.RuleType,
+			&row.MaxHolders,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan sod rule row", err)
+		}
+		ruleRows = append(ruleRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate sod rule rows", err)
+	}
+	rows.Close()
+
+	rules := make([]*sod.Rule, 0, len(ruleRows))
+	for _, row := range ruleRows {
+		roleIDs, err := r.loadRoleIDs(ctx, querier, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, row.toDomain(roleIDs))
+	}
+
+	return rules, nil
+}
+
+func (r *SoDRuleRepository) loadRoleIDs(ctx context.Context, querier postgres.Querier, ruleID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := querier.Query(ctx, queryFindSoDRuleRoles, ruleID)
+	if err != nil {
+		return nil, postgres.NewDBError("load sod rule roles", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDs(rows, "sod rule role id")
+}
+
+func (r *SoDRuleRepository) syncRoles(ctx context.Context, querier postgres.Querier, ruleID uuid.UUID, roleIDs []uuid.UUID) error {
+	_, err := querier.Exec(ctx, queryDeleteSoDRuleRoles, ruleID)
+	if err != nil {
+		return postgres.NewDBError("delete sod rule roles", err)
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := querier.Exec(ctx, queryInsertSoDRuleRole, ruleID, roleID)
+		if err != nil {
+			return postgres.NewDBError("insert sod rule role", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/internal/infrastructure/persistence/postgres"
+)
+
+const (
+	queryInsertAttributeDefinition = `
+		INSERT INTO user_attribute_definitions (id, key, label, description, attribute_type, required, visibility, rules, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	queryUpdateAttributeDefinition = `
+		UPDATE user_attribute_definitions
+		SET label = $2, description = $3, required = $4, visibility = $5, rules = $6, updated_at = $7
+		WHERE id = $1`
+
+	queryDeleteAttributeDefinition = `
+		DELETE FROM user_attribute_definitions WHERE id = $1`
+
+	queryStripUserAttribute = `
+		UPDATE users SET attributes = attributes - $1::text, version = version + 1 WHERE attributes ? $1::text`
+
+	queryFindAttributeDefinitionByKey = `
+		SELECT id, key, label, description, attribute_type, required, visibility, rules, created_at, updated_at
+		FROM user_attribute_definitions
+		WHERE key = $1`
+
+	queryFindAllAttributeDefinitions = `
+		SELECT id, key, label, description, attribute_type, required, visibility, rules, created_at, updated_at
+		FROM user_attribute_definitions
+		ORDER BY key`
+)
+
+type attributeRulesRow struct {
+	MinLength *int     `json:"min_length,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Options   []string `json:"options,omitempty"`
+}
+
+type attributeDefinitionRow struct {
+	ID          uuid.UUID
+	Key         string
+	Label       string
+	Description *string
+	Type        string
+	Required    bool
+	Visibility  string
+	Rules       []byte
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (r *attributeDefinitionRow) toDomain() (*userattribute.Definition, error) {
+	var rules attributeRulesRow
+	if len(r.Rules) > 0 {
+		if err := json.Unmarshal(r.Rules, &rules); err != nil {
+			return nil, fmt.Errorf("decode rules of attribute %q: %w", r.Key, err)
+		}
+	}
+
+	description := ""
+	if r.Description != nil {
+		description = *r.Description
+	}
+
+	return userattribute.ReconstructDefinition(userattribute.ReconstructDefinitionParams{
+		ID:          r.ID,
+		Key:         r.Key,
+		Label:       r.Label,
+		Description: description,
+		Type:        userattribute.Type(r.Type),
+		Required:    r.Required,
+		Visibility:  userattribute.Visibility(r.Visibility),
+		Rules: userattribute.Rules{
+			MinLength: rules.MinLength,
+			MaxLength: rules.MaxLength,
+			Pattern:   rules.Pattern,
+			Min:       rules.Min,
+			Max:       rules.Max,
+			Options:   rules.Options,
+		},
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}), nil
+}
+
+func attributeDefinitionToRow(definition *userattribute.Definition) (*attributeDefinitionRow, error) {
+	rules := definition.Rules()
+	encodedRules, err := json.Marshal(attributeRulesRow{
+		MinLength: rules.MinLength,
+		MaxLength: rules.MaxLength,
+		Pattern:   rules.Pattern,
+		Min:       rules.Min,
+		Max:       rules.Max,
+		Options:   rules.Options,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode rules of attribute %q: %w", definition.Key(), err)
+	}
+
+	description := definition.Description()
+	return &attributeDefinitionRow{
+		ID:          definition.ID(),
+		Key:         definition.Key(),
+		Label:       definition.Label(),
+		Description: &description,
+		Type:        definition.Type().String(),
+		Required:    definition.Required(),
+		Visibility:  definition.Visibility().String(),
+		Rules:       encodedRules,
+		CreatedAt:   definition.CreatedAt(),
+		UpdatedAt:   definition.UpdatedAt(),
+	}, nil
+}
+
+type UserAttributeDefinitionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewUserAttributeDefinitionRepository(pool *pgxpool.Pool) *UserAttributeDefinitionRepository {
+	return &UserAttributeDefinitionRepository{pool: pool}
+}
+
+func (r *UserAttributeDefinitionRepository) Create(ctx context.Context, definition *userattribute.Definition) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row, err := attributeDefinitionToRow(definition)
+	if err != nil {
+		return err
+	}
+
+	_, err = querier.Exec(ctx, queryInsertAttributeDefinition,
+		row.ID,
+		row.Key,
+		row.Label,
+		row.Description,
+		row.Type,
+		row.Required,
+		row.Visibility,
+		row.Rules,
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return userattribute.NewKeyExistsError(row.Key)
+		}
+		return postgres.NewDBError("create attribute definition", err)
+	}
+
+	return nil
+}
+
+func (r *UserAttributeDefinitionRepository) Update(ctx context.Context, definition *userattribute.Definition) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+	row, err := attributeDefinitionToRow(definition)
+	if err != nil {
+		return err
+	}
+
+	cmdTag, err := querier.Exec(ctx, queryUpdateAttributeDefinition,
+		row.ID,
+		row.Label,
+		row.Description,
+		row.Required,
+		row.Visibility,
+		row.Rules,
+		row.UpdatedAt,
+	)
+	if err != nil {
+		return postgres.NewDBError("update attribute definition", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return userattribute.NewDefinitionNotFoundError(row.Key)
+	}
+
+	return nil
+}
+
+// Delete removes the definition and strips its key from every user in the
+// same transaction, so no orphaned values survive the definition.
+func (r *UserAttributeDefinitionRepository) Delete(ctx context.Context, definition *userattribute.Definition) error {
+	return postgres.WithTransaction(ctx, r.pool, func(txContext context.Context) error {
+		querier := postgres.GetQuerier(txContext, r.pool)
+
+		cmdTag, err := querier.Exec(txContext, queryDeleteAttributeDefinition, definition.ID())
+		if err != nil {
+			return postgres.NewDBError("delete attribute definition", err)
+		}
+		if cmdTag.RowsAffected() == 0 {
+			return userattribute.NewDefinitionNotFoundError(definition.Key())
+		}
+
+		if _, err := querier.Exec(txContext, queryStripUserAttribute, definition.Key()); err != nil {
+			return postgres.NewDBError("strip user attribute", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *UserAttributeDefinitionRepository) FindByKey(ctx context.Context, key string) (*userattribute.Definition, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	row := &attributeDefinitionRow{}
+	err := querier.QueryRow(ctx, queryFindAttributeDefinitionByKey, key).Scan(
+		&row.ID,
+		&row.Key,
+		&row.Label,
+		&row.Description,
+		&row.Type,
+		&row.Required,
+		&row.Visibility,
+		&row.Rules,
+		&row.CreatedAt,
+		&row.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userattribute.NewDefinitionNotFoundError(key)
+		}
+		return nil, postgres.NewDBError("find attribute definition by key", err)
+	}
+
+	return row.toDomain()
+}
+
+func (r *UserAttributeDefinitionRepository) FindAll(ctx context.Context) ([]*userattribute.Definition, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindAllAttributeDefinitions)
+	if err != nil {
+		return nil, postgres.NewDBError("find attribute definitions", err)
+	}
+	defer rows.Close()
+
+	definitions := make([]*userattribute.Definition, 0)
+	for rows.Next() {
+		row := &attributeDefinitionRow{}
+		err := rows.Scan(
+			&row.ID,
+			&row.Key,
+			&row.Label,
+			&row.Description,
+			&row.Type,
+			&row.Required,
+			&row.Visibility,
+			&row.Rules,
+			&row.CreatedAt,
+			&row.UpdatedAt,
+		)
+		if err != nil {
+			return nil, postgres.NewDBError("scan attribute definition row", err)
+		}
+
+		definition, err := row.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate attribute definition rows", err)
+	}
+
+	return definitions, nil
+}
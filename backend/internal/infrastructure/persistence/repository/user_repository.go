@@ -2,8 +2,12 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -19,51 +23,100 @@ const (
 	usersTable = "users"
 
 	queryInsertUser = `
-		INSERT INTO users (id, email, password_hash, full_name, status, created_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		INSERT INTO users (id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at,
+			suspended_until, suspension_reason, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
 
 	queryUpdateUser = `
 		UPDATE users
-		SET email = $2, password_hash = $3, full_name = $4, status = $5, updated_at = $6, deleted_at = $7
-		WHERE id = $1 AND deleted_at IS NULL`
+		SET email = $2, password_hash = $3, full_name = $4, status = $5, attributes = $6, avatar = $7, updated_at = $8, deleted_at = $9, erased_at = $10,
+			suspended_until = $11, suspension_reason = $12, version = version + 1
+		WHERE id = $1 AND version = $13 AND (deleted_at IS NULL OR $9::timestamptz IS NOT NULL)`
+
+	queryExistsUserForUpdate = `
+		SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND (deleted_at IS NULL OR $2::timestamptz IS NOT NULL))`
 
 	querySoftDeleteUser = `
 		UPDATE users
-		SET deleted_at = $2, updated_at = $2
+		SET deleted_at = $2, updated_at = $2, version = version + 1
 		WHERE id = $1 AND deleted_at IS NULL`
 
+	queryRestoreUser = `
+		UPDATE users
+		SET deleted_at = NULL, updated_at = $2, version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL AND erased_at IS NULL`
+
+	queryPurgeUser = `
+		DELETE FROM users
+		WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	queryFindUsersDeletedBefore = `
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
+		FROM users
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1
+		ORDER BY deleted_at
+		LIMIT $2`
+
+	queryFindUsersSuspendedUntil = `
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
+		FROM users
+		WHERE status = 'suspended' AND suspended_until <= $1 AND deleted_at IS NULL
+		ORDER BY suspended_until
+		LIMIT $2`
+
 	queryFindUserByID = `
-		SELECT id, email, password_hash, full_name, status, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL`
 
+	queryFindUserByIDIncludingDeleted = `
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
+		FROM users
+		WHERE id = $1`
+
 	queryFindUserByEmail = `
-		SELECT id, email, password_hash, full_name, status, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
 		FROM users
 		WHERE LOWER(email) = LOWER($1) AND deleted_at IS NULL`
 
 	queryExistsByEmail = `
 		SELECT EXISTS(SELECT 1 FROM users WHERE LOWER(email) = LOWER($1) AND deleted_at IS NULL)`
 
+	queryRecordUserLogin = `
+		UPDATE users
+		SET last_login_at = $2
+		WHERE id = $1 AND deleted_at IS NULL`
+
 	queryCountUsers = `SELECT COUNT(*) FROM users`
 
 	querySelectUsers = `
-		SELECT id, email, password_hash, full_name, status, created_at, updated_at, deleted_at
+		SELECT id, email, password_hash, full_name, status, attributes, avatar, created_at, updated_at, deleted_at, erased_at, last_login_at, suspended_until, suspension_reason, version
 		FROM users`
 
-	queryFindUserRoles = `
-		SELECT role_id FROM user_roles WHERE user_id = $1`
+	queryFindRolesByUsers = `
+		SELECT user_id, role_id, access_request_id FROM user_roles WHERE user_id = ANY($1)`
 
 	queryDeleteUserRoles = `
 		DELETE FROM user_roles WHERE user_id = $1`
 
 	queryInsertUserRole = `
-		INSERT INTO user_roles (user_id, role_id, assigned_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO user_roles (user_id, role_id, assigned_at, access_request_id)
+		VALUES ($1, $2, $3, $4)
 		ON CONFLICT (user_id, role_id) DO NOTHING`
 
+	queryFindDeniedPermissionsByUsers = `
+		SELECT user_id, permission_id FROM user_denied_permissions WHERE user_id = ANY($1)`
+
+	queryDeleteUserDeniedPermissions = `
+		DELETE FROM user_denied_permissions WHERE user_id = $1`
+
+	queryInsertUserDeniedPermission = `
+		INSERT INTO user_denied_permissions (user_id, permission_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, permission_id) DO NOTHING`
+
 	queryFindUsersByRole = `
-		SELECT u.id, u.email, u.password_hash, u.full_name, u.status, u.created_at, u.updated_at, u.deleted_at
+		SELECT u.id, u.email, u.password_hash, u.full_name, u.status, u.attributes, u.avatar, u.created_at, u.updated_at, u.deleted_at, u.erased_at, u.last_login_at, u.suspended_until, u.suspension_reason, u.version
 		FROM users u
 		INNER JOIN user_roles ur ON u.id = ur.user_id
 		WHERE ur.role_id = $1 AND u.deleted_at IS NULL
@@ -72,42 +125,126 @@ const (
 
 const pgUniqueViolationCode = "23505"
 
+type avatarRow struct {
+	Key       string            `json:"key"`
+	Extension string            `json:"extension"`
+	URLs      map[string]string `json:"urls"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
 type userRow struct {
 	ID           uuid.UUID
 	Email        string
 	PasswordHash string
 	FullName     string
 	Status       string
+	Attributes   []byte
+	Avatar       []byte
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
+	ErasedAt     *time.Time
+	LastLoginAt  *time.Time
+	// SuspendedUntil and SuspensionReason are only set while the user is
+	// suspended.
+	SuspendedUntil   *time.Time
+	SuspensionReason *string
+	Version          int64
 }
 
-func (r *userRow) toDomain(roleIDs []uuid.UUID) (*user.User, error) {
+func (r *userRow) toDomain(roles userRoles, deniedPermissionIDs []uuid.UUID) (*user.User, error) {
+	var attributes map[string]any
+	if len(r.Attributes) > 0 {
+		if err := json.Unmarshal(r.Attributes, &attributes); err != nil {
+			return nil, fmt.Errorf("decode user attributes: %w", err)
+		}
+	}
+
+	var avatar *user.Avatar
+	if len(r.Avatar) > 0 {
+		var decoded avatarRow
+		if err := json.Unmarshal(r.Avatar, &decoded); err != nil {
+			return nil, fmt.Errorf("decode user avatar: %w", err)
+		}
+		avatar = &user.Avatar{
+			Key:       decoded.Key,
+			Extension: decoded.Extension,
+			URLs:      decoded.URLs,
+			UpdatedAt: decoded.UpdatedAt,
+		}
+	}
+
+	suspensionReason := ""
+	if r.SuspensionReason != nil {
+		suspensionReason = *r.SuspensionReason
+	}
+
 	return user.ReconstructUser(user.ReconstructUserParams{
-		ID:           r.ID,
-		Email:        r.Email,
-		PasswordHash: r.PasswordHash,
-		FullName:     r.FullName,
-		Status:       user.Status(r.Status),
-		RoleIDs:      roleIDs,
-		CreatedAt:    r.CreatedAt,
-		UpdatedAt:    r.UpdatedAt,
-		DeletedAt:    r.DeletedAt,
+		ID:                  r.ID,
+		Email:               r.Email,
+		PasswordHash:        r.PasswordHash,
+		FullName:            r.FullName,
+		Status:              user.Status(r.Status),
+		RoleIDs:             roles.roleIDs,
+		TemporaryRoles:      roles.temporaryRoles,
+		DeniedPermissionIDs: deniedPermissionIDs,
+		Attributes:          attributes,
+		Avatar:              avatar,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+		DeletedAt:           r.DeletedAt,
+		ErasedAt:            r.ErasedAt,
+		LastLoginAt:         r.LastLoginAt,
+		SuspendedUntil:      r.SuspendedUntil,
+		SuspensionReason:    suspensionReason,
+		Version:             r.Version,
 	})
 }
 
-func userToRow(u *user.User) *userRow {
-	return &userRow{
-		ID:           u.ID(),
-		Email:        u.Email().String(),
-		PasswordHash: u.PasswordHash().String(),
-		FullName:     u.FullName().String(),
-		Status:       u.Status().String(),
-		CreatedAt:    u.CreatedAt(),
-		UpdatedAt:    u.UpdatedAt(),
-		DeletedAt:    u.DeletedAt(),
+func userToRow(u *user.User) (*userRow, error) {
+	attributes := u.Attributes()
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	encodedAttributes, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("encode user attributes: %w", err)
+	}
+
+	var encodedAvatar []byte
+	if avatar := u.Avatar(); avatar != nil {
+		encodedAvatar, err = json.Marshal(avatarRow{
+			Key:       avatar.Key,
+			Extension: avatar.Extension,
+			URLs:      avatar.URLs,
+			UpdatedAt: avatar.UpdatedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encode user avatar: %w", err)
+		}
+	}
+
+	var suspensionReason *string
+	if reason := u.SuspensionReason(); reason != "" {
+		suspensionReason = &reason
 	}
+
+	return &userRow{
+		ID:               u.ID(),
+		Email:            u.Email().String(),
+		PasswordHash:     u.PasswordHash().String(),
+		FullName:         u.FullName().String(),
+		Status:           u.Status().String(),
+		Attributes:       encodedAttributes,
+		Avatar:           encodedAvatar,
+		CreatedAt:        u.CreatedAt(),
+		UpdatedAt:        u.UpdatedAt(),
+		DeletedAt:        u.DeletedAt(),
+		ErasedAt:         u.ErasedAt(),
+		SuspendedUntil:   u.SuspendedUntil(),
+		SuspensionReason: suspensionReason,
+		Version:          u.Version(),
+	}, nil
 }
 
 type UserRepository struct {
@@ -120,17 +257,26 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 
 func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 	querier := postgres.GetQuerier(ctx, r.pool)
-	row := userToRow(u)
+	row, err := userToRow(u)
+	if err != nil {
+		return err
+	}
 
-	_, err := querier.Exec(ctx, queryInsertUser,
+	_, err = querier.Exec(ctx, queryInsertUser,
 		row.ID,
 		row.Email,
 		row.PasswordHash,
 		row.FullName,
 		row.Status,
+		row.Attributes,
+		row.Avatar,
 		row.CreatedAt,
 		row.UpdatedAt,
 		row.DeletedAt,
+		row.ErasedAt,
+		row.SuspendedUntil,
+		row.SuspensionReason,
+		row.Version,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -140,7 +286,11 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 		return postgres.NewDBError("create user", err)
 	}
 
-	if err := r.syncRoles(ctx, querier, u.ID(), u.RoleIDs()); err != nil {
+	if err := r.syncRoles(ctx, querier, u); err != nil {
+		return err
+	}
+
+	if err := r.syncDeniedPermissions(ctx, querier, u.ID(), u.DeniedPermissionIDs()); err != nil {
 		return err
 	}
 
@@ -149,7 +299,10 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 
 func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 	querier := postgres.GetQuerier(ctx, r.pool)
-	row := userToRow(u)
+	row, err := userToRow(u)
+	if err != nil {
+		return err
+	}
 
 	cmdTag, err := querier.Exec(ctx, queryUpdateUser,
 		row.ID,
@@ -157,8 +310,14 @@ func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 		row.PasswordHash,
 		row.FullName,
 		row.Status,
+		row.Attributes,
+		row.Avatar,
 		row.UpdatedAt,
 		row.DeletedAt,
+		row.ErasedAt,
+		row.SuspendedUntil,
+		row.SuspensionReason,
+		row.Version,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -169,10 +328,22 @@ func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 	}
 
 	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := querier.QueryRow(ctx, queryExistsUserForUpdate, row.ID, row.DeletedAt).Scan(&exists); err != nil {
+			return postgres.NewDBError("check user exists", err)
+		}
+		if exists {
+			return user.NewConcurrentModificationError(row.ID.String())
+		}
 		return user.NewUserNotFoundError(row.ID.String())
 	}
+	u.AdvanceVersion()
 
-	if err := r.syncRoles(ctx, querier, u.ID(), u.RoleIDs()); err != nil {
+	if err := r.syncRoles(ctx, querier, u); err != nil {
+		return err
+	}
+
+	if err := r.syncDeniedPermissions(ctx, querier, u.ID(), u.DeniedPermissionIDs()); err != nil {
 		return err
 	}
 
@@ -195,19 +366,120 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (r *UserRepository) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryRecordUserLogin, id, at.UTC())
+	if err != nil {
+		return postgres.NewDBError("record user login", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return user.NewUserNotFoundError(id.String())
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Restore(ctx context.Context, u *user.User) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryRestoreUser, u.ID(), u.UpdatedAt())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+			return user.NewEmailAlreadyExistsError(u.Email().String())
+		}
+		return postgres.NewDBError("restore user", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return user.NewUserNotFoundError(u.ID().String())
+	}
+	u.AdvanceVersion()
+
+	return nil
+}
+
+func (r *UserRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	cmdTag, err := querier.Exec(ctx, queryPurgeUser, id)
+	if err != nil {
+		return postgres.NewDBError("purge user", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return user.NewUserNotFoundError(id.String())
+	}
+
+	return nil
+}
+
+func (r *UserRepository) FindSuspensionsEndedBy(ctx context.Context, now time.Time, limit int) ([]*user.User, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindUsersSuspendedUntil, now, limit)
+	if err != nil {
+		return nil, postgres.NewDBError("find users with ended suspensions", err)
+	}
+	defer rows.Close()
+
+	userRows, err := scanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withRoles(ctx, querier, userRows)
+}
+
+func (r *UserRepository) FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*user.User, error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, queryFindUsersDeletedBefore, cutoff, limit)
+	if err != nil {
+		return nil, postgres.NewDBError("find users deleted before", err)
+	}
+	defer rows.Close()
+
+	userRows, err := scanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withRoles(ctx, querier, userRows)
+}
+
 func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return r.findByID(ctx, queryFindUserByID, id)
+}
+
+func (r *UserRepository) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return r.findByID(ctx, queryFindUserByIDIncludingDeleted, id)
+}
+
+func (r *UserRepository) findByID(ctx context.Context, query string, id uuid.UUID) (*user.User, error) {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
 	row := &userRow{}
-	err := querier.QueryRow(ctx, queryFindUserByID, id).Scan(
+	err := querier.QueryRow(ctx, query, id).Scan(
 		&row.ID,
 		&row.Email,
 		&row.PasswordHash,
 		&row.FullName,
 		&row.Status,
+		&row.Attributes,
+		&row.Avatar,
 		&row.CreatedAt,
 		&row.UpdatedAt,
 		&row.DeletedAt,
+		&row.ErasedAt,
+		&row.LastLoginAt,
+		&row.SuspendedUntil,
+		&row.SuspensionReason,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -216,12 +488,12 @@ func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User
 		return nil, postgres.NewDBError("find user by id", err)
 	}
 
-	roleIDs, err := r.loadRoleIDs(ctx, querier, id)
+	users, err := r.withRoles(ctx, querier, []*userRow{row})
 	if err != nil {
 		return nil, err
 	}
 
-	return row.toDomain(roleIDs)
+	return users[0], nil
 }
 
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
@@ -234,9 +506,16 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.U
 		&row.PasswordHash,
 		&row.FullName,
 		&row.Status,
+		&row.Attributes,
+		&row.Avatar,
 		&row.CreatedAt,
 		&row.UpdatedAt,
 		&row.DeletedAt,
+		&row.ErasedAt,
+		&row.LastLoginAt,
+		&row.SuspendedUntil,
+		&row.SuspensionReason,
+		&row.Version,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -245,12 +524,12 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.U
 		return nil, postgres.NewDBError("find user by email", err)
 	}
 
-	roleIDs, err := r.loadRoleIDs(ctx, querier, row.ID)
+	users, err := r.withRoles(ctx, querier, []*userRow{row})
 	if err != nil {
 		return nil, err
 	}
 
-	return row.toDomain(roleIDs)
+	return users[0], nil
 }
 
 func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
@@ -268,16 +547,117 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 func (r *UserRepository) List(ctx context.Context, filter user.Filter, pagination shared.Pagination) ([]*user.User, int64, error) {
 	querier := postgres.GetQuerier(ctx, r.pool)
 
+	where, searchRank, err := userListWhere(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	whereClause, args := where.Build()
+
+	total, err := r.countUsers(ctx, querier, whereClause, args)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return []*user.User{}, 0, nil
+	}
+
+	orderBy := userOrderBy(filter.Sort, searchRank)
+	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+
+	dataQuery := querySelectUsers
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
+
+	users, err := r.queryUsers(ctx, querier, dataQuery, args)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (r *UserRepository) ListByCursor(ctx context.Context, filter user.Filter, page shared.CursorPage) (shared.CursorResult[*user.User], error) {
+	querier := postgres.GetQuerier(ctx, r.pool)
+
+	where, _, err := userListWhere(filter)
+	if err != nil {
+		return shared.CursorResult[*user.User]{}, err
+	}
+
+	var total *int64
+	if page.WithTotal() {
+		whereClause, args := where.Build()
+		count, err := r.countUsers(ctx, querier, whereClause, args)
+		if err != nil {
+			return shared.CursorResult[*user.User]{}, err
+		}
+		total = &count
+	}
+
+	keyset, keys := userKeyset(filter.Sort)
+	if err := keyset.Seek(where, page.Cursor()); err != nil {
+		return shared.CursorResult[*user.User]{}, err
+	}
+	whereClause, args := where.Build()
+
+	dataQuery := querySelectUsers
+	if whereClause != "" {
+		dataQuery = dataQuery + " " + whereClause
+	}
+	dataQuery = dataQuery + " " + keyset.OrderBy(page.IsBackward()).Build() + " " + keyset.Limit(page)
+
+	users, err := r.queryUsers(ctx, querier, dataQuery, args)
+	if err != nil {
+		return shared.CursorResult[*user.User]{}, err
+	}
+
+	result := postgres.PageKeyset(keyset, users, page, keys)
+	result.Total = total
+	return result, nil
+}
+
+// userListWhere builds the conditions shared by both listing modes. It
+// returns the search rank expression when the filter searches.
+func userListWhere(filter user.Filter) (*postgres.WhereClause, string, error) {
 	where := postgres.NewWhereClause()
-	where.IsNull("deleted_at")
+	switch filter.Deleted {
+	case user.DeletedScopeOnly:
+		where.IsNotNull("deleted_at")
+	case user.DeletedScopeInclude:
+	default:
+		where.IsNull("deleted_at")
+	}
+
+	if filter.OrganizationID != nil {
+		where.AddCondition("EXISTS (SELECT 1 FROM organization_members om WHERE om.user_id = users.id AND om.organization_id = $%d)", *filter.OrganizationID)
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = status.String()
+		}
+		where.AddCondition("status = ANY($%d)", statuses)
+	}
+
+	if len(filter.RoleIDs) > 0 {
+		where.AddCondition("EXISTS (SELECT 1 FROM user_roles ur WHERE ur.user_id = users.id AND ur.role_id = ANY($%d))", filter.RoleIDs)
+	}
 
-	if filter.Status != nil {
-		where.Eq("status", filter.Status.String())
+	var searchRank string
+	if filter.Search != nil && strings.TrimSpace(*filter.Search) != "" {
+		searchRank = addUserSearch(where, strings.TrimSpace(*filter.Search))
 	}
 
-	if filter.Search != nil && *filter.Search != "" {
-		searchPattern := "%" + *filter.Search + "%"
-		where.AddCondition("(LOWER(email) LIKE LOWER($%d) OR LOWER(full_name) LIKE LOWER($%d))", searchPattern, searchPattern)
+	if len(filter.Attributes) > 0 {
+		encodedAttributes, err := json.Marshal(filter.Attributes)
+		if err != nil {
+			return nil, "", fmt.Errorf("encode attribute filter: %w", err)
+		}
+		where.AddCondition("attributes @> $%d::jsonb", string(encodedAttributes))
 	}
 
 	if filter.DateRange.HasFrom() {
@@ -288,72 +668,186 @@ func (r *UserRepository) List(ctx context.Context, filter user.Filter, paginatio
 		where.Lte("created_at", *filter.DateRange.To())
 	}
 
-	whereClause, args := where.Build()
+	if filter.NeverLoggedIn {
+		where.IsNull("last_login_at")
+	}
 
+	if filter.LastLoginRange.HasFrom() {
+		where.Gte("last_login_at", *filter.LastLoginRange.From())
+	}
+
+	if filter.LastLoginRange.HasTo() {
+		where.Lte("last_login_at", *filter.LastLoginRange.To())
+	}
+
+	return where, searchRank, nil
+}
+
+func (r *UserRepository) countUsers(ctx context.Context, querier postgres.Querier, whereClause string, args []any) (int64, error) {
 	countQuery := queryCountUsers
 	if whereClause != "" {
 		countQuery = countQuery + " " + whereClause
 	}
 
 	var total int64
-	err := querier.QueryRow(ctx, countQuery, args...).Scan(&total)
+	if err := querier.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return 0, postgres.NewDBError("count users", err)
+	}
+	return total, nil
+}
+
+func (r *UserRepository) queryUsers(ctx context.Context, querier postgres.Querier, query string, args []any) ([]*user.User, error) {
+	rows, err := querier.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, postgres.NewDBError("count users", err)
+		return nil, postgres.NewDBError("list users", err)
 	}
+	defer rows.Close()
 
-	if total == 0 {
-		return []*user.User{}, 0, nil
+	userRows, err := scanUserRows(rows)
+	if err != nil {
+		return nil, err
 	}
+	rows.Close()
 
-	orderBy := postgres.NewOrderByClause().Desc("created_at")
-	paginationClause := postgres.NewPaginationClauseFromOffset(pagination.Limit(), pagination.Offset())
+	return r.withRoles(ctx, querier, userRows)
+}
 
-	dataQuery := querySelectUsers
-	if whereClause != "" {
-		dataQuery = dataQuery + " " + whereClause
+var userSortColumns = map[user.SortField]string{
+	user.SortFieldCreatedAt:   "created_at",
+	user.SortFieldUpdatedAt:   "updated_at",
+	user.SortFieldEmail:       "email",
+	user.SortFieldFullName:    "full_name",
+	user.SortFieldStatus:      "status",
+	user.SortFieldLastLoginAt: "last_login_at",
+}
+
+// addUserSearch matches whole words and word prefixes through the
+// search_vector index, and substrings and near-misses through the trigram
+// indexes. It returns the expression that ranks a match.
+func addUserSearch(where *postgres.WhereClause, term string) string {
+	pattern := "%" + escapeLikePattern(term) + "%"
+	prefixQuery := prefixTSQuery(term)
+
+	first := where.NextParamIndex()
+	if prefixQuery == "" {
+		where.AddCondition("(email ILIKE $%d OR full_name ILIKE $%d OR $%d <%% full_name OR $%d <%% email)", pattern, pattern, term, term)
+		return fmt.Sprintf("(word_similarity($%d, full_name) + word_similarity($%d, email))", first+2, first+3)
 	}
-	dataQuery = dataQuery + " " + orderBy.Build() + " " + paginationClause.Build()
 
-	rows, err := querier.Query(ctx, dataQuery, args...)
-	if err != nil {
-		return nil, 0, postgres.NewDBError("list users", err)
+	where.AddCondition("(search_vector @@ to_tsquery('simple', $%d) OR email ILIKE $%d OR full_name ILIKE $%d OR $%d <%% full_name OR $%d <%% email)",
+		prefixQuery, pattern, pattern, term, term)
+	return fmt.Sprintf("(ts_rank_cd(search_vector, to_tsquery('simple', $%d)) + word_similarity($%d, full_name) + word_similarity($%d, email))",
+		first, first+3, first+4)
+}
+
+// prefixTSQuery turns free text into a tsquery where every word must match
+// as a prefix. Only letters and digits survive, so the result never carries
+// tsquery operators from the input.
+func prefixTSQuery(term string) string {
+	words := strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
 	}
-	defer rows.Close()
+	return strings.Join(words, " & ")
+}
 
-	users := make([]*user.User, 0)
-	for rows.Next() {
-		row := &userRow{}
-		err := rows.Scan(
-			&row.ID,
-			&row.Email,
-			&row.PasswordHash,
-			&row.FullName,
-			&row.Status,
-			&row.CreatedAt,
-			&row.UpdatedAt,
-			&row.DeletedAt,
-		)
-		if err != nil {
-			return nil, 0, postgres.NewDBError("scan user row", err)
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// userOrderBy always ends with id so that pages are stable when sort keys tie.
+func userOrderBy(sorts []user.Sort, searchRank string) *postgres.OrderByClause {
+	if len(sorts) == 0 {
+		sorts = []user.Sort{{Field: user.SortFieldCreatedAt, Order: shared.SortOrderDesc}}
+		if searchRank != "" {
+			sorts = append([]user.Sort{{Field: user.SortFieldRelevance, Order: shared.SortOrderDesc}}, sorts...)
 		}
+	}
 
-		roleIDs, err := r.loadRoleIDs(ctx, querier, row.ID)
-		if err != nil {
-			return nil, 0, err
+	orderBy := postgres.NewOrderByClause()
+	tieBreak := postgres.OrderDesc
+	for _, sort := range sorts {
+		direction := postgres.OrderDirection(sort.Order.SQL())
+		switch {
+		case sort.Field == user.SortFieldRelevance:
+			if searchRank == "" {
+				continue
+			}
+			orderBy.Add(searchRank, direction)
+		case sort.Field == user.SortFieldLastLoginAt:
+			orderBy.AddNullsLast(userSortColumns[sort.Field], direction)
+		default:
+			column, ok := userSortColumns[sort.Field]
+			if !ok {
+				continue
+			}
+			orderBy.Add(column, direction)
 		}
+		tieBreak = direction
+	}
+	return orderBy.Add("id", tieBreak)
+}
 
-		u, err := row.toDomain(roleIDs)
-		if err != nil {
-			return nil, 0, postgres.NewDBError("convert user row to domain", err)
+// userKeyset mirrors userOrderBy for cursor pages, minus relevance, and
+// returns how to read a user's key values in column order.
+func userKeyset(sorts []user.Sort) (*postgres.Keyset, func(*user.User) []any) {
+	if len(sorts) == 0 {
+		sorts = []user.Sort{{Field: user.SortFieldCreatedAt, Order: shared.SortOrderDesc}}
+	}
+
+	columns := make([]postgres.KeysetColumn, 0, len(sorts)+1)
+	fields := make([]user.SortField, 0, len(sorts))
+	tieBreak := postgres.OrderDesc
+	for _, sort := range sorts {
+		column, ok := userSortColumns[sort.Field]
+		if !ok {
+			continue
 		}
-		users = append(users, u)
+		direction := postgres.OrderDirection(sort.Order.SQL())
+		keyType := postgres.KeyText
+		if sort.Field == user.SortFieldCreatedAt || sort.Field == user.SortFieldUpdatedAt || sort.Field == user.SortFieldLastLoginAt {
+			keyType = postgres.KeyTime
+		}
+		columns = append(columns, postgres.KeysetColumn{
+			Column:    column,
+			Direction: direction,
+			Type:      keyType,
+			Nullable:  sort.Field == user.SortFieldLastLoginAt,
+		})
+		fields = append(fields, sort.Field)
+		tieBreak = direction
 	}
+	columns = append(columns, postgres.KeysetColumn{Column: "id", Direction: tieBreak, Type: postgres.KeyUUID})
 
-	if err := rows.Err(); err != nil {
-		return nil, 0, postgres.NewDBError("iterate user rows", err)
+	keys := func(u *user.User) []any {
+		values := make([]any, 0, len(fields)+1)
+		for _, field := range fields {
+			values = append(values, userSortValue(u, field))
+		}
+		return append(values, u.ID())
 	}
+	return postgres.NewKeyset(columns...), keys
+}
 
-	return users, total, nil
+func userSortValue(u *user.User, field user.SortField) any {
+	switch field {
+	case user.SortFieldCreatedAt:
+		return u.CreatedAt()
+	case user.SortFieldUpdatedAt:
+		return u.UpdatedAt()
+	case user.SortFieldEmail:
+		return u.Email().String()
+	case user.SortFieldFullName:
+		return u.FullName().String()
+	case user.SortFieldStatus:
+		return u.Status().String()
+	case user.SortFieldLastLoginAt:
+		return u.LastLoginAt()
+	default:
+		return nil
+	}
 }
 
 func (r *UserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) ([]*user.User, error) {
@@ -365,7 +859,17 @@ func (r *UserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) ([]*u
 	}
 	defer rows.Close()
 
-	users := make([]*user.User, 0)
+	userRows, err := scanUserRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return r.withRoles(ctx, querier, userRows)
+}
+
+func scanUserRows(rows pgx.Rows) ([]*userRow, error) {
+	userRows := make([]*userRow, 0)
 	for rows.Next() {
 		row := &userRow{}
 		err := rows.Scan(
@@ -374,65 +878,119 @@ func (r *UserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) ([]*u
 			&row.PasswordHash,
 			&row.FullName,
 			&row.Status,
+			&row.Attributes,
+			&row.Avatar,
 			&row.CreatedAt,
 			&row.UpdatedAt,
 			&row.DeletedAt,
+			&row.ErasedAt,
+			&row.LastLoginAt,
+			&row.SuspendedUntil,
+			&row.SuspensionReason,
+			&row.Version,
 		)
 		if err != nil {
 			return nil, postgres.NewDBError("scan user row", err)
 		}
+		userRows = append(userRows, row)
+	}
 
-		roleIDs, err := r.loadRoleIDs(ctx, querier, row.ID)
-		if err != nil {
-			return nil, err
-		}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate user rows", err)
+	}
+
+	return userRows, nil
+}
+
+// withRoles loads the role and denied permission IDs of all the users in
+// one query each, so reading a page costs the same number of round trips
+// whatever its size.
+func (r *UserRepository) withRoles(ctx context.Context, querier postgres.Querier, userRows []*userRow) ([]*user.User, error) {
+	users := make([]*user.User, 0, len(userRows))
+	if len(userRows) == 0 {
+		return users, nil
+	}
+
+	userIDs := make([]uuid.UUID, len(userRows))
+	for i, row := range userRows {
+		userIDs[i] = row.ID
+	}
 
-		u, err := row.toDomain(roleIDs)
+	roles, err := r.loadRoles(ctx, querier, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	deniedPermissionIDs, err := r.loadDeniedPermissionIDs(ctx, querier, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range userRows {
+		u, err := row.toDomain(roles[row.ID], deniedPermissionIDs[row.ID])
 		if err != nil {
 			return nil, postgres.NewDBError("convert user row to domain", err)
 		}
 		users = append(users, u)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, postgres.NewDBError("iterate user rows", err)
-	}
-
 	return users, nil
 }
 
-func (r *UserRepository) loadRoleIDs(ctx context.Context, querier postgres.Querier, userID uuid.UUID) ([]uuid.UUID, error) {
-	rows, err := querier.Query(ctx, queryFindUserRoles, userID)
+// userRoles holds the roles of one user and, for the time-boxed ones, the
+// access request that granted them.
+type userRoles struct {
+	roleIDs        []uuid.UUID
+	temporaryRoles map[uuid.UUID]uuid.UUID
+}
+
+func (r *UserRepository) loadRoles(ctx context.Context, querier postgres.Querier, userIDs []uuid.UUID) (map[uuid.UUID]userRoles, error) {
+	rows, err := querier.Query(ctx, queryFindRolesByUsers, userIDs)
 	if err != nil {
 		return nil, postgres.NewDBError("load user roles", err)
 	}
 	defer rows.Close()
 
-	roleIDs := make([]uuid.UUID, 0)
+	rolesByUser := make(map[uuid.UUID]userRoles, len(userIDs))
+	for _, userID := range userIDs {
+		rolesByUser[userID] = userRoles{roleIDs: make([]uuid.UUID, 0), temporaryRoles: make(map[uuid.UUID]uuid.UUID)}
+	}
+
 	for rows.Next() {
-		var roleID uuid.UUID
-		if err := rows.Scan(&roleID); err != nil {
+		var userID, roleID uuid.UUID
+		var accessRequestID *uuid.UUID
+		if err := rows.Scan(&userID, &roleID, &accessRequestID); err != nil {
 			return nil, postgres.NewDBError("scan role id", err)
 		}
-		roleIDs = append(roleIDs, roleID)
+		roles := rolesByUser[userID]
+		roles.roleIDs = append(roles.roleIDs, roleID)
+		if accessRequestID != nil {
+			roles.temporaryRoles[roleID] = *accessRequestID
+		}
+		rolesByUser[userID] = roles
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, postgres.NewDBError("iterate role ids", err)
 	}
 
-	return roleIDs, nil
+	return rolesByUser, nil
 }
 
-func (r *UserRepository) syncRoles(ctx context.Context, querier postgres.Querier, userID uuid.UUID, roleIDs []uuid.UUID) error {
-	_, err := querier.Exec(ctx, queryDeleteUserRoles, userID)
+func (r *UserRepository) syncRoles(ctx context.Context, querier postgres.Querier, u *user.User) error {
+	_, err := querier.Exec(ctx, queryDeleteUserRoles, u.ID())
 	if err != nil {
 		return postgres.NewDBError("delete user roles", err)
 	}
 
 	now := time.Now().UTC()
-	for _, roleID := range roleIDs {
-		_, err := querier.Exec(ctx, queryInsertUserRole, userID, roleID, now)
+	temporaryRoles := u.TemporaryRoles()
+	for _, roleID := range u.RoleIDs() {
+		var accessRequestID *uuid.UUID
+		if grantedBy, ok := temporaryRoles[roleID]; ok {
+			accessRequestID = &grantedBy
+		}
+		_, err := querier.Exec(ctx, queryInsertUserRole, u.ID(), roleID, now, accessRequestID)
 		if err != nil {
 			return postgres.NewDBError("insert user role", err)
 		}
@@ -440,3 +998,53 @@ func (r *UserRepository) syncRoles(ctx context.Context, querier postgres.Querier
 
 	return nil
 }
+
+func (r *UserRepository) loadDeniedPermissionIDs(ctx context.Context, querier postgres.Querier, userIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := querier.Query(ctx, queryFindDeniedPermissionsByUsers, userIDs)
+	if err != nil {
+		return nil, postgres.NewDBError("load user denied permissions", err)
+	}
+	defer rows.Close()
+
+	return scanUUIDsByOwner(rows, userIDs, "permission id")
+}
+
+func (r *UserRepository) syncDeniedPermissions(ctx context.Context, querier postgres.Querier, userID uuid.UUID, permissionIDs []uuid.UUID) error {
+	_, err := querier.Exec(ctx, queryDeleteUserDeniedPermissions, userID)
+	if err != nil {
+		return postgres.NewDBError("delete user denied permissions", err)
+	}
+
+	now := time.Now().UTC()
+	for _, permissionID := range permissionIDs {
+		_, err := querier.Exec(ctx, queryInsertUserDeniedPermission, userID, permissionID, now)
+		if err != nil {
+			return postgres.NewDBError("insert user denied permission", err)
+		}
+	}
+
+	return nil
+}
+
+// scanUUIDsByOwner groups (owner ID, ID) rows by owner. Every owner in
+// ownerIDs gets an entry, empty when no row references it.
+func scanUUIDsByOwner(rows pgx.Rows, ownerIDs []uuid.UUID, name string) (map[uuid.UUID][]uuid.UUID, error) {
+	idsByOwner := make(map[uuid.UUID][]uuid.UUID, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		idsByOwner[ownerID] = make([]uuid.UUID, 0)
+	}
+
+	for rows.Next() {
+		var ownerID, id uuid.UUID
+		if err := rows.Scan(&ownerID, &id); err != nil {
+			return nil, postgres.NewDBError("scan "+name, err)
+		}
+		idsByOwner[ownerID] = append(idsByOwner[ownerID], id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, postgres.NewDBError("iterate "+name+"s", err)
+	}
+
+	return idsByOwner, nil
+}
@@ -5,11 +5,15 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
 	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
 )
@@ -111,6 +115,162 @@ func TestUserRepository_Integration(t *testing.T) {
 		foundUser, err := repository.FindByID(context.Background(), testUser.ID())
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Name", foundUser.FullName().String())
+		assert.Equal(t, int64(2), foundUser.Version())
+	})
+
+	t.Run("Update rejects a stale copy", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "stale@test.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Original Name",
+		})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testUser))
+
+		first, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+		second, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+
+		require.NoError(t, first.UpdateProfile("First Writer"))
+		require.NoError(t, repository.Update(context.Background(), first))
+
+		require.NoError(t, second.UpdateProfile("Second Writer"))
+		err = repository.Update(context.Background(), second)
+		assert.ErrorIs(t, err, user.ErrConcurrentModification)
+
+		foundUser, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "First Writer", foundUser.FullName().String())
+	})
+
+	t.Run("Update keeps the access request behind a time-boxed role", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "timeboxed@test.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Time-boxed User",
+		})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testUser))
+
+		temporaryRole, err := role.NewRole(role.NewRoleParams{Name: "on_call", DisplayName: "On Call"})
+		require.NoError(t, err)
+		permanentRole, err := role.NewRole(role.NewRoleParams{Name: "viewer", DisplayName: "Viewer"})
+		require.NoError(t, err)
+		roleRepository := NewRoleRepository(suite.DatabasePool)
+		require.NoError(t, roleRepository.Create(context.Background(), temporaryRole))
+		require.NoError(t, roleRepository.Create(context.Background(), permanentRole))
+
+		request, err := accessrequest.NewAccessRequest(accessrequest.NewAccessRequestParams{
+			RequesterID:   testUser.ID(),
+			RoleID:        temporaryRole.ID(),
+			Justification: "incident INC-42",
+			Duration:      time.Hour,
+		})
+		require.NoError(t, err)
+		require.NoError(t, NewAccessRequestRepository(suite.DatabasePool).Create(context.Background(), request))
+
+		require.NoError(t, testUser.AssignTemporaryRole(temporaryRole.ID(), request.ID()))
+		require.NoError(t, testUser.AssignRole(permanentRole.ID()))
+		require.NoError(t, repository.Update(context.Background(), testUser))
+
+		foundUser, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{temporaryRole.ID(), permanentRole.ID()}, foundUser.RoleIDs())
+		assert.Equal(t, map[uuid.UUID]uuid.UUID{temporaryRole.ID(): request.ID()}, foundUser.TemporaryRoles())
+	})
+
+	t.Run("FindSuspensionsEndedBy returns ended suspensions", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		suspend := func(email string, until time.Time) *user.User {
+			testUser, err := user.NewUser(user.NewUserParams{
+				Email:        email,
+				PasswordHash: "$2a$10$hashedpassword",
+				FullName:     "Suspended User",
+			})
+			require.NoError(t, err)
+			require.NoError(t, testUser.Activate())
+			require.NoError(t, testUser.Suspend(until, "spam", nil))
+			require.NoError(t, repository.Create(context.Background(), testUser))
+			return testUser
+		}
+
+		ended := suspend("ended@test.com", time.Now().Add(time.Minute))
+		suspend("ongoing@test.com", time.Now().Add(time.Hour))
+
+		users, err := repository.FindSuspensionsEndedBy(context.Background(), time.Now().Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, ended.ID(), users[0].ID())
+		assert.Equal(t, user.StatusSuspended, users[0].Status())
+		require.NotNil(t, users[0].SuspendedUntil())
+		assert.Equal(t, "spam", users[0].SuspensionReason())
+	})
+
+	t.Run("Preferences round trip and follow the user", func(t *testing.T) {
+		suite.CleanAllTables(t)
+		preferencesRepository := NewPreferencesRepository(suite.DatabasePool)
+
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "preferences@test.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Preferences User",
+		})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testUser))
+
+		_, err = preferencesRepository.FindByUserID(context.Background(), testUser.ID())
+		assert.ErrorIs(t, err, user.ErrPreferencesNotFound)
+
+		locale, timezone, dateFormat, accountUpdates := "vi", "Asia/Ho_Chi_Minh", "dmy", false
+		preferences := user.DefaultPreferences(testUser.ID())
+		require.NoError(t, preferences.Update(user.UpdatePreferencesParams{
+			Locale:         &locale,
+			Timezone:       &timezone,
+			DateFormat:     &dateFormat,
+			AccountUpdates: &accountUpdates,
+		}))
+		require.NoError(t, preferencesRepository.Save(context.Background(), preferences))
+		require.NoError(t, preferencesRepository.Save(context.Background(), preferences))
+
+		found, err := preferencesRepository.FindByUserID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "vi", found.Locale())
+		assert.Equal(t, "Asia/Ho_Chi_Minh", found.Timezone())
+		assert.Equal(t, user.DateFormatDMY, found.DateFormat())
+		assert.False(t, found.Notifications().AccountUpdates)
+
+		require.NoError(t, repository.Delete(context.Background(), testUser.ID()))
+		require.NoError(t, repository.Purge(context.Background(), testUser.ID()))
+		_, err = preferencesRepository.FindByUserID(context.Background(), testUser.ID())
+		assert.ErrorIs(t, err, user.ErrPreferencesNotFound)
+	})
+
+	t.Run("RecordLogin leaves updated_at alone", func(t *testing.T) {
+		suite.CleanAllTables(t)
+
+		testUser, err := user.NewUser(user.NewUserParams{
+			Email:        "login@test.com",
+			PasswordHash: "$2a$10$hashedpassword",
+			FullName:     "Login User",
+		})
+		require.NoError(t, err)
+		require.NoError(t, repository.Create(context.Background(), testUser))
+
+		before, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+
+		require.NoError(t, repository.RecordLogin(context.Background(), testUser.ID(), time.Now()))
+
+		after, err := repository.FindByID(context.Background(), testUser.ID())
+		require.NoError(t, err)
+		require.NotNil(t, after.LastLoginAt())
+		assert.True(t, before.UpdatedAt().Equal(after.UpdatedAt()))
 	})
 
 	t.Run("Delete soft deletes user", func(t *testing.T) {
@@ -169,10 +329,7 @@ func TestUserRepository_Integration(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		users, total, err := repository.List(context.Background(), user.ListFilter{}, user.ListPagination{
-			Page:  1,
-			Limit: 2,
-		})
+		users, total, err := repository.List(context.Background(), user.Filter{}, shared.NewPagination(1, 2))
 		require.NoError(t, err)
 		assert.Equal(t, int64(5), total)
 		assert.Len(t, users, 2)
@@ -201,13 +358,9 @@ func TestUserRepository_Integration(t *testing.T) {
 		err = repository.Create(context.Background(), pendingUser)
 		require.NoError(t, err)
 
-		activeStatus := user.StatusActive
-		users, total, err := repository.List(context.Background(), user.ListFilter{
-			Status: &activeStatus,
-		}, user.ListPagination{
-			Page:  1,
-			Limit: 10,
-		})
+		users, total, err := repository.List(context.Background(), user.Filter{
+			Statuses: []user.Status{user.StatusActive},
+		}, shared.NewPagination(1, 10))
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, users, 1)
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBlobStore keeps blobs as files under a root directory. The files are
+// expected to be served under publicBaseURL, for example by the API's media
+// route.
+type LocalBlobStore struct {
+	root          string
+	publicBaseURL string
+}
+
+func NewLocalBlobStore(root, publicBaseURL string) *LocalBlobStore {
+	return &LocalBlobStore{
+		root:          root,
+		publicBaseURL: strings.TrimSuffix(publicBaseURL, "/"),
+	}
+}
+
+func (store *LocalBlobStore) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial blob.
+	temporary, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	defer os.Remove(temporary.Name())
+
+	if _, err := temporary.Write(content); err != nil {
+		temporary.Close()
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+	if err := temporary.Close(); err != nil {
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+	if err := os.Chmod(temporary.Name(), 0o644); err != nil {
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+
+	if err := os.Rename(temporary.Name(), path); err != nil {
+		return fmt.Errorf("store blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (store *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (store *LocalBlobStore) URL(key string) string {
+	return store.publicBaseURL + "/" + key
+}
+
+func (store *LocalBlobStore) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(store.root, filepath.FromSlash(key)), nil
+}
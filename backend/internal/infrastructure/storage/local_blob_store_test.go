@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	root := t.TempDir()
+	store := NewLocalBlobStore(root, "/media/")
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "avatars/1/small.png", []byte("png-bytes"), "image/png"))
+	content, err := os.ReadFile(filepath.Join(root, "avatars", "1", "small.png"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png-bytes"), content)
+	assert.Equal(t, "/media/avatars/1/small.png", store.URL("avatars/1/small.png"))
+
+	require.NoError(t, store.Delete(ctx, "avatars/1/small.png"))
+	_, err = os.Stat(filepath.Join(root, "avatars", "1", "small.png"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, store.Delete(ctx, "avatars/1/small.png"), "deleting a missing blob succeeds")
+
+	assert.Error(t, store.Put(ctx, "../escape.png", []byte("x"), "image/png"))
+	assert.Error(t, store.Put(ctx, "/etc/passwd", []byte("x"), "text/plain"))
+}
@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service          = "s3"
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3AmzDateLayout    = "20060102T150405Z"
+	s3DateLayout       = "20060102"
+	s3RequestTimeout   = 30 * time.Second
+)
+
+type S3BlobStoreConfig struct {
+	// Endpoint is the service URL, such as https://s3.eu-west-1.amazonaws.com
+	// or http://localhost:9000 for MinIO. Buckets are addressed path-style.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicBaseURL, when set, replaces endpoint/bucket in object URLs, for
+	// example to serve objects through a CDN.
+	PublicBaseURL string
+	HTTPClient    *http.Client
+}
+
+// S3BlobStore talks to any S3-compatible object store using Signature
+// Version 4.
+type S3BlobStore struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	publicBaseURL   string
+	client          *http.Client
+	now             func() time.Time
+}
+
+func NewS3BlobStore(config S3BlobStoreConfig) (*S3BlobStore, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: s3RequestTimeout}
+	}
+
+	publicBaseURL := strings.TrimSuffix(config.PublicBaseURL, "/")
+	if publicBaseURL == "" {
+		publicBaseURL = endpoint.String() + "/" + config.Bucket
+	}
+
+	return &S3BlobStore{
+		endpoint:        endpoint,
+		region:          config.Region,
+		bucket:          config.Bucket,
+		accessKeyID:     config.AccessKeyID,
+		secretAccessKey: config.SecretAccessKey,
+		publicBaseURL:   publicBaseURL,
+		client:          client,
+		now:             time.Now,
+	}, nil
+}
+
+func (store *S3BlobStore) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	request, err := store.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", contentType)
+
+	return store.do(request, "put", key, http.StatusOK)
+}
+
+func (store *S3BlobStore) Delete(ctx context.Context, key string) error {
+	request, err := store.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	return store.do(request, "delete", key, http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+}
+
+func (store *S3BlobStore) URL(key string) string {
+	return store.publicBaseURL + "/" + escapePath(key)
+}
+
+func (store *S3BlobStore) newRequest(ctx context.Context, method, key string, content []byte) (*http.Request, error) {
+	objectURL := *store.endpoint
+	objectURL.Path = store.endpoint.Path + "/" + store.bucket + "/" + key
+	objectURL.RawPath = store.endpoint.Path + "/" + escapePath(store.bucket+"/"+key)
+
+	request, err := http.NewRequestWithContext(ctx, method, objectURL.String(), bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("build s3 request: %w", err)
+	}
+	request.ContentLength = int64(len(content))
+
+	payloadHash := sha256.Sum256(content)
+	request.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	return request, nil
+}
+
+func (store *S3BlobStore) do(request *http.Request, operation, key string, acceptedStatuses ...int) error {
+	store.sign(request, store.now().UTC())
+
+	response, err := store.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("s3 %s %s: %w", operation, key, err)
+	}
+	defer response.Body.Close()
+
+	for _, status := range acceptedStatuses {
+		if response.StatusCode == status {
+			io.Copy(io.Discard, response.Body)
+			return nil
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return fmt.Errorf("s3 %s %s: unexpected status %d: %s", operation, key, response.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// sign adds a Signature Version 4 Authorization header covering the host,
+// the content type and every x-amz-* header. The payload hash must already
+// be set in X-Amz-Content-Sha256.
+func (store *S3BlobStore) sign(request *http.Request, now time.Time) {
+	amzDate := now.Format(s3AmzDateLayout)
+	request.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		lowerName := strings.ToLower(name)
+		if lowerName == "content-type" || lowerName == "range" || strings.HasPrefix(lowerName, "x-amz-") {
+			headers[lowerName] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	signedHeaderNames := make([]string, 0, len(headers))
+	for name := range headers {
+		signedHeaderNames = append(signedHeaderNames, name)
+	}
+	sort.Strings(signedHeaderNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaderNames {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(signedHeaderNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		canonicalQuery(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		request.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := now.Format(s3DateLayout) + "/" + store.region + "/" + s3Service + "/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+store.secretAccessKey), now.Format(s3DateLayout))
+	signingKey = hmacSHA256(signingKey, store.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, store.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		sortedValues := append([]string(nil), values[key]...)
+		sort.Strings(sortedValues)
+		for _, value := range sortedValues {
+			pairs = append(pairs, escapeComponent(key)+"="+escapeComponent(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath percent-encodes every byte of a slash-separated path outside
+// the RFC 3986 unreserved set, as Signature Version 4 requires.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = escapeComponent(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func escapeComponent(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		character := value[i]
+		if ('A' <= character && character <= 'Z') || ('a' <= character && character <= 'z') ||
+			('0' <= character && character <= '9') || character == '-' || character == '_' || character == '.' || character == '~' {
+			builder.WriteByte(character)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", character)
+	}
+	return builder.String()
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAccessRequestRequest struct {
+	RoleID          uuid.UUID `json:"role_id" validate:"required"`
+	Justification   string    `json:"justification" validate:"required,min=1,max=1000"`
+	DurationMinutes int       `json:"duration_minutes" validate:"required,min=1"`
+}
+
+type ReviewAccessRequestRequest struct {
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+type AccessRequestResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	RequesterID     uuid.UUID  `json:"requester_id"`
+	RoleID          uuid.UUID  `json:"role_id"`
+	OrganizationID  *uuid.UUID `json:"organization_id,omitempty"`
+	Justification   string     `json:"justification"`
+	DurationSeconds int64      `json:"duration_seconds"`
+	Status          string     `json:"status"`
+	ReviewerID      *uuid.UUID `json:"reviewer_id,omitempty"`
+	ReviewComment   string     `json:"review_comment,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type PaginatedAccessRequestsResponse struct {
+	Items      []AccessRequestResponse `json:"items"`
+	Total      int64                   `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	TotalPages int                     `json:"total_pages"`
+	HasNext    bool                    `json:"has_next"`
+	HasPrev    bool                    `json:"has_prev"`
+}
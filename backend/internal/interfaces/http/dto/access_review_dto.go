@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LaunchAccessReviewRequest struct {
+	Name        string      `json:"name" validate:"required,min=1,max=200"`
+	Description string      `json:"description" validate:"omitempty,max=1000"`
+	RoleIDs     []uuid.UUID `json:"role_ids"`
+	UserIDs     []uuid.UUID `json:"user_ids"`
+	DueAt       time.Time   `json:"due_at" validate:"required"`
+}
+
+type AssignAccessReviewerRequest struct {
+	RoleID     uuid.UUID `json:"role_id" validate:"required"`
+	ReviewerID uuid.UUID `json:"reviewer_id" validate:"required"`
+}
+
+type DecideAccessReviewItemRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=keep revoke"`
+	Comment  string `json:"comment" validate:"required,min=1,max=1000"`
+}
+
+type CloseAccessReviewRequest struct {
+	Force bool `json:"force"`
+}
+
+type AccessReviewItemResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	RoleID      uuid.UUID  `json:"role_id"`
+	ReviewerID  *uuid.UUID `json:"reviewer_id,omitempty"`
+	Decision    string     `json:"decision"`
+	Comment     string     `json:"comment,omitempty"`
+	DecidedBy   *uuid.UUID `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+type AccessReviewResponse struct {
+	ID           uuid.UUID                  `json:"id"`
+	Name         string                     `json:"name"`
+	Description  string                     `json:"description,omitempty"`
+	ScopeRoleIDs []uuid.UUID                `json:"scope_role_ids"`
+	ScopeUserIDs []uuid.UUID                `json:"scope_user_ids"`
+	Status       string                     `json:"status"`
+	DueAt        time.Time                  `json:"due_at"`
+	CreatedBy    uuid.UUID                  `json:"created_by"`
+	ClosedBy     *uuid.UUID                 `json:"closed_by,omitempty"`
+	ClosedAt     *time.Time                 `json:"closed_at,omitempty"`
+	Kept         int                        `json:"kept"`
+	Revoked      int                        `json:"revoked"`
+	Pending      int                        `json:"pending"`
+	Items        []AccessReviewItemResponse `json:"items"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
+}
+
+type PaginatedAccessReviewsResponse struct {
+	Items      []AccessReviewResponse `json:"items"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	TotalPages int                    `json:"total_pages"`
+	HasNext    bool                   `json:"has_next"`
+	HasPrev    bool                   `json:"has_prev"`
+}
+
+type AccessReviewAssignmentResponse struct {
+	CampaignID   uuid.UUID                `json:"campaign_id"`
+	CampaignName string                   `json:"campaign_name"`
+	DueAt        time.Time                `json:"due_at"`
+	Item         AccessReviewItemResponse `json:"item"`
+}
+
+type AccessReviewRevocationFailureResponse struct {
+	ItemID uuid.UUID `json:"item_id"`
+	UserID uuid.UUID `json:"user_id"`
+	RoleID uuid.UUID `json:"role_id"`
+	Reason string    `json:"reason"`
+}
+
+type CloseAccessReviewResponse struct {
+	Campaign           AccessReviewResponse                    `json:"campaign"`
+	RevocationsApplied int                                     `json:"revocations_applied"`
+	RevocationFailures []AccessReviewRevocationFailureResponse `json:"revocation_failures"`
+}
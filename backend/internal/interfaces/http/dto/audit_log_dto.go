@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogResponse struct {
+	ID            uuid.UUID      `json:"id"`
+	Timestamp     time.Time      `json:"timestamp"`
+	EventType     string         `json:"event_type"`
+	ActorID       *uuid.UUID     `json:"actor_id,omitempty"`
+	Action        string         `json:"action"`
+	ResourceType  string         `json:"resource_type,omitempty"`
+	ResourceID    string         `json:"resource_id,omitempty"`
+	IPAddress     string         `json:"ip_address,omitempty"`
+	UserAgent     string         `json:"user_agent,omitempty"`
+	Success       bool           `json:"success"`
+	FailureReason string         `json:"failure_reason,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+}
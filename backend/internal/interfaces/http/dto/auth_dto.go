@@ -13,14 +13,20 @@ type RegisterRequest struct {
 }
 
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	Email          string     `json:"email" validate:"required,email"`
+	Password       string     `json:"password" validate:"required"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
+type SwitchOrganizationRequest struct {
+	OrganizationID *uuid.UUID `json:"organization_id"`
+	RefreshToken   string     `json:"refresh_token" validate:"required"`
+}
+
 type LogoutRequest struct {
 	LogoutAll bool `json:"logout_all,omitempty"`
 }
@@ -35,19 +41,22 @@ type ResetPasswordRequest struct {
 }
 
 type AuthResponse struct {
-	User         UserResponse `json:"user"`
-	AccessToken  string       `json:"access_token"`
-	RefreshToken string       `json:"refresh_token"`
-	ExpiresAt    time.Time    `json:"expires_at"`
+	User           UserResponse `json:"user"`
+	AccessToken    string       `json:"access_token"`
+	RefreshToken   string       `json:"refresh_token"`
+	ExpiresAt      time.Time    `json:"expires_at"`
+	OrganizationID *uuid.UUID   `json:"organization_id,omitempty"`
 }
 
 type AuthUserResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Email       string    `json:"email"`
-	FullName    string    `json:"full_name"`
-	Status      string    `json:"status"`
-	Roles       []string  `json:"roles"`
-	Permissions []string  `json:"permissions"`
+	ID                uuid.UUID  `json:"id"`
+	Email             string     `json:"email"`
+	FullName          string     `json:"full_name"`
+	Status            string     `json:"status"`
+	OrganizationID    *uuid.UUID `json:"organization_id,omitempty"`
+	Roles             []string   `json:"roles"`
+	Permissions       []string   `json:"permissions"`
+	DeniedPermissions []string   `json:"denied_permissions"`
 }
 
 type SessionResponse struct {
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type CheckPermissionRequest struct {
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Permission     string     `json:"permission" validate:"required,max=100"`
+}
+
+type CheckPermissionsRequest struct {
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Permissions    []string   `json:"permissions" validate:"required,min=1,max=100,dive,required,max=100"`
+}
+
+type ExplainPermissionsRequest struct {
+	UserID         *uuid.UUID `json:"user_id,omitempty"`
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	Permissions    []string   `json:"permissions" validate:"omitempty,max=100,dive,required,max=100"`
+}
+
+type AuthzGrantResponse struct {
+	RoleID            uuid.UUID  `json:"role_id"`
+	RoleName          string     `json:"role_name"`
+	OrganizationID    *uuid.UUID `json:"organization_id,omitempty"`
+	Source            string     `json:"source"`
+	GroupID           *uuid.UUID `json:"group_id,omitempty"`
+	GroupName         string     `json:"group_name,omitempty"`
+	Permissions       []string   `json:"permissions,omitempty"`
+	DeniedPermissions []string   `json:"denied_permissions,omitempty"`
+	MatchedPermission string     `json:"matched_permission,omitempty"`
+}
+
+type AuthzDecisionResponse struct {
+	Permission     string               `json:"permission"`
+	Allowed        bool                 `json:"allowed"`
+	Reason         string               `json:"reason"`
+	MatchedGrants  []AuthzGrantResponse `json:"matched_grants"`
+	DenyingGrants  []AuthzGrantResponse `json:"denying_grants,omitempty"`
+	DeniedDirectly bool                 `json:"denied_directly,omitempty"`
+}
+
+type AuthzBatchDecisionResponse struct {
+	UserID         uuid.UUID               `json:"user_id"`
+	OrganizationID *uuid.UUID              `json:"organization_id,omitempty"`
+	Allowed        bool                    `json:"allowed"`
+	Decisions      []AuthzDecisionResponse `json:"decisions"`
+}
+
+type AuthzExplanationResponse struct {
+	UserID            uuid.UUID               `json:"user_id"`
+	OrganizationID    *uuid.UUID              `json:"organization_id,omitempty"`
+	DenialReason      string                  `json:"denial_reason,omitempty"`
+	Roles             []string                `json:"roles"`
+	Permissions       []string                `json:"permissions"`
+	DeniedPermissions []string                `json:"denied_permissions"`
+	Grants            []AuthzGrantResponse    `json:"grants"`
+	Decisions         []AuthzDecisionResponse `json:"decisions"`
+}
+
+type SimulateAccessChangeRequest struct {
+	Type                string      `json:"type" validate:"required,oneof=role_permissions user_roles role_deletion"`
+	RoleID              *uuid.UUID  `json:"role_id,omitempty"`
+	AddPermissionIDs    []uuid.UUID `json:"add_permission_ids" validate:"omitempty,max=100"`
+	RemovePermissionIDs []uuid.UUID `json:"remove_permission_ids" validate:"omitempty,max=100"`
+	UserID              *uuid.UUID  `json:"user_id,omitempty"`
+	RoleIDs             []uuid.UUID `json:"role_ids" validate:"omitempty,max=100"`
+	OrganizationID      *uuid.UUID  `json:"organization_id,omitempty"`
+}
+
+type AuthzPermissionImpactResponse struct {
+	UserID            uuid.UUID `json:"user_id"`
+	Email             string    `json:"email"`
+	FullName          string    `json:"full_name"`
+	GainedPermissions []string  `json:"gained_permissions"`
+	LostPermissions   []string  `json:"lost_permissions"`
+}
+
+type AuthzSimulationResponse struct {
+	ChangeType     string                          `json:"change_type"`
+	OrganizationID *uuid.UUID                      `json:"organization_id,omitempty"`
+	UsersEvaluated int                             `json:"users_evaluated"`
+	UsersAffected  int                             `json:"users_affected"`
+	Impacts        []AuthzPermissionImpactResponse `json:"impacts"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateGroupRequest struct {
+	Name        string      `json:"name" validate:"required,min=1,max=100"`
+	Description string      `json:"description" validate:"omitempty,max=500"`
+	RoleIDs     []uuid.UUID `json:"role_ids" validate:"omitempty,dive,required"`
+}
+
+type UpdateGroupRequest struct {
+	Name        string `json:"name" validate:"omitempty,min=1,max=100"`
+	Description string `json:"description" validate:"omitempty,max=500"`
+}
+
+type AddGroupMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type GroupResponse struct {
+	ID             uuid.UUID   `json:"id"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	OrganizationID *uuid.UUID  `json:"organization_id,omitempty"`
+	RoleIDs        []uuid.UUID `json:"role_ids"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+type PaginatedGroupsResponse struct {
+	Items      []GroupResponse `json:"items"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	Limit      int             `json:"limit"`
+	TotalPages int             `json:"total_pages"`
+	HasNext    bool            `json:"has_next"`
+	HasPrev    bool            `json:"has_prev"`
+}
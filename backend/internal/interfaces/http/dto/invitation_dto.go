@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateInvitationRequest struct {
+	Email   string      `json:"email" validate:"required,email"`
+	RoleIDs []uuid.UUID `json:"role_ids" validate:"omitempty,max=50"`
+}
+
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,password"`
+	FullName string `json:"full_name" validate:"required,min=2,max=255"`
+}
+
+type InvitationResponse struct {
+	ID         uuid.UUID   `json:"id"`
+	Email      string      `json:"email"`
+	RoleIDs    []uuid.UUID `json:"role_ids"`
+	InvitedBy  uuid.UUID   `json:"invited_by"`
+	Status     string      `json:"status"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+	UserID     *uuid.UUID  `json:"user_id,omitempty"`
+	AcceptedAt *time.Time  `json:"accepted_at,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+type PaginatedInvitationsResponse struct {
+	Items      []InvitationResponse `json:"items"`
+	Total      int64                `json:"total"`
+	Page       int                  `json:"page"`
+	Limit      int                  `json:"limit"`
+	TotalPages int                  `json:"total_pages"`
+	HasNext    bool                 `json:"has_next"`
+	HasPrev    bool                 `json:"has_prev"`
+}
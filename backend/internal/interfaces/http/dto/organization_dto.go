@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateOrganizationRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=255"`
+	Slug        string `json:"slug" validate:"required,min=1,max=100"`
+	Description string `json:"description" validate:"omitempty,max=500"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name        string `json:"name" validate:"omitempty,min=1,max=255"`
+	Description string `json:"description" validate:"omitempty,max=500"`
+}
+
+type AddOrganizationMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type OrganizationResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PaginatedOrganizationsResponse struct {
+	Items      []OrganizationResponse `json:"items"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	TotalPages int                    `json:"total_pages"`
+	HasNext    bool                   `json:"has_next"`
+	HasPrev    bool                   `json:"has_prev"`
+}
+
+type OrganizationMemberResponse struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	JoinedAt       time.Time `json:"joined_at"`
+}
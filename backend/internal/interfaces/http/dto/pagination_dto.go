@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+
+// CursorMeta is the response meta of a cursor-paginated listing. Clients
+// pass next_cursor or prev_cursor back as the cursor parameter.
+type CursorMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	HasNext    bool   `json:"has_next"`
+	HasPrev    bool   `json:"has_prev"`
+	Total      *int64 `json:"total,omitempty"`
+}
+
+func CursorMetaFromResult[T any](result shared.CursorResult[T]) CursorMeta {
+	return CursorMeta{
+		Limit:      result.Limit,
+		NextCursor: result.NextCursor,
+		PrevCursor: result.PrevCursor,
+		HasNext:    result.NextCursor != "",
+		HasPrev:    result.PrevCursor != "",
+		Total:      result.Total,
+	}
+}
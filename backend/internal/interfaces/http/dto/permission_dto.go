@@ -23,6 +23,34 @@ type PermissionResponse struct {
 	Code        string    `json:"code"`
 	Description string    `json:"description"`
 	IsSystem    bool      `json:"is_system"`
+	Version     int64     `json:"version,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
+
+type PermissionGrantResponse struct {
+	PermissionResponse
+	Effect   string   `json:"effect"`
+	DeniedBy []string `json:"denied_by,omitempty"`
+}
+
+type PermissionRequirementResponse struct {
+	Permissions []string `json:"permissions"`
+	Match       string   `json:"match"`
+}
+
+type RoutePermissionResponse struct {
+	Method       string                          `json:"method"`
+	Pattern      string                          `json:"pattern"`
+	Requirements []PermissionRequirementResponse `json:"requirements"`
+}
+
+type MissingPermissionResponse struct {
+	Code         string   `json:"code"`
+	ReferencedBy []string `json:"referenced_by"`
+}
+
+type PermissionDriftResponse struct {
+	Missing []MissingPermissionResponse `json:"missing"`
+	Unused  []PermissionResponse        `json:"unused"`
+}
@@ -7,10 +7,11 @@ import (
 )
 
 type CreateRoleRequest struct {
-	Name          string      `json:"name" validate:"required,min=1,max=100"`
-	DisplayName   string      `json:"display_name" validate:"required,min=1,max=255"`
-	Description   string      `json:"description" validate:"omitempty,max=500"`
-	PermissionIDs []uuid.UUID `json:"permission_ids" validate:"omitempty,dive,uuid4"`
+	Name           string      `json:"name" validate:"required,min=1,max=100"`
+	DisplayName    string      `json:"display_name" validate:"required,min=1,max=255"`
+	Description    string      `json:"description" validate:"omitempty,max=500"`
+	PermissionIDs  []uuid.UUID `json:"permission_ids" validate:"omitempty,dive,uuid4"`
+	OrganizationID *uuid.UUID  `json:"organization_id,omitempty"`
 }
 
 type UpdateRoleRequest struct {
@@ -27,28 +28,33 @@ type SetUserRolesRequest struct {
 }
 
 type RoleResponse struct {
-	ID            uuid.UUID   `json:"id"`
-	Name          string      `json:"name"`
-	DisplayName   string      `json:"display_name"`
-	Description   string      `json:"description"`
-	PermissionIDs []uuid.UUID `json:"permission_ids"`
-	IsSystem      bool        `json:"is_system"`
-	IsDefault     bool        `json:"is_default"`
-	Priority      int         `json:"priority"`
-	CreatedAt     time.Time   `json:"created_at"`
-	UpdatedAt     time.Time   `json:"updated_at"`
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	DisplayName         string      `json:"display_name"`
+	Description         string      `json:"description"`
+	PermissionIDs       []uuid.UUID `json:"permission_ids"`
+	DeniedPermissionIDs []uuid.UUID `json:"denied_permission_ids"`
+	IsSystem            bool        `json:"is_system"`
+	IsDefault           bool        `json:"is_default"`
+	Priority            int         `json:"priority"`
+	OrganizationID      *uuid.UUID  `json:"organization_id,omitempty"`
+	Version             int64       `json:"version,omitempty"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
 }
 
 type RoleWithPermissionsResponse struct {
-	ID            uuid.UUID   `json:"id"`
-	Name          string      `json:"name"`
-	DisplayName   string      `json:"display_name"`
-	Description   string      `json:"description"`
-	Permissions   []string    `json:"permissions"`
-	PermissionIDs []uuid.UUID `json:"permission_ids"`
-	IsSystem      bool        `json:"is_system"`
-	IsDefault     bool        `json:"is_default"`
-	Priority      int         `json:"priority"`
-	CreatedAt     time.Time   `json:"created_at"`
-	UpdatedAt     time.Time   `json:"updated_at"`
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	DisplayName         string      `json:"display_name"`
+	Description         string      `json:"description"`
+	Permissions         []string    `json:"permissions"`
+	PermissionIDs       []uuid.UUID `json:"permission_ids"`
+	DeniedPermissionIDs []uuid.UUID `json:"denied_permission_ids"`
+	IsSystem            bool        `json:"is_system"`
+	IsDefault           bool        `json:"is_default"`
+	Priority            int         `json:"priority"`
+	Version             int64       `json:"version,omitempty"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
 }
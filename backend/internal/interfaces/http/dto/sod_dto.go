@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateSoDRuleRequest struct {
+	Name        string      `json:"name" validate:"required,min=1,max=100"`
+	Description string      `json:"description" validate:"omitempty,max=500"`
+	Type        string      `json:"type" validate:"required,oneof=mutual_exclusion max_cardinality"`
+	RoleIDs     []uuid.UUID `json:"role_ids" validate:"required,min=1,dive,required"`
+	MaxHolders  int         `json:"max_holders" validate:"omitempty,min=1"`
+}
+
+type UpdateSoDRuleRequest struct {
+	Name        string      `json:"name" validate:"omitempty,min=1,max=100"`
+	Description string      `json:"description" validate:"omitempty,max=500"`
+	RoleIDs     []uuid.UUID `json:"role_ids" validate:"omitempty,min=1,dive,required"`
+	MaxHolders  int         `json:"max_holders" validate:"omitempty,min=1"`
+}
+
+type SoDRuleResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	RoleIDs     []uuid.UUID `json:"role_ids"`
+	MaxHolders  int         `json:"max_holders,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type SoDViolationResponse struct {
+	RuleID   uuid.UUID   `json:"rule_id"`
+	RuleName string      `json:"rule_name"`
+	RuleType string      `json:"rule_type"`
+	UserID   uuid.UUID   `json:"user_id"`
+	RoleIDs  []uuid.UUID `json:"role_ids"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttributeRulesRequest struct {
+	MinLength *int     `json:"min_length,omitempty" validate:"omitempty,min=0"`
+	MaxLength *int     `json:"max_length,omitempty" validate:"omitempty,min=1"`
+	Pattern   string   `json:"pattern,omitempty" validate:"omitempty,max=500"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Options   []string `json:"options,omitempty" validate:"omitempty,dive,required,max=100"`
+}
+
+type CreateAttributeDefinitionRequest struct {
+	Key         string                `json:"key" validate:"required,max=63"`
+	Label       string                `json:"label" validate:"omitempty,max=100"`
+	Description string                `json:"description" validate:"omitempty,max=500"`
+	Type        string                `json:"type" validate:"required,oneof=string number boolean date enum"`
+	Required    bool                  `json:"required"`
+	Visibility  string                `json:"visibility" validate:"omitempty,oneof=public self admin"`
+	Rules       AttributeRulesRequest `json:"rules"`
+}
+
+type UpdateAttributeDefinitionRequest struct {
+	Label       string                 `json:"label" validate:"omitempty,max=100"`
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=500"`
+	Required    *bool                  `json:"required,omitempty"`
+	Visibility  string                 `json:"visibility" validate:"omitempty,oneof=public self admin"`
+	Rules       *AttributeRulesRequest `json:"rules,omitempty"`
+}
+
+type AttributeRulesResponse struct {
+	MinLength *int     `json:"min_length,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Options   []string `json:"options,omitempty"`
+}
+
+type AttributeDefinitionResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	Key         string                 `json:"key"`
+	Label       string                 `json:"label"`
+	Description string                 `json:"description"`
+	Type        string                 `json:"type"`
+	Required    bool                   `json:"required"`
+	Visibility  string                 `json:"visibility"`
+	Rules       AttributeRulesResponse `json:"rules"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
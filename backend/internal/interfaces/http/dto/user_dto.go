@@ -9,13 +9,15 @@ import (
 )
 
 type CreateUserRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,password"`
-	FullName string `json:"full_name" validate:"required,min=2,max=255"`
+	Email      string         `json:"email" validate:"required,email"`
+	Password   string         `json:"password" validate:"required,password"`
+	FullName   string         `json:"full_name" validate:"required,min=2,max=255"`
+	Attributes map[string]any `json:"attributes,omitempty"`
 }
 
 type UpdateUserRequest struct {
-	FullName *string `json:"full_name,omitempty" validate:"omitempty,min=2,max=255"`
+	FullName   *string        `json:"full_name,omitempty" validate:"omitempty,min=2,max=255"`
+	Attributes map[string]any `json:"attributes,omitempty"`
 }
 
 type ChangePasswordRequest struct {
@@ -24,40 +26,96 @@ type ChangePasswordRequest struct {
 	ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=NewPassword"`
 }
 
+type RequestEmailChangeRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email"`
+}
+
+type EmailChangeTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// UpdatePreferencesRequest changes only the settings it contains. An empty
+// locale makes responses follow Accept-Language again.
+type UpdatePreferencesRequest struct {
+	Locale        *string                         `json:"locale,omitempty" validate:"omitempty,max=16"`
+	Timezone      *string                         `json:"timezone,omitempty" validate:"omitempty,min=1,max=64"`
+	DateFormat    *string                         `json:"date_format,omitempty" validate:"omitempty,oneof=iso dmy mdy"`
+	Notifications *NotificationPreferencesRequest `json:"notifications,omitempty"`
+}
+
+type NotificationPreferencesRequest struct {
+	AccountUpdates *bool `json:"account_updates,omitempty"`
+}
+
+type ChangeUserEmailRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 type BanUserRequest struct {
 	Reason string `json:"reason" validate:"required,min=1,max=500"`
 }
 
+type SuspendUserRequest struct {
+	Until  time.Time `json:"until" validate:"required"`
+	Reason string    `json:"reason" validate:"required,min=1,max=500"`
+}
+
+type UnbanUserRequest struct {
+	Justification string `json:"justification" validate:"required,min=1,max=1000"`
+}
+
 type ListUsersRequest struct {
-	Page      int     `json:"page" validate:"omitempty,gte=1"`
-	Limit     int     `json:"limit" validate:"omitempty,gte=1,lte=100"`
-	Status    *string `json:"status,omitempty" validate:"omitempty,oneof=pending active inactive banned"`
-	Search    *string `json:"search,omitempty" validate:"omitempty,max=255"`
-	SortBy    *string `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at email full_name"`
-	SortOrder *string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
-	DateFrom  *string `json:"date_from,omitempty"`
-	DateTo    *string `json:"date_to,omitempty"`
+	Page   int         `json:"page" validate:"omitempty,gte=1"`
+	Limit  int         `json:"limit" validate:"omitempty,gte=1,lte=100"`
+	Status []string    `json:"status,omitempty" validate:"omitempty,dive,oneof=pending active inactive suspended banned"`
+	RoleID []uuid.UUID `json:"role_id,omitempty"`
+	Search *string     `json:"search,omitempty" validate:"omitempty,max=255"`
+	// SortBy and SortOrder are comma-separated, e.g. sort_by=status,last_login_at
+	// with sort_order=asc,desc. Keys are created_at, updated_at, email,
+	// full_name, status, last_login_at and relevance.
+	SortBy        *string `json:"sort_by,omitempty" validate:"omitempty,max=100"`
+	SortOrder     *string `json:"sort_order,omitempty" validate:"omitempty,max=50"`
+	DateFrom      *string `json:"date_from,omitempty"`
+	DateTo        *string `json:"date_to,omitempty"`
+	LastLoginFrom *string `json:"last_login_from,omitempty"`
+	LastLoginTo   *string `json:"last_login_to,omitempty"`
+	NeverLoggedIn bool    `json:"never_logged_in,omitempty"`
 }
 
 type UserResponse struct {
-	ID        uuid.UUID  `json:"id"`
-	Email     string     `json:"email"`
-	FullName  string     `json:"full_name"`
-	Status    string     `json:"status"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	ID               uuid.UUID         `json:"id"`
+	Email            string            `json:"email"`
+	FullName         string            `json:"full_name"`
+	Status           string            `json:"status"`
+	SuspendedUntil   *time.Time        `json:"suspended_until,omitempty"`
+	SuspensionReason string            `json:"suspension_reason,omitempty"`
+	Attributes       map[string]any    `json:"attributes,omitempty"`
+	AvatarURLs       map[string]string `json:"avatar_urls,omitempty"`
+	Version          int64             `json:"version,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	DeletedAt        *time.Time        `json:"deleted_at,omitempty"`
+	LastLoginAt      *time.Time        `json:"last_login_at,omitempty"`
 }
 
 func UserResponseFromDomain(domainUser *user.User) UserResponse {
+	var avatarURLs map[string]string
+	if avatar := domainUser.Avatar(); avatar != nil {
+		avatarURLs = avatar.URLs
+	}
 	return UserResponse{
-		ID:        domainUser.ID(),
-		Email:     domainUser.Email().String(),
-		FullName:  domainUser.FullName().String(),
-		Status:    domainUser.Status().String(),
-		CreatedAt: domainUser.CreatedAt(),
-		UpdatedAt: domainUser.UpdatedAt(),
-		DeletedAt: domainUser.DeletedAt(),
+		ID:               domainUser.ID(),
+		Email:            domainUser.Email().String(),
+		FullName:         domainUser.FullName().String(),
+		Status:           domainUser.Status().String(),
+		SuspendedUntil:   domainUser.SuspendedUntil(),
+		SuspensionReason: domainUser.SuspensionReason(),
+		AvatarURLs:       avatarURLs,
+		Version:          domainUser.Version(),
+		CreatedAt:        domainUser.CreatedAt(),
+		UpdatedAt:        domainUser.UpdatedAt(),
+		DeletedAt:        domainUser.DeletedAt(),
+		LastLoginAt:      domainUser.LastLoginAt(),
 	}
 }
 
@@ -78,3 +136,99 @@ type PaginatedUsersResponse struct {
 	HasNext    bool           `json:"has_next"`
 	HasPrev    bool           `json:"has_prev"`
 }
+
+type ImportRowErrorResponse struct {
+	Row   int    `json:"row"`
+	Email string `json:"email,omitempty"`
+	Error string `json:"error"`
+}
+
+type ImportUsersResponse struct {
+	DryRun    bool                     `json:"dry_run"`
+	Total     int                      `json:"total"`
+	Created   int                      `json:"created"`
+	Updated   int                      `json:"updated"`
+	Unchanged int                      `json:"unchanged"`
+	Failed    int                      `json:"failed"`
+	Invited   int                      `json:"invited"`
+	Errors    []ImportRowErrorResponse `json:"errors"`
+}
+
+type ImportProgressResponse struct {
+	Stage     string `json:"stage"`
+	Processed int    `json:"processed"`
+	Total     int    `json:"total"`
+}
+
+type BulkUserFilterRequest struct {
+	Status *string `json:"status,omitempty" validate:"omitempty,oneof=pending active inactive suspended banned"`
+	Search *string `json:"search,omitempty" validate:"omitempty,max=255"`
+}
+
+type BulkUserOperationRequest struct {
+	Action  string                 `json:"action" validate:"required,oneof=activate deactivate ban delete set_roles"`
+	UserIDs []uuid.UUID            `json:"user_ids" validate:"omitempty,max=1000"`
+	Filter  *BulkUserFilterRequest `json:"filter,omitempty"`
+	Reason  string                 `json:"reason" validate:"omitempty,max=500"`
+	RoleIDs []uuid.UUID            `json:"role_ids"`
+}
+
+type BulkOperationItemResponse struct {
+	UserID      uuid.UUID  `json:"user_id"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+}
+
+type BulkOperationResponse struct {
+	ID            uuid.UUID                   `json:"id"`
+	Action        string                      `json:"action"`
+	Reason        string                      `json:"reason,omitempty"`
+	RoleIDs       []uuid.UUID                 `json:"role_ids,omitempty"`
+	Status        string                      `json:"status"`
+	CreatedBy     uuid.UUID                   `json:"created_by"`
+	Total         int                         `json:"total"`
+	Succeeded     int                         `json:"succeeded"`
+	Failed        int                         `json:"failed"`
+	Pending       int                         `json:"pending"`
+	FailureReason string                      `json:"failure_reason,omitempty"`
+	Items         []BulkOperationItemResponse `json:"items"`
+	StartedAt     *time.Time                  `json:"started_at,omitempty"`
+	CompletedAt   *time.Time                  `json:"completed_at,omitempty"`
+	CreatedAt     time.Time                   `json:"created_at"`
+	UpdatedAt     time.Time                   `json:"updated_at"`
+}
+
+type ErasureReceiptResponse struct {
+	UserID                    uuid.UUID  `json:"user_id"`
+	ErasedAt                  time.Time  `json:"erased_at"`
+	ErasedBy                  *uuid.UUID `json:"erased_by,omitempty"`
+	RefreshTokensDeleted      int64      `json:"refresh_tokens_deleted"`
+	ResetTokensDeleted        int64      `json:"reset_tokens_deleted"`
+	InvitationsDeleted        int64      `json:"invitations_deleted"`
+	AuditEntriesPseudonymized int64      `json:"audit_entries_pseudonymized"`
+	AvatarRemoved             bool       `json:"avatar_removed"`
+}
+
+type EmailChangeResponse struct {
+	NewEmail    string    `json:"new_email"`
+	RequestedAt time.Time `json:"requested_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type PreferencesResponse struct {
+	Locale        string                          `json:"locale"`
+	Timezone      string                          `json:"timezone"`
+	DateFormat    string                          `json:"date_format"`
+	Notifications NotificationPreferencesResponse `json:"notifications"`
+	UpdatedAt     *time.Time                      `json:"updated_at,omitempty"`
+}
+
+type NotificationPreferencesResponse struct {
+	AccountUpdates bool `json:"account_updates"`
+}
+
+type EmailChangeConfirmedResponse struct {
+	UserID uuid.UUID `json:"user_id"`
+	Email  string    `json:"email"`
+}
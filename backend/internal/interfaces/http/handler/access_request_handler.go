@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	accessrequestcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/command"
+	accessrequestdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/dto"
+	accessrequestquery "github.com/tranvuongduy2003/go-copilot/internal/application/accessrequest/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type AccessRequestHandler struct {
+	createAccessRequestHandler       *accessrequestcommand.CreateAccessRequestHandler
+	approveAccessRequestHandler      *accessrequestcommand.ApproveAccessRequestHandler
+	denyAccessRequestHandler         *accessrequestcommand.DenyAccessRequestHandler
+	cancelAccessRequestHandler       *accessrequestcommand.CancelAccessRequestHandler
+	getAccessRequestHandler          *accessrequestquery.GetAccessRequestHandler
+	listPendingAccessRequestsHandler *accessrequestquery.ListPendingAccessRequestsHandler
+	listMyAccessRequestsHandler      *accessrequestquery.ListMyAccessRequestsHandler
+	validator                        *validator.Validator
+	logger                           logger.Logger
+}
+
+type AccessRequestHandlerParams struct {
+	CreateAccessRequestHandler       *accessrequestcommand.CreateAccessRequestHandler
+	ApproveAccessRequestHandler      *accessrequestcommand.ApproveAccessRequestHandler
+	DenyAccessRequestHandler         *accessrequestcommand.DenyAccessRequestHandler
+	CancelAccessRequestHandler       *accessrequestcommand.CancelAccessRequestHandler
+	GetAccessRequestHandler          *accessrequestquery.GetAccessRequestHandler
+	ListPendingAccessRequestsHandler *accessrequestquery.ListPendingAccessRequestsHandler
+	ListMyAccessRequestsHandler      *accessrequestquery.ListMyAccessRequestsHandler
+	Validator                        *validator.Validator
+	Logger                           logger.Logger
+}
+
+func NewAccessRequestHandler(params AccessRequestHandlerParams) *AccessRequestHandler {
+	return &AccessRequestHandler{
+		createAccessRequestHandler:       params.CreateAccessRequestHandler,
+		approveAccessRequestHandler:      params.ApproveAccessRequestHandler,
+		denyAccessRequestHandler:         params.DenyAccessRequestHandler,
+		cancelAccessRequestHandler:       params.CancelAccessRequestHandler,
+		getAccessRequestHandler:          params.GetAccessRequestHandler,
+		listPendingAccessRequestsHandler: params.ListPendingAccessRequestsHandler,
+		listMyAccessRequestsHandler:      params.ListMyAccessRequestsHandler,
+		validator:                        params.Validator,
+		logger:                           params.Logger,
+	}
+}
+
+func (handler *AccessRequestHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	var requestBody dto.CreateAccessRequestRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := accessrequestcommand.CreateAccessRequestCommand{
+		RequesterID:   authContext.UserID,
+		RoleID:        requestBody.RoleID,
+		Justification: requestBody.Justification,
+		Duration:      time.Duration(requestBody.DurationMinutes) * time.Minute,
+	}
+
+	accessRequestDTO, err := handler.createAccessRequestHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/access-requests/" + accessRequestDTO.ID.String()
+	response.CreatedWithLocation(writer, toAccessRequestResponse(accessRequestDTO), location)
+}
+
+func (handler *AccessRequestHandler) ListMine(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	page, limit := parseAccessRequestPagination(request)
+	query := accessrequestquery.ListMyAccessRequestsQuery{
+		RequesterID: authContext.UserID,
+		Page:        page,
+		Limit:       limit,
+	}
+	if statusStr := request.URL.Query().Get("status"); statusStr != "" {
+		status, valid := accessrequest.ParseStatus(statusStr)
+		if !valid {
+			response.BadRequest(writer, request, "invalid status")
+			return
+		}
+		query.Status = &status
+	}
+
+	result, err := handler.listMyAccessRequestsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toPaginatedAccessRequestsResponse(result))
+}
+
+func (handler *AccessRequestHandler) ListPending(writer http.ResponseWriter, request *http.Request) {
+	page, limit := parseAccessRequestPagination(request)
+	query := accessrequestquery.ListPendingAccessRequestsQuery{
+		Page:  page,
+		Limit: limit,
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok {
+		query.OrganizationID = authContext.OrganizationID
+	}
+
+	result, err := handler.listPendingAccessRequestsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toPaginatedAccessRequestsResponse(result))
+}
+
+func (handler *AccessRequestHandler) Get(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	requestID, ok := parseAccessRequestID(writer, request)
+	if !ok {
+		return
+	}
+
+	query := accessrequestquery.GetAccessRequestQuery{
+		RequestID: requestID,
+		ViewerID:  authContext.UserID,
+	}
+
+	accessRequestDTO, err := handler.getAccessRequestHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessRequestResponse(accessRequestDTO))
+}
+
+func (handler *AccessRequestHandler) Approve(writer http.ResponseWriter, request *http.Request) {
+	authContext, requestID, requestBody, ok := handler.parseReview(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := accessrequestcommand.ApproveAccessRequestCommand{
+		RequestID:  requestID,
+		ReviewerID: authContext.UserID,
+		Comment:    requestBody.Comment,
+	}
+
+	accessRequestDTO, err := handler.approveAccessRequestHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessRequestResponse(accessRequestDTO))
+}
+
+func (handler *AccessRequestHandler) Deny(writer http.ResponseWriter, request *http.Request) {
+	authContext, requestID, requestBody, ok := handler.parseReview(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := accessrequestcommand.DenyAccessRequestCommand{
+		RequestID:  requestID,
+		ReviewerID: authContext.UserID,
+		Comment:    requestBody.Comment,
+	}
+
+	accessRequestDTO, err := handler.denyAccessRequestHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessRequestResponse(accessRequestDTO))
+}
+
+func (handler *AccessRequestHandler) Cancel(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	requestID, ok := parseAccessRequestID(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := accessrequestcommand.CancelAccessRequestCommand{
+		RequestID:   requestID,
+		RequesterID: authContext.UserID,
+	}
+
+	accessRequestDTO, err := handler.cancelAccessRequestHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessRequestResponse(accessRequestDTO))
+}
+
+func (handler *AccessRequestHandler) parseReview(writer http.ResponseWriter, request *http.Request) (*middleware.AuthContext, uuid.UUID, dto.ReviewAccessRequestRequest, bool) {
+	var requestBody dto.ReviewAccessRequestRequest
+
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return nil, uuid.Nil, requestBody, false
+	}
+
+	requestID, ok := parseAccessRequestID(writer, request)
+	if !ok {
+		return nil, uuid.Nil, requestBody, false
+	}
+
+	if request.ContentLength != 0 {
+		if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+			response.BadRequest(writer, request, "invalid request body")
+			return nil, uuid.Nil, requestBody, false
+		}
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return nil, uuid.Nil, requestBody, false
+		}
+		response.BadRequest(writer, request, err.Error())
+		return nil, uuid.Nil, requestBody, false
+	}
+
+	return authContext, requestID, requestBody, true
+}
+
+func parseAccessRequestID(writer http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	requestID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid access request id")
+		return uuid.Nil, false
+	}
+	return requestID, true
+}
+
+func parseAccessRequestPagination(request *http.Request) (int, int) {
+	queryParams := request.URL.Query()
+
+	page := 1
+	if pageStr := queryParams.Get("page"); pageStr != "" {
+		if parsedPage, err := strconv.Atoi(pageStr); err == nil && parsedPage > 0 {
+			page = parsedPage
+		}
+	}
+
+	limit := 20
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+
+	return page, limit
+}
+
+func toAccessRequestResponse(accessRequestDTO *accessrequestdto.AccessRequestDTO) dto.AccessRequestResponse {
+	return dto.AccessRequestResponse{
+		ID:              accessRequestDTO.ID,
+		RequesterID:     accessRequestDTO.RequesterID,
+		RoleID:          accessRequestDTO.RoleID,
+		OrganizationID:  accessRequestDTO.OrganizationID,
+		Justification:   accessRequestDTO.Justification,
+		DurationSeconds: accessRequestDTO.DurationSeconds,
+		Status:          accessRequestDTO.Status,
+		ReviewerID:      accessRequestDTO.ReviewerID,
+		ReviewComment:   accessRequestDTO.ReviewComment,
+		ReviewedAt:      accessRequestDTO.ReviewedAt,
+		ExpiresAt:       accessRequestDTO.ExpiresAt,
+		CreatedAt:       accessRequestDTO.CreatedAt,
+		UpdatedAt:       accessRequestDTO.UpdatedAt,
+	}
+}
+
+func toPaginatedAccessRequestsResponse(result *accessrequestdto.PaginatedAccessRequestsDTO) dto.PaginatedAccessRequestsResponse {
+	items := make([]dto.AccessRequestResponse, len(result.Items))
+	for i, accessRequestDTO := range result.Items {
+		items[i] = toAccessRequestResponse(accessRequestDTO)
+	}
+
+	return dto.PaginatedAccessRequestsResponse{
+		Items:      items,
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+		HasNext:    result.HasNext,
+		HasPrev:    result.HasPrev,
+	}
+}
@@ -0,0 +1,390 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	accessreviewcommand "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/command"
+	accessreviewdto "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/dto"
+	accessreviewquery "github.com/tranvuongduy2003/go-copilot/internal/application/accessreview/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+const EvidenceSignatureHeader = "X-Evidence-Signature"
+
+type AccessReviewHandler struct {
+	launchAccessReviewHandler         *accessreviewcommand.LaunchAccessReviewHandler
+	assignAccessReviewerHandler       *accessreviewcommand.AssignAccessReviewerHandler
+	decideAccessReviewItemHandler     *accessreviewcommand.DecideAccessReviewItemHandler
+	closeAccessReviewHandler          *accessreviewcommand.CloseAccessReviewHandler
+	getAccessReviewHandler            *accessreviewquery.GetAccessReviewHandler
+	listAccessReviewsHandler          *accessreviewquery.ListAccessReviewsHandler
+	listMyReviewAssignmentsHandler    *accessreviewquery.ListMyReviewAssignmentsHandler
+	exportAccessReviewEvidenceHandler *accessreviewquery.ExportAccessReviewEvidenceHandler
+	validator                         *validator.Validator
+	logger                            logger.Logger
+}
+
+type AccessReviewHandlerParams struct {
+	LaunchAccessReviewHandler         *accessreviewcommand.LaunchAccessReviewHandler
+	AssignAccessReviewerHandler       *accessreviewcommand.AssignAccessReviewerHandler
+	DecideAccessReviewItemHandler     *accessreviewcommand.DecideAccessReviewItemHandler
+	CloseAccessReviewHandler          *accessreviewcommand.CloseAccessReviewHandler
+	GetAccessReviewHandler            *accessreviewquery.GetAccessReviewHandler
+	ListAccessReviewsHandler          *accessreviewquery.ListAccessReviewsHandler
+	ListMyReviewAssignmentsHandler    *accessreviewquery.ListMyReviewAssignmentsHandler
+	ExportAccessReviewEvidenceHandler *accessreviewquery.ExportAccessReviewEvidenceHandler
+	Validator                         *validator.Validator
+	Logger                            logger.Logger
+}
+
+func NewAccessReviewHandler(params AccessReviewHandlerParams) *AccessReviewHandler {
+	return &AccessReviewHandler{
+		launchAccessReviewHandler:         params.LaunchAccessReviewHandler,
+		assignAccessReviewerHandler:       params.AssignAccessReviewerHandler,
+		decideAccessReviewItemHandler:     params.DecideAccessReviewItemHandler,
+		closeAccessReviewHandler:          params.CloseAccessReviewHandler,
+		getAccessReviewHandler:            params.GetAccessReviewHandler,
+		listAccessReviewsHandler:          params.ListAccessReviewsHandler,
+		listMyReviewAssignmentsHandler:    params.ListMyReviewAssignmentsHandler,
+		exportAccessReviewEvidenceHandler: params.ExportAccessReviewEvidenceHandler,
+		validator:                         params.Validator,
+		logger:                            params.Logger,
+	}
+}
+
+func (handler *AccessReviewHandler) Launch(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	var requestBody dto.LaunchAccessReviewRequest
+	if !handler.decode(writer, request, &requestBody) {
+		return
+	}
+
+	cmd := accessreviewcommand.LaunchAccessReviewCommand{
+		Name:        requestBody.Name,
+		Description: requestBody.Description,
+		RoleIDs:     requestBody.RoleIDs,
+		UserIDs:     requestBody.UserIDs,
+		DueAt:       requestBody.DueAt,
+		ActorID:     authContext.UserID,
+	}
+
+	campaignDTO, err := handler.launchAccessReviewHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/access-reviews/" + campaignDTO.ID.String()
+	response.CreatedWithLocation(writer, toAccessReviewResponse(campaignDTO), location)
+}
+
+func (handler *AccessReviewHandler) List(writer http.ResponseWriter, request *http.Request) {
+	page, limit := parseAccessRequestPagination(request)
+	query := accessreviewquery.ListAccessReviewsQuery{
+		Page:  page,
+		Limit: limit,
+	}
+	if statusStr := request.URL.Query().Get("status"); statusStr != "" {
+		status, valid := accessreview.ParseStatus(statusStr)
+		if !valid {
+			response.BadRequest(writer, request, "invalid status")
+			return
+		}
+		query.Status = &status
+	}
+
+	result, err := handler.listAccessReviewsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	items := make([]dto.AccessReviewResponse, len(result.Items))
+	for i, campaignDTO := range result.Items {
+		items[i] = toAccessReviewResponse(campaignDTO)
+	}
+
+	response.Success(writer, dto.PaginatedAccessReviewsResponse{
+		Items:      items,
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+		HasNext:    result.HasNext,
+		HasPrev:    result.HasPrev,
+	})
+}
+
+func (handler *AccessReviewHandler) ListMyAssignments(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	assignments, err := handler.listMyReviewAssignmentsHandler.Handle(request.Context(), accessreviewquery.ListMyReviewAssignmentsQuery{
+		ReviewerID: authContext.UserID,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	responses := make([]dto.AccessReviewAssignmentResponse, len(assignments))
+	for i, assignment := range assignments {
+		responses[i] = dto.AccessReviewAssignmentResponse{
+			CampaignID:   assignment.CampaignID,
+			CampaignName: assignment.CampaignName,
+			DueAt:        assignment.DueAt,
+			Item:         toAccessReviewItemResponse(assignment.Item),
+		}
+	}
+
+	response.Success(writer, responses)
+}
+
+func (handler *AccessReviewHandler) Get(writer http.ResponseWriter, request *http.Request) {
+	campaignID, ok := parseAccessReviewID(writer, request)
+	if !ok {
+		return
+	}
+
+	campaignDTO, err := handler.getAccessReviewHandler.Handle(request.Context(), accessreviewquery.GetAccessReviewQuery{CampaignID: campaignID})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessReviewResponse(campaignDTO))
+}
+
+func (handler *AccessReviewHandler) AssignReviewer(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	campaignID, ok := parseAccessReviewID(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.AssignAccessReviewerRequest
+	if !handler.decode(writer, request, &requestBody) {
+		return
+	}
+
+	cmd := accessreviewcommand.AssignAccessReviewerCommand{
+		CampaignID: campaignID,
+		RoleID:     requestBody.RoleID,
+		ReviewerID: requestBody.ReviewerID,
+		ActorID:    authContext.UserID,
+	}
+
+	campaignDTO, err := handler.assignAccessReviewerHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessReviewResponse(campaignDTO))
+}
+
+func (handler *AccessReviewHandler) Decide(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	campaignID, ok := parseAccessReviewID(writer, request)
+	if !ok {
+		return
+	}
+
+	itemID, err := uuid.Parse(chi.URLParam(request, "itemId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid review item id")
+		return
+	}
+
+	var requestBody dto.DecideAccessReviewItemRequest
+	if !handler.decode(writer, request, &requestBody) {
+		return
+	}
+
+	cmd := accessreviewcommand.DecideAccessReviewItemCommand{
+		CampaignID: campaignID,
+		ItemID:     itemID,
+		ReviewerID: authContext.UserID,
+		Decision:   accessreview.Decision(requestBody.Decision),
+		Comment:    requestBody.Comment,
+	}
+
+	itemDTO, err := handler.decideAccessReviewItemHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAccessReviewItemResponse(itemDTO))
+}
+
+func (handler *AccessReviewHandler) Close(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	campaignID, ok := parseAccessReviewID(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.CloseAccessReviewRequest
+	if request.ContentLength != 0 {
+		if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+			response.BadRequest(writer, request, "invalid request body")
+			return
+		}
+	}
+
+	cmd := accessreviewcommand.CloseAccessReviewCommand{
+		CampaignID: campaignID,
+		ActorID:    authContext.UserID,
+		Force:      requestBody.Force,
+	}
+
+	result, err := handler.closeAccessReviewHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	failures := make([]dto.AccessReviewRevocationFailureResponse, len(result.RevocationFailures))
+	for i, failure := range result.RevocationFailures {
+		failures[i] = dto.AccessReviewRevocationFailureResponse{
+			ItemID: failure.ItemID,
+			UserID: failure.UserID,
+			RoleID: failure.RoleID,
+			Reason: failure.Reason,
+		}
+	}
+
+	response.Success(writer, dto.CloseAccessReviewResponse{
+		Campaign:           toAccessReviewResponse(result.Campaign),
+		RevocationsApplied: result.RevocationsApplied,
+		RevocationFailures: failures,
+	})
+}
+
+func (handler *AccessReviewHandler) ExportEvidence(writer http.ResponseWriter, request *http.Request) {
+	campaignID, ok := parseAccessReviewID(writer, request)
+	if !ok {
+		return
+	}
+
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format = accessreviewquery.EvidenceFormatJSON
+	}
+
+	evidence, err := handler.exportAccessReviewEvidenceHandler.Handle(request.Context(), accessreviewquery.ExportAccessReviewEvidenceQuery{
+		CampaignID: campaignID,
+		Format:     format,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", evidence.ContentType)
+	writer.Header().Set("Content-Disposition", `attachment; filename="`+evidence.Filename+`"`)
+	writer.Header().Set(EvidenceSignatureHeader, "sha256="+evidence.Signature)
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(evidence.Content)
+}
+
+func (handler *AccessReviewHandler) decode(writer http.ResponseWriter, request *http.Request, requestBody interface{}) bool {
+	if err := json.NewDecoder(request.Body).Decode(requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return false
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return false
+		}
+		response.BadRequest(writer, request, err.Error())
+		return false
+	}
+
+	return true
+}
+
+func parseAccessReviewID(writer http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	campaignID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid access review id")
+		return uuid.Nil, false
+	}
+	return campaignID, true
+}
+
+func toAccessReviewItemResponse(itemDTO *accessreviewdto.ReviewItemDTO) dto.AccessReviewItemResponse {
+	return dto.AccessReviewItemResponse{
+		ID:          itemDTO.ID,
+		UserID:      itemDTO.UserID,
+		RoleID:      itemDTO.RoleID,
+		ReviewerID:  itemDTO.ReviewerID,
+		Decision:    itemDTO.Decision,
+		Comment:     itemDTO.Comment,
+		DecidedBy:   itemDTO.DecidedBy,
+		DecidedAt:   itemDTO.DecidedAt,
+		EscalatedAt: itemDTO.EscalatedAt,
+		RevokedAt:   itemDTO.RevokedAt,
+	}
+}
+
+func toAccessReviewResponse(campaignDTO *accessreviewdto.CampaignDTO) dto.AccessReviewResponse {
+	items := make([]dto.AccessReviewItemResponse, len(campaignDTO.Items))
+	for i, itemDTO := range campaignDTO.Items {
+		items[i] = toAccessReviewItemResponse(itemDTO)
+	}
+
+	return dto.AccessReviewResponse{
+		ID:           campaignDTO.ID,
+		Name:         campaignDTO.Name,
+		Description:  campaignDTO.Description,
+		ScopeRoleIDs: campaignDTO.ScopeRoleIDs,
+		ScopeUserIDs: campaignDTO.ScopeUserIDs,
+		Status:       campaignDTO.Status,
+		DueAt:        campaignDTO.DueAt,
+		CreatedBy:    campaignDTO.CreatedBy,
+		ClosedBy:     campaignDTO.ClosedBy,
+		ClosedAt:     campaignDTO.ClosedAt,
+		Kept:         campaignDTO.Kept,
+		Revoked:      campaignDTO.Revoked,
+		Pending:      campaignDTO.Pending,
+		Items:        items,
+		CreatedAt:    campaignDTO.CreatedAt,
+		UpdatedAt:    campaignDTO.UpdatedAt,
+	}
+}
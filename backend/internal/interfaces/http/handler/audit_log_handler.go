@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	auditlogdto "github.com/tranvuongduy2003/go-copilot/internal/application/auditlog/dto"
+	auditlogquery "github.com/tranvuongduy2003/go-copilot/internal/application/auditlog/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+)
+
+type AuditLogHandler struct {
+	listAuditLogsHandler *auditlogquery.ListAuditLogsHandler
+	logger               logger.Logger
+}
+
+type AuditLogHandlerParams struct {
+	ListAuditLogsHandler *auditlogquery.ListAuditLogsHandler
+	Logger               logger.Logger
+}
+
+func NewAuditLogHandler(params AuditLogHandlerParams) *AuditLogHandler {
+	return &AuditLogHandler{
+		listAuditLogsHandler: params.ListAuditLogsHandler,
+		logger:               params.Logger,
+	}
+}
+
+// List always uses cursor pagination; a missing cursor means the first
+// page.
+func (handler *AuditLogHandler) List(writer http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	includeTotal, err := parseOptionalBool(queryParams.Get("include_total"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid include_total parameter")
+		return
+	}
+
+	query := auditlogquery.ListAuditLogsQuery{
+		EventType:    queryParams.Get("event_type"),
+		Action:       queryParams.Get("action"),
+		ResourceType: queryParams.Get("resource_type"),
+		ResourceID:   queryParams.Get("resource_id"),
+		From:         queryParams.Get("from"),
+		To:           queryParams.Get("to"),
+		Cursor:       queryParams.Get("cursor"),
+		Limit:        queryLimit(queryParams),
+		IncludeTotal: includeTotal,
+	}
+
+	if actorIDStr := queryParams.Get("actor_id"); actorIDStr != "" {
+		actorID, err := uuid.Parse(actorIDStr)
+		if err != nil {
+			response.BadRequest(writer, request, "invalid actor_id parameter")
+			return
+		}
+		query.ActorID = &actorID
+	}
+
+	if successStr := queryParams.Get("success"); successStr != "" {
+		success, err := strconv.ParseBool(successStr)
+		if err != nil {
+			response.BadRequest(writer, request, "invalid success parameter")
+			return
+		}
+		query.Success = &success
+	}
+
+	result, err := handler.listAuditLogsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	auditLogResponses := make([]dto.AuditLogResponse, len(result.Items))
+	for i, entry := range result.Items {
+		auditLogResponses[i] = toAuditLogResponse(entry)
+	}
+
+	response.SuccessWithMeta(writer, auditLogResponses, dto.CursorMetaFromResult(result))
+}
+
+func toAuditLogResponse(entry *auditlogdto.AuditLogDTO) dto.AuditLogResponse {
+	return dto.AuditLogResponse{
+		ID:            entry.ID,
+		Timestamp:     entry.Timestamp,
+		EventType:     entry.EventType,
+		ActorID:       entry.ActorID,
+		Action:        entry.Action,
+		ResourceType:  entry.ResourceType,
+		ResourceID:    entry.ResourceID,
+		IPAddress:     entry.IPAddress,
+		UserAgent:     entry.UserAgent,
+		Success:       entry.Success,
+		FailureReason: entry.FailureReason,
+		Metadata:      entry.Metadata,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 
 	authcommand "github.com/tranvuongduy2003/go-copilot/internal/application/auth/command"
+	authdto "github.com/tranvuongduy2003/go-copilot/internal/application/auth/dto"
 	authquery "github.com/tranvuongduy2003/go-copilot/internal/application/auth/query"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
@@ -19,46 +20,52 @@ import (
 )
 
 type AuthHandler struct {
-	registerHandler        *authcommand.RegisterHandler
-	loginHandler           *authcommand.LoginHandler
-	refreshTokenHandler    *authcommand.RefreshTokenHandler
-	logoutHandler          *authcommand.LogoutHandler
-	forgotPasswordHandler  *authcommand.ForgotPasswordHandler
-	resetPasswordHandler   *authcommand.ResetPasswordHandler
-	revokeSessionHandler   *authcommand.RevokeSessionHandler
-	getCurrentUserHandler  *authquery.GetCurrentUserHandler
-	getUserSessionsHandler *authquery.GetUserSessionsHandler
-	validator              *validator.Validator
-	logger                 logger.Logger
+	registerHandler                *authcommand.RegisterHandler
+	loginHandler                   *authcommand.LoginHandler
+	refreshTokenHandler            *authcommand.RefreshTokenHandler
+	switchOrganizationHandler      *authcommand.SwitchOrganizationHandler
+	logoutHandler                  *authcommand.LogoutHandler
+	forgotPasswordHandler          *authcommand.ForgotPasswordHandler
+	resetPasswordHandler           *authcommand.ResetPasswordHandler
+	revokeSessionHandler           *authcommand.RevokeSessionHandler
+	getCurrentUserHandler          *authquery.GetCurrentUserHandler
+	getUserSessionsHandler         *authquery.GetUserSessionsHandler
+	getUserSessionsByCursorHandler *authquery.GetUserSessionsByCursorHandler
+	validator                      *validator.Validator
+	logger                         logger.Logger
 }
 
 type AuthHandlerParams struct {
-	RegisterHandler        *authcommand.RegisterHandler
-	LoginHandler           *authcommand.LoginHandler
-	RefreshTokenHandler    *authcommand.RefreshTokenHandler
-	LogoutHandler          *authcommand.LogoutHandler
-	ForgotPasswordHandler  *authcommand.ForgotPasswordHandler
-	ResetPasswordHandler   *authcommand.ResetPasswordHandler
-	RevokeSessionHandler   *authcommand.RevokeSessionHandler
-	GetCurrentUserHandler  *authquery.GetCurrentUserHandler
-	GetUserSessionsHandler *authquery.GetUserSessionsHandler
-	Validator              *validator.Validator
-	Logger                 logger.Logger
+	RegisterHandler                *authcommand.RegisterHandler
+	LoginHandler                   *authcommand.LoginHandler
+	RefreshTokenHandler            *authcommand.RefreshTokenHandler
+	SwitchOrganizationHandler      *authcommand.SwitchOrganizationHandler
+	LogoutHandler                  *authcommand.LogoutHandler
+	ForgotPasswordHandler          *authcommand.ForgotPasswordHandler
+	ResetPasswordHandler           *authcommand.ResetPasswordHandler
+	RevokeSessionHandler           *authcommand.RevokeSessionHandler
+	GetCurrentUserHandler          *authquery.GetCurrentUserHandler
+	GetUserSessionsHandler         *authquery.GetUserSessionsHandler
+	GetUserSessionsByCursorHandler *authquery.GetUserSessionsByCursorHandler
+	Validator                      *validator.Validator
+	Logger                         logger.Logger
 }
 
 func NewAuthHandler(params AuthHandlerParams) *AuthHandler {
 	return &AuthHandler{
-		registerHandler:        params.RegisterHandler,
-		loginHandler:           params.LoginHandler,
-		refreshTokenHandler:    params.RefreshTokenHandler,
-		logoutHandler:          params.LogoutHandler,
-		forgotPasswordHandler:  params.ForgotPasswordHandler,
-		resetPasswordHandler:   params.ResetPasswordHandler,
-		revokeSessionHandler:   params.RevokeSessionHandler,
-		getCurrentUserHandler:  params.GetCurrentUserHandler,
-		getUserSessionsHandler: params.GetUserSessionsHandler,
-		validator:              params.Validator,
-		logger:                 params.Logger,
+		registerHandler:                params.RegisterHandler,
+		loginHandler:                   params.LoginHandler,
+		refreshTokenHandler:            params.RefreshTokenHandler,
+		switchOrganizationHandler:      params.SwitchOrganizationHandler,
+		logoutHandler:                  params.LogoutHandler,
+		forgotPasswordHandler:          params.ForgotPasswordHandler,
+		resetPasswordHandler:           params.ResetPasswordHandler,
+		revokeSessionHandler:           params.RevokeSessionHandler,
+		getCurrentUserHandler:          params.GetCurrentUserHandler,
+		getUserSessionsHandler:         params.GetUserSessionsHandler,
+		getUserSessionsByCursorHandler: params.GetUserSessionsByCursorHandler,
+		validator:                      params.Validator,
+		logger:                         params.Logger,
 	}
 }
 
@@ -125,10 +132,11 @@ func (handler *AuthHandler) Login(writer http.ResponseWriter, request *http.Requ
 	}
 
 	cmd := authcommand.LoginCommand{
-		Email:     requestBody.Email,
-		Password:  requestBody.Password,
-		IPAddress: handler.getClientIP(request),
-		UserAgent: request.UserAgent(),
+		Email:          requestBody.Email,
+		Password:       requestBody.Password,
+		OrganizationID: requestBody.OrganizationID,
+		IPAddress:      handler.getClientIP(request),
+		UserAgent:      request.UserAgent(),
 	}
 
 	result, err := handler.loginHandler.Handle(request.Context(), cmd)
@@ -147,9 +155,10 @@ func (handler *AuthHandler) Login(writer http.ResponseWriter, request *http.Requ
 			UpdatedAt: result.User.UpdatedAt,
 			DeletedAt: result.User.DeletedAt,
 		},
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-		ExpiresAt:    result.ExpiresAt,
+		AccessToken:    result.AccessToken,
+		RefreshToken:   result.RefreshToken,
+		ExpiresAt:      result.ExpiresAt,
+		OrganizationID: result.OrganizationID,
 	})
 }
 
@@ -191,9 +200,63 @@ func (handler *AuthHandler) RefreshToken(writer http.ResponseWriter, request *ht
 			UpdatedAt: result.User.UpdatedAt,
 			DeletedAt: result.User.DeletedAt,
 		},
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-		ExpiresAt:    result.ExpiresAt,
+		AccessToken:    result.AccessToken,
+		RefreshToken:   result.RefreshToken,
+		ExpiresAt:      result.ExpiresAt,
+		OrganizationID: result.OrganizationID,
+	})
+}
+
+func (handler *AuthHandler) SwitchOrganization(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	var requestBody dto.SwitchOrganizationRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := authcommand.SwitchOrganizationCommand{
+		UserID:         authContext.UserID,
+		OrganizationID: requestBody.OrganizationID,
+		RefreshToken:   requestBody.RefreshToken,
+		IPAddress:      handler.getClientIP(request),
+		UserAgent:      request.UserAgent(),
+	}
+
+	result, err := handler.switchOrganizationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.AuthResponse{
+		User: dto.UserResponse{
+			ID:        result.User.ID,
+			Email:     result.User.Email,
+			FullName:  result.User.FullName,
+			Status:    result.User.Status,
+			CreatedAt: result.User.CreatedAt,
+			UpdatedAt: result.User.UpdatedAt,
+			DeletedAt: result.User.DeletedAt,
+		},
+		AccessToken:    result.AccessToken,
+		RefreshToken:   result.RefreshToken,
+		ExpiresAt:      result.ExpiresAt,
+		OrganizationID: result.OrganizationID,
 	})
 }
 
@@ -314,7 +377,10 @@ func (handler *AuthHandler) GetCurrentUser(writer http.ResponseWriter, request *
 		return
 	}
 
-	userQuery := authquery.GetCurrentUserQuery{UserID: authContext.UserID}
+	userQuery := authquery.GetCurrentUserQuery{
+		UserID:         authContext.UserID,
+		OrganizationID: authContext.OrganizationID,
+	}
 	result, err := handler.getCurrentUserHandler.Handle(request.Context(), userQuery)
 	if err != nil {
 		response.Error(writer, request, err)
@@ -322,12 +388,14 @@ func (handler *AuthHandler) GetCurrentUser(writer http.ResponseWriter, request *
 	}
 
 	response.Success(writer, dto.AuthUserResponse{
-		ID:          result.ID,
-		Email:       result.Email,
-		FullName:    result.FullName,
-		Status:      result.Status,
-		Roles:       result.Roles,
-		Permissions: result.Permissions,
+		ID:                result.ID,
+		Email:             result.Email,
+		FullName:          result.FullName,
+		Status:            result.Status,
+		OrganizationID:    result.OrganizationID,
+		Roles:             result.Roles,
+		Permissions:       result.Permissions,
+		DeniedPermissions: result.DeniedPermissions,
 	})
 }
 
@@ -340,6 +408,28 @@ func (handler *AuthHandler) GetSessions(writer http.ResponseWriter, request *htt
 
 	currentTokenID, _ := uuid.Parse(authContext.TokenID)
 
+	queryParams := request.URL.Query()
+	cursor, includeTotal, useCursor, err := cursorParams(queryParams)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid include_total parameter")
+		return
+	}
+	if useCursor {
+		result, err := handler.getUserSessionsByCursorHandler.Handle(request.Context(), authquery.GetUserSessionsByCursorQuery{
+			UserID:         authContext.UserID,
+			CurrentTokenID: currentTokenID,
+			Cursor:         cursor,
+			Limit:          queryLimit(queryParams),
+			IncludeTotal:   includeTotal,
+		})
+		if err != nil {
+			response.Error(writer, request, err)
+			return
+		}
+		response.SuccessWithMeta(writer, toSessionResponses(result.Items), dto.CursorMetaFromResult(result))
+		return
+	}
+
 	sessionQuery := authquery.GetUserSessionsQuery{
 		UserID:         authContext.UserID,
 		CurrentTokenID: currentTokenID,
@@ -351,6 +441,10 @@ func (handler *AuthHandler) GetSessions(writer http.ResponseWriter, request *htt
 		return
 	}
 
+	response.Success(writer, toSessionResponses(sessions))
+}
+
+func toSessionResponses(sessions []*authdto.SessionDTO) []dto.SessionResponse {
 	sessionResponses := make([]dto.SessionResponse, len(sessions))
 	for i, session := range sessions {
 		sessionResponses[i] = dto.SessionResponse{
@@ -366,8 +460,7 @@ func (handler *AuthHandler) GetSessions(writer http.ResponseWriter, request *htt
 			IsCurrent:  session.IsCurrent,
 		}
 	}
-
-	response.Success(writer, sessionResponses)
+	return sessionResponses
 }
 
 func (handler *AuthHandler) RevokeSession(writer http.ResponseWriter, request *http.Request) {
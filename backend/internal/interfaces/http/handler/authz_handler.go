@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	authzdto "github.com/tranvuongduy2003/go-copilot/internal/application/authz/dto"
+	authzquery "github.com/tranvuongduy2003/go-copilot/internal/application/authz/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+const CheckOtherSubjectsPermission = "authz:check"
+
+type AuthzHandler struct {
+	checkPermissionsHandler   *authzquery.CheckPermissionsHandler
+	explainPermissionsHandler *authzquery.ExplainPermissionsHandler
+	simulateChangeHandler     *authzquery.SimulateAccessChangeHandler
+	validator                 *validator.Validator
+	logger                    logger.Logger
+}
+
+type AuthzHandlerParams struct {
+	CheckPermissionsHandler   *authzquery.CheckPermissionsHandler
+	ExplainPermissionsHandler *authzquery.ExplainPermissionsHandler
+	SimulateChangeHandler     *authzquery.SimulateAccessChangeHandler
+	Validator                 *validator.Validator
+	Logger                    logger.Logger
+}
+
+func NewAuthzHandler(params AuthzHandlerParams) *AuthzHandler {
+	return &AuthzHandler{
+		checkPermissionsHandler:   params.CheckPermissionsHandler,
+		explainPermissionsHandler: params.ExplainPermissionsHandler,
+		simulateChangeHandler:     params.SimulateChangeHandler,
+		validator:                 params.Validator,
+		logger:                    params.Logger,
+	}
+}
+
+func (handler *AuthzHandler) Check(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CheckPermissionRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	userID, organizationID, ok := handler.resolveSubject(writer, request, requestBody.UserID, requestBody.OrganizationID)
+	if !ok {
+		return
+	}
+
+	query := authzquery.CheckPermissionsQuery{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Permissions:    []string{requestBody.Permission},
+	}
+
+	decisions, err := handler.checkPermissionsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAuthzDecisionResponse(decisions[0]))
+}
+
+func (handler *AuthzHandler) CheckBatch(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CheckPermissionsRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	userID, organizationID, ok := handler.resolveSubject(writer, request, requestBody.UserID, requestBody.OrganizationID)
+	if !ok {
+		return
+	}
+
+	query := authzquery.CheckPermissionsQuery{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Permissions:    requestBody.Permissions,
+	}
+
+	decisions, err := handler.checkPermissionsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	allowed := true
+	for _, decision := range decisions {
+		allowed = allowed && decision.Allowed
+	}
+
+	response.Success(writer, dto.AuthzBatchDecisionResponse{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Allowed:        allowed,
+		Decisions:      toAuthzDecisionResponses(decisions),
+	})
+}
+
+func (handler *AuthzHandler) Explain(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.ExplainPermissionsRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	userID, organizationID, ok := handler.resolveSubject(writer, request, requestBody.UserID, requestBody.OrganizationID)
+	if !ok {
+		return
+	}
+
+	query := authzquery.ExplainPermissionsQuery{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Permissions:    requestBody.Permissions,
+	}
+
+	explanation, err := handler.explainPermissionsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.AuthzExplanationResponse{
+		UserID:            explanation.UserID,
+		OrganizationID:    explanation.OrganizationID,
+		DenialReason:      explanation.DenialReason,
+		Roles:             explanation.Roles,
+		Permissions:       explanation.Permissions,
+		DeniedPermissions: explanation.DeniedPermissions,
+		Grants:            toAuthzGrantResponses(explanation.Grants),
+		Decisions:         toAuthzDecisionResponses(explanation.Decisions),
+	})
+}
+
+func (handler *AuthzHandler) Simulate(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.SimulateAccessChangeRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	query := authzquery.SimulateAccessChangeQuery{
+		Type:                requestBody.Type,
+		AddPermissionIDs:    requestBody.AddPermissionIDs,
+		RemovePermissionIDs: requestBody.RemovePermissionIDs,
+		RoleIDs:             requestBody.RoleIDs,
+		OrganizationID:      requestBody.OrganizationID,
+	}
+	if requestBody.RoleID != nil {
+		query.RoleID = *requestBody.RoleID
+	}
+	if requestBody.UserID != nil {
+		query.UserID = *requestBody.UserID
+	}
+
+	simulation, err := handler.simulateChangeHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	impacts := make([]dto.AuthzPermissionImpactResponse, len(simulation.Impacts))
+	for i, impact := range simulation.Impacts {
+		impacts[i] = dto.AuthzPermissionImpactResponse{
+			UserID:            impact.UserID,
+			Email:             impact.Email,
+			FullName:          impact.FullName,
+			GainedPermissions: impact.GainedPermissions,
+			LostPermissions:   impact.LostPermissions,
+		}
+	}
+
+	response.Success(writer, dto.AuthzSimulationResponse{
+		ChangeType:     simulation.ChangeType,
+		OrganizationID: simulation.OrganizationID,
+		UsersEvaluated: simulation.UsersEvaluated,
+		UsersAffected:  simulation.UsersAffected,
+		Impacts:        impacts,
+	})
+}
+
+func (handler *AuthzHandler) resolveSubject(writer http.ResponseWriter, request *http.Request, userID, organizationID *uuid.UUID) (uuid.UUID, *uuid.UUID, bool) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return uuid.Nil, nil, false
+	}
+
+	subjectID := authContext.UserID
+	if userID != nil {
+		subjectID = *userID
+	}
+
+	subjectOrganizationID := authContext.OrganizationID
+	if organizationID != nil {
+		subjectOrganizationID = organizationID
+	}
+
+	isSelf := subjectID == authContext.UserID && sameOrganizationScope(subjectOrganizationID, authContext.OrganizationID)
+	if !isSelf && !authContext.HasPermission(CheckOtherSubjectsPermission) {
+		response.Forbidden(writer, request, "insufficient permissions")
+		return uuid.Nil, nil, false
+	}
+
+	return subjectID, subjectOrganizationID, true
+}
+
+func sameOrganizationScope(left, right *uuid.UUID) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	return *left == *right
+}
+
+func toAuthzGrantResponses(grants []*authzdto.GrantDTO) []dto.AuthzGrantResponse {
+	responses := make([]dto.AuthzGrantResponse, len(grants))
+	for i, grant := range grants {
+		responses[i] = dto.AuthzGrantResponse{
+			RoleID:            grant.RoleID,
+			RoleName:          grant.RoleName,
+			OrganizationID:    grant.OrganizationID,
+			Source:            grant.Source,
+			GroupID:           grant.GroupID,
+			GroupName:         grant.GroupName,
+			Permissions:       grant.Permissions,
+			DeniedPermissions: grant.DeniedPermissions,
+			MatchedPermission: grant.MatchedPermission,
+		}
+	}
+	return responses
+}
+
+func toAuthzDecisionResponse(decision *authzdto.DecisionDTO) dto.AuthzDecisionResponse {
+	return dto.AuthzDecisionResponse{
+		Permission:     decision.Permission,
+		Allowed:        decision.Allowed,
+		Reason:         decision.Reason,
+		MatchedGrants:  toAuthzGrantResponses(decision.MatchedGrants),
+		DenyingGrants:  toAuthzGrantResponses(decision.DenyingGrants),
+		DeniedDirectly: decision.DeniedDirectly,
+	}
+}
+
+func toAuthzDecisionResponses(decisions []*authzdto.DecisionDTO) []dto.AuthzDecisionResponse {
+	responses := make([]dto.AuthzDecisionResponse, len(decisions))
+	for i, decision := range decisions {
+		responses[i] = toAuthzDecisionResponse(decision)
+	}
+	return responses
+}
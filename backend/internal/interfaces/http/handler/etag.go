@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header")
+
+// setETag exposes an aggregate's version as a strong entity tag, which a
+// client echoes in If-Match to make its next write conditional.
+func setETag(writer http.ResponseWriter, version int64) {
+	if version < 1 {
+		return
+	}
+	writer.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion returns the version a request's If-Match header asks to
+// write against. A missing header or "*" leaves the write unconditional.
+// Weak tags are accepted since the version is the only validator there is.
+func ifMatchVersion(request *http.Request) (*int64, error) {
+	header := strings.TrimSpace(request.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return nil, errInvalidIfMatch
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version < 1 {
+		return nil, errInvalidIfMatch
+	}
+	return &version, nil
+}
@@ -0,0 +1,414 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	groupcommand "github.com/tranvuongduy2003/go-copilot/internal/application/group/command"
+	groupdto "github.com/tranvuongduy2003/go-copilot/internal/application/group/dto"
+	groupquery "github.com/tranvuongduy2003/go-copilot/internal/application/group/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type GroupHandler struct {
+	createGroupHandler         *groupcommand.CreateGroupHandler
+	updateGroupHandler         *groupcommand.UpdateGroupHandler
+	deleteGroupHandler         *groupcommand.DeleteGroupHandler
+	addGroupMemberHandler      *groupcommand.AddGroupMemberHandler
+	removeGroupMemberHandler   *groupcommand.RemoveGroupMemberHandler
+	assignRoleToGroupHandler   *groupcommand.AssignRoleToGroupHandler
+	revokeRoleFromGroupHandler *groupcommand.RevokeRoleFromGroupHandler
+	getGroupHandler            *groupquery.GetGroupHandler
+	listGroupsHandler          *groupquery.ListGroupsHandler
+	listGroupMembersHandler    *groupquery.ListGroupMembersHandler
+	listUserGroupsHandler      *groupquery.ListUserGroupsHandler
+	validator                  *validator.Validator
+	logger                     logger.Logger
+}
+
+type GroupHandlerParams struct {
+	CreateGroupHandler         *groupcommand.CreateGroupHandler
+	UpdateGroupHandler         *groupcommand.UpdateGroupHandler
+	DeleteGroupHandler         *groupcommand.DeleteGroupHandler
+	AddGroupMemberHandler      *groupcommand.AddGroupMemberHandler
+	RemoveGroupMemberHandler   *groupcommand.RemoveGroupMemberHandler
+	AssignRoleToGroupHandler   *groupcommand.AssignRoleToGroupHandler
+	RevokeRoleFromGroupHandler *groupcommand.RevokeRoleFromGroupHandler
+	GetGroupHandler            *groupquery.GetGroupHandler
+	ListGroupsHandler          *groupquery.ListGroupsHandler
+	ListGroupMembersHandler    *groupquery.ListGroupMembersHandler
+	ListUserGroupsHandler      *groupquery.ListUserGroupsHandler
+	Validator                  *validator.Validator
+	Logger                     logger.Logger
+}
+
+func NewGroupHandler(params GroupHandlerParams) *GroupHandler {
+	return &GroupHandler{
+		createGroupHandler:         params.CreateGroupHandler,
+		updateGroupHandler:         params.UpdateGroupHandler,
+		deleteGroupHandler:         params.DeleteGroupHandler,
+		addGroupMemberHandler:      params.AddGroupMemberHandler,
+		removeGroupMemberHandler:   params.RemoveGroupMemberHandler,
+		assignRoleToGroupHandler:   params.AssignRoleToGroupHandler,
+		revokeRoleFromGroupHandler: params.RevokeRoleFromGroupHandler,
+		getGroupHandler:            params.GetGroupHandler,
+		listGroupsHandler:          params.ListGroupsHandler,
+		listGroupMembersHandler:    params.ListGroupMembersHandler,
+		listUserGroupsHandler:      params.ListUserGroupsHandler,
+		validator:                  params.Validator,
+		logger:                     params.Logger,
+	}
+}
+
+func (handler *GroupHandler) List(writer http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	page := 1
+	if pageStr := queryParams.Get("page"); pageStr != "" {
+		if parsedPage, err := strconv.Atoi(pageStr); err == nil && parsedPage > 0 {
+			page = parsedPage
+		}
+	}
+
+	limit := 20
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+
+	var search *string
+	if searchStr := queryParams.Get("search"); searchStr != "" {
+		search = &searchStr
+	}
+
+	listQuery := groupquery.ListGroupsQuery{
+		Page:   page,
+		Limit:  limit,
+		Search: search,
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok {
+		listQuery.OrganizationID = authContext.OrganizationID
+	}
+
+	result, err := handler.listGroupsHandler.Handle(request.Context(), listQuery)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.PaginatedGroupsResponse{
+		Items:      toGroupResponses(result.Items),
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+		HasNext:    result.HasNext,
+		HasPrev:    result.HasPrev,
+	})
+}
+
+func (handler *GroupHandler) ListMine(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	query := groupquery.ListUserGroupsQuery{UserID: authContext.UserID}
+	groups, err := handler.listUserGroupsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toGroupResponses(groups))
+}
+
+func (handler *GroupHandler) Get(writer http.ResponseWriter, request *http.Request) {
+	groupDTO, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	response.Success(writer, toGroupResponse(groupDTO))
+}
+
+func (handler *GroupHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CreateGroupRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := groupcommand.CreateGroupCommand{
+		Name:        requestBody.Name,
+		Description: requestBody.Description,
+		RoleIDs:     requestBody.RoleIDs,
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok {
+		cmd.OrganizationID = authContext.OrganizationID
+	}
+
+	groupDTO, err := handler.createGroupHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/groups/" + groupDTO.ID.String()
+	response.CreatedWithLocation(writer, toGroupResponse(groupDTO), location)
+}
+
+func (handler *GroupHandler) Update(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.UpdateGroupRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := groupcommand.UpdateGroupCommand{
+		GroupID:     existingGroup.ID,
+		Name:        requestBody.Name,
+		Description: requestBody.Description,
+	}
+
+	groupDTO, err := handler.updateGroupHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toGroupResponse(groupDTO))
+}
+
+func (handler *GroupHandler) Delete(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := groupcommand.DeleteGroupCommand{GroupID: existingGroup.ID}
+	if err := handler.deleteGroupHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *GroupHandler) ListMembers(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	query := groupquery.ListGroupMembersQuery{GroupID: existingGroup.ID}
+	members, err := handler.listGroupMembersHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	userResponses := make([]dto.UserResponse, len(members))
+	for i, userDTO := range members {
+		userResponses[i] = dto.UserResponse{
+			ID:        userDTO.ID,
+			Email:     userDTO.Email,
+			FullName:  userDTO.FullName,
+			Status:    userDTO.Status,
+			CreatedAt: userDTO.CreatedAt,
+			UpdatedAt: userDTO.UpdatedAt,
+			DeletedAt: userDTO.DeletedAt,
+		}
+	}
+
+	response.Success(writer, userResponses)
+}
+
+func (handler *GroupHandler) AddMember(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.AddGroupMemberRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := groupcommand.AddGroupMemberCommand{
+		GroupID: existingGroup.ID,
+		UserID:  requestBody.UserID,
+		ActorID: requestActorID(request),
+	}
+	if err := handler.addGroupMemberHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *GroupHandler) RemoveMember(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(request, "userId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	cmd := groupcommand.RemoveGroupMemberCommand{
+		GroupID: existingGroup.ID,
+		UserID:  userID,
+	}
+	if err := handler.removeGroupMemberHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *GroupHandler) AssignRole(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	roleID, err := uuid.Parse(chi.URLParam(request, "roleId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid role id")
+		return
+	}
+
+	cmd := groupcommand.AssignRoleToGroupCommand{
+		GroupID: existingGroup.ID,
+		RoleID:  roleID,
+		ActorID: requestActorID(request),
+	}
+
+	groupDTO, err := handler.assignRoleToGroupHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toGroupResponse(groupDTO))
+}
+
+func (handler *GroupHandler) RevokeRole(writer http.ResponseWriter, request *http.Request) {
+	existingGroup, ok := handler.loadAccessibleGroup(writer, request)
+	if !ok {
+		return
+	}
+
+	roleID, err := uuid.Parse(chi.URLParam(request, "roleId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid role id")
+		return
+	}
+
+	cmd := groupcommand.RevokeRoleFromGroupCommand{
+		GroupID: existingGroup.ID,
+		RoleID:  roleID,
+		ActorID: requestActorID(request),
+	}
+
+	groupDTO, err := handler.revokeRoleFromGroupHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toGroupResponse(groupDTO))
+}
+
+func (handler *GroupHandler) loadAccessibleGroup(writer http.ResponseWriter, request *http.Request) (*groupdto.GroupDTO, bool) {
+	groupID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid group id")
+		return nil, false
+	}
+
+	groupDTO, err := handler.getGroupHandler.Handle(request.Context(), groupquery.GetGroupQuery{GroupID: groupID})
+	if err != nil {
+		response.Error(writer, request, err)
+		return nil, false
+	}
+
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok && authContext.OrganizationID != nil {
+		if groupDTO.OrganizationID == nil || *groupDTO.OrganizationID != *authContext.OrganizationID {
+			response.Error(writer, request, group.NewGroupNotFoundError(groupID.String()))
+			return nil, false
+		}
+	}
+
+	return groupDTO, true
+}
+
+func toGroupResponse(groupDTO *groupdto.GroupDTO) dto.GroupResponse {
+	return dto.GroupResponse{
+		ID:             groupDTO.ID,
+		Name:           groupDTO.Name,
+		Description:    groupDTO.Description,
+		OrganizationID: groupDTO.OrganizationID,
+		RoleIDs:        groupDTO.RoleIDs,
+		CreatedAt:      groupDTO.CreatedAt,
+		UpdatedAt:      groupDTO.UpdatedAt,
+	}
+}
+
+func toGroupResponses(groupDTOs []*groupdto.GroupDTO) []dto.GroupResponse {
+	groupResponses := make([]dto.GroupResponse, len(groupDTOs))
+	for i, groupDTO := range groupDTOs {
+		groupResponses[i] = toGroupResponse(groupDTO)
+	}
+	return groupResponses
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	invitationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/command"
+	invitationdto "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/dto"
+	invitationquery "github.com/tranvuongduy2003/go-copilot/internal/application/invitation/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type InvitationHandler struct {
+	createInvitationHandler *invitationcommand.CreateInvitationHandler
+	resendInvitationHandler *invitationcommand.ResendInvitationHandler
+	revokeInvitationHandler *invitationcommand.RevokeInvitationHandler
+	acceptInvitationHandler *invitationcommand.AcceptInvitationHandler
+	listInvitationsHandler  *invitationquery.ListInvitationsHandler
+	validator               *validator.Validator
+	logger                  logger.Logger
+}
+
+type InvitationHandlerParams struct {
+	CreateInvitationHandler *invitationcommand.CreateInvitationHandler
+	ResendInvitationHandler *invitationcommand.ResendInvitationHandler
+	RevokeInvitationHandler *invitationcommand.RevokeInvitationHandler
+	AcceptInvitationHandler *invitationcommand.AcceptInvitationHandler
+	ListInvitationsHandler  *invitationquery.ListInvitationsHandler
+	Validator               *validator.Validator
+	Logger                  logger.Logger
+}
+
+func NewInvitationHandler(params InvitationHandlerParams) *InvitationHandler {
+	return &InvitationHandler{
+		createInvitationHandler: params.CreateInvitationHandler,
+		resendInvitationHandler: params.ResendInvitationHandler,
+		revokeInvitationHandler: params.RevokeInvitationHandler,
+		acceptInvitationHandler: params.AcceptInvitationHandler,
+		listInvitationsHandler:  params.ListInvitationsHandler,
+		validator:               params.Validator,
+		logger:                  params.Logger,
+	}
+}
+
+func (handler *InvitationHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	var requestBody dto.CreateInvitationRequest
+	if !handler.decode(writer, request, &requestBody) {
+		return
+	}
+
+	cmd := invitationcommand.CreateInvitationCommand{
+		Email:     requestBody.Email,
+		RoleIDs:   requestBody.RoleIDs,
+		InvitedBy: authContext.UserID,
+	}
+
+	invitationDTO, err := handler.createInvitationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/invitations/" + invitationDTO.ID.String()
+	response.CreatedWithLocation(writer, toInvitationResponse(invitationDTO), location)
+}
+
+// List defaults to pending invitations; pass status to see the others.
+func (handler *InvitationHandler) List(writer http.ResponseWriter, request *http.Request) {
+	page, limit := parseAccessRequestPagination(request)
+	query := invitationquery.ListInvitationsQuery{
+		Page:  page,
+		Limit: limit,
+		Email: request.URL.Query().Get("email"),
+	}
+
+	status := invitation.StatusPending
+	if statusStr := request.URL.Query().Get("status"); statusStr != "" {
+		parsed, valid := invitation.ParseStatus(statusStr)
+		if !valid {
+			response.BadRequest(writer, request, "invalid status")
+			return
+		}
+		status = parsed
+	}
+	query.Status = &status
+
+	result, err := handler.listInvitationsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toPaginatedInvitationsResponse(result))
+}
+
+func (handler *InvitationHandler) Resend(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	invitationID, ok := parseInvitationID(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := invitationcommand.ResendInvitationCommand{
+		InvitationID: invitationID,
+		ActorID:      authContext.UserID,
+	}
+
+	invitationDTO, err := handler.resendInvitationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toInvitationResponse(invitationDTO))
+}
+
+func (handler *InvitationHandler) Revoke(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	invitationID, ok := parseInvitationID(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := invitationcommand.RevokeInvitationCommand{
+		InvitationID: invitationID,
+		ActorID:      authContext.UserID,
+	}
+
+	invitationDTO, err := handler.revokeInvitationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toInvitationResponse(invitationDTO))
+}
+
+// Accept is public: the invitation token is the credential.
+func (handler *InvitationHandler) Accept(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.AcceptInvitationRequest
+	if !handler.decode(writer, request, &requestBody) {
+		return
+	}
+
+	cmd := invitationcommand.AcceptInvitationCommand{
+		Token:    requestBody.Token,
+		Password: requestBody.Password,
+		FullName: requestBody.FullName,
+	}
+
+	userDTO, err := handler.acceptInvitationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Created(writer, dto.UserResponse{
+		ID:        userDTO.ID,
+		Email:     userDTO.Email,
+		FullName:  userDTO.FullName,
+		Status:    userDTO.Status,
+		CreatedAt: userDTO.CreatedAt,
+		UpdatedAt: userDTO.UpdatedAt,
+	})
+}
+
+func (handler *InvitationHandler) decode(writer http.ResponseWriter, request *http.Request, requestBody any) bool {
+	if err := json.NewDecoder(request.Body).Decode(requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return false
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return false
+		}
+		response.BadRequest(writer, request, err.Error())
+		return false
+	}
+
+	return true
+}
+
+func parseInvitationID(writer http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	invitationID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid invitation id")
+		return uuid.Nil, false
+	}
+	return invitationID, true
+}
+
+func toInvitationResponse(invitationDTO *invitationdto.InvitationDTO) dto.InvitationResponse {
+	return dto.InvitationResponse{
+		ID:         invitationDTO.ID,
+		Email:      invitationDTO.Email,
+		RoleIDs:    invitationDTO.RoleIDs,
+		InvitedBy:  invitationDTO.InvitedBy,
+		Status:     invitationDTO.Status,
+		ExpiresAt:  invitationDTO.ExpiresAt,
+		UserID:     invitationDTO.UserID,
+		AcceptedAt: invitationDTO.AcceptedAt,
+		CreatedAt:  invitationDTO.CreatedAt,
+		UpdatedAt:  invitationDTO.UpdatedAt,
+	}
+}
+
+func toPaginatedInvitationsResponse(result *invitationdto.PaginatedInvitationsDTO) dto.PaginatedInvitationsResponse {
+	items := make([]dto.InvitationResponse, len(result.Items))
+	for i, invitationDTO := range result.Items {
+		items[i] = toInvitationResponse(invitationDTO)
+	}
+
+	return dto.PaginatedInvitationsResponse{
+		Items:      items,
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+		HasNext:    result.HasNext,
+		HasPrev:    result.HasPrev,
+	}
+}
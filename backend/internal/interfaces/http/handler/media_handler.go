@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// MediaHandler serves blobs written by the local storage driver. Object keys
+// never change content, so responses are cacheable indefinitely.
+type MediaHandler struct {
+	prefix     string
+	fileServer http.Handler
+}
+
+func NewMediaHandler(prefix, root string) *MediaHandler {
+	prefix = "/" + strings.Trim(prefix, "/")
+	return &MediaHandler{
+		prefix:     prefix,
+		fileServer: http.StripPrefix(prefix, http.FileServer(noDirectoryFileSystem{http.Dir(root)})),
+	}
+}
+
+func (handler *MediaHandler) Prefix() string {
+	return handler.prefix
+}
+
+func (handler *MediaHandler) Serve(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("X-Content-Type-Options", "nosniff")
+	writer.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	handler.fileServer.ServeHTTP(writer, request)
+}
+
+// noDirectoryFileSystem hides directories so the file server never renders
+// an index of stored blobs.
+type noDirectoryFileSystem struct {
+	fileSystem http.FileSystem
+}
+
+func (fileSystem noDirectoryFileSystem) Open(name string) (http.File, error) {
+	file, err := fileSystem.fileSystem.Open(path.Clean(name))
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, os.ErrNotExist
+	}
+	return file, nil
+}
@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	organizationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/organization/command"
+	organizationdto "github.com/tranvuongduy2003/go-copilot/internal/application/organization/dto"
+	organizationquery "github.com/tranvuongduy2003/go-copilot/internal/application/organization/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type OrganizationHandler struct {
+	createOrganizationHandler       *organizationcommand.CreateOrganizationHandler
+	updateOrganizationHandler       *organizationcommand.UpdateOrganizationHandler
+	deleteOrganizationHandler       *organizationcommand.DeleteOrganizationHandler
+	addOrganizationMemberHandler    *organizationcommand.AddOrganizationMemberHandler
+	removeOrganizationMemberHandler *organizationcommand.RemoveOrganizationMemberHandler
+	getOrganizationHandler          *organizationquery.GetOrganizationHandler
+	listOrganizationsHandler        *organizationquery.ListOrganizationsHandler
+	listOrganizationMembersHandler  *organizationquery.ListOrganizationMembersHandler
+	listUserOrganizationsHandler    *organizationquery.ListUserOrganizationsHandler
+	validator                       *validator.Validator
+	logger                          logger.Logger
+}
+
+type OrganizationHandlerParams struct {
+	CreateOrganizationHandler       *organizationcommand.CreateOrganizationHandler
+	UpdateOrganizationHandler       *organizationcommand.UpdateOrganizationHandler
+	DeleteOrganizationHandler       *organizationcommand.DeleteOrganizationHandler
+	AddOrganizationMemberHandler    *organizationcommand.AddOrganizationMemberHandler
+	RemoveOrganizationMemberHandler *organizationcommand.RemoveOrganizationMemberHandler
+	GetOrganizationHandler          *organizationquery.GetOrganizationHandler
+	ListOrganizationsHandler        *organizationquery.ListOrganizationsHandler
+	ListOrganizationMembersHandler  *organizationquery.ListOrganizationMembersHandler
+	ListUserOrganizationsHandler    *organizationquery.ListUserOrganizationsHandler
+	Validator                       *validator.Validator
+	Logger                          logger.Logger
+}
+
+func NewOrganizationHandler(params OrganizationHandlerParams) *OrganizationHandler {
+	return &OrganizationHandler{
+		createOrganizationHandler:       params.CreateOrganizationHandler,
+		updateOrganizationHandler:       params.UpdateOrganizationHandler,
+		deleteOrganizationHandler:       params.DeleteOrganizationHandler,
+		addOrganizationMemberHandler:    params.AddOrganizationMemberHandler,
+		removeOrganizationMemberHandler: params.RemoveOrganizationMemberHandler,
+		getOrganizationHandler:          params.GetOrganizationHandler,
+		listOrganizationsHandler:        params.ListOrganizationsHandler,
+		listOrganizationMembersHandler:  params.ListOrganizationMembersHandler,
+		listUserOrganizationsHandler:    params.ListUserOrganizationsHandler,
+		validator:                       params.Validator,
+		logger:                          params.Logger,
+	}
+}
+
+func (handler *OrganizationHandler) List(writer http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	page := 1
+	if pageStr := queryParams.Get("page"); pageStr != "" {
+		if parsedPage, err := strconv.Atoi(pageStr); err == nil && parsedPage > 0 {
+			page = parsedPage
+		}
+	}
+
+	limit := 20
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+
+	query := organizationquery.ListOrganizationsQuery{Page: page, Limit: limit}
+	result, err := handler.listOrganizationsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.PaginatedOrganizationsResponse{
+		Items:      toOrganizationResponses(result.Items),
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+		HasNext:    result.HasNext,
+		HasPrev:    result.HasPrev,
+	})
+}
+
+func (handler *OrganizationHandler) ListMine(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	query := organizationquery.ListUserOrganizationsQuery{UserID: authContext.UserID}
+	organizations, err := handler.listUserOrganizationsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toOrganizationResponses(organizations))
+}
+
+func (handler *OrganizationHandler) Get(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	query := organizationquery.GetOrganizationQuery{OrganizationID: organizationID}
+	organizationDTO, err := handler.getOrganizationHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toOrganizationResponse(organizationDTO))
+}
+
+func (handler *OrganizationHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CreateOrganizationRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := organizationcommand.CreateOrganizationCommand{
+		Name:        requestBody.Name,
+		Slug:        requestBody.Slug,
+		Description: requestBody.Description,
+	}
+
+	organizationDTO, err := handler.createOrganizationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/organizations/" + organizationDTO.ID.String()
+	response.CreatedWithLocation(writer, toOrganizationResponse(organizationDTO), location)
+}
+
+func (handler *OrganizationHandler) Update(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.UpdateOrganizationRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := organizationcommand.UpdateOrganizationCommand{
+		OrganizationID: organizationID,
+		Name:           requestBody.Name,
+		Description:    requestBody.Description,
+	}
+
+	organizationDTO, err := handler.updateOrganizationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toOrganizationResponse(organizationDTO))
+}
+
+func (handler *OrganizationHandler) Delete(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	cmd := organizationcommand.DeleteOrganizationCommand{OrganizationID: organizationID}
+	if err := handler.deleteOrganizationHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *OrganizationHandler) ListMembers(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	query := organizationquery.ListOrganizationMembersQuery{OrganizationID: organizationID}
+	memberships, err := handler.listOrganizationMembersHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	memberResponses := make([]dto.OrganizationMemberResponse, len(memberships))
+	for i, membershipDTO := range memberships {
+		memberResponses[i] = dto.OrganizationMemberResponse{
+			OrganizationID: membershipDTO.OrganizationID,
+			UserID:         membershipDTO.UserID,
+			JoinedAt:       membershipDTO.JoinedAt,
+		}
+	}
+
+	response.Success(writer, memberResponses)
+}
+
+func (handler *OrganizationHandler) AddMember(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	var requestBody dto.AddOrganizationMemberRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := organizationcommand.AddOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		UserID:         requestBody.UserID,
+	}
+
+	membershipDTO, err := handler.addOrganizationMemberHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Created(writer, dto.OrganizationMemberResponse{
+		OrganizationID: membershipDTO.OrganizationID,
+		UserID:         membershipDTO.UserID,
+		JoinedAt:       membershipDTO.JoinedAt,
+	})
+}
+
+func (handler *OrganizationHandler) RemoveMember(writer http.ResponseWriter, request *http.Request) {
+	organizationID, ok := handler.parseAccessibleOrganizationID(writer, request)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(chi.URLParam(request, "userId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	cmd := organizationcommand.RemoveOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	}
+	if err := handler.removeOrganizationMemberHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *OrganizationHandler) parseAccessibleOrganizationID(writer http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	organizationID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid organization id")
+		return uuid.Nil, false
+	}
+
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok && authContext.OrganizationID != nil {
+		if *authContext.OrganizationID != organizationID {
+			response.Error(writer, request, organization.ErrNotMember)
+			return uuid.Nil, false
+		}
+	}
+
+	return organizationID, true
+}
+
+func toOrganizationResponse(organizationDTO *organizationdto.OrganizationDTO) dto.OrganizationResponse {
+	return dto.OrganizationResponse{
+		ID:          organizationDTO.ID,
+		Name:        organizationDTO.Name,
+		Slug:        organizationDTO.Slug,
+		Description: organizationDTO.Description,
+		CreatedAt:   organizationDTO.CreatedAt,
+		UpdatedAt:   organizationDTO.UpdatedAt,
+	}
+}
+
+func toOrganizationResponses(organizationDTOs []*organizationdto.OrganizationDTO) []dto.OrganizationResponse {
+	organizationResponses := make([]dto.OrganizationResponse, len(organizationDTOs))
+	for i, organizationDTO := range organizationDTOs {
+		organizationResponses[i] = toOrganizationResponse(organizationDTO)
+	}
+	return organizationResponses
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// cursorParams reads the keyset pagination parameters. ok is false when the
+// request has no cursor parameter at all; an empty cursor asks for the
+// first page.
+func cursorParams(queryParams url.Values) (cursor string, includeTotal bool, ok bool, err error) {
+	if !queryParams.Has("cursor") {
+		return "", false, false, nil
+	}
+	includeTotal, err = parseOptionalBool(queryParams.Get("include_total"))
+	if err != nil {
+		return "", false, false, err
+	}
+	return queryParams.Get("cursor"), includeTotal, true, nil
+}
+
+// queryLimit returns the limit parameter, or zero for the default when it
+// is missing or not a positive number.
+func queryLimit(queryParams url.Values) int {
+	limit, err := strconv.Atoi(queryParams.Get("limit"))
+	if err != nil || limit < 1 {
+		return 0
+	}
+	return limit
+}
@@ -8,45 +8,56 @@ import (
 	"github.com/google/uuid"
 
 	permissioncommand "github.com/tranvuongduy2003/go-copilot/internal/application/permission/command"
+	permissiondto "github.com/tranvuongduy2003/go-copilot/internal/application/permission/dto"
 	permissionquery "github.com/tranvuongduy2003/go-copilot/internal/application/permission/query"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/routes"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
 )
 
 type PermissionHandler struct {
-	createPermissionHandler      *permissioncommand.CreatePermissionHandler
-	updatePermissionHandler      *permissioncommand.UpdatePermissionHandler
-	deletePermissionHandler      *permissioncommand.DeletePermissionHandler
-	listPermissionsHandler       *permissionquery.ListPermissionsHandler
-	getPermissionHandler         *permissionquery.GetPermissionHandler
-	getPermissionsForRoleHandler *permissionquery.GetPermissionsForRoleHandler
-	validator                    *validator.Validator
-	logger                       logger.Logger
+	createPermissionHandler        *permissioncommand.CreatePermissionHandler
+	updatePermissionHandler        *permissioncommand.UpdatePermissionHandler
+	deletePermissionHandler        *permissioncommand.DeletePermissionHandler
+	listPermissionsHandler         *permissionquery.ListPermissionsHandler
+	listPermissionsByCursorHandler *permissionquery.ListPermissionsByCursorHandler
+	getPermissionHandler           *permissionquery.GetPermissionHandler
+	getPermissionsForRoleHandler   *permissionquery.GetPermissionsForRoleHandler
+	detectPermissionDriftHandler   *permissionquery.DetectPermissionDriftHandler
+	routeRegistry                  *routes.Registry
+	validator                      *validator.Validator
+	logger                         logger.Logger
 }
 
 type PermissionHandlerParams struct {
-	CreatePermissionHandler      *permissioncommand.CreatePermissionHandler
-	UpdatePermissionHandler      *permissioncommand.UpdatePermissionHandler
-	DeletePermissionHandler      *permissioncommand.DeletePermissionHandler
-	ListPermissionsHandler       *permissionquery.ListPermissionsHandler
-	GetPermissionHandler         *permissionquery.GetPermissionHandler
-	GetPermissionsForRoleHandler *permissionquery.GetPermissionsForRoleHandler
-	Validator                    *validator.Validator
-	Logger                       logger.Logger
+	CreatePermissionHandler        *permissioncommand.CreatePermissionHandler
+	UpdatePermissionHandler        *permissioncommand.UpdatePermissionHandler
+	DeletePermissionHandler        *permissioncommand.DeletePermissionHandler
+	ListPermissionsHandler         *permissionquery.ListPermissionsHandler
+	ListPermissionsByCursorHandler *permissionquery.ListPermissionsByCursorHandler
+	GetPermissionHandler           *permissionquery.GetPermissionHandler
+	GetPermissionsForRoleHandler   *permissionquery.GetPermissionsForRoleHandler
+	DetectPermissionDriftHandler   *permissionquery.DetectPermissionDriftHandler
+	RouteRegistry                  *routes.Registry
+	Validator                      *validator.Validator
+	Logger                         logger.Logger
 }
 
 func NewPermissionHandler(params PermissionHandlerParams) *PermissionHandler {
 	return &PermissionHandler{
-		createPermissionHandler:      params.CreatePermissionHandler,
-		updatePermissionHandler:      params.UpdatePermissionHandler,
-		deletePermissionHandler:      params.DeletePermissionHandler,
-		listPermissionsHandler:       params.ListPermissionsHandler,
-		getPermissionHandler:         params.GetPermissionHandler,
-		getPermissionsForRoleHandler: params.GetPermissionsForRoleHandler,
-		validator:                    params.Validator,
-		logger:                       params.Logger,
+		createPermissionHandler:        params.CreatePermissionHandler,
+		updatePermissionHandler:        params.UpdatePermissionHandler,
+		deletePermissionHandler:        params.DeletePermissionHandler,
+		listPermissionsHandler:         params.ListPermissionsHandler,
+		listPermissionsByCursorHandler: params.ListPermissionsByCursorHandler,
+		getPermissionHandler:           params.GetPermissionHandler,
+		getPermissionsForRoleHandler:   params.GetPermissionsForRoleHandler,
+		detectPermissionDriftHandler:   params.DetectPermissionDriftHandler,
+		routeRegistry:                  params.RouteRegistry,
+		validator:                      params.Validator,
+		logger:                         params.Logger,
 	}
 }
 
@@ -58,6 +69,26 @@ func (handler *PermissionHandler) List(writer http.ResponseWriter, request *http
 		resource = &resourceStr
 	}
 
+	cursor, includeTotal, useCursor, err := cursorParams(queryParams)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid include_total parameter")
+		return
+	}
+	if useCursor {
+		result, err := handler.listPermissionsByCursorHandler.Handle(request.Context(), permissionquery.ListPermissionsByCursorQuery{
+			Resource:     resource,
+			Cursor:       cursor,
+			Limit:        queryLimit(queryParams),
+			IncludeTotal: includeTotal,
+		})
+		if err != nil {
+			response.Error(writer, request, err)
+			return
+		}
+		response.SuccessWithMeta(writer, toPermissionResponses(result.Items), dto.CursorMetaFromResult(result))
+		return
+	}
+
 	query := permissionquery.ListPermissionsQuery{
 		Resource: resource,
 	}
@@ -68,6 +99,10 @@ func (handler *PermissionHandler) List(writer http.ResponseWriter, request *http
 		return
 	}
 
+	response.Success(writer, toPermissionResponses(permissions))
+}
+
+func toPermissionResponses(permissions []*permissiondto.PermissionDTO) []dto.PermissionResponse {
 	permissionResponses := make([]dto.PermissionResponse, len(permissions))
 	for i, permission := range permissions {
 		permissionResponses[i] = dto.PermissionResponse{
@@ -77,12 +112,12 @@ func (handler *PermissionHandler) List(writer http.ResponseWriter, request *http
 			Code:        permission.Code,
 			Description: permission.Description,
 			IsSystem:    permission.IsSystem,
+			Version:     permission.Version,
 			CreatedAt:   permission.CreatedAt,
 			UpdatedAt:   permission.UpdatedAt,
 		}
 	}
-
-	response.Success(writer, permissionResponses)
+	return permissionResponses
 }
 
 func (handler *PermissionHandler) Get(writer http.ResponseWriter, request *http.Request) {
@@ -99,6 +134,7 @@ func (handler *PermissionHandler) Get(writer http.ResponseWriter, request *http.
 		return
 	}
 
+	setETag(writer, permission.Version)
 	response.Success(writer, dto.PermissionResponse{
 		ID:          permission.ID,
 		Resource:    permission.Resource,
@@ -106,6 +142,7 @@ func (handler *PermissionHandler) Get(writer http.ResponseWriter, request *http.
 		Code:        permission.Code,
 		Description: permission.Description,
 		IsSystem:    permission.IsSystem,
+		Version:     permission.Version,
 		CreatedAt:   permission.CreatedAt,
 		UpdatedAt:   permission.UpdatedAt,
 	})
@@ -147,6 +184,7 @@ func (handler *PermissionHandler) Create(writer http.ResponseWriter, request *ht
 		Code:        permission.Code,
 		Description: permission.Description,
 		IsSystem:    permission.IsSystem,
+		Version:     permission.Version,
 		CreatedAt:   permission.CreatedAt,
 		UpdatedAt:   permission.UpdatedAt,
 	}, location)
@@ -159,6 +197,12 @@ func (handler *PermissionHandler) Update(writer http.ResponseWriter, request *ht
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	var requestBody dto.UpdatePermissionRequest
 	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
 		response.BadRequest(writer, request, "invalid request body")
@@ -175,8 +219,9 @@ func (handler *PermissionHandler) Update(writer http.ResponseWriter, request *ht
 	}
 
 	cmd := permissioncommand.UpdatePermissionCommand{
-		PermissionID: permissionID,
-		Description:  requestBody.Description,
+		PermissionID:    permissionID,
+		Description:     requestBody.Description,
+		ExpectedVersion: expectedVersion,
 	}
 
 	permission, err := handler.updatePermissionHandler.Handle(request.Context(), cmd)
@@ -185,6 +230,7 @@ func (handler *PermissionHandler) Update(writer http.ResponseWriter, request *ht
 		return
 	}
 
+	setETag(writer, permission.Version)
 	response.Success(writer, dto.PermissionResponse{
 		ID:          permission.ID,
 		Resource:    permission.Resource,
@@ -192,6 +238,7 @@ func (handler *PermissionHandler) Update(writer http.ResponseWriter, request *ht
 		Code:        permission.Code,
 		Description: permission.Description,
 		IsSystem:    permission.IsSystem,
+		Version:     permission.Version,
 		CreatedAt:   permission.CreatedAt,
 		UpdatedAt:   permission.UpdatedAt,
 	})
@@ -204,7 +251,13 @@ func (handler *PermissionHandler) Delete(writer http.ResponseWriter, request *ht
 		return
 	}
 
-	cmd := permissioncommand.DeletePermissionCommand{PermissionID: permissionID}
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := permissioncommand.DeletePermissionCommand{PermissionID: permissionID, ExpectedVersion: expectedVersion}
 	if err := handler.deletePermissionHandler.Handle(request.Context(), cmd); err != nil {
 		response.Error(writer, request, err)
 		return
@@ -213,6 +266,68 @@ func (handler *PermissionHandler) Delete(writer http.ResponseWriter, request *ht
 	response.NoContent(writer)
 }
 
+func (handler *PermissionHandler) ListRoutes(writer http.ResponseWriter, request *http.Request) {
+	registeredRoutes := handler.routeRegistry.Routes()
+
+	routeResponses := make([]dto.RoutePermissionResponse, len(registeredRoutes))
+	for i, route := range registeredRoutes {
+		requirements := make([]dto.PermissionRequirementResponse, len(route.Requirements))
+		for j, requirement := range route.Requirements {
+			requirements[j] = dto.PermissionRequirementResponse{
+				Permissions: requirement.Permissions,
+				Match:       string(requirement.Match),
+			}
+		}
+		routeResponses[i] = dto.RoutePermissionResponse{
+			Method:       route.Method,
+			Pattern:      route.Pattern,
+			Requirements: requirements,
+		}
+	}
+
+	response.Success(writer, routeResponses)
+}
+
+func (handler *PermissionHandler) Drift(writer http.ResponseWriter, request *http.Request) {
+	query := permissionquery.DetectPermissionDriftQuery{
+		References: handler.routeRegistry.References(),
+	}
+
+	drift, err := handler.detectPermissionDriftHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	missing := make([]dto.MissingPermissionResponse, len(drift.Missing))
+	for i, missingPermission := range drift.Missing {
+		missing[i] = dto.MissingPermissionResponse{
+			Code:         missingPermission.Code,
+			ReferencedBy: missingPermission.ReferencedBy,
+		}
+	}
+
+	unused := make([]dto.PermissionResponse, len(drift.Unused))
+	for i, permission := range drift.Unused {
+		unused[i] = dto.PermissionResponse{
+			ID:          permission.ID,
+			Resource:    permission.Resource,
+			Action:      permission.Action,
+			Code:        permission.Code,
+			Description: permission.Description,
+			IsSystem:    permission.IsSystem,
+			Version:     permission.Version,
+			CreatedAt:   permission.CreatedAt,
+			UpdatedAt:   permission.UpdatedAt,
+		}
+	}
+
+	response.Success(writer, dto.PermissionDriftResponse{
+		Missing: missing,
+		Unused:  unused,
+	})
+}
+
 func (handler *PermissionHandler) parsePermissionID(request *http.Request) (uuid.UUID, error) {
 	permissionIDParam := chi.URLParam(request, "id")
 	return uuid.Parse(permissionIDParam)
@@ -8,13 +8,21 @@ import (
 	"github.com/google/uuid"
 
 	rolecommand "github.com/tranvuongduy2003/go-copilot/internal/application/role/command"
+	roledto "github.com/tranvuongduy2003/go-copilot/internal/application/role/dto"
 	rolequery "github.com/tranvuongduy2003/go-copilot/internal/application/role/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
 )
 
+// AllOrganizationRolesPermission lets a caller whose token carries no
+// organization list the roles of every tenant. Without it such callers see
+// only the global roles.
+const AllOrganizationRolesPermission = "organizations:list"
+
 type RoleHandler struct {
 	createRoleHandler               *rolecommand.CreateRoleHandler
 	updateRoleHandler               *rolecommand.UpdateRoleHandler
@@ -22,7 +30,11 @@ type RoleHandler struct {
 	assignPermissionToRoleHandler   *rolecommand.AssignPermissionToRoleHandler
 	removePermissionFromRoleHandler *rolecommand.RemovePermissionFromRoleHandler
 	setRolePermissionsHandler       *rolecommand.SetRolePermissionsHandler
+	denyRolePermissionHandler       *rolecommand.DenyRolePermissionHandler
+	removeRoleDenyHandler           *rolecommand.RemoveRoleDenyHandler
 	listRolesHandler                *rolequery.ListRolesHandler
+	listRolesByCursorHandler        *rolequery.ListRolesByCursorHandler
+	listAssignableRolesHandler      *rolequery.ListAssignableRolesHandler
 	getRoleHandler                  *rolequery.GetRoleHandler
 	getUsersWithRoleHandler         *rolequery.GetUsersWithRoleHandler
 	validator                       *validator.Validator
@@ -36,7 +48,11 @@ type RoleHandlerParams struct {
 	AssignPermissionToRoleHandler   *rolecommand.AssignPermissionToRoleHandler
 	RemovePermissionFromRoleHandler *rolecommand.RemovePermissionFromRoleHandler
 	SetRolePermissionsHandler       *rolecommand.SetRolePermissionsHandler
+	DenyRolePermissionHandler       *rolecommand.DenyRolePermissionHandler
+	RemoveRoleDenyHandler           *rolecommand.RemoveRoleDenyHandler
 	ListRolesHandler                *rolequery.ListRolesHandler
+	ListRolesByCursorHandler        *rolequery.ListRolesByCursorHandler
+	ListAssignableRolesHandler      *rolequery.ListAssignableRolesHandler
 	GetRoleHandler                  *rolequery.GetRoleHandler
 	GetUsersWithRoleHandler         *rolequery.GetUsersWithRoleHandler
 	Validator                       *validator.Validator
@@ -51,7 +67,11 @@ func NewRoleHandler(params RoleHandlerParams) *RoleHandler {
 		assignPermissionToRoleHandler:   params.AssignPermissionToRoleHandler,
 		removePermissionFromRoleHandler: params.RemovePermissionFromRoleHandler,
 		setRolePermissionsHandler:       params.SetRolePermissionsHandler,
+		denyRolePermissionHandler:       params.DenyRolePermissionHandler,
+		removeRoleDenyHandler:           params.RemoveRoleDenyHandler,
 		listRolesHandler:                params.ListRolesHandler,
+		listRolesByCursorHandler:        params.ListRolesByCursorHandler,
+		listAssignableRolesHandler:      params.ListAssignableRolesHandler,
 		getRoleHandler:                  params.GetRoleHandler,
 		getUsersWithRoleHandler:         params.GetUsersWithRoleHandler,
 		validator:                       params.Validator,
@@ -60,30 +80,61 @@ func NewRoleHandler(params RoleHandlerParams) *RoleHandler {
 }
 
 func (handler *RoleHandler) List(writer http.ResponseWriter, request *http.Request) {
-	query := rolequery.ListRolesQuery{}
+	scope := roleScope(request)
+	query := rolequery.ListRolesQuery{
+		OrganizationID:   scope.OrganizationID,
+		AllOrganizations: scope.AllOrganizations,
+	}
+
+	queryParams := request.URL.Query()
+	cursor, includeTotal, useCursor, err := cursorParams(queryParams)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid include_total parameter")
+		return
+	}
+	if useCursor {
+		result, err := handler.listRolesByCursorHandler.Handle(request.Context(), rolequery.ListRolesByCursorQuery{
+			OrganizationID:   query.OrganizationID,
+			AllOrganizations: query.AllOrganizations,
+			Cursor:           cursor,
+			Limit:            queryLimit(queryParams),
+			IncludeTotal:     includeTotal,
+		})
+		if err != nil {
+			response.Error(writer, request, err)
+			return
+		}
+		response.SuccessWithMeta(writer, toRoleResponses(result.Items), dto.CursorMetaFromResult(result))
+		return
+	}
+
 	roles, err := handler.listRolesHandler.Handle(request.Context(), query)
 	if err != nil {
 		response.Error(writer, request, err)
 		return
 	}
 
-	roleResponses := make([]dto.RoleResponse, len(roles))
-	for i, roleDTO := range roles {
-		roleResponses[i] = dto.RoleResponse{
-			ID:            roleDTO.ID,
-			Name:          roleDTO.Name,
-			DisplayName:   roleDTO.DisplayName,
-			Description:   roleDTO.Description,
-			PermissionIDs: roleDTO.PermissionIDs,
-			IsSystem:      roleDTO.IsSystem,
-			IsDefault:     roleDTO.IsDefault,
-			Priority:      roleDTO.Priority,
-			CreatedAt:     roleDTO.CreatedAt,
-			UpdatedAt:     roleDTO.UpdatedAt,
-		}
+	response.Success(writer, toRoleResponses(roles))
+}
+
+func (handler *RoleHandler) ListAssignable(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
 	}
 
-	response.Success(writer, roleResponses)
+	query := rolequery.ListAssignableRolesQuery{
+		ActorID:        authContext.UserID,
+		OrganizationID: authContext.OrganizationID,
+	}
+	roles, err := handler.listAssignableRolesHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toRoleResponses(roles))
 }
 
 func (handler *RoleHandler) Get(writer http.ResponseWriter, request *http.Request) {
@@ -93,25 +144,28 @@ func (handler *RoleHandler) Get(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	query := rolequery.GetRoleQuery{RoleID: roleID}
+	query := rolequery.GetRoleQuery{RoleID: roleID, Scope: roleScope(request)}
 	roleDTO, err := handler.getRoleHandler.Handle(request.Context(), query)
 	if err != nil {
 		response.Error(writer, request, err)
 		return
 	}
 
+	setETag(writer, roleDTO.Version)
 	response.Success(writer, dto.RoleWithPermissionsResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		Permissions:   roleDTO.Permissions,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		Permissions:         roleDTO.Permissions,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	})
 }
 
@@ -132,10 +186,14 @@ func (handler *RoleHandler) Create(writer http.ResponseWriter, request *http.Req
 	}
 
 	cmd := rolecommand.CreateRoleCommand{
-		Name:          requestBody.Name,
-		DisplayName:   requestBody.DisplayName,
-		Description:   requestBody.Description,
-		PermissionIDs: requestBody.PermissionIDs,
+		Name:           requestBody.Name,
+		DisplayName:    requestBody.DisplayName,
+		Description:    requestBody.Description,
+		PermissionIDs:  requestBody.PermissionIDs,
+		OrganizationID: requestBody.OrganizationID,
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok && authContext.OrganizationID != nil {
+		cmd.OrganizationID = authContext.OrganizationID
 	}
 
 	roleDTO, err := handler.createRoleHandler.Handle(request.Context(), cmd)
@@ -146,16 +204,19 @@ func (handler *RoleHandler) Create(writer http.ResponseWriter, request *http.Req
 
 	location := "/api/v1/roles/" + roleDTO.ID.String()
 	response.CreatedWithLocation(writer, dto.RoleResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	}, location)
 }
 
@@ -166,6 +227,12 @@ func (handler *RoleHandler) Update(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	var requestBody dto.UpdateRoleRequest
 	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
 		response.BadRequest(writer, request, "invalid request body")
@@ -182,9 +249,12 @@ func (handler *RoleHandler) Update(writer http.ResponseWriter, request *http.Req
 	}
 
 	cmd := rolecommand.UpdateRoleCommand{
-		RoleID:      roleID,
-		DisplayName: requestBody.DisplayName,
-		Description: requestBody.Description,
+		RoleID:          roleID,
+		DisplayName:     requestBody.DisplayName,
+		Description:     requestBody.Description,
+		ActorID:         requestActorID(request),
+		Scope:           roleScope(request),
+		ExpectedVersion: expectedVersion,
 	}
 
 	roleDTO, err := handler.updateRoleHandler.Handle(request.Context(), cmd)
@@ -193,17 +263,21 @@ func (handler *RoleHandler) Update(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
+	setETag(writer, roleDTO.Version)
 	response.Success(writer, dto.RoleResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	})
 }
 
@@ -214,7 +288,13 @@ func (handler *RoleHandler) Delete(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
-	cmd := rolecommand.DeleteRoleCommand{RoleID: roleID}
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := rolecommand.DeleteRoleCommand{RoleID: roleID, ActorID: requestActorID(request), Scope: roleScope(request), ExpectedVersion: expectedVersion}
 	if err := handler.deleteRoleHandler.Handle(request.Context(), cmd); err != nil {
 		response.Error(writer, request, err)
 		return
@@ -230,6 +310,12 @@ func (handler *RoleHandler) SetPermissions(writer http.ResponseWriter, request *
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	var requestBody dto.SetRolePermissionsRequest
 	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
 		response.BadRequest(writer, request, "invalid request body")
@@ -246,8 +332,11 @@ func (handler *RoleHandler) SetPermissions(writer http.ResponseWriter, request *
 	}
 
 	cmd := rolecommand.SetRolePermissionsCommand{
-		RoleID:        roleID,
-		PermissionIDs: requestBody.PermissionIDs,
+		RoleID:          roleID,
+		PermissionIDs:   requestBody.PermissionIDs,
+		ActorID:         requestActorID(request),
+		Scope:           roleScope(request),
+		ExpectedVersion: expectedVersion,
 	}
 
 	roleDTO, err := handler.setRolePermissionsHandler.Handle(request.Context(), cmd)
@@ -256,17 +345,21 @@ func (handler *RoleHandler) SetPermissions(writer http.ResponseWriter, request *
 		return
 	}
 
+	setETag(writer, roleDTO.Version)
 	response.Success(writer, dto.RoleResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	})
 }
 
@@ -283,9 +376,18 @@ func (handler *RoleHandler) AddPermission(writer http.ResponseWriter, request *h
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	cmd := rolecommand.AssignPermissionToRoleCommand{
-		RoleID:       roleID,
-		PermissionID: permissionID,
+		RoleID:          roleID,
+		PermissionID:    permissionID,
+		ActorID:         requestActorID(request),
+		Scope:           roleScope(request),
+		ExpectedVersion: expectedVersion,
 	}
 
 	roleDTO, err := handler.assignPermissionToRoleHandler.Handle(request.Context(), cmd)
@@ -294,17 +396,21 @@ func (handler *RoleHandler) AddPermission(writer http.ResponseWriter, request *h
 		return
 	}
 
+	setETag(writer, roleDTO.Version)
 	response.Success(writer, dto.RoleResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	})
 }
 
@@ -321,28 +427,127 @@ func (handler *RoleHandler) RemovePermission(writer http.ResponseWriter, request
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	cmd := rolecommand.RemovePermissionFromRoleCommand{
+		RoleID:          roleID,
+		PermissionID:    permissionID,
+		ActorID:         requestActorID(request),
+		Scope:           roleScope(request),
+		ExpectedVersion: expectedVersion,
+	}
+
+	roleDTO, err := handler.removePermissionFromRoleHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	setETag(writer, roleDTO.Version)
+	response.Success(writer, dto.RoleResponse{
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
+	})
+}
+
+func (handler *RoleHandler) DenyPermission(writer http.ResponseWriter, request *http.Request) {
+	roleID, err := handler.parseRoleID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid role id")
+		return
+	}
+
+	permissionID, err := handler.parsePermissionID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid permission id")
+		return
+	}
+
+	cmd := rolecommand.DenyRolePermissionCommand{
 		RoleID:       roleID,
 		PermissionID: permissionID,
+		ActorID:      requestActorID(request),
+		Scope:        roleScope(request),
 	}
 
-	roleDTO, err := handler.removePermissionFromRoleHandler.Handle(request.Context(), cmd)
+	roleDTO, err := handler.denyRolePermissionHandler.Handle(request.Context(), cmd)
 	if err != nil {
 		response.Error(writer, request, err)
 		return
 	}
 
 	response.Success(writer, dto.RoleResponse{
-		ID:            roleDTO.ID,
-		Name:          roleDTO.Name,
-		DisplayName:   roleDTO.DisplayName,
-		Description:   roleDTO.Description,
-		PermissionIDs: roleDTO.PermissionIDs,
-		IsSystem:      roleDTO.IsSystem,
-		IsDefault:     roleDTO.IsDefault,
-		Priority:      roleDTO.Priority,
-		CreatedAt:     roleDTO.CreatedAt,
-		UpdatedAt:     roleDTO.UpdatedAt,
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
+	})
+}
+
+func (handler *RoleHandler) RemoveDeny(writer http.ResponseWriter, request *http.Request) {
+	roleID, err := handler.parseRoleID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid role id")
+		return
+	}
+
+	permissionID, err := handler.parsePermissionID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid permission id")
+		return
+	}
+
+	cmd := rolecommand.RemoveRoleDenyCommand{
+		RoleID:       roleID,
+		PermissionID: permissionID,
+		ActorID:      requestActorID(request),
+		Scope:        roleScope(request),
+	}
+
+	roleDTO, err := handler.removeRoleDenyHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.RoleResponse{
+		ID:                  roleDTO.ID,
+		Name:                roleDTO.Name,
+		DisplayName:         roleDTO.DisplayName,
+		Description:         roleDTO.Description,
+		PermissionIDs:       roleDTO.PermissionIDs,
+		DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+		IsSystem:            roleDTO.IsSystem,
+		IsDefault:           roleDTO.IsDefault,
+		Priority:            roleDTO.Priority,
+		OrganizationID:      roleDTO.OrganizationID,
+		Version:             roleDTO.Version,
+		CreatedAt:           roleDTO.CreatedAt,
+		UpdatedAt:           roleDTO.UpdatedAt,
 	})
 }
 
@@ -353,7 +558,7 @@ func (handler *RoleHandler) GetUsersWithRole(writer http.ResponseWriter, request
 		return
 	}
 
-	query := rolequery.GetUsersWithRoleQuery{RoleID: roleID}
+	query := rolequery.GetUsersWithRoleQuery{RoleID: roleID, Scope: roleScope(request)}
 	users, err := handler.getUsersWithRoleHandler.Handle(request.Context(), query)
 	if err != nil {
 		response.Error(writer, request, err)
@@ -367,6 +572,7 @@ func (handler *RoleHandler) GetUsersWithRole(writer http.ResponseWriter, request
 			Email:     userDTO.Email,
 			FullName:  userDTO.FullName,
 			Status:    userDTO.Status,
+			Version:   userDTO.Version,
 			CreatedAt: userDTO.CreatedAt,
 			UpdatedAt: userDTO.UpdatedAt,
 			DeletedAt: userDTO.DeletedAt,
@@ -385,3 +591,57 @@ func (handler *RoleHandler) parsePermissionID(request *http.Request) (uuid.UUID,
 	permissionIDParam := chi.URLParam(request, "permissionId")
 	return uuid.Parse(permissionIDParam)
 }
+
+func toRoleResponses(roles []*roledto.RoleDTO) []dto.RoleResponse {
+	roleResponses := make([]dto.RoleResponse, len(roles))
+	for i, roleDTO := range roles {
+		roleResponses[i] = dto.RoleResponse{
+			ID:                  roleDTO.ID,
+			Name:                roleDTO.Name,
+			DisplayName:         roleDTO.DisplayName,
+			Description:         roleDTO.Description,
+			PermissionIDs:       roleDTO.PermissionIDs,
+			DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
+			IsSystem:            roleDTO.IsSystem,
+			IsDefault:           roleDTO.IsDefault,
+			Priority:            roleDTO.Priority,
+			OrganizationID:      roleDTO.OrganizationID,
+			Version:             roleDTO.Version,
+			CreatedAt:           roleDTO.CreatedAt,
+			UpdatedAt:           roleDTO.UpdatedAt,
+		}
+	}
+	return roleResponses
+}
+
+// roleScope limits role lookups by ID to the roles the caller could list, so a
+// token for one organization cannot reach another tenant's roles.
+func roleScope(request *http.Request) *role.Scope {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		return &role.Scope{}
+	}
+	return &role.Scope{
+		OrganizationID:   authContext.OrganizationID,
+		AllOrganizations: authContext.OrganizationID == nil && authContext.HasPermission(AllOrganizationRolesPermission),
+	}
+}
+
+func requestActorID(request *http.Request) *uuid.UUID {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		return nil
+	}
+	actorID := authContext.UserID
+	return &actorID
+}
+
+// requestOrganizationID returns the organization the caller's token is scoped
+// to, or nil for a token without one.
+func requestOrganizationID(request *http.Request) *uuid.UUID {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		return nil
+	}
+	return authContext.OrganizationID
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	sodcommand "github.com/tranvuongduy2003/go-copilot/internal/application/sod/command"
+	soddto "github.com/tranvuongduy2003/go-copilot/internal/application/sod/dto"
+	sodquery "github.com/tranvuongduy2003/go-copilot/internal/application/sod/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type SoDHandler struct {
+	createSoDRuleHandler     *sodcommand.CreateSoDRuleHandler
+	updateSoDRuleHandler     *sodcommand.UpdateSoDRuleHandler
+	deleteSoDRuleHandler     *sodcommand.DeleteSoDRuleHandler
+	getSoDRuleHandler        *sodquery.GetSoDRuleHandler
+	listSoDRulesHandler      *sodquery.ListSoDRulesHandler
+	listSoDViolationsHandler *sodquery.ListSoDViolationsHandler
+	validator                *validator.Validator
+	logger                   logger.Logger
+}
+
+type SoDHandlerParams struct {
+	CreateSoDRuleHandler     *sodcommand.CreateSoDRuleHandler
+	UpdateSoDRuleHandler     *sodcommand.UpdateSoDRuleHandler
+	DeleteSoDRuleHandler     *sodcommand.DeleteSoDRuleHandler
+	GetSoDRuleHandler        *sodquery.GetSoDRuleHandler
+	ListSoDRulesHandler      *sodquery.ListSoDRulesHandler
+	ListSoDViolationsHandler *sodquery.ListSoDViolationsHandler
+	Validator                *validator.Validator
+	Logger                   logger.Logger
+}
+
+func NewSoDHandler(params SoDHandlerParams) *SoDHandler {
+	return &SoDHandler{
+		createSoDRuleHandler:     params.CreateSoDRuleHandler,
+		updateSoDRuleHandler:     params.UpdateSoDRuleHandler,
+		deleteSoDRuleHandler:     params.DeleteSoDRuleHandler,
+		getSoDRuleHandler:        params.GetSoDRuleHandler,
+		listSoDRulesHandler:      params.ListSoDRulesHandler,
+		listSoDViolationsHandler: params.ListSoDViolationsHandler,
+		validator:                params.Validator,
+		logger:                   params.Logger,
+	}
+}
+
+func (handler *SoDHandler) List(writer http.ResponseWriter, request *http.Request) {
+	rules, err := handler.listSoDRulesHandler.Handle(request.Context(), sodquery.ListSoDRulesQuery{})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	ruleResponses := make([]dto.SoDRuleResponse, len(rules))
+	for i, ruleDTO := range rules {
+		ruleResponses[i] = toSoDRuleResponse(ruleDTO)
+	}
+
+	response.Success(writer, ruleResponses)
+}
+
+func (handler *SoDHandler) Get(writer http.ResponseWriter, request *http.Request) {
+	ruleID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid sod rule id")
+		return
+	}
+
+	ruleDTO, err := handler.getSoDRuleHandler.Handle(request.Context(), sodquery.GetSoDRuleQuery{RuleID: ruleID})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toSoDRuleResponse(ruleDTO))
+}
+
+func (handler *SoDHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CreateSoDRuleRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	ruleType, valid := sod.ParseRuleType(requestBody.Type)
+	if !valid {
+		response.BadRequest(writer, request, "invalid sod rule type")
+		return
+	}
+
+	cmd := sodcommand.CreateSoDRuleCommand{
+		Name:        requestBody.Name,
+		Description: requestBody.Description,
+		Type:        ruleType,
+		RoleIDs:     requestBody.RoleIDs,
+		MaxHolders:  requestBody.MaxHolders,
+	}
+
+	ruleDTO, err := handler.createSoDRuleHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/sod-rules/" + ruleDTO.ID.String()
+	response.CreatedWithLocation(writer, toSoDRuleResponse(ruleDTO), location)
+}
+
+func (handler *SoDHandler) Update(writer http.ResponseWriter, request *http.Request) {
+	ruleID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid sod rule id")
+		return
+	}
+
+	var requestBody dto.UpdateSoDRuleRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := sodcommand.UpdateSoDRuleCommand{
+		RuleID:      ruleID,
+		Name:        requestBody.Name,
+		Description: requestBody.Description,
+		RoleIDs:     requestBody.RoleIDs,
+		MaxHolders:  requestBody.MaxHolders,
+	}
+
+	ruleDTO, err := handler.updateSoDRuleHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toSoDRuleResponse(ruleDTO))
+}
+
+func (handler *SoDHandler) Delete(writer http.ResponseWriter, request *http.Request) {
+	ruleID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid sod rule id")
+		return
+	}
+
+	cmd := sodcommand.DeleteSoDRuleCommand{RuleID: ruleID}
+	if err := handler.deleteSoDRuleHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *SoDHandler) ListViolations(writer http.ResponseWriter, request *http.Request) {
+	handler.writeViolations(writer, request, sodquery.ListSoDViolationsQuery{})
+}
+
+func (handler *SoDHandler) ListRuleViolations(writer http.ResponseWriter, request *http.Request) {
+	ruleID, err := uuid.Parse(chi.URLParam(request, "id"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid sod rule id")
+		return
+	}
+
+	handler.writeViolations(writer, request, sodquery.ListSoDViolationsQuery{RuleID: &ruleID})
+}
+
+func (handler *SoDHandler) writeViolations(writer http.ResponseWriter, request *http.Request, query sodquery.ListSoDViolationsQuery) {
+	violations, err := handler.listSoDViolationsHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	violationResponses := make([]dto.SoDViolationResponse, len(violations))
+	for i, violation := range violations {
+		violationResponses[i] = dto.SoDViolationResponse{
+			RuleID:   violation.RuleID,
+			RuleName: violation.RuleName,
+			RuleType: violation.RuleType,
+			UserID:   violation.UserID,
+			RoleIDs:  violation.RoleIDs,
+		}
+	}
+
+	response.Success(writer, violationResponses)
+}
+
+func toSoDRuleResponse(ruleDTO *soddto.RuleDTO) dto.SoDRuleResponse {
+	return dto.SoDRuleResponse{
+		ID:          ruleDTO.ID,
+		Name:        ruleDTO.Name,
+		Description: ruleDTO.Description,
+		Type:        ruleDTO.Type,
+		RoleIDs:     ruleDTO.RoleIDs,
+		MaxHolders:  ruleDTO.MaxHolders,
+		CreatedAt:   ruleDTO.CreatedAt,
+		UpdatedAt:   ruleDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	userattributecommand "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/command"
+	userattributedto "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/dto"
+	userattributequery "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
+	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
+)
+
+type UserAttributeHandler struct {
+	createAttributeDefinitionHandler *userattributecommand.CreateAttributeDefinitionHandler
+	updateAttributeDefinitionHandler *userattributecommand.UpdateAttributeDefinitionHandler
+	deleteAttributeDefinitionHandler *userattributecommand.DeleteAttributeDefinitionHandler
+	listAttributeDefinitionsHandler  *userattributequery.ListAttributeDefinitionsHandler
+	validator                        *validator.Validator
+	logger                           logger.Logger
+}
+
+type UserAttributeHandlerParams struct {
+	CreateAttributeDefinitionHandler *userattributecommand.CreateAttributeDefinitionHandler
+	UpdateAttributeDefinitionHandler *userattributecommand.UpdateAttributeDefinitionHandler
+	DeleteAttributeDefinitionHandler *userattributecommand.DeleteAttributeDefinitionHandler
+	ListAttributeDefinitionsHandler  *userattributequery.ListAttributeDefinitionsHandler
+	Validator                        *validator.Validator
+	Logger                           logger.Logger
+}
+
+func NewUserAttributeHandler(params UserAttributeHandlerParams) *UserAttributeHandler {
+	return &UserAttributeHandler{
+		createAttributeDefinitionHandler: params.CreateAttributeDefinitionHandler,
+		updateAttributeDefinitionHandler: params.UpdateAttributeDefinitionHandler,
+		deleteAttributeDefinitionHandler: params.DeleteAttributeDefinitionHandler,
+		listAttributeDefinitionsHandler:  params.ListAttributeDefinitionsHandler,
+		validator:                        params.Validator,
+		logger:                           params.Logger,
+	}
+}
+
+func (handler *UserAttributeHandler) List(writer http.ResponseWriter, request *http.Request) {
+	definitions, err := handler.listAttributeDefinitionsHandler.Handle(request.Context(), userattributequery.ListAttributeDefinitionsQuery{})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	definitionResponses := make([]dto.AttributeDefinitionResponse, len(definitions))
+	for i, definition := range definitions {
+		definitionResponses[i] = toAttributeDefinitionResponse(definition)
+	}
+
+	response.Success(writer, definitionResponses)
+}
+
+func (handler *UserAttributeHandler) Create(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.CreateAttributeDefinitionRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	attributeType, valid := userattribute.ParseType(requestBody.Type)
+	if !valid {
+		response.BadRequest(writer, request, "invalid attribute type")
+		return
+	}
+
+	cmd := userattributecommand.CreateAttributeDefinitionCommand{
+		Key:         requestBody.Key,
+		Label:       requestBody.Label,
+		Description: requestBody.Description,
+		Type:        attributeType,
+		Required:    requestBody.Required,
+		Visibility:  userattribute.Visibility(requestBody.Visibility),
+		Rules:       toAttributeRules(requestBody.Rules),
+	}
+
+	definition, err := handler.createAttributeDefinitionHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	location := "/api/v1/user-attributes/" + definition.Key
+	response.CreatedWithLocation(writer, toAttributeDefinitionResponse(definition), location)
+}
+
+func (handler *UserAttributeHandler) Update(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.UpdateAttributeDefinitionRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := userattributecommand.UpdateAttributeDefinitionCommand{
+		Key:         chi.URLParam(request, "key"),
+		Label:       requestBody.Label,
+		Description: requestBody.Description,
+		Required:    requestBody.Required,
+		Visibility:  userattribute.Visibility(requestBody.Visibility),
+	}
+	if requestBody.Rules != nil {
+		rules := toAttributeRules(*requestBody.Rules)
+		cmd.Rules = &rules
+	}
+
+	definition, err := handler.updateAttributeDefinitionHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toAttributeDefinitionResponse(definition))
+}
+
+func (handler *UserAttributeHandler) Delete(writer http.ResponseWriter, request *http.Request) {
+	cmd := userattributecommand.DeleteAttributeDefinitionCommand{Key: chi.URLParam(request, "key")}
+	if err := handler.deleteAttributeDefinitionHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func toAttributeRules(rules dto.AttributeRulesRequest) userattribute.Rules {
+	return userattribute.Rules{
+		MinLength: rules.MinLength,
+		MaxLength: rules.MaxLength,
+		Pattern:   rules.Pattern,
+		Min:       rules.Min,
+		Max:       rules.Max,
+		Options:   rules.Options,
+	}
+}
+
+func toAttributeDefinitionResponse(definition *userattributedto.DefinitionDTO) dto.AttributeDefinitionResponse {
+	return dto.AttributeDefinitionResponse{
+		ID:          definition.ID,
+		Key:         definition.Key,
+		Label:       definition.Label,
+		Description: definition.Description,
+		Type:        definition.Type,
+		Required:    definition.Required,
+		Visibility:  definition.Visibility,
+		Rules: dto.AttributeRulesResponse{
+			MinLength: definition.Rules.MinLength,
+			MaxLength: definition.Rules.MaxLength,
+			Pattern:   definition.Rules.Pattern,
+			Min:       definition.Rules.Min,
+			Max:       definition.Rules.Max,
+			Options:   definition.Rules.Options,
+		},
+		CreatedAt: definition.CreatedAt,
+		UpdatedAt: definition.UpdatedAt,
+	}
+}
@@ -2,15 +2,31 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	bulkoperationcommand "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/command"
+	bulkoperationdto "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/dto"
+	bulkoperationquery "github.com/tranvuongduy2003/go-copilot/internal/application/bulkoperation/query"
 	usercommand "github.com/tranvuongduy2003/go-copilot/internal/application/user/command"
+	userdto "github.com/tranvuongduy2003/go-copilot/internal/application/user/dto"
 	userquery "github.com/tranvuongduy2003/go-copilot/internal/application/user/query"
+	userattributedto "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/dto"
+	userattributequery "github.com/tranvuongduy2003/go-copilot/internal/application/userattribute/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/dto"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
@@ -20,17 +36,38 @@ type UserHandler struct {
 	createUserHandler        *usercommand.CreateUserHandler
 	updateUserHandler        *usercommand.UpdateUserHandler
 	deleteUserHandler        *usercommand.DeleteUserHandler
+	restoreUserHandler       *usercommand.RestoreUserHandler
 	changePasswordHandler    *usercommand.ChangePasswordHandler
 	activateUserHandler      *usercommand.ActivateUserHandler
 	deactivateUserHandler    *usercommand.DeactivateUserHandler
 	banUserHandler           *usercommand.BanUserHandler
+	suspendUserHandler       *usercommand.SuspendUserHandler
+	unbanUserHandler         *usercommand.UnbanUserHandler
 	assignRoleToUserHandler  *usercommand.AssignRoleToUserHandler
 	revokeRoleFromUserHandler *usercommand.RevokeRoleFromUserHandler
 	setUserRolesHandler      *usercommand.SetUserRolesHandler
+	denyUserPermissionHandler *usercommand.DenyUserPermissionHandler
+	removeUserDenyHandler    *usercommand.RemoveUserDenyHandler
+	importUsersHandler       *usercommand.ImportUsersHandler
+	uploadAvatarHandler      *usercommand.UploadAvatarHandler
+	deleteAvatarHandler      *usercommand.DeleteAvatarHandler
+	eraseUserHandler         *usercommand.EraseUserHandler
+	requestEmailChangeHandler *usercommand.RequestEmailChangeHandler
+	confirmEmailChangeHandler *usercommand.ConfirmEmailChangeHandler
+	cancelEmailChangeHandler *usercommand.CancelEmailChangeHandler
+	changeUserEmailHandler   *usercommand.ChangeUserEmailHandler
+	updatePreferencesHandler *usercommand.UpdatePreferencesHandler
 	getUserHandler           *userquery.GetUserHandler
 	listUsersHandler         *userquery.ListUsersHandler
+	listUsersByCursorHandler *userquery.ListUsersByCursorHandler
 	getUserRolesHandler      *userquery.GetUserRolesHandler
 	getUserPermissionsHandler *userquery.GetUserPermissionsHandler
+	exportUsersHandler       *userquery.ExportUsersHandler
+	exportPersonalDataHandler *userquery.ExportPersonalDataHandler
+	getPreferencesHandler    *userquery.GetPreferencesHandler
+	startBulkUserOperationHandler *bulkoperationcommand.StartBulkUserOperationHandler
+	getBulkOperationHandler  *bulkoperationquery.GetBulkOperationHandler
+	listAttributeDefinitionsHandler *userattributequery.ListAttributeDefinitionsHandler
 	validator                *validator.Validator
 	logger                   logger.Logger
 }
@@ -39,17 +76,38 @@ type UserHandlerParams struct {
 	CreateUserHandler         *usercommand.CreateUserHandler
 	UpdateUserHandler         *usercommand.UpdateUserHandler
 	DeleteUserHandler         *usercommand.DeleteUserHandler
+	RestoreUserHandler        *usercommand.RestoreUserHandler
 	ChangePasswordHandler     *usercommand.ChangePasswordHandler
 	ActivateUserHandler       *usercommand.ActivateUserHandler
 	DeactivateUserHandler     *usercommand.DeactivateUserHandler
 	BanUserHandler            *usercommand.BanUserHandler
+	SuspendUserHandler        *usercommand.SuspendUserHandler
+	UnbanUserHandler          *usercommand.UnbanUserHandler
 	AssignRoleToUserHandler   *usercommand.AssignRoleToUserHandler
 	RevokeRoleFromUserHandler *usercommand.RevokeRoleFromUserHandler
 	SetUserRolesHandler       *usercommand.SetUserRolesHandler
+	DenyUserPermissionHandler *usercommand.DenyUserPermissionHandler
+	RemoveUserDenyHandler     *usercommand.RemoveUserDenyHandler
+	ImportUsersHandler        *usercommand.ImportUsersHandler
+	UploadAvatarHandler       *usercommand.UploadAvatarHandler
+	DeleteAvatarHandler       *usercommand.DeleteAvatarHandler
+	EraseUserHandler          *usercommand.EraseUserHandler
+	RequestEmailChangeHandler *usercommand.RequestEmailChangeHandler
+	ConfirmEmailChangeHandler *usercommand.ConfirmEmailChangeHandler
+	CancelEmailChangeHandler  *usercommand.CancelEmailChangeHandler
+	ChangeUserEmailHandler    *usercommand.ChangeUserEmailHandler
+	UpdatePreferencesHandler  *usercommand.UpdatePreferencesHandler
 	GetUserHandler            *userquery.GetUserHandler
 	ListUsersHandler          *userquery.ListUsersHandler
+	ListUsersByCursorHandler  *userquery.ListUsersByCursorHandler
 	GetUserRolesHandler       *userquery.GetUserRolesHandler
 	GetUserPermissionsHandler *userquery.GetUserPermissionsHandler
+	ExportUsersHandler        *userquery.ExportUsersHandler
+	ExportPersonalDataHandler *userquery.ExportPersonalDataHandler
+	GetPreferencesHandler     *userquery.GetPreferencesHandler
+	StartBulkUserOperationHandler *bulkoperationcommand.StartBulkUserOperationHandler
+	GetBulkOperationHandler   *bulkoperationquery.GetBulkOperationHandler
+	ListAttributeDefinitionsHandler *userattributequery.ListAttributeDefinitionsHandler
 	Validator                 *validator.Validator
 	Logger                    logger.Logger
 }
@@ -59,17 +117,38 @@ func NewUserHandler(params UserHandlerParams) *UserHandler {
 		createUserHandler:         params.CreateUserHandler,
 		updateUserHandler:         params.UpdateUserHandler,
 		deleteUserHandler:         params.DeleteUserHandler,
+		restoreUserHandler:        params.RestoreUserHandler,
 		changePasswordHandler:     params.ChangePasswordHandler,
 		activateUserHandler:       params.ActivateUserHandler,
 		deactivateUserHandler:     params.DeactivateUserHandler,
 		banUserHandler:            params.BanUserHandler,
+		suspendUserHandler:        params.SuspendUserHandler,
+		unbanUserHandler:          params.UnbanUserHandler,
 		assignRoleToUserHandler:   params.AssignRoleToUserHandler,
 		revokeRoleFromUserHandler: params.RevokeRoleFromUserHandler,
 		setUserRolesHandler:       params.SetUserRolesHandler,
+		denyUserPermissionHandler: params.DenyUserPermissionHandler,
+		removeUserDenyHandler:     params.RemoveUserDenyHandler,
+		importUsersHandler:        params.ImportUsersHandler,
+		uploadAvatarHandler:       params.UploadAvatarHandler,
+		deleteAvatarHandler:       params.DeleteAvatarHandler,
+		eraseUserHandler:          params.EraseUserHandler,
+		requestEmailChangeHandler: params.RequestEmailChangeHandler,
+		confirmEmailChangeHandler: params.ConfirmEmailChangeHandler,
+		cancelEmailChangeHandler:  params.CancelEmailChangeHandler,
+		changeUserEmailHandler:    params.ChangeUserEmailHandler,
+		updatePreferencesHandler:  params.UpdatePreferencesHandler,
 		getUserHandler:            params.GetUserHandler,
 		listUsersHandler:          params.ListUsersHandler,
+		listUsersByCursorHandler:  params.ListUsersByCursorHandler,
 		getUserRolesHandler:       params.GetUserRolesHandler,
 		getUserPermissionsHandler: params.GetUserPermissionsHandler,
+		exportUsersHandler:        params.ExportUsersHandler,
+		exportPersonalDataHandler: params.ExportPersonalDataHandler,
+		getPreferencesHandler:     params.GetPreferencesHandler,
+		startBulkUserOperationHandler: params.StartBulkUserOperationHandler,
+		getBulkOperationHandler:   params.GetBulkOperationHandler,
+		listAttributeDefinitionsHandler: params.ListAttributeDefinitionsHandler,
 		validator:                 params.Validator,
 		logger:                    params.Logger,
 	}
@@ -92,9 +171,10 @@ func (handler *UserHandler) Create(writer http.ResponseWriter, request *http.Req
 	}
 
 	cmd := usercommand.CreateUserCommand{
-		Email:    requestBody.Email,
-		Password: requestBody.Password,
-		FullName: requestBody.FullName,
+		Email:      requestBody.Email,
+		Password:   requestBody.Password,
+		FullName:   requestBody.FullName,
+		Attributes: requestBody.Attributes,
 	}
 
 	userDTO, err := handler.createUserHandler.Handle(request.Context(), cmd)
@@ -103,16 +183,14 @@ func (handler *UserHandler) Create(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
 	location := "/api/v1/users/" + userDTO.ID.String()
-	response.CreatedWithLocation(writer, dto.UserResponse{
-		ID:        userDTO.ID,
-		Email:     userDTO.Email,
-		FullName:  userDTO.FullName,
-		Status:    userDTO.Status,
-		CreatedAt: userDTO.CreatedAt,
-		UpdatedAt: userDTO.UpdatedAt,
-		DeletedAt: userDTO.DeletedAt,
-	}, location)
+	response.CreatedWithLocation(writer, userResponses[0], location)
 }
 
 func (handler *UserHandler) Get(writer http.ResponseWriter, request *http.Request) {
@@ -122,22 +200,21 @@ func (handler *UserHandler) Get(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	userQuery := userquery.GetUserQuery{UserID: userID}
+	userQuery := userquery.GetUserQuery{UserID: userID, OrganizationID: requestOrganizationID(request)}
 	userDTO, err := handler.getUserHandler.Handle(request.Context(), userQuery)
 	if err != nil {
 		response.Error(writer, request, err)
 		return
 	}
 
-	response.Success(writer, dto.UserResponse{
-		ID:        userDTO.ID,
-		Email:     userDTO.Email,
-		FullName:  userDTO.FullName,
-		Status:    userDTO.Status,
-		CreatedAt: userDTO.CreatedAt,
-		UpdatedAt: userDTO.UpdatedAt,
-		DeletedAt: userDTO.DeletedAt,
-	})
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	setETag(writer, userDTO.Version)
+	response.Success(writer, userResponses[0])
 }
 
 func (handler *UserHandler) List(writer http.ResponseWriter, request *http.Request) {
@@ -157,9 +234,26 @@ func (handler *UserHandler) List(writer http.ResponseWriter, request *http.Reque
 		}
 	}
 
-	var status *string
-	if statusStr := queryParams.Get("status"); statusStr != "" {
-		status = &statusStr
+	cursor, includeTotal, useCursor, err := cursorParams(queryParams)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid include_total parameter")
+		return
+	}
+
+	roleIDs := make([]uuid.UUID, 0)
+	for _, roleIDStr := range listQueryValues(queryParams, "role_id") {
+		roleID, err := uuid.Parse(roleIDStr)
+		if err != nil {
+			response.BadRequest(writer, request, "invalid role_id parameter")
+			return
+		}
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	neverLoggedIn, err := parseOptionalBool(queryParams.Get("never_logged_in"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid never_logged_in parameter")
+		return
 	}
 
 	var search *string
@@ -187,15 +281,69 @@ func (handler *UserHandler) List(writer http.ResponseWriter, request *http.Reque
 		dateTo = &dateToStr
 	}
 
+	var lastLoginFrom *string
+	if lastLoginFromStr := queryParams.Get("last_login_from"); lastLoginFromStr != "" {
+		lastLoginFrom = &lastLoginFromStr
+	}
+
+	var lastLoginTo *string
+	if lastLoginToStr := queryParams.Get("last_login_to"); lastLoginToStr != "" {
+		lastLoginTo = &lastLoginToStr
+	}
+
+	var deleted *string
+	if deletedStr := queryParams.Get("deleted"); deletedStr != "" {
+		authContext, _ := middleware.GetAuthContext(request.Context())
+		if authContext == nil || !authContext.HasPermission(deletedUsersPermission) {
+			response.Forbidden(writer, request, "listing deleted users requires "+deletedUsersPermission)
+			return
+		}
+		deleted = &deletedStr
+	}
+
+	var attributes map[string]string
+	for param, values := range queryParams {
+		key, found := strings.CutPrefix(param, attributeFilterPrefix)
+		if !found || key == "" || len(values) == 0 {
+			continue
+		}
+		if attributes == nil {
+			attributes = make(map[string]string)
+		}
+		attributes[key] = values[0]
+	}
+
 	listQuery := userquery.ListUsersQuery{
-		Page:      page,
-		Limit:     limit,
-		Status:    status,
-		Search:    search,
-		SortBy:    sortBy,
-		SortOrder: sortOrder,
-		DateFrom:  dateFrom,
-		DateTo:    dateTo,
+		Page:          page,
+		Limit:         limit,
+		Statuses:      listQueryValues(queryParams, "status"),
+		RoleIDs:       roleIDs,
+		Search:        search,
+		SortBy:        sortBy,
+		SortOrder:     sortOrder,
+		DateFrom:      dateFrom,
+		DateTo:        dateTo,
+		LastLoginFrom: lastLoginFrom,
+		LastLoginTo:   lastLoginTo,
+		NeverLoggedIn: neverLoggedIn,
+		Deleted:       deleted,
+		Attributes:    attributes,
+		Audience:      userattribute.AudiencePublic,
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok {
+		listQuery.OrganizationID = authContext.OrganizationID
+		if authContext.HasPermission(attributeAdminPermission) {
+			listQuery.Audience = userattribute.AudienceAdmin
+		}
+	}
+
+	if useCursor {
+		handler.listUsersByCursor(writer, request, userquery.ListUsersByCursorQuery{
+			ListUsersQuery: listQuery,
+			Cursor:         cursor,
+			IncludeTotal:   includeTotal,
+		})
+		return
 	}
 
 	result, err := handler.listUsersHandler.Handle(request.Context(), listQuery)
@@ -204,17 +352,10 @@ func (handler *UserHandler) List(writer http.ResponseWriter, request *http.Reque
 		return
 	}
 
-	userResponses := make([]dto.UserResponse, len(result.Items))
-	for i, userDTO := range result.Items {
-		userResponses[i] = dto.UserResponse{
-			ID:        userDTO.ID,
-			Email:     userDTO.Email,
-			FullName:  userDTO.FullName,
-			Status:    userDTO.Status,
-			CreatedAt: userDTO.CreatedAt,
-			UpdatedAt: userDTO.UpdatedAt,
-			DeletedAt: userDTO.DeletedAt,
-		}
+	userResponses, err := handler.toUserResponses(request, result.Items...)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
 	}
 
 	response.Success(writer, dto.PaginatedUsersResponse{
@@ -228,6 +369,22 @@ func (handler *UserHandler) List(writer http.ResponseWriter, request *http.Reque
 	})
 }
 
+func (handler *UserHandler) listUsersByCursor(writer http.ResponseWriter, request *http.Request, listQuery userquery.ListUsersByCursorQuery) {
+	result, err := handler.listUsersByCursorHandler.Handle(request.Context(), listQuery)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	userResponses, err := handler.toUserResponses(request, result.Items...)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.SuccessWithMeta(writer, userResponses, dto.CursorMetaFromResult(result))
+}
+
 func (handler *UserHandler) Update(writer http.ResponseWriter, request *http.Request) {
 	userID, err := handler.parseUserID(request)
 	if err != nil {
@@ -235,6 +392,12 @@ func (handler *UserHandler) Update(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	var requestBody dto.UpdateUserRequest
 	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
 		response.BadRequest(writer, request, "invalid request body")
@@ -251,8 +414,11 @@ func (handler *UserHandler) Update(writer http.ResponseWriter, request *http.Req
 	}
 
 	cmd := usercommand.UpdateUserCommand{
-		UserID:   userID,
-		FullName: requestBody.FullName,
+		UserID:          userID,
+		FullName:        requestBody.FullName,
+		Attributes:      requestBody.Attributes,
+		ActorID:         requestActorID(request),
+		ExpectedVersion: expectedVersion,
 	}
 
 	userDTO, err := handler.updateUserHandler.Handle(request.Context(), cmd)
@@ -261,15 +427,14 @@ func (handler *UserHandler) Update(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
-	response.Success(writer, dto.UserResponse{
-		ID:        userDTO.ID,
-		Email:     userDTO.Email,
-		FullName:  userDTO.FullName,
-		Status:    userDTO.Status,
-		CreatedAt: userDTO.CreatedAt,
-		UpdatedAt: userDTO.UpdatedAt,
-		DeletedAt: userDTO.DeletedAt,
-	})
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	setETag(writer, userDTO.Version)
+	response.Success(writer, userResponses[0])
 }
 
 func (handler *UserHandler) Delete(writer http.ResponseWriter, request *http.Request) {
@@ -279,7 +444,13 @@ func (handler *UserHandler) Delete(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
-	cmd := usercommand.DeleteUserCommand{UserID: userID}
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := usercommand.DeleteUserCommand{UserID: userID, ActorID: requestActorID(request), ExpectedVersion: expectedVersion}
 	if err := handler.deleteUserHandler.Handle(request.Context(), cmd); err != nil {
 		response.Error(writer, request, err)
 		return
@@ -288,6 +459,29 @@ func (handler *UserHandler) Delete(writer http.ResponseWriter, request *http.Req
 	response.NoContent(writer)
 }
 
+func (handler *UserHandler) Restore(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	cmd := usercommand.RestoreUserCommand{UserID: userID, ActorID: requestActorID(request)}
+	userDTO, err := handler.restoreUserHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, userResponses[0])
+}
+
 func (handler *UserHandler) ChangePassword(writer http.ResponseWriter, request *http.Request) {
 	userID, err := handler.parseUserID(request)
 	if err != nil {
@@ -331,7 +525,7 @@ func (handler *UserHandler) Activate(writer http.ResponseWriter, request *http.R
 		return
 	}
 
-	cmd := usercommand.ActivateUserCommand{UserID: userID}
+	cmd := usercommand.ActivateUserCommand{UserID: userID, ActorID: requestActorID(request)}
 	userDTO, err := handler.activateUserHandler.Handle(request.Context(), cmd)
 	if err != nil {
 		response.Error(writer, request, err)
@@ -343,6 +537,7 @@ func (handler *UserHandler) Activate(writer http.ResponseWriter, request *http.R
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -356,7 +551,7 @@ func (handler *UserHandler) Deactivate(writer http.ResponseWriter, request *http
 		return
 	}
 
-	cmd := usercommand.DeactivateUserCommand{UserID: userID}
+	cmd := usercommand.DeactivateUserCommand{UserID: userID, ActorID: requestActorID(request)}
 	userDTO, err := handler.deactivateUserHandler.Handle(request.Context(), cmd)
 	if err != nil {
 		response.Error(writer, request, err)
@@ -368,6 +563,7 @@ func (handler *UserHandler) Deactivate(writer http.ResponseWriter, request *http
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -397,8 +593,9 @@ func (handler *UserHandler) Ban(writer http.ResponseWriter, request *http.Reques
 	}
 
 	cmd := usercommand.BanUserCommand{
-		UserID: userID,
-		Reason: requestBody.Reason,
+		UserID:  userID,
+		Reason:  requestBody.Reason,
+		ActorID: requestActorID(request),
 	}
 
 	userDTO, err := handler.banUserHandler.Handle(request.Context(), cmd)
@@ -412,6 +609,102 @@ func (handler *UserHandler) Ban(writer http.ResponseWriter, request *http.Reques
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
+		CreatedAt: userDTO.CreatedAt,
+		UpdatedAt: userDTO.UpdatedAt,
+		DeletedAt: userDTO.DeletedAt,
+	})
+}
+
+func (handler *UserHandler) Suspend(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	var requestBody dto.SuspendUserRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := usercommand.SuspendUserCommand{
+		UserID:  userID,
+		Until:   requestBody.Until,
+		Reason:  requestBody.Reason,
+		ActorID: requestActorID(request),
+	}
+
+	userDTO, err := handler.suspendUserHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.UserResponse{
+		ID:               userDTO.ID,
+		Email:            userDTO.Email,
+		FullName:         userDTO.FullName,
+		Status:           userDTO.Status,
+		SuspendedUntil:   userDTO.SuspendedUntil,
+		SuspensionReason: userDTO.SuspensionReason,
+		Version:          userDTO.Version,
+		CreatedAt:        userDTO.CreatedAt,
+		UpdatedAt:        userDTO.UpdatedAt,
+		DeletedAt:        userDTO.DeletedAt,
+	})
+}
+
+func (handler *UserHandler) Unban(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	var requestBody dto.UnbanUserRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := usercommand.UnbanUserCommand{
+		UserID:        userID,
+		Justification: requestBody.Justification,
+		ActorID:       requestActorID(request),
+	}
+
+	userDTO, err := handler.unbanUserHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.UserResponse{
+		ID:        userDTO.ID,
+		Email:     userDTO.Email,
+		FullName:  userDTO.FullName,
+		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -425,7 +718,7 @@ func (handler *UserHandler) GetRoles(writer http.ResponseWriter, request *http.R
 		return
 	}
 
-	query := userquery.GetUserRolesQuery{UserID: userID}
+	query := userquery.GetUserRolesQuery{UserID: userID, OrganizationID: requestOrganizationID(request)}
 	roles, err := handler.getUserRolesHandler.Handle(request.Context(), query)
 	if err != nil {
 		response.Error(writer, request, err)
@@ -440,11 +733,13 @@ func (handler *UserHandler) GetRoles(writer http.ResponseWriter, request *http.R
 			DisplayName:   roleDTO.DisplayName,
 			Description:   roleDTO.Description,
 			PermissionIDs: roleDTO.PermissionIDs,
+			DeniedPermissionIDs: roleDTO.DeniedPermissionIDs,
 			IsSystem:      roleDTO.IsSystem,
 			IsDefault:     roleDTO.IsDefault,
-			Priority:      roleDTO.Priority,
-			CreatedAt:     roleDTO.CreatedAt,
-			UpdatedAt:     roleDTO.UpdatedAt,
+			Priority:       roleDTO.Priority,
+			OrganizationID: roleDTO.OrganizationID,
+			CreatedAt:      roleDTO.CreatedAt,
+			UpdatedAt:      roleDTO.UpdatedAt,
 		}
 	}
 
@@ -458,6 +753,12 @@ func (handler *UserHandler) SetRoles(writer http.ResponseWriter, request *http.R
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(request)
+	if err != nil {
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
 	var requestBody dto.SetUserRolesRequest
 	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
 		response.BadRequest(writer, request, "invalid request body")
@@ -474,8 +775,10 @@ func (handler *UserHandler) SetRoles(writer http.ResponseWriter, request *http.R
 	}
 
 	cmd := usercommand.SetUserRolesCommand{
-		UserID:  userID,
-		RoleIDs: requestBody.RoleIDs,
+		UserID:          userID,
+		RoleIDs:         requestBody.RoleIDs,
+		ActorID:         requestActorID(request),
+		ExpectedVersion: expectedVersion,
 	}
 
 	userDTO, err := handler.setUserRolesHandler.Handle(request.Context(), cmd)
@@ -484,11 +787,13 @@ func (handler *UserHandler) SetRoles(writer http.ResponseWriter, request *http.R
 		return
 	}
 
+	setETag(writer, userDTO.Version)
 	response.Success(writer, dto.UserResponse{
 		ID:        userDTO.ID,
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -509,8 +814,9 @@ func (handler *UserHandler) AssignRole(writer http.ResponseWriter, request *http
 	}
 
 	cmd := usercommand.AssignRoleToUserCommand{
-		UserID: userID,
-		RoleID: roleID,
+		UserID:  userID,
+		RoleID:  roleID,
+		ActorID: requestActorID(request),
 	}
 
 	userDTO, err := handler.assignRoleToUserHandler.Handle(request.Context(), cmd)
@@ -524,6 +830,7 @@ func (handler *UserHandler) AssignRole(writer http.ResponseWriter, request *http
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -544,8 +851,9 @@ func (handler *UserHandler) RevokeRole(writer http.ResponseWriter, request *http
 	}
 
 	cmd := usercommand.RevokeRoleFromUserCommand{
-		UserID: userID,
-		RoleID: roleID,
+		UserID:  userID,
+		RoleID:  roleID,
+		ActorID: requestActorID(request),
 	}
 
 	userDTO, err := handler.revokeRoleFromUserHandler.Handle(request.Context(), cmd)
@@ -559,6 +867,81 @@ func (handler *UserHandler) RevokeRole(writer http.ResponseWriter, request *http
 		Email:     userDTO.Email,
 		FullName:  userDTO.FullName,
 		Status:    userDTO.Status,
+		Version:   userDTO.Version,
+		CreatedAt: userDTO.CreatedAt,
+		UpdatedAt: userDTO.UpdatedAt,
+		DeletedAt: userDTO.DeletedAt,
+	})
+}
+
+func (handler *UserHandler) DenyPermission(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	permissionID, err := handler.parsePermissionID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid permission id")
+		return
+	}
+
+	cmd := usercommand.DenyUserPermissionCommand{
+		UserID:       userID,
+		PermissionID: permissionID,
+		ActorID:      requestActorID(request),
+	}
+
+	userDTO, err := handler.denyUserPermissionHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.UserResponse{
+		ID:        userDTO.ID,
+		Email:     userDTO.Email,
+		FullName:  userDTO.FullName,
+		Status:    userDTO.Status,
+		Version:   userDTO.Version,
+		CreatedAt: userDTO.CreatedAt,
+		UpdatedAt: userDTO.UpdatedAt,
+		DeletedAt: userDTO.DeletedAt,
+	})
+}
+
+func (handler *UserHandler) RemoveDeny(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	permissionID, err := handler.parsePermissionID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid permission id")
+		return
+	}
+
+	cmd := usercommand.RemoveUserDenyCommand{
+		UserID:       userID,
+		PermissionID: permissionID,
+		ActorID:      requestActorID(request),
+	}
+
+	userDTO, err := handler.removeUserDenyHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.UserResponse{
+		ID:        userDTO.ID,
+		Email:     userDTO.Email,
+		FullName:  userDTO.FullName,
+		Status:    userDTO.Status,
+		Version:   userDTO.Version,
 		CreatedAt: userDTO.CreatedAt,
 		UpdatedAt: userDTO.UpdatedAt,
 		DeletedAt: userDTO.DeletedAt,
@@ -572,31 +955,627 @@ func (handler *UserHandler) GetPermissions(writer http.ResponseWriter, request *
 		return
 	}
 
-	query := userquery.GetUserPermissionsQuery{UserID: userID}
+	query := userquery.GetUserPermissionsQuery{UserID: userID, OrganizationID: requestOrganizationID(request)}
 	permissions, err := handler.getUserPermissionsHandler.Handle(request.Context(), query)
 	if err != nil {
 		response.Error(writer, request, err)
 		return
 	}
 
-	permissionResponses := make([]dto.PermissionResponse, len(permissions))
+	permissionResponses := make([]dto.PermissionGrantResponse, len(permissions))
 	for i, permission := range permissions {
-		permissionResponses[i] = dto.PermissionResponse{
-			ID:          permission.ID,
-			Resource:    permission.Resource,
-			Action:      permission.Action,
-			Code:        permission.Code,
-			Description: permission.Description,
-			IsSystem:    permission.IsSystem,
-			CreatedAt:   permission.CreatedAt,
-			UpdatedAt:   permission.UpdatedAt,
+		permissionResponses[i] = dto.PermissionGrantResponse{
+			PermissionResponse: dto.PermissionResponse{
+				ID:          permission.ID,
+				Resource:    permission.Resource,
+				Action:      permission.Action,
+				Code:        permission.Code,
+				Description: permission.Description,
+				IsSystem:    permission.IsSystem,
+				CreatedAt:   permission.CreatedAt,
+				UpdatedAt:   permission.UpdatedAt,
+			},
+			Effect:   permission.Effect,
+			DeniedBy: permission.DeniedBy,
 		}
 	}
 
 	response.Success(writer, permissionResponses)
 }
 
-func (handler *UserHandler) parseUserID(request *http.Request) (uuid.UUID, error) {
+func (handler *UserHandler) Import(writer http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	dryRun, err := parseOptionalBool(queryParams.Get("dry_run"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid dry_run parameter")
+		return
+	}
+	sendInvitations, err := parseOptionalBool(queryParams.Get("send_invitations"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid send_invitations parameter")
+		return
+	}
+
+	source := io.Reader(request.Body)
+	format := queryParams.Get("format")
+	mediaType, _, _ := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		file, fileHeader, err := request.FormFile("file")
+		if err != nil {
+			response.BadRequest(writer, request, "missing file field")
+			return
+		}
+		defer file.Close()
+		source = file
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+		}
+	} else if format == "" {
+		format = transferFormatFromMediaType(mediaType)
+	}
+
+	cmd := usercommand.ImportUsersCommand{
+		Format:          format,
+		Source:          source,
+		DryRun:          dryRun,
+		SendInvitations: sendInvitations,
+		ActorID:         requestActorID(request),
+	}
+
+	flusher, canFlush := writer.(http.Flusher)
+	if !canFlush || !strings.Contains(request.Header.Get("Accept"), ndjsonContentType) {
+		result, err := handler.importUsersHandler.Handle(request.Context(), cmd)
+		if err != nil {
+			response.Error(writer, request, err)
+			return
+		}
+		response.Success(writer, toImportUsersResponse(result))
+		return
+	}
+
+	writer.Header().Set("Content-Type", ndjsonContentType)
+	writer.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(writer)
+	cmd.Progress = func(progress userdto.ImportProgressDTO) {
+		encoder.Encode(map[string]dto.ImportProgressResponse{"progress": {
+			Stage:     progress.Stage,
+			Processed: progress.Processed,
+			Total:     progress.Total,
+		}})
+		flusher.Flush()
+	}
+
+	result, err := handler.importUsersHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		encoder.Encode(response.ErrorBody(request, err))
+		return
+	}
+	encoder.Encode(response.SuccessResponse{Data: toImportUsersResponse(result)})
+}
+
+func (handler *UserHandler) Export(writer http.ResponseWriter, request *http.Request) {
+	queryParams := request.URL.Query()
+
+	format := strings.ToLower(queryParams.Get("format"))
+	if format == "" {
+		format = userdto.TransferFormatCSV
+	}
+	if err := userquery.ValidateExportFormat(format); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	exportQuery := userquery.ExportUsersQuery{Format: format}
+	if statusStr := queryParams.Get("status"); statusStr != "" {
+		exportQuery.Status = &statusStr
+	}
+	if searchStr := queryParams.Get("search"); searchStr != "" {
+		exportQuery.Search = &searchStr
+	}
+	if authContext, ok := middleware.GetAuthContext(request.Context()); ok {
+		exportQuery.OrganizationID = authContext.OrganizationID
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == userdto.TransferFormatJSON {
+		contentType = "application/json"
+	}
+	exportWriter := &deferredHeaderWriter{
+		writer:      writer,
+		contentType: contentType,
+		filename:    "users-" + time.Now().UTC().Format("20060102") + "." + format,
+	}
+
+	if _, err := handler.exportUsersHandler.Handle(request.Context(), exportQuery, exportWriter); err != nil {
+		if !exportWriter.started {
+			response.Error(writer, request, err)
+			return
+		}
+		handler.logger.Error("user export aborted mid-stream",
+			logger.String("format", format),
+			logger.Err(err),
+		)
+	}
+}
+
+// bulkActionPermissions lists the permission each bulk action requires on top
+// of users:bulk, matching the permission of the equivalent single-user route.
+var bulkActionPermissions = map[string]string{
+	bulkoperation.ActionActivate.String():   "users:manage",
+	bulkoperation.ActionDeactivate.String(): "users:manage",
+	bulkoperation.ActionBan.String():        "users:manage",
+	bulkoperation.ActionDelete.String():     "users:delete",
+	bulkoperation.ActionSetRoles.String():   "roles:assign",
+}
+
+func (handler *UserHandler) BulkOperate(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
+
+	var requestBody dto.BulkUserOperationRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	if !authContext.HasPermission(bulkActionPermissions[requestBody.Action]) {
+		response.Forbidden(writer, request, "insufficient permissions for bulk "+requestBody.Action)
+		return
+	}
+
+	cmd := bulkoperationcommand.StartBulkUserOperationCommand{
+		Action:  requestBody.Action,
+		UserIDs: requestBody.UserIDs,
+		Reason:  requestBody.Reason,
+		RoleIDs: requestBody.RoleIDs,
+		ActorID: authContext.UserID,
+	}
+	if requestBody.Filter != nil {
+		cmd.Filter = &bulkoperationcommand.UserFilter{
+			Status:         requestBody.Filter.Status,
+			Search:         requestBody.Filter.Search,
+			OrganizationID: authContext.OrganizationID,
+		}
+	}
+
+	operationDTO, err := handler.startBulkUserOperationHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	if operationDTO.Status == bulkoperation.StatusPending.String() {
+		location := "/api/v1/users/bulk/" + operationDTO.ID.String()
+		response.AcceptedWithLocation(writer, toBulkOperationResponse(operationDTO), location)
+		return
+	}
+	response.Success(writer, toBulkOperationResponse(operationDTO))
+}
+
+func (handler *UserHandler) GetBulkOperation(writer http.ResponseWriter, request *http.Request) {
+	operationID, err := uuid.Parse(chi.URLParam(request, "operationId"))
+	if err != nil {
+		response.BadRequest(writer, request, "invalid operation id")
+		return
+	}
+
+	query := bulkoperationquery.GetBulkOperationQuery{OperationID: operationID}
+	operationDTO, err := handler.getBulkOperationHandler.Handle(request.Context(), query)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toBulkOperationResponse(operationDTO))
+}
+
+// UploadAvatar serves both /users/me/avatar and /users/{id}/avatar; without an
+// id in the path the avatar of the authenticated user is replaced.
+func (handler *UserHandler) UploadAvatar(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.targetUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	file, _, err := request.FormFile(avatarFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.Error(writer, request, err)
+			return
+		}
+		response.BadRequest(writer, request, "missing avatar field")
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	cmd := usercommand.UploadAvatarCommand{
+		UserID:  userID,
+		Content: content,
+		ActorID: requestActorID(request),
+	}
+	userDTO, err := handler.uploadAvatarHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, userResponses[0])
+}
+
+func (handler *UserHandler) DeleteAvatar(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.targetUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	cmd := usercommand.DeleteAvatarCommand{UserID: userID, ActorID: requestActorID(request)}
+	if _, err := handler.deleteAvatarHandler.Handle(request.Context(), cmd); err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+// ExportPersonalData serves both /users/me/personal-data and
+// /users/{id}/personal-data as a downloadable JSON document or ZIP archive.
+func (handler *UserHandler) ExportPersonalData(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.targetUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format = userquery.PersonalDataFormatJSON
+	}
+
+	export, err := handler.exportPersonalDataHandler.Handle(request.Context(), userquery.ExportPersonalDataQuery{
+		UserID: userID,
+		Format: format,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", export.ContentType)
+	writer.Header().Set("Content-Disposition", `attachment; filename="`+export.Filename+`"`)
+	writer.WriteHeader(http.StatusOK)
+	writer.Write(export.Content)
+}
+
+func (handler *UserHandler) Erase(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	cmd := usercommand.EraseUserCommand{UserID: userID, ActorID: requestActorID(request)}
+	receipt, err := handler.eraseUserHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.ErasureReceiptResponse{
+		UserID:                    receipt.UserID,
+		ErasedAt:                  receipt.ErasedAt,
+		ErasedBy:                  receipt.ErasedBy,
+		RefreshTokensDeleted:      receipt.RefreshTokensDeleted,
+		ResetTokensDeleted:        receipt.ResetTokensDeleted,
+		InvitationsDeleted:        receipt.InvitationsDeleted,
+		AuditEntriesPseudonymized: receipt.AuditEntriesPseudonymized,
+		AvatarRemoved:             receipt.AvatarRemoved,
+	})
+}
+
+// GetPreferences returns the caller's preferences, or the defaults if they
+// never saved any.
+func (handler *UserHandler) GetPreferences(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "authentication required")
+		return
+	}
+
+	preferences, err := handler.getPreferencesHandler.Handle(request.Context(), userquery.GetPreferencesQuery{
+		UserID: authContext.UserID,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toPreferencesResponse(preferences))
+}
+
+// UpdatePreferences changes the settings present in the body and keeps the
+// others.
+func (handler *UserHandler) UpdatePreferences(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "authentication required")
+		return
+	}
+
+	var requestBody dto.UpdatePreferencesRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	cmd := usercommand.UpdatePreferencesCommand{
+		UserID:     authContext.UserID,
+		Locale:     requestBody.Locale,
+		Timezone:   requestBody.Timezone,
+		DateFormat: requestBody.DateFormat,
+	}
+	if requestBody.Notifications != nil {
+		cmd.AccountUpdates = requestBody.Notifications.AccountUpdates
+	}
+
+	preferences, err := handler.updatePreferencesHandler.Handle(request.Context(), cmd)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, toPreferencesResponse(preferences))
+}
+
+func toPreferencesResponse(preferences *userdto.PreferencesDTO) dto.PreferencesResponse {
+	return dto.PreferencesResponse{
+		Locale:     preferences.Locale,
+		Timezone:   preferences.Timezone,
+		DateFormat: preferences.DateFormat,
+		Notifications: dto.NotificationPreferencesResponse{
+			AccountUpdates: preferences.AccountUpdates,
+		},
+		UpdatedAt: preferences.UpdatedAt,
+	}
+}
+
+// RequestEmailChange starts a change of the caller's own email, which takes
+// effect once confirmed from the new address.
+func (handler *UserHandler) RequestEmailChange(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "authentication required")
+		return
+	}
+
+	var requestBody dto.RequestEmailChangeRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	change, err := handler.requestEmailChangeHandler.Handle(request.Context(), usercommand.RequestEmailChangeCommand{
+		UserID:   authContext.UserID,
+		NewEmail: requestBody.NewEmail,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, dto.EmailChangeResponse{
+		NewEmail:    change.NewEmail,
+		RequestedAt: change.RequestedAt,
+		ExpiresAt:   change.ExpiresAt,
+	})
+}
+
+// ConfirmEmailChange is reached from the link mailed to the new address and
+// needs no session.
+func (handler *UserHandler) ConfirmEmailChange(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.EmailChangeTokenRequest
+	if !handler.decodeEmailChangeToken(writer, request, &requestBody) {
+		return
+	}
+
+	userDTO, err := handler.confirmEmailChangeHandler.Handle(request.Context(), usercommand.ConfirmEmailChangeCommand{
+		Token: requestBody.Token,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.SuccessWithMessage(writer, dto.EmailChangeConfirmedResponse{
+		UserID: userDTO.ID,
+		Email:  userDTO.Email,
+	}, "email changed, sign in again with the new address")
+}
+
+// CancelEmailChange is reached from the link mailed to the current address
+// and needs no session.
+func (handler *UserHandler) CancelEmailChange(writer http.ResponseWriter, request *http.Request) {
+	var requestBody dto.EmailChangeTokenRequest
+	if !handler.decodeEmailChangeToken(writer, request, &requestBody) {
+		return
+	}
+
+	err := handler.cancelEmailChangeHandler.Handle(request.Context(), usercommand.CancelEmailChangeCommand{
+		Token: requestBody.Token,
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.NoContent(writer)
+}
+
+func (handler *UserHandler) decodeEmailChangeToken(writer http.ResponseWriter, request *http.Request, requestBody *dto.EmailChangeTokenRequest) bool {
+	if err := json.NewDecoder(request.Body).Decode(requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return false
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return false
+		}
+		response.BadRequest(writer, request, err.Error())
+		return false
+	}
+
+	return true
+}
+
+// ChangeEmail lets an administrator set a user's email without
+// confirmation. The change is audited as an override.
+func (handler *UserHandler) ChangeEmail(writer http.ResponseWriter, request *http.Request) {
+	userID, err := handler.parseUserID(request)
+	if err != nil {
+		response.BadRequest(writer, request, "invalid user id")
+		return
+	}
+
+	var requestBody dto.ChangeUserEmailRequest
+	if err := json.NewDecoder(request.Body).Decode(&requestBody); err != nil {
+		response.BadRequest(writer, request, "invalid request body")
+		return
+	}
+
+	if err := handler.validator.Validate(requestBody); err != nil {
+		if validationErrors, ok := validator.GetValidationErrors(err); ok {
+			response.ValidationError(writer, request, validationErrors)
+			return
+		}
+		response.BadRequest(writer, request, err.Error())
+		return
+	}
+
+	userDTO, err := handler.changeUserEmailHandler.Handle(request.Context(), usercommand.ChangeUserEmailCommand{
+		UserID:   userID,
+		NewEmail: requestBody.Email,
+		ActorID:  requestActorID(request),
+	})
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	userResponses, err := handler.toUserResponses(request, userDTO)
+	if err != nil {
+		response.Error(writer, request, err)
+		return
+	}
+
+	response.Success(writer, userResponses[0])
+}
+
+// targetUserID resolves the user a /users/me or /users/{id} route acts on.
+func (handler *UserHandler) targetUserID(request *http.Request) (uuid.UUID, error) {
+	if chi.URLParam(request, "id") != "" {
+		return handler.parseUserID(request)
+	}
+	authContext, ok := middleware.GetAuthContext(request.Context())
+	if !ok {
+		return uuid.Nil, errors.New("missing auth context")
+	}
+	return authContext.UserID, nil
+}
+
+// toUserResponses narrows each user's attributes to what the caller may read:
+// holders of users:update see everything, users see their own self-visible
+// attributes, and everyone else only public ones.
+func (handler *UserHandler) toUserResponses(request *http.Request, userDTOs ...*userdto.UserDTO) ([]dto.UserResponse, error) {
+	definitions, err := handler.listAttributeDefinitionsHandler.Handle(request.Context(), userattributequery.ListAttributeDefinitionsQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	authContext, _ := middleware.GetAuthContext(request.Context())
+
+	userResponses := make([]dto.UserResponse, len(userDTOs))
+	for i, userDTO := range userDTOs {
+		userResponses[i] = dto.UserResponse{
+			ID:               userDTO.ID,
+			Email:            userDTO.Email,
+			FullName:         userDTO.FullName,
+			Status:           userDTO.Status,
+			SuspendedUntil:   userDTO.SuspendedUntil,
+			SuspensionReason: userDTO.SuspensionReason,
+			Attributes:       userattributedto.VisibleAttributes(definitions, userDTO.Attributes, attributeAudience(authContext, userDTO.ID)),
+			AvatarURLs:       userDTO.AvatarURLs,
+			Version:          userDTO.Version,
+			CreatedAt:        userDTO.CreatedAt,
+			UpdatedAt:        userDTO.UpdatedAt,
+			DeletedAt:        userDTO.DeletedAt,
+			LastLoginAt:      userDTO.LastLoginAt,
+		}
+	}
+
+	return userResponses, nil
+}
+
+func attributeAudience(authContext *middleware.AuthContext, userID uuid.UUID) userattribute.Audience {
+	switch {
+	case authContext == nil:
+		return userattribute.AudiencePublic
+	case authContext.HasPermission(attributeAdminPermission):
+		return userattribute.AudienceAdmin
+	case authContext.UserID == userID:
+		return userattribute.AudienceSelf
+	default:
+		return userattribute.AudiencePublic
+	}
+}
+
+func (handler *UserHandler) parseUserID(request *http.Request) (uuid.UUID, error) {
 	userIDParam := chi.URLParam(request, "id")
 	return uuid.Parse(userIDParam)
 }
@@ -605,3 +1584,133 @@ func (handler *UserHandler) parseRoleID(request *http.Request) (uuid.UUID, error
 	roleIDParam := chi.URLParam(request, "roleId")
 	return uuid.Parse(roleIDParam)
 }
+
+func (handler *UserHandler) parsePermissionID(request *http.Request) (uuid.UUID, error) {
+	permissionIDParam := chi.URLParam(request, "permissionId")
+	return uuid.Parse(permissionIDParam)
+}
+
+const ndjsonContentType = "application/x-ndjson"
+
+const (
+	// attributeFilterPrefix marks list query parameters that filter on custom
+	// attributes, as in ?attr.department=engineering.
+	attributeFilterPrefix = "attr."
+
+	// attributeAdminPermission lets a caller read and filter on every custom
+	// attribute regardless of its visibility.
+	attributeAdminPermission = "users:update"
+
+	// deletedUsersPermission lets a caller list soft-deleted users, the
+	// same permission that deletes and restores them.
+	deletedUsersPermission = "users:delete"
+
+	// avatarFormField is the multipart field that carries an uploaded avatar.
+	avatarFormField = "avatar"
+)
+
+type deferredHeaderWriter struct {
+	writer      http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (exportWriter *deferredHeaderWriter) Write(data []byte) (int, error) {
+	if !exportWriter.started {
+		exportWriter.started = true
+		exportWriter.writer.Header().Set("Content-Type", exportWriter.contentType)
+		exportWriter.writer.Header().Set("Content-Disposition", `attachment; filename="`+exportWriter.filename+`"`)
+		exportWriter.writer.WriteHeader(http.StatusOK)
+	}
+	written, err := exportWriter.writer.Write(data)
+	if flusher, ok := exportWriter.writer.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return written, err
+}
+
+// listQueryValues accepts both repeated parameters and comma-separated
+// values, so ?status=active&status=banned equals ?status=active,banned.
+func listQueryValues(queryParams url.Values, key string) []string {
+	values := make([]string, 0)
+	for _, param := range queryParams[key] {
+		for _, value := range strings.Split(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func parseOptionalBool(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+func transferFormatFromMediaType(mediaType string) string {
+	switch mediaType {
+	case "text/csv":
+		return userdto.TransferFormatCSV
+	case "application/json":
+		return userdto.TransferFormatJSON
+	default:
+		return ""
+	}
+}
+
+func toImportUsersResponse(result *userdto.ImportResultDTO) dto.ImportUsersResponse {
+	errorResponses := make([]dto.ImportRowErrorResponse, len(result.Errors))
+	for i, rowError := range result.Errors {
+		errorResponses[i] = dto.ImportRowErrorResponse{
+			Row:   rowError.Row,
+			Email: rowError.Email,
+			Error: rowError.Error,
+		}
+	}
+
+	return dto.ImportUsersResponse{
+		DryRun:    result.DryRun,
+		Total:     result.Total,
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Unchanged: result.Unchanged,
+		Failed:    result.Failed,
+		Invited:   result.Invited,
+		Errors:    errorResponses,
+	}
+}
+
+func toBulkOperationResponse(operationDTO *bulkoperationdto.OperationDTO) dto.BulkOperationResponse {
+	items := make([]dto.BulkOperationItemResponse, len(operationDTO.Items))
+	for i, item := range operationDTO.Items {
+		items[i] = dto.BulkOperationItemResponse{
+			UserID:      item.UserID,
+			Status:      item.Status,
+			Error:       item.Error,
+			ProcessedAt: item.ProcessedAt,
+		}
+	}
+
+	return dto.BulkOperationResponse{
+		ID:            operationDTO.ID,
+		Action:        operationDTO.Action,
+		Reason:        operationDTO.Reason,
+		RoleIDs:       operationDTO.RoleIDs,
+		Status:        operationDTO.Status,
+		CreatedBy:     operationDTO.CreatedBy,
+		Total:         operationDTO.Total,
+		Succeeded:     operationDTO.Succeeded,
+		Failed:        operationDTO.Failed,
+		Pending:       operationDTO.Pending,
+		FailureReason: operationDTO.FailureReason,
+		Items:         items,
+		StartedAt:     operationDTO.StartedAt,
+		CompletedAt:   operationDTO.CompletedAt,
+		CreatedAt:     operationDTO.CreatedAt,
+		UpdatedAt:     operationDTO.UpdatedAt,
+	}
+}
@@ -10,17 +10,20 @@ import (
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
 )
 
 type authContextKey struct{}
 
 type AuthContext struct {
-	UserID      uuid.UUID
-	Email       string
-	Roles       []string
-	Permissions []string
-	TokenID     string
-	ExpiresAt   time.Time
+	UserID            uuid.UUID
+	Email             string
+	OrganizationID    *uuid.UUID
+	Roles             []string
+	Permissions       []string
+	DeniedPermissions []string
+	TokenID           string
+	ExpiresAt         time.Time
 }
 
 type AuthMiddleware struct {
@@ -63,15 +66,18 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 		}
 
 		authContext := &AuthContext{
-			UserID:      claims.UserID,
-			Email:       claims.Email,
-			Roles:       claims.Roles,
-			Permissions: claims.Permissions,
-			TokenID:     claims.TokenID,
-			ExpiresAt:   claims.ExpiresAt,
+			UserID:            claims.UserID,
+			Email:             claims.Email,
+			OrganizationID:    claims.OrganizationID,
+			Roles:             claims.Roles,
+			Permissions:       claims.Permissions,
+			DeniedPermissions: claims.DeniedPermissions,
+			TokenID:           claims.TokenID,
+			ExpiresAt:         claims.ExpiresAt,
 		}
 
 		ctx := context.WithValue(request.Context(), authContextKey{}, authContext)
+		ctx = withPreferredLocale(ctx, writer, claims.Locale)
 		request = request.WithContext(ctx)
 
 		next.ServeHTTP(writer, request)
@@ -106,21 +112,35 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 		}
 
 		authContext := &AuthContext{
-			UserID:      claims.UserID,
-			Email:       claims.Email,
-			Roles:       claims.Roles,
-			Permissions: claims.Permissions,
-			TokenID:     claims.TokenID,
-			ExpiresAt:   claims.ExpiresAt,
+			UserID:            claims.UserID,
+			Email:             claims.Email,
+			OrganizationID:    claims.OrganizationID,
+			Roles:             claims.Roles,
+			Permissions:       claims.Permissions,
+			DeniedPermissions: claims.DeniedPermissions,
+			TokenID:           claims.TokenID,
+			ExpiresAt:         claims.ExpiresAt,
 		}
 
 		ctx := context.WithValue(request.Context(), authContextKey{}, authContext)
+		ctx = withPreferredLocale(ctx, writer, claims.Locale)
 		request = request.WithContext(ctx)
 
 		next.ServeHTTP(writer, request)
 	})
 }
 
+// withPreferredLocale switches the request to the locale the user saved in
+// their preferences. Login and token refresh sign it into the access token,
+// so no request has to look the preferences up.
+func withPreferredLocale(ctx context.Context, writer http.ResponseWriter, locale string) context.Context {
+	if locale == "" {
+		return ctx
+	}
+	writer.Header().Set("Content-Language", locale)
+	return i18n.WithLocale(ctx, locale)
+}
+
 func (m *AuthMiddleware) extractToken(request *http.Request) (string, error) {
 	authHeader := request.Header.Get("Authorization")
 	if authHeader == "" {
@@ -8,65 +8,80 @@ import (
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/response"
 )
 
-func RequirePermission(permission string) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			authContext, ok := GetAuthContext(request.Context())
-			if !ok {
-				response.Unauthorized(writer, request, "unauthorized")
-				return
-			}
+type PermissionMatch string
 
-			if !hasPermission(authContext.Permissions, permission) {
-				response.Forbidden(writer, request, "insufficient permissions")
-				return
+const (
+	PermissionMatchAll PermissionMatch = "all"
+	PermissionMatchAny PermissionMatch = "any"
+)
+
+type PermissionRequirement struct {
+	Permissions []string
+	Match       PermissionMatch
+}
+
+func (requirement PermissionRequirement) SatisfiedBy(authContext *AuthContext) bool {
+	if requirement.Match == PermissionMatchAny {
+		for _, permission := range requirement.Permissions {
+			if authContext.HasPermission(permission) {
+				return true
 			}
+		}
+		return false
+	}
 
-			next.ServeHTTP(writer, request)
-		})
+	for _, permission := range requirement.Permissions {
+		if !authContext.HasPermission(permission) {
+			return false
+		}
 	}
+	return true
 }
 
-func RequireAnyPermission(permissions ...string) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			authContext, ok := GetAuthContext(request.Context())
-			if !ok {
-				response.Unauthorized(writer, request, "unauthorized")
-				return
-			}
+type permissionGate struct {
+	next        http.Handler
+	requirement PermissionRequirement
+}
 
-			for _, permission := range permissions {
-				if hasPermission(authContext.Permissions, permission) {
-					next.ServeHTTP(writer, request)
-					return
-				}
-			}
+func (gate *permissionGate) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	authContext, ok := GetAuthContext(request.Context())
+	if !ok {
+		response.Unauthorized(writer, request, "unauthorized")
+		return
+	}
 
-			response.Forbidden(writer, request, "insufficient permissions")
-		})
+	if !gate.requirement.SatisfiedBy(authContext) {
+		response.Forbidden(writer, request, "insufficient permissions")
+		return
 	}
+
+	gate.next.ServeHTTP(writer, request)
+}
+
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return requirePermissions(PermissionRequirement{Permissions: []string{permission}, Match: PermissionMatchAll})
+}
+
+func RequireAnyPermission(permissions ...string) func(http.Handler) http.Handler {
+	return requirePermissions(PermissionRequirement{Permissions: permissions, Match: PermissionMatchAny})
 }
 
 func RequireAllPermissions(permissions ...string) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			authContext, ok := GetAuthContext(request.Context())
-			if !ok {
-				response.Unauthorized(writer, request, "unauthorized")
-				return
-			}
+	return requirePermissions(PermissionRequirement{Permissions: permissions, Match: PermissionMatchAll})
+}
 
-			for _, permission := range permissions {
-				if !hasPermission(authContext.Permissions, permission) {
-					response.Forbidden(writer, request, "insufficient permissions")
-					return
-				}
-			}
+func requirePermissions(requirement PermissionRequirement) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return &permissionGate{next: next, requirement: requirement}
+	}
+}
 
-			next.ServeHTTP(writer, request)
-		})
+func PermissionRequirementOf(middleware func(http.Handler) http.Handler) (PermissionRequirement, bool) {
+	gate, ok := middleware(http.NotFoundHandler()).(*permissionGate)
+	if !ok {
+		return PermissionRequirement{}, false
 	}
+	return gate.requirement, true
 }
 
 func RequireRole(role string) func(http.Handler) http.Handler {
@@ -139,6 +154,22 @@ func ResourceOwner(paramName string) func(http.Handler) http.Handler {
 	}
 }
 
+func (authContext *AuthContext) HasPermission(permission string) bool {
+	if isDenied(authContext.DeniedPermissions, permission) {
+		return false
+	}
+	return hasPermission(authContext.Permissions, permission)
+}
+
+func isDenied(deniedPermissions []string, permission string) bool {
+	for _, deniedPermission := range deniedPermissions {
+		if deniedPermission == permission {
+			return true
+		}
+	}
+	return false
+}
+
 func hasPermission(userPermissions []string, permission string) bool {
 	for _, userPermission := range userPermissions {
 		if userPermission == permission || userPermission == "system:admin" {
@@ -142,6 +142,32 @@ func TestRequirePermission(t *testing.T) {
 			requiredPermission: "users:create",
 			expectedStatus:     http.StatusForbidden,
 		},
+		{
+			name: "deny when permission is explicitly denied",
+			setupContext: func() context.Context {
+				authContext := &AuthContext{
+					UserID:            uuid.New(),
+					Permissions:       []string{"users:read", "users:delete"},
+					DeniedPermissions: []string{"users:delete"},
+				}
+				return context.WithValue(context.Background(), authContextKey{}, authContext)
+			},
+			requiredPermission: "users:delete",
+			expectedStatus:     http.StatusForbidden,
+		},
+		{
+			name: "deny overrides system:admin",
+			setupContext: func() context.Context {
+				authContext := &AuthContext{
+					UserID:            uuid.New(),
+					Permissions:       []string{"system:admin"},
+					DeniedPermissions: []string{"users:delete"},
+				}
+				return context.WithValue(context.Background(), authContextKey{}, authContext)
+			},
+			requiredPermission: "users:delete",
+			expectedStatus:     http.StatusForbidden,
+		},
 		{
 			name: "deny when no auth context",
 			setupContext: func() context.Context {
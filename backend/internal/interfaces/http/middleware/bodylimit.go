@@ -3,6 +3,7 @@ package middleware
 import (
 	"fmt"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 )
@@ -89,7 +90,13 @@ func shouldExclude(request *http.Request, excludedPaths []string, excludedMethod
 	return false
 }
 
+// matchPath treats a trailing "*" as a prefix match and any other "*" as a
+// single path segment, so "/api/v1/users/*/avatar" matches one user's avatar.
 func matchPath(requestPath, pattern string) bool {
+	if strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+		matched, err := path.Match(pattern, requestPath)
+		return err == nil && matched
+	}
 	if strings.HasSuffix(pattern, "*") {
 		prefix := strings.TrimSuffix(pattern, "*")
 		return strings.HasPrefix(requestPath, prefix)
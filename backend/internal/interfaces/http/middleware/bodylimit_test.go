@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestPath string
+		pattern     string
+		expected    bool
+	}{
+		{name: "exact match", requestPath: "/api/v1/users", pattern: "/api/v1/users", expected: true},
+		{name: "exact mismatch", requestPath: "/api/v1/users/me", pattern: "/api/v1/users", expected: false},
+		{name: "trailing wildcard matches prefix", requestPath: "/api/v1/users/me/avatar", pattern: "/api/v1/users*", expected: true},
+		{name: "segment wildcard matches one segment", requestPath: "/api/v1/users/me/avatar", pattern: "/api/v1/users/*/avatar", expected: true},
+		{name: "segment wildcard does not span segments", requestPath: "/api/v1/users/a/b/avatar", pattern: "/api/v1/users/*/avatar", expected: false},
+		{name: "segment wildcard requires the suffix", requestPath: "/api/v1/users/me", pattern: "/api/v1/users/*/avatar", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchPath(tt.requestPath, tt.pattern))
+		})
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	successHandler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	})
+
+	config := DefaultBodyLimitConfig()
+	config.Limit = 16
+	config.ExcludedPaths = []string{"/users/*/avatar"}
+	handler := BodyLimit(config)(successHandler)
+
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{name: "allows body within limit", path: "/users", body: "small", expectedStatus: http.StatusOK},
+		{name: "rejects body over limit", path: "/users", body: strings.Repeat("x", 32), expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "skips excluded path", path: "/users/me/avatar", body: strings.Repeat("x", 32), expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+)
+
+// Locale picks the language of error messages from the Accept-Language
+// header. AuthMiddleware replaces it with the locale an authenticated user
+// saved in their preferences.
+func Locale(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		locale := i18n.ParseAcceptLanguage(request.Header.Get("Accept-Language"))
+		if locale == "" {
+			locale = i18n.Default
+		}
+
+		writer.Header().Add("Vary", "Accept-Language")
+		writer.Header().Set("Content-Language", locale)
+
+		next.ServeHTTP(writer, request.WithContext(i18n.WithLocale(request.Context(), locale)))
+	})
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+	"github.com/tranvuongduy2003/go-copilot/pkg/testutil"
+)
+
+func TestLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{name: "defaults to English", acceptLanguage: "", expected: i18n.English},
+		{name: "picks Vietnamese", acceptLanguage: "vi-VN,vi;q=0.9,en;q=0.8", expected: i18n.Vietnamese},
+		{name: "falls back on unsupported languages", acceptLanguage: "fr-FR", expected: i18n.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var locale string
+			handler := Locale(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				locale = i18n.FromContext(request.Context())
+			}))
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("Accept-Language", tt.acceptLanguage)
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.expected, locale)
+			assert.Equal(t, tt.expected, recorder.Header().Get("Content-Language"))
+			assert.Equal(t, "Accept-Language", recorder.Header().Get("Vary"))
+		})
+	}
+}
+
+func TestAuthMiddleware_RequireAuth_PreferredLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{name: "uses the locale in the token", locale: i18n.Vietnamese, expected: i18n.Vietnamese},
+		{name: "keeps the request locale without a saved one", locale: "", expected: i18n.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenGenerator := testutil.NewMockTokenGenerator()
+			tokenGenerator.ParsedClaims = &auth.Claims{
+				UserID:    uuid.New(),
+				Locale:    tt.locale,
+				TokenID:   uuid.NewString(),
+				ExpiresAt: time.Now().Add(time.Hour),
+			}
+			middleware := NewAuthMiddleware(tokenGenerator, nil)
+
+			var locale string
+			handler := Locale(middleware.RequireAuth(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				locale = i18n.FromContext(request.Context())
+			})))
+
+			// The middleware has no preferences dependency: every request
+			// takes the locale from its token alone.
+			for i := 0; i < 3; i++ {
+				request := httptest.NewRequest(http.MethodGet, "/", nil)
+				request.Header.Set("Authorization", "Bearer token")
+				request.Header.Set("Accept-Language", "en")
+				recorder := httptest.NewRecorder()
+
+				handler.ServeHTTP(recorder, request)
+
+				assert.Equal(t, tt.expected, locale)
+				assert.Equal(t, tt.expected, recorder.Header().Get("Content-Language"))
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
 	"github.com/tranvuongduy2003/go-copilot/pkg/validator"
 )
 
@@ -61,21 +63,32 @@ func CreatedWithLocation(writer http.ResponseWriter, data interface{}, location
 	JSON(writer, http.StatusCreated, SuccessResponse{Data: data})
 }
 
+func AcceptedWithLocation(writer http.ResponseWriter, data interface{}, location string) {
+	writer.Header().Set("Location", location)
+	JSON(writer, http.StatusAccepted, SuccessResponse{Data: data})
+}
+
 func NoContent(writer http.ResponseWriter) {
 	writer.WriteHeader(http.StatusNoContent)
 }
 
 func Error(writer http.ResponseWriter, request *http.Request, err error) {
-	statusCode, response := mapErrorToResponse(err)
+	statusCode, response := mapErrorToResponse(err, requestLocale(request))
 	response.TraceID = GetRequestID(request)
 	JSON(writer, statusCode, response)
 }
 
+func ErrorBody(request *http.Request, err error) ErrorResponse {
+	_, response := mapErrorToResponse(err, requestLocale(request))
+	response.TraceID = GetRequestID(request)
+	return response
+}
+
 func BadRequest(writer http.ResponseWriter, request *http.Request, message string) {
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "BAD_REQUEST",
-			Message: message,
+			Message: translate(request, message),
 		},
 		TraceID: GetRequestID(request),
 	}
@@ -89,7 +102,7 @@ func Unauthorized(writer http.ResponseWriter, request *http.Request, message str
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "UNAUTHORIZED",
-			Message: message,
+			Message: translate(request, message),
 		},
 		TraceID: GetRequestID(request),
 	}
@@ -103,7 +116,7 @@ func Forbidden(writer http.ResponseWriter, request *http.Request, message string
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "FORBIDDEN",
-			Message: message,
+			Message: translate(request, message),
 		},
 		TraceID: GetRequestID(request),
 	}
@@ -117,7 +130,7 @@ func NotFound(writer http.ResponseWriter, request *http.Request, message string)
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "NOT_FOUND",
-			Message: message,
+			Message: translate(request, message),
 		},
 		TraceID: GetRequestID(request),
 	}
@@ -128,7 +141,7 @@ func InternalError(writer http.ResponseWriter, request *http.Request) {
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "INTERNAL_ERROR",
-			Message: "an internal error occurred",
+			Message: translate(request, "an internal error occurred"),
 		},
 		TraceID: GetRequestID(request),
 	}
@@ -136,6 +149,7 @@ func InternalError(writer http.ResponseWriter, request *http.Request) {
 }
 
 func ValidationError(writer http.ResponseWriter, request *http.Request, errs validator.ValidationErrors) {
+	errs = errs.Localize(requestLocale(request))
 	details := make([]FieldError, len(errs))
 	for i, err := range errs {
 		details[i] = FieldError{
@@ -147,7 +161,7 @@ func ValidationError(writer http.ResponseWriter, request *http.Request, errs val
 	response := ErrorResponse{
 		Error: ErrorDetail{
 			Code:    "VALIDATION_ERROR",
-			Message: "validation failed",
+			Message: translate(request, "validation failed"),
 			Details: details,
 		},
 		TraceID: GetRequestID(request),
@@ -155,8 +169,11 @@ func ValidationError(writer http.ResponseWriter, request *http.Request, errs val
 	JSON(writer, http.StatusBadRequest, response)
 }
 
-func mapErrorToResponse(err error) (int, ErrorResponse) {
+// mapErrorToResponse renders the message of err in locale. Messages that
+// have no translation, such as ones built from input, stay in English.
+func mapErrorToResponse(err error, locale string) (int, ErrorResponse) {
 	if validationErrs, ok := validator.GetValidationErrors(err); ok {
+		validationErrs = validationErrs.Localize(locale)
 		details := make([]FieldError, len(validationErrs))
 		for i, validationErr := range validationErrs {
 			details[i] = FieldError{
@@ -167,7 +184,7 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 		return http.StatusBadRequest, ErrorResponse{
 			Error: ErrorDetail{
 				Code:    "VALIDATION_ERROR",
-				Message: "validation failed",
+				Message: i18n.Translate(locale, "validation failed", nil),
 				Details: details,
 			},
 		}
@@ -177,22 +194,29 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 	if errors.As(err, &notFoundErr) {
 		return http.StatusNotFound, ErrorResponse{
 			Error: ErrorDetail{
-				Code:    string(shared.ErrCodeNotFound),
-				Message: notFoundErr.Error(),
+				Code: string(shared.ErrCodeNotFound),
+				Message: i18n.Translate(locale, "{entity} with identifier '{identifier}' not found", i18n.Params{
+					"entity":     i18n.Translate(locale, notFoundErr.EntityType, nil),
+					"identifier": notFoundErr.Identifier,
+				}),
 			},
 		}
 	}
 
 	var validationErr *shared.ValidationError
 	if errors.As(err, &validationErr) {
+		message := i18n.Translate(locale, validationErr.Message, nil)
 		return http.StatusBadRequest, ErrorResponse{
 			Error: ErrorDetail{
-				Code:    string(shared.ErrCodeValidation),
-				Message: validationErr.Error(),
+				Code: string(shared.ErrCodeValidation),
+				Message: i18n.Translate(locale, "validation error on field '{field}': {message}", i18n.Params{
+					"field":   validationErr.Field,
+					"message": message,
+				}),
 				Details: []FieldError{
 					{
 						Field:   validationErr.Field,
-						Message: validationErr.Message,
+						Message: message,
 					},
 				},
 			},
@@ -203,8 +227,31 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 	if errors.As(err, &conflictErr) {
 		return http.StatusConflict, ErrorResponse{
 			Error: ErrorDetail{
-				Code:    string(shared.ErrCodeConflict),
-				Message: conflictErr.Error(),
+				Code: string(shared.ErrCodeConflict),
+				Message: i18n.Translate(locale, "{entity} with {field} '{value}' already exists", i18n.Params{
+					"entity": i18n.Translate(locale, conflictErr.EntityType, nil),
+					"field":  conflictErr.Field,
+					"value":  conflictErr.Value,
+				}),
+			},
+		}
+	}
+
+	var concurrentModificationErr *shared.ConcurrentModificationError
+	if errors.As(err, &concurrentModificationErr) {
+		statusCode := http.StatusConflict
+		template := "{entity} with identifier '{identifier}' was modified by another request"
+		if concurrentModificationErr.Code() == shared.ErrCodePreconditionFailed {
+			statusCode = http.StatusPreconditionFailed
+			template = "{entity} with identifier '{identifier}' does not match the expected version"
+		}
+		return statusCode, ErrorResponse{
+			Error: ErrorDetail{
+				Code: string(concurrentModificationErr.Code()),
+				Message: i18n.Translate(locale, template, i18n.Params{
+					"entity":     i18n.Translate(locale, concurrentModificationErr.EntityType, nil),
+					"identifier": concurrentModificationErr.Identifier,
+				}),
 			},
 		}
 	}
@@ -216,24 +263,40 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 			return http.StatusUnauthorized, ErrorResponse{
 				Error: ErrorDetail{
 					Code:    "UNAUTHORIZED",
-					Message: errorMessage,
+					Message: i18n.Translate(locale, errorMessage, nil),
 				},
 			}
 		}
 		return http.StatusForbidden, ErrorResponse{
 			Error: ErrorDetail{
 				Code:    string(shared.ErrCodeAuthorization),
-				Message: errorMessage,
+				Message: i18n.Translate(locale, errorMessage, nil),
 			},
 		}
 	}
 
 	var businessRuleErr *shared.BusinessRuleViolationError
 	if errors.As(err, &businessRuleErr) {
+		message := i18n.Translate(locale, businessRuleErr.Error(), nil)
+		if businessRuleErr.Template != "" {
+			message = i18n.Translate(locale, businessRuleErr.Template, businessRuleErr.Params)
+		}
 		return http.StatusUnprocessableEntity, ErrorResponse{
 			Error: ErrorDetail{
 				Code:    string(shared.ErrCodeBusinessRule),
-				Message: businessRuleErr.Error(),
+				Message: message,
+			},
+		}
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "REQUEST_TOO_LARGE",
+				Message: i18n.Translate(locale, "request body exceeds limit of {limit} bytes", i18n.Params{
+					"limit": strconv.FormatInt(maxBytesErr.Limit, 10),
+				}),
 			},
 		}
 	}
@@ -242,8 +305,11 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 	if errors.As(err, &statusTransitionErr) {
 		return http.StatusUnprocessableEntity, ErrorResponse{
 			Error: ErrorDetail{
-				Code:    string(shared.ErrCodeInvalidStatusTrans),
-				Message: statusTransitionErr.Error(),
+				Code: string(shared.ErrCodeInvalidStatusTrans),
+				Message: i18n.Translate(locale, "cannot transition from '{from}' to '{to}'", i18n.Params{
+					"from": i18n.Translate(locale, statusTransitionErr.CurrentStatus, nil),
+					"to":   i18n.Translate(locale, statusTransitionErr.TargetStatus, nil),
+				}),
 			},
 		}
 	}
@@ -251,11 +317,24 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 	return http.StatusInternalServerError, ErrorResponse{
 		Error: ErrorDetail{
 			Code:    string(shared.ErrCodeInternal),
-			Message: "an internal error occurred",
+			Message: i18n.Translate(locale, "an internal error occurred", nil),
 		},
 	}
 }
 
+// requestLocale returns the locale the Locale middleware, or the
+// authenticated user's preferences, chose for the request.
+func requestLocale(request *http.Request) string {
+	if request == nil {
+		return i18n.Default
+	}
+	return i18n.FromContext(request.Context())
+}
+
+func translate(request *http.Request, message string) string {
+	return i18n.Translate(requestLocale(request), message, nil)
+}
+
 func GetRequestID(request *http.Request) string {
 	if request == nil {
 		return ""
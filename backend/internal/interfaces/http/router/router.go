@@ -6,33 +6,47 @@ import (
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/authz"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/handler"
 	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/routes"
 	"github.com/tranvuongduy2003/go-copilot/pkg/config"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type RouterDependencies struct {
-	UserHandler       *handler.UserHandler
-	AuthHandler       *handler.AuthHandler
-	PermissionHandler *handler.PermissionHandler
-	RoleHandler       *handler.RoleHandler
-	HealthHandler     *handler.HealthHandler
-	MetricsHandler    *handler.MetricsHandler
-	DocsHandler       *handler.DocsHandler
-	AuthMiddleware    *middleware.AuthMiddleware
-	Logger            logger.Logger
-	Config            *config.Config
+	UserHandler          *handler.UserHandler
+	AuthHandler          *handler.AuthHandler
+	PermissionHandler    *handler.PermissionHandler
+	RoleHandler          *handler.RoleHandler
+	OrganizationHandler  *handler.OrganizationHandler
+	GroupHandler         *handler.GroupHandler
+	AuthzHandler         *handler.AuthzHandler
+	AccessRequestHandler *handler.AccessRequestHandler
+	AccessReviewHandler  *handler.AccessReviewHandler
+	InvitationHandler    *handler.InvitationHandler
+	SoDHandler           *handler.SoDHandler
+	UserAttributeHandler *handler.UserAttributeHandler
+	AuditLogHandler      *handler.AuditLogHandler
+	HealthHandler        *handler.HealthHandler
+	MetricsHandler       *handler.MetricsHandler
+	DocsHandler          *handler.DocsHandler
+	MediaHandler         *handler.MediaHandler
+	AuthMiddleware       *middleware.AuthMiddleware
+	RouteRegistry        *routes.Registry
+	Logger               logger.Logger
+	Config               *config.Config
 }
 
 func NewRouter(dependencies RouterDependencies) http.Handler {
 	router := chi.NewRouter()
 
 	router.Use(middleware.RequestID)
+	router.Use(middleware.Locale)
 	router.Use(middleware.Logging(dependencies.Logger))
 	router.Use(middleware.Recovery(dependencies.Logger))
 	router.Use(middleware.CORS(dependencies.Config.CORS))
-	router.Use(middleware.BodyLimitDefault)
+	router.Use(middleware.BodyLimit(defaultBodyLimitConfig()))
 	router.Use(middleware.QueryLimitDefault)
 	router.Use(middleware.Timeout(30 * time.Second))
 	router.Use(middleware.SecureHeaders)
@@ -56,6 +70,15 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 		})
 	}
 
+	if dependencies.MediaHandler != nil {
+		router.Get(dependencies.MediaHandler.Prefix()+"/*", dependencies.MediaHandler.Serve)
+	}
+
+	avatarBodyLimit := middleware.BodyLimit(middleware.BodyLimitConfig{
+		Limit:           dependencies.Config.Avatar.MaxUploadSize + multipartOverhead,
+		ExcludedMethods: []string{http.MethodGet, http.MethodHead, http.MethodOptions},
+	})
+
 	loginRateLimiter := middleware.NewRateLimiter(middleware.LoginRateLimiterConfig())
 	registerRateLimiter := middleware.NewRateLimiter(middleware.RegisterRateLimiterConfig())
 	passwordResetRateLimiter := middleware.NewRateLimiter(middleware.PasswordResetRateLimiterConfig())
@@ -68,6 +91,9 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 			authRouter.With(middleware.RateLimit(tokenRefreshRateLimiter)).Post("/refresh", dependencies.AuthHandler.RefreshToken)
 			authRouter.With(middleware.RateLimit(passwordResetRateLimiter)).Post("/forgot-password", dependencies.AuthHandler.ForgotPassword)
 			authRouter.With(middleware.RateLimit(passwordResetRateLimiter)).Post("/reset-password", dependencies.AuthHandler.ResetPassword)
+			authRouter.With(middleware.RateLimit(passwordResetRateLimiter)).Post("/email-change/confirm", dependencies.UserHandler.ConfirmEmailChange)
+			authRouter.With(middleware.RateLimit(passwordResetRateLimiter)).Post("/email-change/cancel", dependencies.UserHandler.CancelEmailChange)
+			authRouter.With(middleware.RateLimit(registerRateLimiter)).Post("/invitations/accept", dependencies.InvitationHandler.Accept)
 
 			authRouter.Group(func(protectedAuthRouter chi.Router) {
 				protectedAuthRouter.Use(dependencies.AuthMiddleware.RequireAuth)
@@ -76,6 +102,9 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 				protectedAuthRouter.Get("/me", dependencies.AuthHandler.GetCurrentUser)
 				protectedAuthRouter.Get("/sessions", dependencies.AuthHandler.GetSessions)
 				protectedAuthRouter.Delete("/sessions/{id}", dependencies.AuthHandler.RevokeSession)
+				protectedAuthRouter.Get("/organizations", dependencies.OrganizationHandler.ListMine)
+				protectedAuthRouter.Get("/groups", dependencies.GroupHandler.ListMine)
+				protectedAuthRouter.With(middleware.RateLimit(tokenRefreshRateLimiter)).Post("/switch-organization", dependencies.AuthHandler.SwitchOrganization)
 			})
 		})
 
@@ -84,21 +113,42 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 
 			userRouter.With(middleware.RequirePermission("users:create")).Post("/", dependencies.UserHandler.Create)
 			userRouter.With(middleware.RequirePermission("users:list")).Get("/", dependencies.UserHandler.List)
+			userRouter.With(middleware.RequirePermission("users:import")).Post("/import", dependencies.UserHandler.Import)
+			userRouter.With(middleware.RequirePermission("users:export")).Get("/export", dependencies.UserHandler.Export)
+			userRouter.With(middleware.RequirePermission("users:bulk")).Post("/bulk", dependencies.UserHandler.BulkOperate)
+			userRouter.With(middleware.RequirePermission("users:bulk")).Get("/bulk/{operationId}", dependencies.UserHandler.GetBulkOperation)
+
+			userRouter.With(avatarBodyLimit).Put("/me/avatar", dependencies.UserHandler.UploadAvatar)
+			userRouter.Delete("/me/avatar", dependencies.UserHandler.DeleteAvatar)
+			userRouter.Get("/me/personal-data", dependencies.UserHandler.ExportPersonalData)
+			userRouter.With(middleware.RateLimit(passwordResetRateLimiter)).Post("/me/email-change", dependencies.UserHandler.RequestEmailChange)
+			userRouter.Get("/me/preferences", dependencies.UserHandler.GetPreferences)
+			userRouter.Put("/me/preferences", dependencies.UserHandler.UpdatePreferences)
 
 			userRouter.Route("/{id}", func(userIDRouter chi.Router) {
 				userIDRouter.With(middleware.RequirePermission("users:read")).Get("/", dependencies.UserHandler.Get)
 				userIDRouter.With(middleware.RequirePermission("users:update")).Put("/", dependencies.UserHandler.Update)
+				userIDRouter.With(middleware.RequirePermission("users:update")).Put("/email", dependencies.UserHandler.ChangeEmail)
 				userIDRouter.With(middleware.RequirePermission("users:delete")).Delete("/", dependencies.UserHandler.Delete)
+				userIDRouter.With(middleware.RequirePermission("users:delete")).Post("/restore", dependencies.UserHandler.Restore)
+				userIDRouter.With(middleware.RequirePermission("users:update"), avatarBodyLimit).Put("/avatar", dependencies.UserHandler.UploadAvatar)
+				userIDRouter.With(middleware.RequirePermission("users:update")).Delete("/avatar", dependencies.UserHandler.DeleteAvatar)
+				userIDRouter.With(middleware.RequirePermission("users:privacy")).Get("/personal-data", dependencies.UserHandler.ExportPersonalData)
+				userIDRouter.With(middleware.RequirePermission("users:privacy")).Post("/erasure", dependencies.UserHandler.Erase)
 
 				userIDRouter.With(middleware.ResourceOwner("id")).Post("/password", dependencies.UserHandler.ChangePassword)
 				userIDRouter.With(middleware.RequirePermission("users:manage")).Post("/activate", dependencies.UserHandler.Activate)
 				userIDRouter.With(middleware.RequirePermission("users:manage")).Post("/deactivate", dependencies.UserHandler.Deactivate)
 				userIDRouter.With(middleware.RequirePermission("users:manage")).Post("/ban", dependencies.UserHandler.Ban)
+				userIDRouter.With(middleware.RequirePermission("users:manage")).Post("/suspend", dependencies.UserHandler.Suspend)
+				userIDRouter.With(middleware.RequirePermission("users:manage")).Post("/unban", dependencies.UserHandler.Unban)
 
 				userIDRouter.With(middleware.RequireAnyPermission("users:read", "roles:assign")).Get("/roles", dependencies.UserHandler.GetRoles)
 				userIDRouter.With(middleware.RequirePermission("roles:assign")).Put("/roles", dependencies.UserHandler.SetRoles)
 				userIDRouter.With(middleware.RequirePermission("roles:assign")).Post("/roles/{roleId}", dependencies.UserHandler.AssignRole)
 				userIDRouter.With(middleware.RequirePermission("roles:assign")).Delete("/roles/{roleId}", dependencies.UserHandler.RevokeRole)
+				userIDRouter.With(middleware.RequirePermission("roles:assign")).Post("/denied-permissions/{permissionId}", dependencies.UserHandler.DenyPermission)
+				userIDRouter.With(middleware.RequirePermission("roles:assign")).Delete("/denied-permissions/{permissionId}", dependencies.UserHandler.RemoveDeny)
 				userIDRouter.With(middleware.RequireAnyPermission("users:read", "permissions:read")).Get("/permissions", dependencies.UserHandler.GetPermissions)
 			})
 		})
@@ -108,6 +158,8 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 
 			permissionRouter.With(middleware.RequirePermission("permissions:list")).Get("/", dependencies.PermissionHandler.List)
 			permissionRouter.With(middleware.RequirePermission("permissions:create")).Post("/", dependencies.PermissionHandler.Create)
+			permissionRouter.With(middleware.RequirePermission("permissions:list")).Get("/routes", dependencies.PermissionHandler.ListRoutes)
+			permissionRouter.With(middleware.RequirePermission("permissions:list")).Get("/drift", dependencies.PermissionHandler.Drift)
 
 			permissionRouter.Route("/{id}", func(permissionIDRouter chi.Router) {
 				permissionIDRouter.With(middleware.RequirePermission("permissions:read")).Get("/", dependencies.PermissionHandler.Get)
@@ -120,6 +172,7 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 			roleRouter.Use(dependencies.AuthMiddleware.RequireAuth)
 
 			roleRouter.With(middleware.RequirePermission("roles:list")).Get("/", dependencies.RoleHandler.List)
+			roleRouter.With(middleware.RequirePermission("roles:assign")).Get("/assignable", dependencies.RoleHandler.ListAssignable)
 			roleRouter.With(middleware.RequirePermission("roles:create")).Post("/", dependencies.RoleHandler.Create)
 
 			roleRouter.Route("/{id}", func(roleIDRouter chi.Router) {
@@ -130,10 +183,153 @@ func NewRouter(dependencies RouterDependencies) http.Handler {
 				roleIDRouter.With(middleware.RequirePermission("roles:update")).Put("/permissions", dependencies.RoleHandler.SetPermissions)
 				roleIDRouter.With(middleware.RequirePermission("roles:update")).Post("/permissions/{permissionId}", dependencies.RoleHandler.AddPermission)
 				roleIDRouter.With(middleware.RequirePermission("roles:update")).Delete("/permissions/{permissionId}", dependencies.RoleHandler.RemovePermission)
+				roleIDRouter.With(middleware.RequirePermission("roles:update")).Post("/denied-permissions/{permissionId}", dependencies.RoleHandler.DenyPermission)
+				roleIDRouter.With(middleware.RequirePermission("roles:update")).Delete("/denied-permissions/{permissionId}", dependencies.RoleHandler.RemoveDeny)
 				roleIDRouter.With(middleware.RequirePermission("roles:read")).Get("/users", dependencies.RoleHandler.GetUsersWithRole)
 			})
 		})
+
+		apiRouter.Route("/organizations", func(organizationRouter chi.Router) {
+			organizationRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			organizationRouter.With(middleware.RequirePermission("organizations:list")).Get("/", dependencies.OrganizationHandler.List)
+			organizationRouter.With(middleware.RequirePermission("organizations:create")).Post("/", dependencies.OrganizationHandler.Create)
+
+			organizationRouter.Route("/{id}", func(organizationIDRouter chi.Router) {
+				organizationIDRouter.With(middleware.RequirePermission("organizations:read")).Get("/", dependencies.OrganizationHandler.Get)
+				organizationIDRouter.With(middleware.RequirePermission("organizations:update")).Put("/", dependencies.OrganizationHandler.Update)
+				organizationIDRouter.With(middleware.RequirePermission("organizations:delete")).Delete("/", dependencies.OrganizationHandler.Delete)
+
+				organizationIDRouter.With(middleware.RequirePermission("organizations:read")).Get("/members", dependencies.OrganizationHandler.ListMembers)
+				organizationIDRouter.With(middleware.RequirePermission("organizations:manage")).Post("/members", dependencies.OrganizationHandler.AddMember)
+				organizationIDRouter.With(middleware.RequirePermission("organizations:manage")).Delete("/members/{userId}", dependencies.OrganizationHandler.RemoveMember)
+			})
+		})
+
+		apiRouter.Route("/groups", func(groupRouter chi.Router) {
+			groupRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			groupRouter.With(middleware.RequirePermission("groups:list")).Get("/", dependencies.GroupHandler.List)
+			groupRouter.With(middleware.RequirePermission("groups:create")).Post("/", dependencies.GroupHandler.Create)
+
+			groupRouter.Route("/{id}", func(groupIDRouter chi.Router) {
+				groupIDRouter.With(middleware.RequirePermission("groups:read")).Get("/", dependencies.GroupHandler.Get)
+				groupIDRouter.With(middleware.RequirePermission("groups:update")).Put("/", dependencies.GroupHandler.Update)
+				groupIDRouter.With(middleware.RequirePermission("groups:delete")).Delete("/", dependencies.GroupHandler.Delete)
+
+				groupIDRouter.With(middleware.RequirePermission("groups:read")).Get("/members", dependencies.GroupHandler.ListMembers)
+				groupIDRouter.With(middleware.RequirePermission("groups:manage")).Post("/members", dependencies.GroupHandler.AddMember)
+				groupIDRouter.With(middleware.RequirePermission("groups:manage")).Delete("/members/{userId}", dependencies.GroupHandler.RemoveMember)
+				groupIDRouter.With(middleware.RequirePermission("groups:manage")).Post("/roles/{roleId}", dependencies.GroupHandler.AssignRole)
+				groupIDRouter.With(middleware.RequirePermission("groups:manage")).Delete("/roles/{roleId}", dependencies.GroupHandler.RevokeRole)
+			})
+		})
+
+		apiRouter.Route("/authz", func(authzRouter chi.Router) {
+			authzRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			authzRouter.Post("/check", dependencies.AuthzHandler.Check)
+			authzRouter.Post("/check/batch", dependencies.AuthzHandler.CheckBatch)
+			authzRouter.Post("/explain", dependencies.AuthzHandler.Explain)
+			authzRouter.With(middleware.RequirePermission("authz:simulate")).Post("/simulate", dependencies.AuthzHandler.Simulate)
+		})
+
+		apiRouter.Route("/access-requests", func(accessRequestRouter chi.Router) {
+			accessRequestRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			approverPermission := dependencies.Config.AccessRequest.ApproverPermission
+
+			accessRequestRouter.Post("/", dependencies.AccessRequestHandler.Create)
+			accessRequestRouter.Get("/mine", dependencies.AccessRequestHandler.ListMine)
+			accessRequestRouter.With(middleware.RequirePermission(approverPermission)).Get("/pending", dependencies.AccessRequestHandler.ListPending)
+
+			accessRequestRouter.Route("/{id}", func(accessRequestIDRouter chi.Router) {
+				accessRequestIDRouter.Get("/", dependencies.AccessRequestHandler.Get)
+				accessRequestIDRouter.With(middleware.RequirePermission(approverPermission)).Post("/approve", dependencies.AccessRequestHandler.Approve)
+				accessRequestIDRouter.With(middleware.RequirePermission(approverPermission)).Post("/deny", dependencies.AccessRequestHandler.Deny)
+				accessRequestIDRouter.Post("/cancel", dependencies.AccessRequestHandler.Cancel)
+			})
+		})
+
+		apiRouter.Route("/invitations", func(invitationRouter chi.Router) {
+			invitationRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			invitationRouter.With(middleware.RequirePermission("users:invite")).Post("/", dependencies.InvitationHandler.Create)
+			invitationRouter.With(middleware.RequirePermission("users:invite")).Get("/", dependencies.InvitationHandler.List)
+			invitationRouter.With(middleware.RequirePermission("users:invite")).Post("/{id}/resend", dependencies.InvitationHandler.Resend)
+			invitationRouter.With(middleware.RequirePermission("users:invite")).Post("/{id}/revoke", dependencies.InvitationHandler.Revoke)
+		})
+
+		apiRouter.Route("/access-reviews", func(accessReviewRouter chi.Router) {
+			accessReviewRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			accessReviewRouter.With(middleware.RequirePermission("access_reviews:manage")).Get("/", dependencies.AccessReviewHandler.List)
+			accessReviewRouter.With(middleware.RequirePermission("access_reviews:manage")).Post("/", dependencies.AccessReviewHandler.Launch)
+			accessReviewRouter.With(middleware.RequirePermission("access_reviews:review")).Get("/assignments", dependencies.AccessReviewHandler.ListMyAssignments)
+
+			accessReviewRouter.Route("/{id}", func(accessReviewIDRouter chi.Router) {
+				accessReviewIDRouter.With(middleware.RequirePermission("access_reviews:manage")).Get("/", dependencies.AccessReviewHandler.Get)
+				accessReviewIDRouter.With(middleware.RequirePermission("access_reviews:manage")).Post("/reviewers", dependencies.AccessReviewHandler.AssignReviewer)
+				accessReviewIDRouter.With(middleware.RequirePermission("access_reviews:review")).Post("/items/{itemId}/decision", dependencies.AccessReviewHandler.Decide)
+				accessReviewIDRouter.With(middleware.RequirePermission("access_reviews:manage")).Post("/close", dependencies.AccessReviewHandler.Close)
+				accessReviewIDRouter.With(middleware.RequirePermission("access_reviews:manage")).Get("/evidence", dependencies.AccessReviewHandler.ExportEvidence)
+			})
+		})
+
+		apiRouter.Route("/sod-rules", func(sodRouter chi.Router) {
+			sodRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			sodRouter.With(middleware.RequirePermission("sod_rules:read")).Get("/", dependencies.SoDHandler.List)
+			sodRouter.With(middleware.RequirePermission("sod_rules:manage")).Post("/", dependencies.SoDHandler.Create)
+			sodRouter.With(middleware.RequirePermission("sod_rules:read")).Get("/violations", dependencies.SoDHandler.ListViolations)
+
+			sodRouter.Route("/{id}", func(sodIDRouter chi.Router) {
+				sodIDRouter.With(middleware.RequirePermission("sod_rules:read")).Get("/", dependencies.SoDHandler.Get)
+				sodIDRouter.With(middleware.RequirePermission("sod_rules:manage")).Put("/", dependencies.SoDHandler.Update)
+				sodIDRouter.With(middleware.RequirePermission("sod_rules:manage")).Delete("/", dependencies.SoDHandler.Delete)
+				sodIDRouter.With(middleware.RequirePermission("sod_rules:read")).Get("/violations", dependencies.SoDHandler.ListRuleViolations)
+			})
+		})
+
+		apiRouter.Route("/user-attributes", func(attributeRouter chi.Router) {
+			attributeRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			attributeRouter.With(middleware.RequirePermission("users:read")).Get("/", dependencies.UserAttributeHandler.List)
+			attributeRouter.With(middleware.RequirePermission("user_attributes:manage")).Post("/", dependencies.UserAttributeHandler.Create)
+			attributeRouter.With(middleware.RequirePermission("user_attributes:manage")).Put("/{key}", dependencies.UserAttributeHandler.Update)
+			attributeRouter.With(middleware.RequirePermission("user_attributes:manage")).Delete("/{key}", dependencies.UserAttributeHandler.Delete)
+		})
+
+		apiRouter.Route("/audit-logs", func(auditLogRouter chi.Router) {
+			auditLogRouter.Use(dependencies.AuthMiddleware.RequireAuth)
+
+			auditLogRouter.With(middleware.RequirePermission("audit_logs:read")).Get("/", dependencies.AuditLogHandler.List)
+		})
 	})
 
+	if dependencies.RouteRegistry != nil {
+		dependencies.RouteRegistry.Declare(authz.SuperPermissionCode, "superuser bypass")
+		dependencies.RouteRegistry.Declare(handler.CheckOtherSubjectsPermission, "POST /api/v1/authz/check")
+		dependencies.RouteRegistry.Declare(handler.CheckOtherSubjectsPermission, "POST /api/v1/authz/check/batch")
+		dependencies.RouteRegistry.Declare(handler.CheckOtherSubjectsPermission, "POST /api/v1/authz/explain")
+		if err := dependencies.RouteRegistry.Load(router); err != nil {
+			dependencies.Logger.Error("failed to load route registry", logger.Err(err))
+		}
+	}
+
 	return router
 }
+
+// multipartOverhead leaves room for the multipart boundaries and part headers
+// around an avatar so a file of exactly the configured size is accepted.
+const multipartOverhead = 64 << 10
+
+// avatarUploadPaths carry their own, larger body limit and are skipped by the
+// global one.
+var avatarUploadPaths = []string{"/api/v1/users/*/avatar"}
+
+func defaultBodyLimitConfig() middleware.BodyLimitConfig {
+	config := middleware.DefaultBodyLimitConfig()
+	config.ExcludedPaths = avatarUploadPaths
+	return config
+}
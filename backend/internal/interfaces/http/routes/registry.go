@@ -0,0 +1,125 @@
+package routes
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+
+	permissionquery "github.com/tranvuongduy2003/go-copilot/internal/application/permission/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+)
+
+type Route struct {
+	Method       string
+	Pattern      string
+	Requirements []middleware.PermissionRequirement
+}
+
+func (route Route) String() string {
+	return route.Method + " " + route.Pattern
+}
+
+func (route Route) Permissions() []string {
+	var permissions []string
+	for _, requirement := range route.Requirements {
+		permissions = append(permissions, requirement.Permissions...)
+	}
+	return permissions
+}
+
+type Registry struct {
+	mutex    sync.RWMutex
+	routes   []Route
+	declared map[string][]string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		declared: make(map[string][]string),
+	}
+}
+
+func (registry *Registry) Load(router chi.Routes) error {
+	var routes []Route
+
+	walkErr := chi.Walk(router, func(method string, pattern string, _ http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		route := Route{
+			Method:  method,
+			Pattern: normalizePattern(pattern),
+		}
+		for _, routeMiddleware := range middlewares {
+			if requirement, ok := middleware.PermissionRequirementOf(routeMiddleware); ok {
+				route.Requirements = append(route.Requirements, requirement)
+			}
+		}
+		routes = append(routes, route)
+		return nil
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Pattern != routes[j].Pattern {
+			return routes[i].Pattern < routes[j].Pattern
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.routes = routes
+	return nil
+}
+
+func (registry *Registry) Declare(permission string, source string) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.declared[permission] = append(registry.declared[permission], source)
+}
+
+func (registry *Registry) Routes() []Route {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	routes := make([]Route, len(registry.routes))
+	copy(routes, registry.routes)
+	return routes
+}
+
+func (registry *Registry) References() []permissionquery.PermissionReference {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	sources := make(map[string][]string)
+	for _, route := range registry.routes {
+		for _, permission := range route.Permissions() {
+			sources[permission] = append(sources[permission], route.String())
+		}
+	}
+	for permission, declaredSources := range registry.declared {
+		sources[permission] = append(sources[permission], declaredSources...)
+	}
+
+	references := make([]permissionquery.PermissionReference, 0, len(sources))
+	for code, codeSources := range sources {
+		references = append(references, permissionquery.PermissionReference{
+			Code:    code,
+			Sources: codeSources,
+		})
+	}
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].Code < references[j].Code
+	})
+	return references
+}
+
+func normalizePattern(pattern string) string {
+	if len(pattern) > 1 {
+		return strings.TrimSuffix(pattern, "/")
+	}
+	return pattern
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	permissionquery "github.com/tranvuongduy2003/go-copilot/internal/application/permission/query"
+	"github.com/tranvuongduy2003/go-copilot/internal/interfaces/http/middleware"
+)
+
+func TestRegistry_Load(t *testing.T) {
+	noop := func(writer http.ResponseWriter, request *http.Request) {}
+
+	router := chi.NewRouter()
+	router.Use(middleware.RequestID)
+	router.Get("/health", noop)
+	router.Route("/api/v1/users", func(userRouter chi.Router) {
+		userRouter.Use(middleware.RequireAuth)
+		userRouter.With(middleware.RequirePermission("users:list")).Get("/", noop)
+		userRouter.With(middleware.RequirePermission("users:create")).Post("/", noop)
+		userRouter.Route("/{id}", func(userIDRouter chi.Router) {
+			userIDRouter.With(middleware.RequirePermission("users:read")).Get("/", noop)
+			userIDRouter.With(middleware.RequireAnyPermission("users:read", "roles:assign")).Get("/roles", noop)
+		})
+	})
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Load(router))
+
+	routes := registry.Routes()
+	require.Len(t, routes, 5)
+
+	byRoute := make(map[string]Route, len(routes))
+	for _, route := range routes {
+		byRoute[route.String()] = route
+	}
+
+	assert.Empty(t, byRoute["GET /health"].Requirements)
+	assert.Equal(t, []string{"users:list"}, byRoute["GET /api/v1/users"].Permissions())
+	assert.Equal(t, []string{"users:create"}, byRoute["POST /api/v1/users"].Permissions())
+	assert.Equal(t, []string{"users:read"}, byRoute["GET /api/v1/users/{id}"].Permissions())
+
+	rolesRoute := byRoute["GET /api/v1/users/{id}/roles"]
+	require.Len(t, rolesRoute.Requirements, 1)
+	assert.Equal(t, middleware.PermissionMatchAny, rolesRoute.Requirements[0].Match)
+	assert.Equal(t, []string{"users:read", "roles:assign"}, rolesRoute.Permissions())
+}
+
+func TestRegistry_References(t *testing.T) {
+	noop := func(writer http.ResponseWriter, request *http.Request) {}
+
+	router := chi.NewRouter()
+	router.With(middleware.RequirePermission("users:read")).Get("/users", noop)
+	router.With(middleware.RequireAnyPermission("users:read", "permissions:read")).Get("/users/{id}/permissions", noop)
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Load(router))
+	registry.Declare("system:admin", "superuser bypass")
+
+	assert.Equal(t, []permissionquery.PermissionReference{
+		{Code: "permissions:read", Sources: []string{"GET /users/{id}/permissions"}},
+		{Code: "system:admin", Sources: []string{"superuser bypass"}},
+		{Code: "users:read", Sources: []string{"GET /users", "GET /users/{id}/permissions"}},
+	}, registry.References())
+}
@@ -0,0 +1,17 @@
+DELETE FROM permissions WHERE resource = 'organizations';
+
+ALTER TABLE refresh_tokens DROP CONSTRAINT IF EXISTS fk_refresh_tokens_organization;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS organization_id;
+
+DELETE FROM roles WHERE organization_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_roles_organization_id;
+DROP INDEX IF EXISTS idx_roles_organization_name_unique;
+DROP INDEX IF EXISTS idx_roles_global_name_unique;
+ALTER TABLE roles ADD CONSTRAINT roles_name_unique UNIQUE (name);
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS fk_roles_organization;
+ALTER TABLE roles DROP COLUMN IF EXISTS organization_id;
+
+DROP INDEX IF EXISTS idx_organization_members_user_id;
+DROP TABLE IF EXISTS organization_members;
+DROP TRIGGER IF EXISTS trigger_organizations_updated_at ON organizations;
+DROP TABLE IF EXISTS organizations;
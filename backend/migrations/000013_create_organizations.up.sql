@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT organizations_slug_unique UNIQUE (slug)
+);
+
+CREATE TRIGGER trigger_organizations_updated_at
+    BEFORE UPDATE ON organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id),
+    CONSTRAINT fk_organization_members_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_organization_members_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
+
+ALTER TABLE roles ADD COLUMN organization_id UUID NULL;
+ALTER TABLE roles ADD CONSTRAINT fk_roles_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE roles DROP CONSTRAINT roles_name_unique;
+CREATE UNIQUE INDEX idx_roles_global_name_unique ON roles (name) WHERE organization_id IS NULL;
+CREATE UNIQUE INDEX idx_roles_organization_name_unique ON roles (organization_id, name) WHERE organization_id IS NOT NULL;
+CREATE INDEX idx_roles_organization_id ON roles(organization_id);
+
+ALTER TABLE refresh_tokens ADD COLUMN organization_id UUID NULL;
+ALTER TABLE refresh_tokens ADD CONSTRAINT fk_refresh_tokens_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000016', 'organizations', 'create', 'Create new organizations', TRUE),
+    ('a0000000-0000-0000-0000-000000000017', 'organizations', 'read', 'View organization details', TRUE),
+    ('a0000000-0000-0000-0000-000000000018', 'organizations', 'update', 'Update organization information', TRUE),
+    ('a0000000-0000-0000-0000-000000000019', 'organizations', 'delete', 'Delete organizations', TRUE),
+    ('a0000000-0000-0000-0000-000000000020', 'organizations', 'list', 'List all organizations', TRUE),
+    ('a0000000-0000-0000-0000-000000000021', 'organizations', 'manage', 'Manage organization members', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'organizations'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,11 @@
+DELETE FROM permissions WHERE resource = 'groups';
+
+DROP INDEX IF EXISTS idx_group_members_user_id;
+DROP TABLE IF EXISTS group_members;
+DROP INDEX IF EXISTS idx_group_roles_role_id;
+DROP TABLE IF EXISTS group_roles;
+DROP TRIGGER IF EXISTS trigger_groups_updated_at ON groups;
+DROP INDEX IF EXISTS idx_groups_organization_id;
+DROP INDEX IF EXISTS idx_groups_organization_name_unique;
+DROP INDEX IF EXISTS idx_groups_global_name_unique;
+DROP TABLE IF EXISTS groups;
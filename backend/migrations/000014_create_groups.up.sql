@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS groups (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    organization_id UUID NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_groups_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_groups_global_name_unique ON groups (LOWER(name)) WHERE organization_id IS NULL;
+CREATE UNIQUE INDEX idx_groups_organization_name_unique ON groups (organization_id, LOWER(name)) WHERE organization_id IS NOT NULL;
+CREATE INDEX idx_groups_organization_id ON groups(organization_id);
+
+CREATE TRIGGER trigger_groups_updated_at
+    BEFORE UPDATE ON groups
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS group_roles (
+    group_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, role_id),
+    CONSTRAINT fk_group_roles_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_roles_role FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_roles_role_id ON group_roles(role_id);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id),
+    CONSTRAINT fk_group_members_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_members_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_group_members_user_id ON group_members(user_id);
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000022', 'groups', 'create', 'Create new groups', TRUE),
+    ('a0000000-0000-0000-0000-000000000023', 'groups', 'read', 'View group details', TRUE),
+    ('a0000000-0000-0000-0000-000000000024', 'groups', 'update', 'Update group information', TRUE),
+    ('a0000000-0000-0000-0000-000000000025', 'groups', 'delete', 'Delete groups', TRUE),
+    ('a0000000-0000-0000-0000-000000000026', 'groups', 'list', 'List all groups', TRUE),
+    ('a0000000-0000-0000-0000-000000000027', 'groups', 'manage', 'Manage group members and roles', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'groups'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,12 @@
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000028', 'authz', 'check', 'Check and explain permissions of other users', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT role_id, id FROM permissions
+CROSS JOIN (VALUES
+    ('b0000000-0000-0000-0000-000000000001'::UUID),
+    ('b0000000-0000-0000-0000-000000000002'::UUID)
+) AS roles(role_id)
+WHERE resource = 'authz'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,8 @@
+DELETE FROM permissions WHERE resource = 'access_requests';
+
+DROP TRIGGER IF EXISTS trigger_access_requests_updated_at ON access_requests;
+DROP INDEX IF EXISTS idx_access_requests_pending_unique;
+DROP INDEX IF EXISTS idx_access_requests_expires_at;
+DROP INDEX IF EXISTS idx_access_requests_status;
+DROP INDEX IF EXISTS idx_access_requests_requester_id;
+DROP TABLE IF EXISTS access_requests;
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS access_requests (
+    id UUID PRIMARY KEY,
+    requester_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    organization_id UUID NULL,
+    justification TEXT NOT NULL,
+    duration_seconds BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewer_id UUID NULL,
+    review_comment TEXT,
+    reviewed_at TIMESTAMPTZ NULL,
+    expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_access_requests_requester FOREIGN KEY (requester_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_access_requests_role FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_access_requests_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    CONSTRAINT fk_access_requests_reviewer FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_access_requests_status CHECK (status IN ('pending', 'approved', 'denied', 'cancelled', 'expired')),
+    CONSTRAINT chk_access_requests_duration CHECK (duration_seconds > 0)
+);
+
+CREATE INDEX idx_access_requests_requester_id ON access_requests(requester_id, created_at DESC);
+CREATE INDEX idx_access_requests_status ON access_requests(status, created_at);
+CREATE INDEX idx_access_requests_expires_at ON access_requests(expires_at) WHERE status = 'approved';
+CREATE UNIQUE INDEX idx_access_requests_pending_unique ON access_requests(requester_id, role_id) WHERE status = 'pending';
+
+CREATE TRIGGER trigger_access_requests_updated_at
+    BEFORE UPDATE ON access_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000029', 'access_requests', 'approve', 'Review just-in-time access requests', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'access_requests'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,7 @@
+DELETE FROM permissions WHERE resource = 'sod_rules';
+
+DROP TRIGGER IF EXISTS trigger_sod_rules_updated_at ON sod_rules;
+DROP INDEX IF EXISTS idx_sod_rule_roles_role_id;
+DROP TABLE IF EXISTS sod_rule_roles;
+DROP INDEX IF EXISTS idx_sod_rules_name;
+DROP TABLE IF EXISTS sod_rules;
@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS sod_rules (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    rule_type VARCHAR(30) NOT NULL,
+    max_holders INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_sod_rules_type CHECK (rule_type IN ('mutual_exclusion', 'max_cardinality')),
+    CONSTRAINT chk_sod_rules_max_holders CHECK (max_holders >= 0)
+);
+
+CREATE UNIQUE INDEX idx_sod_rules_name ON sod_rules(LOWER(name));
+
+CREATE TABLE IF NOT EXISTS sod_rule_roles (
+    rule_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    PRIMARY KEY (rule_id, role_id),
+    CONSTRAINT fk_sod_rule_roles_rule FOREIGN KEY (rule_id) REFERENCES sod_rules(id) ON DELETE CASCADE,
+    CONSTRAINT fk_sod_rule_roles_role FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sod_rule_roles_role_id ON sod_rule_roles(role_id);
+
+CREATE TRIGGER trigger_sod_rules_updated_at
+    BEFORE UPDATE ON sod_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000030', 'sod_rules', 'read', 'View separation-of-duties rules and violations', TRUE),
+    ('a0000000-0000-0000-0000-000000000031', 'sod_rules', 'manage', 'Create, update and delete separation-of-duties rules', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'sod_rules'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000002', id FROM permissions WHERE resource = 'sod_rules' AND action = 'read'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS user_denied_permissions;
+
+DELETE FROM role_permissions WHERE effect = 'deny';
+
+ALTER TABLE role_permissions
+    DROP CONSTRAINT IF EXISTS chk_role_permissions_effect,
+    DROP COLUMN IF EXISTS effect;
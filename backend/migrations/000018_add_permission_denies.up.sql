@@ -0,0 +1,14 @@
+ALTER TABLE role_permissions
+    ADD COLUMN effect VARCHAR(10) NOT NULL DEFAULT 'allow',
+    ADD CONSTRAINT chk_role_permissions_effect CHECK (effect IN ('allow', 'deny'));
+
+CREATE TABLE IF NOT EXISTS user_denied_permissions (
+    user_id UUID NOT NULL,
+    permission_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, permission_id),
+    CONSTRAINT fk_user_denied_permissions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_denied_permissions_permission FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_denied_permissions_permission_id ON user_denied_permissions(permission_id);
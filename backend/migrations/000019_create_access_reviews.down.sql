@@ -0,0 +1,8 @@
+DELETE FROM permissions WHERE resource = 'access_reviews';
+
+DROP TRIGGER IF EXISTS trigger_access_review_campaigns_updated_at ON access_review_campaigns;
+DROP INDEX IF EXISTS idx_access_review_items_reviewer_id;
+DROP INDEX IF EXISTS idx_access_review_items_campaign_id;
+DROP INDEX IF EXISTS idx_access_review_campaigns_status;
+DROP TABLE IF EXISTS access_review_items;
+DROP TABLE IF EXISTS access_review_campaigns;
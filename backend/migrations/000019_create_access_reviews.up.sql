@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS access_review_campaigns (
+    id UUID PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    description TEXT,
+    scope_role_ids UUID[] NOT NULL DEFAULT '{}',
+    scope_user_ids UUID[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    due_at TIMESTAMPTZ NOT NULL,
+    created_by UUID NOT NULL,
+    closed_by UUID NULL,
+    closed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_access_review_campaigns_status CHECK (status IN ('active', 'closed'))
+);
+
+-- Review items intentionally keep user and role ids without foreign keys so the
+-- evidence trail survives users or roles being removed after the campaign.
+CREATE TABLE IF NOT EXISTS access_review_items (
+    id UUID PRIMARY KEY,
+    campaign_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    reviewer_id UUID NULL,
+    decision VARCHAR(20) NOT NULL DEFAULT 'pending',
+    comment TEXT,
+    decided_by UUID NULL,
+    decided_at TIMESTAMPTZ NULL,
+    escalated_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    CONSTRAINT fk_access_review_items_campaign FOREIGN KEY (campaign_id) REFERENCES access_review_campaigns(id) ON DELETE CASCADE,
+    CONSTRAINT chk_access_review_items_decision CHECK (decision IN ('pending', 'keep', 'revoke')),
+    CONSTRAINT uq_access_review_items_assignment UNIQUE (campaign_id, user_id, role_id)
+);
+
+CREATE INDEX idx_access_review_campaigns_status ON access_review_campaigns(status, due_at);
+CREATE INDEX idx_access_review_items_campaign_id ON access_review_items(campaign_id);
+CREATE INDEX idx_access_review_items_reviewer_id ON access_review_items(reviewer_id) WHERE decision = 'pending';
+
+CREATE TRIGGER trigger_access_review_campaigns_updated_at
+    BEFORE UPDATE ON access_review_campaigns
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000032', 'access_reviews', 'manage', 'Launch, close and export access review campaigns', TRUE),
+    ('a0000000-0000-0000-0000-000000000033', 'access_reviews', 'review', 'Decide on assigned access review items', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'access_reviews'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000002', id FROM permissions WHERE resource = 'access_reviews' AND action = 'review'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
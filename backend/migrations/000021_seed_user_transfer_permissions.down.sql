@@ -0,0 +1 @@
+DELETE FROM permissions WHERE resource = 'users' AND action IN ('import', 'export');
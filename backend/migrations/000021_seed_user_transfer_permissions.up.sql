@@ -0,0 +1,13 @@
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000035', 'users', 'import', 'Bulk import users from CSV or JSON files', TRUE),
+    ('a0000000-0000-0000-0000-000000000036', 'users', 'export', 'Export users with their role names', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT role_id, id FROM permissions
+CROSS JOIN (VALUES
+    ('b0000000-0000-0000-0000-000000000001'::UUID),
+    ('b0000000-0000-0000-0000-000000000002'::UUID)
+) AS roles(role_id)
+WHERE resource = 'users' AND action IN ('import', 'export')
+ON CONFLICT (role_id, permission_id) DO NOTHING;
@@ -0,0 +1,6 @@
+DELETE FROM permissions WHERE resource = 'users' AND action = 'bulk';
+
+DROP TRIGGER IF EXISTS trigger_bulk_operations_updated_at ON bulk_operations;
+DROP INDEX IF EXISTS idx_bulk_operations_created_by;
+DROP TABLE IF EXISTS bulk_operation_items;
+DROP TABLE IF EXISTS bulk_operations;
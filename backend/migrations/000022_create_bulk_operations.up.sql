@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS bulk_operations (
+    id UUID PRIMARY KEY,
+    action VARCHAR(20) NOT NULL,
+    reason TEXT,
+    role_ids UUID[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_by UUID NOT NULL,
+    failure_reason TEXT,
+    started_at TIMESTAMPTZ NULL,
+    completed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_bulk_operations_action CHECK (action IN ('activate', 'deactivate', 'ban', 'delete', 'set_roles')),
+    CONSTRAINT chk_bulk_operations_status CHECK (status IN ('pending', 'running', 'completed', 'failed'))
+);
+
+-- Items keep user ids without foreign keys so results remain readable after
+-- the targeted users are purged.
+CREATE TABLE IF NOT EXISTS bulk_operation_items (
+    operation_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    user_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    failure TEXT,
+    processed_at TIMESTAMPTZ NULL,
+    PRIMARY KEY (operation_id, user_id),
+    CONSTRAINT fk_bulk_operation_items_operation FOREIGN KEY (operation_id) REFERENCES bulk_operations(id) ON DELETE CASCADE,
+    CONSTRAINT chk_bulk_operation_items_status CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+CREATE INDEX idx_bulk_operations_created_by ON bulk_operations(created_by, created_at DESC);
+
+CREATE TRIGGER trigger_bulk_operations_updated_at
+    BEFORE UPDATE ON bulk_operations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000037', 'users', 'bulk', 'Run administrative operations on many users at once', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT role_id, id FROM permissions
+CROSS JOIN (VALUES
+    ('b0000000-0000-0000-0000-000000000001'::UUID),
+    ('b0000000-0000-0000-0000-000000000002'::UUID)
+) AS roles(role_id)
+WHERE resource = 'users' AND action = 'bulk'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
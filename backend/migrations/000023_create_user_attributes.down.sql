@@ -0,0 +1,11 @@
+DELETE FROM role_permissions WHERE permission_id IN (
+    SELECT id FROM permissions WHERE resource = 'user_attributes'
+);
+
+DELETE FROM permissions WHERE resource = 'user_attributes';
+
+DROP TRIGGER IF EXISTS trigger_user_attribute_definitions_updated_at ON user_attribute_definitions;
+DROP TABLE IF EXISTS user_attribute_definitions;
+
+DROP INDEX IF EXISTS idx_users_attributes;
+ALTER TABLE users DROP COLUMN IF EXISTS attributes;
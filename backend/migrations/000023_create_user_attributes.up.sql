@@ -0,0 +1,37 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX idx_users_attributes ON users USING GIN (attributes jsonb_path_ops);
+
+CREATE TABLE IF NOT EXISTS user_attribute_definitions (
+    id UUID PRIMARY KEY,
+    key VARCHAR(63) NOT NULL,
+    label VARCHAR(100) NOT NULL,
+    description TEXT,
+    attribute_type VARCHAR(20) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    visibility VARCHAR(20) NOT NULL DEFAULT 'admin',
+    rules JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_user_attribute_definitions_type CHECK (attribute_type IN ('string', 'number', 'boolean', 'date', 'enum')),
+    CONSTRAINT chk_user_attribute_definitions_visibility CHECK (visibility IN ('public', 'self', 'admin'))
+);
+
+CREATE UNIQUE INDEX idx_user_attribute_definitions_key ON user_attribute_definitions(key);
+
+CREATE TRIGGER trigger_user_attribute_definitions_updated_at
+    BEFORE UPDATE ON user_attribute_definitions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000038', 'user_attributes', 'manage', 'Create, update and delete custom user profile attributes', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000001', id FROM permissions WHERE resource = 'user_attributes'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT 'b0000000-0000-0000-0000-000000000002', id FROM permissions WHERE resource = 'user_attributes'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
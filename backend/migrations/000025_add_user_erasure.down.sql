@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE resource = 'users' AND action = 'privacy';
+
+ALTER TABLE users DROP COLUMN IF EXISTS erased_at;
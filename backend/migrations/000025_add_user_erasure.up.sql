@@ -0,0 +1,14 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMPTZ;
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000039', 'users', 'privacy', 'Export and erase the personal data held about users', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT role_id, id FROM permissions
+CROSS JOIN (VALUES
+    ('b0000000-0000-0000-0000-000000000001'::UUID),
+    ('b0000000-0000-0000-0000-000000000002'::UUID)
+) AS roles(role_id)
+WHERE resource = 'users' AND action = 'privacy'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
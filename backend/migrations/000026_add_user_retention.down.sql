@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_deleted_at_purge;
+
+-- Fails if a deleted user's email has been registered again; resolve those
+-- duplicates before rolling back.
+DROP INDEX IF EXISTS users_email_active_unique;
+ALTER TABLE users ADD CONSTRAINT users_email_unique UNIQUE (email);
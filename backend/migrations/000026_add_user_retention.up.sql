@@ -0,0 +1,6 @@
+-- Only live users reserve their email, so the address of a soft-deleted user
+-- can be registered again.
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_active_unique ON users (LOWER(email)) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at_purge ON users (deleted_at) WHERE deleted_at IS NOT NULL;
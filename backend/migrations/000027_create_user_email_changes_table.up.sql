@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS user_email_changes (
+    user_id UUID PRIMARY KEY,
+    new_email VARCHAR(255) NOT NULL,
+    confirm_token_hash VARCHAR(255) NOT NULL,
+    cancel_token_hash VARCHAR(255) NOT NULL,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT user_email_changes_confirm_token_hash_unique UNIQUE (confirm_token_hash),
+    CONSTRAINT user_email_changes_cancel_token_hash_unique UNIQUE (cancel_token_hash),
+    CONSTRAINT fk_user_email_changes_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+DELETE FROM permissions WHERE resource = 'users' AND action = 'invite';
+
+DROP TRIGGER IF EXISTS trigger_invitations_updated_at ON invitations;
+DROP INDEX IF EXISTS idx_invitations_pending_email_unique;
+DROP INDEX IF EXISTS idx_invitations_status;
+DROP TABLE IF EXISTS invitations;
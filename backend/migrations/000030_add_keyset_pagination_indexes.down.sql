@@ -0,0 +1,6 @@
+DELETE FROM permissions WHERE resource = 'audit_logs' AND action = 'read';
+
+DROP INDEX IF EXISTS idx_audit_logs_user_timestamp_id;
+DROP INDEX IF EXISTS idx_audit_logs_timestamp_id;
+DROP INDEX IF EXISTS idx_refresh_tokens_user_created_at_id;
+DROP INDEX IF EXISTS idx_users_created_at_id;
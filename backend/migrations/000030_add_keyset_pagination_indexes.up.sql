@@ -0,0 +1,19 @@
+-- Keyset pages seek on the sort key and id, so each listing's default order
+-- needs an index that ends with the id.
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_created_at_id ON refresh_tokens (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_timestamp_id ON audit_logs (timestamp DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_user_timestamp_id ON audit_logs (user_id, timestamp DESC, id DESC);
+
+INSERT INTO permissions (id, resource, action, description, is_system) VALUES
+    ('a0000000-0000-0000-0000-000000000041', 'audit_logs', 'read', 'Browse the audit log', TRUE)
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT role_id, id FROM permissions
+CROSS JOIN (VALUES
+    ('b0000000-0000-0000-0000-000000000001'::UUID),
+    ('b0000000-0000-0000-0000-000000000002'::UUID)
+) AS roles(role_id)
+WHERE resource = 'audit_logs' AND action = 'read'
+ON CONFLICT (role_id, permission_id) DO NOTHING;
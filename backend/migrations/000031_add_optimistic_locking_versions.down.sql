@@ -0,0 +1,13 @@
+CREATE OR REPLACE FUNCTION check_single_default_role()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.is_default = TRUE THEN
+        UPDATE roles SET is_default = FALSE WHERE id != NEW.id AND is_default = TRUE;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE permissions DROP COLUMN IF EXISTS version;
+ALTER TABLE roles DROP COLUMN IF EXISTS version;
+ALTER TABLE users DROP COLUMN IF EXISTS version;
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_users_suspended_until;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_suspension_check;
+
+-- Suspended users must not regain access by a rollback, so they are left
+-- inactive for an administrator to reactivate.
+UPDATE users SET status = 'inactive' WHERE status = 'suspended';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check
+    CHECK (status IN ('pending', 'active', 'inactive', 'banned'));
+
+ALTER TABLE users DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_until;
@@ -0,0 +1,13 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspension_reason TEXT;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check
+    CHECK (status IN ('pending', 'active', 'inactive', 'suspended', 'banned'));
+
+-- A suspension without an end would never be lifted by the reinstatement job.
+ALTER TABLE users ADD CONSTRAINT users_suspension_check
+    CHECK (status <> 'suspended' OR suspended_until IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_users_suspended_until ON users(suspended_until)
+    WHERE status = 'suspended' AND deleted_at IS NULL;
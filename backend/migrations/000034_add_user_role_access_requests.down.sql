@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_user_roles_access_request_id;
+
+ALTER TABLE user_roles DROP CONSTRAINT IF EXISTS fk_user_roles_access_request;
+ALTER TABLE user_roles DROP COLUMN IF EXISTS access_request_id;
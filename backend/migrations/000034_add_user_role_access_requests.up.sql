@@ -0,0 +1,18 @@
+ALTER TABLE user_roles ADD COLUMN IF NOT EXISTS access_request_id UUID NULL;
+ALTER TABLE user_roles ADD CONSTRAINT fk_user_roles_access_request
+    FOREIGN KEY (access_request_id) REFERENCES access_requests(id) ON DELETE SET NULL;
+
+-- Roles granted by requests that are still approved came from those requests;
+-- mark them so that the expiry job keeps revoking them. A role assigned
+-- before the approval was not granted by the request and stays permanent.
+UPDATE user_roles ur
+SET access_request_id = ar.id
+FROM access_requests ar
+WHERE ar.status = 'approved'
+    AND ar.requester_id = ur.user_id
+    AND ar.role_id = ur.role_id
+    AND ar.reviewed_at IS NOT NULL
+    AND ur.assigned_at >= ar.reviewed_at;
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_access_request_id ON user_roles(access_request_id)
+    WHERE access_request_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS trigger_users_updated_at ON users;
+
+CREATE TRIGGER trigger_users_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,12 @@
+-- Recording a sign-in only sets last_login_at. It must not count as a change
+-- to the user, so the trigger leaves updated_at alone for such updates.
+DROP TRIGGER IF EXISTS trigger_users_updated_at ON users;
+
+CREATE TRIGGER trigger_users_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+    WHEN (
+        OLD.last_login_at IS NOT DISTINCT FROM NEW.last_login_at
+        OR (to_jsonb(OLD) - 'last_login_at') IS DISTINCT FROM (to_jsonb(NEW) - 'last_login_at')
+    )
+    EXECUTE FUNCTION update_updated_at_column();
@@ -7,13 +7,24 @@ import (
 )
 
 type Config struct {
-	App      AppConfig      `mapstructure:"app"`
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"db"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Log      LogConfig      `mapstructure:"log"`
-	CORS     CORSConfig     `mapstructure:"cors"`
+	App            AppConfig            `mapstructure:"app"`
+	Server         ServerConfig         `mapstructure:"server"`
+	Database       DatabaseConfig       `mapstructure:"db"`
+	Redis          RedisConfig          `mapstructure:"redis"`
+	JWT            JWTConfig            `mapstructure:"jwt"`
+	Log            LogConfig            `mapstructure:"log"`
+	CORS           CORSConfig           `mapstructure:"cors"`
+	AccessRequest  AccessRequestConfig  `mapstructure:"access_request"`
+	AccessReview   AccessReviewConfig   `mapstructure:"access_review"`
+	RBAC           RBACConfig           `mapstructure:"rbac"`
+	Storage        StorageConfig        `mapstructure:"storage"`
+	Avatar         AvatarConfig         `mapstructure:"avatar"`
+	UserRetention  UserRetentionConfig  `mapstructure:"user_retention"`
+	UserSuspension UserSuspensionConfig `mapstructure:"user_suspension"`
+	Mail           MailConfig           `mapstructure:"mail"`
+	EmailChange    EmailChangeConfig    `mapstructure:"email_change"`
+	Invitation     InvitationConfig     `mapstructure:"invitation"`
+	Registration   RegistrationConfig   `mapstructure:"registration"`
 }
 
 type AppConfig struct {
@@ -72,6 +83,127 @@ type CORSConfig struct {
 	MaxAge         int      `mapstructure:"max_age"`
 }
 
+type AccessRequestConfig struct {
+	ApproverPermission  string        `mapstructure:"approver_permission"`
+	MaxDuration         time.Duration `mapstructure:"max_duration"`
+	ExpiryCheckInterval time.Duration `mapstructure:"expiry_check_interval"`
+}
+
+type AccessReviewConfig struct {
+	EvidenceSigningKey      string        `mapstructure:"evidence_signing_key"`
+	EscalationCheckInterval time.Duration `mapstructure:"escalation_check_interval"`
+}
+
+type RBACConfig struct {
+	PermissionDriftMode string `mapstructure:"permission_drift_mode"`
+}
+
+const (
+	PermissionDriftWarn = "warn"
+	PermissionDriftFail = "fail"
+)
+
+type StorageConfig struct {
+	Driver        string   `mapstructure:"driver"`
+	LocalPath     string   `mapstructure:"local_path"`
+	PublicBaseURL string   `mapstructure:"public_base_url"`
+	S3            S3Config `mapstructure:"s3"`
+}
+
+type S3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+}
+
+const (
+	StorageDriverLocal = "local"
+	StorageDriverS3    = "s3"
+
+	// DefaultMediaPath is where the API serves local blobs when no public base
+	// URL is configured.
+	DefaultMediaPath = "/media"
+)
+
+// MediaPath is the path under which the API itself serves local blobs.
+func (c *StorageConfig) MediaPath() string {
+	if c.PublicBaseURL == "" {
+		return DefaultMediaPath
+	}
+	return c.PublicBaseURL
+}
+
+type AvatarConfig struct {
+	MaxUploadSize int64 `mapstructure:"max_upload_size"`
+}
+
+// UserRetentionConfig controls how long soft-deleted users can be restored
+// before they are purged. A zero Period keeps deleted users forever.
+type UserRetentionConfig struct {
+	Period             time.Duration `mapstructure:"period"`
+	PurgeCheckInterval time.Duration `mapstructure:"purge_check_interval"`
+}
+
+// UserSuspensionConfig bounds how long an administrator can suspend a user
+// and how often expired suspensions are lifted.
+type UserSuspensionConfig struct {
+	MaxDuration            time.Duration `mapstructure:"max_duration"`
+	ReinstateCheckInterval time.Duration `mapstructure:"reinstate_check_interval"`
+}
+
+type MailConfig struct {
+	Driver string     `mapstructure:"driver"`
+	From   string     `mapstructure:"from"`
+	SMTP   SMTPConfig `mapstructure:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+const (
+	MailDriverLog  = "log"
+	MailDriverSMTP = "smtp"
+)
+
+// EmailChangeConfig controls self-service email changes. The confirm and
+// cancel URLs are pages of the client application; the token is appended as
+// a "token" query parameter.
+type EmailChangeConfig struct {
+	TokenTTL   time.Duration `mapstructure:"token_ttl"`
+	ConfirmURL string        `mapstructure:"confirm_url"`
+	CancelURL  string        `mapstructure:"cancel_url"`
+}
+
+// InvitationConfig controls admin-issued invitations. AcceptURL is a page of
+// the client application that receives the token as a "token" query
+// parameter.
+type InvitationConfig struct {
+	TokenTTL  time.Duration `mapstructure:"token_ttl"`
+	AcceptURL string        `mapstructure:"accept_url"`
+}
+
+// RegistrationConfig controls self-service sign-up. In invite-only mode
+// /auth/register is disabled and accounts are created by accepting an
+// invitation.
+type RegistrationConfig struct {
+	Mode string `mapstructure:"mode"`
+}
+
+const (
+	RegistrationModeOpen       = "open"
+	RegistrationModeInviteOnly = "invite_only"
+)
+
+func (c *RegistrationConfig) IsInviteOnly() bool {
+	return c.Mode == RegistrationModeInviteOnly
+}
+
 const (
 	EnvDevelopment = "development"
 	EnvStaging     = "staging"
@@ -91,6 +91,39 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"})
 	v.SetDefault("cors.allowed_headers", []string{"Authorization", "Content-Type", "X-Request-ID"})
 	v.SetDefault("cors.max_age", 86400)
+
+	v.SetDefault("access_request.approver_permission", "access_requests:approve")
+	v.SetDefault("access_request.max_duration", 8*time.Hour)
+	v.SetDefault("access_request.expiry_check_interval", time.Minute)
+
+	v.SetDefault("access_review.escalation_check_interval", 15*time.Minute)
+
+	v.SetDefault("rbac.permission_drift_mode", PermissionDriftWarn)
+
+	v.SetDefault("storage.driver", StorageDriverLocal)
+	v.SetDefault("storage.local_path", "data/blobs")
+	v.SetDefault("storage.s3.region", "us-east-1")
+
+	v.SetDefault("avatar.max_upload_size", 5<<20)
+
+	v.SetDefault("user_retention.period", 30*24*time.Hour)
+	v.SetDefault("user_retention.purge_check_interval", time.Hour)
+
+	v.SetDefault("user_suspension.max_duration", 365*24*time.Hour)
+	v.SetDefault("user_suspension.reinstate_check_interval", time.Minute)
+
+	v.SetDefault("mail.driver", MailDriverLog)
+	v.SetDefault("mail.from", "no-reply@localhost")
+	v.SetDefault("mail.smtp.port", 587)
+
+	v.SetDefault("email_change.token_ttl", 24*time.Hour)
+	v.SetDefault("email_change.confirm_url", "http://localhost:3000/email-change/confirm")
+	v.SetDefault("email_change.cancel_url", "http://localhost:3000/email-change/cancel")
+
+	v.SetDefault("invitation.token_ttl", 7*24*time.Hour)
+	v.SetDefault("invitation.accept_url", "http://localhost:3000/invitations/accept")
+
+	v.SetDefault("registration.mode", RegistrationModeOpen)
 }
 
 func bindEnvVars(v *viper.Viper) {
@@ -138,6 +171,48 @@ func bindEnvVars(v *viper.Viper) {
 		"cors.allowed_methods": "CORS_ALLOWED_METHODS",
 		"cors.allowed_headers": "CORS_ALLOWED_HEADERS",
 		"cors.max_age":         "CORS_MAX_AGE",
+
+		"access_request.approver_permission":   "ACCESS_REQUEST_APPROVER_PERMISSION",
+		"access_request.max_duration":          "ACCESS_REQUEST_MAX_DURATION",
+		"access_request.expiry_check_interval": "ACCESS_REQUEST_EXPIRY_CHECK_INTERVAL",
+
+		"access_review.evidence_signing_key":      "ACCESS_REVIEW_EVIDENCE_SIGNING_KEY",
+		"access_review.escalation_check_interval": "ACCESS_REVIEW_ESCALATION_CHECK_INTERVAL",
+
+		"rbac.permission_drift_mode": "RBAC_PERMISSION_DRIFT_MODE",
+
+		"storage.driver":               "STORAGE_DRIVER",
+		"storage.local_path":           "STORAGE_LOCAL_PATH",
+		"storage.public_base_url":      "STORAGE_PUBLIC_BASE_URL",
+		"storage.s3.endpoint":          "STORAGE_S3_ENDPOINT",
+		"storage.s3.region":            "STORAGE_S3_REGION",
+		"storage.s3.bucket":            "STORAGE_S3_BUCKET",
+		"storage.s3.access_key_id":     "STORAGE_S3_ACCESS_KEY_ID",
+		"storage.s3.secret_access_key": "STORAGE_S3_SECRET_ACCESS_KEY",
+
+		"avatar.max_upload_size": "AVATAR_MAX_UPLOAD_SIZE",
+
+		"user_retention.period":               "USER_RETENTION_PERIOD",
+		"user_retention.purge_check_interval": "USER_RETENTION_PURGE_CHECK_INTERVAL",
+
+		"user_suspension.max_duration":             "USER_SUSPENSION_MAX_DURATION",
+		"user_suspension.reinstate_check_interval": "USER_SUSPENSION_REINSTATE_CHECK_INTERVAL",
+
+		"mail.driver":        "MAIL_DRIVER",
+		"mail.from":          "MAIL_FROM",
+		"mail.smtp.host":     "MAIL_SMTP_HOST",
+		"mail.smtp.port":     "MAIL_SMTP_PORT",
+		"mail.smtp.username": "MAIL_SMTP_USERNAME",
+		"mail.smtp.password": "MAIL_SMTP_PASSWORD",
+
+		"email_change.token_ttl":   "EMAIL_CHANGE_TOKEN_TTL",
+		"email_change.confirm_url": "EMAIL_CHANGE_CONFIRM_URL",
+		"email_change.cancel_url":  "EMAIL_CHANGE_CANCEL_URL",
+
+		"invitation.token_ttl":  "INVITATION_TOKEN_TTL",
+		"invitation.accept_url": "INVITATION_ACCEPT_URL",
+
+		"registration.mode": "REGISTRATION_MODE",
 	}
 
 	for key, envVar := range envBindings {
@@ -2,8 +2,10 @@ package config
 
 import (
 	"errors"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type ValidationError struct {
@@ -39,6 +41,17 @@ func (c *Config) Validate() error {
 	errs = append(errs, c.JWT.Validate(c.App.Env)...)
 	errs = append(errs, c.Log.Validate()...)
 	errs = append(errs, c.CORS.Validate()...)
+	errs = append(errs, c.AccessRequest.Validate()...)
+	errs = append(errs, c.AccessReview.Validate(c.App.Env)...)
+	errs = append(errs, c.RBAC.Validate()...)
+	errs = append(errs, c.Storage.Validate()...)
+	errs = append(errs, c.Avatar.Validate()...)
+	errs = append(errs, c.UserRetention.Validate()...)
+	errs = append(errs, c.UserSuspension.Validate()...)
+	errs = append(errs, c.Mail.Validate()...)
+	errs = append(errs, c.EmailChange.Validate()...)
+	errs = append(errs, c.Invitation.Validate()...)
+	errs = append(errs, c.Registration.Validate()...)
 
 	if len(errs) > 0 {
 		return errs
@@ -306,6 +319,262 @@ func (c *CORSConfig) Validate() ValidationErrors {
 	return errs
 }
 
+func (c *AccessRequestConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.ApproverPermission == "" {
+		errs = append(errs, ValidationError{
+			Field:   "access_request.approver_permission",
+			Message: "approver permission is required",
+		})
+	}
+
+	if c.MaxDuration < time.Minute {
+		errs = append(errs, ValidationError{
+			Field:   "access_request.max_duration",
+			Message: "maximum access duration must be at least one minute",
+		})
+	}
+
+	if c.ExpiryCheckInterval < time.Second {
+		errs = append(errs, ValidationError{
+			Field:   "access_request.expiry_check_interval",
+			Message: "expiry check interval must be at least one second",
+		})
+	}
+
+	return errs
+}
+
+func (c *AccessReviewConfig) Validate(env string) ValidationErrors {
+	var errs ValidationErrors
+
+	if env == EnvProduction && c.EvidenceSigningKey == "" {
+		errs = append(errs, ValidationError{
+			Field:   "access_review.evidence_signing_key",
+			Message: "evidence signing key is required in production",
+		})
+	}
+
+	if c.EvidenceSigningKey != "" && len(c.EvidenceSigningKey) < 32 {
+		errs = append(errs, ValidationError{
+			Field:   "access_review.evidence_signing_key",
+			Message: "evidence signing key should be at least 32 characters",
+		})
+	}
+
+	if c.EscalationCheckInterval < time.Second {
+		errs = append(errs, ValidationError{
+			Field:   "access_review.escalation_check_interval",
+			Message: "escalation check interval must be at least one second",
+		})
+	}
+
+	return errs
+}
+
+func (c *RBACConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.PermissionDriftMode != PermissionDriftWarn && c.PermissionDriftMode != PermissionDriftFail {
+		errs = append(errs, ValidationError{
+			Field:   "rbac.permission_drift_mode",
+			Message: "invalid permission drift mode '" + c.PermissionDriftMode + "', must be one of: warn, fail",
+		})
+	}
+
+	return errs
+}
+
+func (c *StorageConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	switch c.Driver {
+	case StorageDriverLocal:
+		if c.LocalPath == "" {
+			errs = append(errs, ValidationError{
+				Field:   "storage.local_path",
+				Message: "local path is required for the local storage driver",
+			})
+		}
+		if c.PublicBaseURL != "" && !strings.HasPrefix(c.PublicBaseURL, "/") {
+			errs = append(errs, ValidationError{
+				Field:   "storage.public_base_url",
+				Message: "public base url must be a path when using the local storage driver",
+			})
+		}
+	case StorageDriverS3:
+		if c.S3.Endpoint == "" {
+			errs = append(errs, ValidationError{
+				Field:   "storage.s3.endpoint",
+				Message: "endpoint is required for the s3 storage driver",
+			})
+		}
+		if c.S3.Bucket == "" {
+			errs = append(errs, ValidationError{
+				Field:   "storage.s3.bucket",
+				Message: "bucket is required for the s3 storage driver",
+			})
+		}
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "storage.driver",
+			Message: "invalid storage driver '" + c.Driver + "', must be one of: local, s3",
+		})
+	}
+
+	return errs
+}
+
+func (c *AvatarConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.MaxUploadSize < 1<<10 || c.MaxUploadSize > 8<<20 {
+		errs = append(errs, ValidationError{
+			Field:   "avatar.max_upload_size",
+			Message: "max upload size must be between 1KB and 8MB",
+		})
+	}
+
+	return errs
+}
+
+func (c *UserRetentionConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.Period < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "user_retention.period",
+			Message: "retention period cannot be negative",
+		})
+	}
+
+	if c.Period > 0 && c.PurgeCheckInterval < time.Second {
+		errs = append(errs, ValidationError{
+			Field:   "user_retention.purge_check_interval",
+			Message: "purge check interval must be at least one second",
+		})
+	}
+
+	return errs
+}
+
+func (c *UserSuspensionConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.MaxDuration < time.Minute {
+		errs = append(errs, ValidationError{
+			Field:   "user_suspension.max_duration",
+			Message: "maximum suspension must be at least one minute",
+		})
+	}
+
+	if c.ReinstateCheckInterval < time.Second {
+		errs = append(errs, ValidationError{
+			Field:   "user_suspension.reinstate_check_interval",
+			Message: "reinstate check interval must be at least one second",
+		})
+	}
+
+	return errs
+}
+
+func (c *MailConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.From == "" {
+		errs = append(errs, ValidationError{
+			Field:   "mail.from",
+			Message: "sender address is required",
+		})
+	}
+
+	switch c.Driver {
+	case MailDriverLog:
+	case MailDriverSMTP:
+		if c.SMTP.Host == "" {
+			errs = append(errs, ValidationError{
+				Field:   "mail.smtp.host",
+				Message: "host is required for the smtp mail driver",
+			})
+		}
+		if c.SMTP.Port < 1 || c.SMTP.Port > 65535 {
+			errs = append(errs, ValidationError{
+				Field:   "mail.smtp.port",
+				Message: "port must be between 1 and 65535",
+			})
+		}
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "mail.driver",
+			Message: "invalid mail driver '" + c.Driver + "', must be one of: log, smtp",
+		})
+	}
+
+	return errs
+}
+
+func (c *EmailChangeConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.TokenTTL < time.Minute {
+		errs = append(errs, ValidationError{
+			Field:   "email_change.token_ttl",
+			Message: "token ttl must be at least one minute",
+		})
+	}
+
+	links := []struct{ field, value string }{
+		{"email_change.confirm_url", c.ConfirmURL},
+		{"email_change.cancel_url", c.CancelURL},
+	}
+	for _, link := range links {
+		if parsed, err := url.Parse(link.value); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			errs = append(errs, ValidationError{
+				Field:   link.field,
+				Message: "must be an absolute url",
+			})
+		}
+	}
+
+	return errs
+}
+
+func (c *InvitationConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.TokenTTL < time.Minute {
+		errs = append(errs, ValidationError{
+			Field:   "invitation.token_ttl",
+			Message: "token ttl must be at least one minute",
+		})
+	}
+
+	if parsed, err := url.Parse(c.AcceptURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		errs = append(errs, ValidationError{
+			Field:   "invitation.accept_url",
+			Message: "must be an absolute url",
+		})
+	}
+
+	return errs
+}
+
+func (c *RegistrationConfig) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	switch c.Mode {
+	case RegistrationModeOpen, RegistrationModeInviteOnly:
+	default:
+		errs = append(errs, ValidationError{
+			Field:   "registration.mode",
+			Message: "invalid registration mode '" + c.Mode + "', must be one of: open, invite_only",
+		})
+	}
+
+	return errs
+}
+
 func IsValidationError(err error) bool {
 	var validationErrs ValidationErrors
 	return errors.As(err, &validationErrs)
@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	English    = "en"
+	Vietnamese = "vi"
+)
+
+// Default is the locale used when neither the user nor the request asks for
+// one, and the language every message is written in.
+const Default = English
+
+// Params fills the {name} placeholders of a message.
+type Params map[string]string
+
+// catalogs maps a locale to translations keyed by the English message. The
+// English text doubles as the message id, so a message missing from a
+// catalog still renders in English instead of as an opaque key.
+var catalogs = map[string]map[string]string{
+	English:    {},
+	Vietnamese: vietnamese,
+}
+
+func Supported() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Translate renders message in locale, falling back to the English message
+// when the locale or the message is unknown.
+func Translate(locale, message string, params Params) string {
+	if translated, ok := catalogs[locale][message]; ok {
+		message = translated
+	}
+	return Render(message, params)
+}
+
+// Lookup returns the translation of message in locale, reporting whether the
+// catalog has one.
+func Lookup(locale, message string) (string, bool) {
+	translated, ok := catalogs[locale][message]
+	return translated, ok
+}
+
+// Render fills the placeholders of message in a single pass, so a value that
+// itself looks like a placeholder is left as it is.
+func Render(message string, params Params) string {
+	if len(params) == 0 {
+		return message
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
+
+// ParseAcceptLanguage returns the supported locale the client prefers most
+// according to an Accept-Language header, or "" when it accepts none of
+// them. Region subtags are ignored, so "vi-VN" selects Vietnamese.
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		locale  string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, options, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(options), "="); ok && strings.TrimSpace(name) == "q" {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if !IsSupported(primary) {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: primary, quality: quality})
+	}
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].locale
+}
+
+type contextKey struct{}
+
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale of the request being served, or Default.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return Default
+	}
+	if locale, ok := ctx.Value(contextKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return Default
+}
@@ -0,0 +1,165 @@
+package i18n
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslate(t *testing.T) {
+	params := Params{"field": "email"}
+
+	assert.Equal(t, "email là bắt buộc", Translate(Vietnamese, "{field} is required", params))
+	assert.Equal(t, "email is required", Translate(English, "{field} is required", params))
+	assert.Equal(t, "email is required", Translate("fr", "{field} is required", params))
+	assert.Equal(t, "something new", Translate(Vietnamese, "something new", nil))
+}
+
+func TestLookup(t *testing.T) {
+	translated, ok := Lookup(Vietnamese, "invalid request body")
+	assert.True(t, ok)
+	assert.Equal(t, "nội dung yêu cầu không hợp lệ", translated)
+
+	_, ok = Lookup(Vietnamese, "no such message")
+	assert.False(t, ok)
+}
+
+func TestVietnameseCatalogKeepsPlaceholders(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{[a-z_]+\}`)
+	for message, translated := range vietnamese {
+		expected := placeholder.FindAllString(message, -1)
+		actual := placeholder.FindAllString(translated, -1)
+		sort.Strings(expected)
+		sort.Strings(actual)
+		assert.Equal(t, expected, actual, "placeholders of %q", message)
+	}
+}
+
+// TestVietnameseCatalogCoversDomainErrors reads the exported Err variables
+// of the domain packages from source, so that an error added later without
+// a translation fails here rather than reaching clients in English.
+func TestVietnameseCatalogCoversDomainErrors(t *testing.T) {
+	paths, err := filepath.Glob("../../internal/domain/*/*.go")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	fileSet := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		require.NoError(t, err)
+
+		for _, declaration := range file.Decls {
+			general, ok := declaration.(*ast.GenDecl)
+			if !ok || general.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range general.Specs {
+				value := spec.(*ast.ValueSpec)
+				for i, name := range value.Names {
+					if !name.IsExported() || !strings.HasPrefix(name.Name, "Err") || i >= len(value.Values) {
+						continue
+					}
+					messages, ok := domainErrorMessages(value.Values[i])
+					if !assert.True(t, ok, "cannot read the message of %s.%s", file.Name.Name, name.Name) {
+						continue
+					}
+					for _, message := range messages {
+						_, translated := Lookup(Vietnamese, message)
+						assert.True(t, translated, "%s.%s: no Vietnamese translation for %q", file.Name.Name, name.Name, message)
+					}
+				}
+			}
+		}
+	}
+}
+
+// domainErrorMessages returns the catalog keys a shared.New...Error call
+// is rendered with: the message or template, or the entity name for errors
+// whose message is a fixed template.
+func domainErrorMessages(expression ast.Expr) ([]string, bool) {
+	call, ok := expression.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	if pkg, ok := selector.X.(*ast.Ident); !ok || pkg.Name != "shared" {
+		return nil, false
+	}
+
+	argument := func(index int) (string, bool) {
+		if index >= len(call.Args) {
+			return "", false
+		}
+		literal, ok := call.Args[index].(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			return "", false
+		}
+		text, err := strconv.Unquote(literal.Value)
+		return text, err == nil
+	}
+
+	switch selector.Sel.Name {
+	case "NewNotFoundError", "NewConflictError", "NewConcurrentModificationError", "NewPreconditionFailedError":
+		entity, ok := argument(0)
+		return []string{entity}, ok
+	case "NewValidationError", "NewBusinessRuleViolationErrorWithParams":
+		message, ok := argument(1)
+		return []string{message}, ok
+	case "NewBusinessRuleViolationError":
+		message, ok := argument(1)
+		if message == "" {
+			// Built per violation, see the package's constructor.
+			return nil, ok
+		}
+		return []string{message}, ok
+	case "NewAuthorizationError":
+		action, actionOK := argument(0)
+		resource, resourceOK := argument(1)
+		return []string{"not authorized to " + action + " on " + resource}, actionOK && resourceOK
+	}
+	return nil, false
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"", ""},
+		{"vi", Vietnamese},
+		{"vi-VN,vi;q=0.9,en;q=0.8", Vietnamese},
+		{"en-US,en;q=0.9,vi;q=0.8", English},
+		{"fr-FR,vi;q=0.5,en;q=0.7", English},
+		{"fr, de", ""},
+		{"vi;q=0, en", English},
+		{"*", ""},
+		{"VI-vn", Vietnamese},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseAcceptLanguage(tt.header))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, Vietnamese, FromContext(WithLocale(context.Background(), Vietnamese)))
+}
@@ -0,0 +1,246 @@
+package i18n
+
+var vietnamese = map[string]string{
+	// Request validation (pkg/validator).
+	"{field} is required":                                           "{field} là bắt buộc",
+	"{field} must be a valid email address":                         "{field} phải là địa chỉ email hợp lệ",
+	"{field} must be a valid email address with an existing domain": "{field} phải là địa chỉ email hợp lệ với tên miền tồn tại",
+	"{field} must be a valid phone number":                          "{field} phải là số điện thoại hợp lệ",
+	"{field} must be at least 8 characters with uppercase, lowercase, number, and special character": "{field} phải có ít nhất 8 ký tự, gồm chữ hoa, chữ thường, chữ số và ký tự đặc biệt",
+	"{field} must be a valid UUID": "{field} phải là UUID hợp lệ",
+	"{field} must start with a letter and contain only letters, numbers, and underscores (3-30 characters)": "{field} phải bắt đầu bằng chữ cái và chỉ chứa chữ cái, chữ số và dấu gạch dưới (3-30 ký tự)",
+	"{field} must be at least {param} characters":                                                           "{field} phải có ít nhất {param} ký tự",
+	"{field} must be at most {param} characters":                                                            "{field} chỉ được có tối đa {param} ký tự",
+	"{field} must be exactly {param} characters":                                                            "{field} phải có đúng {param} ký tự",
+	"{field} must be greater than or equal to {param}":                                                      "{field} phải lớn hơn hoặc bằng {param}",
+	"{field} must be less than or equal to {param}":                                                         "{field} phải nhỏ hơn hoặc bằng {param}",
+	"{field} must be greater than {param}":                                                                  "{field} phải lớn hơn {param}",
+	"{field} must be less than {param}":                                                                     "{field} phải nhỏ hơn {param}",
+	"{field} must be equal to {param}":                                                                      "{field} phải bằng {param}",
+	"{field} must not be equal to {param}":                                                                  "{field} không được bằng {param}",
+	"{field} must be one of: {param}":                                                                       "{field} phải là một trong các giá trị: {param}",
+	"{field} must be a valid URL":                                                                           "{field} phải là URL hợp lệ",
+	"{field} must be a valid URI":                                                                           "{field} phải là URI hợp lệ",
+	"{field} must contain only letters":                                                                     "{field} chỉ được chứa chữ cái",
+	"{field} must contain only letters and numbers":                                                         "{field} chỉ được chứa chữ cái và chữ số",
+	"{field} must be a valid number":                                                                        "{field} phải là số hợp lệ",
+	"{field} must be a boolean":                                                                             "{field} phải là giá trị đúng/sai",
+	"{field} must contain '{param}'":                                                                        "{field} phải chứa '{param}'",
+	"{field} must not contain '{param}'":                                                                    "{field} không được chứa '{param}'",
+	"{field} must start with '{param}'":                                                                     "{field} phải bắt đầu bằng '{param}'",
+	"{field} must end with '{param}'":                                                                       "{field} phải kết thúc bằng '{param}'",
+	"{field} must be a valid datetime in format {param}":                                                    "{field} phải là thời gian hợp lệ theo định dạng {param}",
+	"{field} must be valid JSON":                                                                            "{field} phải là JSON hợp lệ",
+	"{field} must be a valid JWT":                                                                           "{field} phải là JWT hợp lệ",
+	"{field} must be a valid UUID v4":                                                                       "{field} phải là UUID v4 hợp lệ",
+	"{field} must be a valid IP address":                                                                    "{field} phải là địa chỉ IP hợp lệ",
+	"{field} must be a valid IPv4 address":                                                                  "{field} phải là địa chỉ IPv4 hợp lệ",
+	"{field} must be a valid IPv6 address":                                                                  "{field} phải là địa chỉ IPv6 hợp lệ",
+	"{field} must be a valid CIDR notation":                                                                 "{field} phải là ký hiệu CIDR hợp lệ",
+	"{field} must be a valid MAC address":                                                                   "{field} phải là địa chỉ MAC hợp lệ",
+	"{field} must be a valid hostname":                                                                      "{field} phải là tên máy chủ hợp lệ",
+	"{field} must be a valid FQDN":                                                                          "{field} phải là FQDN hợp lệ",
+	"{field} must contain unique values":                                                                    "{field} không được chứa giá trị trùng lặp",
+	"{field} must contain only ASCII characters":                                                            "{field} chỉ được chứa ký tự ASCII",
+	"{field} must contain only printable ASCII characters":                                                  "{field} chỉ được chứa ký tự ASCII in được",
+	"{field} must be valid base64":                                                                          "{field} phải là base64 hợp lệ",
+	"{field} must be a valid hexadecimal":                                                                   "{field} phải là số thập lục phân hợp lệ",
+	"{field} must be lowercase":                                                                             "{field} phải viết thường",
+	"{field} must be uppercase":                                                                             "{field} phải viết hoa",
+	"{field} failed on {tag} validation":                                                                    "{field} không hợp lệ theo quy tắc {tag}",
+
+	// Fixed HTTP error messages.
+	"validation failed":          "dữ liệu không hợp lệ",
+	"an internal error occurred": "đã xảy ra lỗi hệ thống",
+	"unauthorized":               "chưa xác thực",
+	"forbidden":                  "không có quyền truy cập",
+	"resource not found":         "không tìm thấy tài nguyên",
+	"request body exceeds limit of {limit} bytes": "nội dung yêu cầu vượt quá giới hạn {limit} byte",
+	"invalid request body":                        "nội dung yêu cầu không hợp lệ",
+	"invalid user id":                             "mã người dùng không hợp lệ",
+	"invalid role id":                             "mã vai trò không hợp lệ",
+	"invalid permission id":                       "mã quyền không hợp lệ",
+	"invalid organization id":                     "mã tổ chức không hợp lệ",
+	"invalid group id":                            "mã nhóm không hợp lệ",
+	"invalid session id":                          "mã phiên đăng nhập không hợp lệ",
+	"invalid invitation id":                       "mã lời mời không hợp lệ",
+	"invalid include_total parameter":             "tham số include_total không hợp lệ",
+	"invalid status":                              "trạng thái không hợp lệ",
+	"invalid token":                               "token không hợp lệ",
+	"token expired":                               "token đã hết hạn",
+	"token has been revoked":                      "token đã bị thu hồi",
+	"missing token":                               "thiếu token",
+	"missing authorization header":                "thiếu header Authorization",
+	"invalid authorization header format":         "header Authorization sai định dạng",
+	"authentication required":                     "cần đăng nhập",
+	"access denied":                               "truy cập bị từ chối",
+	"insufficient permissions":                    "không đủ quyền",
+	"insufficient role":                           "không đủ vai trò",
+	"invalid If-Match header":                     "header If-Match không hợp lệ",
+
+	// Domain errors.
+	"{entity} with identifier '{identifier}' not found":                           "không tìm thấy {entity} có mã '{identifier}'",
+	"{entity} with {field} '{value}' already exists":                              "{entity} có {field} '{value}' đã tồn tại",
+	"{entity} with identifier '{identifier}' was modified by another request":     "{entity} có mã '{identifier}' đã bị thay đổi bởi một yêu cầu khác",
+	"{entity} with identifier '{identifier}' does not match the expected version": "{entity} có mã '{identifier}' không khớp với phiên bản mong đợi",
+	"validation error on field '{field}': {message}":                              "lỗi dữ liệu ở trường '{field}': {message}",
+	"cannot transition from '{from}' to '{to}'":                                   "không thể chuyển trạng thái từ '{from}' sang '{to}'",
+
+	// Entity names used in domain errors.
+	"User":                 "người dùng",
+	"Role":                 "vai trò",
+	"Permission":           "quyền",
+	"Organization":         "tổ chức",
+	"Group":                "nhóm",
+	"Invitation":           "lời mời",
+	"Session":              "phiên đăng nhập",
+	"RefreshToken":         "refresh token",
+	"AccessRequest":        "yêu cầu truy cập",
+	"Preferences":          "tùy chọn cá nhân",
+	"BulkOperation":        "thao tác hàng loạt",
+	"BulkOperationItem":    "mục của thao tác hàng loạt",
+	"AccessReviewCampaign": "đợt rà soát quyền truy cập",
+	"AccessReviewItem":     "mục rà soát quyền truy cập",
+	"AttributeDefinition":  "định nghĩa thuộc tính",
+	"SoDRule":              "quy tắc phân tách nhiệm vụ",
+
+	// User statuses.
+	"pending":   "chờ kích hoạt",
+	"active":    "đang hoạt động",
+	"inactive":  "ngừng hoạt động",
+	"suspended": "tạm khóa",
+	"banned":    "bị cấm",
+
+	// Authorization errors.
+	"not authorized to authenticate on user":                                       "email hoặc mật khẩu không đúng",
+	"not authorized to authenticate on missing token":                              "thiếu token xác thực",
+	"not authorized to authenticate on invalid token format":                       "token xác thực sai định dạng",
+	"not authorized to validate on token (expired)":                                "token đã hết hạn",
+	"not authorized to validate on token (invalid)":                                "token không hợp lệ",
+	"not authorized to validate on token (revoked)":                                "token đã bị thu hồi",
+	"not authorized to validate on refresh token (invalid)":                        "refresh token không hợp lệ",
+	"not authorized to validate on password reset token (invalid)":                 "mã đặt lại mật khẩu không hợp lệ",
+	"not authorized to validate on password reset token":                           "mã đặt lại mật khẩu không hợp lệ",
+	"not authorized to register on user (registration is invite-only)":             "chỉ có thể đăng ký thông qua lời mời",
+	"not authorized to access on organization (not a member)":                      "bạn không phải thành viên của tổ chức này",
+	"not authorized to grant on roles to yourself":                                 "không thể tự cấp vai trò cho chính mình",
+	"not authorized to manage on role with equal or higher priority than your own": "không thể quản lý vai trò có mức ưu tiên bằng hoặc cao hơn vai trò của bạn",
+	"not authorized to manage on user who holds a higher priority role":            "không thể quản lý người dùng giữ vai trò có mức ưu tiên cao hơn",
+	"not authorized to review on access request":                                   "bạn không phải người duyệt của yêu cầu truy cập này",
+	"not authorized to cancel on access request":                                   "chỉ người gửi yêu cầu truy cập mới có thể hủy yêu cầu",
+	"not authorized to review on access review item":                               "bạn không phải người rà soát của mục này",
+	"not authorized to change on global role from inside an organization":          "không thể thay đổi vai trò toàn hệ thống từ bên trong một tổ chức",
+
+	// Business rules.
+	"user is already deleted":                                             "người dùng đã bị xóa",
+	"user is already active":                                              "người dùng đã được kích hoạt",
+	"user is already inactive":                                            "người dùng đã ngừng hoạt động",
+	"user is banned and cannot perform this action":                       "người dùng đã bị cấm và không thể thực hiện thao tác này",
+	"user is suspended and cannot perform this action":                    "người dùng đang bị tạm khóa và không thể thực hiện thao tác này",
+	"user is neither banned nor suspended":                                "người dùng không bị cấm và không bị tạm khóa",
+	"user's suspension has not ended yet":                                 "thời hạn tạm khóa của người dùng chưa kết thúc",
+	"user is not deleted":                                                 "người dùng chưa bị xóa",
+	"user's personal data has already been erased":                        "dữ liệu cá nhân của người dùng đã được xóa",
+	"user's personal data has been erased and cannot be restored":         "dữ liệu cá nhân của người dùng đã bị xóa và không thể khôi phục",
+	"user already holds the requested role":                               "người dùng đã có vai trò được yêu cầu",
+	"role is already assigned to this user":                               "vai trò đã được gán cho người dùng này",
+	"role is not assigned to this user":                                   "vai trò chưa được gán cho người dùng này",
+	"role belongs to an organization the user is not a member of":         "vai trò thuộc một tổ chức mà người dùng không phải thành viên",
+	"permission is already denied for this user":                          "quyền này đã bị từ chối đối với người dùng",
+	"permission is not denied for this user":                              "quyền này không bị từ chối đối với người dùng",
+	"permission is already assigned to this role":                         "quyền đã được gán cho vai trò này",
+	"permission is not assigned to this role":                             "quyền chưa được gán cho vai trò này",
+	"permission is already denied by this role":                           "quyền đã bị vai trò này từ chối",
+	"permission is not denied by this role":                               "quyền không bị vai trò này từ chối",
+	"permission is currently assigned to one or more roles":               "quyền đang được gán cho một hoặc nhiều vai trò",
+	"a role cannot both grant and deny the same permission":               "một vai trò không thể vừa cấp vừa từ chối cùng một quyền",
+	"role is assigned to users and cannot be deleted":                     "vai trò đang được gán cho người dùng và không thể xóa",
+	"system roles cannot be deleted":                                      "không thể xóa vai trò hệ thống",
+	"system roles cannot be modified":                                     "không thể sửa vai trò hệ thống",
+	"system permissions cannot be deleted":                                "không thể xóa quyền hệ thống",
+	"system permissions cannot be modified":                               "không thể sửa quyền hệ thống",
+	"the default role cannot be deleted":                                  "không thể xóa vai trò mặc định",
+	"new email is the same as the current email":                          "email mới trùng với email hiện tại",
+	"email change token is invalid or has expired":                        "mã đổi email không hợp lệ hoặc đã hết hạn",
+	"email is already verified":                                           "email đã được xác minh",
+	"account is temporarily locked due to too many failed login attempts": "tài khoản tạm thời bị khóa do đăng nhập sai quá nhiều lần",
+	"account is not active":                                               "tài khoản không hoạt động",
+	"account has been banned":                                             "tài khoản đã bị cấm",
+	"account is suspended":                                                "tài khoản đang bị tạm khóa",
+	"account is suspended until {until}":                                  "tài khoản bị tạm khóa đến {until}",
+	"refresh token has expired":                                           "refresh token đã hết hạn",
+	"refresh token has been revoked":                                      "refresh token đã bị thu hồi",
+	"maximum number of active sessions exceeded":                          "đã vượt quá số phiên đăng nhập tối đa",
+	"password reset token has expired":                                    "mã đặt lại mật khẩu đã hết hạn",
+	"invitation has expired":                                              "lời mời đã hết hạn",
+	"invitation token is invalid or has already been used":                "mã lời mời không hợp lệ hoặc đã được sử dụng",
+	"user is already a member of this organization":                       "người dùng đã là thành viên của tổ chức này",
+	"user is already a member of this group":                              "người dùng đã là thành viên của nhóm này",
+	"user is not a member of this group":                                  "người dùng không phải thành viên của nhóm này",
+	"role is already assigned to this group":                              "vai trò đã được gán cho nhóm này",
+	"role is not assigned to this group":                                  "vai trò chưa được gán cho nhóm này",
+	"role scope does not match the group's organization":                  "phạm vi của vai trò không khớp với tổ chức của nhóm",
+	"user is not a member of the group's organization":                    "người dùng không phải thành viên của tổ chức sở hữu nhóm",
+	"requested duration exceeds the maximum allowed":                      "thời hạn yêu cầu vượt quá mức tối đa cho phép",
+	"requesters cannot review their own access requests":                  "người gửi yêu cầu không thể tự duyệt yêu cầu truy cập của mình",
+	"campaign is no longer accepting decisions":                           "đợt rà soát không còn nhận quyết định",
+	"campaign scope does not cover any role assignments":                  "phạm vi đợt rà soát không bao gồm phân quyền vai trò nào",
+	"campaign still has undecided items":                                  "đợt rà soát vẫn còn mục chưa được quyết định",
+	"review item has already been decided":                                "mục rà soát đã được quyết định",
+	"role is not part of this campaign":                                   "vai trò không thuộc đợt rà soát này",
+	"reviewers cannot decide on their own access":                         "người rà soát không thể tự quyết định về quyền truy cập của chính mình",
+	"action must be one of activate, deactivate, ban, delete, set_roles":  "hành động phải là một trong activate, deactivate, ban, delete, set_roles",
+	"user has already been processed by this operation":                   "người dùng đã được thao tác này xử lý",
+	"bulk operations cannot be applied to your own account":               "không thể áp dụng thao tác hàng loạt cho tài khoản của chính bạn",
+	"bulk operation does not match any users":                             "thao tác hàng loạt không khớp với người dùng nào",
+	"a bulk operation may target at most {max} users":                     "một thao tác hàng loạt chỉ được áp dụng cho tối đa {max} người dùng",
+	"an import may contain at most {max} rows":                            "một lần nhập chỉ được chứa tối đa {max} dòng",
+
+	// Field validation messages raised by the domain.
+	"is required":                                  "là bắt buộc",
+	"is malformed":                                 "sai định dạng",
+	"must be in the future":                        "phải là thời điểm trong tương lai",
+	"must be asc or desc":                          "phải là asc hoặc desc",
+	"requires sort_by":                             "cần có sort_by",
+	"too many sort keys":                           "quá nhiều khóa sắp xếp",
+	"must not be before from":                      "không được trước from",
+	"email cannot be empty":                        "email không được để trống",
+	"invalid email format":                         "email sai định dạng",
+	"full name cannot be empty":                    "họ tên không được để trống",
+	"full name must be at least 2 characters":      "họ tên phải có ít nhất 2 ký tự",
+	"full name must not exceed 255 characters":     "họ tên không được vượt quá 255 ký tự",
+	"invalid password":                             "mật khẩu không hợp lệ",
+	"password must be at least 8 characters":       "mật khẩu phải có ít nhất 8 ký tự",
+	"password must not exceed 128 characters":      "mật khẩu không được vượt quá 128 ký tự",
+	"password does not meet security requirements": "mật khẩu không đáp ứng yêu cầu bảo mật",
+	"phone number cannot be empty":                 "số điện thoại không được để trống",
+	"invalid phone number format":                  "số điện thoại sai định dạng",
+	"invalid country code":                         "mã quốc gia không hợp lệ",
+	"invalid user status":                          "trạng thái người dùng không hợp lệ",
+	"reason is required to ban users":              "cần có lý do để cấm người dùng",
+	"reason cannot exceed 500 characters":          "lý do không được vượt quá 500 ký tự",
+	"justification cannot be empty":                "lời giải thích không được để trống",
+	"justification cannot exceed 1000 characters":  "lời giải thích không được vượt quá 1000 ký tự",
+	"role name cannot be empty":                    "tên vai trò không được để trống",
+	"role name cannot exceed 100 characters":       "tên vai trò không được vượt quá 100 ký tự",
+	"display name cannot be empty":                 "tên hiển thị không được để trống",
+	"display name cannot exceed 255 characters":    "tên hiển thị không được vượt quá 255 ký tự",
+	"description cannot exceed 500 characters":     "mô tả không được vượt quá 500 ký tự",
+	"file is empty":                                "tệp trống",
+	"file must be a JPEG, PNG or GIF image":        "tệp phải là ảnh JPEG, PNG hoặc GIF",
+	"image could not be decoded":                   "không thể đọc ảnh",
+	"image dimensions are too large":               "kích thước ảnh quá lớn",
+	"is not a supported locale":                    "không phải ngôn ngữ được hỗ trợ",
+	"is not a known time zone":                     "không phải múi giờ hợp lệ",
+	"must be one of iso, dmy or mdy":               "phải là một trong iso, dmy hoặc mdy",
+
+	// Notification mails.
+	"Confirm your new email address": "Xác nhận địa chỉ email mới của bạn",
+	"Hello {name},\n\nConfirm that you want to use this address for your account by opening the link below before {expires}:\n\n{link}\n\nIf you did not ask for this, ignore this message.\n": "Xin chào {name},\n\nHãy mở liên kết dưới đây trước {expires} để xác nhận bạn muốn dùng địa chỉ này cho tài khoản của mình:\n\n{link}\n\nNếu bạn không yêu cầu thay đổi này, hãy bỏ qua email này.\n",
+	"Your email address is being changed": "Địa chỉ email của bạn đang được thay đổi",
+	"Hello {name},\n\nA change of your account email to {new_email} was requested. It takes effect once confirmed from the new address.\n\nIf you did not ask for this, cancel the change and change your password:\n\n{link}\n": "Xin chào {name},\n\nCó yêu cầu đổi email tài khoản của bạn sang {new_email}. Thay đổi chỉ có hiệu lực sau khi được xác nhận từ địa chỉ mới.\n\nNếu bạn không yêu cầu thay đổi này, hãy hủy yêu cầu và đổi mật khẩu:\n\n{link}\n",
+	"Your email address was changed": "Địa chỉ email của bạn đã được thay đổi",
+	"Hello {name},\n\nAn administrator changed the email address of your account to {new_email}. Sign in with the new address from now on.\n\nIf you did not expect this, contact your administrator.\n": "Xin chào {name},\n\nQuản trị viên đã đổi địa chỉ email của tài khoản bạn sang {new_email}. Từ nay hãy đăng nhập bằng địa chỉ mới.\n\nNếu bạn không mong đợi thay đổi này, hãy liên hệ quản trị viên.\n",
+	"You have been invited to create an account": "Bạn được mời tạo tài khoản",
+	"Hello,\n\nYou have been invited to create an account. Open the link below before {expires} to choose your name and password:\n\n{link}\n\nThe link can only be used once. If you were not expecting this invitation, ignore this message.\n": "Xin chào,\n\nBạn được mời tạo tài khoản. Hãy mở liên kết dưới đây trước {expires} để chọn tên và mật khẩu:\n\n{link}\n\nLiên kết chỉ dùng được một lần. Nếu bạn không mong đợi lời mời này, hãy bỏ qua email này.\n",
+}
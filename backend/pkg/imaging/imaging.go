@@ -0,0 +1,147 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	// Registers the GIF decoder with image.Decode.
+	_ "image/gif"
+)
+
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatGIF  Format = "gif"
+)
+
+// MaxPixels caps the decoded size of an image so that a small, highly
+// compressed upload cannot exhaust memory.
+const MaxPixels = 40_000_000
+
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+func (f Format) Extension() string {
+	if f == FormatJPEG {
+		return "jpg"
+	}
+	return string(f)
+}
+
+// Detect sniffs the format from the content itself; file names and declared
+// content types are not trusted.
+func Detect(data []byte) (Format, error) {
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		return FormatJPEG, nil
+	case "image/png":
+		return FormatPNG, nil
+	case "image/gif":
+		return FormatGIF, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Decode detects the format, checks the dimensions against MaxPixels and
+// decodes the first frame.
+func Decode(data []byte) (image.Image, Format, error) {
+	format, err := Detect(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("read image header: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return nil, "", ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("decode image: %w", err)
+	}
+
+	return img, format, nil
+}
+
+// Square crops the centred square of src and scales it to size×size. Each
+// destination pixel averages the source pixels it covers, so downscaling does
+// not alias; upscaling repeats the nearest pixel.
+func Square(src image.Image, size int) *image.NRGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	originX := bounds.Min.X + (bounds.Dx()-side)/2
+	originY := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	if side == 0 {
+		return dst
+	}
+
+	for dy := 0; dy < size; dy++ {
+		sy0 := originY + dy*side/size
+		sy1 := max(originY+(dy+1)*side/size, sy0+1)
+		for dx := 0; dx < size; dx++ {
+			sx0 := originX + dx*side/size
+			sx1 := max(originX+(dx+1)*side/size, sx0+1)
+
+			var r, g, b, a, count uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					count++
+				}
+			}
+
+			dst.Set(dx, dy, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+
+	return dst
+}
+
+// Encode writes img as JPEG or PNG. Other formats are read-only.
+func Encode(img image.Image, format Format) ([]byte, error) {
+	var buffer bytes.Buffer
+	switch format {
+	case FormatJPEG:
+		if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, fmt.Errorf("encode jpeg: %w", err)
+		}
+	case FormatPNG:
+		if err := png.Encode(&buffer, img); err != nil {
+			return nil, fmt.Errorf("encode png: %w", err)
+		}
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stripedImage(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			switch {
+			case x < width/3:
+				img.Set(x, y, color.NRGBA{R: 255, A: 255})
+			case x < 2*width/3:
+				img.Set(x, y, color.NRGBA{G: 255, A: 255})
+			default:
+				img.Set(x, y, color.NRGBA{B: 255, A: 255})
+			}
+		}
+	}
+	return img
+}
+
+func encodeWith(t *testing.T, encode func(*bytes.Buffer) error) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	require.NoError(t, encode(&buffer))
+	return buffer.Bytes()
+}
+
+func TestDetect(t *testing.T) {
+	img := stripedImage(4, 4)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected Format
+		err      error
+	}{
+		{"png", encodeWith(t, func(b *bytes.Buffer) error { return png.Encode(b, img) }), FormatPNG, nil},
+		{"jpeg", encodeWith(t, func(b *bytes.Buffer) error { return jpeg.Encode(b, img, nil) }), FormatJPEG, nil},
+		{"gif", encodeWith(t, func(b *bytes.Buffer) error { return gif.Encode(b, img, nil) }), FormatGIF, nil},
+		{"svg is not accepted", []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`), "", ErrUnsupportedFormat},
+		{"text", []byte("not an image"), "", ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := Detect(tt.data)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestDecode_RejectsOversizedDimensions(t *testing.T) {
+	data := encodeWith(t, func(b *bytes.Buffer) error { return png.Encode(b, image.NewGray(image.Rect(0, 0, 1, 1))) })
+
+	// Rewrite the IHDR chunk to claim 100000×100000 pixels.
+	const ihdrData = 16
+	binary.BigEndian.PutUint32(data[ihdrData:], 100000)
+	binary.BigEndian.PutUint32(data[ihdrData+4:], 100000)
+	binary.BigEndian.PutUint32(data[ihdrData+13:], crc32.ChecksumIEEE(data[ihdrData-4:ihdrData+13]))
+
+	_, _, err := Decode(data)
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestSquare(t *testing.T) {
+	t.Run("crops the centre of a wide image", func(t *testing.T) {
+		result := Square(stripedImage(300, 100), 10)
+
+		assert.Equal(t, image.Rect(0, 0, 10, 10), result.Bounds())
+		assert.Equal(t, color.NRGBA{G: 255, A: 255}, result.NRGBAAt(0, 0))
+		assert.Equal(t, color.NRGBA{G: 255, A: 255}, result.NRGBAAt(9, 9))
+	})
+
+	t.Run("averages source pixels when downscaling", func(t *testing.T) {
+		result := Square(stripedImage(3, 3), 1)
+
+		pixel := result.NRGBAAt(0, 0)
+		assert.InDelta(t, 85, pixel.R, 1)
+		assert.InDelta(t, 85, pixel.G, 1)
+		assert.InDelta(t, 85, pixel.B, 1)
+	})
+
+	t.Run("upscales small images", func(t *testing.T) {
+		result := Square(stripedImage(3, 3), 9)
+
+		assert.Equal(t, color.NRGBA{R: 255, A: 255}, result.NRGBAAt(0, 4))
+		assert.Equal(t, color.NRGBA{B: 255, A: 255}, result.NRGBAAt(8, 4))
+	})
+}
+
+func TestEncode(t *testing.T) {
+	img := Square(stripedImage(30, 30), 16)
+
+	for _, format := range []Format{FormatJPEG, FormatPNG} {
+		data, err := Encode(img, format)
+		require.NoError(t, err)
+
+		decoded, decodedFormat, err := Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, format, decodedFormat)
+		assert.Equal(t, img.Bounds(), decoded.Bounds())
+	}
+
+	_, err := Encode(img, FormatGIF)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
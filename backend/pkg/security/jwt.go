@@ -32,12 +32,19 @@ func NewJWTTokenGenerator(config JWTConfig) auth.TokenGenerator {
 
 type jwtClaims struct {
 	jwt.RegisteredClaims
-	Email       string   `json:"email"`
-	Roles       []string `json:"roles"`
-	Permissions []string `json:"permissions"`
+	Email          string   `json:"email"`
+	OrganizationID string   `json:"org_id,omitempty"`
+	Roles          []string `json:"roles"`
+	Permissions    []string `json:"permissions"`
+	Denied         []string `json:"denied_permissions,omitempty"`
+	Locale         string   `json:"locale,omitempty"`
 }
 
-func (generator *jwtTokenGenerator) GenerateAccessToken(userID uuid.UUID, email string, roles []string, permissions []string) (auth.AccessToken, error) {
+func (generator *jwtTokenGenerator) GenerateAccessToken(userID uuid.UUID, email string, roles []string, permissions []string, deniedPermissions []string) (auth.AccessToken, error) {
+	return generator.GenerateOrganizationAccessToken(userID, email, nil, roles, permissions, deniedPermissions, "")
+}
+
+func (generator *jwtTokenGenerator) GenerateOrganizationAccessToken(userID uuid.UUID, email string, organizationID *uuid.UUID, roles []string, permissions []string, deniedPermissions []string, locale string) (auth.AccessToken, error) {
 	now := time.Now().UTC()
 	expiresAt := now.Add(generator.config.AccessTokenTTL)
 	tokenID := uuid.New().String()
@@ -55,6 +62,11 @@ func (generator *jwtTokenGenerator) GenerateAccessToken(userID uuid.UUID, email
 		Email:       email,
 		Roles:       roles,
 		Permissions: permissions,
+		Denied:      deniedPermissions,
+		Locale:      locale,
+	}
+	if organizationID != nil {
+		claims.OrganizationID = organizationID.String()
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -114,16 +126,28 @@ func (generator *jwtTokenGenerator) ParseAccessToken(tokenString string) (*auth.
 		audience = claims.Audience[0]
 	}
 
+	var organizationID *uuid.UUID
+	if claims.OrganizationID != "" {
+		parsedOrganizationID, err := uuid.Parse(claims.OrganizationID)
+		if err != nil {
+			return nil, auth.ErrTokenInvalid
+		}
+		organizationID = &parsedOrganizationID
+	}
+
 	return &auth.Claims{
-		UserID:      userID,
-		Email:       claims.Email,
-		Roles:       claims.Roles,
-		Permissions: claims.Permissions,
-		TokenID:     claims.ID,
-		IssuedAt:    issuedAt,
-		ExpiresAt:   expiresAt,
-		Issuer:      claims.Issuer,
-		Audience:    audience,
+		UserID:            userID,
+		Email:             claims.Email,
+		OrganizationID:    organizationID,
+		Roles:             claims.Roles,
+		Permissions:       claims.Permissions,
+		DeniedPermissions: claims.Denied,
+		Locale:            claims.Locale,
+		TokenID:           claims.ID,
+		IssuedAt:          issuedAt,
+		ExpiresAt:         expiresAt,
+		Issuer:            claims.Issuer,
+		Audience:          audience,
 	}, nil
 }
 
@@ -27,7 +27,7 @@ func TestJWTTokenGenerator_GenerateAccessToken(t *testing.T) {
 	roles := []string{"admin", "user"}
 	permissions := []string{"users:read", "users:create"}
 
-	accessToken, err := generator.GenerateAccessToken(userID, email, roles, permissions)
+	accessToken, err := generator.GenerateAccessToken(userID, email, roles, permissions, nil)
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, accessToken.Token())
@@ -50,7 +50,7 @@ func TestJWTTokenGenerator_ParseAccessToken(t *testing.T) {
 	roles := []string{"admin", "user"}
 	permissions := []string{"users:read", "users:create"}
 
-	accessToken, err := generator.GenerateAccessToken(userID, email, roles, permissions)
+	accessToken, err := generator.GenerateAccessToken(userID, email, roles, permissions, nil)
 	require.NoError(t, err)
 
 	claims, err := generator.ParseAccessToken(accessToken.Token())
@@ -77,7 +77,7 @@ func TestJWTTokenGenerator_ExpiredToken(t *testing.T) {
 	generator := NewJWTTokenGenerator(config)
 
 	userID := uuid.New()
-	accessToken, err := generator.GenerateAccessToken(userID, "test@example.com", nil, nil)
+	accessToken, err := generator.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
 	require.NoError(t, err)
 
 	_, err = generator.ParseAccessToken(accessToken.Token())
@@ -143,7 +143,7 @@ func TestJWTTokenGenerator_WrongSecret(t *testing.T) {
 	generator2 := NewJWTTokenGenerator(config2)
 
 	userID := uuid.New()
-	accessToken, err := generator1.GenerateAccessToken(userID, "test@example.com", nil, nil)
+	accessToken, err := generator1.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
 	require.NoError(t, err)
 
 	_, err = generator2.ParseAccessToken(accessToken.Token())
@@ -208,8 +208,8 @@ func TestJWTTokenGenerator_TokenContainsUniqueID(t *testing.T) {
 
 	userID := uuid.New()
 
-	token1, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil)
-	token2, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil)
+	token1, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
+	token2, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
 
 	claims1, _ := generator.ParseAccessToken(token1.Token())
 	claims2, _ := generator.ParseAccessToken(token2.Token())
@@ -229,7 +229,7 @@ func TestJWTTokenGenerator_ClaimsExpiration(t *testing.T) {
 	generator := NewJWTTokenGenerator(config)
 
 	userID := uuid.New()
-	accessToken, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil)
+	accessToken, _ := generator.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
 	claims, _ := generator.ParseAccessToken(accessToken.Token())
 
 	assert.False(t, claims.IsExpired())
@@ -237,3 +237,80 @@ func TestJWTTokenGenerator_ClaimsExpiration(t *testing.T) {
 	expectedExpiration := time.Now().UTC().Add(15 * time.Minute)
 	assert.WithinDuration(t, expectedExpiration, claims.ExpiresAt, 2*time.Second)
 }
+
+func TestJWTTokenGenerator_GenerateOrganizationAccessToken(t *testing.T) {
+	config := JWTConfig{
+		SecretKey:       "test-secret-key-that-is-long-enough",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+		Issuer:          "test-issuer",
+		Audience:        "test-audience",
+	}
+	generator := NewJWTTokenGenerator(config)
+
+	userID := uuid.New()
+	organizationID := uuid.New()
+
+	accessToken, err := generator.GenerateOrganizationAccessToken(userID, "test@example.com", &organizationID, []string{"user"}, nil, nil, "")
+	require.NoError(t, err)
+
+	claims, err := generator.ParseAccessToken(accessToken.Token())
+	require.NoError(t, err)
+	require.NotNil(t, claims.OrganizationID)
+	assert.Equal(t, organizationID, *claims.OrganizationID)
+	assert.True(t, claims.HasOrganization())
+
+	globalToken, err := generator.GenerateAccessToken(userID, "test@example.com", nil, nil, nil)
+	require.NoError(t, err)
+
+	globalClaims, err := generator.ParseAccessToken(globalToken.Token())
+	require.NoError(t, err)
+	assert.Nil(t, globalClaims.OrganizationID)
+	assert.False(t, globalClaims.HasOrganization())
+}
+
+func TestJWTTokenGenerator_DeniedPermissions(t *testing.T) {
+	config := JWTConfig{
+		SecretKey:       "test-secret-key-that-is-long-enough",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+		Issuer:          "test-issuer",
+		Audience:        "test-audience",
+	}
+	generator := NewJWTTokenGenerator(config)
+
+	accessToken, err := generator.GenerateAccessToken(uuid.New(), "test@example.com", []string{"support"}, []string{"users:read", "users:delete"}, []string{"users:delete"})
+	require.NoError(t, err)
+
+	claims, err := generator.ParseAccessToken(accessToken.Token())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"users:delete"}, claims.DeniedPermissions)
+	assert.True(t, claims.HasPermission("users:read"))
+	assert.False(t, claims.HasPermission("users:delete"))
+	assert.False(t, claims.HasAllPermissions("users:read", "users:delete"))
+}
+
+func TestJWTTokenGenerator_Locale(t *testing.T) {
+	config := JWTConfig{
+		SecretKey:       "test-secret-key-that-is-long-enough",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+		Issuer:          "test-issuer",
+		Audience:        "test-audience",
+	}
+	generator := NewJWTTokenGenerator(config)
+
+	accessToken, err := generator.GenerateOrganizationAccessToken(uuid.New(), "test@example.com", nil, []string{"user"}, nil, nil, "vi")
+	require.NoError(t, err)
+
+	claims, err := generator.ParseAccessToken(accessToken.Token())
+	require.NoError(t, err)
+	assert.Equal(t, "vi", claims.Locale)
+
+	globalToken, err := generator.GenerateAccessToken(uuid.New(), "test@example.com", nil, nil, nil)
+	require.NoError(t, err)
+
+	globalClaims, err := generator.ParseAccessToken(globalToken.Token())
+	require.NoError(t, err)
+	assert.Empty(t, globalClaims.Locale)
+}
@@ -3,12 +3,16 @@ package security
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
-const passwordResetKeyPrefix = "password_reset:"
+const (
+	passwordResetKeyPrefix      = "password_reset:"
+	passwordResetEmailKeyPrefix = "password_reset_email:"
+)
 
 type RedisPasswordResetTokenStore struct {
 	client *redis.Client
@@ -25,8 +29,14 @@ func (store *RedisPasswordResetTokenStore) Store(ctx context.Context, email stri
 		return fmt.Errorf("expiration time must be in the future")
 	}
 
-	err := store.client.Set(ctx, key, email, ttl).Err()
-	if err != nil {
+	// The per-email set lets erasure find every outstanding token for an
+	// address; it lives as long as the newest token.
+	emailKey := store.buildEmailKey(email)
+	pipeline := store.client.TxPipeline()
+	pipeline.Set(ctx, key, email, ttl)
+	pipeline.SAdd(ctx, emailKey, tokenHash)
+	pipeline.Expire(ctx, emailKey, ttl)
+	if _, err := pipeline.Exec(ctx); err != nil {
 		return fmt.Errorf("failed to store password reset token: %w", err)
 	}
 
@@ -58,6 +68,40 @@ func (store *RedisPasswordResetTokenStore) Delete(ctx context.Context, tokenHash
 	return nil
 }
 
+// DeleteAllForEmail removes every outstanding reset token issued to email and
+// returns how many were still live.
+func (store *RedisPasswordResetTokenStore) DeleteAllForEmail(ctx context.Context, email string) (int64, error) {
+	emailKey := store.buildEmailKey(email)
+
+	tokenHashes, err := store.client.SMembers(ctx, emailKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list password reset tokens: %w", err)
+	}
+
+	keys := make([]string, 0, len(tokenHashes))
+	for _, tokenHash := range tokenHashes {
+		keys = append(keys, store.buildKey(tokenHash))
+	}
+
+	var deleted int64
+	if len(keys) > 0 {
+		deleted, err = store.client.Del(ctx, keys...).Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete password reset tokens: %w", err)
+		}
+	}
+
+	if err := store.client.Del(ctx, emailKey).Err(); err != nil {
+		return deleted, fmt.Errorf("failed to delete password reset token index: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func (store *RedisPasswordResetTokenStore) buildEmailKey(email string) string {
+	return passwordResetEmailKeyPrefix + strings.ToLower(email)
+}
+
 func (store *RedisPasswordResetTokenStore) buildKey(tokenHash string) string {
 	return passwordResetKeyPrefix + tokenHash
 }
@@ -17,7 +17,7 @@ type IntegrationTestSuite struct {
 	CleanupFunctions []func()
 }
 
-func NewIntegrationTestSuite(t *testing.T) *IntegrationTestSuite {
+func NewIntegrationTestSuite(t testing.TB) *IntegrationTestSuite {
 	t.Helper()
 
 	if os.Getenv("INTEGRATION_TEST") != "true" {
@@ -77,7 +77,7 @@ func buildDatabaseURLFromEnv() string {
 	)
 }
 
-func (suite *IntegrationTestSuite) Cleanup(t *testing.T) {
+func (suite *IntegrationTestSuite) Cleanup(t testing.TB) {
 	t.Helper()
 
 	for i := len(suite.CleanupFunctions) - 1; i >= 0; i-- {
@@ -93,7 +93,7 @@ func (suite *IntegrationTestSuite) AddCleanup(cleanupFunction func()) {
 	suite.CleanupFunctions = append(suite.CleanupFunctions, cleanupFunction)
 }
 
-func (suite *IntegrationTestSuite) TruncateTables(t *testing.T, tables ...string) {
+func (suite *IntegrationTestSuite) TruncateTables(t testing.TB, tables ...string) {
 	t.Helper()
 
 	ctx := context.Background()
@@ -107,7 +107,7 @@ func (suite *IntegrationTestSuite) TruncateTables(t *testing.T, tables ...string
 	}
 }
 
-func (suite *IntegrationTestSuite) CleanAllTables(t *testing.T) {
+func (suite *IntegrationTestSuite) CleanAllTables(t testing.TB) {
 	t.Helper()
 
 	tables := []string{
@@ -124,7 +124,7 @@ func (suite *IntegrationTestSuite) CleanAllTables(t *testing.T) {
 	suite.TruncateTables(t, tables...)
 }
 
-func (suite *IntegrationTestSuite) ExecuteSQL(t *testing.T, query string, args ...interface{}) sql.Result {
+func (suite *IntegrationTestSuite) ExecuteSQL(t testing.TB, query string, args ...interface{}) sql.Result {
 	t.Helper()
 
 	ctx := context.Background()
@@ -148,7 +148,7 @@ func (result *pgxCommandTagResult) RowsAffected() (int64, error) {
 	return result.commandTag.RowsAffected(), nil
 }
 
-func (suite *IntegrationTestSuite) WithTransaction(t *testing.T, testFunction func(ctx context.Context)) {
+func (suite *IntegrationTestSuite) WithTransaction(t testing.TB, testFunction func(ctx context.Context)) {
 	t.Helper()
 
 	ctx := context.Background()
@@ -169,7 +169,7 @@ func (suite *IntegrationTestSuite) WithTransaction(t *testing.T, testFunction fu
 
 type transactionContextKey struct{}
 
-func (suite *IntegrationTestSuite) SeedTestData(t *testing.T, seedFunction func(pool *pgxpool.Pool) error) {
+func (suite *IntegrationTestSuite) SeedTestData(t testing.TB, seedFunction func(pool *pgxpool.Pool) error) {
 	t.Helper()
 
 	if err := seedFunction(suite.DatabasePool); err != nil {
@@ -177,7 +177,7 @@ func (suite *IntegrationTestSuite) SeedTestData(t *testing.T, seedFunction func(
 	}
 }
 
-func SetupIntegrationTest(t *testing.T) (*IntegrationTestSuite, func()) {
+func SetupIntegrationTest(t testing.TB) (*IntegrationTestSuite, func()) {
 	t.Helper()
 
 	suite := NewIntegrationTestSuite(t)
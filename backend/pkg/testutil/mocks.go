@@ -3,22 +3,36 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessrequest"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/accessreview"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/auditlog"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/auth"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/bulkoperation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/group"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/invitation"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/organization"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/permission"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/role"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/shared"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/sod"
 	"github.com/tranvuongduy2003/go-copilot/internal/domain/user"
+	"github.com/tranvuongduy2003/go-copilot/internal/domain/userattribute"
 	"github.com/tranvuongduy2003/go-copilot/pkg/logger"
 )
 
 type MockUserRepository struct {
-	Users       map[uuid.UUID]*user.User
-	EmailIndex  map[string]*user.User
-	CreateError error
+	Users               map[uuid.UUID]*user.User
+	EmailIndex          map[string]*user.User
+	OrganizationMembers map[uuid.UUID]map[uuid.UUID]bool
+	CreateError         error
 	UpdateError error
 	DeleteError error
 	FindError   error
@@ -27,8 +41,9 @@ type MockUserRepository struct {
 
 func NewMockUserRepository() *MockUserRepository {
 	return &MockUserRepository{
-		Users:      make(map[uuid.UUID]*user.User),
-		EmailIndex: make(map[string]*user.User),
+		Users:               make(map[uuid.UUID]*user.User),
+		EmailIndex:          make(map[string]*user.User),
+		OrganizationMembers: make(map[uuid.UUID]map[uuid.UUID]bool),
 	}
 }
 
@@ -51,6 +66,12 @@ func (m *MockUserRepository) Update(ctx context.Context, u *user.User) error {
 	if _, exists := m.Users[u.ID()]; !exists {
 		return user.NewUserNotFoundError(u.ID().String())
 	}
+	for email, indexed := range m.EmailIndex {
+		if indexed.ID() == u.ID() && email != u.Email().String() {
+			delete(m.EmailIndex, email)
+		}
+	}
+	u.AdvanceVersion()
 	m.Users[u.ID()] = u
 	m.EmailIndex[u.Email().String()] = u
 	return nil
@@ -60,84 +81,1463 @@ func (m *MockUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.DeleteError != nil {
 		return m.DeleteError
 	}
-	u, exists := m.Users[id]
-	if !exists {
-		return user.NewUserNotFoundError(id.String())
+	u, exists := m.Users[id]
+	if !exists {
+		return user.NewUserNotFoundError(id.String())
+	}
+	delete(m.Users, id)
+	delete(m.EmailIndex, u.Email().String())
+	return nil
+}
+
+func (m *MockUserRepository) Restore(ctx context.Context, u *user.User) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, exists := m.Users[u.ID()]; !exists {
+		return user.NewUserNotFoundError(u.ID().String())
+	}
+	for _, other := range m.Users {
+		if other.ID() != u.ID() && !other.IsDeleted() && other.Email().String() == u.Email().String() {
+			return user.NewEmailAlreadyExistsError(u.Email().String())
+		}
+	}
+	u.AdvanceVersion()
+	m.Users[u.ID()] = u
+	m.EmailIndex[u.Email().String()] = u
+	return nil
+}
+
+func (m *MockUserRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	u, exists := m.Users[id]
+	if !exists || !u.IsDeleted() {
+		return user.NewUserNotFoundError(id.String())
+	}
+	delete(m.Users, id)
+	if m.EmailIndex[u.Email().String()] == u {
+		delete(m.EmailIndex, u.Email().String())
+	}
+	return nil
+}
+
+func (m *MockUserRepository) FindSuspensionsEndedBy(ctx context.Context, now time.Time, limit int) ([]*user.User, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*user.User, 0)
+	for _, u := range m.Users {
+		if u.Status().IsSuspended() && !u.IsDeleted() && !u.SuspendedUntil().After(now) {
+			result = append(result, u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SuspendedUntil().Before(*result[j].SuspendedUntil())
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *MockUserRepository) FindDeletedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*user.User, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*user.User, 0)
+	for _, u := range m.Users {
+		if u.IsDeleted() && u.DeletedAt().Before(cutoff) {
+			result = append(result, u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].DeletedAt().Before(*result[j].DeletedAt())
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	u, exists := m.Users[id]
+	if !exists {
+		return nil, user.NewUserNotFoundError(id.String())
+	}
+	return u, nil
+}
+
+func (m *MockUserRepository) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return m.FindByID(ctx, id)
+}
+
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	u, exists := m.EmailIndex[email]
+	if !exists {
+		return nil, user.NewUserNotFoundError(email)
+	}
+	return u, nil
+}
+
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	_, exists := m.EmailIndex[email]
+	return exists, nil
+}
+
+func (m *MockUserRepository) List(ctx context.Context, filter user.Filter, pagination shared.Pagination) ([]*user.User, int64, error) {
+	if m.ListError != nil {
+		return nil, 0, m.ListError
+	}
+
+	result := m.matchingUsers(filter)
+
+	total := int64(len(result))
+
+	offset := pagination.Offset()
+	limit := pagination.Limit()
+
+	if offset >= int(total) {
+		return []*user.User{}, total, nil
+	}
+
+	end := offset + limit
+	if end > int(total) {
+		end = int(total)
+	}
+
+	return result[offset:end], total, nil
+}
+
+// ListByCursor pages through matching users newest first.
+func (m *MockUserRepository) ListByCursor(ctx context.Context, filter user.Filter, page shared.CursorPage) (shared.CursorResult[*user.User], error) {
+	if m.ListError != nil {
+		return shared.CursorResult[*user.User]{}, m.ListError
+	}
+
+	matching := m.matchingUsers(filter)
+	slices.SortFunc(matching, func(a, b *user.User) int {
+		if c := b.CreatedAt().Compare(a.CreatedAt()); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID().String(), a.ID().String())
+	})
+
+	return mockCursorPage(matching, page, (*user.User).ID), nil
+}
+
+// mockCursorPage pages through items in their given order. Its cursors carry
+// the boundary item's id as the last key, like the real keysets do.
+func mockCursorPage[T any](items []T, page shared.CursorPage, id func(T) uuid.UUID) shared.CursorResult[T] {
+	start, end := 0, len(items)
+	if cursor := page.Cursor(); cursor != nil {
+		boundary := cursor.Values[len(cursor.Values)-1]
+		index := slices.IndexFunc(items, func(item T) bool { return boundary != nil && id(item).String() == *boundary })
+		if cursor.Backward {
+			end = max(index, 0)
+			start = max(end-page.Limit(), 0)
+		} else {
+			start = index + 1
+		}
+	}
+	end = min(end, start+page.Limit())
+
+	result := shared.CursorResult[T]{Items: items[start:end], Limit: page.Limit()}
+	if end < len(items) && end > start {
+		result.NextCursor = mockCursor(id(items[end-1]), false)
+	}
+	if start > 0 && end > start {
+		result.PrevCursor = mockCursor(id(items[start]), true)
+	}
+	if page.WithTotal() {
+		total := int64(len(items))
+		result.Total = &total
+	}
+	return result
+}
+
+func mockCursor(id uuid.UUID, backward bool) string {
+	value := id.String()
+	return shared.EncodeCursor(shared.Cursor{Sort: "mock", Values: []*string{&value}, Backward: backward})
+}
+
+func (m *MockUserRepository) matchingUsers(filter user.Filter) []*user.User {
+	result := make([]*user.User, 0)
+	for _, u := range m.Users {
+		if (filter.Deleted == user.DeletedScopeExclude && u.IsDeleted()) ||
+			(filter.Deleted == user.DeletedScopeOnly && !u.IsDeleted()) {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, u.Status()) {
+			continue
+		}
+		if len(filter.RoleIDs) > 0 && !slices.ContainsFunc(filter.RoleIDs, u.HasRole) {
+			continue
+		}
+		if filter.Search != nil && !matchesSearch(u, *filter.Search) {
+			continue
+		}
+		if filter.NeverLoggedIn && u.LastLoginAt() != nil {
+			continue
+		}
+		if filter.OrganizationID != nil && !m.OrganizationMembers[*filter.OrganizationID][u.ID()] {
+			continue
+		}
+		if !matchesAttributes(u.Attributes(), filter.Attributes) {
+			continue
+		}
+		result = append(result, u)
+	}
+	return result
+}
+
+func matchesSearch(u *user.User, search string) bool {
+	search = strings.ToLower(strings.TrimSpace(search))
+	return strings.Contains(strings.ToLower(u.Email().String()), search) ||
+		strings.Contains(strings.ToLower(u.FullName().String()), search)
+}
+
+func (m *MockUserRepository) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	u, exists := m.Users[id]
+	if !exists || u.IsDeleted() {
+		return user.NewUserNotFoundError(id.String())
+	}
+	u.RecordLogin(at)
+	return nil
+}
+
+func matchesAttributes(attributes, want map[string]any) bool {
+	for key, value := range want {
+		if attributes[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MockUserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) ([]*user.User, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*user.User, 0)
+	for _, u := range m.Users {
+		for _, rid := range u.RoleIDs() {
+			if rid == roleID {
+				result = append(result, u)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockUserRepository) AddUser(u *user.User) {
+	m.Users[u.ID()] = u
+	m.EmailIndex[u.Email().String()] = u
+}
+
+func (m *MockUserRepository) AddOrganizationMember(organizationID, userID uuid.UUID) {
+	if m.OrganizationMembers[organizationID] == nil {
+		m.OrganizationMembers[organizationID] = make(map[uuid.UUID]bool)
+	}
+	m.OrganizationMembers[organizationID][userID] = true
+}
+
+type MockPasswordHasher struct {
+	HashResult   string
+	HashError    error
+	VerifyResult bool
+	VerifyError  error
+}
+
+func NewMockPasswordHasher() *MockPasswordHasher {
+	return &MockPasswordHasher{
+		HashResult:   "$2a$10$hashedpassword",
+		VerifyResult: true,
+	}
+}
+
+func (m *MockPasswordHasher) Hash(password string) (string, error) {
+	if m.HashError != nil {
+		return "", m.HashError
+	}
+	return m.HashResult, nil
+}
+
+func (m *MockPasswordHasher) Compare(hashedPassword, password string) error {
+	if m.VerifyError != nil {
+		return m.VerifyError
+	}
+	if !m.VerifyResult {
+		return fmt.Errorf("password mismatch")
+	}
+	return nil
+}
+
+func (m *MockPasswordHasher) Verify(hashedPassword, plainPassword string) (bool, error) {
+	if m.VerifyError != nil {
+		return false, m.VerifyError
+	}
+	return m.VerifyResult, nil
+}
+
+type MockEventBus struct {
+	PublishedEvents []shared.DomainEvent
+	PublishError    error
+	Handlers        map[string][]shared.EventHandler
+}
+
+func NewMockEventBus() *MockEventBus {
+	return &MockEventBus{
+		PublishedEvents: make([]shared.DomainEvent, 0),
+		Handlers:        make(map[string][]shared.EventHandler),
+	}
+}
+
+func (m *MockEventBus) Publish(ctx context.Context, events ...shared.DomainEvent) error {
+	if m.PublishError != nil {
+		return m.PublishError
+	}
+	m.PublishedEvents = append(m.PublishedEvents, events...)
+	return nil
+}
+
+func (m *MockEventBus) Subscribe(eventType string, handler shared.EventHandler) {
+	m.Handlers[eventType] = append(m.Handlers[eventType], handler)
+}
+
+func (m *MockEventBus) Unsubscribe(eventType string, handler shared.EventHandler) {
+}
+
+type MockBlobStore struct {
+	mu          sync.Mutex
+	Objects     map[string][]byte
+	PutError    error
+	DeleteError error
+}
+
+func NewMockBlobStore() *MockBlobStore {
+	return &MockBlobStore{Objects: make(map[string][]byte)}
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.PutError != nil {
+		return m.PutError
+	}
+	m.Objects[key] = append([]byte(nil), content...)
+	return nil
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	delete(m.Objects, key)
+	return nil
+}
+
+func (m *MockBlobStore) URL(key string) string {
+	return "https://blobs.test/" + key
+}
+
+type MockAuditTrail struct {
+	mu                sync.Mutex
+	Records           map[uuid.UUID][]user.AuditRecord
+	Identifiers       []string
+	PseudonymizeError error
+}
+
+func NewMockAuditTrail() *MockAuditTrail {
+	return &MockAuditTrail{Records: make(map[uuid.UUID][]user.AuditRecord)}
+}
+
+func (m *MockAuditTrail) FindByUser(ctx context.Context, userID uuid.UUID) ([]user.AuditRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]user.AuditRecord(nil), m.Records[userID]...), nil
+}
+
+func (m *MockAuditTrail) Pseudonymize(ctx context.Context, userID uuid.UUID, identifiers []string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.PseudonymizeError != nil {
+		return 0, m.PseudonymizeError
+	}
+	m.Identifiers = append([]string(nil), identifiers...)
+	records := m.Records[userID]
+	for i := range records {
+		records[i].IPAddress = ""
+		records[i].UserAgent = ""
+	}
+	return int64(len(records)), nil
+}
+
+type MockAuditLogRepository struct {
+	Entries    []*auditlog.Entry
+	LastFilter auditlog.Filter
+	ListError  error
+}
+
+func NewMockAuditLogRepository() *MockAuditLogRepository {
+	return &MockAuditLogRepository{Entries: make([]*auditlog.Entry, 0)}
+}
+
+func (m *MockAuditLogRepository) ListByCursor(ctx context.Context, filter auditlog.Filter, page shared.CursorPage) (shared.CursorResult[*auditlog.Entry], error) {
+	m.LastFilter = filter
+	if m.ListError != nil {
+		return shared.CursorResult[*auditlog.Entry]{}, m.ListError
+	}
+	entries := make([]*auditlog.Entry, 0)
+	for _, entry := range m.Entries {
+		if filter.ActorID != nil && (entry.ActorID == nil || *entry.ActorID != *filter.ActorID) {
+			continue
+		}
+		if (filter.EventType != "" && entry.EventType != filter.EventType) ||
+			(filter.Action != "" && entry.Action != filter.Action) ||
+			(filter.Success != nil && entry.Success != *filter.Success) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b *auditlog.Entry) int {
+		if c := b.Timestamp.Compare(a.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID.String(), a.ID.String())
+	})
+	return mockCursorPage(entries, page, func(entry *auditlog.Entry) uuid.UUID { return entry.ID }), nil
+}
+
+type MockEmailChangeRepository struct {
+	mu          sync.Mutex
+	Changes     map[uuid.UUID]*user.EmailChange
+	SaveError   error
+	DeleteError error
+}
+
+func NewMockEmailChangeRepository() *MockEmailChangeRepository {
+	return &MockEmailChangeRepository{Changes: make(map[uuid.UUID]*user.EmailChange)}
+}
+
+func (m *MockEmailChangeRepository) Save(ctx context.Context, change *user.EmailChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.SaveError != nil {
+		return m.SaveError
+	}
+	m.Changes[change.UserID()] = change
+	return nil
+}
+
+func (m *MockEmailChangeRepository) FindByConfirmTokenHash(ctx context.Context, tokenHash string) (*user.EmailChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, change := range m.Changes {
+		if change.ConfirmTokenHash() == tokenHash {
+			return change, nil
+		}
+	}
+	return nil, user.ErrInvalidEmailChangeToken
+}
+
+func (m *MockEmailChangeRepository) FindByCancelTokenHash(ctx context.Context, tokenHash string) (*user.EmailChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, change := range m.Changes {
+		if change.CancelTokenHash() == tokenHash {
+			return change, nil
+		}
+	}
+	return nil, user.ErrInvalidEmailChangeToken
+}
+
+func (m *MockEmailChangeRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	delete(m.Changes, userID)
+	return nil
+}
+
+type MockPreferencesRepository struct {
+	mu          sync.Mutex
+	Preferences map[uuid.UUID]*user.Preferences
+	FindError   error
+	SaveError   error
+}
+
+func NewMockPreferencesRepository() *MockPreferencesRepository {
+	return &MockPreferencesRepository{Preferences: make(map[uuid.UUID]*user.Preferences)}
+}
+
+func (m *MockPreferencesRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	if preferences, ok := m.Preferences[userID]; ok {
+		return preferences, nil
+	}
+	return nil, user.ErrPreferencesNotFound
+}
+
+func (m *MockPreferencesRepository) Save(ctx context.Context, preferences *user.Preferences) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.SaveError != nil {
+		return m.SaveError
+	}
+	m.Preferences[preferences.UserID()] = preferences
+	return nil
+}
+
+type MockMailer struct {
+	mu        sync.Mutex
+	Messages  []shared.MailMessage
+	SendError error
+}
+
+func NewMockMailer() *MockMailer {
+	return &MockMailer{}
+}
+
+func (m *MockMailer) Send(ctx context.Context, message shared.MailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.SendError != nil {
+		return m.SendError
+	}
+	m.Messages = append(m.Messages, message)
+	return nil
+}
+
+type MockTransactionManager struct {
+	Transactions int
+	CommitError  error
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Transactions++
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	return m.CommitError
+}
+
+type NoopLogger struct{}
+
+func NewNoopLogger() *NoopLogger {
+	return &NoopLogger{}
+}
+
+func (l *NoopLogger) Debug(msg string, fields ...logger.Field) {}
+func (l *NoopLogger) Info(msg string, fields ...logger.Field)  {}
+func (l *NoopLogger) Warn(msg string, fields ...logger.Field)  {}
+func (l *NoopLogger) Error(msg string, fields ...logger.Field) {}
+func (l *NoopLogger) Fatal(msg string, fields ...logger.Field) {}
+func (l *NoopLogger) With(fields ...logger.Field) logger.Logger {
+	return l
+}
+func (l *NoopLogger) Sync() error {
+	return nil
+}
+
+type MockRoleRepository struct {
+	Roles       map[uuid.UUID]*role.Role
+	NameIndex   map[string]*role.Role
+	CreateError error
+	UpdateError error
+	DeleteError error
+	FindError   error
+}
+
+func NewMockRoleRepository() *MockRoleRepository {
+	return &MockRoleRepository{
+		Roles:     make(map[uuid.UUID]*role.Role),
+		NameIndex: make(map[string]*role.Role),
+	}
+}
+
+func (m *MockRoleRepository) Create(ctx context.Context, r *role.Role) error {
+	if m.CreateError != nil {
+		return m.CreateError
+	}
+	m.Roles[r.ID()] = r
+	m.NameIndex[r.Name()] = r
+	return nil
+}
+
+func (m *MockRoleRepository) Update(ctx context.Context, r *role.Role) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	r.AdvanceVersion()
+	m.Roles[r.ID()] = r
+	return nil
+}
+
+func (m *MockRoleRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	r, exists := m.Roles[id]
+	if !exists {
+		return role.ErrRoleNotFound
+	}
+	if r.Version() != version {
+		return role.NewConcurrentModificationError(id.String())
+	}
+	delete(m.Roles, id)
+	delete(m.NameIndex, r.Name())
+	return nil
+}
+
+func (m *MockRoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	r, exists := m.Roles[id]
+	if !exists {
+		return nil, role.ErrRoleNotFound
+	}
+	return r, nil
+}
+
+func (m *MockRoleRepository) FindByName(ctx context.Context, name string) (*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	r, exists := m.NameIndex[name]
+	if !exists {
+		return nil, role.ErrRoleNotFound
+	}
+	return r, nil
+}
+
+func (m *MockRoleRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*role.Role, 0)
+	for _, id := range ids {
+		if r, exists := m.Roles[id]; exists {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRoleRepository) FindDefault(ctx context.Context) (*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	for _, r := range m.Roles {
+		if r.IsDefault() {
+			return r, nil
+		}
+	}
+	return nil, role.ErrRoleNotFound
+}
+
+func (m *MockRoleRepository) FindAll(ctx context.Context) ([]*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*role.Role, 0)
+	for _, r := range m.Roles {
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func (m *MockRoleRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	_, exists := m.NameIndex[name]
+	return exists, nil
+}
+
+func (m *MockRoleRepository) FindByPermission(ctx context.Context, permissionID uuid.UUID) ([]*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*role.Role, 0)
+	for _, r := range m.Roles {
+		if r.HasPermission(permissionID) {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRoleRepository) FindAllForOrganization(ctx context.Context, organizationID uuid.UUID) ([]*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*role.Role, 0)
+	for _, r := range m.Roles {
+		if r.AppliesTo(&organizationID) {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRoleRepository) FindGlobal(ctx context.Context) ([]*role.Role, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*role.Role, 0)
+	for _, r := range m.Roles {
+		if r.OrganizationID() == nil {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRoleRepository) ListByCursor(ctx context.Context, scope role.Scope, page shared.CursorPage) (shared.CursorResult[*role.Role], error) {
+	if m.FindError != nil {
+		return shared.CursorResult[*role.Role]{}, m.FindError
+	}
+	roles := make([]*role.Role, 0)
+	for _, r := range m.Roles {
+		if scope.Includes(r) {
+			roles = append(roles, r)
+		}
+	}
+	slices.SortFunc(roles, func(a, b *role.Role) int {
+		if a.Priority() != b.Priority() {
+			return b.Priority() - a.Priority()
+		}
+		if c := strings.Compare(a.Name(), b.Name()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID().String(), b.ID().String())
+	})
+	return mockCursorPage(roles, page, (*role.Role).ID), nil
+}
+
+func (m *MockRoleRepository) ExistsByNameInOrganization(ctx context.Context, name string, organizationID uuid.UUID) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	for _, r := range m.Roles {
+		if r.Name() == name && r.OrganizationID() != nil && *r.OrganizationID() == organizationID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockRoleRepository) AddRole(r *role.Role) {
+	m.Roles[r.ID()] = r
+	m.NameIndex[r.Name()] = r
+}
+
+type MockOrganizationRepository struct {
+	Organizations map[uuid.UUID]*organization.Organization
+	SlugIndex     map[string]*organization.Organization
+	Members       map[uuid.UUID]map[uuid.UUID]*organization.Membership
+	CreateError   error
+	UpdateError   error
+	DeleteError   error
+	FindError     error
+}
+
+func NewMockOrganizationRepository() *MockOrganizationRepository {
+	return &MockOrganizationRepository{
+		Organizations: make(map[uuid.UUID]*organization.Organization),
+		SlugIndex:     make(map[string]*organization.Organization),
+		Members:       make(map[uuid.UUID]map[uuid.UUID]*organization.Membership),
+	}
+}
+
+func (m *MockOrganizationRepository) Create(ctx context.Context, o *organization.Organization) error {
+	if m.CreateError != nil {
+		return m.CreateError
+	}
+	if _, exists := m.SlugIndex[o.Slug()]; exists {
+		return organization.NewOrganizationSlugExistsError(o.Slug())
+	}
+	m.Organizations[o.ID()] = o
+	m.SlugIndex[o.Slug()] = o
+	return nil
+}
+
+func (m *MockOrganizationRepository) Update(ctx context.Context, o *organization.Organization) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, exists := m.Organizations[o.ID()]; !exists {
+		return organization.NewOrganizationNotFoundError(o.ID().String())
+	}
+	m.Organizations[o.ID()] = o
+	return nil
+}
+
+func (m *MockOrganizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	o, exists := m.Organizations[id]
+	if !exists {
+		return organization.NewOrganizationNotFoundError(id.String())
+	}
+	delete(m.Organizations, id)
+	delete(m.SlugIndex, o.Slug())
+	delete(m.Members, id)
+	return nil
+}
+
+func (m *MockOrganizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	o, exists := m.Organizations[id]
+	if !exists {
+		return nil, organization.NewOrganizationNotFoundError(id.String())
+	}
+	return o, nil
+}
+
+func (m *MockOrganizationRepository) FindBySlug(ctx context.Context, slug string) (*organization.Organization, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	o, exists := m.SlugIndex[slug]
+	if !exists {
+		return nil, organization.NewOrganizationNotFoundError(slug)
+	}
+	return o, nil
+}
+
+func (m *MockOrganizationRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	_, exists := m.SlugIndex[slug]
+	return exists, nil
+}
+
+func (m *MockOrganizationRepository) List(ctx context.Context, pagination shared.Pagination) ([]*organization.Organization, int64, error) {
+	if m.FindError != nil {
+		return nil, 0, m.FindError
+	}
+	result := make([]*organization.Organization, 0)
+	for _, o := range m.Organizations {
+		result = append(result, o)
+	}
+
+	total := int64(len(result))
+	offset := pagination.Offset()
+	if offset >= int(total) {
+		return []*organization.Organization{}, total, nil
+	}
+	end := offset + pagination.Limit()
+	if end > int(total) {
+		end = int(total)
+	}
+	return result[offset:end], total, nil
+}
+
+func (m *MockOrganizationRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*organization.Organization, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*organization.Organization, 0)
+	for organizationID, members := range m.Members {
+		if _, isMember := members[userID]; isMember {
+			if o, exists := m.Organizations[organizationID]; exists {
+				result = append(result, o)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockOrganizationRepository) AddMember(ctx context.Context, membership *organization.Membership) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if m.Members[membership.OrganizationID()] == nil {
+		m.Members[membership.OrganizationID()] = make(map[uuid.UUID]*organization.Membership)
+	}
+	m.Members[membership.OrganizationID()][membership.UserID()] = membership
+	return nil
+}
+
+func (m *MockOrganizationRepository) RemoveMember(ctx context.Context, organizationID, userID uuid.UUID) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, isMember := m.Members[organizationID][userID]; !isMember {
+		return organization.ErrNotMember
+	}
+	delete(m.Members[organizationID], userID)
+	return nil
+}
+
+func (m *MockOrganizationRepository) IsMember(ctx context.Context, organizationID, userID uuid.UUID) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	_, isMember := m.Members[organizationID][userID]
+	return isMember, nil
+}
+
+func (m *MockOrganizationRepository) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]*organization.Membership, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*organization.Membership, 0)
+	for _, membership := range m.Members[organizationID] {
+		result = append(result, membership)
+	}
+	return result, nil
+}
+
+func (m *MockOrganizationRepository) AddOrganization(o *organization.Organization) {
+	m.Organizations[o.ID()] = o
+	m.SlugIndex[o.Slug()] = o
+}
+
+func (m *MockOrganizationRepository) AddMembership(organizationID, userID uuid.UUID) {
+	membership, _ := organization.NewMembership(organizationID, userID)
+	_ = m.AddMember(context.Background(), membership)
+}
+
+type MockGroupRepository struct {
+	Groups      map[uuid.UUID]*group.Group
+	Members     map[uuid.UUID]map[uuid.UUID]bool
+	CreateError error
+	UpdateError error
+	DeleteError error
+	FindError   error
+}
+
+func NewMockGroupRepository() *MockGroupRepository {
+	return &MockGroupRepository{
+		Groups:  make(map[uuid.UUID]*group.Group),
+		Members: make(map[uuid.UUID]map[uuid.UUID]bool),
+	}
+}
+
+func (m *MockGroupRepository) Create(ctx context.Context, g *group.Group) error {
+	if m.CreateError != nil {
+		return m.CreateError
+	}
+	exists, _ := m.ExistsByName(ctx, g.Name(), g.OrganizationID())
+	if exists {
+		return group.NewGroupNameExistsError(g.Name())
+	}
+	m.Groups[g.ID()] = g
+	return nil
+}
+
+func (m *MockGroupRepository) Update(ctx context.Context, g *group.Group) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, exists := m.Groups[g.ID()]; !exists {
+		return group.NewGroupNotFoundError(g.ID().String())
+	}
+	m.Groups[g.ID()] = g
+	return nil
+}
+
+func (m *MockGroupRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteError != nil {
+		return m.DeleteError
+	}
+	if _, exists := m.Groups[id]; !exists {
+		return group.NewGroupNotFoundError(id.String())
+	}
+	delete(m.Groups, id)
+	delete(m.Members, id)
+	return nil
+}
+
+func (m *MockGroupRepository) FindByID(ctx context.Context, id uuid.UUID) (*group.Group, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	g, exists := m.Groups[id]
+	if !exists {
+		return nil, group.NewGroupNotFoundError(id.String())
+	}
+	return g, nil
+}
+
+func (m *MockGroupRepository) ExistsByName(ctx context.Context, name string, organizationID *uuid.UUID) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	for _, g := range m.Groups {
+		if !strings.EqualFold(g.Name(), name) {
+			continue
+		}
+		if sameOrganization(g.OrganizationID(), organizationID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockGroupRepository) List(ctx context.Context, filter group.Filter, pagination shared.Pagination) ([]*group.Group, int64, error) {
+	if m.FindError != nil {
+		return nil, 0, m.FindError
+	}
+	result := make([]*group.Group, 0)
+	for _, g := range m.Groups {
+		if filter.OrganizationID != nil && !sameOrganization(g.OrganizationID(), filter.OrganizationID) {
+			continue
+		}
+		if filter.Search != nil && !strings.Contains(strings.ToLower(g.Name()), strings.ToLower(*filter.Search)) {
+			continue
+		}
+		result = append(result, g)
+	}
+
+	total := int64(len(result))
+	offset := pagination.Offset()
+	if offset >= int(total) {
+		return []*group.Group{}, total, nil
+	}
+	end := offset + pagination.Limit()
+	if end > int(total) {
+		end = int(total)
+	}
+	return result[offset:end], total, nil
+}
+
+func (m *MockGroupRepository) FindByMember(ctx context.Context, userID uuid.UUID) ([]*group.Group, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*group.Group, 0)
+	for groupID, members := range m.Members {
+		if members[userID] {
+			if g, exists := m.Groups[groupID]; exists {
+				result = append(result, g)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockGroupRepository) FindRoleIDsByMember(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	groups, err := m.FindByMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]uuid.UUID, 0)
+	for _, g := range groups {
+		result = append(result, g.RoleIDs()...)
+	}
+	return result, nil
+}
+
+func (m *MockGroupRepository) AddMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if m.Members[groupID] == nil {
+		m.Members[groupID] = make(map[uuid.UUID]bool)
+	}
+	if m.Members[groupID][userID] {
+		return group.ErrAlreadyMember
+	}
+	m.Members[groupID][userID] = true
+	return nil
+}
+
+func (m *MockGroupRepository) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if !m.Members[groupID][userID] {
+		return group.ErrNotMember
+	}
+	delete(m.Members[groupID], userID)
+	return nil
+}
+
+func (m *MockGroupRepository) IsMember(ctx context.Context, groupID, userID uuid.UUID) (bool, error) {
+	if m.FindError != nil {
+		return false, m.FindError
+	}
+	return m.Members[groupID][userID], nil
+}
+
+func (m *MockGroupRepository) FindMemberIDsByRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	seen := make(map[uuid.UUID]bool)
+	result := make([]uuid.UUID, 0)
+	for groupID, members := range m.Members {
+		g, exists := m.Groups[groupID]
+		if !exists || !g.HasRole(roleID) {
+			continue
+		}
+		for userID, isMember := range members {
+			if isMember && !seen[userID] {
+				seen[userID] = true
+				result = append(result, userID)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockGroupRepository) ListMemberIDs(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]uuid.UUID, 0)
+	for userID := range m.Members[groupID] {
+		result = append(result, userID)
+	}
+	return result, nil
+}
+
+func (m *MockGroupRepository) AddGroup(g *group.Group) {
+	m.Groups[g.ID()] = g
+}
+
+func (m *MockGroupRepository) AddMembership(groupID, userID uuid.UUID) {
+	_ = m.AddMember(context.Background(), groupID, userID)
+}
+
+func sameOrganization(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+type MockAccessRequestRepository struct {
+	Requests    map[uuid.UUID]*accessrequest.AccessRequest
+	CreateError error
+	UpdateError error
+	FindError   error
+}
+
+func NewMockAccessRequestRepository() *MockAccessRequestRepository {
+	return &MockAccessRequestRepository{
+		Requests: make(map[uuid.UUID]*accessrequest.AccessRequest),
+	}
+}
+
+func (m *MockAccessRequestRepository) Create(ctx context.Context, request *accessrequest.AccessRequest) error {
+	if m.CreateError != nil {
+		return m.CreateError
+	}
+	exists, _ := m.ExistsPending(ctx, request.RequesterID(), request.RoleID())
+	if exists {
+		return accessrequest.ErrPendingRequestExists
+	}
+	m.Requests[request.ID()] = request
+	return nil
+}
+
+func (m *MockAccessRequestRepository) Update(ctx context.Context, request *accessrequest.AccessRequest) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, exists := m.Requests[request.ID()]; !exists {
+		return accessrequest.NewAccessRequestNotFoundError(request.ID().String())
+	}
+	m.Requests[request.ID()] = request
+	return nil
+}
+
+func (m *MockAccessRequestRepository) FindByID(ctx context.Context, id uuid.UUID) (*accessrequest.AccessRequest, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	request, exists := m.Requests[id]
+	if !exists {
+		return nil, accessrequest.NewAccessRequestNotFoundError(id.String())
+	}
+	return request, nil
+}
+
+func (m *MockAccessRequestRepository) List(ctx context.Context, filter accessrequest.Filter, pagination shared.Pagination) ([]*accessrequest.AccessRequest, int64, error) {
+	if m.FindError != nil {
+		return nil, 0, m.FindError
+	}
+	result := make([]*accessrequest.AccessRequest, 0)
+	for _, request := range m.Requests {
+		if filter.RequesterID != nil && request.RequesterID() != *filter.RequesterID {
+			continue
+		}
+		if filter.Status != nil && request.Status() != *filter.Status {
+			continue
+		}
+		if filter.OrganizationID != nil && !sameOrganization(request.OrganizationID(), filter.OrganizationID) {
+			continue
+		}
+		result = append(result, request)
+	}
+
+	total := int64(len(result))
+	offset := pagination.Offset()
+	if offset >= int(total) {
+		return []*accessrequest.AccessRequest{}, total, nil
+	}
+	end := offset + pagination.Limit()
+	if end > int(total) {
+		end = int(total)
+	}
+	return result[offset:end], total, nil
+}
+
+func (m *MockAccessRequestRepository) ExistsPending(ctx context.Context, requesterID, roleID uuid.UUID) (bool, error) {
+	for _, request := range m.Requests {
+		if request.RequesterID() == requesterID && request.RoleID() == roleID && request.Status().IsPending() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAccessRequestRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*accessrequest.AccessRequest, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*accessrequest.AccessRequest, 0)
+	for _, request := range m.Requests {
+		if len(result) >= limit {
+			break
+		}
+		if request.IsExpiredAt(now) {
+			result = append(result, request)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAccessRequestRepository) AddAccessRequest(request *accessrequest.AccessRequest) {
+	m.Requests[request.ID()] = request
+}
+
+type MockInvitationRepository struct {
+	Invitations map[uuid.UUID]*invitation.Invitation
+	CreateError error
+	UpdateError error
+	FindError   error
+}
+
+func NewMockInvitationRepository() *MockInvitationRepository {
+	return &MockInvitationRepository{
+		Invitations: make(map[uuid.UUID]*invitation.Invitation),
+	}
+}
+
+func (m *MockInvitationRepository) Create(ctx context.Context, inv *invitation.Invitation) error {
+	if m.CreateError != nil {
+		return m.CreateError
+	}
+	exists, _ := m.ExistsPendingForEmail(ctx, inv.Email().String())
+	if exists {
+		return invitation.ErrPendingInvitationExists
+	}
+	m.Invitations[inv.ID()] = inv
+	return nil
+}
+
+func (m *MockInvitationRepository) Update(ctx context.Context, inv *invitation.Invitation) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
+	}
+	if _, exists := m.Invitations[inv.ID()]; !exists {
+		return invitation.NewInvitationNotFoundError(inv.ID().String())
+	}
+	m.Invitations[inv.ID()] = inv
+	return nil
+}
+
+func (m *MockInvitationRepository) FindByID(ctx context.Context, id uuid.UUID) (*invitation.Invitation, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	inv, exists := m.Invitations[id]
+	if !exists {
+		return nil, invitation.NewInvitationNotFoundError(id.String())
+	}
+	return inv, nil
+}
+
+func (m *MockInvitationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*invitation.Invitation, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	for _, inv := range m.Invitations {
+		if inv.TokenHash() == tokenHash {
+			return inv, nil
+		}
+	}
+	return nil, invitation.ErrInvalidInvitationToken
+}
+
+func (m *MockInvitationRepository) List(ctx context.Context, filter invitation.Filter, pagination shared.Pagination) ([]*invitation.Invitation, int64, error) {
+	if m.FindError != nil {
+		return nil, 0, m.FindError
+	}
+	now := time.Now()
+	result := make([]*invitation.Invitation, 0)
+	for _, inv := range m.Invitations {
+		if filter.Status != nil && inv.EffectiveStatus(now) != *filter.Status {
+			continue
+		}
+		if filter.Email != "" && !strings.Contains(inv.Email().String(), strings.ToLower(filter.Email)) {
+			continue
+		}
+		result = append(result, inv)
+	}
+
+	total := int64(len(result))
+	offset := pagination.Offset()
+	if offset >= int(total) {
+		return []*invitation.Invitation{}, total, nil
+	}
+	end := offset + pagination.Limit()
+	if end > int(total) {
+		end = int(total)
+	}
+	return result[offset:end], total, nil
+}
+
+func (m *MockInvitationRepository) ExistsPendingForEmail(ctx context.Context, email string) (bool, error) {
+	for _, inv := range m.Invitations {
+		if strings.EqualFold(inv.Email().String(), email) && inv.Status().IsPending() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockInvitationRepository) DeleteAllForEmail(ctx context.Context, email string) (int64, error) {
+	var deleted int64
+	for id, inv := range m.Invitations {
+		if strings.EqualFold(inv.Email().String(), email) {
+			delete(m.Invitations, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *MockInvitationRepository) AddInvitation(inv *invitation.Invitation) {
+	m.Invitations[inv.ID()] = inv
+}
+
+type MockAccessReviewRepository struct {
+	Campaigns   map[uuid.UUID]*accessreview.Campaign
+	CreateError error
+	UpdateError error
+	FindError   error
+}
+
+func NewMockAccessReviewRepository() *MockAccessReviewRepository {
+	return &MockAccessReviewRepository{
+		Campaigns: make(map[uuid.UUID]*accessreview.Campaign),
+	}
+}
+
+func (m *MockAccessReviewRepository) Create(ctx context.Context, campaign *accessreview.Campaign) error {
+	if m.CreateError != nil {
+		return m.CreateError
 	}
-	delete(m.Users, id)
-	delete(m.EmailIndex, u.Email().String())
+	m.Campaigns[campaign.ID()] = campaign
 	return nil
 }
 
-func (m *MockUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
-	if m.FindError != nil {
-		return nil, m.FindError
+func (m *MockAccessReviewRepository) Update(ctx context.Context, campaign *accessreview.Campaign) error {
+	if m.UpdateError != nil {
+		return m.UpdateError
 	}
-	u, exists := m.Users[id]
-	if !exists {
-		return nil, user.NewUserNotFoundError(id.String())
+	if _, exists := m.Campaigns[campaign.ID()]; !exists {
+		return accessreview.NewCampaignNotFoundError(campaign.ID().String())
 	}
-	return u, nil
+	m.Campaigns[campaign.ID()] = campaign
+	return nil
 }
 
-func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+func (m *MockAccessReviewRepository) FindByID(ctx context.Context, id uuid.UUID) (*accessreview.Campaign, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	u, exists := m.EmailIndex[email]
+	campaign, exists := m.Campaigns[id]
 	if !exists {
-		return nil, user.NewUserNotFoundError(email)
+		return nil, accessreview.NewCampaignNotFoundError(id.String())
 	}
-	return u, nil
+	return campaign, nil
 }
 
-func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+func (m *MockAccessReviewRepository) List(ctx context.Context, filter accessreview.Filter, pagination shared.Pagination) ([]*accessreview.Campaign, int64, error) {
 	if m.FindError != nil {
-		return false, m.FindError
-	}
-	_, exists := m.EmailIndex[email]
-	return exists, nil
-}
-
-func (m *MockUserRepository) List(ctx context.Context, filter user.Filter, pagination shared.Pagination) ([]*user.User, int64, error) {
-	if m.ListError != nil {
-		return nil, 0, m.ListError
+		return nil, 0, m.FindError
 	}
-
-	result := make([]*user.User, 0)
-	for _, u := range m.Users {
-		if filter.Status != nil && u.Status() != *filter.Status {
+	result := make([]*accessreview.Campaign, 0)
+	for _, campaign := range m.Campaigns {
+		if filter.Status != nil && campaign.Status() != *filter.Status {
 			continue
 		}
-		result = append(result, u)
+		result = append(result, campaign)
 	}
 
 	total := int64(len(result))
-
 	offset := pagination.Offset()
-	limit := pagination.Limit()
-
 	if offset >= int(total) {
-		return []*user.User{}, total, nil
+		return []*accessreview.Campaign{}, total, nil
 	}
-
-	end := offset + limit
+	end := offset + pagination.Limit()
 	if end > int(total) {
 		end = int(total)
 	}
-
 	return result[offset:end], total, nil
 }
 
-func (m *MockUserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) ([]*user.User, error) {
+func (m *MockAccessReviewRepository) FindActiveByReviewer(ctx context.Context, reviewerID uuid.UUID) ([]*accessreview.Campaign, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	result := make([]*user.User, 0)
-	for _, u := range m.Users {
-		for _, rid := range u.RoleIDs() {
-			if rid == roleID {
-				result = append(result, u)
+	result := make([]*accessreview.Campaign, 0)
+	for _, campaign := range m.Campaigns {
+		if !campaign.Status().IsActive() {
+			continue
+		}
+		for _, item := range campaign.Items() {
+			if campaign.CanReview(item, reviewerID) {
+				result = append(result, campaign)
 				break
 			}
 		}
@@ -145,223 +1545,224 @@ func (m *MockUserRepository) FindByRole(ctx context.Context, roleID uuid.UUID) (
 	return result, nil
 }
 
-func (m *MockUserRepository) AddUser(u *user.User) {
-	m.Users[u.ID()] = u
-	m.EmailIndex[u.Email().String()] = u
+func (m *MockAccessReviewRepository) FindOverdue(ctx context.Context, now time.Time, limit int) ([]*accessreview.Campaign, error) {
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	result := make([]*accessreview.Campaign, 0)
+	for _, campaign := range m.Campaigns {
+		if len(result) >= limit {
+			break
+		}
+		if campaign.IsOverdueAt(now) {
+			result = append(result, campaign)
+		}
+	}
+	return result, nil
 }
 
-type MockPasswordHasher struct {
-	HashResult   string
-	HashError    error
-	VerifyResult bool
-	VerifyError  error
+func (m *MockAccessReviewRepository) AddCampaign(campaign *accessreview.Campaign) {
+	m.Campaigns[campaign.ID()] = campaign
 }
 
-func NewMockPasswordHasher() *MockPasswordHasher {
-	return &MockPasswordHasher{
-		HashResult:   "$2a$10$hashedpassword",
-		VerifyResult: true,
-	}
+type MockBulkOperationRepository struct {
+	mu          sync.Mutex
+	Operations  map[uuid.UUID]*bulkoperation.Operation
+	Updates     int
+	CreateError error
+	UpdateError error
+	FindError   error
 }
 
-func (m *MockPasswordHasher) Hash(password string) (string, error) {
-	if m.HashError != nil {
-		return "", m.HashError
+func NewMockBulkOperationRepository() *MockBulkOperationRepository {
+	return &MockBulkOperationRepository{
+		Operations: make(map[uuid.UUID]*bulkoperation.Operation),
 	}
-	return m.HashResult, nil
 }
 
-func (m *MockPasswordHasher) Compare(hashedPassword, password string) error {
-	if m.VerifyError != nil {
-		return m.VerifyError
-	}
-	if !m.VerifyResult {
-		return fmt.Errorf("password mismatch")
+func (m *MockBulkOperationRepository) Create(ctx context.Context, operation *bulkoperation.Operation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.CreateError != nil {
+		return m.CreateError
 	}
+	m.Operations[operation.ID()] = operation
 	return nil
 }
 
-func (m *MockPasswordHasher) Verify(hashedPassword, plainPassword string) (bool, error) {
-	if m.VerifyError != nil {
-		return false, m.VerifyError
-	}
-	return m.VerifyResult, nil
-}
-
-type MockEventBus struct {
-	PublishedEvents []shared.DomainEvent
-	PublishError    error
-	Handlers        map[string][]shared.EventHandler
-}
-
-func NewMockEventBus() *MockEventBus {
-	return &MockEventBus{
-		PublishedEvents: make([]shared.DomainEvent, 0),
-		Handlers:        make(map[string][]shared.EventHandler),
+func (m *MockBulkOperationRepository) Update(ctx context.Context, operation *bulkoperation.Operation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.UpdateError != nil {
+		return m.UpdateError
 	}
-}
-
-func (m *MockEventBus) Publish(ctx context.Context, events ...shared.DomainEvent) error {
-	if m.PublishError != nil {
-		return m.PublishError
+	if _, exists := m.Operations[operation.ID()]; !exists {
+		return bulkoperation.NewOperationNotFoundError(operation.ID().String())
 	}
-	m.PublishedEvents = append(m.PublishedEvents, events...)
+	m.Operations[operation.ID()] = operation
+	m.Updates++
 	return nil
 }
 
-func (m *MockEventBus) Subscribe(eventType string, handler shared.EventHandler) {
-	m.Handlers[eventType] = append(m.Handlers[eventType], handler)
-}
-
-func (m *MockEventBus) Unsubscribe(eventType string, handler shared.EventHandler) {
-}
-
-type NoopLogger struct{}
-
-func NewNoopLogger() *NoopLogger {
-	return &NoopLogger{}
-}
-
-func (l *NoopLogger) Debug(msg string, fields ...logger.Field) {}
-func (l *NoopLogger) Info(msg string, fields ...logger.Field)  {}
-func (l *NoopLogger) Warn(msg string, fields ...logger.Field)  {}
-func (l *NoopLogger) Error(msg string, fields ...logger.Field) {}
-func (l *NoopLogger) Fatal(msg string, fields ...logger.Field) {}
-func (l *NoopLogger) With(fields ...logger.Field) logger.Logger {
-	return l
-}
-func (l *NoopLogger) Sync() error {
-	return nil
+func (m *MockBulkOperationRepository) FindByID(ctx context.Context, id uuid.UUID) (*bulkoperation.Operation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.FindError != nil {
+		return nil, m.FindError
+	}
+	operation, exists := m.Operations[id]
+	if !exists {
+		return nil, bulkoperation.NewOperationNotFoundError(id.String())
+	}
+	return operation, nil
 }
 
-type MockRoleRepository struct {
-	Roles       map[uuid.UUID]*role.Role
-	NameIndex   map[string]*role.Role
+type MockSoDRuleRepository struct {
+	Rules       map[uuid.UUID]*sod.Rule
 	CreateError error
-	UpdateError error
-	DeleteError error
 	FindError   error
 }
 
-func NewMockRoleRepository() *MockRoleRepository {
-	return &MockRoleRepository{
-		Roles:     make(map[uuid.UUID]*role.Role),
-		NameIndex: make(map[string]*role.Role),
+func NewMockSoDRuleRepository() *MockSoDRuleRepository {
+	return &MockSoDRuleRepository{
+		Rules: make(map[uuid.UUID]*sod.Rule),
 	}
 }
 
-func (m *MockRoleRepository) Create(ctx context.Context, r *role.Role) error {
+func (m *MockSoDRuleRepository) Create(ctx context.Context, rule *sod.Rule) error {
 	if m.CreateError != nil {
 		return m.CreateError
 	}
-	m.Roles[r.ID()] = r
-	m.NameIndex[r.Name()] = r
+	for _, existing := range m.Rules {
+		if strings.EqualFold(existing.Name(), rule.Name()) {
+			return sod.NewRuleNameExistsError(rule.Name())
+		}
+	}
+	m.Rules[rule.ID()] = rule
 	return nil
 }
 
-func (m *MockRoleRepository) Update(ctx context.Context, r *role.Role) error {
-	if m.UpdateError != nil {
-		return m.UpdateError
+func (m *MockSoDRuleRepository) Update(ctx context.Context, rule *sod.Rule) error {
+	if _, exists := m.Rules[rule.ID()]; !exists {
+		return sod.NewRuleNotFoundError(rule.ID().String())
 	}
-	m.Roles[r.ID()] = r
+	m.Rules[rule.ID()] = rule
 	return nil
 }
 
-func (m *MockRoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	if m.DeleteError != nil {
-		return m.DeleteError
-	}
-	r, exists := m.Roles[id]
-	if !exists {
-		return role.ErrRoleNotFound
+func (m *MockSoDRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, exists := m.Rules[id]; !exists {
+		return sod.NewRuleNotFoundError(id.String())
 	}
-	delete(m.Roles, id)
-	delete(m.NameIndex, r.Name())
+	delete(m.Rules, id)
 	return nil
 }
 
-func (m *MockRoleRepository) FindByID(ctx context.Context, id uuid.UUID) (*role.Role, error) {
+func (m *MockSoDRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*sod.Rule, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	r, exists := m.Roles[id]
+	rule, exists := m.Rules[id]
 	if !exists {
-		return nil, role.ErrRoleNotFound
+		return nil, sod.NewRuleNotFoundError(id.String())
 	}
-	return r, nil
+	return rule, nil
 }
 
-func (m *MockRoleRepository) FindByName(ctx context.Context, name string) (*role.Role, error) {
+func (m *MockSoDRuleRepository) FindAll(ctx context.Context) ([]*sod.Rule, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	r, exists := m.NameIndex[name]
-	if !exists {
-		return nil, role.ErrRoleNotFound
+	result := make([]*sod.Rule, 0, len(m.Rules))
+	for _, rule := range m.Rules {
+		result = append(result, rule)
 	}
-	return r, nil
+	return result, nil
 }
 
-func (m *MockRoleRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*role.Role, error) {
+func (m *MockSoDRuleRepository) FindByRoles(ctx context.Context, roleIDs []uuid.UUID) ([]*sod.Rule, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	result := make([]*role.Role, 0)
-	for _, id := range ids {
-		if r, exists := m.Roles[id]; exists {
-			result = append(result, r)
+	result := make([]*sod.Rule, 0)
+	for _, rule := range m.Rules {
+		for _, roleID := range roleIDs {
+			if rule.CoversRole(roleID) {
+				result = append(result, rule)
+				break
+			}
 		}
 	}
 	return result, nil
 }
 
-func (m *MockRoleRepository) FindDefault(ctx context.Context) (*role.Role, error) {
-	if m.FindError != nil {
-		return nil, m.FindError
+func (m *MockSoDRuleRepository) AddRule(rule *sod.Rule) {
+	m.Rules[rule.ID()] = rule
+}
+
+type MockAttributeDefinitionRepository struct {
+	Definitions map[string]*userattribute.Definition
+	CreateError error
+	FindError   error
+}
+
+func NewMockAttributeDefinitionRepository() *MockAttributeDefinitionRepository {
+	return &MockAttributeDefinitionRepository{
+		Definitions: make(map[string]*userattribute.Definition),
 	}
-	for _, r := range m.Roles {
-		if r.IsDefault() {
-			return r, nil
-		}
+}
+
+func (m *MockAttributeDefinitionRepository) Create(ctx context.Context, definition *userattribute.Definition) error {
+	if m.CreateError != nil {
+		return m.CreateError
 	}
-	return nil, role.ErrRoleNotFound
+	if _, exists := m.Definitions[definition.Key()]; exists {
+		return userattribute.NewKeyExistsError(definition.Key())
+	}
+	m.Definitions[definition.Key()] = definition
+	return nil
 }
 
-func (m *MockRoleRepository) FindAll(ctx context.Context) ([]*role.Role, error) {
-	if m.FindError != nil {
-		return nil, m.FindError
+func (m *MockAttributeDefinitionRepository) Update(ctx context.Context, definition *userattribute.Definition) error {
+	if _, exists := m.Definitions[definition.Key()]; !exists {
+		return userattribute.NewDefinitionNotFoundError(definition.Key())
 	}
-	result := make([]*role.Role, 0)
-	for _, r := range m.Roles {
-		result = append(result, r)
+	m.Definitions[definition.Key()] = definition
+	return nil
+}
+
+func (m *MockAttributeDefinitionRepository) Delete(ctx context.Context, definition *userattribute.Definition) error {
+	if _, exists := m.Definitions[definition.Key()]; !exists {
+		return userattribute.NewDefinitionNotFoundError(definition.Key())
 	}
-	return result, nil
+	delete(m.Definitions, definition.Key())
+	return nil
 }
 
-func (m *MockRoleRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+func (m *MockAttributeDefinitionRepository) FindByKey(ctx context.Context, key string) (*userattribute.Definition, error) {
 	if m.FindError != nil {
-		return false, m.FindError
+		return nil, m.FindError
 	}
-	_, exists := m.NameIndex[name]
-	return exists, nil
+	definition, exists := m.Definitions[key]
+	if !exists {
+		return nil, userattribute.NewDefinitionNotFoundError(key)
+	}
+	return definition, nil
 }
 
-func (m *MockRoleRepository) FindByPermission(ctx context.Context, permissionID uuid.UUID) ([]*role.Role, error) {
+func (m *MockAttributeDefinitionRepository) FindAll(ctx context.Context) ([]*userattribute.Definition, error) {
 	if m.FindError != nil {
 		return nil, m.FindError
 	}
-	result := make([]*role.Role, 0)
-	for _, r := range m.Roles {
-		if r.HasPermission(permissionID) {
-			result = append(result, r)
-		}
+	result := make([]*userattribute.Definition, 0, len(m.Definitions))
+	for _, definition := range m.Definitions {
+		result = append(result, definition)
 	}
 	return result, nil
 }
 
-func (m *MockRoleRepository) AddRole(r *role.Role) {
-	m.Roles[r.ID()] = r
-	m.NameIndex[r.Name()] = r
+func (m *MockAttributeDefinitionRepository) AddDefinition(definition *userattribute.Definition) {
+	m.Definitions[definition.Key()] = definition
 }
 
 type MockPermissionRepository struct {
@@ -384,15 +1785,19 @@ func (m *MockPermissionRepository) Create(ctx context.Context, p *permission.Per
 }
 
 func (m *MockPermissionRepository) Update(ctx context.Context, p *permission.Permission) error {
+	p.AdvanceVersion()
 	m.Permissions[p.ID()] = p
 	return nil
 }
 
-func (m *MockPermissionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockPermissionRepository) Delete(ctx context.Context, id uuid.UUID, version int64) error {
 	p, exists := m.Permissions[id]
 	if !exists {
 		return permission.ErrPermissionNotFound
 	}
+	if p.Version() != version {
+		return permission.NewConcurrentModificationError(id.String())
+	}
 	delete(m.Permissions, id)
 	delete(m.CodeIndex, p.CodeString())
 	return nil
@@ -468,6 +1873,22 @@ func (m *MockPermissionRepository) FindAll(ctx context.Context) ([]*permission.P
 	return result, nil
 }
 
+func (m *MockPermissionRepository) ListByCursor(ctx context.Context, resource *permission.Resource, page shared.CursorPage) (shared.CursorResult[*permission.Permission], error) {
+	if m.FindError != nil {
+		return shared.CursorResult[*permission.Permission]{}, m.FindError
+	}
+	permissions := make([]*permission.Permission, 0)
+	for _, p := range m.Permissions {
+		if resource == nil || p.Resource().String() == resource.String() {
+			permissions = append(permissions, p)
+		}
+	}
+	slices.SortFunc(permissions, func(a, b *permission.Permission) int {
+		return strings.Compare(a.Code().String(), b.Code().String())
+	})
+	return mockCursorPage(permissions, page, (*permission.Permission).ID), nil
+}
+
 func (m *MockPermissionRepository) ExistsByCode(ctx context.Context, code permission.PermissionCode) (bool, error) {
 	if m.FindError != nil {
 		return false, m.FindError
@@ -571,6 +1992,20 @@ func (m *MockRefreshTokenRepository) FindActiveByUserID(ctx context.Context, use
 	return result, nil
 }
 
+func (m *MockRefreshTokenRepository) ListActiveByCursor(ctx context.Context, userID uuid.UUID, page shared.CursorPage) (shared.CursorResult[*auth.RefreshToken], error) {
+	tokens, err := m.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return shared.CursorResult[*auth.RefreshToken]{}, err
+	}
+	slices.SortFunc(tokens, func(a, b *auth.RefreshToken) int {
+		if c := b.CreatedAt().Compare(a.CreatedAt()); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID().String(), a.ID().String())
+	})
+	return mockCursorPage(tokens, page, (*auth.RefreshToken).ID), nil
+}
+
 func (m *MockRefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
 	if m.DeleteError != nil {
 		return m.DeleteError
@@ -581,6 +2016,19 @@ func (m *MockRefreshTokenRepository) RevokeAllByUserID(ctx context.Context, user
 	return nil
 }
 
+func (m *MockRefreshTokenRepository) DeleteAllByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	if m.DeleteError != nil {
+		return 0, m.DeleteError
+	}
+	tokens := m.UserTokens[userID]
+	for _, token := range tokens {
+		delete(m.Tokens, token.ID())
+		delete(m.HashIndex, token.TokenHash())
+	}
+	delete(m.UserTokens, userID)
+	return int64(len(tokens)), nil
+}
+
 func (m *MockRefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	return 0, nil
 }
@@ -599,11 +2047,16 @@ func (m *MockRefreshTokenRepository) CountActiveByUserID(ctx context.Context, us
 }
 
 type MockTokenGenerator struct {
-	RefreshToken     string
-	RefreshTokenHash string
-	GenerateError    error
-	ParseError       error
-	ParsedClaims     *auth.Claims
+	RefreshToken          string
+	RefreshTokenHash      string
+	GenerateError         error
+	ParseError            error
+	ParsedClaims          *auth.Claims
+	LastOrganizationID    *uuid.UUID
+	LastRoles             []string
+	LastPermissions       []string
+	LastDeniedPermissions []string
+	LastLocale            string
 }
 
 func NewMockTokenGenerator() *MockTokenGenerator {
@@ -613,10 +2066,19 @@ func NewMockTokenGenerator() *MockTokenGenerator {
 	}
 }
 
-func (m *MockTokenGenerator) GenerateAccessToken(userID uuid.UUID, email string, roles, permissions []string) (auth.AccessToken, error) {
+func (m *MockTokenGenerator) GenerateAccessToken(userID uuid.UUID, email string, roles, permissions, deniedPermissions []string) (auth.AccessToken, error) {
+	return m.GenerateOrganizationAccessToken(userID, email, nil, roles, permissions, deniedPermissions, "")
+}
+
+func (m *MockTokenGenerator) GenerateOrganizationAccessToken(userID uuid.UUID, email string, organizationID *uuid.UUID, roles, permissions, deniedPermissions []string, locale string) (auth.AccessToken, error) {
 	if m.GenerateError != nil {
 		return auth.AccessToken{}, m.GenerateError
 	}
+	m.LastOrganizationID = organizationID
+	m.LastRoles = roles
+	m.LastPermissions = permissions
+	m.LastDeniedPermissions = deniedPermissions
+	m.LastLocale = locale
 	return auth.NewAccessToken("mock_access_token", time.Now().Add(15*time.Minute)), nil
 }
 
@@ -740,3 +2202,17 @@ func (m *MockPasswordResetTokenStore) Delete(ctx context.Context, tokenHash stri
 	delete(m.Tokens, tokenHash)
 	return nil
 }
+
+func (m *MockPasswordResetTokenStore) DeleteAllForEmail(ctx context.Context, email string) (int64, error) {
+	if m.DeleteError != nil {
+		return 0, m.DeleteError
+	}
+	var deleted int64
+	for tokenHash, tokenEmail := range m.Tokens {
+		if strings.EqualFold(tokenEmail, email) {
+			delete(m.Tokens, tokenHash)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
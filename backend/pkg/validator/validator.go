@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
 )
 
 type Validator struct {
@@ -21,10 +23,26 @@ type ValidationError struct {
 	Tag     string `json:"tag"`
 	Value   string `json:"value,omitempty"`
 	Message string `json:"message"`
+
+	template string
+	params   i18n.Params
 }
 
 type ValidationErrors []ValidationError
 
+// Localize returns the errors with their messages rendered in locale.
+// Messages registered without a translation stay in English.
+func (e ValidationErrors) Localize(locale string) ValidationErrors {
+	localized := make(ValidationErrors, len(e))
+	for i, err := range e {
+		if err.template != "" {
+			err.Message = i18n.Translate(locale, err.template, err.params)
+		}
+		localized[i] = err
+	}
+	return localized
+}
+
 func (e ValidationErrors) Error() string {
 	if len(e) == 0 {
 		return ""
@@ -99,26 +117,27 @@ func (v *Validator) translateErrors(errs validator.ValidationErrors) ValidationE
 		tag := err.Tag()
 		param := err.Param()
 
-		message := v.getMessage(tag, field, param)
+		template := v.getTemplate(tag)
+		params := i18n.Params{"field": field, "param": param, "tag": tag}
 
 		result = append(result, ValidationError{
-			Field:   field,
-			Tag:     tag,
-			Value:   formatValue(err.Value()),
-			Message: message,
+			Field:    field,
+			Tag:      tag,
+			Value:    formatValue(err.Value()),
+			Message:  i18n.Render(template, params),
+			template: template,
+			params:   params,
 		})
 	}
 
 	return result
 }
 
-func (v *Validator) getMessage(tag, field, param string) string {
+func (v *Validator) getTemplate(tag string) string {
 	if msg, ok := v.messages[tag]; ok {
-		msg = strings.ReplaceAll(msg, "{field}", field)
-		msg = strings.ReplaceAll(msg, "{param}", param)
 		return msg
 	}
-	return field + " failed on " + tag + " validation"
+	return "{field} failed on {tag} validation"
 }
 
 func (v *Validator) registerCustomValidations() {
@@ -0,0 +1,35 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tranvuongduy2003/go-copilot/pkg/i18n"
+)
+
+func TestValidationErrors_Localize(t *testing.T) {
+	type request struct {
+		Email    string `validate:"required,email"`
+		FullName string `validate:"min=2"`
+	}
+
+	err := New().Validate(request{FullName: "a"})
+	errs, ok := GetValidationErrors(err)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "email is required", errs[0].Message)
+
+	localized := errs.Localize(i18n.Vietnamese)
+	assert.Equal(t, "email là bắt buộc", localized[0].Message)
+	assert.Equal(t, "full_name phải có ít nhất 2 ký tự", localized[1].Message)
+	assert.Equal(t, "email is required", errs[0].Message, "the original errors stay in English")
+}
+
+func TestDefaultMessagesHaveVietnameseTranslations(t *testing.T) {
+	for tag, message := range defaultMessages() {
+		_, ok := i18n.Lookup(i18n.Vietnamese, message)
+		assert.True(t, ok, "no Vietnamese translation for the %q message", tag)
+	}
+}